@@ -0,0 +1,57 @@
+package grpcclient
+
+import (
+	"strconv"
+
+	internal_grpc "github.com/antonio-alexander/go-bludgeon/internal/grpc/client"
+
+	"github.com/pkg/errors"
+)
+
+// environmental variables
+const (
+	EnvNameGrpcAddress string = "BLUDGEON_CHANGES_GRPC_ADDRESS"
+	EnvNameGrpcPort    string = "BLUDGEON_CHANGES_GRPC_PORT"
+)
+
+// defaults
+const (
+	DefaultPort    string = "8015"
+	DefaultAddress string = "localhost"
+)
+
+type Configuration struct {
+	internal_grpc.Configuration
+}
+
+func (r *Configuration) Default() {
+	r.Address = DefaultAddress
+	r.Port = DefaultPort
+	r.Options = internal_grpc.DefaultOptions
+}
+
+func (r *Configuration) FromEnv(envs map[string]string) {
+	//Get the address from the environment, then the port
+	if address, ok := envs[EnvNameGrpcAddress]; ok {
+		r.Address = address
+	}
+	if port, ok := envs[EnvNameGrpcPort]; ok {
+		r.Port = port
+	}
+}
+
+func (r *Configuration) Validate() error {
+	//validate that the address isn't empty
+	// check if the port is empty, and then ensure
+	// that the port is an integer
+	if r.Address == "" {
+		return errors.New(internal_grpc.ErrAddressEmpty)
+	}
+	if r.Port == "" {
+		return errors.New(internal_grpc.ErrPortEmpty)
+	}
+	if _, e := strconv.Atoi(r.Port); e != nil {
+		return errors.Errorf(internal_grpc.ErrPortBadf, r.Port)
+	}
+	return nil
+}
@@ -0,0 +1,9 @@
+// Copyright 2022 antonio-alexander. All rights reserved.
+// Use of this source code is governed by an MPLv2
+// license that can be found in the LICENSE file.
+
+/*
+Package grpcclient provides an implementation of a grpc client to interact with
+the changes service.
+*/
+package grpcclient
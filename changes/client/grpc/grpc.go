@@ -0,0 +1,236 @@
+package grpcclient
+
+import (
+	"context"
+	"sync"
+
+	client "github.com/antonio-alexander/go-bludgeon/changes/client"
+	data "github.com/antonio-alexander/go-bludgeon/changes/data"
+	pb "github.com/antonio-alexander/go-bludgeon/changes/data/pb"
+
+	internal "github.com/antonio-alexander/go-bludgeon/internal"
+	config "github.com/antonio-alexander/go-bludgeon/internal/config"
+	grpcclient "github.com/antonio-alexander/go-bludgeon/internal/grpc/client"
+	logger "github.com/antonio-alexander/go-bludgeon/internal/logger"
+
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc"
+)
+
+type grpcClient struct {
+	sync.RWMutex
+	logger.Logger
+	changesClient pb.ChangesClient
+	ctx           context.Context
+	cancel        context.CancelFunc
+	handlers      map[string]*handler
+	client        interface {
+		internal.Configurer
+		internal.Initializer
+		internal.Parameterizer
+		grpc.ClientConnInterface
+	}
+}
+
+// New can be used to create a concrete instance of the grpc client
+// that implements the interfaces of client.Client and client.Handler
+func New() interface {
+	client.Client
+	client.Handler
+	client.Metrics
+	internal.Initializer
+	internal.Configurer
+	internal.Parameterizer
+} {
+	return &grpcClient{
+		Logger:   logger.NewNullLogger(),
+		client:   grpcclient.New(),
+		handlers: make(map[string]*handler),
+	}
+}
+
+func (g *grpcClient) SetParameters(parameters ...interface{}) {
+	g.client.SetParameters(parameters...)
+}
+
+func (g *grpcClient) SetUtilities(parameters ...interface{}) {
+	g.client.SetUtilities(parameters...)
+	for _, parameter := range parameters {
+		switch p := parameter.(type) {
+		case logger.Logger:
+			g.Logger = p
+		}
+	}
+}
+
+func (g *grpcClient) Configure(items ...interface{}) error {
+	var configuration *Configuration
+	var envs map[string]string
+
+	for _, item := range items {
+		switch v := item.(type) {
+		case config.Envs:
+			envs = v
+		case *Configuration:
+			configuration = v
+		}
+	}
+	if configuration == nil {
+		configuration = new(Configuration)
+		configuration.Default()
+		configuration.FromEnv(envs)
+	}
+	if err := configuration.Validate(); err != nil {
+		return err
+	}
+	if err := g.client.Configure(&configuration.Configuration); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Initialize can be used to ready the underlying pointer for use
+func (g *grpcClient) Initialize() error {
+	g.Lock()
+	defer g.Unlock()
+
+	if err := g.client.Initialize(); err != nil {
+		return err
+	}
+	g.changesClient = pb.NewChangesClient(g.client)
+	g.ctx, g.cancel = context.WithCancel(context.Background())
+	return nil
+}
+
+func (g *grpcClient) Shutdown() {
+	g.Lock()
+	defer g.Unlock()
+
+	if g.cancel != nil {
+		g.cancel()
+	}
+	for handlerId, handler := range g.handlers {
+		handler.Close()
+		delete(g.handlers, handlerId)
+	}
+	g.client.Shutdown()
+}
+
+// ChangeUpsert can be used to create a change
+func (g *grpcClient) ChangeUpsert(ctx context.Context, changePartial data.ChangePartial) (*data.Change, error) {
+	response, err := g.changesClient.ChangeUpsert(ctx, &pb.ChangeUpsertRequest{
+		ChangePartial: pb.FromChangePartial(&changePartial),
+	})
+	return pb.ToChange(response.GetChange()), err
+}
+
+// ChangeRead can be used to read a single change given a valid id
+func (g *grpcClient) ChangeRead(ctx context.Context, changeId string) (*data.Change, error) {
+	response, err := g.changesClient.ChangeRead(ctx, &pb.ChangeReadRequest{Id: changeId})
+	return pb.ToChange(response.GetChange()), err
+}
+
+// ChangesRead can be used to read one or more changes given a set of
+// search parameters
+func (g *grpcClient) ChangesRead(ctx context.Context, search data.ChangeSearch) ([]*data.Change, error) {
+	response, err := g.changesClient.ChangesRead(ctx, &pb.ChangesReadRequest{
+		ChangeSearch: pb.ToChangeSearch(&search),
+	})
+	return pb.ToChanges(response.GetChanges()), err
+}
+
+// ChangeDelete can be used to delete a single change given a valid id
+func (g *grpcClient) ChangeDelete(ctx context.Context, changeId string) error {
+	_, err := g.changesClient.ChangeDelete(ctx, &pb.ChangeDeleteRequest{Id: changeId})
+	return err
+}
+
+// RegistrationUpsert can be used to create (or update) a registration, only
+// changes that match the filter will be associated with the registration
+func (g *grpcClient) RegistrationUpsert(ctx context.Context, registrationId string, options ...client.RegistrationOption) error {
+	registrationOptions := client.NewRegistrationOptions(options...)
+	_, err := g.changesClient.RegistrationUpsert(ctx, &pb.RegistrationUpsertRequest{
+		RegistrationId: registrationId,
+		Filter:         pb.FromRegistrationFilter(&registrationOptions.Filter),
+	})
+	return err
+}
+
+// RegistrationChangesRead can be used to read all of the changes that have
+// yet to be acknowledged by the registration
+func (g *grpcClient) RegistrationChangesRead(ctx context.Context, registrationId string) ([]*data.Change, error) {
+	response, err := g.changesClient.RegistrationChangesRead(ctx, &pb.RegistrationChangesReadRequest{
+		RegistrationId: registrationId,
+	})
+	return pb.ToChanges(response.GetChanges()), err
+}
+
+// RegistrationChangeAcknowledge can be used to acknowledge one or more
+// changes for the registration
+func (g *grpcClient) RegistrationChangeAcknowledge(ctx context.Context, registrationId string, changeIds ...string) error {
+	_, err := g.changesClient.RegistrationChangeAcknowledge(ctx, &pb.RegistrationChangeAcknowledgeRequest{
+		RegistrationId: registrationId,
+		ChangeIds:      changeIds,
+	})
+	return err
+}
+
+// RegistrationDelete can be used to delete a registration
+func (g *grpcClient) RegistrationDelete(ctx context.Context, registrationId string) error {
+	_, err := g.changesClient.RegistrationDelete(ctx, &pb.RegistrationDeleteRequest{
+		RegistrationId: registrationId,
+	})
+	return err
+}
+
+// MetricsRead can be used to read the delivery metrics of each
+// registration and the metrics of each handler
+func (g *grpcClient) MetricsRead(ctx context.Context) (*data.Metrics, error) {
+	response, err := g.changesClient.MetricsRead(ctx, &pb.MetricsReadRequest{})
+	if err != nil {
+		return nil, err
+	}
+	return pb.ToMetrics(response.GetMetrics()), nil
+}
+
+// HandlerCreate can be used to subscribe to changes (that match the filter),
+// the subscription is re-established if the stream fails or the handler is
+// disconnected because of an overflow
+func (g *grpcClient) HandlerCreate(handlerFx client.HandlerFx, options ...client.HandlerOption) (string, error) {
+	g.Lock()
+	defer g.Unlock()
+
+	if g.changesClient == nil {
+		return "", errors.New("not initialized")
+	}
+	handlerId := uuid.Must(uuid.NewRandom()).String()
+	handlerOptions := client.NewHandlerOptions(options...)
+	g.handlers[handlerId] = newHandler(g.ctx, g, handlerId, g.changesClient, handlerOptions.Filter,
+		handlerOptions.HandlerOptions, handlerFx, handlerOptions.OverflowFx)
+	return handlerId, nil
+}
+
+func (g *grpcClient) HandlerConnected(handlerId string) (bool, error) {
+	g.RLock()
+	defer g.RUnlock()
+
+	handler, ok := g.handlers[handlerId]
+	if !ok {
+		return false, errors.New("handler not found")
+	}
+	return handler.IsConnected(), nil
+}
+
+func (g *grpcClient) HandlerDelete(handlerId string) error {
+	g.Lock()
+	defer g.Unlock()
+
+	handler, ok := g.handlers[handlerId]
+	if !ok {
+		return errors.New("handler not found")
+	}
+	delete(g.handlers, handlerId)
+	handler.Close()
+	return nil
+}
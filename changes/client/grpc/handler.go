@@ -0,0 +1,126 @@
+package grpcclient
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	client "github.com/antonio-alexander/go-bludgeon/changes/client"
+	data "github.com/antonio-alexander/go-bludgeon/changes/data"
+	pb "github.com/antonio-alexander/go-bludgeon/changes/data/pb"
+
+	internal_logger "github.com/antonio-alexander/go-bludgeon/internal/logger"
+)
+
+type handler struct {
+	sync.RWMutex
+	sync.WaitGroup
+	internal_logger.Logger
+	changesClient pb.ChangesClient
+	logAlias      string
+	ctx           context.Context
+	cancel        context.CancelFunc
+	filter        data.RegistrationFilter
+	options       data.HandlerOptions
+	handlerFx     client.HandlerFx
+	overflowFx    client.OverflowFx
+	connected     bool
+}
+
+func newHandler(ctx context.Context, logger internal_logger.Logger, handlerId string, changesClient pb.ChangesClient, filter data.RegistrationFilter, options data.HandlerOptions, handlerFx client.HandlerFx, overflowFx client.OverflowFx) *handler {
+	ctx, cancel := context.WithCancel(ctx)
+	h := &handler{
+		Logger:        logger,
+		changesClient: changesClient,
+		handlerFx:     handlerFx,
+		overflowFx:    overflowFx,
+		filter:        filter,
+		options:       options,
+		logAlias:      logAlias + "[" + handlerId + "] ",
+		ctx:           ctx,
+		cancel:        cancel,
+	}
+	h.launchSubscribe()
+	return h
+}
+
+func (h *handler) Close() {
+	h.cancel()
+	h.Wait()
+}
+
+func (h *handler) setConnected(connected bool) {
+	h.Lock()
+	defer h.Unlock()
+	h.connected = connected
+}
+
+func (h *handler) IsConnected() bool {
+	h.RLock()
+	defer h.RUnlock()
+	return h.connected
+}
+
+// subscribe will open a stream and call the handler function for each set of
+// changes received, it returns once the stream fails or the handler is closed
+func (h *handler) subscribe() error {
+	stream, err := h.changesClient.Subscribe(h.ctx, &pb.SubscribeRequest{
+		Filter:          pb.FromRegistrationFilter(&h.filter),
+		RegistrationId:  h.options.RegistrationId,
+		Overflow:        string(h.options.Overflow),
+		OverflowTimeout: h.options.OverflowTimeout,
+	})
+	if err != nil {
+		return err
+	}
+	//KIM: the stream is only established once the headers
+	// have been received from the server
+	if _, err := stream.Header(); err != nil {
+		return err
+	}
+	h.setConnected(true)
+	defer h.setConnected(false)
+	h.Trace(h.logAlias + "connected")
+	for {
+		response, err := stream.Recv()
+		if err != nil {
+			return err
+		}
+		if overflow := response.GetOverflow(); overflow != nil {
+			if h.overflowFx == nil {
+				continue
+			}
+			if err := h.overflowFx(pb.ToHandlerOverflow(overflow)); err != nil {
+				h.Error(h.logAlias+"error while handling overflow: %s", err)
+			}
+			continue
+		}
+		if err := h.handlerFx(pb.ToChanges(response.GetChanges())...); err != nil {
+			h.Error(h.logAlias+"error while handling changes: %s", err)
+		}
+	}
+}
+
+func (h *handler) launchSubscribe() {
+	started := make(chan struct{})
+	h.Add(1)
+	go func() {
+		defer h.Done()
+
+		tRetry := time.NewTimer(0)
+		defer tRetry.Stop()
+		close(started)
+		for {
+			select {
+			case <-h.ctx.Done():
+				return
+			case <-tRetry.C:
+				if err := h.subscribe(); err != nil && h.ctx.Err() == nil {
+					h.Error(h.logAlias+"error while subscribing: %s", err)
+				}
+				tRetry.Reset(SubscribeRetryRate)
+			}
+		}
+	}()
+	<-started
+}
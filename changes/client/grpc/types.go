@@ -0,0 +1,9 @@
+package grpcclient
+
+import "time"
+
+const logAlias string = "[grpc_client] "
+
+// SubscribeRetryRate is how long a handler waits before attempting
+// to re-subscribe after its stream fails
+var SubscribeRetryRate time.Duration = 10 * time.Second
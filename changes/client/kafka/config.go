@@ -4,10 +4,14 @@ import "errors"
 
 const DefaultTopic string = "changes"
 
-const EnvNameChangesTopic string = "BLUDGEON_CHANGES_TOPIC"
+const (
+	EnvNameChangesTopic       string = "BLUDGEON_CHANGES_TOPIC"
+	EnvNameChangesIngestTopic string = "BLUDGEON_CHANGES_INGEST_TOPIC"
+)
 
 type Configuration struct {
-	Topic string
+	Topic       string
+	IngestTopic string //the topic changes are upserted to (optional)
 }
 
 func (c *Configuration) Validate() error {
@@ -25,4 +29,7 @@ func (c *Configuration) FromEnv(envs map[string]string) {
 	if topic := envs[EnvNameChangesTopic]; topic != "" {
 		c.Topic = topic
 	}
+	if ingestTopic := envs[EnvNameChangesIngestTopic]; ingestTopic != "" {
+		c.IngestTopic = ingestTopic
+	}
 }
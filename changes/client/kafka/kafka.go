@@ -1,9 +1,11 @@
 package kafkaclient
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"sync"
+	"time"
 
 	"github.com/antonio-alexander/go-bludgeon/changes/client"
 	"github.com/antonio-alexander/go-bludgeon/changes/data"
@@ -17,11 +19,17 @@ import (
 	"github.com/google/uuid"
 )
 
+type handler struct {
+	handlerFx  client.HandlerFx
+	overflowFx client.OverflowFx
+	filter     data.RegistrationFilter
+}
+
 type kafkaClient struct {
 	sync.RWMutex
 	sync.WaitGroup
 	internal_logger.Logger
-	handlers    map[string]client.HandlerFx
+	handlers    map[string]handler
 	subscribeId string
 	initialized bool
 	configured  bool
@@ -36,57 +44,99 @@ type kafkaClient struct {
 
 func New() interface {
 	client.Handler
+	client.ChangeUpserter
 	internal.Initializer
 	internal.Parameterizer
 	internal.Configurer
 } {
 	return &kafkaClient{
-		handlers:    make(map[string]client.HandlerFx),
+		handlers:    make(map[string]handler),
 		kafkaClient: internal_kafka.New(),
 		Logger:      logger.NewNullLogger(),
 	}
 }
 
-func (k *kafkaClient) handleFx(changes ...*data.Change) {
+// handleFx will provide the changes to each handler (that matches), if any handler
+// returns an error, the error is returned so the changes are re-delivered
+func (k *kafkaClient) handleFx(changes ...*data.Change) error {
 	k.RLock()
 	defer k.RUnlock()
 
 	var wg sync.WaitGroup
+	var errMux sync.Mutex
+	var err error
+
+	for handlerId, handler := range k.handlers {
+		var changesFiltered []*data.Change
 
-	for _, handleFx := range k.handlers {
+		for _, change := range changes {
+			if handler.filter.Match(change) {
+				changesFiltered = append(changesFiltered, change)
+			}
+		}
+		if len(changesFiltered) == 0 {
+			continue
+		}
 		wg.Add(1)
-		go func(handleFx client.HandlerFx) {
+		go func(handlerId string, handleFx client.HandlerFx) {
 			defer wg.Done()
 
-			handleFx(changes...)
-		}(handleFx)
+			if e := handleFx(changesFiltered...); e != nil {
+				k.Debug(logAlias+"error while handling change(s) for %s: %s", handlerId, e)
+				errMux.Lock()
+				err = e
+				errMux.Unlock()
+			}
+		}(handlerId, handler.handlerFx)
 	}
 	wg.Wait()
+	return err
 }
 
-func (k *kafkaClient) subscribeFx(topic string, bytes []byte) {
+// overflowFx will signal each handler that the service publishing to
+// the topic was unable to deliver changes
+func (k *kafkaClient) overflowFx(overflow *data.HandlerOverflow) {
+	k.RLock()
+	defer k.RUnlock()
+
+	for handlerId, handler := range k.handlers {
+		if handler.overflowFx == nil {
+			continue
+		}
+		if err := handler.overflowFx(overflow); err != nil {
+			k.Error(logAlias+"error while handling overflow for %s: %s", handlerId, err)
+		}
+	}
+}
+
+// subscribeFx will handle the changes (or overflow) consumed from the topic, if a
+// handler returns an error, the message isn't acknowledged so it's re-delivered
+func (k *kafkaClient) subscribeFx(topic string, bytes []byte) error {
 	if len(bytes) == 0 {
 		k.Trace(logAlias + "no bytes received")
-		return
+		return nil
 	}
 	wrapper := &data.Wrapper{}
 	if err := json.Unmarshal(bytes, wrapper); err != nil {
 		k.Error(logAlias+"error while unmarshalling json: %s", err)
-		return
+		return nil
 	}
 	item, err := data.FromWrapper(wrapper)
 	if err != nil {
 		k.Error(logAlias+"error during  FromWrapper: %s", err)
-		return
+		return nil
 	}
 	switch v := item.(type) {
 	default:
 		k.Trace(logAlias+"received unsupported type: %T", v)
 	case *data.Change:
-		k.handleFx(v)
+		return k.handleFx(v)
 	case *data.ChangeDigest:
-		k.handleFx(v.Changes...)
+		return k.handleFx(v.Changes...)
+	case *data.HandlerOverflow:
+		k.overflowFx(v)
 	}
+	return nil
 }
 
 func (k *kafkaClient) SetParameters(parameters ...interface{}) {
@@ -156,7 +206,7 @@ func (k *kafkaClient) Initialize() error {
 	if err := k.kafkaClient.Initialize(); err != nil {
 		return err
 	}
-	subscribeId, err := k.kafkaClient.Subscribe(k.config.Topic, k.subscribeFx)
+	subscribeId, err := k.kafkaClient.SubscribeAck(k.config.Topic, k.subscribeFx)
 	if err != nil {
 		return err
 	}
@@ -171,17 +221,71 @@ func (k *kafkaClient) Shutdown() {
 	if !k.initialized {
 		return
 	}
-	k.kafkaClient.Unsubscribe(k.subscribeId)
+	k.kafkaClient.Unsubscribe(k.config.Topic, k.subscribeId)
 	k.initialized, k.subscribeId = false, ""
 	k.Info(logAlias + "shutdown")
 }
 
-func (k *kafkaClient) HandlerCreate(handlerFx client.HandlerFx) (string, error) {
+// ChangeUpsert can be used to publish a change to the ingest topic, the changes
+// service will upsert it once it's consumed. The change returned doesn't have an
+// id because it hasn't been upserted yet, when changed defaults to now so it
+// reflects when the change occurred rather than when it was ingested. The trace
+// and correlation ids of the context are published as headers
+func (k *kafkaClient) ChangeUpsert(ctx context.Context, changePartial data.ChangePartial) (*data.Change, error) {
+	k.RLock()
+	defer k.RUnlock()
+
+	if !k.initialized {
+		return nil, errors.New("not initialized")
+	}
+	if k.config.IngestTopic == "" {
+		return nil, errors.New("ingest topic not configured")
+	}
+	if changePartial.WhenChanged == nil {
+		whenChanged := time.Now().UnixNano()
+		changePartial.WhenChanged = &whenChanged
+	}
+	if err := k.kafkaClient.PublishWithHeaders(k.config.IngestTopic, "",
+		internal_kafka.HeadersFromContext(ctx), data.ToWrapper(&changePartial)); err != nil {
+		return nil, err
+	}
+	change := &data.Change{WhenChanged: *changePartial.WhenChanged}
+	if changePartial.ChangedBy != nil {
+		change.ChangedBy = *changePartial.ChangedBy
+	}
+	if changePartial.DataId != nil {
+		change.DataId = *changePartial.DataId
+	}
+	if changePartial.DataServiceName != nil {
+		change.DataServiceName = *changePartial.DataServiceName
+	}
+	if changePartial.DataType != nil {
+		change.DataType = *changePartial.DataType
+	}
+	if changePartial.DataAction != nil {
+		change.DataAction = *changePartial.DataAction
+	}
+	if changePartial.DataVersion != nil {
+		change.DataVersion = *changePartial.DataVersion
+	}
+	change.Payload = changePartial.Payload
+	return change, nil
+}
+
+// HandlerCreate can be used to create a handler for changes published to the
+// topic, the registration id and overflow strategy are ignored because they're
+// configured for the service publishing to the topic
+func (k *kafkaClient) HandlerCreate(handlerFx client.HandlerFx, options ...client.HandlerOption) (string, error) {
 	k.Lock()
 	defer k.Unlock()
 
+	handlerOptions := client.NewHandlerOptions(options...)
 	handlerId := uuid.Must(uuid.NewRandom()).String()
-	k.handlers[handlerId] = handlerFx
+	k.handlers[handlerId] = handler{
+		handlerFx:  handlerFx,
+		overflowFx: handlerOptions.OverflowFx,
+		filter:     handlerOptions.Filter,
+	}
 	return handlerId, nil
 }
 
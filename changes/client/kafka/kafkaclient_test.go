@@ -37,6 +37,7 @@ func init() {
 		}
 	}
 	configKafka.Default()
+	configKafka.GroupId = generateId()
 	configKafka.FromEnv(envs)
 	configKafka.Brokers = []string{"localhost:9092"}
 	configKafka.ToSarama()
@@ -44,6 +45,7 @@ func init() {
 	configChangesRest.FromEnv(envs)
 	configChangesRest.Rest.Port = "8080"
 	configChangesKafka.Default()
+	configChangesKafka.IngestTopic = "changes_ingest"
 }
 
 // REFERENCE: https://stackoverflow.com/questions/22892120/how-to-generate-a-random-string-of-a-fixed-length-in-go
@@ -69,6 +71,7 @@ type kafkaClientTest struct {
 		internal.Initializer
 		internal.Configurer
 		client.Handler
+		client.ChangeUpserter
 	}
 	changesClient interface {
 		client.Client
@@ -171,6 +174,60 @@ func (r *kafkaClientTest) TestChangeStreaming(t *testing.T) {
 	assert.Nil(t, err)
 }
 
+func (r *kafkaClientTest) TestChangeIngest(t *testing.T) {
+	ctx := context.TODO()
+	changeReceived := make(chan *data.Change, 1)
+
+	//generate dynamic constants
+	dataId, dataVersion := generateId(), rand.Intn(1000)
+	serviceName, dataType := randomString(), "test"
+
+	//register handler
+	handlerId, err := r.changesHandler.HandlerCreate(func(changes ...*data.Change) error {
+		for _, c := range changes {
+			if c.DataId == dataId {
+				select {
+				default:
+				case changeReceived <- c:
+				}
+			}
+		}
+		return nil
+	})
+	assert.Nil(t, err)
+
+	time.Sleep(10 * time.Second)
+
+	//upsert change (published to the ingest topic)
+	changeUpserted, err := r.changesHandler.ChangeUpsert(ctx, data.ChangePartial{
+		DataId:          &dataId,
+		DataVersion:     &dataVersion,
+		DataType:        &dataType,
+		DataServiceName: &serviceName,
+	})
+	assert.Nil(t, err)
+	if assert.NotNil(t, changeUpserted) {
+		assert.Empty(t, changeUpserted.Id)
+		assert.NotZero(t, changeUpserted.WhenChanged)
+	}
+
+	//wait for change to be ingested (and received)
+	select {
+	case change := <-changeReceived:
+		assert.NotEmpty(t, change.Id)
+		assert.Equal(t, dataVersion, change.DataVersion)
+		assert.Equal(t, changeUpserted.WhenChanged, change.WhenChanged)
+		err = r.changesClient.ChangeDelete(ctx, change.Id)
+		assert.Nil(t, err)
+	case <-time.After(10 * time.Second):
+		assert.Fail(t, "unable to confirm change ingested")
+	}
+
+	//unregister handler
+	err = r.changesHandler.HandlerDelete(handlerId)
+	assert.Nil(t, err)
+}
+
 func TestChangesKafkaClient(t *testing.T) {
 	r := newKafkaClientTest()
 
@@ -178,5 +235,6 @@ func TestChangesKafkaClient(t *testing.T) {
 	defer r.Shutdown(t)
 
 	t.Run("Change Streaming", r.TestChangeStreaming)
+	t.Run("Change Ingest", r.TestChangeIngest)
 	//TODO: test consumer group?
 }
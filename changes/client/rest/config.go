@@ -70,10 +70,10 @@ func (c *Configuration) FromEnv(envs map[string]string) {
 		c.Rest.Port = port
 	}
 	if s, ok := envs[EnvNameDisableCache]; ok {
-		c.DisableQueue, _ = strconv.ParseBool(s)
+		c.DisableCache, _ = strconv.ParseBool(s)
 	}
 	if s, ok := envs[EnvNameDisableQueue]; ok {
-		c.DisableCache, _ = strconv.ParseBool(s)
+		c.DisableQueue, _ = strconv.ParseBool(s)
 	}
 }
 
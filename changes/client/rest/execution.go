@@ -25,5 +25,6 @@ func changePartialToChange(changePartial data.ChangePartial) *data.Change {
 	if whenChanged := changePartial.WhenChanged; whenChanged != nil {
 		change.WhenChanged = *whenChanged
 	}
+	change.Payload = changePartial.Payload
 	return change
 }
@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"strings"
 	"sync"
 	"time"
 
@@ -30,15 +31,21 @@ type handler struct {
 	ctx          context.Context
 	cancel       context.CancelFunc
 	disconnected chan struct{}
+	filter       data.RegistrationFilter
+	options      data.HandlerOptions
 	handlerFx    client.HandlerFx
+	overflowFx   client.OverflowFx
 }
 
-func newHandler(ctx context.Context, logger internal_logger.Logger, handlerId string, config *Configuration, handlerFx client.HandlerFx) *handler {
+func newHandler(ctx context.Context, logger internal_logger.Logger, handlerId string, config *Configuration, filter data.RegistrationFilter, options data.HandlerOptions, handlerFx client.HandlerFx, overflowFx client.OverflowFx) *handler {
 	ctx, cancel := context.WithCancel(ctx)
 	h := &handler{
 		Logger:       logger,
 		client:       internal_websocketclient.New(),
 		handlerFx:    handlerFx,
+		overflowFx:   overflowFx,
+		filter:       filter,
+		options:      options,
 		disconnected: make(chan struct{}, 1),
 		config:       config,
 		logAlias:     logAlias + "[" + handlerId + "] ",
@@ -67,8 +74,8 @@ func (h *handler) launchConnect() {
 		defer h.Done()
 
 		connectFx := func() bool {
-			uri := fmt.Sprintf("ws://%s:%s"+data.RouteChangesWebsocket,
-				h.config.Rest.Address, h.config.Rest.Port)
+			uri := fmt.Sprintf("ws://%s:%s"+data.RouteChangesWebsocket+h.filter.ToParams()+
+				"&"+strings.TrimPrefix(h.options.ToParams(), "?"), h.config.Rest.Address, h.config.Rest.Port)
 			response, err := h.client.Connect(h.ctx, uri, http.Header{})
 			if err != nil {
 				h.Error(logAlias+"error while connecting to websocket: %s", err)
@@ -144,6 +151,19 @@ func (h *handler) launchChangeReader() error {
 					h.Error(logAlias+"error while handling changes: %s", err)
 					return
 				}
+			case data.MessageTypeHandlerOverflow:
+				overflow := &data.HandlerOverflow{}
+				if err := json.Unmarshal(wrapper.Bytes, overflow); err != nil {
+					h.Error(h.logAlias+"error while unmarshalling overflow: %s", err)
+					return
+				}
+				if h.overflowFx == nil {
+					return
+				}
+				if err := h.overflowFx(overflow); err != nil {
+					h.Error(h.logAlias+"error while handling overflow: %s", err)
+					return
+				}
 			}
 		}
 		tRate := time.NewTicker(10 * time.Second)
@@ -53,6 +53,7 @@ type restClient struct {
 func New() interface {
 	client.Client
 	client.Handler
+	client.Metrics
 	internal.Initializer
 	internal.Configurer
 	internal.Parameterizer
@@ -356,8 +357,11 @@ func (r *restClient) ChangeDelete(ctx context.Context, changeId string) error {
 	return nil
 }
 
-func (r *restClient) RegistrationUpsert(ctx context.Context, registrationId string) error {
-	bytes, err := json.Marshal(&data.RequestRegister{RegistrationId: registrationId})
+func (r *restClient) RegistrationUpsert(ctx context.Context, registrationId string, options ...client.RegistrationOption) error {
+	bytes, err := json.Marshal(&data.RequestRegister{
+		RegistrationId: registrationId,
+		Filter:         client.NewRegistrationOptions(options...).Filter,
+	})
 	if err != nil {
 		return err
 	}
@@ -405,12 +409,30 @@ func (r *restClient) RegistrationDelete(ctx context.Context, registrationId stri
 	return nil
 }
 
-func (r *restClient) HandlerCreate(handlerFx client.HandlerFx) (string, error) {
+func (r *restClient) MetricsRead(ctx context.Context) (*data.Metrics, error) {
+	uri := fmt.Sprintf("http://%s:%s"+data.RouteChangesMetrics, r.config.Rest.Address, r.config.Rest.Port)
+	bytes, err := r.doRequest(ctx, uri, data.MethodMetricsRead, nil)
+	if err != nil {
+		return nil, err
+	}
+	metrics := &data.Metrics{}
+	if err = json.Unmarshal(bytes, metrics); err != nil {
+		return nil, err
+	}
+	return metrics, nil
+}
+
+// HandlerCreate can be used to create a handler (a websocket) for changes that
+// match the filter, the websocket is re-connected if the handler is disconnected
+// because of an overflow
+func (r *restClient) HandlerCreate(handlerFx client.HandlerFx, options ...client.HandlerOption) (string, error) {
 	r.Lock()
 	defer r.Unlock()
 
 	handlerId := uuid.Must(uuid.NewRandom()).String()
-	r.handlers[handlerId] = newHandler(r.ctx, r, handlerId, r.config, handlerFx)
+	handlerOptions := client.NewHandlerOptions(options...)
+	r.handlers[handlerId] = newHandler(r.ctx, r, handlerId, r.config, handlerOptions.Filter,
+		handlerOptions.HandlerOptions, handlerFx, handlerOptions.OverflowFx)
 	return handlerId, nil
 }
 
@@ -6,22 +6,114 @@ import (
 	"github.com/antonio-alexander/go-bludgeon/changes/data"
 )
 
-type Client interface {
+type ChangeUpserter interface {
 	ChangeUpsert(ctx context.Context, changePartial data.ChangePartial) (*data.Change, error)
+}
+
+type Client interface {
+	ChangeUpserter
 	ChangeRead(ctx context.Context, changeId string) (*data.Change, error)
 	ChangesRead(ctx context.Context, search data.ChangeSearch) ([]*data.Change, error)
 	ChangeDelete(ctx context.Context, changeId string) error
 
-	RegistrationUpsert(ctx context.Context, registrationId string) error
+	//RegistrationUpsert can be used to create (or update) a registration, the
+	// filter is optional (see RegistrationFilter)
+	RegistrationUpsert(ctx context.Context, registrationId string, options ...RegistrationOption) error
 	RegistrationChangesRead(ctx context.Context, registrationId string) ([]*data.Change, error)
 	RegistrationChangeAcknowledge(ctx context.Context, registrationId string, changeIds ...string) error
 	RegistrationDelete(ctx context.Context, registrationId string) error
 }
 
 type Handler interface {
-	HandlerCreate(handlerFx HandlerFx) (handlerId string, err error)
+	//HandlerCreate can be used to create a handler for changes, the options are
+	// optional (see HandlerFilter, HandlerRegistrationId, HandlerOverflow and
+	// HandlerOverflowFx)
+	HandlerCreate(handlerFx HandlerFx, options ...HandlerOption) (handlerId string, err error)
 	HandlerConnected(handlerId string) (bool, error)
 	HandlerDelete(handlerId string) (err error)
 }
 
+type Metrics interface {
+	MetricsRead(ctx context.Context) (*data.Metrics, error)
+}
+
 type HandlerFx func(...*data.Change) error
+
+type OverflowFx func(*data.HandlerOverflow) error
+
+// RegistrationOptions are the (optional) options of a registration
+type RegistrationOptions struct {
+	Filter data.RegistrationFilter
+}
+
+type RegistrationOption func(*RegistrationOptions)
+
+// RegistrationFilter can be used to only register for changes
+// that match the filter
+func RegistrationFilter(filter data.RegistrationFilter) RegistrationOption {
+	return func(o *RegistrationOptions) {
+		o.Filter = filter
+	}
+}
+
+// NewRegistrationOptions will return the registration options
+// with the options applied
+func NewRegistrationOptions(options ...RegistrationOption) RegistrationOptions {
+	var o RegistrationOptions
+
+	for _, option := range options {
+		option(&o)
+	}
+	return o
+}
+
+// HandlerOptions are the (optional) options of a handler
+type HandlerOptions struct {
+	data.HandlerOptions
+	Filter     data.RegistrationFilter
+	OverflowFx OverflowFx
+}
+
+type HandlerOption func(*HandlerOptions)
+
+// HandlerFilter can be used to only handle changes that match the filter
+func HandlerFilter(filter data.RegistrationFilter) HandlerOption {
+	return func(o *HandlerOptions) {
+		o.Filter = filter
+	}
+}
+
+// HandlerRegistrationId can be used to create the handler for a registration,
+// this is used to report if a registration is connected
+func HandlerRegistrationId(registrationId string) HandlerOption {
+	return func(o *HandlerOptions) {
+		o.RegistrationId = registrationId
+	}
+}
+
+// HandlerOverflow can be used to set what's done when the handler's queue is
+// full and how long (in milliseconds) to wait for room when using block
+func HandlerOverflow(overflow data.OverflowStrategy, overflowTimeout int64) HandlerOption {
+	return func(o *HandlerOptions) {
+		o.Overflow, o.OverflowTimeout = overflow, overflowTimeout
+	}
+}
+
+// HandlerOverflowFx can be used to set the function that's called when changes
+// couldn't be delivered to the handler, if the handler was disconnected the client
+// should resync using RegistrationChangesRead
+func HandlerOverflowFx(overflowFx OverflowFx) HandlerOption {
+	return func(o *HandlerOptions) {
+		o.OverflowFx = overflowFx
+	}
+}
+
+// NewHandlerOptions will return the handler options with the options applied
+func NewHandlerOptions(options ...HandlerOption) HandlerOptions {
+	var o HandlerOptions
+
+	for _, option := range options {
+		option(&o)
+	}
+	return o
+}
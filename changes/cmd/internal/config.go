@@ -26,6 +26,7 @@ const (
 type Configuration struct {
 	MetaType     meta.Type `json:"type"`
 	RestEnabled  bool      `json:"rest_enabled"`
+	GrpcEnabled  bool      `json:"grpc_enabled"`
 	KafkaEnabled bool      `json:"kafka_enabled"`
 }
 
@@ -48,6 +49,7 @@ func (c *Configuration) Write(configFile string) error {
 func (c *Configuration) Default(pwd string) {
 	c.MetaType = DefaultMetaType
 	c.RestEnabled = DefaultRestEnabled
+	c.GrpcEnabled = DefaultGrpcEnabled
 	c.KafkaEnabled = DefaultKafkaEnabled
 }
 
@@ -60,6 +62,11 @@ func (c *Configuration) FromEnv(pwd string, envs map[string]string) {
 			c.RestEnabled = restEnabled
 		}
 	}
+	if s, ok := envs[EnvNameServiceGrpcEnabled]; ok {
+		if grpcEnabled, err := strconv.ParseBool(s); err == nil {
+			c.GrpcEnabled = grpcEnabled
+		}
+	}
 	if s, ok := envs[EnvNameServiceKafkaEnabled]; ok {
 		if kafkaEnabled, err := strconv.ParseBool(s); err == nil {
 			c.KafkaEnabled = kafkaEnabled
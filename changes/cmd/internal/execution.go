@@ -8,6 +8,7 @@ import (
 	metafile "github.com/antonio-alexander/go-bludgeon/changes/meta/file"
 	metamemory "github.com/antonio-alexander/go-bludgeon/changes/meta/memory"
 	metamysql "github.com/antonio-alexander/go-bludgeon/changes/meta/mysql"
+	servicegrpc "github.com/antonio-alexander/go-bludgeon/changes/service/grpc"
 	servicekafka "github.com/antonio-alexander/go-bludgeon/changes/service/kafka"
 	servicerest "github.com/antonio-alexander/go-bludgeon/changes/service/rest"
 
@@ -15,6 +16,7 @@ import (
 
 	internal "github.com/antonio-alexander/go-bludgeon/internal"
 	config "github.com/antonio-alexander/go-bludgeon/internal/config"
+	serverGrpc "github.com/antonio-alexander/go-bludgeon/internal/grpc/server"
 	kafka "github.com/antonio-alexander/go-bludgeon/internal/kafka"
 	internal_logger "github.com/antonio-alexander/go-bludgeon/internal/logger"
 	internal_meta "github.com/antonio-alexander/go-bludgeon/internal/meta"
@@ -42,6 +44,10 @@ func parameterize(config *Configuration) (interface {
 		meta.Change
 		meta.Registration
 		meta.RegistrationChange
+		meta.Webhook
+		meta.DeadLetter
+		meta.Replay
+		meta.Retention
 		internal.Initializer
 		internal.Configurer
 		internal.Parameterizer
@@ -74,6 +80,15 @@ func parameterize(config *Configuration) (interface {
 		parameters = append(parameters, changesMeta, restServer,
 			changesRestService, healthCheckRestService)
 	}
+	if config.GrpcEnabled {
+		changesGrpcService := servicegrpc.New()
+		changesGrpcService.SetUtilities(logger)
+		changesGrpcService.SetParameters(changesLogic)
+		grpcServer := serverGrpc.New()
+		grpcServer.SetUtilities(logger)
+		grpcServer.SetParameters(changesGrpcService)
+		parameters = append(parameters, grpcServer, changesGrpcService)
+	}
 	if config.KafkaEnabled {
 		kafkaClient := kafka.New()
 		kafkaClient.SetUtilities(logger)
@@ -34,6 +34,9 @@ type Change struct {
 	// An integer that's atomically incremented each time something is mutated
 	// example: 1
 	DataVersion int `json:"data_version"`
+
+	// A snapshot of the data and the fields that were changed (optional)
+	Payload *ChangePayload `json:"payload,omitempty"`
 }
 
 func (c *Change) Type() MessageType {
@@ -92,6 +95,9 @@ type ChangePartial struct {
 	// An integer that's atomically incremented each time something is mutated
 	// example: 1
 	DataVersion *int `json:"data_version,omitempty"`
+
+	// A snapshot of the data and the fields that were changed (optional)
+	Payload *ChangePayload `json:"payload,omitempty"`
 }
 
 func (c *ChangePartial) Type() MessageType {
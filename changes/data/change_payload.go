@@ -0,0 +1,136 @@
+package data
+
+import (
+	"bytes"
+	"encoding/json"
+	"reflect"
+	"sort"
+)
+
+type ChangePayload struct {
+	// A snapshot of the data after the change occured, if the data was
+	// deleted, this is a snapshot of the data before it was deleted
+	// example: {"id":"86fa2f09-d260-11ec-bd5d-0242c0a8e002","first_name":"Antonio"}
+	Snapshot json.RawMessage `json:"snapshot,omitempty"`
+
+	// The fields of the data that were changed
+	Diff []*FieldDiff `json:"diff,omitempty"`
+}
+
+func (c *ChangePayload) MarshalBinary() ([]byte, error) {
+	return json.Marshal(c)
+}
+
+func (c *ChangePayload) UnmarshalBinary(bytes []byte) error {
+	return json.Unmarshal(bytes, c)
+}
+
+// Fields can be used to get the names of the fields that were
+// changed
+func (c *ChangePayload) Fields() []string {
+	if c == nil {
+		return nil
+	}
+	fields := make([]string, 0, len(c.Diff))
+	for _, diff := range c.Diff {
+		fields = append(fields, diff.Field)
+	}
+	return fields
+}
+
+// HasField can be used to determine if any of the given fields
+// were changed
+func (c *ChangePayload) HasField(fields ...string) bool {
+	for _, field := range c.Fields() {
+		for _, f := range fields {
+			if field == f {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+type FieldDiff struct {
+	// The name of the field (as it's serialized) that was changed
+	// example: first_name
+	Field string `json:"field"`
+
+	// The value of the field before the change, omitted if the
+	// field didn't exist
+	// example: "Antonio"
+	Before json.RawMessage `json:"before,omitempty"`
+
+	// The value of the field after the change, omitted if the
+	// field no longer exists
+	// example: "Tony"
+	After json.RawMessage `json:"after,omitempty"`
+}
+
+// NewChangePayload can be used to generate a change payload from the
+// data before and after it was changed; before should be nil if the
+// data was created and after should be nil if the data was deleted.
+// The diff is generated by comparing the top-level fields of the
+// json representation of the data, a nil pointer (e.g. a nil *Employee)
+// is treated the same as nil
+func NewChangePayload(before, after interface{}) (*ChangePayload, error) {
+	var fieldsBefore, fieldsAfter map[string]json.RawMessage
+
+	//KIM: a nil pointer within an interface isn't nil, it must be converted
+	// into a nil interface otherwise it would be snapshotted as null
+	nilFx := func(item interface{}) interface{} {
+		if v := reflect.ValueOf(item); v.Kind() == reflect.Ptr && v.IsNil() {
+			return nil
+		}
+		return item
+	}
+	before, after = nilFx(before), nilFx(after)
+	fieldsFx := func(item interface{}) ([]byte, map[string]json.RawMessage, error) {
+		if item == nil {
+			return nil, nil, nil
+		}
+		bytes, err := json.Marshal(item)
+		if err != nil {
+			return nil, nil, err
+		}
+		fields := make(map[string]json.RawMessage)
+		if err := json.Unmarshal(bytes, &fields); err != nil {
+			return nil, nil, err
+		}
+		return bytes, fields, nil
+	}
+	snapshotBefore, fieldsBefore, err := fieldsFx(before)
+	if err != nil {
+		return nil, err
+	}
+	snapshotAfter, fieldsAfter, err := fieldsFx(after)
+	if err != nil {
+		return nil, err
+	}
+	changePayload := &ChangePayload{Snapshot: snapshotAfter}
+	if after == nil {
+		changePayload.Snapshot = snapshotBefore
+	}
+	fields := make([]string, 0, len(fieldsBefore)+len(fieldsAfter))
+	for field := range fieldsBefore {
+		fields = append(fields, field)
+	}
+	for field := range fieldsAfter {
+		if _, ok := fieldsBefore[field]; !ok {
+			fields = append(fields, field)
+		}
+	}
+	sort.Strings(fields)
+	for _, field := range fields {
+		valueBefore, valueAfter := fieldsBefore[field], fieldsAfter[field]
+		if bytes.Equal(valueBefore, valueAfter) {
+			continue
+		}
+		changePayload.Diff = append(changePayload.Diff, &FieldDiff{
+			Field:  field,
+			Before: valueBefore,
+			After:  valueAfter,
+		})
+	}
+	return changePayload, nil
+}
@@ -14,6 +14,7 @@ type ChangeSearch struct {
 	ServiceNames  []string `json:"service_names,omitempty"`
 	LatestVersion *bool    `json:"latest_version,omitempty"`
 	Since         *int64   `json:"since,string,omitempty"`
+	Fields        []string `json:"fields,omitempty"`
 }
 
 func (c *ChangeSearch) ToParams() string {
@@ -40,6 +41,10 @@ func (c *ChangeSearch) ToParams() string {
 		parameters = append(parameters,
 			fmt.Sprintf(parameterf, ParameterActions, strings.Join(c.Actions, ",")))
 	}
+	if len(c.Fields) > 0 {
+		parameters = append(parameters,
+			fmt.Sprintf(parameterf, ParameterFields, strings.Join(c.Fields, ",")))
+	}
 	if c.LatestVersion != nil {
 		parameters = append(parameters, fmt.Sprint(*c.LatestVersion))
 	}
@@ -82,6 +87,12 @@ func (c *ChangeSearch) FromParams(params map[string][]string) {
 					c.ServiceNames = append(c.ServiceNames, strings.Split(value, ",")...)
 				}
 			}
+		case ParameterFields:
+			for _, value := range value {
+				if value != "" {
+					c.Fields = append(c.Fields, strings.Split(value, ",")...)
+				}
+			}
 		case ParameterLatestVersion:
 			for _, value := range value {
 				latestVersion, err := strconv.ParseBool(value)
@@ -10,13 +10,17 @@ const (
 )
 
 const (
-	ParameterChangeIds     string = "change_ids"
-	ParameterDataIds       string = "data_ids"
-	ParameterTypes         string = "types"
-	ParameterActions       string = "actions"
-	ParameterServiceNames  string = "service_names"
-	ParameterLatestVersion string = "latest_version"
-	ParameterSince         string = "since"
+	ParameterChangeIds       string = "change_ids"
+	ParameterDataIds         string = "data_ids"
+	ParameterTypes           string = "types"
+	ParameterActions         string = "actions"
+	ParameterServiceNames    string = "service_names"
+	ParameterLatestVersion   string = "latest_version"
+	ParameterSince           string = "since"
+	ParameterFields          string = "fields"
+	ParameterRegistrationId  string = "registration_id"
+	ParameterOverflow        string = "overflow"
+	ParameterOverflowTimeout string = "overflow_timeout"
 )
 
 const (
@@ -27,12 +31,24 @@ const (
 	MethodRegistrationChangeAcknowledge = http.MethodPut
 	MethodRegistrationUpsert            = http.MethodPatch
 	MethodRegistrationDelete            = http.MethodDelete
+	MethodWebhookRead                   = http.MethodGet
+	MethodWebhookDelete                 = http.MethodDelete
+	MethodDeadLettersRead               = http.MethodGet
+	MethodReplayStart                   = http.MethodPost
+	MethodReplayRead                    = http.MethodGet
+	MethodReplayStop                    = http.MethodDelete
+	MethodReplayResume                  = http.MethodPut
+	MethodPrune                         = http.MethodPost
+	MethodPruneReportRead               = http.MethodGet
+	MethodMetricsRead                   = http.MethodGet
 )
 
 const (
 	RouteChanges                                  string = "/api/v1/changes"
 	RouteChangesWebsocket                         string = RouteChanges + "/ws"
 	RouteChangesSearch                            string = RouteChanges + "/search"
+	RouteChangesPrune                             string = RouteChanges + "/prune"
+	RouteChangesMetrics                           string = RouteChanges + "/metrics"
 	RouteChangesParam                             string = RouteChanges + "/{" + PathChangeId + "}"
 	RouteChangesParamf                            string = RouteChanges + "/%s"
 	RouteChangesRegistration                      string = RouteChanges + "/registration"
@@ -42,4 +58,12 @@ const (
 	RouteChangesRegistrationParamChangesf         string = RouteChangesRegistrationParamf + "/changes"
 	RouteChangesRegistrationServiceIdAcknowledge  string = RouteChangesRegistration + "/{" + PathRegistrationId + "}/acknowledge"
 	RouteChangesRegistrationServiceIdAcknowledgef string = RouteChangesRegistration + "/%s/acknowledge"
+	RouteChangesRegistrationParamWebhook          string = RouteChangesRegistrationParam + "/webhook"
+	RouteChangesRegistrationParamWebhookf         string = RouteChangesRegistrationParamf + "/webhook"
+	RouteChangesRegistrationParamDeadLetters      string = RouteChangesRegistrationParam + "/dead_letters"
+	RouteChangesRegistrationParamDeadLettersf     string = RouteChangesRegistrationParamf + "/dead_letters"
+	RouteChangesRegistrationParamReplay           string = RouteChangesRegistrationParam + "/replay"
+	RouteChangesRegistrationParamReplayf          string = RouteChangesRegistrationParamf + "/replay"
+	RouteChangesRegistrationParamReplayResume     string = RouteChangesRegistrationParamReplay + "/resume"
+	RouteChangesRegistrationParamReplayResumef    string = RouteChangesRegistrationParamReplayf + "/resume"
 )
@@ -0,0 +1,37 @@
+package data
+
+import "encoding/json"
+
+type DeadLetter struct {
+	// The ID of the registration the change was dead-lettered for
+	// example: timers
+	RegistrationId string `json:"registration_id"`
+
+	// The ID of the change that was dead-lettered (v4 UUID)
+	// example: 86fa2f09-d260-11ec-bd5d-0242c0a8e002
+	ChangeId string `json:"change_id"`
+
+	// The number of times the change was delivered without
+	// being acknowledged
+	// example: 10
+	Attempts int `json:"attempts"`
+
+	// The time the change was dead-lettered
+	// example: 1652417242000
+	DeadLettered int64 `json:"dead_lettered,string"`
+
+	// The change that was dead-lettered
+	Change *Change `json:"change,omitempty"`
+}
+
+type DeadLetterDigest struct {
+	DeadLetters []*DeadLetter `json:"dead_letters"`
+}
+
+func (d *DeadLetterDigest) MarshalBinary() ([]byte, error) {
+	return json.Marshal(d)
+}
+
+func (d *DeadLetterDigest) UnmarshalBinary(bytes []byte) error {
+	return json.Unmarshal(bytes, d)
+}
@@ -0,0 +1,131 @@
+package data
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+type OverflowStrategy string
+
+const (
+	// OverflowDropNewest will discard the change that couldn't be queued
+	OverflowDropNewest OverflowStrategy = "drop_newest"
+
+	// OverflowDropOldest will discard the oldest queued change to make
+	// room for the change being queued
+	OverflowDropOldest OverflowStrategy = "drop_oldest"
+
+	// OverflowBlock will wait (up to the overflow timeout) for room in the
+	// queue, if there's still no room, the change is discarded
+	OverflowBlock OverflowStrategy = "block"
+
+	// OverflowDisconnect will delete the handler, the client is expected
+	// to resync using RegistrationChangesRead
+	OverflowDisconnect OverflowStrategy = "disconnect"
+)
+
+// Valid can be used to determine if the overflow strategy is supported, an
+// empty strategy is valid (the default is used)
+func (o OverflowStrategy) Valid() bool {
+	switch o {
+	case "", OverflowDropNewest, OverflowDropOldest,
+		OverflowBlock, OverflowDisconnect:
+		return true
+	}
+	return false
+}
+
+type HandlerOptions struct {
+	// The ID of the registration the handler is created for (optional),
+	// this is used to report if a registration is connected
+	// example: timers
+	RegistrationId string `json:"registration_id,omitempty"`
+
+	// What to do when the handler's queue is full, if empty, the
+	// default strategy is used
+	// example: drop_oldest
+	Overflow OverflowStrategy `json:"overflow,omitempty"`
+
+	// How long (in milliseconds) to wait for room in the handler's queue
+	// when using the block strategy, if zero, the default timeout is used
+	// example: 1000
+	OverflowTimeout int64 `json:"overflow_timeout,string,omitempty"`
+}
+
+func (h *HandlerOptions) ToParams() string {
+	const parameterf string = "%s=%s"
+	var parameters []string
+
+	if h.RegistrationId != "" {
+		parameters = append(parameters,
+			fmt.Sprintf(parameterf, ParameterRegistrationId, h.RegistrationId))
+	}
+	if h.Overflow != "" {
+		parameters = append(parameters,
+			fmt.Sprintf(parameterf, ParameterOverflow, h.Overflow))
+	}
+	if h.OverflowTimeout > 0 {
+		parameters = append(parameters,
+			fmt.Sprintf(parameterf, ParameterOverflowTimeout, strconv.FormatInt(h.OverflowTimeout, 10)))
+	}
+	return "?" + strings.Join(parameters, "&")
+}
+
+func (h *HandlerOptions) FromParams(params map[string][]string) {
+	for key, value := range params {
+		if len(value) == 0 {
+			continue
+		}
+		switch strings.ToLower(key) {
+		case ParameterRegistrationId:
+			h.RegistrationId = value[0]
+		case ParameterOverflow:
+			h.Overflow = OverflowStrategy(value[0])
+		case ParameterOverflowTimeout:
+			if overflowTimeout, err := strconv.ParseInt(value[0], 10, 64); err == nil {
+				h.OverflowTimeout = overflowTimeout
+			}
+		}
+	}
+}
+
+type HandlerOverflow struct {
+	// The ID of the handler whose queue overflowed
+	// example: 86fa2f09-d260-11ec-bd5d-0242c0a8e002
+	HandlerId string `json:"handler_id"`
+
+	// The ID of the registration the handler was created for (if any)
+	// example: timers
+	RegistrationId string `json:"registration_id,omitempty"`
+
+	// The strategy used to handle the overflow
+	// example: drop_oldest
+	Strategy OverflowStrategy `json:"strategy"`
+
+	// The ids of the changes that were discarded (not delivered)
+	// example: ["86fa2f09-d260-11ec-bd5d-0242c0a8e002"]
+	ChangeIds []string `json:"change_ids,omitempty"`
+
+	// The total number of overflows for the handler
+	// example: 1
+	Overflows int64 `json:"overflows"`
+
+	// Whether or not the handler was disconnected, if true, no more changes
+	// will be delivered and the client should resync
+	// example: false
+	Disconnected bool `json:"disconnected"`
+}
+
+func (h *HandlerOverflow) Type() MessageType {
+	return MessageTypeHandlerOverflow
+}
+
+func (h *HandlerOverflow) MarshalBinary() ([]byte, error) {
+	return json.Marshal(h)
+}
+
+func (h *HandlerOverflow) UnmarshalBinary(bytes []byte) error {
+	return json.Unmarshal(bytes, h)
+}
@@ -0,0 +1,79 @@
+package data
+
+import "encoding/json"
+
+type RegistrationMetrics struct {
+	// The ID of the registration
+	// example: timers
+	RegistrationId string `json:"registration_id"`
+
+	// The number of changes pending (unacknowledged) for the registration
+	// example: 10
+	Pending int `json:"pending"`
+
+	// The number of changes dead-lettered for the registration
+	// example: 0
+	DeadLettered int `json:"dead_lettered"`
+
+	// The age (in milliseconds) of the oldest change pending for the
+	// registration, zero if no changes are pending
+	// example: 60000
+	OldestPendingAge int64 `json:"oldest_pending_age,string"`
+
+	// The time of the last acknowledgement, zero if the registration
+	// has never acknowledged a change
+	// example: 1652417242000
+	LastAcknowledged int64 `json:"last_acknowledged,string"`
+
+	// The time the registration was last seen (upserted, read or acknowledged)
+	// example: 1652417242000
+	LastSeen int64 `json:"last_seen,string"`
+
+	// Whether or not a handler (websocket, gRPC or kafka) is connected
+	// for the registration
+	// example: true
+	Connected bool `json:"connected"`
+
+	// The ids of the handlers connected for the registration
+	// example: ["86fa2f09-d260-11ec-bd5d-0242c0a8e002"]
+	HandlerIds []string `json:"handler_ids,omitempty"`
+}
+
+type HandlerMetrics struct {
+	// The ID of the handler
+	// example: 86fa2f09-d260-11ec-bd5d-0242c0a8e002
+	HandlerId string `json:"handler_id"`
+
+	// The ID of the registration the handler was created for (if any)
+	// example: timers
+	RegistrationId string `json:"registration_id,omitempty"`
+
+	// The number of changes in the handler's queue
+	// example: 0
+	QueueDepth int `json:"queue_depth"`
+
+	// The maximum number of changes the handler's queue can hold
+	// example: 1024
+	QueueSize int `json:"queue_size"`
+
+	// The number of changes that couldn't be queued for the handler
+	// because its queue was full
+	// example: 0
+	Overflows int64 `json:"overflows"`
+}
+
+type Metrics struct {
+	// The delivery metrics of each registration
+	Registrations []*RegistrationMetrics `json:"registrations"`
+
+	// The metrics of each handler
+	Handlers []*HandlerMetrics `json:"handlers"`
+}
+
+func (m *Metrics) MarshalBinary() ([]byte, error) {
+	return json.Marshal(m)
+}
+
+func (m *Metrics) UnmarshalBinary(bytes []byte) error {
+	return json.Unmarshal(bytes, m)
+}
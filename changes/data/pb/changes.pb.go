@@ -0,0 +1,2902 @@
+//
+//go_bludgeon_changes defines a set of types for use with the changes service
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.28.0
+// 	protoc        v3.20.1
+// source: changes.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// ChangeUpsertRequest
+type ChangeUpsertRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// change_partial
+	ChangePartial *ChangePartial `protobuf:"bytes,1,opt,name=change_partial,json=changePartial,proto3" json:"change_partial,omitempty"`
+}
+
+func (x *ChangeUpsertRequest) Reset() {
+	*x = ChangeUpsertRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_changes_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ChangeUpsertRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChangeUpsertRequest) ProtoMessage() {}
+
+func (x *ChangeUpsertRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_changes_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChangeUpsertRequest.ProtoReflect.Descriptor instead.
+func (*ChangeUpsertRequest) Descriptor() ([]byte, []int) {
+	return file_changes_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ChangeUpsertRequest) GetChangePartial() *ChangePartial {
+	if x != nil {
+		return x.ChangePartial
+	}
+	return nil
+}
+
+// ChangeUpsertResponse
+type ChangeUpsertResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// change
+	Change *Change `protobuf:"bytes,1,opt,name=change,proto3" json:"change,omitempty"`
+}
+
+func (x *ChangeUpsertResponse) Reset() {
+	*x = ChangeUpsertResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_changes_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ChangeUpsertResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChangeUpsertResponse) ProtoMessage() {}
+
+func (x *ChangeUpsertResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_changes_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChangeUpsertResponse.ProtoReflect.Descriptor instead.
+func (*ChangeUpsertResponse) Descriptor() ([]byte, []int) {
+	return file_changes_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ChangeUpsertResponse) GetChange() *Change {
+	if x != nil {
+		return x.Change
+	}
+	return nil
+}
+
+// ChangeReadRequest
+type ChangeReadRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// id
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *ChangeReadRequest) Reset() {
+	*x = ChangeReadRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_changes_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ChangeReadRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChangeReadRequest) ProtoMessage() {}
+
+func (x *ChangeReadRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_changes_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChangeReadRequest.ProtoReflect.Descriptor instead.
+func (*ChangeReadRequest) Descriptor() ([]byte, []int) {
+	return file_changes_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ChangeReadRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// ChangeReadResponse
+type ChangeReadResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// change
+	Change *Change `protobuf:"bytes,1,opt,name=change,proto3" json:"change,omitempty"`
+}
+
+func (x *ChangeReadResponse) Reset() {
+	*x = ChangeReadResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_changes_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ChangeReadResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChangeReadResponse) ProtoMessage() {}
+
+func (x *ChangeReadResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_changes_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChangeReadResponse.ProtoReflect.Descriptor instead.
+func (*ChangeReadResponse) Descriptor() ([]byte, []int) {
+	return file_changes_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ChangeReadResponse) GetChange() *Change {
+	if x != nil {
+		return x.Change
+	}
+	return nil
+}
+
+// ChangesReadRequest
+type ChangesReadRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// change_search
+	ChangeSearch *ChangeSearch `protobuf:"bytes,1,opt,name=change_search,json=changeSearch,proto3" json:"change_search,omitempty"`
+}
+
+func (x *ChangesReadRequest) Reset() {
+	*x = ChangesReadRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_changes_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ChangesReadRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChangesReadRequest) ProtoMessage() {}
+
+func (x *ChangesReadRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_changes_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChangesReadRequest.ProtoReflect.Descriptor instead.
+func (*ChangesReadRequest) Descriptor() ([]byte, []int) {
+	return file_changes_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ChangesReadRequest) GetChangeSearch() *ChangeSearch {
+	if x != nil {
+		return x.ChangeSearch
+	}
+	return nil
+}
+
+// ChangesReadResponse
+type ChangesReadResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// changes
+	Changes []*Change `protobuf:"bytes,1,rep,name=changes,proto3" json:"changes,omitempty"`
+}
+
+func (x *ChangesReadResponse) Reset() {
+	*x = ChangesReadResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_changes_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ChangesReadResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChangesReadResponse) ProtoMessage() {}
+
+func (x *ChangesReadResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_changes_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChangesReadResponse.ProtoReflect.Descriptor instead.
+func (*ChangesReadResponse) Descriptor() ([]byte, []int) {
+	return file_changes_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ChangesReadResponse) GetChanges() []*Change {
+	if x != nil {
+		return x.Changes
+	}
+	return nil
+}
+
+// ChangeDeleteRequest
+type ChangeDeleteRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// id
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *ChangeDeleteRequest) Reset() {
+	*x = ChangeDeleteRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_changes_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ChangeDeleteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChangeDeleteRequest) ProtoMessage() {}
+
+func (x *ChangeDeleteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_changes_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChangeDeleteRequest.ProtoReflect.Descriptor instead.
+func (*ChangeDeleteRequest) Descriptor() ([]byte, []int) {
+	return file_changes_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ChangeDeleteRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// ChangeDeleteResponse
+type ChangeDeleteResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ChangeDeleteResponse) Reset() {
+	*x = ChangeDeleteResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_changes_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ChangeDeleteResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChangeDeleteResponse) ProtoMessage() {}
+
+func (x *ChangeDeleteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_changes_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChangeDeleteResponse.ProtoReflect.Descriptor instead.
+func (*ChangeDeleteResponse) Descriptor() ([]byte, []int) {
+	return file_changes_proto_rawDescGZIP(), []int{7}
+}
+
+// RegistrationUpsertRequest
+type RegistrationUpsertRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// registration_id
+	RegistrationId string `protobuf:"bytes,1,opt,name=registration_id,json=registrationId,proto3" json:"registration_id,omitempty"`
+	// filter
+	Filter *RegistrationFilter `protobuf:"bytes,2,opt,name=filter,proto3" json:"filter,omitempty"`
+}
+
+func (x *RegistrationUpsertRequest) Reset() {
+	*x = RegistrationUpsertRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_changes_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RegistrationUpsertRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegistrationUpsertRequest) ProtoMessage() {}
+
+func (x *RegistrationUpsertRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_changes_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegistrationUpsertRequest.ProtoReflect.Descriptor instead.
+func (*RegistrationUpsertRequest) Descriptor() ([]byte, []int) {
+	return file_changes_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *RegistrationUpsertRequest) GetRegistrationId() string {
+	if x != nil {
+		return x.RegistrationId
+	}
+	return ""
+}
+
+func (x *RegistrationUpsertRequest) GetFilter() *RegistrationFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+// RegistrationUpsertResponse
+type RegistrationUpsertResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *RegistrationUpsertResponse) Reset() {
+	*x = RegistrationUpsertResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_changes_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RegistrationUpsertResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegistrationUpsertResponse) ProtoMessage() {}
+
+func (x *RegistrationUpsertResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_changes_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegistrationUpsertResponse.ProtoReflect.Descriptor instead.
+func (*RegistrationUpsertResponse) Descriptor() ([]byte, []int) {
+	return file_changes_proto_rawDescGZIP(), []int{9}
+}
+
+// RegistrationChangesReadRequest
+type RegistrationChangesReadRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// registration_id
+	RegistrationId string `protobuf:"bytes,1,opt,name=registration_id,json=registrationId,proto3" json:"registration_id,omitempty"`
+}
+
+func (x *RegistrationChangesReadRequest) Reset() {
+	*x = RegistrationChangesReadRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_changes_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RegistrationChangesReadRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegistrationChangesReadRequest) ProtoMessage() {}
+
+func (x *RegistrationChangesReadRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_changes_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegistrationChangesReadRequest.ProtoReflect.Descriptor instead.
+func (*RegistrationChangesReadRequest) Descriptor() ([]byte, []int) {
+	return file_changes_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *RegistrationChangesReadRequest) GetRegistrationId() string {
+	if x != nil {
+		return x.RegistrationId
+	}
+	return ""
+}
+
+// RegistrationChangesReadResponse
+type RegistrationChangesReadResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// changes
+	Changes []*Change `protobuf:"bytes,1,rep,name=changes,proto3" json:"changes,omitempty"`
+}
+
+func (x *RegistrationChangesReadResponse) Reset() {
+	*x = RegistrationChangesReadResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_changes_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RegistrationChangesReadResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegistrationChangesReadResponse) ProtoMessage() {}
+
+func (x *RegistrationChangesReadResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_changes_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegistrationChangesReadResponse.ProtoReflect.Descriptor instead.
+func (*RegistrationChangesReadResponse) Descriptor() ([]byte, []int) {
+	return file_changes_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *RegistrationChangesReadResponse) GetChanges() []*Change {
+	if x != nil {
+		return x.Changes
+	}
+	return nil
+}
+
+// RegistrationChangeAcknowledgeRequest
+type RegistrationChangeAcknowledgeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// registration_id
+	RegistrationId string `protobuf:"bytes,1,opt,name=registration_id,json=registrationId,proto3" json:"registration_id,omitempty"`
+	// change_ids
+	ChangeIds []string `protobuf:"bytes,2,rep,name=change_ids,json=changeIds,proto3" json:"change_ids,omitempty"`
+}
+
+func (x *RegistrationChangeAcknowledgeRequest) Reset() {
+	*x = RegistrationChangeAcknowledgeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_changes_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RegistrationChangeAcknowledgeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegistrationChangeAcknowledgeRequest) ProtoMessage() {}
+
+func (x *RegistrationChangeAcknowledgeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_changes_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegistrationChangeAcknowledgeRequest.ProtoReflect.Descriptor instead.
+func (*RegistrationChangeAcknowledgeRequest) Descriptor() ([]byte, []int) {
+	return file_changes_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *RegistrationChangeAcknowledgeRequest) GetRegistrationId() string {
+	if x != nil {
+		return x.RegistrationId
+	}
+	return ""
+}
+
+func (x *RegistrationChangeAcknowledgeRequest) GetChangeIds() []string {
+	if x != nil {
+		return x.ChangeIds
+	}
+	return nil
+}
+
+// RegistrationChangeAcknowledgeResponse
+type RegistrationChangeAcknowledgeResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *RegistrationChangeAcknowledgeResponse) Reset() {
+	*x = RegistrationChangeAcknowledgeResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_changes_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RegistrationChangeAcknowledgeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegistrationChangeAcknowledgeResponse) ProtoMessage() {}
+
+func (x *RegistrationChangeAcknowledgeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_changes_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegistrationChangeAcknowledgeResponse.ProtoReflect.Descriptor instead.
+func (*RegistrationChangeAcknowledgeResponse) Descriptor() ([]byte, []int) {
+	return file_changes_proto_rawDescGZIP(), []int{13}
+}
+
+// RegistrationDeleteRequest
+type RegistrationDeleteRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// registration_id
+	RegistrationId string `protobuf:"bytes,1,opt,name=registration_id,json=registrationId,proto3" json:"registration_id,omitempty"`
+}
+
+func (x *RegistrationDeleteRequest) Reset() {
+	*x = RegistrationDeleteRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_changes_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RegistrationDeleteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegistrationDeleteRequest) ProtoMessage() {}
+
+func (x *RegistrationDeleteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_changes_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegistrationDeleteRequest.ProtoReflect.Descriptor instead.
+func (*RegistrationDeleteRequest) Descriptor() ([]byte, []int) {
+	return file_changes_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *RegistrationDeleteRequest) GetRegistrationId() string {
+	if x != nil {
+		return x.RegistrationId
+	}
+	return ""
+}
+
+// RegistrationDeleteResponse
+type RegistrationDeleteResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *RegistrationDeleteResponse) Reset() {
+	*x = RegistrationDeleteResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_changes_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RegistrationDeleteResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegistrationDeleteResponse) ProtoMessage() {}
+
+func (x *RegistrationDeleteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_changes_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegistrationDeleteResponse.ProtoReflect.Descriptor instead.
+func (*RegistrationDeleteResponse) Descriptor() ([]byte, []int) {
+	return file_changes_proto_rawDescGZIP(), []int{15}
+}
+
+// SubscribeRequest
+type SubscribeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// filter
+	Filter *RegistrationFilter `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"`
+	// registration_id
+	RegistrationId string `protobuf:"bytes,2,opt,name=registration_id,json=registrationId,proto3" json:"registration_id,omitempty"`
+	// overflow
+	Overflow string `protobuf:"bytes,3,opt,name=overflow,proto3" json:"overflow,omitempty"`
+	// overflow_timeout
+	OverflowTimeout int64 `protobuf:"varint,4,opt,name=overflow_timeout,json=overflowTimeout,proto3" json:"overflow_timeout,omitempty"`
+}
+
+func (x *SubscribeRequest) Reset() {
+	*x = SubscribeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_changes_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SubscribeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubscribeRequest) ProtoMessage() {}
+
+func (x *SubscribeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_changes_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubscribeRequest.ProtoReflect.Descriptor instead.
+func (*SubscribeRequest) Descriptor() ([]byte, []int) {
+	return file_changes_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *SubscribeRequest) GetFilter() *RegistrationFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+func (x *SubscribeRequest) GetRegistrationId() string {
+	if x != nil {
+		return x.RegistrationId
+	}
+	return ""
+}
+
+func (x *SubscribeRequest) GetOverflow() string {
+	if x != nil {
+		return x.Overflow
+	}
+	return ""
+}
+
+func (x *SubscribeRequest) GetOverflowTimeout() int64 {
+	if x != nil {
+		return x.OverflowTimeout
+	}
+	return 0
+}
+
+// SubscribeResponse
+type SubscribeResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// changes
+	Changes []*Change `protobuf:"bytes,1,rep,name=changes,proto3" json:"changes,omitempty"`
+	// overflow
+	Overflow *HandlerOverflow `protobuf:"bytes,2,opt,name=overflow,proto3" json:"overflow,omitempty"`
+}
+
+func (x *SubscribeResponse) Reset() {
+	*x = SubscribeResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_changes_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SubscribeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubscribeResponse) ProtoMessage() {}
+
+func (x *SubscribeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_changes_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubscribeResponse.ProtoReflect.Descriptor instead.
+func (*SubscribeResponse) Descriptor() ([]byte, []int) {
+	return file_changes_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *SubscribeResponse) GetChanges() []*Change {
+	if x != nil {
+		return x.Changes
+	}
+	return nil
+}
+
+func (x *SubscribeResponse) GetOverflow() *HandlerOverflow {
+	if x != nil {
+		return x.Overflow
+	}
+	return nil
+}
+
+// MetricsReadRequest
+type MetricsReadRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *MetricsReadRequest) Reset() {
+	*x = MetricsReadRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_changes_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MetricsReadRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MetricsReadRequest) ProtoMessage() {}
+
+func (x *MetricsReadRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_changes_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MetricsReadRequest.ProtoReflect.Descriptor instead.
+func (*MetricsReadRequest) Descriptor() ([]byte, []int) {
+	return file_changes_proto_rawDescGZIP(), []int{18}
+}
+
+// MetricsReadResponse
+type MetricsReadResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// metrics
+	Metrics *Metrics `protobuf:"bytes,1,opt,name=metrics,proto3" json:"metrics,omitempty"`
+}
+
+func (x *MetricsReadResponse) Reset() {
+	*x = MetricsReadResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_changes_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MetricsReadResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MetricsReadResponse) ProtoMessage() {}
+
+func (x *MetricsReadResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_changes_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MetricsReadResponse.ProtoReflect.Descriptor instead.
+func (*MetricsReadResponse) Descriptor() ([]byte, []int) {
+	return file_changes_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *MetricsReadResponse) GetMetrics() *Metrics {
+	if x != nil {
+		return x.Metrics
+	}
+	return nil
+}
+
+// ChangePartial
+type ChangePartial struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// when_changed_oneof
+	//
+	// Types that are assignable to WhenChangedOneof:
+	//
+	//	*ChangePartial_WhenChanged
+	WhenChangedOneof isChangePartial_WhenChangedOneof `protobuf_oneof:"when_changed_oneof"`
+	// changed_by_oneof
+	//
+	// Types that are assignable to ChangedByOneof:
+	//
+	//	*ChangePartial_ChangedBy
+	ChangedByOneof isChangePartial_ChangedByOneof `protobuf_oneof:"changed_by_oneof"`
+	// data_id_oneof
+	//
+	// Types that are assignable to DataIdOneof:
+	//
+	//	*ChangePartial_DataId
+	DataIdOneof isChangePartial_DataIdOneof `protobuf_oneof:"data_id_oneof"`
+	// data_service_name_oneof
+	//
+	// Types that are assignable to DataServiceNameOneof:
+	//
+	//	*ChangePartial_DataServiceName
+	DataServiceNameOneof isChangePartial_DataServiceNameOneof `protobuf_oneof:"data_service_name_oneof"`
+	// data_type_oneof
+	//
+	// Types that are assignable to DataTypeOneof:
+	//
+	//	*ChangePartial_DataType
+	DataTypeOneof isChangePartial_DataTypeOneof `protobuf_oneof:"data_type_oneof"`
+	// data_action_oneof
+	//
+	// Types that are assignable to DataActionOneof:
+	//
+	//	*ChangePartial_DataAction
+	DataActionOneof isChangePartial_DataActionOneof `protobuf_oneof:"data_action_oneof"`
+	// data_version_oneof
+	//
+	// Types that are assignable to DataVersionOneof:
+	//
+	//	*ChangePartial_DataVersion
+	DataVersionOneof isChangePartial_DataVersionOneof `protobuf_oneof:"data_version_oneof"`
+	// payload
+	Payload *ChangePayload `protobuf:"bytes,8,opt,name=payload,proto3" json:"payload,omitempty"`
+}
+
+func (x *ChangePartial) Reset() {
+	*x = ChangePartial{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_changes_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ChangePartial) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChangePartial) ProtoMessage() {}
+
+func (x *ChangePartial) ProtoReflect() protoreflect.Message {
+	mi := &file_changes_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChangePartial.ProtoReflect.Descriptor instead.
+func (*ChangePartial) Descriptor() ([]byte, []int) {
+	return file_changes_proto_rawDescGZIP(), []int{20}
+}
+
+func (m *ChangePartial) GetWhenChangedOneof() isChangePartial_WhenChangedOneof {
+	if m != nil {
+		return m.WhenChangedOneof
+	}
+	return nil
+}
+
+func (x *ChangePartial) GetWhenChanged() int64 {
+	if x, ok := x.GetWhenChangedOneof().(*ChangePartial_WhenChanged); ok {
+		return x.WhenChanged
+	}
+	return 0
+}
+
+func (m *ChangePartial) GetChangedByOneof() isChangePartial_ChangedByOneof {
+	if m != nil {
+		return m.ChangedByOneof
+	}
+	return nil
+}
+
+func (x *ChangePartial) GetChangedBy() string {
+	if x, ok := x.GetChangedByOneof().(*ChangePartial_ChangedBy); ok {
+		return x.ChangedBy
+	}
+	return ""
+}
+
+func (m *ChangePartial) GetDataIdOneof() isChangePartial_DataIdOneof {
+	if m != nil {
+		return m.DataIdOneof
+	}
+	return nil
+}
+
+func (x *ChangePartial) GetDataId() string {
+	if x, ok := x.GetDataIdOneof().(*ChangePartial_DataId); ok {
+		return x.DataId
+	}
+	return ""
+}
+
+func (m *ChangePartial) GetDataServiceNameOneof() isChangePartial_DataServiceNameOneof {
+	if m != nil {
+		return m.DataServiceNameOneof
+	}
+	return nil
+}
+
+func (x *ChangePartial) GetDataServiceName() string {
+	if x, ok := x.GetDataServiceNameOneof().(*ChangePartial_DataServiceName); ok {
+		return x.DataServiceName
+	}
+	return ""
+}
+
+func (m *ChangePartial) GetDataTypeOneof() isChangePartial_DataTypeOneof {
+	if m != nil {
+		return m.DataTypeOneof
+	}
+	return nil
+}
+
+func (x *ChangePartial) GetDataType() string {
+	if x, ok := x.GetDataTypeOneof().(*ChangePartial_DataType); ok {
+		return x.DataType
+	}
+	return ""
+}
+
+func (m *ChangePartial) GetDataActionOneof() isChangePartial_DataActionOneof {
+	if m != nil {
+		return m.DataActionOneof
+	}
+	return nil
+}
+
+func (x *ChangePartial) GetDataAction() string {
+	if x, ok := x.GetDataActionOneof().(*ChangePartial_DataAction); ok {
+		return x.DataAction
+	}
+	return ""
+}
+
+func (m *ChangePartial) GetDataVersionOneof() isChangePartial_DataVersionOneof {
+	if m != nil {
+		return m.DataVersionOneof
+	}
+	return nil
+}
+
+func (x *ChangePartial) GetDataVersion() int32 {
+	if x, ok := x.GetDataVersionOneof().(*ChangePartial_DataVersion); ok {
+		return x.DataVersion
+	}
+	return 0
+}
+
+func (x *ChangePartial) GetPayload() *ChangePayload {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+type isChangePartial_WhenChangedOneof interface {
+	isChangePartial_WhenChangedOneof()
+}
+
+type ChangePartial_WhenChanged struct {
+	// when_changed
+	WhenChanged int64 `protobuf:"varint,1,opt,name=when_changed,json=whenChanged,proto3,oneof"`
+}
+
+func (*ChangePartial_WhenChanged) isChangePartial_WhenChangedOneof() {}
+
+type isChangePartial_ChangedByOneof interface {
+	isChangePartial_ChangedByOneof()
+}
+
+type ChangePartial_ChangedBy struct {
+	// changed_by
+	ChangedBy string `protobuf:"bytes,2,opt,name=changed_by,json=changedBy,proto3,oneof"`
+}
+
+func (*ChangePartial_ChangedBy) isChangePartial_ChangedByOneof() {}
+
+type isChangePartial_DataIdOneof interface {
+	isChangePartial_DataIdOneof()
+}
+
+type ChangePartial_DataId struct {
+	// data_id
+	DataId string `protobuf:"bytes,3,opt,name=data_id,json=dataId,proto3,oneof"`
+}
+
+func (*ChangePartial_DataId) isChangePartial_DataIdOneof() {}
+
+type isChangePartial_DataServiceNameOneof interface {
+	isChangePartial_DataServiceNameOneof()
+}
+
+type ChangePartial_DataServiceName struct {
+	// data_service_name
+	DataServiceName string `protobuf:"bytes,4,opt,name=data_service_name,json=dataServiceName,proto3,oneof"`
+}
+
+func (*ChangePartial_DataServiceName) isChangePartial_DataServiceNameOneof() {}
+
+type isChangePartial_DataTypeOneof interface {
+	isChangePartial_DataTypeOneof()
+}
+
+type ChangePartial_DataType struct {
+	// data_type
+	DataType string `protobuf:"bytes,5,opt,name=data_type,json=dataType,proto3,oneof"`
+}
+
+func (*ChangePartial_DataType) isChangePartial_DataTypeOneof() {}
+
+type isChangePartial_DataActionOneof interface {
+	isChangePartial_DataActionOneof()
+}
+
+type ChangePartial_DataAction struct {
+	// data_action
+	DataAction string `protobuf:"bytes,6,opt,name=data_action,json=dataAction,proto3,oneof"`
+}
+
+func (*ChangePartial_DataAction) isChangePartial_DataActionOneof() {}
+
+type isChangePartial_DataVersionOneof interface {
+	isChangePartial_DataVersionOneof()
+}
+
+type ChangePartial_DataVersion struct {
+	// data_version
+	DataVersion int32 `protobuf:"varint,7,opt,name=data_version,json=dataVersion,proto3,oneof"`
+}
+
+func (*ChangePartial_DataVersion) isChangePartial_DataVersionOneof() {}
+
+// Change
+type Change struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// id
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// when_changed
+	WhenChanged int64 `protobuf:"varint,2,opt,name=when_changed,json=whenChanged,proto3" json:"when_changed,omitempty"`
+	// changed_by
+	ChangedBy string `protobuf:"bytes,3,opt,name=changed_by,json=changedBy,proto3" json:"changed_by,omitempty"`
+	// data_id
+	DataId string `protobuf:"bytes,4,opt,name=data_id,json=dataId,proto3" json:"data_id,omitempty"`
+	// data_service_name
+	DataServiceName string `protobuf:"bytes,5,opt,name=data_service_name,json=dataServiceName,proto3" json:"data_service_name,omitempty"`
+	// data_type
+	DataType string `protobuf:"bytes,6,opt,name=data_type,json=dataType,proto3" json:"data_type,omitempty"`
+	// data_action
+	DataAction string `protobuf:"bytes,7,opt,name=data_action,json=dataAction,proto3" json:"data_action,omitempty"`
+	// data_version
+	DataVersion int32 `protobuf:"varint,8,opt,name=data_version,json=dataVersion,proto3" json:"data_version,omitempty"`
+	// payload
+	Payload *ChangePayload `protobuf:"bytes,9,opt,name=payload,proto3" json:"payload,omitempty"`
+}
+
+func (x *Change) Reset() {
+	*x = Change{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_changes_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Change) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Change) ProtoMessage() {}
+
+func (x *Change) ProtoReflect() protoreflect.Message {
+	mi := &file_changes_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Change.ProtoReflect.Descriptor instead.
+func (*Change) Descriptor() ([]byte, []int) {
+	return file_changes_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *Change) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Change) GetWhenChanged() int64 {
+	if x != nil {
+		return x.WhenChanged
+	}
+	return 0
+}
+
+func (x *Change) GetChangedBy() string {
+	if x != nil {
+		return x.ChangedBy
+	}
+	return ""
+}
+
+func (x *Change) GetDataId() string {
+	if x != nil {
+		return x.DataId
+	}
+	return ""
+}
+
+func (x *Change) GetDataServiceName() string {
+	if x != nil {
+		return x.DataServiceName
+	}
+	return ""
+}
+
+func (x *Change) GetDataType() string {
+	if x != nil {
+		return x.DataType
+	}
+	return ""
+}
+
+func (x *Change) GetDataAction() string {
+	if x != nil {
+		return x.DataAction
+	}
+	return ""
+}
+
+func (x *Change) GetDataVersion() int32 {
+	if x != nil {
+		return x.DataVersion
+	}
+	return 0
+}
+
+func (x *Change) GetPayload() *ChangePayload {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+// ChangePayload
+type ChangePayload struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// snapshot (json)
+	Snapshot []byte `protobuf:"bytes,1,opt,name=snapshot,proto3" json:"snapshot,omitempty"`
+	// diff
+	Diff []*FieldDiff `protobuf:"bytes,2,rep,name=diff,proto3" json:"diff,omitempty"`
+}
+
+func (x *ChangePayload) Reset() {
+	*x = ChangePayload{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_changes_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ChangePayload) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChangePayload) ProtoMessage() {}
+
+func (x *ChangePayload) ProtoReflect() protoreflect.Message {
+	mi := &file_changes_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChangePayload.ProtoReflect.Descriptor instead.
+func (*ChangePayload) Descriptor() ([]byte, []int) {
+	return file_changes_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *ChangePayload) GetSnapshot() []byte {
+	if x != nil {
+		return x.Snapshot
+	}
+	return nil
+}
+
+func (x *ChangePayload) GetDiff() []*FieldDiff {
+	if x != nil {
+		return x.Diff
+	}
+	return nil
+}
+
+// FieldDiff
+type FieldDiff struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// field
+	Field string `protobuf:"bytes,1,opt,name=field,proto3" json:"field,omitempty"`
+	// before (json)
+	Before []byte `protobuf:"bytes,2,opt,name=before,proto3" json:"before,omitempty"`
+	// after (json)
+	After []byte `protobuf:"bytes,3,opt,name=after,proto3" json:"after,omitempty"`
+}
+
+func (x *FieldDiff) Reset() {
+	*x = FieldDiff{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_changes_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FieldDiff) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FieldDiff) ProtoMessage() {}
+
+func (x *FieldDiff) ProtoReflect() protoreflect.Message {
+	mi := &file_changes_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FieldDiff.ProtoReflect.Descriptor instead.
+func (*FieldDiff) Descriptor() ([]byte, []int) {
+	return file_changes_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *FieldDiff) GetField() string {
+	if x != nil {
+		return x.Field
+	}
+	return ""
+}
+
+func (x *FieldDiff) GetBefore() []byte {
+	if x != nil {
+		return x.Before
+	}
+	return nil
+}
+
+func (x *FieldDiff) GetAfter() []byte {
+	if x != nil {
+		return x.After
+	}
+	return nil
+}
+
+// ChangeSearch
+type ChangeSearch struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// change_ids
+	ChangeIds []string `protobuf:"bytes,1,rep,name=change_ids,json=changeIds,proto3" json:"change_ids,omitempty"`
+	// data_ids
+	DataIds []string `protobuf:"bytes,2,rep,name=data_ids,json=dataIds,proto3" json:"data_ids,omitempty"`
+	// types
+	Types []string `protobuf:"bytes,3,rep,name=types,proto3" json:"types,omitempty"`
+	// actions
+	Actions []string `protobuf:"bytes,4,rep,name=actions,proto3" json:"actions,omitempty"`
+	// service_names
+	ServiceNames []string `protobuf:"bytes,5,rep,name=service_names,json=serviceNames,proto3" json:"service_names,omitempty"`
+	// latest_version_oneof
+	//
+	// Types that are assignable to LatestVersionOneof:
+	//
+	//	*ChangeSearch_LatestVersion
+	LatestVersionOneof isChangeSearch_LatestVersionOneof `protobuf_oneof:"latest_version_oneof"`
+	// since_oneof
+	//
+	// Types that are assignable to SinceOneof:
+	//
+	//	*ChangeSearch_Since
+	SinceOneof isChangeSearch_SinceOneof `protobuf_oneof:"since_oneof"`
+	// fields
+	Fields []string `protobuf:"bytes,8,rep,name=fields,proto3" json:"fields,omitempty"`
+}
+
+func (x *ChangeSearch) Reset() {
+	*x = ChangeSearch{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_changes_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ChangeSearch) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChangeSearch) ProtoMessage() {}
+
+func (x *ChangeSearch) ProtoReflect() protoreflect.Message {
+	mi := &file_changes_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChangeSearch.ProtoReflect.Descriptor instead.
+func (*ChangeSearch) Descriptor() ([]byte, []int) {
+	return file_changes_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *ChangeSearch) GetChangeIds() []string {
+	if x != nil {
+		return x.ChangeIds
+	}
+	return nil
+}
+
+func (x *ChangeSearch) GetDataIds() []string {
+	if x != nil {
+		return x.DataIds
+	}
+	return nil
+}
+
+func (x *ChangeSearch) GetTypes() []string {
+	if x != nil {
+		return x.Types
+	}
+	return nil
+}
+
+func (x *ChangeSearch) GetActions() []string {
+	if x != nil {
+		return x.Actions
+	}
+	return nil
+}
+
+func (x *ChangeSearch) GetServiceNames() []string {
+	if x != nil {
+		return x.ServiceNames
+	}
+	return nil
+}
+
+func (m *ChangeSearch) GetLatestVersionOneof() isChangeSearch_LatestVersionOneof {
+	if m != nil {
+		return m.LatestVersionOneof
+	}
+	return nil
+}
+
+func (x *ChangeSearch) GetLatestVersion() bool {
+	if x, ok := x.GetLatestVersionOneof().(*ChangeSearch_LatestVersion); ok {
+		return x.LatestVersion
+	}
+	return false
+}
+
+func (m *ChangeSearch) GetSinceOneof() isChangeSearch_SinceOneof {
+	if m != nil {
+		return m.SinceOneof
+	}
+	return nil
+}
+
+func (x *ChangeSearch) GetSince() int64 {
+	if x, ok := x.GetSinceOneof().(*ChangeSearch_Since); ok {
+		return x.Since
+	}
+	return 0
+}
+
+func (x *ChangeSearch) GetFields() []string {
+	if x != nil {
+		return x.Fields
+	}
+	return nil
+}
+
+type isChangeSearch_LatestVersionOneof interface {
+	isChangeSearch_LatestVersionOneof()
+}
+
+type ChangeSearch_LatestVersion struct {
+	// latest_version
+	LatestVersion bool `protobuf:"varint,6,opt,name=latest_version,json=latestVersion,proto3,oneof"`
+}
+
+func (*ChangeSearch_LatestVersion) isChangeSearch_LatestVersionOneof() {}
+
+type isChangeSearch_SinceOneof interface {
+	isChangeSearch_SinceOneof()
+}
+
+type ChangeSearch_Since struct {
+	// since
+	Since int64 `protobuf:"varint,7,opt,name=since,proto3,oneof"`
+}
+
+func (*ChangeSearch_Since) isChangeSearch_SinceOneof() {}
+
+// RegistrationFilter
+type RegistrationFilter struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// service_names
+	ServiceNames []string `protobuf:"bytes,1,rep,name=service_names,json=serviceNames,proto3" json:"service_names,omitempty"`
+	// types
+	Types []string `protobuf:"bytes,2,rep,name=types,proto3" json:"types,omitempty"`
+	// actions
+	Actions []string `protobuf:"bytes,3,rep,name=actions,proto3" json:"actions,omitempty"`
+}
+
+func (x *RegistrationFilter) Reset() {
+	*x = RegistrationFilter{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_changes_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RegistrationFilter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegistrationFilter) ProtoMessage() {}
+
+func (x *RegistrationFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_changes_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegistrationFilter.ProtoReflect.Descriptor instead.
+func (*RegistrationFilter) Descriptor() ([]byte, []int) {
+	return file_changes_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *RegistrationFilter) GetServiceNames() []string {
+	if x != nil {
+		return x.ServiceNames
+	}
+	return nil
+}
+
+func (x *RegistrationFilter) GetTypes() []string {
+	if x != nil {
+		return x.Types
+	}
+	return nil
+}
+
+func (x *RegistrationFilter) GetActions() []string {
+	if x != nil {
+		return x.Actions
+	}
+	return nil
+}
+
+// Metrics
+type Metrics struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// registrations
+	Registrations []*RegistrationMetrics `protobuf:"bytes,1,rep,name=registrations,proto3" json:"registrations,omitempty"`
+	// handlers
+	Handlers []*HandlerMetrics `protobuf:"bytes,2,rep,name=handlers,proto3" json:"handlers,omitempty"`
+}
+
+func (x *Metrics) Reset() {
+	*x = Metrics{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_changes_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Metrics) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Metrics) ProtoMessage() {}
+
+func (x *Metrics) ProtoReflect() protoreflect.Message {
+	mi := &file_changes_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Metrics.ProtoReflect.Descriptor instead.
+func (*Metrics) Descriptor() ([]byte, []int) {
+	return file_changes_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *Metrics) GetRegistrations() []*RegistrationMetrics {
+	if x != nil {
+		return x.Registrations
+	}
+	return nil
+}
+
+func (x *Metrics) GetHandlers() []*HandlerMetrics {
+	if x != nil {
+		return x.Handlers
+	}
+	return nil
+}
+
+// RegistrationMetrics
+type RegistrationMetrics struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// registration_id
+	RegistrationId string `protobuf:"bytes,1,opt,name=registration_id,json=registrationId,proto3" json:"registration_id,omitempty"`
+	// pending
+	Pending int64 `protobuf:"varint,2,opt,name=pending,proto3" json:"pending,omitempty"`
+	// dead_lettered
+	DeadLettered int64 `protobuf:"varint,3,opt,name=dead_lettered,json=deadLettered,proto3" json:"dead_lettered,omitempty"`
+	// oldest_pending_age
+	OldestPendingAge int64 `protobuf:"varint,4,opt,name=oldest_pending_age,json=oldestPendingAge,proto3" json:"oldest_pending_age,omitempty"`
+	// last_acknowledged
+	LastAcknowledged int64 `protobuf:"varint,5,opt,name=last_acknowledged,json=lastAcknowledged,proto3" json:"last_acknowledged,omitempty"`
+	// last_seen
+	LastSeen int64 `protobuf:"varint,6,opt,name=last_seen,json=lastSeen,proto3" json:"last_seen,omitempty"`
+	// connected
+	Connected bool `protobuf:"varint,7,opt,name=connected,proto3" json:"connected,omitempty"`
+	// handler_ids
+	HandlerIds []string `protobuf:"bytes,8,rep,name=handler_ids,json=handlerIds,proto3" json:"handler_ids,omitempty"`
+}
+
+func (x *RegistrationMetrics) Reset() {
+	*x = RegistrationMetrics{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_changes_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RegistrationMetrics) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegistrationMetrics) ProtoMessage() {}
+
+func (x *RegistrationMetrics) ProtoReflect() protoreflect.Message {
+	mi := &file_changes_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegistrationMetrics.ProtoReflect.Descriptor instead.
+func (*RegistrationMetrics) Descriptor() ([]byte, []int) {
+	return file_changes_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *RegistrationMetrics) GetRegistrationId() string {
+	if x != nil {
+		return x.RegistrationId
+	}
+	return ""
+}
+
+func (x *RegistrationMetrics) GetPending() int64 {
+	if x != nil {
+		return x.Pending
+	}
+	return 0
+}
+
+func (x *RegistrationMetrics) GetDeadLettered() int64 {
+	if x != nil {
+		return x.DeadLettered
+	}
+	return 0
+}
+
+func (x *RegistrationMetrics) GetOldestPendingAge() int64 {
+	if x != nil {
+		return x.OldestPendingAge
+	}
+	return 0
+}
+
+func (x *RegistrationMetrics) GetLastAcknowledged() int64 {
+	if x != nil {
+		return x.LastAcknowledged
+	}
+	return 0
+}
+
+func (x *RegistrationMetrics) GetLastSeen() int64 {
+	if x != nil {
+		return x.LastSeen
+	}
+	return 0
+}
+
+func (x *RegistrationMetrics) GetConnected() bool {
+	if x != nil {
+		return x.Connected
+	}
+	return false
+}
+
+func (x *RegistrationMetrics) GetHandlerIds() []string {
+	if x != nil {
+		return x.HandlerIds
+	}
+	return nil
+}
+
+// HandlerMetrics
+type HandlerMetrics struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// handler_id
+	HandlerId string `protobuf:"bytes,1,opt,name=handler_id,json=handlerId,proto3" json:"handler_id,omitempty"`
+	// registration_id
+	RegistrationId string `protobuf:"bytes,2,opt,name=registration_id,json=registrationId,proto3" json:"registration_id,omitempty"`
+	// queue_depth
+	QueueDepth int64 `protobuf:"varint,3,opt,name=queue_depth,json=queueDepth,proto3" json:"queue_depth,omitempty"`
+	// queue_size
+	QueueSize int64 `protobuf:"varint,4,opt,name=queue_size,json=queueSize,proto3" json:"queue_size,omitempty"`
+	// overflows
+	Overflows int64 `protobuf:"varint,5,opt,name=overflows,proto3" json:"overflows,omitempty"`
+}
+
+func (x *HandlerMetrics) Reset() {
+	*x = HandlerMetrics{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_changes_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HandlerMetrics) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HandlerMetrics) ProtoMessage() {}
+
+func (x *HandlerMetrics) ProtoReflect() protoreflect.Message {
+	mi := &file_changes_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HandlerMetrics.ProtoReflect.Descriptor instead.
+func (*HandlerMetrics) Descriptor() ([]byte, []int) {
+	return file_changes_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *HandlerMetrics) GetHandlerId() string {
+	if x != nil {
+		return x.HandlerId
+	}
+	return ""
+}
+
+func (x *HandlerMetrics) GetRegistrationId() string {
+	if x != nil {
+		return x.RegistrationId
+	}
+	return ""
+}
+
+func (x *HandlerMetrics) GetQueueDepth() int64 {
+	if x != nil {
+		return x.QueueDepth
+	}
+	return 0
+}
+
+func (x *HandlerMetrics) GetQueueSize() int64 {
+	if x != nil {
+		return x.QueueSize
+	}
+	return 0
+}
+
+func (x *HandlerMetrics) GetOverflows() int64 {
+	if x != nil {
+		return x.Overflows
+	}
+	return 0
+}
+
+// HandlerOverflow
+type HandlerOverflow struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// handler_id
+	HandlerId string `protobuf:"bytes,1,opt,name=handler_id,json=handlerId,proto3" json:"handler_id,omitempty"`
+	// registration_id
+	RegistrationId string `protobuf:"bytes,2,opt,name=registration_id,json=registrationId,proto3" json:"registration_id,omitempty"`
+	// strategy
+	Strategy string `protobuf:"bytes,3,opt,name=strategy,proto3" json:"strategy,omitempty"`
+	// change_ids
+	ChangeIds []string `protobuf:"bytes,4,rep,name=change_ids,json=changeIds,proto3" json:"change_ids,omitempty"`
+	// overflows
+	Overflows int64 `protobuf:"varint,5,opt,name=overflows,proto3" json:"overflows,omitempty"`
+	// disconnected
+	Disconnected bool `protobuf:"varint,6,opt,name=disconnected,proto3" json:"disconnected,omitempty"`
+}
+
+func (x *HandlerOverflow) Reset() {
+	*x = HandlerOverflow{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_changes_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HandlerOverflow) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HandlerOverflow) ProtoMessage() {}
+
+func (x *HandlerOverflow) ProtoReflect() protoreflect.Message {
+	mi := &file_changes_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HandlerOverflow.ProtoReflect.Descriptor instead.
+func (*HandlerOverflow) Descriptor() ([]byte, []int) {
+	return file_changes_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *HandlerOverflow) GetHandlerId() string {
+	if x != nil {
+		return x.HandlerId
+	}
+	return ""
+}
+
+func (x *HandlerOverflow) GetRegistrationId() string {
+	if x != nil {
+		return x.RegistrationId
+	}
+	return ""
+}
+
+func (x *HandlerOverflow) GetStrategy() string {
+	if x != nil {
+		return x.Strategy
+	}
+	return ""
+}
+
+func (x *HandlerOverflow) GetChangeIds() []string {
+	if x != nil {
+		return x.ChangeIds
+	}
+	return nil
+}
+
+func (x *HandlerOverflow) GetOverflows() int64 {
+	if x != nil {
+		return x.Overflows
+	}
+	return 0
+}
+
+func (x *HandlerOverflow) GetDisconnected() bool {
+	if x != nil {
+		return x.Disconnected
+	}
+	return false
+}
+
+var File_changes_proto protoreflect.FileDescriptor
+
+var file_changes_proto_rawDesc = []byte{
+	0x0a, 0x0d, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12,
+	0x13, 0x67, 0x6f, 0x5f, 0x62, 0x6c, 0x75, 0x64, 0x67, 0x65, 0x6f, 0x6e, 0x5f, 0x63, 0x68, 0x61,
+	0x6e, 0x67, 0x65, 0x73, 0x22, 0x60, 0x0a, 0x13, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x55, 0x70,
+	0x73, 0x65, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x49, 0x0a, 0x0e, 0x63,
+	0x68, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x70, 0x61, 0x72, 0x74, 0x69, 0x61, 0x6c, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x67, 0x6f, 0x5f, 0x62, 0x6c, 0x75, 0x64, 0x67, 0x65, 0x6f,
+	0x6e, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65,
+	0x50, 0x61, 0x72, 0x74, 0x69, 0x61, 0x6c, 0x52, 0x0d, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x50,
+	0x61, 0x72, 0x74, 0x69, 0x61, 0x6c, 0x22, 0x4b, 0x0a, 0x14, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65,
+	0x55, 0x70, 0x73, 0x65, 0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x33,
+	0x0a, 0x06, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b,
+	0x2e, 0x67, 0x6f, 0x5f, 0x62, 0x6c, 0x75, 0x64, 0x67, 0x65, 0x6f, 0x6e, 0x5f, 0x63, 0x68, 0x61,
+	0x6e, 0x67, 0x65, 0x73, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x06, 0x63, 0x68, 0x61,
+	0x6e, 0x67, 0x65, 0x22, 0x23, 0x0a, 0x11, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x61,
+	0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x49, 0x0a, 0x12, 0x43, 0x68, 0x61, 0x6e,
+	0x67, 0x65, 0x52, 0x65, 0x61, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x33,
+	0x0a, 0x06, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b,
+	0x2e, 0x67, 0x6f, 0x5f, 0x62, 0x6c, 0x75, 0x64, 0x67, 0x65, 0x6f, 0x6e, 0x5f, 0x63, 0x68, 0x61,
+	0x6e, 0x67, 0x65, 0x73, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x06, 0x63, 0x68, 0x61,
+	0x6e, 0x67, 0x65, 0x22, 0x5c, 0x0a, 0x12, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x52, 0x65,
+	0x61, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x46, 0x0a, 0x0d, 0x63, 0x68, 0x61,
+	0x6e, 0x67, 0x65, 0x5f, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x21, 0x2e, 0x67, 0x6f, 0x5f, 0x62, 0x6c, 0x75, 0x64, 0x67, 0x65, 0x6f, 0x6e, 0x5f, 0x63,
+	0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x53, 0x65, 0x61,
+	0x72, 0x63, 0x68, 0x52, 0x0c, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x53, 0x65, 0x61, 0x72, 0x63,
+	0x68, 0x22, 0x4c, 0x0a, 0x13, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x52, 0x65, 0x61, 0x64,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x35, 0x0a, 0x07, 0x63, 0x68, 0x61, 0x6e,
+	0x67, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x6f, 0x5f, 0x62,
+	0x6c, 0x75, 0x64, 0x67, 0x65, 0x6f, 0x6e, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x2e,
+	0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x22,
+	0x25, 0x0a, 0x13, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x16, 0x0a, 0x14, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x85,
+	0x01, 0x0a, 0x19, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x55,
+	0x70, 0x73, 0x65, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x27, 0x0a, 0x0f,
+	0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x3f, 0x0a, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x67, 0x6f, 0x5f, 0x62, 0x6c, 0x75, 0x64, 0x67,
+	0x65, 0x6f, 0x6e, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x67, 0x69,
+	0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x06,
+	0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x22, 0x1c, 0x0a, 0x1a, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x55, 0x70, 0x73, 0x65, 0x72, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x49, 0x0a, 0x1e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x52, 0x65, 0x61, 0x64, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x27, 0x0a, 0x0f, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0e, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x22,
+	0x58, 0x0a, 0x1f, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43,
+	0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x52, 0x65, 0x61, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x35, 0x0a, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x6f, 0x5f, 0x62, 0x6c, 0x75, 0x64, 0x67, 0x65, 0x6f,
+	0x6e, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65,
+	0x52, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x22, 0x6e, 0x0a, 0x24, 0x52, 0x65, 0x67,
+	0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x41,
+	0x63, 0x6b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x27, 0x0a, 0x0f, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x72, 0x65, 0x67, 0x69,
+	0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x68,
+	0x61, 0x6e, 0x67, 0x65, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09,
+	0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x49, 0x64, 0x73, 0x22, 0x27, 0x0a, 0x25, 0x52, 0x65, 0x67,
+	0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x41,
+	0x63, 0x6b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x44, 0x0a, 0x19, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x27, 0x0a, 0x0f, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x22, 0x1c, 0x0a, 0x1a, 0x52, 0x65, 0x67, 0x69,
+	0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xc3, 0x01, 0x0a, 0x10, 0x53, 0x75, 0x62, 0x73, 0x63,
+	0x72, 0x69, 0x62, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3f, 0x0a, 0x06, 0x66,
+	0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x67, 0x6f,
+	0x5f, 0x62, 0x6c, 0x75, 0x64, 0x67, 0x65, 0x6f, 0x6e, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65,
+	0x73, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x69,
+	0x6c, 0x74, 0x65, 0x72, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x27, 0x0a, 0x0f,
+	0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x6f, 0x76, 0x65, 0x72, 0x66, 0x6c, 0x6f,
+	0x77, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6f, 0x76, 0x65, 0x72, 0x66, 0x6c, 0x6f,
+	0x77, 0x12, 0x29, 0x0a, 0x10, 0x6f, 0x76, 0x65, 0x72, 0x66, 0x6c, 0x6f, 0x77, 0x5f, 0x74, 0x69,
+	0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x6f, 0x76, 0x65,
+	0x72, 0x66, 0x6c, 0x6f, 0x77, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x22, 0x8c, 0x01, 0x0a,
+	0x11, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x35, 0x0a, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x6f, 0x5f, 0x62, 0x6c, 0x75, 0x64, 0x67, 0x65, 0x6f,
+	0x6e, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65,
+	0x52, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x12, 0x40, 0x0a, 0x08, 0x6f, 0x76, 0x65,
+	0x72, 0x66, 0x6c, 0x6f, 0x77, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x67, 0x6f,
+	0x5f, 0x62, 0x6c, 0x75, 0x64, 0x67, 0x65, 0x6f, 0x6e, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65,
+	0x73, 0x2e, 0x48, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x72, 0x4f, 0x76, 0x65, 0x72, 0x66, 0x6c, 0x6f,
+	0x77, 0x52, 0x08, 0x6f, 0x76, 0x65, 0x72, 0x66, 0x6c, 0x6f, 0x77, 0x22, 0x14, 0x0a, 0x12, 0x4d,
+	0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x52, 0x65, 0x61, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x22, 0x4d, 0x0a, 0x13, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x52, 0x65, 0x61, 0x64,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x36, 0x0a, 0x07, 0x6d, 0x65, 0x74, 0x72,
+	0x69, 0x63, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x5f, 0x62,
+	0x6c, 0x75, 0x64, 0x67, 0x65, 0x6f, 0x6e, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x2e,
+	0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x52, 0x07, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73,
+	0x22, 0xd7, 0x03, 0x0a, 0x0d, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x50, 0x61, 0x72, 0x74, 0x69,
+	0x61, 0x6c, 0x12, 0x23, 0x0a, 0x0c, 0x77, 0x68, 0x65, 0x6e, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67,
+	0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x48, 0x00, 0x52, 0x0b, 0x77, 0x68, 0x65, 0x6e,
+	0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x12, 0x1f, 0x0a, 0x0a, 0x63, 0x68, 0x61, 0x6e, 0x67,
+	0x65, 0x64, 0x5f, 0x62, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x09, 0x63,
+	0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x42, 0x79, 0x12, 0x19, 0x0a, 0x07, 0x64, 0x61, 0x74, 0x61,
+	0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x02, 0x52, 0x06, 0x64, 0x61, 0x74,
+	0x61, 0x49, 0x64, 0x12, 0x2c, 0x0a, 0x11, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x48, 0x03,
+	0x52, 0x0f, 0x64, 0x61, 0x74, 0x61, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4e, 0x61, 0x6d,
+	0x65, 0x12, 0x1d, 0x0a, 0x09, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x09, 0x48, 0x04, 0x52, 0x08, 0x64, 0x61, 0x74, 0x61, 0x54, 0x79, 0x70, 0x65,
+	0x12, 0x21, 0x0a, 0x0b, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x09, 0x48, 0x05, 0x52, 0x0a, 0x64, 0x61, 0x74, 0x61, 0x41, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x23, 0x0a, 0x0c, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x76, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x48, 0x06, 0x52, 0x0b, 0x64, 0x61, 0x74,
+	0x61, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x3c, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6c,
+	0x6f, 0x61, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x67, 0x6f, 0x5f, 0x62,
+	0x6c, 0x75, 0x64, 0x67, 0x65, 0x6f, 0x6e, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x2e,
+	0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x52, 0x07, 0x70,
+	0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x42, 0x14, 0x0a, 0x12, 0x77, 0x68, 0x65, 0x6e, 0x5f, 0x63,
+	0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x5f, 0x6f, 0x6e, 0x65, 0x6f, 0x66, 0x42, 0x12, 0x0a, 0x10,
+	0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x5f, 0x6f, 0x6e, 0x65, 0x6f, 0x66,
+	0x42, 0x0f, 0x0a, 0x0d, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x69, 0x64, 0x5f, 0x6f, 0x6e, 0x65, 0x6f,
+	0x66, 0x42, 0x19, 0x0a, 0x17, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x5f, 0x6f, 0x6e, 0x65, 0x6f, 0x66, 0x42, 0x11, 0x0a, 0x0f,
+	0x64, 0x61, 0x74, 0x61, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x5f, 0x6f, 0x6e, 0x65, 0x6f, 0x66, 0x42,
+	0x13, 0x0a, 0x11, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6f,
+	0x6e, 0x65, 0x6f, 0x66, 0x42, 0x14, 0x0a, 0x12, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x76, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x6f, 0x6e, 0x65, 0x6f, 0x66, 0x22, 0xbe, 0x02, 0x0a, 0x06, 0x43,
+	0x68, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x77, 0x68, 0x65, 0x6e, 0x5f, 0x63, 0x68,
+	0x61, 0x6e, 0x67, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x77, 0x68, 0x65,
+	0x6e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x68, 0x61, 0x6e,
+	0x67, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x68,
+	0x61, 0x6e, 0x67, 0x65, 0x64, 0x42, 0x79, 0x12, 0x17, 0x0a, 0x07, 0x64, 0x61, 0x74, 0x61, 0x5f,
+	0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x64, 0x61, 0x74, 0x61, 0x49, 0x64,
+	0x12, 0x2a, 0x0a, 0x11, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x64, 0x61, 0x74,
+	0x61, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x09,
+	0x64, 0x61, 0x74, 0x61, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x64, 0x61, 0x74, 0x61, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x64, 0x61, 0x74,
+	0x61, 0x5f, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
+	0x64, 0x61, 0x74, 0x61, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x21, 0x0a, 0x0c, 0x64, 0x61,
+	0x74, 0x61, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x0b, 0x64, 0x61, 0x74, 0x61, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x3c, 0x0a,
+	0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22,
+	0x2e, 0x67, 0x6f, 0x5f, 0x62, 0x6c, 0x75, 0x64, 0x67, 0x65, 0x6f, 0x6e, 0x5f, 0x63, 0x68, 0x61,
+	0x6e, 0x67, 0x65, 0x73, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x50, 0x61, 0x79, 0x6c, 0x6f,
+	0x61, 0x64, 0x52, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x22, 0x5f, 0x0a, 0x0d, 0x43,
+	0x68, 0x61, 0x6e, 0x67, 0x65, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x1a, 0x0a, 0x08,
+	0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08,
+	0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x12, 0x32, 0x0a, 0x04, 0x64, 0x69, 0x66, 0x66,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x67, 0x6f, 0x5f, 0x62, 0x6c, 0x75, 0x64,
+	0x67, 0x65, 0x6f, 0x6e, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x2e, 0x46, 0x69, 0x65,
+	0x6c, 0x64, 0x44, 0x69, 0x66, 0x66, 0x52, 0x04, 0x64, 0x69, 0x66, 0x66, 0x22, 0x4f, 0x0a, 0x09,
+	0x46, 0x69, 0x65, 0x6c, 0x64, 0x44, 0x69, 0x66, 0x66, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x69, 0x65,
+	0x6c, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x12,
+	0x16, 0x0a, 0x06, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x06, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x66, 0x74, 0x65, 0x72,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x61, 0x66, 0x74, 0x65, 0x72, 0x22, 0x9d, 0x02,
+	0x0a, 0x0c, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x12, 0x1d,
+	0x0a, 0x0a, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x09, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x49, 0x64, 0x73, 0x12, 0x19, 0x0a,
+	0x08, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x07, 0x64, 0x61, 0x74, 0x61, 0x49, 0x64, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x79, 0x70, 0x65,
+	0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x74, 0x79, 0x70, 0x65, 0x73, 0x12, 0x18,
+	0x0a, 0x07, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x07, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x0c, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x12, 0x27, 0x0a,
+	0x0e, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x08, 0x48, 0x00, 0x52, 0x0d, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x56,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x05, 0x73, 0x69, 0x6e, 0x63, 0x65, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x03, 0x48, 0x01, 0x52, 0x05, 0x73, 0x69, 0x6e, 0x63, 0x65, 0x12, 0x16,
+	0x0a, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06,
+	0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x42, 0x16, 0x0a, 0x14, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x74,
+	0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x6f, 0x6e, 0x65, 0x6f, 0x66, 0x42, 0x0d,
+	0x0a, 0x0b, 0x73, 0x69, 0x6e, 0x63, 0x65, 0x5f, 0x6f, 0x6e, 0x65, 0x6f, 0x66, 0x22, 0x69, 0x0a,
+	0x12, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x69, 0x6c,
+	0x74, 0x65, 0x72, 0x12, 0x23, 0x0a, 0x0d, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x6e,
+	0x61, 0x6d, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0c, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x79, 0x70, 0x65,
+	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x74, 0x79, 0x70, 0x65, 0x73, 0x12, 0x18,
+	0x0a, 0x07, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x07, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x9a, 0x01, 0x0a, 0x07, 0x4d, 0x65, 0x74,
+	0x72, 0x69, 0x63, 0x73, 0x12, 0x4e, 0x0a, 0x0d, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x67, 0x6f,
+	0x5f, 0x62, 0x6c, 0x75, 0x64, 0x67, 0x65, 0x6f, 0x6e, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65,
+	0x73, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x65,
+	0x74, 0x72, 0x69, 0x63, 0x73, 0x52, 0x0d, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x12, 0x3f, 0x0a, 0x08, 0x68, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x72, 0x73,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x67, 0x6f, 0x5f, 0x62, 0x6c, 0x75, 0x64,
+	0x67, 0x65, 0x6f, 0x6e, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x2e, 0x48, 0x61, 0x6e,
+	0x64, 0x6c, 0x65, 0x72, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x52, 0x08, 0x68, 0x61, 0x6e,
+	0x64, 0x6c, 0x65, 0x72, 0x73, 0x22, 0xb4, 0x02, 0x0a, 0x13, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x12, 0x27, 0x0a,
+	0x0f, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e,
+	0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67,
+	0x12, 0x23, 0x0a, 0x0d, 0x64, 0x65, 0x61, 0x64, 0x5f, 0x6c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x65,
+	0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x64, 0x65, 0x61, 0x64, 0x4c, 0x65, 0x74,
+	0x74, 0x65, 0x72, 0x65, 0x64, 0x12, 0x2c, 0x0a, 0x12, 0x6f, 0x6c, 0x64, 0x65, 0x73, 0x74, 0x5f,
+	0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x10, 0x6f, 0x6c, 0x64, 0x65, 0x73, 0x74, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67,
+	0x41, 0x67, 0x65, 0x12, 0x2b, 0x0a, 0x11, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x61, 0x63, 0x6b, 0x6e,
+	0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x10,
+	0x6c, 0x61, 0x73, 0x74, 0x41, 0x63, 0x6b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x64,
+	0x12, 0x1b, 0x0a, 0x09, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x73, 0x65, 0x65, 0x6e, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x08, 0x6c, 0x61, 0x73, 0x74, 0x53, 0x65, 0x65, 0x6e, 0x12, 0x1c, 0x0a,
+	0x09, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x65, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x09, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x65, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x68,
+	0x61, 0x6e, 0x64, 0x6c, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x0a, 0x68, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x72, 0x49, 0x64, 0x73, 0x22, 0xb6, 0x01, 0x0a,
+	0x0e, 0x48, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x72, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x12,
+	0x1d, 0x0a, 0x0a, 0x68, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x68, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x72, 0x49, 0x64, 0x12, 0x27,
+	0x0a, 0x0f, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x71, 0x75, 0x65, 0x75, 0x65,
+	0x5f, 0x64, 0x65, 0x70, 0x74, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x71, 0x75,
+	0x65, 0x75, 0x65, 0x44, 0x65, 0x70, 0x74, 0x68, 0x12, 0x1d, 0x0a, 0x0a, 0x71, 0x75, 0x65, 0x75,
+	0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x71, 0x75,
+	0x65, 0x75, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x6f, 0x76, 0x65, 0x72, 0x66,
+	0x6c, 0x6f, 0x77, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x6f, 0x76, 0x65, 0x72,
+	0x66, 0x6c, 0x6f, 0x77, 0x73, 0x22, 0xd6, 0x01, 0x0a, 0x0f, 0x48, 0x61, 0x6e, 0x64, 0x6c, 0x65,
+	0x72, 0x4f, 0x76, 0x65, 0x72, 0x66, 0x6c, 0x6f, 0x77, 0x12, 0x1d, 0x0a, 0x0a, 0x68, 0x61, 0x6e,
+	0x64, 0x6c, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x68,
+	0x61, 0x6e, 0x64, 0x6c, 0x65, 0x72, 0x49, 0x64, 0x12, 0x27, 0x0a, 0x0f, 0x72, 0x65, 0x67, 0x69,
+	0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0e, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49,
+	0x64, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x12, 0x1d, 0x0a,
+	0x0a, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x09, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x49, 0x64, 0x73, 0x12, 0x1c, 0x0a, 0x09,
+	0x6f, 0x76, 0x65, 0x72, 0x66, 0x6c, 0x6f, 0x77, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x09, 0x6f, 0x76, 0x65, 0x72, 0x66, 0x6c, 0x6f, 0x77, 0x73, 0x12, 0x22, 0x0a, 0x0c, 0x64, 0x69,
+	0x73, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x0c, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x65, 0x64, 0x32, 0x81,
+	0x09, 0x0a, 0x07, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x12, 0x66, 0x0a, 0x0d, 0x63, 0x68,
+	0x61, 0x6e, 0x67, 0x65, 0x5f, 0x75, 0x70, 0x73, 0x65, 0x72, 0x74, 0x12, 0x28, 0x2e, 0x67, 0x6f,
+	0x5f, 0x62, 0x6c, 0x75, 0x64, 0x67, 0x65, 0x6f, 0x6e, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65,
+	0x73, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x55, 0x70, 0x73, 0x65, 0x72, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x67, 0x6f, 0x5f, 0x62, 0x6c, 0x75, 0x64, 0x67,
+	0x65, 0x6f, 0x6e, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x2e, 0x43, 0x68, 0x61, 0x6e,
+	0x67, 0x65, 0x55, 0x70, 0x73, 0x65, 0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x12, 0x60, 0x0a, 0x0b, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x72, 0x65, 0x61,
+	0x64, 0x12, 0x26, 0x2e, 0x67, 0x6f, 0x5f, 0x62, 0x6c, 0x75, 0x64, 0x67, 0x65, 0x6f, 0x6e, 0x5f,
+	0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x65,
+	0x61, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x67, 0x6f, 0x5f, 0x62,
+	0x6c, 0x75, 0x64, 0x67, 0x65, 0x6f, 0x6e, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x2e,
+	0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x61, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x12, 0x63, 0x0a, 0x0c, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x5f,
+	0x72, 0x65, 0x61, 0x64, 0x12, 0x27, 0x2e, 0x67, 0x6f, 0x5f, 0x62, 0x6c, 0x75, 0x64, 0x67, 0x65,
+	0x6f, 0x6e, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x67,
+	0x65, 0x73, 0x52, 0x65, 0x61, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e,
+	0x67, 0x6f, 0x5f, 0x62, 0x6c, 0x75, 0x64, 0x67, 0x65, 0x6f, 0x6e, 0x5f, 0x63, 0x68, 0x61, 0x6e,
+	0x67, 0x65, 0x73, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x52, 0x65, 0x61, 0x64, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x66, 0x0a, 0x0d, 0x63, 0x68, 0x61,
+	0x6e, 0x67, 0x65, 0x5f, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x12, 0x28, 0x2e, 0x67, 0x6f, 0x5f,
+	0x62, 0x6c, 0x75, 0x64, 0x67, 0x65, 0x6f, 0x6e, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73,
+	0x2e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x67, 0x6f, 0x5f, 0x62, 0x6c, 0x75, 0x64, 0x67, 0x65,
+	0x6f, 0x6e, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x67,
+	0x65, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x12, 0x78, 0x0a, 0x13, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x5f, 0x75, 0x70, 0x73, 0x65, 0x72, 0x74, 0x12, 0x2e, 0x2e, 0x67, 0x6f, 0x5f, 0x62, 0x6c,
+	0x75, 0x64, 0x67, 0x65, 0x6f, 0x6e, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x2e, 0x52,
+	0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x55, 0x70, 0x73, 0x65, 0x72,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2f, 0x2e, 0x67, 0x6f, 0x5f, 0x62, 0x6c,
+	0x75, 0x64, 0x67, 0x65, 0x6f, 0x6e, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x2e, 0x52,
+	0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x55, 0x70, 0x73, 0x65, 0x72,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x88, 0x01, 0x0a, 0x19,
+	0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x63, 0x68, 0x61,
+	0x6e, 0x67, 0x65, 0x73, 0x5f, 0x72, 0x65, 0x61, 0x64, 0x12, 0x33, 0x2e, 0x67, 0x6f, 0x5f, 0x62,
+	0x6c, 0x75, 0x64, 0x67, 0x65, 0x6f, 0x6e, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x2e,
+	0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x68, 0x61, 0x6e,
+	0x67, 0x65, 0x73, 0x52, 0x65, 0x61, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x34,
+	0x2e, 0x67, 0x6f, 0x5f, 0x62, 0x6c, 0x75, 0x64, 0x67, 0x65, 0x6f, 0x6e, 0x5f, 0x63, 0x68, 0x61,
+	0x6e, 0x67, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x52, 0x65, 0x61, 0x64, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x9a, 0x01, 0x0a, 0x1f, 0x72, 0x65, 0x67, 0x69, 0x73,
+	0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x61,
+	0x63, 0x6b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x12, 0x39, 0x2e, 0x67, 0x6f, 0x5f,
+	0x62, 0x6c, 0x75, 0x64, 0x67, 0x65, 0x6f, 0x6e, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73,
+	0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x68, 0x61,
+	0x6e, 0x67, 0x65, 0x41, 0x63, 0x6b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x3a, 0x2e, 0x67, 0x6f, 0x5f, 0x62, 0x6c, 0x75, 0x64, 0x67,
+	0x65, 0x6f, 0x6e, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x67, 0x69,
+	0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x41, 0x63,
+	0x6b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x12, 0x78, 0x0a, 0x13, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x5f, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x12, 0x2e, 0x2e, 0x67, 0x6f, 0x5f,
+	0x62, 0x6c, 0x75, 0x64, 0x67, 0x65, 0x6f, 0x6e, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73,
+	0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2f, 0x2e, 0x67, 0x6f, 0x5f,
+	0x62, 0x6c, 0x75, 0x64, 0x67, 0x65, 0x6f, 0x6e, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73,
+	0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5e, 0x0a,
+	0x09, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x12, 0x25, 0x2e, 0x67, 0x6f, 0x5f,
+	0x62, 0x6c, 0x75, 0x64, 0x67, 0x65, 0x6f, 0x6e, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73,
+	0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x26, 0x2e, 0x67, 0x6f, 0x5f, 0x62, 0x6c, 0x75, 0x64, 0x67, 0x65, 0x6f, 0x6e, 0x5f,
+	0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x30, 0x01, 0x12, 0x63, 0x0a,
+	0x0c, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x5f, 0x72, 0x65, 0x61, 0x64, 0x12, 0x27, 0x2e,
+	0x67, 0x6f, 0x5f, 0x62, 0x6c, 0x75, 0x64, 0x67, 0x65, 0x6f, 0x6e, 0x5f, 0x63, 0x68, 0x61, 0x6e,
+	0x67, 0x65, 0x73, 0x2e, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x52, 0x65, 0x61, 0x64, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x67, 0x6f, 0x5f, 0x62, 0x6c, 0x75, 0x64,
+	0x67, 0x65, 0x6f, 0x6e, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x2e, 0x4d, 0x65, 0x74,
+	0x72, 0x69, 0x63, 0x73, 0x52, 0x65, 0x61, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x42, 0x3a, 0x5a, 0x38, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
+	0x2f, 0x61, 0x6e, 0x74, 0x6f, 0x6e, 0x69, 0x6f, 0x2d, 0x61, 0x6c, 0x65, 0x78, 0x61, 0x6e, 0x64,
+	0x65, 0x72, 0x2f, 0x67, 0x6f, 0x2d, 0x62, 0x6c, 0x75, 0x64, 0x67, 0x65, 0x6f, 0x6e, 0x2f, 0x63,
+	0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x2f, 0x64, 0x61, 0x74, 0x61, 0x2f, 0x70, 0x62, 0x62, 0x06,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_changes_proto_rawDescOnce sync.Once
+	file_changes_proto_rawDescData = file_changes_proto_rawDesc
+)
+
+func file_changes_proto_rawDescGZIP() []byte {
+	file_changes_proto_rawDescOnce.Do(func() {
+		file_changes_proto_rawDescData = protoimpl.X.CompressGZIP(file_changes_proto_rawDescData)
+	})
+	return file_changes_proto_rawDescData
+}
+
+var file_changes_proto_msgTypes = make([]protoimpl.MessageInfo, 30)
+var file_changes_proto_goTypes = []interface{}{
+	(*ChangeUpsertRequest)(nil),                   // 0: go_bludgeon_changes.ChangeUpsertRequest
+	(*ChangeUpsertResponse)(nil),                  // 1: go_bludgeon_changes.ChangeUpsertResponse
+	(*ChangeReadRequest)(nil),                     // 2: go_bludgeon_changes.ChangeReadRequest
+	(*ChangeReadResponse)(nil),                    // 3: go_bludgeon_changes.ChangeReadResponse
+	(*ChangesReadRequest)(nil),                    // 4: go_bludgeon_changes.ChangesReadRequest
+	(*ChangesReadResponse)(nil),                   // 5: go_bludgeon_changes.ChangesReadResponse
+	(*ChangeDeleteRequest)(nil),                   // 6: go_bludgeon_changes.ChangeDeleteRequest
+	(*ChangeDeleteResponse)(nil),                  // 7: go_bludgeon_changes.ChangeDeleteResponse
+	(*RegistrationUpsertRequest)(nil),             // 8: go_bludgeon_changes.RegistrationUpsertRequest
+	(*RegistrationUpsertResponse)(nil),            // 9: go_bludgeon_changes.RegistrationUpsertResponse
+	(*RegistrationChangesReadRequest)(nil),        // 10: go_bludgeon_changes.RegistrationChangesReadRequest
+	(*RegistrationChangesReadResponse)(nil),       // 11: go_bludgeon_changes.RegistrationChangesReadResponse
+	(*RegistrationChangeAcknowledgeRequest)(nil),  // 12: go_bludgeon_changes.RegistrationChangeAcknowledgeRequest
+	(*RegistrationChangeAcknowledgeResponse)(nil), // 13: go_bludgeon_changes.RegistrationChangeAcknowledgeResponse
+	(*RegistrationDeleteRequest)(nil),             // 14: go_bludgeon_changes.RegistrationDeleteRequest
+	(*RegistrationDeleteResponse)(nil),            // 15: go_bludgeon_changes.RegistrationDeleteResponse
+	(*SubscribeRequest)(nil),                      // 16: go_bludgeon_changes.SubscribeRequest
+	(*SubscribeResponse)(nil),                     // 17: go_bludgeon_changes.SubscribeResponse
+	(*MetricsReadRequest)(nil),                    // 18: go_bludgeon_changes.MetricsReadRequest
+	(*MetricsReadResponse)(nil),                   // 19: go_bludgeon_changes.MetricsReadResponse
+	(*ChangePartial)(nil),                         // 20: go_bludgeon_changes.ChangePartial
+	(*Change)(nil),                                // 21: go_bludgeon_changes.Change
+	(*ChangePayload)(nil),                         // 22: go_bludgeon_changes.ChangePayload
+	(*FieldDiff)(nil),                             // 23: go_bludgeon_changes.FieldDiff
+	(*ChangeSearch)(nil),                          // 24: go_bludgeon_changes.ChangeSearch
+	(*RegistrationFilter)(nil),                    // 25: go_bludgeon_changes.RegistrationFilter
+	(*Metrics)(nil),                               // 26: go_bludgeon_changes.Metrics
+	(*RegistrationMetrics)(nil),                   // 27: go_bludgeon_changes.RegistrationMetrics
+	(*HandlerMetrics)(nil),                        // 28: go_bludgeon_changes.HandlerMetrics
+	(*HandlerOverflow)(nil),                       // 29: go_bludgeon_changes.HandlerOverflow
+}
+var file_changes_proto_depIdxs = []int32{
+	20, // 0: go_bludgeon_changes.ChangeUpsertRequest.change_partial:type_name -> go_bludgeon_changes.ChangePartial
+	21, // 1: go_bludgeon_changes.ChangeUpsertResponse.change:type_name -> go_bludgeon_changes.Change
+	21, // 2: go_bludgeon_changes.ChangeReadResponse.change:type_name -> go_bludgeon_changes.Change
+	24, // 3: go_bludgeon_changes.ChangesReadRequest.change_search:type_name -> go_bludgeon_changes.ChangeSearch
+	21, // 4: go_bludgeon_changes.ChangesReadResponse.changes:type_name -> go_bludgeon_changes.Change
+	25, // 5: go_bludgeon_changes.RegistrationUpsertRequest.filter:type_name -> go_bludgeon_changes.RegistrationFilter
+	21, // 6: go_bludgeon_changes.RegistrationChangesReadResponse.changes:type_name -> go_bludgeon_changes.Change
+	25, // 7: go_bludgeon_changes.SubscribeRequest.filter:type_name -> go_bludgeon_changes.RegistrationFilter
+	21, // 8: go_bludgeon_changes.SubscribeResponse.changes:type_name -> go_bludgeon_changes.Change
+	29, // 9: go_bludgeon_changes.SubscribeResponse.overflow:type_name -> go_bludgeon_changes.HandlerOverflow
+	26, // 10: go_bludgeon_changes.MetricsReadResponse.metrics:type_name -> go_bludgeon_changes.Metrics
+	22, // 11: go_bludgeon_changes.ChangePartial.payload:type_name -> go_bludgeon_changes.ChangePayload
+	22, // 12: go_bludgeon_changes.Change.payload:type_name -> go_bludgeon_changes.ChangePayload
+	23, // 13: go_bludgeon_changes.ChangePayload.diff:type_name -> go_bludgeon_changes.FieldDiff
+	27, // 14: go_bludgeon_changes.Metrics.registrations:type_name -> go_bludgeon_changes.RegistrationMetrics
+	28, // 15: go_bludgeon_changes.Metrics.handlers:type_name -> go_bludgeon_changes.HandlerMetrics
+	0,  // 16: go_bludgeon_changes.Changes.change_upsert:input_type -> go_bludgeon_changes.ChangeUpsertRequest
+	2,  // 17: go_bludgeon_changes.Changes.change_read:input_type -> go_bludgeon_changes.ChangeReadRequest
+	4,  // 18: go_bludgeon_changes.Changes.changes_read:input_type -> go_bludgeon_changes.ChangesReadRequest
+	6,  // 19: go_bludgeon_changes.Changes.change_delete:input_type -> go_bludgeon_changes.ChangeDeleteRequest
+	8,  // 20: go_bludgeon_changes.Changes.registration_upsert:input_type -> go_bludgeon_changes.RegistrationUpsertRequest
+	10, // 21: go_bludgeon_changes.Changes.registration_changes_read:input_type -> go_bludgeon_changes.RegistrationChangesReadRequest
+	12, // 22: go_bludgeon_changes.Changes.registration_change_acknowledge:input_type -> go_bludgeon_changes.RegistrationChangeAcknowledgeRequest
+	14, // 23: go_bludgeon_changes.Changes.registration_delete:input_type -> go_bludgeon_changes.RegistrationDeleteRequest
+	16, // 24: go_bludgeon_changes.Changes.subscribe:input_type -> go_bludgeon_changes.SubscribeRequest
+	18, // 25: go_bludgeon_changes.Changes.metrics_read:input_type -> go_bludgeon_changes.MetricsReadRequest
+	1,  // 26: go_bludgeon_changes.Changes.change_upsert:output_type -> go_bludgeon_changes.ChangeUpsertResponse
+	3,  // 27: go_bludgeon_changes.Changes.change_read:output_type -> go_bludgeon_changes.ChangeReadResponse
+	5,  // 28: go_bludgeon_changes.Changes.changes_read:output_type -> go_bludgeon_changes.ChangesReadResponse
+	7,  // 29: go_bludgeon_changes.Changes.change_delete:output_type -> go_bludgeon_changes.ChangeDeleteResponse
+	9,  // 30: go_bludgeon_changes.Changes.registration_upsert:output_type -> go_bludgeon_changes.RegistrationUpsertResponse
+	11, // 31: go_bludgeon_changes.Changes.registration_changes_read:output_type -> go_bludgeon_changes.RegistrationChangesReadResponse
+	13, // 32: go_bludgeon_changes.Changes.registration_change_acknowledge:output_type -> go_bludgeon_changes.RegistrationChangeAcknowledgeResponse
+	15, // 33: go_bludgeon_changes.Changes.registration_delete:output_type -> go_bludgeon_changes.RegistrationDeleteResponse
+	17, // 34: go_bludgeon_changes.Changes.subscribe:output_type -> go_bludgeon_changes.SubscribeResponse
+	19, // 35: go_bludgeon_changes.Changes.metrics_read:output_type -> go_bludgeon_changes.MetricsReadResponse
+	26, // [26:36] is the sub-list for method output_type
+	16, // [16:26] is the sub-list for method input_type
+	16, // [16:16] is the sub-list for extension type_name
+	16, // [16:16] is the sub-list for extension extendee
+	0,  // [0:16] is the sub-list for field type_name
+}
+
+func init() { file_changes_proto_init() }
+func file_changes_proto_init() {
+	if File_changes_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_changes_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChangeUpsertRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_changes_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChangeUpsertResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_changes_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChangeReadRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_changes_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChangeReadResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_changes_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChangesReadRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_changes_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChangesReadResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_changes_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChangeDeleteRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_changes_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChangeDeleteResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_changes_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RegistrationUpsertRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_changes_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RegistrationUpsertResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_changes_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RegistrationChangesReadRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_changes_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RegistrationChangesReadResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_changes_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RegistrationChangeAcknowledgeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_changes_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RegistrationChangeAcknowledgeResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_changes_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RegistrationDeleteRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_changes_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RegistrationDeleteResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_changes_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubscribeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_changes_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubscribeResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_changes_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MetricsReadRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_changes_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MetricsReadResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_changes_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChangePartial); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_changes_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Change); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_changes_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChangePayload); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_changes_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FieldDiff); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_changes_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChangeSearch); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_changes_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RegistrationFilter); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_changes_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Metrics); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_changes_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RegistrationMetrics); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_changes_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HandlerMetrics); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_changes_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HandlerOverflow); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_changes_proto_msgTypes[20].OneofWrappers = []interface{}{
+		(*ChangePartial_WhenChanged)(nil),
+		(*ChangePartial_ChangedBy)(nil),
+		(*ChangePartial_DataId)(nil),
+		(*ChangePartial_DataServiceName)(nil),
+		(*ChangePartial_DataType)(nil),
+		(*ChangePartial_DataAction)(nil),
+		(*ChangePartial_DataVersion)(nil),
+	}
+	file_changes_proto_msgTypes[24].OneofWrappers = []interface{}{
+		(*ChangeSearch_LatestVersion)(nil),
+		(*ChangeSearch_Since)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_changes_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   30,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_changes_proto_goTypes,
+		DependencyIndexes: file_changes_proto_depIdxs,
+		MessageInfos:      file_changes_proto_msgTypes,
+	}.Build()
+	File_changes_proto = out.File
+	file_changes_proto_rawDesc = nil
+	file_changes_proto_goTypes = nil
+	file_changes_proto_depIdxs = nil
+}
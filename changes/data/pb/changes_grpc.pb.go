@@ -0,0 +1,477 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.2.0
+// - protoc             v3.20.1
+// source: changes.proto
+
+package pb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// ChangesClient is the client API for Changes service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type ChangesClient interface {
+	// change_upsert
+	ChangeUpsert(ctx context.Context, in *ChangeUpsertRequest, opts ...grpc.CallOption) (*ChangeUpsertResponse, error)
+	// change_read
+	ChangeRead(ctx context.Context, in *ChangeReadRequest, opts ...grpc.CallOption) (*ChangeReadResponse, error)
+	// changes_read
+	ChangesRead(ctx context.Context, in *ChangesReadRequest, opts ...grpc.CallOption) (*ChangesReadResponse, error)
+	// change_delete
+	ChangeDelete(ctx context.Context, in *ChangeDeleteRequest, opts ...grpc.CallOption) (*ChangeDeleteResponse, error)
+	// registration_upsert
+	RegistrationUpsert(ctx context.Context, in *RegistrationUpsertRequest, opts ...grpc.CallOption) (*RegistrationUpsertResponse, error)
+	// registration_changes_read
+	RegistrationChangesRead(ctx context.Context, in *RegistrationChangesReadRequest, opts ...grpc.CallOption) (*RegistrationChangesReadResponse, error)
+	// registration_change_acknowledge
+	RegistrationChangeAcknowledge(ctx context.Context, in *RegistrationChangeAcknowledgeRequest, opts ...grpc.CallOption) (*RegistrationChangeAcknowledgeResponse, error)
+	// registration_delete
+	RegistrationDelete(ctx context.Context, in *RegistrationDeleteRequest, opts ...grpc.CallOption) (*RegistrationDeleteResponse, error)
+	// subscribe
+	Subscribe(ctx context.Context, in *SubscribeRequest, opts ...grpc.CallOption) (Changes_SubscribeClient, error)
+	// metrics_read
+	MetricsRead(ctx context.Context, in *MetricsReadRequest, opts ...grpc.CallOption) (*MetricsReadResponse, error)
+}
+
+type changesClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewChangesClient(cc grpc.ClientConnInterface) ChangesClient {
+	return &changesClient{cc}
+}
+
+func (c *changesClient) ChangeUpsert(ctx context.Context, in *ChangeUpsertRequest, opts ...grpc.CallOption) (*ChangeUpsertResponse, error) {
+	out := new(ChangeUpsertResponse)
+	err := c.cc.Invoke(ctx, "/go_bludgeon_changes.Changes/change_upsert", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *changesClient) ChangeRead(ctx context.Context, in *ChangeReadRequest, opts ...grpc.CallOption) (*ChangeReadResponse, error) {
+	out := new(ChangeReadResponse)
+	err := c.cc.Invoke(ctx, "/go_bludgeon_changes.Changes/change_read", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *changesClient) ChangesRead(ctx context.Context, in *ChangesReadRequest, opts ...grpc.CallOption) (*ChangesReadResponse, error) {
+	out := new(ChangesReadResponse)
+	err := c.cc.Invoke(ctx, "/go_bludgeon_changes.Changes/changes_read", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *changesClient) ChangeDelete(ctx context.Context, in *ChangeDeleteRequest, opts ...grpc.CallOption) (*ChangeDeleteResponse, error) {
+	out := new(ChangeDeleteResponse)
+	err := c.cc.Invoke(ctx, "/go_bludgeon_changes.Changes/change_delete", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *changesClient) RegistrationUpsert(ctx context.Context, in *RegistrationUpsertRequest, opts ...grpc.CallOption) (*RegistrationUpsertResponse, error) {
+	out := new(RegistrationUpsertResponse)
+	err := c.cc.Invoke(ctx, "/go_bludgeon_changes.Changes/registration_upsert", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *changesClient) RegistrationChangesRead(ctx context.Context, in *RegistrationChangesReadRequest, opts ...grpc.CallOption) (*RegistrationChangesReadResponse, error) {
+	out := new(RegistrationChangesReadResponse)
+	err := c.cc.Invoke(ctx, "/go_bludgeon_changes.Changes/registration_changes_read", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *changesClient) RegistrationChangeAcknowledge(ctx context.Context, in *RegistrationChangeAcknowledgeRequest, opts ...grpc.CallOption) (*RegistrationChangeAcknowledgeResponse, error) {
+	out := new(RegistrationChangeAcknowledgeResponse)
+	err := c.cc.Invoke(ctx, "/go_bludgeon_changes.Changes/registration_change_acknowledge", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *changesClient) RegistrationDelete(ctx context.Context, in *RegistrationDeleteRequest, opts ...grpc.CallOption) (*RegistrationDeleteResponse, error) {
+	out := new(RegistrationDeleteResponse)
+	err := c.cc.Invoke(ctx, "/go_bludgeon_changes.Changes/registration_delete", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *changesClient) Subscribe(ctx context.Context, in *SubscribeRequest, opts ...grpc.CallOption) (Changes_SubscribeClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Changes_ServiceDesc.Streams[0], "/go_bludgeon_changes.Changes/subscribe", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &changesSubscribeClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Changes_SubscribeClient interface {
+	Recv() (*SubscribeResponse, error)
+	grpc.ClientStream
+}
+
+type changesSubscribeClient struct {
+	grpc.ClientStream
+}
+
+func (x *changesSubscribeClient) Recv() (*SubscribeResponse, error) {
+	m := new(SubscribeResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *changesClient) MetricsRead(ctx context.Context, in *MetricsReadRequest, opts ...grpc.CallOption) (*MetricsReadResponse, error) {
+	out := new(MetricsReadResponse)
+	err := c.cc.Invoke(ctx, "/go_bludgeon_changes.Changes/metrics_read", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ChangesServer is the server API for Changes service.
+// All implementations must embed UnimplementedChangesServer
+// for forward compatibility
+type ChangesServer interface {
+	// change_upsert
+	ChangeUpsert(context.Context, *ChangeUpsertRequest) (*ChangeUpsertResponse, error)
+	// change_read
+	ChangeRead(context.Context, *ChangeReadRequest) (*ChangeReadResponse, error)
+	// changes_read
+	ChangesRead(context.Context, *ChangesReadRequest) (*ChangesReadResponse, error)
+	// change_delete
+	ChangeDelete(context.Context, *ChangeDeleteRequest) (*ChangeDeleteResponse, error)
+	// registration_upsert
+	RegistrationUpsert(context.Context, *RegistrationUpsertRequest) (*RegistrationUpsertResponse, error)
+	// registration_changes_read
+	RegistrationChangesRead(context.Context, *RegistrationChangesReadRequest) (*RegistrationChangesReadResponse, error)
+	// registration_change_acknowledge
+	RegistrationChangeAcknowledge(context.Context, *RegistrationChangeAcknowledgeRequest) (*RegistrationChangeAcknowledgeResponse, error)
+	// registration_delete
+	RegistrationDelete(context.Context, *RegistrationDeleteRequest) (*RegistrationDeleteResponse, error)
+	// subscribe
+	Subscribe(*SubscribeRequest, Changes_SubscribeServer) error
+	// metrics_read
+	MetricsRead(context.Context, *MetricsReadRequest) (*MetricsReadResponse, error)
+	mustEmbedUnimplementedChangesServer()
+}
+
+// UnimplementedChangesServer must be embedded to have forward compatible implementations.
+type UnimplementedChangesServer struct {
+}
+
+func (UnimplementedChangesServer) ChangeUpsert(context.Context, *ChangeUpsertRequest) (*ChangeUpsertResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChangeUpsert not implemented")
+}
+func (UnimplementedChangesServer) ChangeRead(context.Context, *ChangeReadRequest) (*ChangeReadResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChangeRead not implemented")
+}
+func (UnimplementedChangesServer) ChangesRead(context.Context, *ChangesReadRequest) (*ChangesReadResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChangesRead not implemented")
+}
+func (UnimplementedChangesServer) ChangeDelete(context.Context, *ChangeDeleteRequest) (*ChangeDeleteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChangeDelete not implemented")
+}
+func (UnimplementedChangesServer) RegistrationUpsert(context.Context, *RegistrationUpsertRequest) (*RegistrationUpsertResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegistrationUpsert not implemented")
+}
+func (UnimplementedChangesServer) RegistrationChangesRead(context.Context, *RegistrationChangesReadRequest) (*RegistrationChangesReadResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegistrationChangesRead not implemented")
+}
+func (UnimplementedChangesServer) RegistrationChangeAcknowledge(context.Context, *RegistrationChangeAcknowledgeRequest) (*RegistrationChangeAcknowledgeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegistrationChangeAcknowledge not implemented")
+}
+func (UnimplementedChangesServer) RegistrationDelete(context.Context, *RegistrationDeleteRequest) (*RegistrationDeleteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegistrationDelete not implemented")
+}
+func (UnimplementedChangesServer) Subscribe(*SubscribeRequest, Changes_SubscribeServer) error {
+	return status.Errorf(codes.Unimplemented, "method Subscribe not implemented")
+}
+func (UnimplementedChangesServer) MetricsRead(context.Context, *MetricsReadRequest) (*MetricsReadResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MetricsRead not implemented")
+}
+func (UnimplementedChangesServer) mustEmbedUnimplementedChangesServer() {}
+
+// UnsafeChangesServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ChangesServer will
+// result in compilation errors.
+type UnsafeChangesServer interface {
+	mustEmbedUnimplementedChangesServer()
+}
+
+func RegisterChangesServer(s grpc.ServiceRegistrar, srv ChangesServer) {
+	s.RegisterService(&Changes_ServiceDesc, srv)
+}
+
+func _Changes_ChangeUpsert_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChangeUpsertRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChangesServer).ChangeUpsert(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/go_bludgeon_changes.Changes/change_upsert",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChangesServer).ChangeUpsert(ctx, req.(*ChangeUpsertRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Changes_ChangeRead_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChangeReadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChangesServer).ChangeRead(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/go_bludgeon_changes.Changes/change_read",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChangesServer).ChangeRead(ctx, req.(*ChangeReadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Changes_ChangesRead_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChangesReadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChangesServer).ChangesRead(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/go_bludgeon_changes.Changes/changes_read",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChangesServer).ChangesRead(ctx, req.(*ChangesReadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Changes_ChangeDelete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChangeDeleteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChangesServer).ChangeDelete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/go_bludgeon_changes.Changes/change_delete",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChangesServer).ChangeDelete(ctx, req.(*ChangeDeleteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Changes_RegistrationUpsert_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RegistrationUpsertRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChangesServer).RegistrationUpsert(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/go_bludgeon_changes.Changes/registration_upsert",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChangesServer).RegistrationUpsert(ctx, req.(*RegistrationUpsertRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Changes_RegistrationChangesRead_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RegistrationChangesReadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChangesServer).RegistrationChangesRead(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/go_bludgeon_changes.Changes/registration_changes_read",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChangesServer).RegistrationChangesRead(ctx, req.(*RegistrationChangesReadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Changes_RegistrationChangeAcknowledge_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RegistrationChangeAcknowledgeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChangesServer).RegistrationChangeAcknowledge(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/go_bludgeon_changes.Changes/registration_change_acknowledge",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChangesServer).RegistrationChangeAcknowledge(ctx, req.(*RegistrationChangeAcknowledgeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Changes_RegistrationDelete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RegistrationDeleteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChangesServer).RegistrationDelete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/go_bludgeon_changes.Changes/registration_delete",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChangesServer).RegistrationDelete(ctx, req.(*RegistrationDeleteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Changes_Subscribe_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SubscribeRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ChangesServer).Subscribe(m, &changesSubscribeServer{stream})
+}
+
+type Changes_SubscribeServer interface {
+	Send(*SubscribeResponse) error
+	grpc.ServerStream
+}
+
+type changesSubscribeServer struct {
+	grpc.ServerStream
+}
+
+func (x *changesSubscribeServer) Send(m *SubscribeResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Changes_MetricsRead_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MetricsReadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChangesServer).MetricsRead(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/go_bludgeon_changes.Changes/metrics_read",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChangesServer).MetricsRead(ctx, req.(*MetricsReadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Changes_ServiceDesc is the grpc.ServiceDesc for Changes service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Changes_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "go_bludgeon_changes.Changes",
+	HandlerType: (*ChangesServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "change_upsert",
+			Handler:    _Changes_ChangeUpsert_Handler,
+		},
+		{
+			MethodName: "change_read",
+			Handler:    _Changes_ChangeRead_Handler,
+		},
+		{
+			MethodName: "changes_read",
+			Handler:    _Changes_ChangesRead_Handler,
+		},
+		{
+			MethodName: "change_delete",
+			Handler:    _Changes_ChangeDelete_Handler,
+		},
+		{
+			MethodName: "registration_upsert",
+			Handler:    _Changes_RegistrationUpsert_Handler,
+		},
+		{
+			MethodName: "registration_changes_read",
+			Handler:    _Changes_RegistrationChangesRead_Handler,
+		},
+		{
+			MethodName: "registration_change_acknowledge",
+			Handler:    _Changes_RegistrationChangeAcknowledge_Handler,
+		},
+		{
+			MethodName: "registration_delete",
+			Handler:    _Changes_RegistrationDelete_Handler,
+		},
+		{
+			MethodName: "metrics_read",
+			Handler:    _Changes_MetricsRead_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "subscribe",
+			Handler:       _Changes_Subscribe_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "changes.proto",
+}
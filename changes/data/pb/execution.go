@@ -0,0 +1,317 @@
+package pb
+
+import "github.com/antonio-alexander/go-bludgeon/changes/data"
+
+func FromChangePartial(c *data.ChangePartial) *ChangePartial {
+	if c == nil {
+		return nil
+	}
+	changePartial := &ChangePartial{}
+	if c.WhenChanged != nil {
+		changePartial.WhenChangedOneof = &ChangePartial_WhenChanged{
+			WhenChanged: *c.WhenChanged,
+		}
+	}
+	if c.ChangedBy != nil {
+		changePartial.ChangedByOneof = &ChangePartial_ChangedBy{
+			ChangedBy: *c.ChangedBy,
+		}
+	}
+	if c.DataId != nil {
+		changePartial.DataIdOneof = &ChangePartial_DataId{
+			DataId: *c.DataId,
+		}
+	}
+	if c.DataServiceName != nil {
+		changePartial.DataServiceNameOneof = &ChangePartial_DataServiceName{
+			DataServiceName: *c.DataServiceName,
+		}
+	}
+	if c.DataType != nil {
+		changePartial.DataTypeOneof = &ChangePartial_DataType{
+			DataType: *c.DataType,
+		}
+	}
+	if c.DataAction != nil {
+		changePartial.DataActionOneof = &ChangePartial_DataAction{
+			DataAction: *c.DataAction,
+		}
+	}
+	if c.DataVersion != nil {
+		changePartial.DataVersionOneof = &ChangePartial_DataVersion{
+			DataVersion: int32(*c.DataVersion),
+		}
+	}
+	changePartial.Payload = FromChangePayload(c.Payload)
+	return changePartial
+}
+
+func ToChangePartial(c *ChangePartial) *data.ChangePartial {
+	if c == nil {
+		return nil
+	}
+	changePartial := &data.ChangePartial{}
+	if c.WhenChangedOneof != nil {
+		i := c.GetWhenChanged()
+		changePartial.WhenChanged = &i
+	}
+	if c.ChangedByOneof != nil {
+		s := c.GetChangedBy()
+		changePartial.ChangedBy = &s
+	}
+	if c.DataIdOneof != nil {
+		s := c.GetDataId()
+		changePartial.DataId = &s
+	}
+	if c.DataServiceNameOneof != nil {
+		s := c.GetDataServiceName()
+		changePartial.DataServiceName = &s
+	}
+	if c.DataTypeOneof != nil {
+		s := c.GetDataType()
+		changePartial.DataType = &s
+	}
+	if c.DataActionOneof != nil {
+		s := c.GetDataAction()
+		changePartial.DataAction = &s
+	}
+	if c.DataVersionOneof != nil {
+		i := int(c.GetDataVersion())
+		changePartial.DataVersion = &i
+	}
+	changePartial.Payload = ToChangePayload(c.GetPayload())
+	return changePartial
+}
+
+func FromChange(c *data.Change) *Change {
+	if c == nil {
+		return nil
+	}
+	return &Change{
+		Id:              c.Id,
+		WhenChanged:     c.WhenChanged,
+		ChangedBy:       c.ChangedBy,
+		DataId:          c.DataId,
+		DataServiceName: c.DataServiceName,
+		DataType:        c.DataType,
+		DataAction:      c.DataAction,
+		DataVersion:     int32(c.DataVersion),
+		Payload:         FromChangePayload(c.Payload),
+	}
+}
+
+func ToChange(c *Change) *data.Change {
+	if c == nil {
+		return nil
+	}
+	return &data.Change{
+		Id:              c.GetId(),
+		WhenChanged:     c.GetWhenChanged(),
+		ChangedBy:       c.GetChangedBy(),
+		DataId:          c.GetDataId(),
+		DataServiceName: c.GetDataServiceName(),
+		DataType:        c.GetDataType(),
+		DataAction:      c.GetDataAction(),
+		DataVersion:     int(c.GetDataVersion()),
+		Payload:         ToChangePayload(c.GetPayload()),
+	}
+}
+
+func FromChangePayload(c *data.ChangePayload) *ChangePayload {
+	if c == nil {
+		return nil
+	}
+	changePayload := &ChangePayload{Snapshot: c.Snapshot}
+	for _, diff := range c.Diff {
+		changePayload.Diff = append(changePayload.Diff, &FieldDiff{
+			Field:  diff.Field,
+			Before: diff.Before,
+			After:  diff.After,
+		})
+	}
+	return changePayload
+}
+
+func ToChangePayload(c *ChangePayload) *data.ChangePayload {
+	if c == nil {
+		return nil
+	}
+	changePayload := &data.ChangePayload{Snapshot: c.GetSnapshot()}
+	for _, diff := range c.GetDiff() {
+		changePayload.Diff = append(changePayload.Diff, &data.FieldDiff{
+			Field:  diff.GetField(),
+			Before: diff.GetBefore(),
+			After:  diff.GetAfter(),
+		})
+	}
+	return changePayload
+}
+
+func FromChanges(c []*data.Change) []*Change {
+	var changes []*Change
+	for _, c := range c {
+		changes = append(changes, FromChange(c))
+	}
+	return changes
+}
+
+func ToChanges(c []*Change) []*data.Change {
+	var changes []*data.Change
+	for _, c := range c {
+		changes = append(changes, ToChange(c))
+	}
+	return changes
+}
+
+func FromChangeSearch(c *ChangeSearch) *data.ChangeSearch {
+	if c == nil {
+		return nil
+	}
+	changeSearch := &data.ChangeSearch{
+		ChangeIds:    c.ChangeIds,
+		DataIds:      c.DataIds,
+		Types:        c.Types,
+		Actions:      c.Actions,
+		ServiceNames: c.ServiceNames,
+		Fields:       c.Fields,
+	}
+	if c.LatestVersionOneof != nil {
+		b := c.GetLatestVersion()
+		changeSearch.LatestVersion = &b
+	}
+	if c.SinceOneof != nil {
+		i := c.GetSince()
+		changeSearch.Since = &i
+	}
+	return changeSearch
+}
+
+func ToChangeSearch(c *data.ChangeSearch) *ChangeSearch {
+	if c == nil {
+		return nil
+	}
+	changeSearch := &ChangeSearch{
+		ChangeIds:    c.ChangeIds,
+		DataIds:      c.DataIds,
+		Types:        c.Types,
+		Actions:      c.Actions,
+		ServiceNames: c.ServiceNames,
+		Fields:       c.Fields,
+	}
+	if c.LatestVersion != nil {
+		changeSearch.LatestVersionOneof = &ChangeSearch_LatestVersion{
+			LatestVersion: *c.LatestVersion,
+		}
+	}
+	if c.Since != nil {
+		changeSearch.SinceOneof = &ChangeSearch_Since{
+			Since: *c.Since,
+		}
+	}
+	return changeSearch
+}
+
+func FromRegistrationFilter(r *data.RegistrationFilter) *RegistrationFilter {
+	if r == nil {
+		return nil
+	}
+	return &RegistrationFilter{
+		ServiceNames: r.ServiceNames,
+		Types:        r.Types,
+		Actions:      r.Actions,
+	}
+}
+
+func ToRegistrationFilter(r *RegistrationFilter) data.RegistrationFilter {
+	return data.RegistrationFilter{
+		ServiceNames: r.GetServiceNames(),
+		Types:        r.GetTypes(),
+		Actions:      r.GetActions(),
+	}
+}
+
+func FromMetrics(m *data.Metrics) *Metrics {
+	if m == nil {
+		return nil
+	}
+	metrics := &Metrics{}
+	for _, r := range m.Registrations {
+		metrics.Registrations = append(metrics.Registrations, &RegistrationMetrics{
+			RegistrationId:   r.RegistrationId,
+			Pending:          int64(r.Pending),
+			DeadLettered:     int64(r.DeadLettered),
+			OldestPendingAge: r.OldestPendingAge,
+			LastAcknowledged: r.LastAcknowledged,
+			LastSeen:         r.LastSeen,
+			Connected:        r.Connected,
+			HandlerIds:       r.HandlerIds,
+		})
+	}
+	for _, h := range m.Handlers {
+		metrics.Handlers = append(metrics.Handlers, &HandlerMetrics{
+			HandlerId:      h.HandlerId,
+			RegistrationId: h.RegistrationId,
+			QueueDepth:     int64(h.QueueDepth),
+			QueueSize:      int64(h.QueueSize),
+			Overflows:      h.Overflows,
+		})
+	}
+	return metrics
+}
+
+func ToMetrics(m *Metrics) *data.Metrics {
+	if m == nil {
+		return nil
+	}
+	metrics := &data.Metrics{}
+	for _, r := range m.GetRegistrations() {
+		metrics.Registrations = append(metrics.Registrations, &data.RegistrationMetrics{
+			RegistrationId:   r.GetRegistrationId(),
+			Pending:          int(r.GetPending()),
+			DeadLettered:     int(r.GetDeadLettered()),
+			OldestPendingAge: r.GetOldestPendingAge(),
+			LastAcknowledged: r.GetLastAcknowledged(),
+			LastSeen:         r.GetLastSeen(),
+			Connected:        r.GetConnected(),
+			HandlerIds:       r.GetHandlerIds(),
+		})
+	}
+	for _, h := range m.GetHandlers() {
+		metrics.Handlers = append(metrics.Handlers, &data.HandlerMetrics{
+			HandlerId:      h.GetHandlerId(),
+			RegistrationId: h.GetRegistrationId(),
+			QueueDepth:     int(h.GetQueueDepth()),
+			QueueSize:      int(h.GetQueueSize()),
+			Overflows:      h.GetOverflows(),
+		})
+	}
+	return metrics
+}
+
+func FromHandlerOverflow(o *data.HandlerOverflow) *HandlerOverflow {
+	if o == nil {
+		return nil
+	}
+	return &HandlerOverflow{
+		HandlerId:      o.HandlerId,
+		RegistrationId: o.RegistrationId,
+		Strategy:       string(o.Strategy),
+		ChangeIds:      o.ChangeIds,
+		Overflows:      o.Overflows,
+		Disconnected:   o.Disconnected,
+	}
+}
+
+func ToHandlerOverflow(o *HandlerOverflow) *data.HandlerOverflow {
+	if o == nil {
+		return nil
+	}
+	return &data.HandlerOverflow{
+		HandlerId:      o.GetHandlerId(),
+		RegistrationId: o.GetRegistrationId(),
+		Strategy:       data.OverflowStrategy(o.GetStrategy()),
+		ChangeIds:      o.GetChangeIds(),
+		Overflows:      o.GetOverflows(),
+		Disconnected:   o.GetDisconnected(),
+	}
+}
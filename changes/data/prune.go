@@ -0,0 +1,41 @@
+package data
+
+import "encoding/json"
+
+type PruneReport struct {
+	// The time the retention policy was enforced
+	// example: 1652417242000
+	WhenPruned int64 `json:"when_pruned,string"`
+
+	// The ids of the changes that were deleted because they exceeded
+	// the maximum age
+	// example: ["86fa2f09-d260-11ec-bd5d-0242c0a8e002"]
+	ChangesExpired []string `json:"changes_expired,omitempty"`
+
+	// The ids of the unacknowledged changes that were removed from each
+	// registration because it exceeded the maximum pending changes
+	// example: {"timers":["86fa2f09-d260-11ec-bd5d-0242c0a8e002"]}
+	ChangesTrimmed map[string][]string `json:"changes_trimmed,omitempty"`
+
+	// The ids of the registrations that were deleted because they
+	// weren't seen within the registration expiry
+	// example: ["timers"]
+	RegistrationsExpired []string `json:"registrations_expired,omitempty"`
+
+	// The ids of the changes that were deleted because they were no
+	// longer referenced by any registration
+	// example: ["86fa2f09-d260-11ec-bd5d-0242c0a8e002"]
+	ChangesPruned []string `json:"changes_pruned,omitempty"`
+
+	// The error that occured while enforcing the retention policy, if any
+	// example: registration not found
+	Error string `json:"error,omitempty"`
+}
+
+func (p *PruneReport) MarshalBinary() ([]byte, error) {
+	return json.Marshal(p)
+}
+
+func (p *PruneReport) UnmarshalBinary(bytes []byte) error {
+	return json.Unmarshal(bytes, p)
+}
@@ -0,0 +1,89 @@
+package data
+
+import (
+	"fmt"
+	"strings"
+)
+
+type RegistrationFilter struct {
+	// The services whose changes should be attached to the registration,
+	// if empty, changes from all services are attached
+	// example: ["employees"]
+	ServiceNames []string `json:"service_names,omitempty"`
+
+	// The data types whose changes should be attached to the registration,
+	// if empty, changes of all data types are attached
+	// example: ["employee"]
+	Types []string `json:"types,omitempty"`
+
+	// The actions whose changes should be attached to the registration,
+	// if empty, changes with any action are attached
+	// example: ["delete"]
+	Actions []string `json:"actions,omitempty"`
+}
+
+// Match can be used to determine if a given change satisfies the filter,
+// the filter is exclusive (all of the non-empty fields must match)
+func (r *RegistrationFilter) Match(change *Change) bool {
+	matchFx := func(values []string, value string) bool {
+		if len(values) == 0 {
+			return true
+		}
+		for _, v := range values {
+			if v == value {
+				return true
+			}
+		}
+		return false
+	}
+	if change == nil {
+		return false
+	}
+	return matchFx(r.ServiceNames, change.DataServiceName) &&
+		matchFx(r.Types, change.DataType) &&
+		matchFx(r.Actions, change.DataAction)
+}
+
+func (r *RegistrationFilter) ToParams() string {
+	const parameterf string = "%s=%s"
+	var parameters []string
+
+	if len(r.ServiceNames) > 0 {
+		parameters = append(parameters,
+			fmt.Sprintf(parameterf, ParameterServiceNames, strings.Join(r.ServiceNames, ",")))
+	}
+	if len(r.Types) > 0 {
+		parameters = append(parameters,
+			fmt.Sprintf(parameterf, ParameterTypes, strings.Join(r.Types, ",")))
+	}
+	if len(r.Actions) > 0 {
+		parameters = append(parameters,
+			fmt.Sprintf(parameterf, ParameterActions, strings.Join(r.Actions, ",")))
+	}
+	return "?" + strings.Join(parameters, "&")
+}
+
+func (r *RegistrationFilter) FromParams(params map[string][]string) {
+	for key, value := range params {
+		switch strings.ToLower(key) {
+		case ParameterServiceNames:
+			for _, value := range value {
+				if value != "" {
+					r.ServiceNames = append(r.ServiceNames, strings.Split(value, ",")...)
+				}
+			}
+		case ParameterTypes:
+			for _, value := range value {
+				if value != "" {
+					r.Types = append(r.Types, strings.Split(value, ",")...)
+				}
+			}
+		case ParameterActions:
+			for _, value := range value {
+				if value != "" {
+					r.Actions = append(r.Actions, strings.Split(value, ",")...)
+				}
+			}
+		}
+	}
+}
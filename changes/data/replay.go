@@ -0,0 +1,63 @@
+package data
+
+import "encoding/json"
+
+// these constants describe the status of a replay
+const (
+	ReplayStatusRunning   string = "running"
+	ReplayStatusStopped   string = "stopped"
+	ReplayStatusCompleted string = "completed"
+	ReplayStatusFailed    string = "failed"
+)
+
+type Replay struct {
+	// The ID of the registration the changes are replayed to
+	// example: timers
+	RegistrationId string `json:"registration_id"`
+
+	// The search used to find the changes to replay
+	Search ChangeSearch `json:"search"`
+
+	// The status of the replay
+	// example: running
+	Status string `json:"status"`
+
+	// The ID of the last change that was replayed, changes are replayed
+	// in the order they occured (when_changed, then id), a replay will
+	// resume with the changes after this one
+	// example: 86fa2f09-d260-11ec-bd5d-0242c0a8e002
+	LastChangeId string `json:"last_change_id,omitempty"`
+
+	// The time the last change that was replayed occured
+	// example: 1652417242000
+	LastWhenChanged int64 `json:"last_when_changed,string"`
+
+	// The number of changes that have been replayed
+	// example: 100
+	Replayed int `json:"replayed"`
+
+	// The error that caused the replay to fail
+	// example: registration not found
+	Error string `json:"error,omitempty"`
+}
+
+func (r *Replay) MarshalBinary() ([]byte, error) {
+	return json.Marshal(r)
+}
+
+func (r *Replay) UnmarshalBinary(bytes []byte) error {
+	return json.Unmarshal(bytes, r)
+}
+
+// After can be used to determine if a change occured after the
+// last change that was replayed
+func (r *Replay) After(change *Change) bool {
+	switch {
+	case r.LastChangeId == "":
+		return true
+	case change.WhenChanged != r.LastWhenChanged:
+		return change.WhenChanged > r.LastWhenChanged
+	default:
+		return change.Id > r.LastChangeId
+	}
+}
@@ -21,7 +21,9 @@ func (r *RequestAcknowledge) UnmarshalBinary(bytes []byte) error {
 }
 
 type RequestRegister struct {
-	RegistrationId string `json:"registration_id"`
+	RegistrationId string             `json:"registration_id"`
+	Filter         RegistrationFilter `json:"filter"`
+	Webhook        *Webhook           `json:"webhook,omitempty"`
 }
 
 func (r *RequestRegister) Type() MessageType {
@@ -35,3 +37,22 @@ func (r *RequestRegister) MarshalBinary() ([]byte, error) {
 func (r *RequestRegister) UnmarshalBinary(bytes []byte) error {
 	return json.Unmarshal(bytes, r)
 }
+
+type RequestReplay struct {
+	// The search used to find the changes to replay, since can be used
+	// to replay the changes that occured after a given time
+	Search ChangeSearch `json:"search"`
+
+	// The ID of a change, if provided, only the changes that occured
+	// after it will be replayed
+	// example: 86fa2f09-d260-11ec-bd5d-0242c0a8e002
+	AfterChangeId string `json:"after_change_id,omitempty"`
+}
+
+func (r *RequestReplay) MarshalBinary() ([]byte, error) {
+	return json.Marshal(r)
+}
+
+func (r *RequestReplay) UnmarshalBinary(bytes []byte) error {
+	return json.Unmarshal(bytes, r)
+}
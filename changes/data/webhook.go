@@ -0,0 +1,89 @@
+package data
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+)
+
+// HeaderWebhookSignature is the header that contains the signature of the
+// body of a webhook request, it's the hex encoded HMAC (SHA256) of the body
+// using the webhook's secret, prefixed with WebhookSignaturePrefix
+const (
+	HeaderWebhookSignature string = "X-Bludgeon-Signature"
+	WebhookSignaturePrefix string = "sha256="
+)
+
+type Webhook struct {
+	// The ID of the registration the webhook belongs to
+	// example: timers
+	RegistrationId string `json:"registration_id"`
+
+	// The url that change digests will be posted to
+	// example: http://timers:8080/api/v1/changes/webhook
+	Url string `json:"url"`
+
+	// The secret used to sign the body of each request, if empty,
+	// requests aren't signed
+	// example: 2ad6e1c6b4e6
+	Secret string `json:"secret,omitempty"`
+}
+
+func (w *Webhook) MarshalBinary() ([]byte, error) {
+	return json.Marshal(w)
+}
+
+func (w *Webhook) UnmarshalBinary(bytes []byte) error {
+	return json.Unmarshal(bytes, w)
+}
+
+type WebhookStatus struct {
+	// The ID of the registration the webhook belongs to
+	// example: timers
+	RegistrationId string `json:"registration_id"`
+
+	// The url that change digests will be posted to
+	// example: http://timers:8080/api/v1/changes/webhook
+	Url string `json:"url"`
+
+	// The time of the last attempted delivery
+	// example: 1652417242000
+	LastAttempt int64 `json:"last_attempt,string"`
+
+	// The time of the last successful delivery
+	// example: 1652417242000
+	LastSuccess int64 `json:"last_success,string"`
+
+	// The error of the last attempted delivery, if it failed
+	// example: unexpected status code: 500
+	LastError string `json:"last_error,omitempty"`
+
+	// The number of consecutive failed deliveries
+	// example: 0
+	Failures int `json:"failures"`
+
+	// The time of the next attempted delivery (if backing off)
+	// example: 1652417242000
+	NextAttempt int64 `json:"next_attempt,string"`
+
+	// The number of changes delivered since the service started
+	// example: 10
+	Delivered int `json:"delivered"`
+}
+
+func (w *WebhookStatus) MarshalBinary() ([]byte, error) {
+	return json.Marshal(w)
+}
+
+func (w *WebhookStatus) UnmarshalBinary(bytes []byte) error {
+	return json.Unmarshal(bytes, w)
+}
+
+// WebhookSignature can be used to generate (or verify) the signature
+// of the body of a webhook request
+func WebhookSignature(secret string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	return WebhookSignaturePrefix + hex.EncodeToString(mac.Sum(nil))
+}
@@ -18,6 +18,7 @@ const (
 	MessageTypeRequestAcknowledge  MessageType = "request_acknowledge"
 	MessageTypeResponseRegister    MessageType = "response_register"
 	MessageTypeResponseAcknowledge MessageType = "response_acknowledge"
+	MessageTypeHandlerOverflow     MessageType = "handler_overflow"
 )
 
 type Empty struct{}
@@ -65,6 +66,12 @@ func FromWrapper(wrapper *Wrapper) (interface{}, error) {
 			return nil, err
 		}
 		return change, nil
+	case MessageTypeChangePartial:
+		changePartial := &ChangePartial{}
+		if err := changePartial.UnmarshalBinary(wrapper.Bytes); err != nil {
+			return nil, err
+		}
+		return changePartial, nil
 	case MessageTypeChangeDigest:
 		changeDigest := &ChangeDigest{}
 		if err := changeDigest.UnmarshalBinary(wrapper.Bytes); err != nil {
@@ -95,6 +102,12 @@ func FromWrapper(wrapper *Wrapper) (interface{}, error) {
 			return nil, err
 		}
 		return response, nil
+	case MessageTypeHandlerOverflow:
+		overflow := &HandlerOverflow{}
+		if err := overflow.UnmarshalBinary(wrapper.Bytes); err != nil {
+			return nil, err
+		}
+		return overflow, nil
 	}
 	return nil, errors.Errorf("unsupported type: %s", wrapper.Type)
 }
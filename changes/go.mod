@@ -4,7 +4,7 @@ go 1.19
 
 require (
 	github.com/antonio-alexander/go-bludgeon/healthcheck v1.0.3
-	github.com/antonio-alexander/go-bludgeon/internal v1.4.3
+	github.com/antonio-alexander/go-bludgeon/internal v1.8.0
 	github.com/antonio-alexander/go-queue v1.2.2
 	github.com/antonio-alexander/go-stash v1.0.2
 	github.com/go-sql-driver/mysql v1.7.0
@@ -12,6 +12,8 @@ require (
 	github.com/gorilla/websocket v1.5.0
 	github.com/pkg/errors v0.9.1
 	github.com/stretchr/testify v1.8.2
+	google.golang.org/grpc v1.53.0
+	google.golang.org/protobuf v1.28.1
 )
 
 require (
@@ -21,6 +23,7 @@ require (
 	github.com/eapache/go-xerial-snappy v0.0.0-20180814174437-776d5712da21 // indirect
 	github.com/eapache/queue v1.1.0 // indirect
 	github.com/gofrs/flock v0.8.1 // indirect
+	github.com/golang/protobuf v1.5.2 // indirect
 	github.com/golang/snappy v0.0.4 // indirect
 	github.com/gorilla/mux v1.8.0 // indirect
 	github.com/hashicorp/errwrap v1.0.0 // indirect
@@ -39,5 +42,7 @@ require (
 	golang.org/x/crypto v0.0.0-20220722155217-630584e8d5aa // indirect
 	golang.org/x/net v0.7.0 // indirect
 	golang.org/x/sys v0.5.0 // indirect
+	golang.org/x/text v0.7.0 // indirect
+	google.golang.org/genproto v0.0.0-20230110181048-76db0878b65f // indirect
 	gopkg.in/yaml.v3 v3.0.1 // indirect
 )
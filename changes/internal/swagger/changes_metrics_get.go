@@ -0,0 +1,25 @@
+package swagger
+
+import "github.com/antonio-alexander/go-bludgeon/changes/data"
+
+// swagger:route GET /changes/metrics changes get_changes_metrics
+// Reads the delivery metrics of each registration (how far behind it is) and
+// the metrics of each handler (websocket, gRPC or kafka).
+//
+//     Consumes:
+//     - application/json
+//
+//     Produces:
+//     - application/json
+//
+//     Schemes: http
+//
+// responses:
+//   200: ChangesMetricsGetResponseOk
+
+// This is the response for a successful metrics read
+// swagger:response ChangesMetricsGetResponseOk
+type ChangesMetricsGetResponseOk struct {
+	// in:body
+	Body data.Metrics
+}
@@ -0,0 +1,25 @@
+package swagger
+
+import "github.com/antonio-alexander/go-bludgeon/changes/data"
+
+// swagger:route GET /changes/prune changes get_changes_prune
+// Reads the report of the last time the retention policy was enforced.
+//
+//     Consumes:
+//     - application/json
+//
+//     Produces:
+//     - application/json
+//
+//     Schemes: http
+//
+// responses:
+//   200: ChangesPruneGetResponseOk
+
+// This is the report of what was removed the last time the retention
+// policy was enforced
+// swagger:response ChangesPruneGetResponseOk
+type ChangesPruneGetResponseOk struct {
+	// in:body
+	Body data.PruneReport
+}
@@ -0,0 +1,35 @@
+package swagger
+
+import (
+	"github.com/antonio-alexander/go-bludgeon/changes/data"
+	"github.com/antonio-alexander/go-bludgeon/internal/errors"
+)
+
+// swagger:route POST /changes/prune changes post_changes_prune
+// Enforces the retention policy immediately and reports what was removed.
+//
+//     Consumes:
+//     - application/json
+//
+//     Produces:
+//     - application/json
+//
+//     Schemes: http
+//
+// responses:
+//   200: ChangesPrunePostResponseOk
+//   500: ChangesPrunePostResponseError
+
+// This is the report of what was removed while enforcing the retention policy
+// swagger:response ChangesPrunePostResponseOk
+type ChangesPrunePostResponseOk struct {
+	// in:body
+	Body data.PruneReport
+}
+
+// This is the response when the retention policy couldn't be enforced
+// swagger:response ChangesPrunePostResponseError
+type ChangesPrunePostResponseError struct {
+	// in:body
+	Body errors.Error
+}
@@ -0,0 +1,42 @@
+package swagger
+
+import (
+	"github.com/antonio-alexander/go-bludgeon/changes/data"
+	"github.com/antonio-alexander/go-bludgeon/internal/errors"
+)
+
+// swagger:route GET /changes/registrations/{registration_id}/dead_letters registrations get_registrations_dead_letters
+// Reads the changes that were dead-lettered for a registration (delivered too many times without being acknowledged).
+//
+//     Consumes:
+//     - application/json
+//
+//     Produces:
+//     - application/json
+//
+//     Schemes: http
+//
+// responses:
+//   200: RegistrationDeadLettersGetResponseOk
+//   404: RegistrationDeadLettersGetResponseNotFound
+
+// This is the response for a successful registration dead letters read
+// swagger:response RegistrationDeadLettersGetResponseOk
+type RegistrationDeadLettersGetResponseOk struct {
+	// in:body
+	Body data.DeadLetterDigest
+}
+
+// This is the response when the registration isn't found
+// swagger:response RegistrationDeadLettersGetResponseNotFound
+type RegistrationDeadLettersGetResponseNotFound struct {
+	// in:body
+	Body errors.Error
+}
+
+// swagger:parameters get_registrations_dead_letters
+type RegistrationDeadLettersGetParams struct {
+	// The registration id
+	// in:path
+	RegistrationId string `json:"registration_id"`
+}
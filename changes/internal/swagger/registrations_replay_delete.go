@@ -0,0 +1,41 @@
+package swagger
+
+import (
+	"github.com/antonio-alexander/go-bludgeon/internal/errors"
+)
+
+// swagger:route DELETE /changes/registrations/{registration_id}/replay registrations delete_registrations_replay
+// Stops the replay of a registration, it can be resumed later.
+//
+//     Consumes:
+//     - application/json
+//
+//     Produces:
+//     - application/json
+//
+//     Schemes: http
+//
+// responses:
+//   200: RegistrationReplayDeleteResponseOK
+//   404: RegistrationReplayDeleteResponseNotFound
+
+// When a replay is successfully stopped, no content is returned
+// swagger:response RegistrationReplayDeleteResponseOK
+type RegistrationReplayDeleteResponseOK struct {
+	// in:body
+	Body struct{}
+}
+
+// This is the response when the replay isn't found
+// swagger:response RegistrationReplayDeleteResponseNotFound
+type RegistrationReplayDeleteResponseNotFound struct {
+	// in:body
+	Body errors.Error
+}
+
+// swagger:parameters delete_registrations_replay
+type RegistrationReplayDeleteParams struct {
+	// The registration id
+	// in:path
+	RegistrationId string `json:"registration_id"`
+}
@@ -0,0 +1,42 @@
+package swagger
+
+import (
+	"github.com/antonio-alexander/go-bludgeon/changes/data"
+	"github.com/antonio-alexander/go-bludgeon/internal/errors"
+)
+
+// swagger:route GET /changes/registrations/{registration_id}/replay registrations get_registrations_replay
+// Reads the status and progress of the replay of a registration.
+//
+//     Consumes:
+//     - application/json
+//
+//     Produces:
+//     - application/json
+//
+//     Schemes: http
+//
+// responses:
+//   200: RegistrationReplayGetResponseOk
+//   404: RegistrationReplayGetResponseNotFound
+
+// This is the response for a successful registration replay read
+// swagger:response RegistrationReplayGetResponseOk
+type RegistrationReplayGetResponseOk struct {
+	// in:body
+	Body data.Replay
+}
+
+// This is the response when the replay isn't found
+// swagger:response RegistrationReplayGetResponseNotFound
+type RegistrationReplayGetResponseNotFound struct {
+	// in:body
+	Body errors.Error
+}
+
+// swagger:parameters get_registrations_replay
+type RegistrationReplayGetParams struct {
+	// The registration id
+	// in:path
+	RegistrationId string `json:"registration_id"`
+}
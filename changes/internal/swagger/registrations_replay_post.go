@@ -0,0 +1,46 @@
+package swagger
+
+import (
+	"github.com/antonio-alexander/go-bludgeon/changes/data"
+	"github.com/antonio-alexander/go-bludgeon/internal/errors"
+)
+
+// swagger:route POST /changes/registrations/{registration_id}/replay registrations post_registrations_replay
+// Starts a replay of historical changes to a registration, the changes that match both the search and the registration's filter are attached in batches (throttled). Starting a replay replaces the existing replay (if any).
+//
+//     Consumes:
+//     - application/json
+//
+//     Produces:
+//     - application/json
+//
+//     Schemes: http
+//
+// responses:
+//   200: RegistrationReplayPostResponseOk
+//   404: RegistrationReplayPostResponseNotFound
+
+// This is the response when a replay is successfully started
+// swagger:response RegistrationReplayPostResponseOk
+type RegistrationReplayPostResponseOk struct {
+	// in:body
+	Body data.Replay
+}
+
+// This is the response when the registration (or the after change) isn't found
+// swagger:response RegistrationReplayPostResponseNotFound
+type RegistrationReplayPostResponseNotFound struct {
+	// in:body
+	Body errors.Error
+}
+
+// swagger:parameters post_registrations_replay
+type RegistrationReplayPostParams struct {
+	// The registration id
+	// in:path
+	RegistrationId string `json:"registration_id"`
+
+	// The search used to find the changes to replay
+	// in: body
+	Body data.RequestReplay
+}
@@ -0,0 +1,42 @@
+package swagger
+
+import (
+	"github.com/antonio-alexander/go-bludgeon/changes/data"
+	"github.com/antonio-alexander/go-bludgeon/internal/errors"
+)
+
+// swagger:route PUT /changes/registrations/{registration_id}/replay/resume registrations put_registrations_replay_resume
+// Resumes the replay of a registration from the last change that was replayed.
+//
+//     Consumes:
+//     - application/json
+//
+//     Produces:
+//     - application/json
+//
+//     Schemes: http
+//
+// responses:
+//   200: RegistrationReplayResumePutResponseOk
+//   404: RegistrationReplayResumePutResponseNotFound
+
+// This is the response when a replay is successfully resumed
+// swagger:response RegistrationReplayResumePutResponseOk
+type RegistrationReplayResumePutResponseOk struct {
+	// in:body
+	Body data.Replay
+}
+
+// This is the response when the replay isn't found
+// swagger:response RegistrationReplayResumePutResponseNotFound
+type RegistrationReplayResumePutResponseNotFound struct {
+	// in:body
+	Body errors.Error
+}
+
+// swagger:parameters put_registrations_replay_resume
+type RegistrationReplayResumePutParams struct {
+	// The registration id
+	// in:path
+	RegistrationId string `json:"registration_id"`
+}
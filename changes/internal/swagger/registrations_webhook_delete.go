@@ -0,0 +1,41 @@
+package swagger
+
+import (
+	"github.com/antonio-alexander/go-bludgeon/internal/errors"
+)
+
+// swagger:route DELETE /changes/registrations/{registration_id}/webhook registrations delete_registrations_webhook
+// Deletes the webhook associated with a registration, the registration itself isn't deleted.
+//
+//     Consumes:
+//     - application/json
+//
+//     Produces:
+//     - application/json
+//
+//     Schemes: http
+//
+// responses:
+//   200: RegistrationWebhookDeleteResponseOK
+//   404: RegistrationWebhookDeleteResponseNotFound
+
+// When a webhook is successfully deleted, no content is returned
+// swagger:response RegistrationWebhookDeleteResponseOK
+type RegistrationWebhookDeleteResponseOK struct {
+	// in:body
+	Body struct{}
+}
+
+// This is the response when the webhook isn't found
+// swagger:response RegistrationWebhookDeleteResponseNotFound
+type RegistrationWebhookDeleteResponseNotFound struct {
+	// in:body
+	Body errors.Error
+}
+
+// swagger:parameters delete_registrations_webhook
+type RegistrationWebhookDeleteParams struct {
+	// The registration id
+	// in:path
+	RegistrationId string `json:"registration_id"`
+}
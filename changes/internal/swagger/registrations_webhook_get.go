@@ -0,0 +1,42 @@
+package swagger
+
+import (
+	"github.com/antonio-alexander/go-bludgeon/changes/data"
+	"github.com/antonio-alexander/go-bludgeon/internal/errors"
+)
+
+// swagger:route GET /changes/registrations/{registration_id}/webhook registrations get_registrations_webhook
+// Reads the delivery status of the webhook associated with a registration.
+//
+//     Consumes:
+//     - application/json
+//
+//     Produces:
+//     - application/json
+//
+//     Schemes: http
+//
+// responses:
+//   200: RegistrationWebhookGetResponseOk
+//   404: RegistrationWebhookGetResponseNotFound
+
+// This is the response for a successful registration webhook read
+// swagger:response RegistrationWebhookGetResponseOk
+type RegistrationWebhookGetResponseOk struct {
+	// in:body
+	Body data.WebhookStatus
+}
+
+// This is the response when the webhook isn't found
+// swagger:response RegistrationWebhookGetResponseNotFound
+type RegistrationWebhookGetResponseNotFound struct {
+	// in:body
+	Body errors.Error
+}
+
+// swagger:parameters get_registrations_webhook
+type RegistrationWebhookGetParams struct {
+	// The registration id
+	// in:path
+	RegistrationId string `json:"registration_id"`
+}
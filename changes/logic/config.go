@@ -0,0 +1,240 @@
+package logic
+
+import (
+	"errors"
+	"strconv"
+	"time"
+
+	data "github.com/antonio-alexander/go-bludgeon/changes/data"
+)
+
+const (
+	WebhookRateLessOrEqualToZero            string = "webhook rate less or equal to zero"
+	WebhookTimeoutLessOrEqualToZero         string = "webhook timeout less or equal to zero"
+	WebhookRetryMinLessOrEqualToZero        string = "webhook retry minimum less or equal to zero"
+	WebhookRetryMaxLessThanMin              string = "webhook retry maximum less than minimum"
+	VisibilityTimeoutLessThanZero           string = "visibility timeout less than zero"
+	MaxDeliveryAttemptsLessThanZero         string = "max delivery attempts less than zero"
+	ReplayRateLessOrEqualToZero             string = "replay rate less or equal to zero"
+	ReplayBatchSizeLessOrEqualToZero        string = "replay batch size less or equal to zero"
+	RetentionMaxAgeLessThanZero             string = "retention max age less than zero"
+	RetentionMaxPendingLessThanZero         string = "retention max pending less than zero"
+	RegistrationExpiryLessThanZero          string = "registration expiry less than zero"
+	PruneRateLessOrEqualToZero              string = "prune rate less or equal to zero"
+	HandlerOverflowNotSupported             string = "handler overflow strategy not supported"
+	HandlerOverflowTimeoutLessOrEqualToZero string = "handler overflow timeout less or equal to zero"
+)
+
+const (
+	EnvNameWebhookRate            string = "BLUDGEON_WEBHOOK_RATE"
+	EnvNameWebhookTimeout         string = "BLUDGEON_WEBHOOK_TIMEOUT"
+	EnvNameWebhookRetryMin        string = "BLUDGEON_WEBHOOK_RETRY_MIN"
+	EnvNameWebhookRetryMax        string = "BLUDGEON_WEBHOOK_RETRY_MAX"
+	EnvNameVisibilityTimeout      string = "BLUDGEON_CHANGES_VISIBILITY_TIMEOUT"
+	EnvNameMaxDeliveryAttempts    string = "BLUDGEON_CHANGES_MAX_DELIVERY_ATTEMPTS"
+	EnvNameReplayRate             string = "BLUDGEON_REPLAY_RATE"
+	EnvNameReplayBatchSize        string = "BLUDGEON_REPLAY_BATCH_SIZE"
+	EnvNameRetentionMaxAge        string = "BLUDGEON_CHANGES_RETENTION_MAX_AGE"
+	EnvNameRetentionMaxPending    string = "BLUDGEON_CHANGES_RETENTION_MAX_PENDING"
+	EnvNameRegistrationExpiry     string = "BLUDGEON_REGISTRATION_EXPIRY"
+	EnvNamePruneRate              string = "BLUDGEON_PRUNE_RATE"
+	EnvNameHandlerOverflow        string = "BLUDGEON_HANDLER_OVERFLOW"
+	EnvNameHandlerOverflowTimeout string = "BLUDGEON_HANDLER_OVERFLOW_TIMEOUT"
+)
+
+const (
+	DefaultWebhookRate            time.Duration         = 10 * time.Second
+	DefaultWebhookTimeout         time.Duration         = 10 * time.Second
+	DefaultWebhookRetryMin        time.Duration         = time.Second
+	DefaultWebhookRetryMax        time.Duration         = 5 * time.Minute
+	DefaultVisibilityTimeout      time.Duration         = 30 * time.Second
+	DefaultMaxDeliveryAttempts    int                   = 10
+	DefaultReplayRate             time.Duration         = time.Second
+	DefaultReplayBatchSize        int                   = 100
+	DefaultRetentionMaxAge        time.Duration         = 0
+	DefaultRetentionMaxPending    int                   = 0
+	DefaultRegistrationExpiry     time.Duration         = 0
+	DefaultPruneRate              time.Duration         = time.Hour
+	DefaultHandlerOverflow        data.OverflowStrategy = data.OverflowDropNewest
+	DefaultHandlerOverflowTimeout time.Duration         = time.Second
+)
+
+var (
+	ErrWebhookRateLessOrEqualToZero            = errors.New(WebhookRateLessOrEqualToZero)
+	ErrWebhookTimeoutLessOrEqualToZero         = errors.New(WebhookTimeoutLessOrEqualToZero)
+	ErrWebhookRetryMinLessOrEqualToZero        = errors.New(WebhookRetryMinLessOrEqualToZero)
+	ErrWebhookRetryMaxLessThanMin              = errors.New(WebhookRetryMaxLessThanMin)
+	ErrVisibilityTimeoutLessThanZero           = errors.New(VisibilityTimeoutLessThanZero)
+	ErrMaxDeliveryAttemptsLessThanZero         = errors.New(MaxDeliveryAttemptsLessThanZero)
+	ErrReplayRateLessOrEqualToZero             = errors.New(ReplayRateLessOrEqualToZero)
+	ErrReplayBatchSizeLessOrEqualToZero        = errors.New(ReplayBatchSizeLessOrEqualToZero)
+	ErrRetentionMaxAgeLessThanZero             = errors.New(RetentionMaxAgeLessThanZero)
+	ErrRetentionMaxPendingLessThanZero         = errors.New(RetentionMaxPendingLessThanZero)
+	ErrRegistrationExpiryLessThanZero          = errors.New(RegistrationExpiryLessThanZero)
+	ErrPruneRateLessOrEqualToZero              = errors.New(PruneRateLessOrEqualToZero)
+	ErrHandlerOverflowNotSupported             = errors.New(HandlerOverflowNotSupported)
+	ErrHandlerOverflowTimeoutLessOrEqualToZero = errors.New(HandlerOverflowTimeoutLessOrEqualToZero)
+)
+
+type Configuration struct {
+	WebhookRate     time.Duration `json:"webhook_rate"`
+	WebhookTimeout  time.Duration `json:"webhook_timeout"`
+	WebhookRetryMin time.Duration `json:"webhook_retry_min"`
+	WebhookRetryMax time.Duration `json:"webhook_retry_max"`
+
+	//VisibilityTimeout is how long changes read for a registration are
+	// hidden from subsequent reads, if zero, changes aren't leased
+	VisibilityTimeout time.Duration `json:"visibility_timeout"`
+
+	//MaxDeliveryAttempts is the number of times a change is read for a
+	// registration before it's dead-lettered, if zero, it's unlimited
+	MaxDeliveryAttempts int `json:"max_delivery_attempts"`
+
+	//ReplayRate is how often a batch of changes is replayed to a
+	// registration, ReplayBatchSize is the size of each batch
+	ReplayRate      time.Duration `json:"replay_rate"`
+	ReplayBatchSize int           `json:"replay_batch_size"`
+
+	//RetentionMaxAge is how long a change is kept (even if it hasn't been
+	// acknowledged), if zero, changes are kept until they're acknowledged
+	RetentionMaxAge time.Duration `json:"retention_max_age"`
+
+	//RetentionMaxPending is the maximum number of unacknowledged changes kept
+	// for each registration (the oldest are removed first), if zero, it's unlimited
+	RetentionMaxPending int `json:"retention_max_pending"`
+
+	//RegistrationExpiry is how long a registration can go without being seen
+	// (upserted, read or acknowledged) before it's deleted, if zero, registrations
+	// don't expire
+	RegistrationExpiry time.Duration `json:"registration_expiry"`
+
+	//PruneRate is how often the retention policy is enforced
+	PruneRate time.Duration `json:"prune_rate"`
+
+	//HandlerOverflow is the strategy used when a handler's queue is full and
+	// the handler didn't provide one, HandlerOverflowTimeout is how long to wait
+	// for room when blocking (the environment variable is in milliseconds)
+	HandlerOverflow        data.OverflowStrategy `json:"handler_overflow"`
+	HandlerOverflowTimeout time.Duration         `json:"handler_overflow_timeout"`
+}
+
+func (c *Configuration) Default() {
+	c.WebhookRate = DefaultWebhookRate
+	c.WebhookTimeout = DefaultWebhookTimeout
+	c.WebhookRetryMin = DefaultWebhookRetryMin
+	c.WebhookRetryMax = DefaultWebhookRetryMax
+	c.VisibilityTimeout = DefaultVisibilityTimeout
+	c.MaxDeliveryAttempts = DefaultMaxDeliveryAttempts
+	c.ReplayRate = DefaultReplayRate
+	c.ReplayBatchSize = DefaultReplayBatchSize
+	c.RetentionMaxAge = DefaultRetentionMaxAge
+	c.RetentionMaxPending = DefaultRetentionMaxPending
+	c.RegistrationExpiry = DefaultRegistrationExpiry
+	c.PruneRate = DefaultPruneRate
+	c.HandlerOverflow = DefaultHandlerOverflow
+	c.HandlerOverflowTimeout = DefaultHandlerOverflowTimeout
+}
+
+func (c *Configuration) Validate() (err error) {
+	if c.WebhookRate <= 0 {
+		return ErrWebhookRateLessOrEqualToZero
+	}
+	if c.WebhookTimeout <= 0 {
+		return ErrWebhookTimeoutLessOrEqualToZero
+	}
+	if c.WebhookRetryMin <= 0 {
+		return ErrWebhookRetryMinLessOrEqualToZero
+	}
+	if c.WebhookRetryMax < c.WebhookRetryMin {
+		return ErrWebhookRetryMaxLessThanMin
+	}
+	if c.VisibilityTimeout < 0 {
+		return ErrVisibilityTimeoutLessThanZero
+	}
+	if c.MaxDeliveryAttempts < 0 {
+		return ErrMaxDeliveryAttemptsLessThanZero
+	}
+	if c.ReplayRate <= 0 {
+		return ErrReplayRateLessOrEqualToZero
+	}
+	if c.ReplayBatchSize <= 0 {
+		return ErrReplayBatchSizeLessOrEqualToZero
+	}
+	if c.RetentionMaxAge < 0 {
+		return ErrRetentionMaxAgeLessThanZero
+	}
+	if c.RetentionMaxPending < 0 {
+		return ErrRetentionMaxPendingLessThanZero
+	}
+	if c.RegistrationExpiry < 0 {
+		return ErrRegistrationExpiryLessThanZero
+	}
+	if c.PruneRate <= 0 {
+		return ErrPruneRateLessOrEqualToZero
+	}
+	if c.HandlerOverflow == "" || !c.HandlerOverflow.Valid() {
+		return ErrHandlerOverflowNotSupported
+	}
+	if c.HandlerOverflowTimeout <= 0 {
+		return ErrHandlerOverflowTimeoutLessOrEqualToZero
+	}
+	return
+}
+
+func (c *Configuration) FromEnv(envs map[string]string) {
+	if s, ok := envs[EnvNameWebhookRate]; ok && s != "" {
+		i, _ := strconv.ParseInt(s, 10, 64)
+		c.WebhookRate = time.Duration(i) * time.Second
+	}
+	if s, ok := envs[EnvNameWebhookTimeout]; ok && s != "" {
+		i, _ := strconv.ParseInt(s, 10, 64)
+		c.WebhookTimeout = time.Duration(i) * time.Second
+	}
+	if s, ok := envs[EnvNameWebhookRetryMin]; ok && s != "" {
+		i, _ := strconv.ParseInt(s, 10, 64)
+		c.WebhookRetryMin = time.Duration(i) * time.Second
+	}
+	if s, ok := envs[EnvNameWebhookRetryMax]; ok && s != "" {
+		i, _ := strconv.ParseInt(s, 10, 64)
+		c.WebhookRetryMax = time.Duration(i) * time.Second
+	}
+	if s, ok := envs[EnvNameVisibilityTimeout]; ok && s != "" {
+		i, _ := strconv.ParseInt(s, 10, 64)
+		c.VisibilityTimeout = time.Duration(i) * time.Second
+	}
+	if s, ok := envs[EnvNameMaxDeliveryAttempts]; ok && s != "" {
+		i, _ := strconv.Atoi(s)
+		c.MaxDeliveryAttempts = i
+	}
+	if s, ok := envs[EnvNameReplayRate]; ok && s != "" {
+		i, _ := strconv.ParseInt(s, 10, 64)
+		c.ReplayRate = time.Duration(i) * time.Second
+	}
+	if s, ok := envs[EnvNameReplayBatchSize]; ok && s != "" {
+		i, _ := strconv.Atoi(s)
+		c.ReplayBatchSize = i
+	}
+	if s, ok := envs[EnvNameRetentionMaxAge]; ok && s != "" {
+		i, _ := strconv.ParseInt(s, 10, 64)
+		c.RetentionMaxAge = time.Duration(i) * time.Second
+	}
+	if s, ok := envs[EnvNameRetentionMaxPending]; ok && s != "" {
+		i, _ := strconv.Atoi(s)
+		c.RetentionMaxPending = i
+	}
+	if s, ok := envs[EnvNameRegistrationExpiry]; ok && s != "" {
+		i, _ := strconv.ParseInt(s, 10, 64)
+		c.RegistrationExpiry = time.Duration(i) * time.Second
+	}
+	if s, ok := envs[EnvNamePruneRate]; ok && s != "" {
+		i, _ := strconv.ParseInt(s, 10, 64)
+		c.PruneRate = time.Duration(i) * time.Second
+	}
+	if s, ok := envs[EnvNameHandlerOverflow]; ok && s != "" {
+		c.HandlerOverflow = data.OverflowStrategy(s)
+	}
+	if s, ok := envs[EnvNameHandlerOverflowTimeout]; ok && s != "" {
+		i, _ := strconv.ParseInt(s, 10, 64)
+		c.HandlerOverflowTimeout = time.Duration(i) * time.Millisecond
+	}
+}
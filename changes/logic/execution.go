@@ -0,0 +1,71 @@
+package logic
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"time"
+
+	data "github.com/antonio-alexander/go-bludgeon/changes/data"
+
+	errors "github.com/pkg/errors"
+)
+
+// webhookBackoff can be used to determine how long to wait before
+// attempting to deliver to a webhook again, the backoff starts at the
+// minimum and doubles with each consecutive failure up to the maximum
+func webhookBackoff(failures int, min, max time.Duration) time.Duration {
+	backoff := min
+	for i := 1; i < failures && backoff < max; i++ {
+		backoff *= 2
+	}
+	if backoff > max {
+		return max
+	}
+	return backoff
+}
+
+// webhookPost can be used to post a change digest to a webhook, if the
+// webhook has a secret, the body is signed; an error is returned unless
+// the webhook responds with a 2xx status code
+func webhookPost(ctx context.Context, client *http.Client, webhook *data.Webhook, changeDigest *data.ChangeDigest) error {
+	body, err := changeDigest.MarshalBinary()
+	if err != nil {
+		return err
+	}
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, webhook.Url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	request.Header.Set("Content-Type", "application/json; charset=utf-8")
+	if webhook.Secret != "" {
+		request.Header.Set(data.HeaderWebhookSignature, data.WebhookSignature(webhook.Secret, body))
+	}
+	response, err := client.Do(request)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+	_, _ = io.Copy(io.Discard, response.Body)
+	if response.StatusCode < http.StatusOK || response.StatusCode >= http.StatusMultipleChoices {
+		return errors.Errorf("unexpected status code: %d", response.StatusCode)
+	}
+	return nil
+}
+
+// changeIdsUnique can be used to remove duplicate change ids while
+// maintaining their order
+func changeIdsUnique(changeIds []string) []string {
+	var changeIdsUnique []string
+
+	found := make(map[string]struct{}, len(changeIds))
+	for _, changeId := range changeIds {
+		if _, ok := found[changeId]; ok {
+			continue
+		}
+		found[changeId] = struct{}{}
+		changeIdsUnique = append(changeIdsUnique, changeId)
+	}
+	return changeIdsUnique
+}
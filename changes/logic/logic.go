@@ -2,12 +2,16 @@ package logic
 
 import (
 	"context"
+	"net/http"
+	"strings"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/antonio-alexander/go-bludgeon/changes/data"
 	"github.com/antonio-alexander/go-bludgeon/changes/meta"
 	"github.com/antonio-alexander/go-bludgeon/internal"
+	"github.com/antonio-alexander/go-bludgeon/internal/config"
 	"github.com/antonio-alexander/go-queue/finite"
 
 	healthcheckdata "github.com/antonio-alexander/go-bludgeon/healthcheck/data"
@@ -20,14 +24,132 @@ import (
 	errors "github.com/pkg/errors"
 )
 
+type handlerQueue interface {
+	goqueue.Enqueuer
+	goqueue.Dequeuer
+	goqueue.Event
+	goqueue.Owner
+	goqueue.Length
+	finite.EnqueueLossy
+	finite.Capacity
+}
+
 type handler struct {
-	stopper chan struct{}
-	queue   interface {
-		goqueue.Enqueuer
-		goqueue.Dequeuer
-		goqueue.Event
-		goqueue.Owner
+	stopper         chan struct{}
+	filter          data.RegistrationFilter
+	registrationId  string
+	overflow        data.OverflowStrategy
+	overflowTimeout time.Duration
+	overflows       *int64
+	overflowPending *handlerOverflow
+	blocked         *handlerBlocked
+	queue           handlerQueue
+}
+
+// handlerChange is a change queued for a handler and the context it's
+// handled with
+type handlerChange struct {
+	ctx    context.Context
+	change *data.Change
+}
+
+// changeContext is the context a change is handled with, it's done once the
+// logic is shutdown, but has the values of the context the change was upserted
+// with (e.g. trace ids) so they can be propagated by the handler
+type changeContext struct {
+	context.Context
+	values context.Context
+}
+
+func (c *changeContext) Value(key interface{}) interface{} {
+	if value := c.values.Value(key); value != nil {
+		return value
+	}
+	return c.Context.Value(key)
+}
+
+// handlerBlocked holds the changes that are waiting for room in the queue of a
+// handler using the block strategy, so the (synchronous) upsert isn't blocked;
+// it's bounded by the size of the queue
+type handlerBlocked struct {
+	sync.Mutex
+	signal  chan struct{}
+	changes []handlerChange
+}
+
+// enqueue will enqueue the change if there's room in the queue and no changes
+// are waiting (so changes stay in order), otherwise the change waits for room,
+// if too many changes are waiting, it will return true (overflow)
+func (h *handlerBlocked) enqueue(queue handlerQueue, item handlerChange) bool {
+	h.Lock()
+	defer h.Unlock()
+	if len(h.changes) == 0 {
+		if overflow := queue.Enqueue(item); !overflow {
+			return false
+		}
 	}
+	if len(h.changes) >= queue.Capacity() {
+		return true
+	}
+	h.changes = append(h.changes, item)
+	select {
+	default:
+	case h.signal <- struct{}{}:
+	}
+	return false
+}
+
+func (h *handlerBlocked) peek() (handlerChange, bool) {
+	h.Lock()
+	defer h.Unlock()
+	if len(h.changes) == 0 {
+		return handlerChange{}, false
+	}
+	return h.changes[0], true
+}
+
+func (h *handlerBlocked) pop() {
+	h.Lock()
+	defer h.Unlock()
+	if len(h.changes) > 0 {
+		h.changes = h.changes[1:]
+	}
+}
+
+// handlerOverflow is used to signal a handler that its queue overflowed,
+// overflows that occur before the handler is signaled are combined
+type handlerOverflow struct {
+	sync.Mutex
+	signal  chan struct{}
+	pending *data.HandlerOverflow
+}
+
+func (h *handlerOverflow) write(overflow *data.HandlerOverflow) {
+	h.Lock()
+	defer h.Unlock()
+	if h.pending == nil {
+		h.pending = overflow
+	} else {
+		h.pending.ChangeIds = append(h.pending.ChangeIds, overflow.ChangeIds...)
+		h.pending.Overflows = overflow.Overflows
+		h.pending.Disconnected = h.pending.Disconnected || overflow.Disconnected
+	}
+	select {
+	default:
+	case h.signal <- struct{}{}:
+	}
+}
+
+func (h *handlerOverflow) read() (overflow *data.HandlerOverflow) {
+	h.Lock()
+	defer h.Unlock()
+	overflow, h.pending = h.pending, nil
+	return
+}
+
+type replayer struct {
+	stopper chan struct{}
+	done    chan struct{}
 }
 
 type logic struct {
@@ -37,19 +159,34 @@ type logic struct {
 	meta.Change
 	meta.Registration
 	meta.RegistrationChange
-	ctx         context.Context
-	cancel      context.CancelFunc
-	handlersMux sync.RWMutex
-	handlers    map[string]handler
-	initialized bool
+	webhook            meta.Webhook
+	deadLetter         meta.DeadLetter
+	replay             meta.Replay
+	retention          meta.Retention
+	metrics            meta.Metrics
+	ctx                context.Context
+	cancel             context.CancelFunc
+	handlersMux        sync.RWMutex
+	handlers           map[string]handler
+	webhookClient      *http.Client
+	webhookSignal      chan struct{}
+	webhookStatusesMux sync.RWMutex
+	webhookStatuses    map[string]*data.WebhookStatus
+	replayersMux       sync.Mutex
+	replayers          map[string]*replayer
+	pruneMux           sync.Mutex
+	pruneReport        data.PruneReport
+	config             *Configuration
+	configured         bool
+	initialized        bool
 }
 
-func changeDequeue(queue goqueue.Dequeuer) (*data.Change, bool) {
+func changeDequeue(queue goqueue.Dequeuer) (handlerChange, bool) {
 	item, underflow := queue.Dequeue()
 	if underflow {
-		return nil, true
+		return handlerChange{}, true
 	}
-	change, _ := item.(*data.Change)
+	change, _ := item.(handlerChange)
 	return change, false
 }
 
@@ -61,40 +198,58 @@ func New() interface {
 	healthchecklogic.Logic
 	internal.Initializer
 	internal.Parameterizer
+	internal.Configurer
 } {
 	return &logic{
-		handlers: make(map[string]handler),
-		Logger:   logger.NewNullLogger(),
-		ctx:      context.Background(),
+		handlers:        make(map[string]handler),
+		Logger:          logger.NewNullLogger(),
+		ctx:             context.Background(),
+		webhookClient:   http.DefaultClient,
+		webhookSignal:   make(chan struct{}, 1),
+		webhookStatuses: make(map[string]*data.WebhookStatus),
+		replayers:       make(map[string]*replayer),
 	}
 }
 
-func (l *logic) handlersWrite(stopper chan struct{}, queue interface {
-	goqueue.Dequeuer
-	goqueue.Enqueuer
-	goqueue.Event
-	goqueue.Owner
-}) (handlerId string) {
+func (l *logic) handlersWrite(handler handler) (handlerId string) {
 	l.handlersMux.Lock()
 	defer l.handlersMux.Unlock()
 	handlerId = uuid.Must(uuid.NewRandom()).String()
-	l.handlers[handlerId] = handler{
-		stopper: stopper,
-		queue:   queue,
-	}
+	l.handlers[handlerId] = handler
 	return
 }
 
-func (l *logic) handlersRead() (handlers map[string]goqueue.Enqueuer) {
+func (l *logic) handlersRead() (handlers map[string]handler) {
 	l.handlersMux.RLock()
 	defer l.handlersMux.RUnlock()
-	handlers = make(map[string]goqueue.Enqueuer)
+	handlers = make(map[string]handler)
 	for handlerId, handler := range l.handlers {
-		handlers[handlerId] = handler.queue
+		handlers[handlerId] = handler
 	}
 	return
 }
 
+// handlersRegistrationIds can be used to read the (unique) registration ids
+// of the handlers that were created for a registration
+func (l *logic) handlersRegistrationIds() []string {
+	l.handlersMux.RLock()
+	defer l.handlersMux.RUnlock()
+	var registrationIds []string
+
+	found := make(map[string]struct{})
+	for _, handler := range l.handlers {
+		if handler.registrationId == "" {
+			continue
+		}
+		if _, ok := found[handler.registrationId]; ok {
+			continue
+		}
+		found[handler.registrationId] = struct{}{}
+		registrationIds = append(registrationIds, handler.registrationId)
+	}
+	return registrationIds
+}
+
 func (l *logic) handlersDelete(handlerId string) error {
 	l.handlersMux.Lock()
 	defer l.handlersMux.Unlock()
@@ -112,7 +267,7 @@ func (l *logic) handlersDelete(handlerId string) error {
 	return nil
 }
 
-func (l *logic) launchHandler(handlerId string, handleFx HandlerFx, stopper chan struct{}, queue interface {
+func (l *logic) launchHandler(handlerId string, handleFx HandlerFx, overflowFx OverflowFx, stopper chan struct{}, overflow *handlerOverflow, queue interface {
 	goqueue.Dequeuer
 	goqueue.Event
 	goqueue.Owner
@@ -130,18 +285,28 @@ func (l *logic) launchHandler(handlerId string, handleFx HandlerFx, stopper chan
 			}
 		}()
 
+		signalOverflow := func() {
+			if pending := overflow.read(); pending != nil && overflowFx != nil {
+				overflowFx(l.ctx, handlerId, pending)
+			}
+		}
 		signalIn := queue.GetSignalIn()
 		close(started)
 		l.Debug(logAlias+"started handler: %s", handlerId)
 		for {
 			select {
 			case <-stopper:
+				//KIM: if the handler was disconnected because its queue
+				// overflowed, it's signaled before it stops
+				signalOverflow()
 				return
 			case <-l.ctx.Done():
 				return
+			case <-overflow.signal:
+				signalOverflow()
 			case <-signalIn:
-				if change, underflow := changeDequeue(queue); !underflow {
-					handleFx(l.ctx, handlerId, []*data.Change{change})
+				if item, underflow := changeDequeue(queue); !underflow {
+					handleFx(item.ctx, handlerId, []*data.Change{item.change})
 				}
 			}
 		}
@@ -149,19 +314,461 @@ func (l *logic) launchHandler(handlerId string, handleFx HandlerFx, stopper chan
 	<-started
 }
 
-func (l *logic) changeBroadcast(change *data.Change) {
+// launchHandlerBlocked will enqueue the changes waiting for room in the queue of a
+// handler using the block strategy (in order), if there isn't room before the
+// timeout elapses, the change overflows
+func (l *logic) launchHandlerBlocked(handlerId string, handler handler) {
+	started := make(chan struct{})
+	l.Add(1)
+	go func() {
+		defer l.Done()
+
+		close(started)
+		for {
+			select {
+			case <-handler.stopper:
+				return
+			case <-l.ctx.Done():
+				return
+			case <-handler.blocked.signal:
+				for item, ok := handler.blocked.peek(); ok; item, ok = handler.blocked.peek() {
+					if !l.changeEnqueueBlock(handler, item) {
+						select {
+						case <-handler.stopper:
+							return
+						case <-l.ctx.Done():
+							return
+						default:
+						}
+						l.handlerOverflowWrite(handlerId, handler, []string{item.change.Id}, false)
+					} else {
+						l.Trace(logAlias+"broadcasted change %s to %s", item.change.Id, handlerId)
+					}
+					handler.blocked.pop()
+				}
+			}
+		}
+	}()
+	<-started
+}
+
+func (l *logic) changeBroadcast(ctx context.Context, change *data.Change) {
 	handlers := l.handlersRead()
 	if len(handlers) == 0 {
 		l.Debug(logAlias + "received change to broadcast, but no handlers")
 		return
 	}
-	for handlerId, queue := range l.handlersRead() {
-		if overflow := queue.Enqueue(change); overflow {
-			l.Trace(logAlias+"overflow while attempting to broadcast change %s to %s", change.Id, handlerId)
+	item := handlerChange{
+		ctx:    &changeContext{Context: l.ctx, values: ctx},
+		change: change,
+	}
+	for handlerId, handler := range handlers {
+		if !handler.filter.Match(change) {
+			l.Trace(logAlias+"filtered change %s from %s", change.Id, handlerId)
+			continue
+		}
+		if handler.blocked != nil {
+			//KIM: changes that are waiting for room in the queue are enqueued
+			// by the handler (so the upsert isn't blocked)
+			if overflow := handler.blocked.enqueue(handler.queue, item); !overflow {
+				l.Trace(logAlias+"broadcasted change %s to %s", change.Id, handlerId)
+				continue
+			}
+		} else if overflow := handler.queue.Enqueue(item); !overflow {
+			l.Trace(logAlias+"broadcasted change %s to %s", change.Id, handlerId)
+			continue
+		}
+		l.changeOverflow(handlerId, handler, item)
+	}
+}
+
+// changeEnqueueBlock will attempt to enqueue the change until there's room
+// in the handler's queue or the timeout elapses
+func (l *logic) changeEnqueueBlock(handler handler, item handlerChange) bool {
+	tTimeout := time.NewTimer(handler.overflowTimeout)
+	defer tTimeout.Stop()
+	signalOut := handler.queue.GetSignalOut()
+	for {
+		if overflow := handler.queue.Enqueue(item); !overflow {
+			return true
+		}
+		select {
+		case <-l.ctx.Done():
+			return false
+		case <-handler.stopper:
+			return false
+		case <-tTimeout.C:
+			return false
+		case <-signalOut:
+		}
+	}
+}
+
+// changeOverflow will handle a change that couldn't be queued using the
+// handler's overflow strategy and signal the handler
+func (l *logic) changeOverflow(handlerId string, handler handler, item handlerChange) {
+	var changeIds []string
+	var disconnected bool
+
+	change := item.change
+	switch handler.overflow {
+	default: //data.OverflowDropNewest
+		changeIds = []string{change.Id}
+	case data.OverflowDropOldest:
+		discardedItem, discarded := handler.queue.EnqueueLossy(item)
+		if !discarded {
+			l.Trace(logAlias+"broadcasted change %s to %s", change.Id, handlerId)
+			return
+		}
+		if discardedChange, ok := discardedItem.(handlerChange); ok {
+			changeIds = []string{discardedChange.change.Id}
+		}
+	case data.OverflowBlock:
+		//KIM: too many changes are waiting for room in the queue
+		changeIds = []string{change.Id}
+	case data.OverflowDisconnect:
+		changeIds, disconnected = []string{change.Id}, true
+	}
+	l.handlerOverflowWrite(handlerId, handler, changeIds, disconnected)
+}
+
+// handlerOverflowWrite will signal the handler that the changes overflowed and
+// disconnect the handler (if applicable)
+func (l *logic) handlerOverflowWrite(handlerId string, handler handler, changeIds []string, disconnected bool) {
+	overflows := atomic.AddInt64(handler.overflows, 1)
+	l.Debug(logAlias+"overflow (%s) while attempting to broadcast change(s) %s to %s (%d overflow(s))",
+		handler.overflow, strings.Join(changeIds, ","), handlerId, overflows)
+	handler.overflowPending.write(&data.HandlerOverflow{
+		HandlerId:      handlerId,
+		RegistrationId: handler.registrationId,
+		Strategy:       handler.overflow,
+		ChangeIds:      changeIds,
+		Overflows:      overflows,
+		Disconnected:   disconnected,
+	})
+	if disconnected {
+		if err := l.handlersDelete(handlerId); err != nil {
+			l.Error(logAlias+"error while disconnecting handler %s: %s", handlerId, err)
+			return
+		}
+		l.Debug(logAlias+"disconnected handler %s", handlerId)
+	}
+}
+
+func (l *logic) webhookStatusRead(registrationId string) data.WebhookStatus {
+	l.webhookStatusesMux.RLock()
+	defer l.webhookStatusesMux.RUnlock()
+	if status, ok := l.webhookStatuses[registrationId]; ok {
+		return *status
+	}
+	return data.WebhookStatus{RegistrationId: registrationId}
+}
+
+// webhookStatusWrite will update the delivery status of the webhook, if the
+// delivery failed, the next attempt is pushed out using an exponential backoff
+func (l *logic) webhookStatusWrite(webhook *data.Webhook, delivered int, err error) {
+	l.webhookStatusesMux.Lock()
+	defer l.webhookStatusesMux.Unlock()
+
+	status, ok := l.webhookStatuses[webhook.RegistrationId]
+	if !ok {
+		status = &data.WebhookStatus{RegistrationId: webhook.RegistrationId}
+		l.webhookStatuses[webhook.RegistrationId] = status
+	}
+	tNow := time.Now()
+	status.Url = webhook.Url
+	status.LastAttempt = tNow.UnixNano()
+	if err != nil {
+		status.Failures++
+		status.LastError = err.Error()
+		status.NextAttempt = tNow.Add(webhookBackoff(status.Failures,
+			l.config.WebhookRetryMin, l.config.WebhookRetryMax)).UnixNano()
+		return
+	}
+	status.Failures, status.LastError, status.NextAttempt = 0, "", 0
+	status.LastSuccess = tNow.UnixNano()
+	status.Delivered += delivered
+}
+
+func (l *logic) webhookStatusDelete(registrationId string) {
+	l.webhookStatusesMux.Lock()
+	defer l.webhookStatusesMux.Unlock()
+	delete(l.webhookStatuses, registrationId)
+}
+
+func (l *logic) webhookSignalSend() {
+	select {
+	default:
+	case l.webhookSignal <- struct{}{}:
+	}
+}
+
+// webhookDispatch will post all of the changes for the webhook's registration
+// as a single digest; the changes are only acknowledged if the webhook responds
+// with a 2xx status code, otherwise they're retried at the next attempt
+func (l *logic) webhookDispatch(webhook *data.Webhook) {
+	changes, err := l.RegistrationChangesRead(l.ctx, webhook.RegistrationId)
+	if err != nil {
+		l.Error(logAlias+"error while reading changes for webhook %s: %s", webhook.RegistrationId, err)
+		return
+	}
+	if len(changes) == 0 {
+		return
+	}
+	ctx, cancel := context.WithTimeout(l.ctx, l.config.WebhookTimeout)
+	err = webhookPost(ctx, l.webhookClient, webhook, &data.ChangeDigest{Changes: changes})
+	cancel()
+	if err != nil {
+		l.webhookStatusWrite(webhook, 0, err)
+		l.Error(logAlias+"error while delivering %d change(s) to webhook %s: %s", len(changes), webhook.RegistrationId, err)
+		return
+	}
+	changeIds := make([]string, 0, len(changes))
+	for _, change := range changes {
+		changeIds = append(changeIds, change.Id)
+	}
+	//KIM: if the acknowledgement fails, the changes will be delivered
+	// again; webhooks should expect at-least-once delivery
+	if err := l.RegistrationChangeAcknowledge(l.ctx, webhook.RegistrationId, changeIds...); err != nil {
+		l.webhookStatusWrite(webhook, 0, err)
+		l.Error(logAlias+"error while acknowledging change(s) for webhook %s: %s", webhook.RegistrationId, err)
+		return
+	}
+	l.webhookStatusWrite(webhook, len(changes), nil)
+	l.Trace(logAlias+"delivered %d change(s) to webhook %s", len(changes), webhook.RegistrationId)
+}
+
+func (l *logic) webhooksDispatch() {
+	webhooks, err := l.webhook.WebhooksRead(l.ctx)
+	if err != nil {
+		l.Error(logAlias+"error while reading webhooks: %s", err)
+		return
+	}
+	tNow := time.Now().UnixNano()
+	for _, webhook := range webhooks {
+		if status := l.webhookStatusRead(webhook.RegistrationId); tNow < status.NextAttempt {
 			continue
 		}
-		l.Trace(logAlias+"broadcasted change %s to %s", change.Id, handlerId)
+		//KIM: webhooks are dispatched serially, a slow webhook will
+		// delay the others by at most the webhook timeout
+		l.webhookDispatch(webhook)
+	}
+}
+
+func (l *logic) launchWebhookDispatcher() {
+	started := make(chan struct{})
+	l.Add(1)
+	go func() {
+		defer l.Done()
+
+		tDispatch := time.NewTicker(l.config.WebhookRate)
+		defer tDispatch.Stop()
+		close(started)
+		for {
+			select {
+			case <-l.ctx.Done():
+				return
+			case <-tDispatch.C:
+			case <-l.webhookSignal:
+			}
+			l.webhooksDispatch()
+		}
+	}()
+	<-started
+}
+
+// replayBatch will attach the next batch of changes (the changes that occured
+// after the last change replayed) to the replay's registration, it returns true
+// once there are no changes left to replay
+func (l *logic) replayBatch(replay *data.Replay) (bool, error) {
+	changes, err := l.replay.ReplayChangesRead(l.ctx, *replay, l.config.ReplayBatchSize)
+	if err != nil {
+		return false, err
+	}
+	if len(changes) == 0 {
+		return true, nil
+	}
+	changeIds := make([]string, 0, len(changes))
+	for _, change := range changes {
+		changeIds = append(changeIds, change.Id)
+	}
+	if err := l.RegistrationChange.RegistrationChangesAttach(l.ctx, replay.RegistrationId, changeIds...); err != nil {
+		return false, err
+	}
+	lastChange := changes[len(changes)-1]
+	replay.LastChangeId, replay.LastWhenChanged = lastChange.Id, lastChange.WhenChanged
+	replay.Replayed += len(changeIds)
+	return len(changeIds) < l.config.ReplayBatchSize, nil
+}
+
+// launchReplay will replay the changes in batches (throttled by the replay rate)
+// until there are none left, the replay is stopped or logic is shutdown; progress
+// is written after each batch so the replay can be resumed
+func (l *logic) launchReplay(replay *data.Replay) {
+	r := &replayer{
+		stopper: make(chan struct{}),
+		done:    make(chan struct{}),
+	}
+	l.replayersMux.Lock()
+	l.replayers[replay.RegistrationId] = r
+	l.replayersMux.Unlock()
+	l.Add(1)
+	go func() {
+		defer l.Done()
+		defer close(r.done)
+		defer func() {
+			l.replayersMux.Lock()
+			defer l.replayersMux.Unlock()
+			if l.replayers[replay.RegistrationId] == r {
+				delete(l.replayers, replay.RegistrationId)
+			}
+		}()
+
+		tReplay := time.NewTicker(l.config.ReplayRate)
+		defer tReplay.Stop()
+		for {
+			done, err := l.replayBatch(replay)
+			if l.ctx.Err() != nil {
+				return
+			}
+			switch {
+			case err != nil:
+				replay.Status, replay.Error = data.ReplayStatusFailed, err.Error()
+				l.Error(logAlias+"error while replaying changes to %s: %s", replay.RegistrationId, err)
+			case done:
+				replay.Status = data.ReplayStatusCompleted
+				l.Debug(logAlias+"replayed %d change(s) to %s", replay.Replayed, replay.RegistrationId)
+			}
+			if err := l.replay.ReplayUpsert(l.ctx, *replay); err != nil {
+				l.Error(logAlias+"error while updating replay for %s: %s", replay.RegistrationId, err)
+			}
+			l.webhookSignalSend()
+			if replay.Status != data.ReplayStatusRunning {
+				return
+			}
+			select {
+			case <-l.ctx.Done():
+				return
+			case <-r.stopper:
+				replay.Status = data.ReplayStatusStopped
+				if err := l.replay.ReplayUpsert(l.ctx, *replay); err != nil {
+					l.Error(logAlias+"error while updating replay for %s: %s", replay.RegistrationId, err)
+				}
+				return
+			case <-tReplay.C:
+			}
+		}
+	}()
+}
+
+// replayerStop will stop the replay of the registration (if running) and
+// wait for it to exit, it returns true if a replay was stopped
+func (l *logic) replayerStop(registrationId string) bool {
+	l.replayersMux.Lock()
+	r, ok := l.replayers[registrationId]
+	delete(l.replayers, registrationId)
+	l.replayersMux.Unlock()
+	if !ok {
+		return false
+	}
+	close(r.stopper)
+	<-r.done
+	return true
+}
+
+// prune will enforce the retention policy: changes older than the max age are
+// expired, registrations that haven't been seen are expired, the pending changes
+// of each registration are trimmed and finally changes no longer referenced by
+// any registration are deleted
+func (l *logic) prune(ctx context.Context) (*data.PruneReport, error) {
+	l.pruneMux.Lock()
+	defer l.pruneMux.Unlock()
+	var changeIdsToPrune []string
+
+	report := &data.PruneReport{WhenPruned: time.Now().UnixNano()}
+	pruneFx := func() error {
+		if l.config.RetentionMaxAge > 0 {
+			changeIds, err := l.retention.ChangesExpire(ctx, l.config.RetentionMaxAge)
+			if err != nil {
+				return err
+			}
+			report.ChangesExpired = changeIds
+		}
+		if l.config.RegistrationExpiry > 0 {
+			//KIM: registrations with one or more handlers are connected, so
+			// they're seen even if they haven't received (or acknowledged)
+			// changes within the expiry
+			if registrationIds := l.handlersRegistrationIds(); len(registrationIds) > 0 {
+				if err := l.retention.RegistrationsSeen(ctx, registrationIds...); err != nil {
+					return err
+				}
+			}
+			registrationIds, changeIds, err := l.retention.RegistrationsExpire(ctx, l.config.RegistrationExpiry)
+			if err != nil {
+				return err
+			}
+			for _, registrationId := range registrationIds {
+				l.replayerStop(registrationId)
+				l.webhookStatusDelete(registrationId)
+			}
+			report.RegistrationsExpired = registrationIds
+			changeIdsToPrune = append(changeIdsToPrune, changeIds...)
+		}
+		if l.config.RetentionMaxPending > 0 {
+			changeIdsTrimmed, changeIds, err := l.retention.RegistrationChangesTrim(ctx, l.config.RetentionMaxPending)
+			if err != nil {
+				return err
+			}
+			if len(changeIdsTrimmed) > 0 {
+				report.ChangesTrimmed = changeIdsTrimmed
+			}
+			changeIdsToPrune = append(changeIdsToPrune, changeIds...)
+		}
+		if changeIdsToPrune = changeIdsUnique(changeIdsToPrune); len(changeIdsToPrune) > 0 {
+			if err := l.ChangesDelete(ctx, changeIdsToPrune...); err != nil {
+				return err
+			}
+			report.ChangesPruned = changeIdsToPrune
+		}
+		return nil
+	}
+	err := pruneFx()
+	if err != nil {
+		report.Error = err.Error()
 	}
+	l.pruneReport = *report
+	return report, err
+}
+
+// launchPruner will periodically enforce the retention policy, each
+// time something is removed, it's logged
+func (l *logic) launchPruner() {
+	started := make(chan struct{})
+	l.Add(1)
+	go func() {
+		defer l.Done()
+
+		tPrune := time.NewTicker(l.config.PruneRate)
+		defer tPrune.Stop()
+		close(started)
+		for {
+			select {
+			case <-l.ctx.Done():
+				return
+			case <-tPrune.C:
+			}
+			report, err := l.prune(l.ctx)
+			if err != nil {
+				l.Error(logAlias+"error while pruning: %s", err)
+			}
+			if len(report.RegistrationsExpired) > 0 || len(report.ChangesTrimmed) > 0 ||
+				len(report.ChangesPruned) > 0 || len(report.ChangesExpired) > 0 {
+				l.Info(logAlias+"pruned: %d registration(s) expired, %d registration(s) trimmed, %d change(s) pruned, %d change(s) expired",
+					len(report.RegistrationsExpired), len(report.ChangesTrimmed), len(report.ChangesPruned), len(report.ChangesExpired))
+			}
+		}
+	}()
+	<-started
 }
 
 func (l *logic) SetUtilities(parameters ...interface{}) {
@@ -180,10 +787,40 @@ func (l *logic) SetParameters(parameters ...interface{}) {
 			meta.Change
 			meta.Registration
 			meta.RegistrationChange
+			meta.Webhook
+			meta.DeadLetter
+			meta.Replay
+			meta.Retention
+			meta.Metrics
 		}:
 			l.Change = p
 			l.Registration = p
 			l.RegistrationChange = p
+			l.webhook = p
+			l.deadLetter = p
+			l.replay = p
+			l.retention = p
+			l.metrics = p
+		case interface {
+			meta.Change
+			meta.Registration
+			meta.RegistrationChange
+		}:
+			l.Change = p
+			l.Registration = p
+			l.RegistrationChange = p
+		case meta.Webhook:
+			l.webhook = p
+		case meta.DeadLetter:
+			l.deadLetter = p
+		case meta.Replay:
+			l.replay = p
+		case meta.Retention:
+			l.retention = p
+		case meta.Metrics:
+			l.metrics = p
+		case *http.Client:
+			l.webhookClient = p
 		case meta.Registration:
 			l.Registration = p
 		case meta.RegistrationChange:
@@ -199,7 +836,45 @@ func (l *logic) SetParameters(parameters ...interface{}) {
 		panic(PanicRegistrationChangeMetaNotSet)
 	case l.Change == nil:
 		panic(PanicChangeMetaNotSet)
+	case l.webhook == nil:
+		panic(PanicWebhookMetaNotSet)
+	case l.deadLetter == nil:
+		panic(PanicDeadLetterMetaNotSet)
+	case l.replay == nil:
+		panic(PanicReplayMetaNotSet)
+	case l.retention == nil:
+		panic(PanicRetentionMetaNotSet)
+	case l.metrics == nil:
+		panic(PanicMetricsMetaNotSet)
+	}
+}
+
+func (l *logic) Configure(items ...interface{}) error {
+	l.Lock()
+	defer l.Unlock()
+
+	var envs map[string]string
+	var c *Configuration
+
+	for _, item := range items {
+		switch v := item.(type) {
+		case config.Envs:
+			envs = v
+		case *Configuration:
+			c = v
+		}
+	}
+	if c == nil {
+		c = new(Configuration)
+		c.Default()
+		c.FromEnv(envs)
 	}
+	if err := c.Validate(); err != nil {
+		return err
+	}
+	l.config = c
+	l.configured = true
+	return nil
 }
 
 func (l *logic) Initialize() error {
@@ -208,7 +883,28 @@ func (l *logic) Initialize() error {
 	if l.initialized {
 		return errors.New("logic already initialized")
 	}
+	//KIM: configuration is optional, if logic hasn't been
+	// configured, the defaults are used
+	if !l.configured {
+		l.config = new(Configuration)
+		l.config.Default()
+	}
 	l.ctx, l.cancel = context.WithCancel(context.Background())
+	l.launchWebhookDispatcher()
+	l.launchPruner()
+	//KIM: replays that were running when logic was shutdown are
+	// resumed from the last change that was replayed
+	replays, err := l.replay.ReplaysRead(l.ctx)
+	if err != nil {
+		l.cancel()
+		l.Wait()
+		return err
+	}
+	for _, replay := range replays {
+		if replay.Status == data.ReplayStatusRunning {
+			l.launchReplay(replay)
+		}
+	}
 	l.initialized = true
 	l.Info(logAlias + "initialized")
 	return nil
@@ -238,12 +934,28 @@ func (l *logic) ChangeUpsert(ctx context.Context, changePartial data.ChangeParti
 		return nil, err
 	}
 	l.Trace(logAlias+"upserted change: %s", change.Id)
-	l.changeBroadcast(change)
+	l.changeBroadcast(ctx, change)
+	l.webhookSignalSend()
 	return change, nil
 }
 
+// RegistrationUpsert will create (or update) the registration, if a filter isn't
+// provided, the registration is for all changes
+func (l *logic) RegistrationUpsert(ctx context.Context, registrationId string, options ...RegistrationOption) error {
+	var registrationOptions RegistrationOptions
+
+	for _, option := range options {
+		option(&registrationOptions)
+	}
+	return l.Registration.RegistrationUpsert(ctx, registrationId, registrationOptions.Filter)
+}
+
+// RegistrationChangesRead will read the changes pending for the registration,
+// the changes are hidden from subsequent reads until the visibility timeout
+// elapses (or they're acknowledged)
 func (l *logic) RegistrationChangesRead(ctx context.Context, registrationId string) ([]*data.Change, error) {
-	changeIds, err := l.RegistrationChange.RegistrationChangesRead(ctx, registrationId)
+	changeIds, err := l.RegistrationChange.RegistrationChangesRead(ctx, registrationId,
+		l.config.VisibilityTimeout, l.config.MaxDeliveryAttempts)
 	if err != nil {
 		return nil, err
 	}
@@ -272,10 +984,169 @@ func (l *logic) RegistrationChangeAcknowledge(ctx context.Context, serviceName s
 	return nil
 }
 
-func (l *logic) HandlerCreate(ctx context.Context, handleFx HandlerFx) (string, error) {
-	stopper, queue := make(chan struct{}), finite.New(QueueSize)
-	handlerId := l.handlersWrite(stopper, queue)
-	l.launchHandler(handlerId, handleFx, stopper, queue)
+func (l *logic) RegistrationDelete(ctx context.Context, registrationId string) error {
+	l.replayerStop(registrationId)
+	if err := l.Registration.RegistrationDelete(ctx, registrationId); err != nil {
+		return err
+	}
+	l.webhookStatusDelete(registrationId)
+	return nil
+}
+
+func (l *logic) DeadLettersRead(ctx context.Context, registrationId string) ([]*data.DeadLetter, error) {
+	deadLetters, err := l.deadLetter.DeadLettersRead(ctx, registrationId)
+	if err != nil {
+		return nil, err
+	}
+	for _, deadLetter := range deadLetters {
+		change, err := l.ChangeRead(ctx, deadLetter.ChangeId)
+		if err != nil {
+			return nil, err
+		}
+		deadLetter.Change = change
+	}
+	return deadLetters, nil
+}
+
+func (l *logic) ReplayStart(ctx context.Context, registrationId string, request data.RequestReplay) (*data.Replay, error) {
+	replay := &data.Replay{
+		RegistrationId: registrationId,
+		Search:         request.Search,
+		Status:         data.ReplayStatusRunning,
+	}
+	if request.AfterChangeId != "" {
+		change, err := l.Change.ChangeRead(ctx, request.AfterChangeId)
+		if err != nil {
+			return nil, err
+		}
+		replay.LastChangeId, replay.LastWhenChanged = change.Id, change.WhenChanged
+	}
+	//KIM: starting a replay replaces the existing replay (if any)
+	l.replayerStop(registrationId)
+	if err := l.replay.ReplayUpsert(ctx, *replay); err != nil {
+		return nil, err
+	}
+	replayCopy := *replay
+	l.launchReplay(&replayCopy)
+	l.Trace(logAlias+"started replay for %s", registrationId)
+	return replay, nil
+}
+
+func (l *logic) ReplayResume(ctx context.Context, registrationId string) (*data.Replay, error) {
+	l.replayerStop(registrationId)
+	replay, err := l.replay.ReplayRead(ctx, registrationId)
+	if err != nil {
+		return nil, err
+	}
+	replay.Status, replay.Error = data.ReplayStatusRunning, ""
+	if err := l.replay.ReplayUpsert(ctx, *replay); err != nil {
+		return nil, err
+	}
+	replayCopy := *replay
+	l.launchReplay(&replayCopy)
+	l.Trace(logAlias+"resumed replay for %s after change %s", registrationId, replay.LastChangeId)
+	return replay, nil
+}
+
+func (l *logic) ReplayRead(ctx context.Context, registrationId string) (*data.Replay, error) {
+	return l.replay.ReplayRead(ctx, registrationId)
+}
+
+func (l *logic) ReplayStop(ctx context.Context, registrationId string) error {
+	if l.replayerStop(registrationId) {
+		l.Trace(logAlias+"stopped replay for %s", registrationId)
+		return nil
+	}
+	replay, err := l.replay.ReplayRead(ctx, registrationId)
+	if err != nil {
+		return err
+	}
+	//KIM: a replay can be running without a replayer if logic
+	// hasn't been initialized (it hasn't been resumed)
+	if replay.Status == data.ReplayStatusRunning {
+		replay.Status = data.ReplayStatusStopped
+		return l.replay.ReplayUpsert(ctx, *replay)
+	}
+	return nil
+}
+
+func (l *logic) Prune(ctx context.Context) (*data.PruneReport, error) {
+	report, err := l.prune(ctx)
+	if err != nil {
+		return nil, err
+	}
+	l.Trace(logAlias + "pruned")
+	return report, nil
+}
+
+func (l *logic) PruneReportRead(ctx context.Context) (*data.PruneReport, error) {
+	l.pruneMux.Lock()
+	defer l.pruneMux.Unlock()
+	report := l.pruneReport
+	return &report, nil
+}
+
+func (l *logic) WebhookUpsert(ctx context.Context, webhook data.Webhook) error {
+	if err := l.webhook.WebhookUpsert(ctx, webhook); err != nil {
+		return err
+	}
+	l.Trace(logAlias+"upserted webhook for %s", webhook.RegistrationId)
+	l.webhookSignalSend()
+	return nil
+}
+
+func (l *logic) WebhookStatusRead(ctx context.Context, registrationId string) (*data.WebhookStatus, error) {
+	webhook, err := l.webhook.WebhookRead(ctx, registrationId)
+	if err != nil {
+		return nil, err
+	}
+	status := l.webhookStatusRead(registrationId)
+	status.Url = webhook.Url
+	return &status, nil
+}
+
+func (l *logic) WebhookDelete(ctx context.Context, registrationId string) error {
+	if err := l.webhook.WebhookDelete(ctx, registrationId); err != nil {
+		return err
+	}
+	l.webhookStatusDelete(registrationId)
+	l.Trace(logAlias+"deleted webhook for %s", registrationId)
+	return nil
+}
+
+func (l *logic) HandlerCreate(ctx context.Context, handleFx HandlerFx, options ...HandlerOption) (string, error) {
+	var handlerOptions HandlerOptions
+
+	for _, option := range options {
+		option(&handlerOptions)
+	}
+	if !handlerOptions.Overflow.Valid() {
+		return "", ErrHandlerOverflowNotSupported
+	}
+	handler := handler{
+		stopper:         make(chan struct{}),
+		filter:          handlerOptions.Filter,
+		registrationId:  handlerOptions.RegistrationId,
+		overflow:        handlerOptions.Overflow,
+		overflowTimeout: time.Duration(handlerOptions.OverflowTimeout) * time.Millisecond,
+		overflows:       new(int64),
+		overflowPending: &handlerOverflow{signal: make(chan struct{}, 1)},
+		queue:           finite.New(QueueSize),
+	}
+	if handler.overflow == "" {
+		handler.overflow = l.config.HandlerOverflow
+	}
+	if handler.overflowTimeout <= 0 {
+		handler.overflowTimeout = l.config.HandlerOverflowTimeout
+	}
+	if handler.overflow == data.OverflowBlock {
+		handler.blocked = &handlerBlocked{signal: make(chan struct{}, 1)}
+	}
+	handlerId := l.handlersWrite(handler)
+	l.launchHandler(handlerId, handleFx, handlerOptions.OverflowFx, handler.stopper, handler.overflowPending, handler.queue)
+	if handler.blocked != nil {
+		l.launchHandlerBlocked(handlerId, handler)
+	}
 	l.Trace(logAlias+"created handler: %s", handlerId)
 	return handlerId, nil
 }
@@ -288,6 +1159,35 @@ func (l *logic) HandlerDelete(ctx context.Context, handlerId string) error {
 	return nil
 }
 
+// MetricsRead will read the delivery metrics of each registration and the
+// metrics of each handler, a registration is connected if one or more handlers
+// were created for it
+func (l *logic) MetricsRead(ctx context.Context) (*data.Metrics, error) {
+	registrationsMetrics, err := l.metrics.RegistrationsMetricsRead(ctx)
+	if err != nil {
+		return nil, err
+	}
+	metrics := &data.Metrics{Registrations: registrationsMetrics}
+	handlerIds := make(map[string][]string)
+	for handlerId, handler := range l.handlersRead() {
+		metrics.Handlers = append(metrics.Handlers, &data.HandlerMetrics{
+			HandlerId:      handlerId,
+			RegistrationId: handler.registrationId,
+			QueueDepth:     handler.queue.Length(),
+			QueueSize:      handler.queue.Capacity(),
+			Overflows:      atomic.LoadInt64(handler.overflows),
+		})
+		if handler.registrationId != "" {
+			handlerIds[handler.registrationId] = append(handlerIds[handler.registrationId], handlerId)
+		}
+	}
+	for _, registrationMetrics := range metrics.Registrations {
+		registrationMetrics.HandlerIds = handlerIds[registrationMetrics.RegistrationId]
+		registrationMetrics.Connected = len(registrationMetrics.HandlerIds) > 0
+	}
+	return metrics, nil
+}
+
 func (l *logic) HealthCheck(ctx context.Context) (*healthcheckdata.HealthCheck, error) {
 	return &healthcheckdata.HealthCheck{Time: time.Now().UnixNano()}, nil
 }
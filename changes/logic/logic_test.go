@@ -2,7 +2,10 @@ package logic_test
 
 import (
 	"context"
+	"io"
 	"math/rand"
+	"net/http"
+	"net/http/httptest"
 	"os"
 	"strings"
 	"testing"
@@ -29,6 +32,7 @@ var (
 	mysqlConfig *internal_mysql.Configuration
 	fileConfig  *internal_file.Configuration
 	logConfig   *logger.Configuration
+	logicConfig *logic.Configuration
 )
 
 type logicTest struct {
@@ -58,6 +62,22 @@ func init() {
 	logConfig.FromEnv(envs)
 	logConfig.Level = logger.Trace
 	logConfig.Prefix = "test_logic"
+	logicConfig = new(logic.Configuration)
+	logicConfig.Default()
+	logicConfig.WebhookRate = time.Second
+	logicConfig.WebhookRetryMin = 100 * time.Millisecond
+	logicConfig.WebhookRetryMax = time.Second
+	//KIM: the tests read registration changes more than once before
+	// acknowledging them, so changes aren't leased
+	logicConfig.VisibilityTimeout = 0
+	logicConfig.MaxDeliveryAttempts = 0
+	logicConfig.ReplayRate = 100 * time.Millisecond
+	logicConfig.ReplayBatchSize = 2
+	//KIM: the retention policy is only enforced when the tests prune
+	// explicitly (the prune rate is longer than the tests)
+	logicConfig.RetentionMaxAge = 24 * time.Hour
+	logicConfig.RetentionMaxPending = 1
+	logicConfig.RegistrationExpiry = time.Second
 	rand.Seed(time.Now().UnixNano())
 }
 
@@ -70,6 +90,11 @@ func newLogicTest(metaType internal_meta.Type) *logicTest {
 		meta.Change
 		meta.Registration
 		meta.RegistrationChange
+		meta.Webhook
+		meta.DeadLetter
+		meta.Replay
+		meta.Retention
+		meta.Metrics
 		internal.Initializer
 		internal.Parameterizer
 		internal.Configurer
@@ -93,6 +118,7 @@ func newLogicTest(metaType internal_meta.Type) *logicTest {
 	}
 	logic := logic.New()
 	logic.SetParameters(logger, meta)
+	logic.Configure(logicConfig)
 	return &logicTest{
 		meta:        meta,
 		Logic:       logic,
@@ -313,6 +339,673 @@ func (l *logicTest) testChangeHandlers(t *testing.T) {
 	}
 }
 
+func (l *logicTest) testChangeHandlersFilter(t *testing.T) {
+	ctx := context.TODO()
+	dataServiceName := generateId()
+	changesReceived := make(chan *data.Change, 2)
+
+	//create handler (filtered by service name)
+	handlerId, err := l.HandlerCreate(ctx, func(ctx context.Context, handlerId string, changes []*data.Change) error {
+		for _, change := range changes {
+			changesReceived <- change
+		}
+		return nil
+	}, logic.HandlerFilter(data.RegistrationFilter{ServiceNames: []string{dataServiceName}}))
+	assert.Nil(t, err)
+	assert.NotEmpty(t, handlerId)
+	defer func() {
+		l.HandlerDelete(ctx, handlerId)
+	}()
+	//upsert changes (only the second should be received)
+	var changeIds []string
+	for _, serviceName := range []string{generateId(), dataServiceName} {
+		dataId, serviceName := generateId(), serviceName
+		dataVersion, dataType := rand.Intn(1000), generateId()
+		dataAction, changedBy := generateId(), "test_change_crud"
+		changeCreated, err := l.ChangeUpsert(ctx, data.ChangePartial{
+			DataId:          &dataId,
+			DataVersion:     &dataVersion,
+			DataType:        &dataType,
+			DataServiceName: &serviceName,
+			DataAction:      &dataAction,
+			ChangedBy:       &changedBy,
+		})
+		assert.Nil(t, err)
+		if !assert.NotNil(t, changeCreated) {
+			return
+		}
+		defer func(changeId string) {
+			l.ChangesDelete(ctx, changeId)
+		}(changeCreated.Id)
+		changeIds = append(changeIds, changeCreated.Id)
+	}
+	//validate change received
+	select {
+	case change := <-changesReceived:
+		assert.Equal(t, changeIds[1], change.Id)
+	case <-time.After(10 * time.Second):
+		assert.Fail(t, "unable to confirm change received")
+	}
+	select {
+	case change := <-changesReceived:
+		assert.Fail(t, "received filtered change", change.Id)
+	case <-time.After(time.Second):
+	}
+}
+
+func (l *logicTest) testWebhookDelivery(t *testing.T) {
+	var attempts int
+
+	ctx := context.TODO()
+	secret := generateId()
+	changeDigests := make(chan *data.ChangeDigest, 1)
+
+	//create receiver (fails the first delivery)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		assert.Nil(t, err)
+		assert.Equal(t, data.WebhookSignature(secret, body), r.Header.Get(data.HeaderWebhookSignature))
+		if attempts++; attempts == 1 {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		changeDigest := &data.ChangeDigest{}
+		err = changeDigest.UnmarshalBinary(body)
+		assert.Nil(t, err)
+		changeDigests <- changeDigest
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	//create registration and webhook
+	registrationId := generateId()
+	err := l.RegistrationUpsert(ctx, registrationId)
+	assert.Nil(t, err)
+	defer func() {
+		l.RegistrationDelete(ctx, registrationId)
+	}()
+	err = l.WebhookUpsert(ctx, data.Webhook{
+		RegistrationId: registrationId,
+		Url:            server.URL,
+		Secret:         secret,
+	})
+	assert.Nil(t, err)
+
+	//upsert change
+	dataId := generateId()
+	dataVersion, dataType := rand.Intn(1000), generateId()
+	dataServiceName, whenChanged := generateId(), time.Now().UnixNano()
+	dataAction, changedBy := generateId(), "test_webhook_delivery"
+	changeCreated, err := l.ChangeUpsert(ctx, data.ChangePartial{
+		DataId:          &dataId,
+		DataVersion:     &dataVersion,
+		DataType:        &dataType,
+		DataServiceName: &dataServiceName,
+		DataAction:      &dataAction,
+		WhenChanged:     &whenChanged,
+		ChangedBy:       &changedBy,
+	})
+	assert.Nil(t, err)
+	if !assert.NotNil(t, changeCreated) {
+		return
+	}
+	defer func(changeId string) {
+		l.ChangesDelete(ctx, changeId)
+	}(changeCreated.Id)
+
+	//validate change delivered (after retry)
+	select {
+	case changeDigest := <-changeDigests:
+		if assert.Len(t, changeDigest.Changes, 1) {
+			assert.Equal(t, changeCreated.Id, changeDigest.Changes[0].Id)
+		}
+	case <-time.After(10 * time.Second):
+		assert.Fail(t, "unable to confirm change delivered")
+		return
+	}
+
+	//validate change acknowledged and status
+	assert.Eventually(t, func() bool {
+		changes, err := l.RegistrationChangesRead(ctx, registrationId)
+		return err == nil && len(changes) == 0
+	}, 5*time.Second, 100*time.Millisecond)
+	status, err := l.WebhookStatusRead(ctx, registrationId)
+	assert.Nil(t, err)
+	if assert.NotNil(t, status) {
+		assert.Equal(t, server.URL, status.Url)
+		assert.Equal(t, 1, status.Delivered)
+		assert.Zero(t, status.Failures)
+		assert.Empty(t, status.LastError)
+		assert.NotZero(t, status.LastSuccess)
+	}
+
+	//delete webhook
+	err = l.WebhookDelete(ctx, registrationId)
+	assert.Nil(t, err)
+	status, err = l.WebhookStatusRead(ctx, registrationId)
+	assert.NotNil(t, err)
+	assert.Nil(t, status)
+}
+
+func (l *logicTest) testReplay(t *testing.T) {
+	var changesCreated []*data.Change
+
+	ctx := context.TODO()
+
+	//create an existing registration, changes are only retained
+	// until every registration has acknowledged them
+	dataType, dataServiceName := generateId(), generateId()
+	dataAction, changedBy := generateId(), "test_replay"
+	registrationIdExisting := generateId()
+	err := l.RegistrationUpsert(ctx, registrationIdExisting, logic.RegistrationFilter(data.RegistrationFilter{
+		Types: []string{dataType},
+	}))
+	assert.Nil(t, err)
+	defer func() {
+		l.RegistrationDelete(ctx, registrationIdExisting)
+	}()
+
+	//upsert changes (before the registration exists)
+	for i := 0; i < 5; i++ {
+		dataId, dataVersion := generateId(), 1
+		whenChanged := time.Now().UnixNano() + int64(i)
+		changeCreated, err := l.ChangeUpsert(ctx, data.ChangePartial{
+			DataId:          &dataId,
+			DataVersion:     &dataVersion,
+			DataType:        &dataType,
+			DataServiceName: &dataServiceName,
+			DataAction:      &dataAction,
+			WhenChanged:     &whenChanged,
+			ChangedBy:       &changedBy,
+		})
+		assert.Nil(t, err)
+		if !assert.NotNil(t, changeCreated) {
+			return
+		}
+		changesCreated = append(changesCreated, changeCreated)
+	}
+	changeIds := make([]string, 0, len(changesCreated))
+	for _, change := range changesCreated {
+		changeIds = append(changeIds, change.Id)
+	}
+	defer func() {
+		l.ChangesDelete(ctx, changeIds...)
+	}()
+
+	//start replay (registration doesn't exist)
+	registrationId := generateId()
+	_, err = l.ReplayStart(ctx, registrationId, data.RequestReplay{})
+	assert.NotNil(t, err)
+
+	//create registration, the changes occured before it existed
+	// so they're not attached
+	err = l.RegistrationUpsert(ctx, registrationId, logic.RegistrationFilter(data.RegistrationFilter{
+		ServiceNames: []string{dataServiceName},
+	}))
+	assert.Nil(t, err)
+	defer func() {
+		l.RegistrationDelete(ctx, registrationId)
+	}()
+	changesRead, err := l.RegistrationChangesRead(ctx, registrationId)
+	assert.Nil(t, err)
+	assert.Empty(t, changesRead)
+
+	//start replay and wait for it to complete (in batches)
+	replay, err := l.ReplayStart(ctx, registrationId, data.RequestReplay{
+		Search: data.ChangeSearch{Types: []string{dataType}},
+	})
+	assert.Nil(t, err)
+	if assert.NotNil(t, replay) {
+		assert.Equal(t, data.ReplayStatusRunning, replay.Status)
+	}
+	assert.Eventually(t, func() bool {
+		replay, err := l.ReplayRead(ctx, registrationId)
+		return err == nil && replay.Status == data.ReplayStatusCompleted
+	}, 5*time.Second, 100*time.Millisecond)
+	replay, err = l.ReplayRead(ctx, registrationId)
+	assert.Nil(t, err)
+	if assert.NotNil(t, replay) {
+		assert.Equal(t, len(changesCreated), replay.Replayed)
+		assert.Equal(t, changesCreated[len(changesCreated)-1].Id, replay.LastChangeId)
+	}
+	changesRead, err = l.RegistrationChangesRead(ctx, registrationId)
+	assert.Nil(t, err)
+	assert.ElementsMatch(t, changesCreated, changesRead)
+	err = l.RegistrationChangeAcknowledge(ctx, registrationId, changeIds...)
+	assert.Nil(t, err)
+
+	//start replay after a given change
+	replay, err = l.ReplayStart(ctx, registrationId, data.RequestReplay{
+		Search:        data.ChangeSearch{Types: []string{dataType}},
+		AfterChangeId: changesCreated[2].Id,
+	})
+	assert.Nil(t, err)
+	assert.NotNil(t, replay)
+	assert.Eventually(t, func() bool {
+		replay, err := l.ReplayRead(ctx, registrationId)
+		return err == nil && replay.Status == data.ReplayStatusCompleted
+	}, 5*time.Second, 100*time.Millisecond)
+	changesRead, err = l.RegistrationChangesRead(ctx, registrationId)
+	assert.Nil(t, err)
+	assert.ElementsMatch(t, changesCreated[3:], changesRead)
+
+	//stop and resume replay, it resumes after the last change
+	// that was replayed so nothing is replayed
+	err = l.ReplayStop(ctx, registrationId)
+	assert.Nil(t, err)
+	replay, err = l.ReplayResume(ctx, registrationId)
+	assert.Nil(t, err)
+	assert.NotNil(t, replay)
+	assert.Eventually(t, func() bool {
+		replay, err := l.ReplayRead(ctx, registrationId)
+		return err == nil && replay.Status == data.ReplayStatusCompleted
+	}, 5*time.Second, 100*time.Millisecond)
+	replay, err = l.ReplayRead(ctx, registrationId)
+	assert.Nil(t, err)
+	if assert.NotNil(t, replay) {
+		assert.Equal(t, 2, replay.Replayed)
+	}
+	err = l.RegistrationChangeAcknowledge(ctx, registrationId, changeIds[3:]...)
+	assert.Nil(t, err)
+
+	//start replay for a registration whose filter doesn't match
+	// the changes, nothing is replayed
+	registrationIdFiltered := generateId()
+	err = l.RegistrationUpsert(ctx, registrationIdFiltered, logic.RegistrationFilter(data.RegistrationFilter{
+		Types: []string{generateId()},
+	}))
+	assert.Nil(t, err)
+	defer func() {
+		l.RegistrationDelete(ctx, registrationIdFiltered)
+	}()
+	_, err = l.ReplayStart(ctx, registrationIdFiltered, data.RequestReplay{
+		Search: data.ChangeSearch{Types: []string{dataType}},
+	})
+	assert.Nil(t, err)
+	assert.Eventually(t, func() bool {
+		replay, err := l.ReplayRead(ctx, registrationIdFiltered)
+		return err == nil && replay.Status == data.ReplayStatusCompleted
+	}, 5*time.Second, 100*time.Millisecond)
+	replay, err = l.ReplayRead(ctx, registrationIdFiltered)
+	assert.Nil(t, err)
+	if assert.NotNil(t, replay) {
+		assert.Equal(t, 0, replay.Replayed)
+	}
+	changesRead, err = l.RegistrationChangesRead(ctx, registrationIdFiltered)
+	assert.Nil(t, err)
+	assert.Empty(t, changesRead)
+	err = l.RegistrationChangeAcknowledge(ctx, registrationIdExisting, changeIds...)
+	assert.Nil(t, err)
+}
+
+func (l *logicTest) testPrune(t *testing.T) {
+	var changeIds []string
+
+	ctx := context.TODO()
+
+	//upsert registration
+	registrationId, dataServiceName := generateId(), generateId()
+	err := l.RegistrationUpsert(ctx, registrationId, logic.RegistrationFilter(data.RegistrationFilter{
+		ServiceNames: []string{dataServiceName},
+	}))
+	assert.Nil(t, err)
+	defer func() {
+		l.RegistrationDelete(ctx, registrationId)
+	}()
+
+	//upsert changes (oldest first)
+	tNow := time.Now()
+	for _, whenChanged := range []int64{
+		tNow.Add(-48 * time.Hour).UnixNano(),
+		tNow.Add(-2 * time.Minute).UnixNano(),
+		tNow.Add(-time.Minute).UnixNano(),
+	} {
+		whenChanged := whenChanged
+		dataId, dataVersion := generateId(), 1
+		dataType, dataAction := generateId(), generateId()
+		changeCreated, err := l.ChangeUpsert(ctx, data.ChangePartial{
+			DataId:          &dataId,
+			DataVersion:     &dataVersion,
+			DataType:        &dataType,
+			DataServiceName: &dataServiceName,
+			DataAction:      &dataAction,
+			WhenChanged:     &whenChanged,
+		})
+		assert.Nil(t, err)
+		if !assert.NotNil(t, changeCreated) {
+			return
+		}
+		changeIds = append(changeIds, changeCreated.Id)
+	}
+	defer func() {
+		l.ChangesDelete(ctx, changeIds...)
+	}()
+
+	//prune, the oldest change is expired and the next oldest
+	// is trimmed (and pruned)
+	report, err := l.Prune(ctx)
+	assert.Nil(t, err)
+	if assert.NotNil(t, report) {
+		assert.Contains(t, report.ChangesExpired, changeIds[0])
+		assert.Equal(t, []string{changeIds[1]}, report.ChangesTrimmed[registrationId])
+		assert.Contains(t, report.ChangesPruned, changeIds[1])
+		assert.NotContains(t, report.RegistrationsExpired, registrationId)
+		assert.Empty(t, report.Error)
+	}
+	reportRead, err := l.PruneReportRead(ctx)
+	assert.Nil(t, err)
+	assert.Equal(t, report, reportRead)
+	for _, changeId := range changeIds[:2] {
+		_, err = l.ChangeRead(ctx, changeId)
+		assert.NotNil(t, err)
+	}
+	changesRead, err := l.RegistrationChangesRead(ctx, registrationId)
+	assert.Nil(t, err)
+	if assert.Len(t, changesRead, 1) {
+		assert.Equal(t, changeIds[2], changesRead[0].Id)
+	}
+
+	//prune after the registration would have expired while a handler
+	// is attached, it's connected so it doesn't expire
+	handlerId, err := l.HandlerCreate(ctx, func(ctx context.Context, handlerId string, changes []*data.Change) error {
+		return nil
+	}, logic.HandlerFilter(data.RegistrationFilter{ServiceNames: []string{dataServiceName}}),
+		logic.HandlerRegistrationId(registrationId))
+	assert.Nil(t, err)
+	time.Sleep(2 * time.Second)
+	report, err = l.Prune(ctx)
+	assert.Nil(t, err)
+	if assert.NotNil(t, report) {
+		assert.NotContains(t, report.RegistrationsExpired, registrationId)
+		assert.NotContains(t, report.ChangesPruned, changeIds[2])
+	}
+	err = l.HandlerDelete(ctx, handlerId)
+	assert.Nil(t, err)
+
+	//prune after the registration expires, its remaining
+	// change is pruned
+	time.Sleep(2 * time.Second)
+	report, err = l.Prune(ctx)
+	assert.Nil(t, err)
+	if assert.NotNil(t, report) {
+		assert.Contains(t, report.RegistrationsExpired, registrationId)
+		assert.Contains(t, report.ChangesPruned, changeIds[2])
+	}
+	_, err = l.ChangeRead(ctx, changeIds[2])
+	assert.NotNil(t, err)
+	_, err = l.RegistrationChangesRead(ctx, registrationId)
+	assert.NotNil(t, err)
+}
+
+func (l *logicTest) testMetrics(t *testing.T) {
+	var changeIds []string
+
+	ctx := context.TODO()
+
+	//upsert registration
+	registrationId, dataServiceName := generateId(), generateId()
+	err := l.RegistrationUpsert(ctx, registrationId, logic.RegistrationFilter(data.RegistrationFilter{
+		ServiceNames: []string{dataServiceName},
+	}))
+	assert.Nil(t, err)
+	defer func() {
+		l.RegistrationDelete(ctx, registrationId)
+	}()
+	metricsFx := func() (*data.RegistrationMetrics, *data.HandlerMetrics) {
+		var registrationMetrics *data.RegistrationMetrics
+		var handlerMetrics *data.HandlerMetrics
+
+		metrics, err := l.MetricsRead(ctx)
+		assert.Nil(t, err)
+		if metrics == nil {
+			return nil, nil
+		}
+		for _, r := range metrics.Registrations {
+			if r.RegistrationId == registrationId {
+				registrationMetrics = r
+			}
+		}
+		for _, h := range metrics.Handlers {
+			if h.RegistrationId == registrationId {
+				handlerMetrics = h
+			}
+		}
+		return registrationMetrics, handlerMetrics
+	}
+
+	//read metrics (nothing pending, not connected)
+	registrationMetrics, handlerMetrics := metricsFx()
+	if assert.NotNil(t, registrationMetrics) {
+		assert.Zero(t, registrationMetrics.Pending)
+		assert.Zero(t, registrationMetrics.OldestPendingAge)
+		assert.Zero(t, registrationMetrics.LastAcknowledged)
+		assert.NotZero(t, registrationMetrics.LastSeen)
+		assert.False(t, registrationMetrics.Connected)
+	}
+	assert.Nil(t, handlerMetrics)
+
+	//create handler for the registration
+	handlerId, err := l.HandlerCreate(ctx, func(ctx context.Context, handlerId string, changes []*data.Change) error {
+		return nil
+	}, logic.HandlerFilter(data.RegistrationFilter{ServiceNames: []string{dataServiceName}}),
+		logic.HandlerRegistrationId(registrationId))
+	assert.Nil(t, err)
+	defer func() {
+		l.HandlerDelete(ctx, handlerId)
+	}()
+
+	//upsert changes (the oldest occured a minute ago)
+	tNow := time.Now()
+	for _, whenChanged := range []int64{
+		tNow.Add(-time.Minute).UnixNano(),
+		tNow.UnixNano(),
+	} {
+		whenChanged := whenChanged
+		dataId, dataVersion := generateId(), 1
+		dataType, dataAction := generateId(), generateId()
+		changeCreated, err := l.ChangeUpsert(ctx, data.ChangePartial{
+			DataId:          &dataId,
+			DataVersion:     &dataVersion,
+			DataType:        &dataType,
+			DataServiceName: &dataServiceName,
+			DataAction:      &dataAction,
+			WhenChanged:     &whenChanged,
+		})
+		assert.Nil(t, err)
+		if !assert.NotNil(t, changeCreated) {
+			return
+		}
+		changeIds = append(changeIds, changeCreated.Id)
+	}
+	defer func() {
+		l.ChangesDelete(ctx, changeIds...)
+	}()
+
+	//read metrics (changes pending, connected)
+	registrationMetrics, handlerMetrics = metricsFx()
+	if assert.NotNil(t, registrationMetrics) {
+		assert.Equal(t, 2, registrationMetrics.Pending)
+		assert.GreaterOrEqual(t, registrationMetrics.OldestPendingAge, time.Minute.Milliseconds())
+		assert.True(t, registrationMetrics.Connected)
+		assert.Equal(t, []string{handlerId}, registrationMetrics.HandlerIds)
+	}
+	if assert.NotNil(t, handlerMetrics) {
+		assert.Equal(t, handlerId, handlerMetrics.HandlerId)
+		assert.Equal(t, logic.QueueSize, handlerMetrics.QueueSize)
+		assert.Zero(t, handlerMetrics.Overflows)
+	}
+
+	//acknowledge changes and delete handler
+	err = l.RegistrationChangeAcknowledge(ctx, registrationId, changeIds...)
+	assert.Nil(t, err)
+	err = l.HandlerDelete(ctx, handlerId)
+	assert.Nil(t, err)
+	registrationMetrics, handlerMetrics = metricsFx()
+	if assert.NotNil(t, registrationMetrics) {
+		assert.Zero(t, registrationMetrics.Pending)
+		assert.Zero(t, registrationMetrics.OldestPendingAge)
+		assert.NotZero(t, registrationMetrics.LastAcknowledged)
+		assert.False(t, registrationMetrics.Connected)
+	}
+	assert.Nil(t, handlerMetrics)
+}
+
+func (l *logicTest) testHandlerOverflow(t *testing.T) {
+	ctx := context.TODO()
+
+	//KIM: the queue size is reduced so that the first change is being
+	// handled, the second is queued and the third overflows
+	queueSize := logic.QueueSize
+	logic.QueueSize = 1
+	defer func() {
+		logic.QueueSize = queueSize
+	}()
+	changeUpsertFx := func(dataServiceName string) string {
+		dataId, dataVersion := generateId(), 1
+		dataType, dataAction := generateId(), generateId()
+		changedBy := "test_handler_overflow"
+		changeCreated, err := l.ChangeUpsert(ctx, data.ChangePartial{
+			DataId:          &dataId,
+			DataVersion:     &dataVersion,
+			DataType:        &dataType,
+			DataServiceName: &dataServiceName,
+			DataAction:      &dataAction,
+			ChangedBy:       &changedBy,
+		})
+		assert.Nil(t, err)
+		if !assert.NotNil(t, changeCreated) {
+			return ""
+		}
+		return changeCreated.Id
+	}
+	for _, c := range []struct {
+		options      data.HandlerOptions
+		releaseAfter time.Duration
+		overflowed   func(changeIds []string) []string
+		received     func(changeIds []string) []string
+		disconnected bool
+	}{
+		{
+			options:    data.HandlerOptions{Overflow: data.OverflowDropNewest},
+			overflowed: func(changeIds []string) []string { return changeIds[2:] },
+			received:   func(changeIds []string) []string { return changeIds[:2] },
+		},
+		{
+			options:    data.HandlerOptions{Overflow: data.OverflowDropOldest},
+			overflowed: func(changeIds []string) []string { return changeIds[1:2] },
+			received:   func(changeIds []string) []string { return []string{changeIds[0], changeIds[2]} },
+		},
+		{
+			options:      data.HandlerOptions{Overflow: data.OverflowBlock, OverflowTimeout: 100},
+			releaseAfter: 500 * time.Millisecond,
+			overflowed:   func(changeIds []string) []string { return changeIds[2:] },
+			received:     func(changeIds []string) []string { return changeIds[:2] },
+		},
+		{
+			options:      data.HandlerOptions{Overflow: data.OverflowBlock, OverflowTimeout: 10000},
+			releaseAfter: 500 * time.Millisecond,
+			received:     func(changeIds []string) []string { return changeIds },
+		},
+		{
+			options:      data.HandlerOptions{Overflow: data.OverflowDisconnect},
+			overflowed:   func(changeIds []string) []string { return changeIds[2:] },
+			received:     func(changeIds []string) []string { return changeIds[:1] },
+			disconnected: true,
+		},
+	} {
+		dataServiceName := generateId()
+		handling, release := make(chan struct{}, 3), make(chan struct{})
+		changesReceived := make(chan string, 3)
+		overflows := make(chan *data.HandlerOverflow, 1)
+
+		//create handler (blocks until released)
+		handlerId, err := l.HandlerCreate(ctx, func(ctx context.Context, handlerId string, changes []*data.Change) error {
+			handling <- struct{}{}
+			<-release
+			for _, change := range changes {
+				changesReceived <- change.Id
+			}
+			return nil
+		}, logic.HandlerFilter(data.RegistrationFilter{ServiceNames: []string{dataServiceName}}),
+			logic.HandlerOverflow(c.options.Overflow, c.options.OverflowTimeout),
+			logic.HandlerOverflowFx(func(ctx context.Context, handlerId string, overflow *data.HandlerOverflow) {
+				overflows <- overflow
+			}))
+		assert.Nil(t, err)
+
+		//upsert changes, wait for the first to be handled
+		var changeIds []string
+		changeIds = append(changeIds, changeUpsertFx(dataServiceName))
+		select {
+		case <-handling:
+		case <-time.After(10 * time.Second):
+			assert.Fail(t, "unable to confirm change handled")
+		}
+		if c.releaseAfter > 0 {
+			time.AfterFunc(c.releaseAfter, func() { close(release) })
+		}
+		tStart := time.Now()
+		changeIds = append(changeIds, changeUpsertFx(dataServiceName))
+		changeIds = append(changeIds, changeUpsertFx(dataServiceName))
+		if c.releaseAfter > 0 {
+			//KIM: the upsert shouldn't wait for room in the handler's
+			// queue (even if the handler uses the block strategy)
+			assert.Less(t, time.Since(tStart), c.releaseAfter)
+		}
+		if c.releaseAfter <= 0 {
+			close(release)
+		}
+
+		//validate changes received
+		var received []string
+		for i := 0; i < len(c.received(changeIds)); i++ {
+			select {
+			case changeId := <-changesReceived:
+				received = append(received, changeId)
+			case <-time.After(10 * time.Second):
+				assert.Fail(t, "unable to confirm change received")
+			}
+		}
+		assert.Equal(t, c.received(changeIds), received)
+
+		//validate overflow
+		if c.overflowed == nil {
+			select {
+			case <-overflows:
+				assert.Fail(t, "unexpected overflow")
+			case <-time.After(100 * time.Millisecond):
+			}
+		} else {
+			select {
+			case overflow := <-overflows:
+				assert.Equal(t, handlerId, overflow.HandlerId)
+				assert.Equal(t, c.options.Overflow, overflow.Strategy)
+				assert.Equal(t, c.overflowed(changeIds), overflow.ChangeIds)
+				assert.Equal(t, int64(1), overflow.Overflows)
+				assert.Equal(t, c.disconnected, overflow.Disconnected)
+			case <-time.After(10 * time.Second):
+				assert.Fail(t, "unable to confirm overflow")
+			}
+		}
+
+		//delete handler (disconnected handlers have already been deleted)
+		err = l.HandlerDelete(ctx, handlerId)
+		if c.disconnected {
+			assert.NotNil(t, err)
+		} else {
+			assert.Nil(t, err)
+		}
+		err = l.ChangesDelete(ctx, changeIds...)
+		assert.Nil(t, err)
+	}
+
+	//attempt to create handler with unsupported overflow strategy
+	_, err := l.HandlerCreate(ctx, func(ctx context.Context, handlerId string, changes []*data.Change) error {
+		return nil
+	}, logic.HandlerOverflow("unsupported", 0))
+	assert.NotNil(t, err)
+}
+
 func testLogic(t *testing.T, metaType internal_meta.Type) {
 	l := newLogicTest(internal_meta.TypeMemory)
 
@@ -323,6 +1016,12 @@ func testLogic(t *testing.T, metaType internal_meta.Type) {
 	//execute tests
 	t.Run("Change Registration", l.testChangeRegistration)
 	t.Run("Change Handlers", l.testChangeHandlers)
+	t.Run("Change Handlers Filter", l.testChangeHandlersFilter)
+	t.Run("Handler Overflow", l.testHandlerOverflow)
+	t.Run("Webhook Delivery", l.testWebhookDelivery)
+	t.Run("Replay", l.testReplay)
+	t.Run("Prune", l.testPrune)
+	t.Run("Metrics", l.testMetrics)
 }
 
 func TestLogicMemory(t *testing.T) {
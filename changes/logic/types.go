@@ -13,6 +13,11 @@ const (
 	PanicChangeMetaNotSet             string = "change meta not set"
 	PanicRegistrationMetaNotSet       string = "change meta not set"
 	PanicRegistrationChangeMetaNotSet string = "change meta not set"
+	PanicWebhookMetaNotSet            string = "webhook meta not set"
+	PanicDeadLetterMetaNotSet         string = "dead letter meta not set"
+	PanicReplayMetaNotSet             string = "replay meta not set"
+	PanicRetentionMetaNotSet          string = "retention meta not set"
+	PanicMetricsMetaNotSet            string = "metrics meta not set"
 	ChangeIdNotProvided               string = "change id not provided"
 	RegisterFilterHandlerNotProvided  string = "unable to register; neither fitler or handler not provided"
 	DefaultQueueSize                  int    = 100
@@ -26,6 +31,11 @@ var (
 
 type HandlerFx func(ctx context.Context, handlerId string, changes []*data.Change) error
 
+// OverflowFx is called when changes couldn't be delivered to a handler because
+// its queue was full, if the handler was disconnected no more changes will be
+// handled and the client should resync using RegistrationChangesRead
+type OverflowFx func(ctx context.Context, handlerId string, overflow *data.HandlerOverflow)
+
 type Logic interface {
 	//changes
 	ChangeUpsert(ctx context.Context, change data.ChangePartial) (*data.Change, error)
@@ -34,12 +44,92 @@ type Logic interface {
 	ChangesDelete(ctx context.Context, changeIds ...string) error
 
 	//registrations
-	RegistrationUpsert(ctx context.Context, registrationId string) error
+	RegistrationUpsert(ctx context.Context, registrationId string, options ...RegistrationOption) error
 	RegistrationChangesRead(ctx context.Context, registrationId string) ([]*data.Change, error)
 	RegistrationChangeAcknowledge(ctx context.Context, registrationId string, changeIds ...string) error
 	RegistrationDelete(ctx context.Context, registrationId string) error
 
+	//dead letters
+	DeadLettersRead(ctx context.Context, registrationId string) ([]*data.DeadLetter, error)
+
+	//replays
+	ReplayStart(ctx context.Context, registrationId string, request data.RequestReplay) (*data.Replay, error)
+	ReplayResume(ctx context.Context, registrationId string) (*data.Replay, error)
+	ReplayRead(ctx context.Context, registrationId string) (*data.Replay, error)
+	ReplayStop(ctx context.Context, registrationId string) error
+
+	//retention
+	Prune(ctx context.Context) (*data.PruneReport, error)
+	PruneReportRead(ctx context.Context) (*data.PruneReport, error)
+
+	//webhooks
+	WebhookUpsert(ctx context.Context, webhook data.Webhook) error
+	WebhookStatusRead(ctx context.Context, registrationId string) (*data.WebhookStatus, error)
+	WebhookDelete(ctx context.Context, registrationId string) error
+
+	//metrics
+	MetricsRead(ctx context.Context) (*data.Metrics, error)
+
 	//handlers
-	HandlerCreate(ctx context.Context, handleFx HandlerFx) (handlerId string, err error)
+	//HandlerCreate can be used to create a handler for changes, the options are
+	// optional (see HandlerFilter, HandlerRegistrationId, HandlerOverflow and
+	// HandlerOverflowFx)
+	HandlerCreate(ctx context.Context, handleFx HandlerFx, options ...HandlerOption) (handlerId string, err error)
 	HandlerDelete(ctx context.Context, handlerId string) error
 }
+
+// RegistrationOptions are the (optional) options of a registration
+type RegistrationOptions struct {
+	Filter data.RegistrationFilter
+}
+
+type RegistrationOption func(*RegistrationOptions)
+
+// RegistrationFilter can be used to only register for changes
+// that match the filter
+func RegistrationFilter(filter data.RegistrationFilter) RegistrationOption {
+	return func(o *RegistrationOptions) {
+		o.Filter = filter
+	}
+}
+
+// HandlerOptions are the (optional) options of a handler
+type HandlerOptions struct {
+	data.HandlerOptions
+	Filter     data.RegistrationFilter
+	OverflowFx OverflowFx
+}
+
+type HandlerOption func(*HandlerOptions)
+
+// HandlerFilter can be used to only handle changes that match the filter
+func HandlerFilter(filter data.RegistrationFilter) HandlerOption {
+	return func(o *HandlerOptions) {
+		o.Filter = filter
+	}
+}
+
+// HandlerRegistrationId can be used to create the handler for a registration,
+// this is used to report if a registration is connected
+func HandlerRegistrationId(registrationId string) HandlerOption {
+	return func(o *HandlerOptions) {
+		o.RegistrationId = registrationId
+	}
+}
+
+// HandlerOverflow can be used to set what's done when the handler's queue is
+// full and how long (in milliseconds) to wait for room when using block, if
+// empty (or zero), the configured default is used
+func HandlerOverflow(overflow data.OverflowStrategy, overflowTimeout int64) HandlerOption {
+	return func(o *HandlerOptions) {
+		o.Overflow, o.OverflowTimeout = overflow, overflowTimeout
+	}
+}
+
+// HandlerOverflowFx can be used to set the function that's called when changes
+// couldn't be delivered to the handler because its queue was full
+func HandlerOverflowFx(overflowFx OverflowFx) HandlerOption {
+	return func(o *HandlerOptions) {
+		o.OverflowFx = overflowFx
+	}
+}
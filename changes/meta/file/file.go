@@ -4,6 +4,7 @@ import (
 	"context"
 	"os"
 	"sync"
+	"time"
 
 	data "github.com/antonio-alexander/go-bludgeon/changes/data"
 	meta "github.com/antonio-alexander/go-bludgeon/changes/meta"
@@ -28,12 +29,22 @@ type file struct {
 	meta.Change
 	meta.Registration
 	meta.RegistrationChange
+	meta.Webhook
+	meta.DeadLetter
+	meta.Replay
+	meta.Retention
+	meta.Metrics
 }
 
 func New() interface {
 	meta.Change
 	meta.Registration
 	meta.RegistrationChange
+	meta.Webhook
+	meta.DeadLetter
+	meta.Replay
+	meta.Retention
+	meta.Metrics
 	internal.Configurer
 	internal.Initializer
 	internal.Parameterizer
@@ -45,6 +56,11 @@ func New() interface {
 		Change:             memory,
 		Registration:       memory,
 		RegistrationChange: memory,
+		Webhook:            memory,
+		DeadLetter:         memory,
+		Replay:             memory,
+		Retention:          memory,
+		Metrics:            memory,
 	}
 }
 
@@ -75,11 +91,21 @@ func (m *file) SetParameters(parameters ...interface{}) {
 			meta.Change
 			meta.Registration
 			meta.RegistrationChange
+			meta.Webhook
+			meta.DeadLetter
+			meta.Replay
+			meta.Retention
+			meta.Metrics
 		}:
 			m.Serializer = p
 			m.Change = p
 			m.Registration = p
 			m.RegistrationChange = p
+			m.Webhook = p
+			m.DeadLetter = p
+			m.Replay = p
+			m.Retention = p
+			m.Metrics = p
 		case meta.Serializer:
 			m.Serializer = p
 		case meta.Change:
@@ -88,6 +114,16 @@ func (m *file) SetParameters(parameters ...interface{}) {
 			m.Registration = p
 		case meta.RegistrationChange:
 			m.RegistrationChange = p
+		case meta.Webhook:
+			m.Webhook = p
+		case meta.DeadLetter:
+			m.DeadLetter = p
+		case meta.Replay:
+			m.Replay = p
+		case meta.Retention:
+			m.Retention = p
+		case meta.Metrics:
+			m.Metrics = p
 		}
 	}
 }
@@ -163,8 +199,8 @@ func (m *file) ChangesDelete(ctx context.Context, changeIds ...string) error {
 	return m.write()
 }
 
-func (m *file) RegistrationUpsert(ctx context.Context, registrationId string) error {
-	if err := m.Registration.RegistrationUpsert(ctx, registrationId); err != nil {
+func (m *file) RegistrationUpsert(ctx context.Context, registrationId string, filter data.RegistrationFilter) error {
+	if err := m.Registration.RegistrationUpsert(ctx, registrationId, filter); err != nil {
 		return err
 	}
 	return m.write()
@@ -184,6 +220,24 @@ func (m *file) RegistrationChangeUpsert(ctx context.Context, changeId string) er
 	return m.write()
 }
 
+func (m *file) RegistrationChangesAttach(ctx context.Context, registrationId string, changeIds ...string) error {
+	if err := m.RegistrationChange.RegistrationChangesAttach(ctx, registrationId, changeIds...); err != nil {
+		return err
+	}
+	return m.write()
+}
+
+func (m *file) RegistrationChangesRead(ctx context.Context, registrationId string, visibilityTimeout time.Duration, maxAttempts int) ([]string, error) {
+	changeIds, err := m.RegistrationChange.RegistrationChangesRead(ctx, registrationId, visibilityTimeout, maxAttempts)
+	if err != nil {
+		return nil, err
+	}
+	if err := m.write(); err != nil {
+		return nil, err
+	}
+	return changeIds, nil
+}
+
 func (m *file) RegistrationChangeAcknowledge(ctx context.Context, registrationId string, changeIds ...string) ([]string, error) {
 	changeIdsToDelete, err := m.RegistrationChange.RegistrationChangeAcknowledge(ctx, registrationId, changeIds...)
 	if err != nil {
@@ -194,3 +248,71 @@ func (m *file) RegistrationChangeAcknowledge(ctx context.Context, registrationId
 	}
 	return changeIdsToDelete, nil
 }
+
+func (m *file) WebhookUpsert(ctx context.Context, webhook data.Webhook) error {
+	if err := m.Webhook.WebhookUpsert(ctx, webhook); err != nil {
+		return err
+	}
+	return m.write()
+}
+
+func (m *file) WebhookDelete(ctx context.Context, registrationId string) error {
+	if err := m.Webhook.WebhookDelete(ctx, registrationId); err != nil {
+		return err
+	}
+	return m.write()
+}
+
+func (m *file) ReplayUpsert(ctx context.Context, replay data.Replay) error {
+	if err := m.Replay.ReplayUpsert(ctx, replay); err != nil {
+		return err
+	}
+	return m.write()
+}
+
+func (m *file) ReplayDelete(ctx context.Context, registrationId string) error {
+	if err := m.Replay.ReplayDelete(ctx, registrationId); err != nil {
+		return err
+	}
+	return m.write()
+}
+
+func (m *file) ChangesExpire(ctx context.Context, maxAge time.Duration) ([]string, error) {
+	changeIds, err := m.Retention.ChangesExpire(ctx, maxAge)
+	if err != nil {
+		return nil, err
+	}
+	if err := m.write(); err != nil {
+		return nil, err
+	}
+	return changeIds, nil
+}
+
+func (m *file) RegistrationChangesTrim(ctx context.Context, maxPending int) (map[string][]string, []string, error) {
+	changeIdsTrimmed, changeIdsToPrune, err := m.Retention.RegistrationChangesTrim(ctx, maxPending)
+	if err != nil {
+		return nil, nil, err
+	}
+	if err := m.write(); err != nil {
+		return nil, nil, err
+	}
+	return changeIdsTrimmed, changeIdsToPrune, nil
+}
+
+func (m *file) RegistrationsExpire(ctx context.Context, expiry time.Duration) ([]string, []string, error) {
+	registrationIds, changeIdsToPrune, err := m.Retention.RegistrationsExpire(ctx, expiry)
+	if err != nil {
+		return nil, nil, err
+	}
+	if err := m.write(); err != nil {
+		return nil, nil, err
+	}
+	return registrationIds, changeIdsToPrune, nil
+}
+
+func (m *file) RegistrationsSeen(ctx context.Context, registrationIds ...string) error {
+	if err := m.Retention.RegistrationsSeen(ctx, registrationIds...); err != nil {
+		return err
+	}
+	return m.write()
+}
@@ -1,6 +1,8 @@
 package memory
 
 import (
+	"encoding/json"
+
 	data "github.com/antonio-alexander/go-bludgeon/changes/data"
 
 	"github.com/google/uuid"
@@ -24,5 +26,56 @@ func copyChange(c *data.Change) *data.Change {
 		DataType:        c.DataType,
 		DataAction:      c.DataAction,
 		DataVersion:     c.DataVersion,
+		Payload:         copyChangePayload(c.Payload),
+	}
+}
+
+func copyChangePayload(c *data.ChangePayload) *data.ChangePayload {
+	if c == nil {
+		return nil
+	}
+	changePayload := &data.ChangePayload{
+		Snapshot: append(json.RawMessage(nil), c.Snapshot...),
+	}
+	for _, diff := range c.Diff {
+		changePayload.Diff = append(changePayload.Diff, &data.FieldDiff{
+			Field:  diff.Field,
+			Before: append(json.RawMessage(nil), diff.Before...),
+			After:  append(json.RawMessage(nil), diff.After...),
+		})
+	}
+	return changePayload
+}
+
+func copyRegistrationFilter(f data.RegistrationFilter) data.RegistrationFilter {
+	return data.RegistrationFilter{
+		ServiceNames: append([]string(nil), f.ServiceNames...),
+		Types:        append([]string(nil), f.Types...),
+		Actions:      append([]string(nil), f.Actions...),
+	}
+}
+
+func copyChangeSearch(c data.ChangeSearch) data.ChangeSearch {
+	changeSearch := data.ChangeSearch{
+		ChangeIds:    append([]string(nil), c.ChangeIds...),
+		DataIds:      append([]string(nil), c.DataIds...),
+		Types:        append([]string(nil), c.Types...),
+		Actions:      append([]string(nil), c.Actions...),
+		ServiceNames: append([]string(nil), c.ServiceNames...),
+		Fields:       append([]string(nil), c.Fields...),
 	}
+	if c.LatestVersion != nil {
+		latestVersion := *c.LatestVersion
+		changeSearch.LatestVersion = &latestVersion
+	}
+	if c.Since != nil {
+		since := *c.Since
+		changeSearch.Since = &since
+	}
+	return changeSearch
+}
+
+func copyReplay(r data.Replay) data.Replay {
+	r.Search = copyChangeSearch(r.Search)
+	return r
 }
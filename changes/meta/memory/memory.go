@@ -2,8 +2,10 @@ package memory
 
 import (
 	"context"
+	"sort"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/antonio-alexander/go-bludgeon/changes/data"
 	"github.com/antonio-alexander/go-bludgeon/changes/meta"
@@ -19,8 +21,13 @@ type memory struct {
 	changesMux          sync.RWMutex
 	changes             map[string]*data.Change
 	registrationsMux    sync.RWMutex
-	registrations       map[string]struct{}
-	registrationChanges map[string]map[string]struct{}
+	registrations       map[string]data.RegistrationFilter
+	registrationChanges map[string]map[string]meta.RegistrationChangeLease
+	webhooks            map[string]data.Webhook
+	deadLetters         map[string]map[string]data.DeadLetter
+	replays             map[string]data.Replay
+	registrationsSeen   map[string]int64
+	registrationsAcked  map[string]int64
 }
 
 func New() interface {
@@ -28,6 +35,11 @@ func New() interface {
 	meta.Change
 	meta.Registration
 	meta.RegistrationChange
+	meta.Webhook
+	meta.DeadLetter
+	meta.Replay
+	meta.Retention
+	meta.Metrics
 	internal.Initializer
 	internal.Configurer
 	internal.Parameterizer
@@ -35,8 +47,13 @@ func New() interface {
 	return &memory{
 		Logger:              logger.NewNullLogger(),
 		changes:             make(map[string]*data.Change),
-		registrations:       make(map[string]struct{}),
-		registrationChanges: make(map[string]map[string]struct{}),
+		registrations:       make(map[string]data.RegistrationFilter),
+		registrationChanges: make(map[string]map[string]meta.RegistrationChangeLease),
+		webhooks:            make(map[string]data.Webhook),
+		deadLetters:         make(map[string]map[string]data.DeadLetter),
+		replays:             make(map[string]data.Replay),
+		registrationsSeen:   make(map[string]int64),
+		registrationsAcked:  make(map[string]int64),
 	}
 }
 
@@ -49,19 +66,34 @@ func (m *memory) validateChange(c data.ChangePartial, create bool, ids ...string
 	if c.DataId == nil || *c.DataId == "" {
 		return meta.ErrChangeNotWritten
 	}
+	if m.changeConflict(c, id) {
+		return meta.ErrChangeConflictWrite
+	}
+	return nil
+}
+
+// changeConflict can be used to determine if a change (other than the one with
+// the given id) has the same data id, version, type, service and action, this
+// mirrors the unique key of the changes table so a change that's delivered more
+// than once (e.g. ingested) is only created once
+func (m *memory) changeConflict(c data.ChangePartial, id string) bool {
+	if c.DataId == nil || c.DataVersion == nil || c.DataType == nil ||
+		c.DataServiceName == nil || c.DataAction == nil {
+		return false
+	}
 	for _, change := range m.changes {
 		if change.Id == id {
 			continue
 		}
-		if c.DataId != nil && *c.DataId == change.DataId &&
-			c.DataServiceName != nil && *c.DataServiceName == change.DataServiceName &&
-			c.DataType != nil && *c.DataType == change.DataType &&
-			c.DataAction != nil && *c.DataAction == change.DataAction &&
-			c.DataVersion != nil && *c.DataVersion == change.DataVersion {
-			return meta.ErrChangeConflictWrite
+		if *c.DataId == change.DataId &&
+			*c.DataVersion == change.DataVersion &&
+			*c.DataType == change.DataType &&
+			*c.DataServiceName == change.DataServiceName &&
+			*c.DataAction == change.DataAction {
+			return true
 		}
 	}
-	return nil
+	return false
 }
 
 func (m *memory) validateRegistration(registrationId string) error {
@@ -78,24 +110,55 @@ func (m *memory) validateRegistrationChange(changeId string) error {
 	return nil
 }
 
+// changeReferenced can be used to determine if a change is still pending
+// (or dead-lettered) for any registration
+func (m *memory) changeReferenced(changeId string) bool {
+	for registrationId := range m.registrationChanges {
+		if _, ok := m.registrationChanges[registrationId][changeId]; ok {
+			return true
+		}
+	}
+	for registrationId := range m.deadLetters {
+		if _, ok := m.deadLetters[registrationId][changeId]; ok {
+			return true
+		}
+	}
+	return false
+}
+
 func (m *memory) findChangeIdsToDelete(changeIds ...string) ([]string, error) {
 	var changeIdsToDelete []string
 
 	for _, changeId := range changeIds {
-		found := false
-		for registrationId := range m.registrationChanges {
-			if _, ok := m.registrationChanges[registrationId][changeId]; ok {
-				found = true
-				break
-			}
-		}
-		if !found {
+		if !m.changeReferenced(changeId) {
 			changeIdsToDelete = append(changeIdsToDelete, changeId)
 		}
 	}
 	return changeIdsToDelete, nil
 }
 
+// registrationDelete can be used to delete a registration and everything
+// that belongs to it, it returns the ids of the changes that were pending
+// (or dead-lettered) for the registration
+func (m *memory) registrationDelete(registrationId string) []string {
+	var changeIds []string
+
+	for changeId := range m.registrationChanges[registrationId] {
+		changeIds = append(changeIds, changeId)
+	}
+	for changeId := range m.deadLetters[registrationId] {
+		changeIds = append(changeIds, changeId)
+	}
+	delete(m.registrations, registrationId)
+	delete(m.registrationChanges, registrationId)
+	delete(m.webhooks, registrationId)
+	delete(m.deadLetters, registrationId)
+	delete(m.replays, registrationId)
+	delete(m.registrationsSeen, registrationId)
+	delete(m.registrationsAcked, registrationId)
+	return changeIds
+}
+
 func (m *memory) SetUtilities(parameters ...interface{}) {
 	for _, p := range parameters {
 		switch p := p.(type) {
@@ -132,11 +195,47 @@ func (m *memory) Serialize() (*meta.SerializedData, error) {
 	m.Lock()
 	defer m.Unlock()
 	serializedData := &meta.SerializedData{
-		Changes: make(map[string]data.Change),
+		Changes:                   make(map[string]data.Change),
+		Registrations:             make(map[string]data.RegistrationFilter),
+		RegistrationChanges:       make(map[string]map[string]meta.RegistrationChangeLease),
+		Webhooks:                  make(map[string]data.Webhook),
+		DeadLetters:               make(map[string]map[string]data.DeadLetter),
+		Replays:                   make(map[string]data.Replay),
+		RegistrationsSeen:         make(map[string]int64),
+		RegistrationsAcknowledged: make(map[string]int64),
 	}
 	for id, employee := range m.changes {
 		serializedData.Changes[id] = *employee
 	}
+	m.registrationsMux.RLock()
+	defer m.registrationsMux.RUnlock()
+	for registrationId, filter := range m.registrations {
+		serializedData.Registrations[registrationId] = copyRegistrationFilter(filter)
+	}
+	for registrationId, leases := range m.registrationChanges {
+		serializedData.RegistrationChanges[registrationId] = make(map[string]meta.RegistrationChangeLease)
+		for changeId, lease := range leases {
+			serializedData.RegistrationChanges[registrationId][changeId] = lease
+		}
+	}
+	for registrationId, webhook := range m.webhooks {
+		serializedData.Webhooks[registrationId] = webhook
+	}
+	for registrationId, deadLetters := range m.deadLetters {
+		serializedData.DeadLetters[registrationId] = make(map[string]data.DeadLetter)
+		for changeId, deadLetter := range deadLetters {
+			serializedData.DeadLetters[registrationId][changeId] = deadLetter
+		}
+	}
+	for registrationId, replay := range m.replays {
+		serializedData.Replays[registrationId] = copyReplay(replay)
+	}
+	for registrationId, seen := range m.registrationsSeen {
+		serializedData.RegistrationsSeen[registrationId] = seen
+	}
+	for registrationId, acknowledged := range m.registrationsAcked {
+		serializedData.RegistrationsAcknowledged[registrationId] = acknowledged
+	}
 	return serializedData, nil
 }
 
@@ -150,6 +249,63 @@ func (m *memory) Deserialize(serializedData *meta.SerializedData) error {
 	for id, employee := range serializedData.Changes {
 		m.changes[id] = &employee
 	}
+	m.registrationsMux.Lock()
+	defer m.registrationsMux.Unlock()
+	m.registrations = make(map[string]data.RegistrationFilter)
+	m.registrationChanges = make(map[string]map[string]meta.RegistrationChangeLease)
+	m.deadLetters = make(map[string]map[string]data.DeadLetter)
+	for registrationId, filter := range serializedData.Registrations {
+		m.registrations[registrationId] = copyRegistrationFilter(filter)
+		m.registrationChanges[registrationId] = make(map[string]meta.RegistrationChangeLease)
+		m.deadLetters[registrationId] = make(map[string]data.DeadLetter)
+	}
+	for registrationId, leases := range serializedData.RegistrationChanges {
+		if _, ok := m.registrationChanges[registrationId]; !ok {
+			continue
+		}
+		for changeId, lease := range leases {
+			m.registrationChanges[registrationId][changeId] = lease
+		}
+	}
+	for registrationId, deadLetters := range serializedData.DeadLetters {
+		if _, ok := m.deadLetters[registrationId]; !ok {
+			continue
+		}
+		for changeId, deadLetter := range deadLetters {
+			m.deadLetters[registrationId][changeId] = deadLetter
+		}
+	}
+	m.webhooks = make(map[string]data.Webhook)
+	for registrationId, webhook := range serializedData.Webhooks {
+		if _, ok := m.registrations[registrationId]; !ok {
+			continue
+		}
+		m.webhooks[registrationId] = webhook
+	}
+	m.replays = make(map[string]data.Replay)
+	for registrationId, replay := range serializedData.Replays {
+		if _, ok := m.registrations[registrationId]; !ok {
+			continue
+		}
+		m.replays[registrationId] = copyReplay(replay)
+	}
+	//KIM: registrations that were serialized before they were tracked
+	// are considered seen when they're deserialized
+	m.registrationsSeen = make(map[string]int64)
+	for registrationId := range m.registrations {
+		seen, ok := serializedData.RegistrationsSeen[registrationId]
+		if !ok {
+			seen = time.Now().UnixNano()
+		}
+		m.registrationsSeen[registrationId] = seen
+	}
+	m.registrationsAcked = make(map[string]int64)
+	for registrationId, acknowledged := range serializedData.RegistrationsAcknowledged {
+		if _, ok := m.registrations[registrationId]; !ok {
+			continue
+		}
+		m.registrationsAcked[registrationId] = acknowledged
+	}
 	return nil
 }
 
@@ -181,12 +337,18 @@ func (m *memory) ChangeCreate(ctx context.Context, c data.ChangePartial) (*data.
 	if c.DataAction != nil {
 		change.DataAction = *c.DataAction
 	}
+	//KIM: when changed defaults to now (similar to the database) so
+	// changes without it aren't immediately expired
+	change.WhenChanged = time.Now().UnixNano()
 	if c.WhenChanged != nil {
 		change.WhenChanged = *c.WhenChanged
 	}
 	if c.ChangedBy != nil {
 		change.ChangedBy = *c.ChangedBy
 	}
+	if c.Payload != nil {
+		change.Payload = copyChangePayload(c.Payload)
+	}
 	m.changes[id] = change
 	m.Debug(logAlias+"created change: %s", change.Id)
 	return copyChange(change), nil
@@ -209,13 +371,9 @@ func (m *memory) ChangesDelete(ctx context.Context, changeIds ...string) error {
 	m.registrationsMux.Lock()
 	defer m.registrationsMux.Unlock()
 
-	for _, registrationChanges := range m.registrationChanges {
-		for changeId := range registrationChanges {
-			for _, changeIdToDelete := range changeIds {
-				if changeIdToDelete == changeId {
-					return meta.ErrChangeNotDeletedConflict
-				}
-			}
+	for _, changeId := range changeIds {
+		if m.changeReferenced(changeId) {
+			return meta.ErrChangeNotDeletedConflict
 		}
 	}
 	for _, changeId := range changeIds {
@@ -285,6 +443,11 @@ func (m *memory) ChangesRead(ctx context.Context, search data.ChangeSearch) ([]*
 				return false
 			}
 		}
+		if len(search.Fields) > 0 {
+			if !c.Payload.HasField(search.Fields...) {
+				return false
+			}
+		}
 		switch {
 		case search.Since != nil:
 			if c.WhenChanged < *search.Since {
@@ -313,19 +476,21 @@ func (m *memory) ChangesRead(ctx context.Context, search data.ChangeSearch) ([]*
 	return changes, nil
 }
 
-func (m *memory) RegistrationUpsert(ctx context.Context, registrationId string) error {
+func (m *memory) RegistrationUpsert(ctx context.Context, registrationId string, filter data.RegistrationFilter) error {
 	m.registrationsMux.Lock()
 	defer m.registrationsMux.Unlock()
 
 	if err := m.validateRegistration(registrationId); err != nil {
 		return err
 	}
-	if _, ok := m.registrations[registrationId]; !ok {
-		m.registrations[registrationId] = struct{}{}
-	}
+	m.registrations[registrationId] = copyRegistrationFilter(filter)
 	if _, ok := m.registrationChanges[registrationId]; !ok {
-		m.registrationChanges[registrationId] = make(map[string]struct{})
+		m.registrationChanges[registrationId] = make(map[string]meta.RegistrationChangeLease)
+	}
+	if _, ok := m.deadLetters[registrationId]; !ok {
+		m.deadLetters[registrationId] = make(map[string]data.DeadLetter)
 	}
+	m.registrationsSeen[registrationId] = time.Now().UnixNano()
 	m.Debug(logAlias+"upserted registration: %s", registrationId)
 	return nil
 }
@@ -337,39 +502,97 @@ func (m *memory) RegistrationDelete(ctx context.Context, registrationId string)
 	if !ok {
 		return meta.ErrRegistrationNotFound
 	}
-	delete(m.registrations, registrationId)
-	delete(m.registrationChanges, registrationId)
+	m.registrationDelete(registrationId)
 	m.Debug(logAlias+"deleted registration: %s", registrationId)
 	return nil
 }
 
 func (m *memory) RegistrationChangeUpsert(ctx context.Context, changeId string) error {
+	m.changesMux.RLock()
+	defer m.changesMux.RUnlock()
 	m.registrationsMux.Lock()
 	defer m.registrationsMux.Unlock()
 
 	if err := m.validateRegistrationChange(changeId); err != nil {
 		return err
 	}
-	if len(m.registrationChanges) == 0 {
+	//KIM: a change that doesn't exist can't match a filter, so
+	// it's not attached to any registration
+	change, ok := m.changes[changeId]
+	if !ok || len(m.registrationChanges) == 0 {
 		return nil
 	}
 	for registrationId := range m.registrationChanges {
-		m.registrationChanges[registrationId][changeId] = struct{}{}
+		if filter := m.registrations[registrationId]; !filter.Match(change) {
+			continue
+		}
+		if _, ok := m.registrationChanges[registrationId][changeId]; ok {
+			continue
+		}
+		m.registrationChanges[registrationId][changeId] = meta.RegistrationChangeLease{}
 	}
 	m.Debug(logAlias+"upserted registration change: %s", changeId)
 	return nil
 }
 
-func (m *memory) RegistrationChangesRead(ctx context.Context, registrationId string) ([]string, error) {
-	m.registrationsMux.RLock()
-	defer m.registrationsMux.RUnlock()
+func (m *memory) RegistrationChangesAttach(ctx context.Context, registrationId string, changeIds ...string) error {
+	m.changesMux.RLock()
+	defer m.changesMux.RUnlock()
+	m.registrationsMux.Lock()
+	defer m.registrationsMux.Unlock()
+
+	leases, ok := m.registrationChanges[registrationId]
+	if !ok {
+		return meta.ErrRegistrationNotFound
+	}
+	for _, changeId := range changeIds {
+		//KIM: changes that don't exist can't be attached
+		if _, ok := m.changes[changeId]; !ok {
+			continue
+		}
+		if _, ok := leases[changeId]; ok {
+			continue
+		}
+		if _, ok := m.deadLetters[registrationId][changeId]; ok {
+			continue
+		}
+		leases[changeId] = meta.RegistrationChangeLease{}
+	}
+	m.Debug(logAlias+"attached %d change(s) to registration: %s", len(changeIds), registrationId)
+	return nil
+}
+
+func (m *memory) RegistrationChangesRead(ctx context.Context, registrationId string, visibilityTimeout time.Duration, maxAttempts int) ([]string, error) {
+	m.registrationsMux.Lock()
+	defer m.registrationsMux.Unlock()
 	var changeIds []string
 
-	_, ok := m.registrationChanges[registrationId]
+	leases, ok := m.registrationChanges[registrationId]
 	if !ok {
 		return nil, meta.ErrRegistrationNotFound
 	}
-	for changeId := range m.registrationChanges[registrationId] {
+	tNow := time.Now()
+	m.registrationsSeen[registrationId] = tNow.UnixNano()
+	for changeId, lease := range leases {
+		if lease.LeasedUntil > tNow.UnixNano() {
+			continue
+		}
+		if maxAttempts > 0 && lease.Attempts >= maxAttempts {
+			delete(leases, changeId)
+			m.deadLetters[registrationId][changeId] = data.DeadLetter{
+				RegistrationId: registrationId,
+				ChangeId:       changeId,
+				Attempts:       lease.Attempts,
+				DeadLettered:   tNow.UnixMilli(),
+			}
+			m.Debug(logAlias+"dead-lettered change %s for %s", changeId, registrationId)
+			continue
+		}
+		lease.Attempts++
+		if visibilityTimeout > 0 {
+			lease.LeasedUntil = tNow.Add(visibilityTimeout).UnixNano()
+		}
+		leases[changeId] = lease
 		changeIds = append(changeIds, changeId)
 	}
 	return changeIds, nil
@@ -381,9 +604,295 @@ func (m *memory) RegistrationChangeAcknowledge(ctx context.Context, registration
 	if _, ok := m.registrations[registrationId]; !ok {
 		return nil, meta.ErrRegistrationNotFound
 	}
+	tNow := time.Now().UnixNano()
+	m.registrationsSeen[registrationId], m.registrationsAcked[registrationId] = tNow, tNow
 	for _, changeId := range changeIds {
 		m.Debug(logAlias+"acknowledged change %s for %s", changeId, registrationId)
 		delete(m.registrationChanges[registrationId], changeId)
+		delete(m.deadLetters[registrationId], changeId)
 	}
 	return m.findChangeIdsToDelete(changeIds...)
 }
+
+func (m *memory) DeadLettersRead(ctx context.Context, registrationId string) ([]*data.DeadLetter, error) {
+	m.registrationsMux.RLock()
+	defer m.registrationsMux.RUnlock()
+
+	deadLetters, ok := m.deadLetters[registrationId]
+	if !ok {
+		return nil, meta.ErrRegistrationNotFound
+	}
+	var deadLettersRead []*data.DeadLetter
+	for _, deadLetter := range deadLetters {
+		deadLetter := deadLetter
+		deadLettersRead = append(deadLettersRead, &deadLetter)
+	}
+	return deadLettersRead, nil
+}
+
+func (m *memory) WebhookUpsert(ctx context.Context, webhook data.Webhook) error {
+	m.registrationsMux.Lock()
+	defer m.registrationsMux.Unlock()
+
+	if webhook.Url == "" {
+		return meta.ErrWebhookNotWritten
+	}
+	if _, ok := m.registrations[webhook.RegistrationId]; !ok {
+		return meta.ErrRegistrationNotFound
+	}
+	m.webhooks[webhook.RegistrationId] = webhook
+	m.Debug(logAlias+"upserted webhook: %s", webhook.RegistrationId)
+	return nil
+}
+
+func (m *memory) WebhookRead(ctx context.Context, registrationId string) (*data.Webhook, error) {
+	m.registrationsMux.RLock()
+	defer m.registrationsMux.RUnlock()
+
+	webhook, ok := m.webhooks[registrationId]
+	if !ok {
+		return nil, meta.ErrWebhookNotFound
+	}
+	return &webhook, nil
+}
+
+func (m *memory) WebhooksRead(ctx context.Context) ([]*data.Webhook, error) {
+	m.registrationsMux.RLock()
+	defer m.registrationsMux.RUnlock()
+
+	var webhooks []*data.Webhook
+	for _, webhook := range m.webhooks {
+		webhook := webhook
+		webhooks = append(webhooks, &webhook)
+	}
+	return webhooks, nil
+}
+
+func (m *memory) WebhookDelete(ctx context.Context, registrationId string) error {
+	m.registrationsMux.Lock()
+	defer m.registrationsMux.Unlock()
+
+	if _, ok := m.webhooks[registrationId]; !ok {
+		return meta.ErrWebhookNotFound
+	}
+	delete(m.webhooks, registrationId)
+	m.Debug(logAlias+"deleted webhook: %s", registrationId)
+	return nil
+}
+
+func (m *memory) ReplayUpsert(ctx context.Context, replay data.Replay) error {
+	m.registrationsMux.Lock()
+	defer m.registrationsMux.Unlock()
+
+	if _, ok := m.registrations[replay.RegistrationId]; !ok {
+		return meta.ErrRegistrationNotFound
+	}
+	m.replays[replay.RegistrationId] = copyReplay(replay)
+	m.Debug(logAlias+"upserted replay: %s", replay.RegistrationId)
+	return nil
+}
+
+func (m *memory) ReplayRead(ctx context.Context, registrationId string) (*data.Replay, error) {
+	m.registrationsMux.RLock()
+	defer m.registrationsMux.RUnlock()
+
+	replay, ok := m.replays[registrationId]
+	if !ok {
+		return nil, meta.ErrReplayNotFound
+	}
+	replay = copyReplay(replay)
+	return &replay, nil
+}
+
+func (m *memory) ReplaysRead(ctx context.Context) ([]*data.Replay, error) {
+	m.registrationsMux.RLock()
+	defer m.registrationsMux.RUnlock()
+
+	var replays []*data.Replay
+	for _, replay := range m.replays {
+		replay := copyReplay(replay)
+		replays = append(replays, &replay)
+	}
+	return replays, nil
+}
+
+func (m *memory) ReplayDelete(ctx context.Context, registrationId string) error {
+	m.registrationsMux.Lock()
+	defer m.registrationsMux.Unlock()
+
+	if _, ok := m.replays[registrationId]; !ok {
+		return meta.ErrReplayNotFound
+	}
+	delete(m.replays, registrationId)
+	m.Debug(logAlias+"deleted replay: %s", registrationId)
+	return nil
+}
+
+func (m *memory) ReplayChangesRead(ctx context.Context, replay data.Replay, limit int) ([]*data.Change, error) {
+	m.registrationsMux.RLock()
+	filter, ok := m.registrations[replay.RegistrationId]
+	m.registrationsMux.RUnlock()
+	if !ok {
+		return nil, meta.ErrRegistrationNotFound
+	}
+	changesFound, err := m.ChangesRead(ctx, replay.Search)
+	if err != nil {
+		return nil, err
+	}
+	var changes []*data.Change
+	for _, change := range changesFound {
+		if filter.Match(change) && replay.After(change) {
+			changes = append(changes, change)
+		}
+	}
+	sort.Slice(changes, func(i, j int) bool {
+		if changes[i].WhenChanged != changes[j].WhenChanged {
+			return changes[i].WhenChanged < changes[j].WhenChanged
+		}
+		return changes[i].Id < changes[j].Id
+	})
+	if limit > 0 && len(changes) > limit {
+		changes = changes[:limit]
+	}
+	return changes, nil
+}
+
+func (m *memory) ChangesExpire(ctx context.Context, maxAge time.Duration) ([]string, error) {
+	m.changesMux.Lock()
+	defer m.changesMux.Unlock()
+	m.registrationsMux.Lock()
+	defer m.registrationsMux.Unlock()
+	var changeIds []string
+
+	whenChangedBefore := time.Now().Add(-maxAge).UnixNano()
+	for changeId, change := range m.changes {
+		if change.WhenChanged >= whenChangedBefore {
+			continue
+		}
+		for registrationId := range m.registrationChanges {
+			delete(m.registrationChanges[registrationId], changeId)
+		}
+		for registrationId := range m.deadLetters {
+			delete(m.deadLetters[registrationId], changeId)
+		}
+		delete(m.changes, changeId)
+		changeIds = append(changeIds, changeId)
+		m.Debug(logAlias+"expired change: %s", changeId)
+	}
+	return changeIds, nil
+}
+
+func (m *memory) RegistrationChangesTrim(ctx context.Context, maxPending int) (map[string][]string, []string, error) {
+	m.changesMux.RLock()
+	defer m.changesMux.RUnlock()
+	m.registrationsMux.Lock()
+	defer m.registrationsMux.Unlock()
+	var changeIdsTrimmed []string
+
+	changeIdsTrimmedByRegistration := make(map[string][]string)
+	for registrationId, leases := range m.registrationChanges {
+		if len(leases) <= maxPending {
+			continue
+		}
+		changeIds := make([]string, 0, len(leases))
+		for changeId := range leases {
+			changeIds = append(changeIds, changeId)
+		}
+		//KIM: changes are trimmed oldest first, changes that don't
+		// exist are considered the oldest
+		whenChangedFx := func(changeId string) int64 {
+			if change, ok := m.changes[changeId]; ok {
+				return change.WhenChanged
+			}
+			return 0
+		}
+		sort.Slice(changeIds, func(i, j int) bool {
+			iWhenChanged, jWhenChanged := whenChangedFx(changeIds[i]), whenChangedFx(changeIds[j])
+			if iWhenChanged != jWhenChanged {
+				return iWhenChanged < jWhenChanged
+			}
+			return changeIds[i] < changeIds[j]
+		})
+		changeIds = changeIds[:len(changeIds)-maxPending]
+		for _, changeId := range changeIds {
+			delete(leases, changeId)
+		}
+		changeIdsTrimmedByRegistration[registrationId] = changeIds
+		changeIdsTrimmed = append(changeIdsTrimmed, changeIds...)
+		m.Debug(logAlias+"trimmed %d change(s) for %s", len(changeIds), registrationId)
+	}
+	changeIdsToPrune, err := m.findChangeIdsToDelete(changeIdsTrimmed...)
+	if err != nil {
+		return nil, nil, err
+	}
+	return changeIdsTrimmedByRegistration, changeIdsToPrune, nil
+}
+
+func (m *memory) RegistrationsExpire(ctx context.Context, expiry time.Duration) ([]string, []string, error) {
+	m.registrationsMux.Lock()
+	defer m.registrationsMux.Unlock()
+	var registrationIds, changeIds []string
+
+	seenBefore := time.Now().Add(-expiry).UnixNano()
+	for registrationId := range m.registrations {
+		if m.registrationsSeen[registrationId] >= seenBefore {
+			continue
+		}
+		changeIds = append(changeIds, m.registrationDelete(registrationId)...)
+		registrationIds = append(registrationIds, registrationId)
+		m.Debug(logAlias+"expired registration: %s", registrationId)
+	}
+	changeIdsToPrune, err := m.findChangeIdsToDelete(changeIds...)
+	if err != nil {
+		return nil, nil, err
+	}
+	return registrationIds, changeIdsToPrune, nil
+}
+
+func (m *memory) RegistrationsSeen(ctx context.Context, registrationIds ...string) error {
+	m.registrationsMux.Lock()
+	defer m.registrationsMux.Unlock()
+
+	tNow := time.Now().UnixNano()
+	for _, registrationId := range registrationIds {
+		if _, ok := m.registrations[registrationId]; !ok {
+			continue
+		}
+		m.registrationsSeen[registrationId] = tNow
+	}
+	return nil
+}
+
+func (m *memory) RegistrationsMetricsRead(ctx context.Context) ([]*data.RegistrationMetrics, error) {
+	m.changesMux.RLock()
+	defer m.changesMux.RUnlock()
+	m.registrationsMux.RLock()
+	defer m.registrationsMux.RUnlock()
+	var registrationsMetrics []*data.RegistrationMetrics
+
+	tNow := time.Now().UnixNano()
+	for registrationId := range m.registrations {
+		registrationMetrics := &data.RegistrationMetrics{
+			RegistrationId:   registrationId,
+			Pending:          len(m.registrationChanges[registrationId]),
+			DeadLettered:     len(m.deadLetters[registrationId]),
+			LastAcknowledged: m.registrationsAcked[registrationId] / int64(time.Millisecond),
+			LastSeen:         m.registrationsSeen[registrationId] / int64(time.Millisecond),
+		}
+		var oldestWhenChanged int64
+		for changeId := range m.registrationChanges[registrationId] {
+			change, ok := m.changes[changeId]
+			if !ok {
+				continue
+			}
+			if oldestWhenChanged == 0 || change.WhenChanged < oldestWhenChanged {
+				oldestWhenChanged = change.WhenChanged
+			}
+		}
+		if oldestWhenChanged > 0 {
+			registrationMetrics.OldestPendingAge = (tNow - oldestWhenChanged) / int64(time.Millisecond)
+		}
+		registrationsMetrics = append(registrationsMetrics, registrationMetrics)
+	}
+	return registrationsMetrics, nil
+}
@@ -3,7 +3,9 @@ package mysql
 import (
 	"context"
 	"database/sql"
+	"encoding/json"
 	"fmt"
+	"strings"
 
 	"github.com/antonio-alexander/go-bludgeon/changes/data"
 	"github.com/antonio-alexander/go-bludgeon/changes/meta"
@@ -23,11 +25,24 @@ func rowsAffected(result sql.Result, errIfNoRowsAffected error) error {
 	return nil
 }
 
+// filterMarshal can be used to convert the values of a registration
+// filter into a JSON array, an empty filter is stored as NULL
+func filterMarshal(values []string) (interface{}, error) {
+	if len(values) == 0 {
+		return nil, nil
+	}
+	bytes, err := json.Marshal(values)
+	if err != nil {
+		return nil, err
+	}
+	return string(bytes), nil
+}
+
 func changeRead(ctx context.Context, db interface {
 	ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error)
 	QueryRowContext(ctx context.Context, query string, args ...interface{}) *sql.Row
 }, id interface{}) (*data.Change, error) {
-	var action, dataType, service, changedBy sql.NullString
+	var action, dataType, service, changedBy, payload sql.NullString
 	var whenChanged sql.NullFloat64
 	var condition string
 
@@ -38,7 +53,7 @@ func changeRead(ctx context.Context, db interface {
 		condition = fmt.Sprintf("change_id = (SELECT id FROM %s WHERE aux_id = ?)", tableChanges)
 	}
 	query := fmt.Sprintf(`SELECT change_id, data_id, version, type,
-		service, action, when_changed, changed_by FROM %s WHERE %s;`,
+		service, action, when_changed, changed_by, payload FROM %s WHERE %s;`,
 		tableChangesV1, condition)
 	row := db.QueryRowContext(ctx, query, id)
 	change := &data.Change{}
@@ -51,6 +66,7 @@ func changeRead(ctx context.Context, db interface {
 		&action,
 		&whenChanged,
 		&changedBy,
+		&payload,
 	); err != nil {
 		switch {
 		default:
@@ -62,5 +78,234 @@ func changeRead(ctx context.Context, db interface {
 	change.DataType, change.DataServiceName = dataType.String, service.String
 	change.ChangedBy, change.WhenChanged = changedBy.String, int64(whenChanged.Float64*1000)
 	change.DataAction = action.String
+	changePayload, err := changePayloadUnmarshal(payload)
+	if err != nil {
+		return nil, err
+	}
+	change.Payload = changePayload
 	return change, nil
 }
+
+// changePayloadUnmarshal will convert the payload column (JSON) into
+// a change payload, a null payload is nil
+func changePayloadUnmarshal(payload sql.NullString) (*data.ChangePayload, error) {
+	if !payload.Valid || payload.String == "" {
+		return nil, nil
+	}
+	changePayload := &data.ChangePayload{}
+	if err := json.Unmarshal([]byte(payload.String), changePayload); err != nil {
+		return nil, err
+	}
+	return changePayload, nil
+}
+
+func webhookScan(scanFx func(...interface{}) error) (*data.Webhook, error) {
+	var secret sql.NullString
+
+	webhook := &data.Webhook{}
+	if err := scanFx(
+		&webhook.RegistrationId,
+		&webhook.Url,
+		&secret,
+	); err != nil {
+		switch {
+		default:
+			return nil, err
+		case err == sql.ErrNoRows:
+			return nil, meta.ErrWebhookNotFound
+		}
+	}
+	webhook.Secret = secret.String
+	return webhook, nil
+}
+
+func replayScan(scanFx func(...interface{}) error) (*data.Replay, error) {
+	var search, lastChangeId, replayError sql.NullString
+
+	replay := &data.Replay{}
+	if err := scanFx(
+		&replay.RegistrationId,
+		&search,
+		&replay.Status,
+		&lastChangeId,
+		&replay.LastWhenChanged,
+		&replay.Replayed,
+		&replayError,
+	); err != nil {
+		switch {
+		default:
+			return nil, err
+		case err == sql.ErrNoRows:
+			return nil, meta.ErrReplayNotFound
+		}
+	}
+	if search.Valid && search.String != "" {
+		if err := json.Unmarshal([]byte(search.String), &replay.Search); err != nil {
+			return nil, err
+		}
+	}
+	replay.LastChangeId, replay.Error = lastChangeId.String, replayError.String
+	return replay, nil
+}
+
+func registrationMetricsScan(scanFx func(...interface{}) error) (*data.RegistrationMetrics, error) {
+	var oldestPendingAge sql.NullInt64
+	var lastAcknowledged, lastSeen sql.NullFloat64
+
+	registrationMetrics := &data.RegistrationMetrics{}
+	if err := scanFx(
+		&registrationMetrics.RegistrationId,
+		&registrationMetrics.Pending,
+		&registrationMetrics.DeadLettered,
+		&oldestPendingAge,
+		&lastAcknowledged,
+		&lastSeen,
+	); err != nil {
+		return nil, err
+	}
+	if oldestPendingAge.Valid {
+		registrationMetrics.OldestPendingAge = oldestPendingAge.Int64 / 1000
+	}
+	if lastAcknowledged.Valid {
+		registrationMetrics.LastAcknowledged = int64(lastAcknowledged.Float64 * 1000)
+	}
+	if lastSeen.Valid {
+		registrationMetrics.LastSeen = int64(lastSeen.Float64 * 1000)
+	}
+	return registrationMetrics, nil
+}
+
+// changeIdsUnreferenced can be used to determine which of the given changes are
+// no longer pending (or dead-lettered) for any registration
+func changeIdsUnreferenced(ctx context.Context, db interface {
+	QueryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error)
+}, changeIds ...string) ([]string, error) {
+	var parameters []string
+	var args []interface{}
+
+	if len(changeIds) == 0 {
+		return nil, nil
+	}
+	for _, changeId := range changeIds {
+		parameters = append(parameters, "?")
+		args = append(args, changeId)
+	}
+	query := fmt.Sprintf(`SELECT id FROM %s WHERE id IN(%s)
+		AND id NOT IN(SELECT change_id FROM %s)
+		AND id NOT IN(SELECT change_id FROM %s);`,
+		tableChanges, strings.Join(parameters, ","), tableRegistrationChanges, tableDeadLetters)
+	rows, err := db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var changeIdsUnreferenced []string
+	for rows.Next() {
+		var changeId string
+
+		if err := rows.Scan(&changeId); err != nil {
+			return nil, err
+		}
+		changeIdsUnreferenced = append(changeIdsUnreferenced, changeId)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return changeIdsUnreferenced, nil
+}
+
+// changeSearchParameters can be used to convert a change search into the
+// conditions (and their arguments) used to query the changes view
+func changeSearchParameters(search data.ChangeSearch) ([]string, []interface{}) {
+	var searchParameters []string
+	var args []interface{}
+
+	if changeIds := search.ChangeIds; len(changeIds) > 0 {
+		var parameters []string
+		for _, changeId := range changeIds {
+			args = append(args, changeId)
+			parameters = append(parameters, "?")
+		}
+		searchParameters = append(searchParameters, fmt.Sprintf("change_id IN(%s)", strings.Join(parameters, ",")))
+	}
+	if dataIds := search.DataIds; len(dataIds) > 0 {
+		var parameters []string
+		for _, dataId := range dataIds {
+			args = append(args, dataId)
+			parameters = append(parameters, "?")
+		}
+		searchParameters = append(searchParameters, fmt.Sprintf("data_id IN(%s)", strings.Join(parameters, ",")))
+	}
+	if dataTypes := search.Types; len(dataTypes) > 0 {
+		var parameters []string
+		for _, dataType := range dataTypes {
+			args = append(args, dataType)
+			parameters = append(parameters, "?")
+		}
+		searchParameters = append(searchParameters, fmt.Sprintf("type IN(%s)", strings.Join(parameters, ",")))
+	}
+	if actions := search.Actions; len(actions) > 0 {
+		var parameters []string
+		for _, action := range actions {
+			args = append(args, action)
+			parameters = append(parameters, "?")
+		}
+		searchParameters = append(searchParameters, fmt.Sprintf("action IN(%s)", strings.Join(parameters, ",")))
+	}
+	if serviceNames := search.ServiceNames; len(serviceNames) > 0 {
+		var parameters []string
+		for _, serviceName := range serviceNames {
+			args = append(args, serviceName)
+			parameters = append(parameters, "?")
+		}
+		searchParameters = append(searchParameters, fmt.Sprintf("service IN(%s)", strings.Join(parameters, ",")))
+	}
+	if search.Since != nil {
+		searchParameters = append(searchParameters, "when_changed >= ?")
+		args = append(args, search.Since)
+	}
+	if fields := search.Fields; len(fields) > 0 {
+		var parameters []string
+		for _, field := range fields {
+			args = append(args, field)
+			parameters = append(parameters, "JSON_CONTAINS(JSON_EXTRACT(payload, '$.diff[*].field'), JSON_QUOTE(?))")
+		}
+		searchParameters = append(searchParameters, fmt.Sprintf("(%s)", strings.Join(parameters, " OR ")))
+	}
+	return searchParameters, args
+}
+
+// changesScan can be used to scan the rows of a query of the changes view
+func changesScan(rows *sql.Rows) ([]*data.Change, error) {
+	var changes []*data.Change
+
+	for rows.Next() {
+		var action, dataType, service, changedBy, payload sql.NullString
+		var whenChanged sql.NullFloat64
+
+		change := &data.Change{}
+		if err := rows.Scan(
+			&change.Id,
+			&change.DataId,
+			&change.DataVersion,
+			&dataType,
+			&service,
+			&action,
+			&whenChanged,
+			&changedBy,
+			&payload,
+		); err != nil {
+			return nil, err
+		}
+		change.DataType, change.DataServiceName = dataType.String, service.String
+		change.ChangedBy, change.WhenChanged = changedBy.String, int64(whenChanged.Float64*1000)
+		change.DataAction = action.String
+		changePayload, err := changePayloadUnmarshal(payload)
+		if err != nil {
+			return nil, err
+		}
+		change.Payload = changePayload
+		changes = append(changes, change)
+	}
+	return changes, nil
+}
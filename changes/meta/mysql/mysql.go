@@ -3,6 +3,7 @@ package mysql
 import (
 	"context"
 	"database/sql"
+	"encoding/json"
 	"fmt"
 	"strings"
 	"sync"
@@ -30,6 +31,11 @@ func New() interface {
 	meta.Change
 	meta.Registration
 	meta.RegistrationChange
+	meta.Webhook
+	meta.DeadLetter
+	meta.Replay
+	meta.Retention
+	meta.Metrics
 	internal.Initializer
 	internal.Configurer
 	internal.Parameterizer
@@ -93,10 +99,20 @@ func (m *mysql) ChangeCreate(ctx context.Context, changePartial data.ChangeParti
 		values = append(values, "?")
 		columns = append(columns, "changed_by")
 	}
+	if payload := changePartial.Payload; payload != nil {
+		bytes, err := json.Marshal(payload)
+		if err != nil {
+			return nil, err
+		}
+		args = append(args, string(bytes))
+		values = append(values, "?")
+		columns = append(columns, "payload")
+	}
 	tx, err := m.Begin()
 	if err != nil {
 		return nil, err
 	}
+	defer tx.Rollback()
 	//REVIEW: should we add "ON DUPLICATE DO NOTHING" to this to allow inserting?
 	query := fmt.Sprintf("INSERT INTO %s(%s) VALUES(%s);", tableChanges, strings.Join(columns, ","), strings.Join(values, ","))
 	result, err := tx.ExecContext(ctx, query, args...)
@@ -110,6 +126,10 @@ func (m *mysql) ChangeCreate(ctx context.Context, changePartial data.ChangeParti
 				return nil, err
 			case 1364:
 				return nil, errors.Wrap(err, meta.ChangeConflictWrite)
+			case 1062:
+				//KIM: the change has already been created (duplicate
+				// data id, version, type, service and action)
+				return nil, meta.ErrChangeConflictWrite
 			}
 		}
 	}
@@ -154,61 +174,16 @@ func (m *mysql) ChangesDelete(ctx context.Context, changeIds ...string) error {
 }
 
 func (m *mysql) ChangesRead(ctx context.Context, search data.ChangeSearch) ([]*data.Change, error) {
-	var searchParameters []string
-	var args []interface{}
 	var query string
 
-	if changeIds := search.ChangeIds; len(changeIds) > 0 {
-		var parameters []string
-		for _, changeId := range changeIds {
-			args = append(args, changeId)
-			parameters = append(parameters, "?")
-		}
-		searchParameters = append(searchParameters, fmt.Sprintf("change_id IN(%s)", strings.Join(parameters, ",")))
-	}
-	if dataIds := search.DataIds; len(dataIds) > 0 {
-		var parameters []string
-		for _, dataId := range dataIds {
-			args = append(args, dataId)
-			parameters = append(parameters, "?")
-		}
-		searchParameters = append(searchParameters, fmt.Sprintf("data_id IN(%s)", strings.Join(parameters, ",")))
-	}
-	if dataTypes := search.Types; len(dataTypes) > 0 {
-		var parameters []string
-		for _, dataType := range dataTypes {
-			args = append(args, dataType)
-			parameters = append(parameters, "?")
-		}
-		searchParameters = append(searchParameters, fmt.Sprintf("type IN(%s)", strings.Join(parameters, ",")))
-	}
-	if actions := search.Actions; len(actions) > 0 {
-		var parameters []string
-		for _, action := range actions {
-			args = append(args, action)
-			parameters = append(parameters, "?")
-		}
-		searchParameters = append(searchParameters, fmt.Sprintf("action IN(%s)", strings.Join(parameters, ",")))
-	}
-	if serviceNames := search.ServiceNames; len(serviceNames) > 0 {
-		var parameters []string
-		for _, serviceName := range serviceNames {
-			args = append(args, serviceName)
-			parameters = append(parameters, "?")
-		}
-		searchParameters = append(searchParameters, fmt.Sprintf("service IN(%s)", strings.Join(parameters, ",")))
-	}
-	if search.Since != nil {
-		searchParameters = append(searchParameters, "when_changed >= ?")
-		args = append(args, search.Since)
-	}
+	searchParameters, args := changeSearchParameters(search)
 	if len(searchParameters) > 0 {
 		query = fmt.Sprintf(`SELECT change_id, data_id, version, type,
-		service, action, when_changed, changed_by FROM %s WHERE %s`,
+		service, action, when_changed, changed_by, payload FROM %s WHERE %s`,
 			tableChangesV1, strings.Join(searchParameters, " AND "))
 	} else {
 		query = fmt.Sprintf(`SELECT change_id, data_id, version, type,
-		service, action, when_changed, changed_by FROM %s`, tableChangesV1)
+		service, action, when_changed, changed_by, payload FROM %s`, tableChangesV1)
 	}
 	rows, err := m.QueryContext(ctx, query, args...)
 	if err != nil {
@@ -228,35 +203,26 @@ func (m *mysql) ChangesRead(ctx context.Context, search data.ChangeSearch) ([]*d
 		}
 	}
 	defer rows.Close()
-	var changes []*data.Change
-	for rows.Next() {
-		var action, dataType, service, changedBy sql.NullString
-		var whenChanged sql.NullFloat64
-
-		change := &data.Change{}
-		if err := rows.Scan(
-			&change.Id,
-			&change.DataId,
-			&change.DataVersion,
-			&dataType,
-			&service,
-			&action,
-			&whenChanged,
-			&changedBy,
-		); err != nil {
-			return nil, err
-		}
-		change.DataType, change.DataServiceName = dataType.String, service.String
-		change.ChangedBy, change.WhenChanged = changedBy.String, int64(whenChanged.Float64*1000)
-		change.DataAction = action.String
-		changes = append(changes, change)
-	}
-	return changes, nil
+	return changesScan(rows)
 }
 
-func (m *mysql) RegistrationUpsert(ctx context.Context, registrationId string) error {
-	query := fmt.Sprintf("INSERT INTO %s(id) VALUES(?) ON DUPLICATE KEY UPDATE id=?;", tableRegistrations)
-	result, err := m.ExecContext(ctx, query, registrationId, registrationId)
+func (m *mysql) RegistrationUpsert(ctx context.Context, registrationId string, filter data.RegistrationFilter) error {
+	serviceNames, err := filterMarshal(filter.ServiceNames)
+	if err != nil {
+		return err
+	}
+	types, err := filterMarshal(filter.Types)
+	if err != nil {
+		return err
+	}
+	actions, err := filterMarshal(filter.Actions)
+	if err != nil {
+		return err
+	}
+	query := fmt.Sprintf(`INSERT INTO %s(id, filter_service_names, filter_types, filter_actions) VALUES(?, ?, ?, ?)
+		ON DUPLICATE KEY UPDATE filter_service_names=VALUES(filter_service_names),
+		filter_types=VALUES(filter_types), filter_actions=VALUES(filter_actions), last_seen=NOW(6);`, tableRegistrations)
+	result, err := m.ExecContext(ctx, query, registrationId, serviceNames, types, actions)
 	if err != nil {
 		switch err := err.(type) {
 		default:
@@ -293,8 +259,14 @@ func (m *mysql) RegistrationDelete(ctx context.Context, registrationId string) e
 }
 
 func (m *mysql) RegistrationChangeUpsert(ctx context.Context, changeId string) error {
-	query := fmt.Sprintf("INSERT INTO %s(registration_id, change_id) SELECT DISTINCT id AS registration_id, ? AS change_id FROM %s;",
-		tableRegistrationChanges, tableRegistrations)
+	//KIM: a null filter matches everything, otherwise the change's
+	// value must be contained within the filter (JSON array)
+	query := fmt.Sprintf(`INSERT INTO %s(registration_id, change_id)
+		SELECT DISTINCT r.id AS registration_id, c.id AS change_id FROM %s AS r JOIN %s AS c ON c.id = ?
+		WHERE (r.filter_service_names IS NULL OR JSON_CONTAINS(r.filter_service_names, JSON_QUOTE(c.service)))
+		AND (r.filter_types IS NULL OR JSON_CONTAINS(r.filter_types, JSON_QUOTE(c.type)))
+		AND (r.filter_actions IS NULL OR JSON_CONTAINS(r.filter_actions, JSON_QUOTE(c.action)));`,
+		tableRegistrationChanges, tableRegistrations, tableChanges)
 	if _, err := m.ExecContext(ctx, query, changeId); err != nil {
 		switch err := err.(type) {
 		default:
@@ -313,15 +285,100 @@ func (m *mysql) RegistrationChangeUpsert(ctx context.Context, changeId string) e
 	return nil
 }
 
-func (m *mysql) RegistrationChangesRead(ctx context.Context, registrationId string) ([]string, error) {
+func (m *mysql) RegistrationChangesAttach(ctx context.Context, registrationId string, changeIds ...string) error {
+	var parameters []string
+
+	if len(changeIds) == 0 {
+		return nil
+	}
+	args := []interface{}{registrationId}
+	for _, changeId := range changeIds {
+		parameters = append(parameters, "?")
+		args = append(args, changeId)
+	}
+	args = append(args, registrationId)
+	//KIM: changes that are already pending are ignored (primary key), changes
+	// that have been dead-lettered are filtered out
+	query := fmt.Sprintf(`INSERT IGNORE INTO %s(registration_id, change_id)
+		SELECT ?, c.id FROM %s AS c WHERE c.id IN(%s)
+		AND c.id NOT IN(SELECT change_id FROM %s WHERE registration_id=?);`,
+		tableRegistrationChanges, tableChanges, strings.Join(parameters, ","), tableDeadLetters)
+	result, err := m.ExecContext(ctx, query, args...)
+	if err != nil {
+		switch err := err.(type) {
+		default:
+			return err
+		case *driver_mysql.MySQLError:
+			switch err.Number {
+			default:
+				return err
+			case 1452:
+				//KIM: foreign key constraint fails, the registration
+				// doesn't exist
+				return meta.ErrRegistrationNotFound
+			}
+		}
+	}
+	//KIM: INSERT IGNORE downgrades the foreign key error to a warning, so
+	// if nothing was attached, confirm that the registration exists
+	if n, err := result.RowsAffected(); err == nil && n == 0 {
+		query = fmt.Sprintf("SELECT registration_id FROM %s WHERE registration_id=?;", tableRegistrationsV1)
+		if err := m.QueryRowContext(ctx, query, registrationId).Scan(new(string)); err != nil {
+			switch {
+			default:
+				return err
+			case err == sql.ErrNoRows:
+				return meta.ErrRegistrationNotFound
+			}
+		}
+	}
+	return nil
+}
+
+func (m *mysql) RegistrationChangesRead(ctx context.Context, registrationId string, visibilityTimeout time.Duration, maxAttempts int) ([]string, error) {
 	var changeIds []string
 
-	query := fmt.Sprintf(`SELECT change_id FROM %s WHERE registration_id=?`, tableRegistrationChanges)
-	rows, err := m.QueryContext(ctx, query, registrationId)
+	tx, err := m.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+	query := fmt.Sprintf("SELECT registration_id FROM %s WHERE registration_id=? FOR UPDATE;", tableRegistrationsV1)
+	if err := tx.QueryRowContext(ctx, query, registrationId).Scan(new(string)); err != nil {
+		switch {
+		default:
+			return nil, err
+		case err == sql.ErrNoRows:
+			return nil, meta.ErrRegistrationNotFound
+		}
+	}
+	query = fmt.Sprintf("UPDATE %s SET last_seen=NOW(6) WHERE id=?;", tableRegistrations)
+	if _, err := tx.ExecContext(ctx, query, registrationId); err != nil {
+		return nil, err
+	}
+	if maxAttempts > 0 {
+		//KIM: changes whose lease has expired and that have been delivered
+		// max attempts times are moved to the dead-letter table
+		condition := "registration_id=? AND attempts>=? AND (leased_until IS NULL OR leased_until<=NOW(6))"
+		query = fmt.Sprintf(`INSERT INTO %s(registration_id, change_id, attempts)
+			SELECT registration_id, change_id, attempts FROM %s WHERE %s;`,
+			tableDeadLetters, tableRegistrationChanges, condition)
+		if _, err := tx.ExecContext(ctx, query, registrationId, maxAttempts); err != nil {
+			return nil, err
+		}
+		query = fmt.Sprintf("DELETE FROM %s WHERE %s;", tableRegistrationChanges, condition)
+		if _, err := tx.ExecContext(ctx, query, registrationId, maxAttempts); err != nil {
+			return nil, err
+		}
+	}
+	query = fmt.Sprintf(`SELECT change_id FROM %s WHERE registration_id=?
+		AND (leased_until IS NULL OR leased_until<=NOW(6)) FOR UPDATE;`, tableRegistrationChanges)
+	rows, err := tx.QueryContext(ctx, query, registrationId)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
+	var parameters []string
 	for rows.Next() {
 		var changeId string
 
@@ -331,51 +388,521 @@ func (m *mysql) RegistrationChangesRead(ctx context.Context, registrationId stri
 			return nil, err
 		}
 		changeIds = append(changeIds, changeId)
+		parameters = append(parameters, "?")
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	if len(changeIds) == 0 {
+		return nil, tx.Commit()
+	}
+	leasedUntil := "NULL"
+	args := []interface{}{registrationId}
+	if visibilityTimeout > 0 {
+		leasedUntil = "NOW(6) + INTERVAL ? MICROSECOND"
+		args = append([]interface{}{visibilityTimeout.Microseconds()}, args...)
+	}
+	for _, changeId := range changeIds {
+		args = append(args, changeId)
+	}
+	query = fmt.Sprintf("UPDATE %s SET attempts=attempts+1, leased_until=%s WHERE registration_id=? AND change_id IN(%s);",
+		tableRegistrationChanges, leasedUntil, strings.Join(parameters, ","))
+	if _, err := tx.ExecContext(ctx, query, args...); err != nil {
+		return nil, err
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, err
 	}
 	return changeIds, nil
 }
 
 func (m *mysql) RegistrationChangeAcknowledge(ctx context.Context, registrationId string, changeIds ...string) ([]string, error) {
 	var parameters []string
+	var nRows int64
 
 	args := []interface{}{registrationId}
 	for _, changeId := range changeIds {
 		args = append(args, changeId)
 		parameters = append(parameters, "?")
 	}
-	query := fmt.Sprintf("DELETE FROM %s WHERE registration_id=? AND change_id IN(%s)", tableRegistrationChanges, strings.Join(parameters, ","))
-	result, err := m.ExecContext(ctx, query, args...)
+	tx, err := m.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+	query := fmt.Sprintf("UPDATE %s SET last_seen=NOW(6), last_acknowledged=NOW(6) WHERE id=?;", tableRegistrations)
+	if _, err := tx.ExecContext(ctx, query, registrationId); err != nil {
+		return nil, err
+	}
+	//KIM: acknowledging a dead-lettered change discards it
+	for _, table := range []string{tableRegistrationChanges, tableDeadLetters} {
+		query := fmt.Sprintf("DELETE FROM %s WHERE registration_id=? AND change_id IN(%s)", table, strings.Join(parameters, ","))
+		result, err := tx.ExecContext(ctx, query, args...)
+		if err != nil {
+			switch err := err.(type) {
+			default:
+				return nil, err
+			case *driver_mysql.MySQLError:
+				switch err.Number {
+				default:
+					return nil, err
+				case 1364:
+					return nil, meta.ErrChangeConflictWrite
+				}
+			}
+		}
+		n, err := result.RowsAffected()
+		if err != nil {
+			return nil, err
+		}
+		nRows += n
+	}
+	if nRows <= 0 {
+		return nil, meta.ErrChangeNotWritten
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	query = fmt.Sprintf(`SELECT change_id FROM %s WHERE change_id NOT IN(SELECT change_id FROM %s)
+		AND change_id NOT IN(SELECT change_id FROM %s);`,
+		tableChangesV1, tableRegistrationChanges, tableDeadLetters)
+	rows, err := m.QueryContext(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var changeIdsToPrune []string
+	for rows.Next() {
+		var changeId string
+
+		if err := rows.Scan(&changeId); err != nil {
+			return nil, err
+		}
+		changeIdsToPrune = append(changeIdsToPrune, changeId)
+	}
+	return changeIdsToPrune, nil
+}
+
+func (m *mysql) DeadLettersRead(ctx context.Context, registrationId string) ([]*data.DeadLetter, error) {
+	query := fmt.Sprintf("SELECT registration_id FROM %s WHERE registration_id=?;", tableRegistrationsV1)
+	if err := m.QueryRowContext(ctx, query, registrationId).Scan(new(string)); err != nil {
+		switch {
+		default:
+			return nil, err
+		case err == sql.ErrNoRows:
+			return nil, meta.ErrRegistrationNotFound
+		}
+	}
+	query = fmt.Sprintf(`SELECT registration_id, change_id, attempts, dead_lettered
+		FROM %s WHERE registration_id=?;`, tableDeadLettersV1)
+	rows, err := m.QueryContext(ctx, query, registrationId)
 	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var deadLetters []*data.DeadLetter
+	for rows.Next() {
+		var deadLettered sql.NullFloat64
+
+		deadLetter := &data.DeadLetter{}
+		if err := rows.Scan(
+			&deadLetter.RegistrationId,
+			&deadLetter.ChangeId,
+			&deadLetter.Attempts,
+			&deadLettered,
+		); err != nil {
+			return nil, err
+		}
+		deadLetter.DeadLettered = int64(deadLettered.Float64 * 1000)
+		deadLetters = append(deadLetters, deadLetter)
+	}
+	return deadLetters, rows.Err()
+}
+
+func (m *mysql) WebhookUpsert(ctx context.Context, webhook data.Webhook) error {
+	if webhook.Url == "" {
+		return meta.ErrWebhookNotWritten
+	}
+	query := fmt.Sprintf(`INSERT INTO %s(registration_id, url, secret) VALUES(?, ?, ?)
+		ON DUPLICATE KEY UPDATE url=VALUES(url), secret=VALUES(secret);`, tableWebhooks)
+	if _, err := m.ExecContext(ctx, query, webhook.RegistrationId, webhook.Url, webhook.Secret); err != nil {
 		switch err := err.(type) {
 		default:
+			return err
+		case *driver_mysql.MySQLError:
+			switch err.Number {
+			default:
+				return err
+			case 1452:
+				//KIM: foreign key constraint fails, the registration
+				// doesn't exist
+				return meta.ErrRegistrationNotFound
+			}
+		}
+	}
+	//KIM: this could affect no rows if the webhook hasn't changed so we
+	// shouldn't specifically check to see if rows were affected
+	return nil
+}
+
+func (m *mysql) WebhookRead(ctx context.Context, registrationId string) (*data.Webhook, error) {
+	query := fmt.Sprintf("SELECT registration_id, url, secret FROM %s WHERE registration_id=?;", tableWebhooksV1)
+	return webhookScan(m.QueryRowContext(ctx, query, registrationId).Scan)
+}
+
+func (m *mysql) WebhooksRead(ctx context.Context) ([]*data.Webhook, error) {
+	query := fmt.Sprintf("SELECT registration_id, url, secret FROM %s;", tableWebhooksV1)
+	rows, err := m.QueryContext(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var webhooks []*data.Webhook
+	for rows.Next() {
+		webhook, err := webhookScan(rows.Scan)
+		if err != nil {
 			return nil, err
+		}
+		webhooks = append(webhooks, webhook)
+	}
+	return webhooks, rows.Err()
+}
+
+func (m *mysql) WebhookDelete(ctx context.Context, registrationId string) error {
+	query := fmt.Sprintf("DELETE FROM %s WHERE registration_id=?;", tableWebhooks)
+	result, err := m.ExecContext(ctx, query, registrationId)
+	if err != nil {
+		return err
+	}
+	return rowsAffected(result, meta.ErrWebhookNotFound)
+}
+
+func (m *mysql) ReplayUpsert(ctx context.Context, replay data.Replay) error {
+	search, err := json.Marshal(replay.Search)
+	if err != nil {
+		return err
+	}
+	query := fmt.Sprintf(`INSERT INTO %s(registration_id, search, status, last_change_id, last_when_changed, replayed, error)
+		VALUES(?, ?, ?, NULLIF(?, ''), ?, ?, NULLIF(?, ''))
+		ON DUPLICATE KEY UPDATE search=VALUES(search), status=VALUES(status), last_change_id=VALUES(last_change_id),
+		last_when_changed=VALUES(last_when_changed), replayed=VALUES(replayed), error=VALUES(error);`, tableReplays)
+	if _, err := m.ExecContext(ctx, query, replay.RegistrationId, string(search), replay.Status,
+		replay.LastChangeId, replay.LastWhenChanged, replay.Replayed, replay.Error); err != nil {
+		switch err := err.(type) {
+		default:
+			return err
 		case *driver_mysql.MySQLError:
 			switch err.Number {
+			default:
+				return err
+			case 1452:
+				//KIM: foreign key constraint fails, the registration
+				// doesn't exist
+				return meta.ErrRegistrationNotFound
+			}
+		}
+	}
+	return nil
+}
+
+func (m *mysql) ReplayRead(ctx context.Context, registrationId string) (*data.Replay, error) {
+	query := fmt.Sprintf(`SELECT registration_id, search, status, last_change_id, last_when_changed,
+		replayed, error FROM %s WHERE registration_id=?;`, tableReplaysV1)
+	return replayScan(m.QueryRowContext(ctx, query, registrationId).Scan)
+}
+
+func (m *mysql) ReplaysRead(ctx context.Context) ([]*data.Replay, error) {
+	query := fmt.Sprintf(`SELECT registration_id, search, status, last_change_id, last_when_changed,
+		replayed, error FROM %s;`, tableReplaysV1)
+	rows, err := m.QueryContext(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var replays []*data.Replay
+	for rows.Next() {
+		replay, err := replayScan(rows.Scan)
+		if err != nil {
+			return nil, err
+		}
+		replays = append(replays, replay)
+	}
+	return replays, rows.Err()
+}
+
+func (m *mysql) ReplayDelete(ctx context.Context, registrationId string) error {
+	query := fmt.Sprintf("DELETE FROM %s WHERE registration_id=?;", tableReplays)
+	result, err := m.ExecContext(ctx, query, registrationId)
+	if err != nil {
+		return err
+	}
+	return rowsAffected(result, meta.ErrReplayNotFound)
+}
+
+func (m *mysql) ReplayChangesRead(ctx context.Context, replay data.Replay, limit int) ([]*data.Change, error) {
+	searchParameters, searchArgs := changeSearchParameters(replay.Search)
+	args := append([]interface{}{replay.RegistrationId}, searchArgs...)
+	//KIM: a null filter matches everything, otherwise the change's
+	// value must be contained within the filter (JSON array)
+	searchParameters = append(searchParameters,
+		"(r.filter_service_names IS NULL OR JSON_CONTAINS(r.filter_service_names, JSON_QUOTE(c.service)))",
+		"(r.filter_types IS NULL OR JSON_CONTAINS(r.filter_types, JSON_QUOTE(c.type)))",
+		"(r.filter_actions IS NULL OR JSON_CONTAINS(r.filter_actions, JSON_QUOTE(c.action)))")
+	//KIM: when_changed is converted to milliseconds (truncated) the same
+	// way it is when scanned so that the cursor matches the replay
+	if replay.LastChangeId != "" {
+		searchParameters = append(searchParameters,
+			"(TRUNCATE(c.when_changed * 1000, 0) > ? OR (TRUNCATE(c.when_changed * 1000, 0) = ? AND c.change_id > ?))")
+		args = append(args, replay.LastWhenChanged, replay.LastWhenChanged, replay.LastChangeId)
+	}
+	query := fmt.Sprintf(`SELECT c.change_id, c.data_id, c.version, c.type,
+		c.service, c.action, c.when_changed, c.changed_by, c.payload FROM %s AS c
+		JOIN %s AS r ON r.id = ? WHERE %s ORDER BY c.when_changed, c.change_id`,
+		tableChangesV1, tableRegistrations, strings.Join(searchParameters, " AND "))
+	if limit > 0 {
+		query += " LIMIT ?"
+		args = append(args, limit)
+	}
+	rows, err := m.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	changes, err := changesScan(rows)
+	if err != nil {
+		return nil, err
+	}
+	if len(changes) == 0 {
+		query = fmt.Sprintf("SELECT registration_id FROM %s WHERE registration_id=?;", tableRegistrationsV1)
+		if err := m.QueryRowContext(ctx, query, replay.RegistrationId).Scan(new(string)); err != nil {
+			switch {
 			default:
 				return nil, err
-			case 1364:
-				return nil, meta.ErrChangeConflictWrite
+			case err == sql.ErrNoRows:
+				return nil, meta.ErrRegistrationNotFound
 			}
 		}
 	}
-	if err := rowsAffected(result, meta.ErrChangeNotWritten); err != nil {
+	return changes, nil
+}
+
+func (m *mysql) ChangesExpire(ctx context.Context, maxAge time.Duration) ([]string, error) {
+	var changeIds []string
+	var parameters []string
+	var args []interface{}
+
+	tx, err := m.Begin()
+	if err != nil {
 		return nil, err
 	}
-	query = fmt.Sprintf("SELECT change_id FROM %s LEFT JOIN %s ON change_id WHERE %s.change_id IS NOT NULL;",
-		tableChangesV1, tableRegistrationsV1, tableChangesV1)
-	rows, err := m.QueryContext(ctx, query)
+	defer tx.Rollback()
+	query := fmt.Sprintf("SELECT id FROM %s WHERE when_changed < NOW(6) - INTERVAL ? MICROSECOND FOR UPDATE;", tableChanges)
+	rows, err := tx.QueryContext(ctx, query, maxAge.Microseconds())
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	var changeIdsToPrune []string
 	for rows.Next() {
 		var changeId string
 
 		if err := rows.Scan(&changeId); err != nil {
 			return nil, err
 		}
-		changeIdsToPrune = append(changeIdsToPrune, changeId)
+		changeIds = append(changeIds, changeId)
+		parameters = append(parameters, "?")
+		args = append(args, changeId)
 	}
-	return changeIdsToPrune, nil
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	if len(changeIds) == 0 {
+		return nil, tx.Commit()
+	}
+	//KIM: expired changes are removed from every registration (even if
+	// they haven't been acknowledged) before they're deleted
+	for _, table := range []string{tableRegistrationChanges, tableDeadLetters} {
+		query = fmt.Sprintf("DELETE FROM %s WHERE change_id IN(%s);", table, strings.Join(parameters, ","))
+		if _, err := tx.ExecContext(ctx, query, args...); err != nil {
+			return nil, err
+		}
+	}
+	query = fmt.Sprintf("DELETE FROM %s WHERE id IN(%s);", tableChanges, strings.Join(parameters, ","))
+	if _, err := tx.ExecContext(ctx, query, args...); err != nil {
+		return nil, err
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return changeIds, nil
+}
+
+func (m *mysql) RegistrationChangesTrim(ctx context.Context, maxPending int) (map[string][]string, []string, error) {
+	var changeIdsTrimmed []string
+	var parameters []string
+	var args []interface{}
+
+	tx, err := m.Begin()
+	if err != nil {
+		return nil, nil, err
+	}
+	defer tx.Rollback()
+	//KIM: the pending changes of each registration are ranked newest
+	// first, anything ranked beyond max pending is trimmed
+	query := fmt.Sprintf(`SELECT registration_id, change_id FROM (
+		SELECT rc.registration_id, rc.change_id, ROW_NUMBER() OVER (
+			PARTITION BY rc.registration_id ORDER BY c.when_changed DESC, c.id DESC) AS pending
+		FROM %s AS rc JOIN %s AS c ON c.id = rc.change_id) AS r WHERE r.pending > ?;`,
+		tableRegistrationChanges, tableChanges)
+	rows, err := tx.QueryContext(ctx, query, maxPending)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer rows.Close()
+	changeIdsTrimmedByRegistration := make(map[string][]string)
+	for rows.Next() {
+		var registrationId, changeId string
+
+		if err := rows.Scan(&registrationId, &changeId); err != nil {
+			return nil, nil, err
+		}
+		changeIdsTrimmedByRegistration[registrationId] = append(changeIdsTrimmedByRegistration[registrationId], changeId)
+		changeIdsTrimmed = append(changeIdsTrimmed, changeId)
+		parameters = append(parameters, "(?, ?)")
+		args = append(args, registrationId, changeId)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, nil, err
+	}
+	if len(changeIdsTrimmed) == 0 {
+		return changeIdsTrimmedByRegistration, nil, tx.Commit()
+	}
+	query = fmt.Sprintf("DELETE FROM %s WHERE (registration_id, change_id) IN(%s);",
+		tableRegistrationChanges, strings.Join(parameters, ","))
+	if _, err := tx.ExecContext(ctx, query, args...); err != nil {
+		return nil, nil, err
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, nil, err
+	}
+	changeIdsToPrune, err := changeIdsUnreferenced(ctx, m, changeIdsTrimmed...)
+	if err != nil {
+		return nil, nil, err
+	}
+	return changeIdsTrimmedByRegistration, changeIdsToPrune, nil
+}
+
+func (m *mysql) RegistrationsExpire(ctx context.Context, expiry time.Duration) ([]string, []string, error) {
+	var registrationIds, changeIds []string
+	var parameters []string
+	var args []interface{}
+
+	tx, err := m.Begin()
+	if err != nil {
+		return nil, nil, err
+	}
+	defer tx.Rollback()
+	query := fmt.Sprintf("SELECT id FROM %s WHERE last_seen < NOW(6) - INTERVAL ? MICROSECOND FOR UPDATE;", tableRegistrations)
+	rows, err := tx.QueryContext(ctx, query, expiry.Microseconds())
+	if err != nil {
+		return nil, nil, err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var registrationId string
+
+		if err := rows.Scan(&registrationId); err != nil {
+			return nil, nil, err
+		}
+		registrationIds = append(registrationIds, registrationId)
+		parameters = append(parameters, "?")
+		args = append(args, registrationId)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, nil, err
+	}
+	if len(registrationIds) == 0 {
+		return nil, nil, tx.Commit()
+	}
+	query = fmt.Sprintf(`SELECT change_id FROM %s WHERE registration_id IN(%s)
+		UNION SELECT change_id FROM %s WHERE registration_id IN(%s);`,
+		tableRegistrationChanges, strings.Join(parameters, ","), tableDeadLetters, strings.Join(parameters, ","))
+	rows, err = tx.QueryContext(ctx, query, append(args, args...)...)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var changeId string
+
+		if err := rows.Scan(&changeId); err != nil {
+			return nil, nil, err
+		}
+		changeIds = append(changeIds, changeId)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, nil, err
+	}
+	//KIM: the pending changes, dead letters, webhook and replay of
+	// each registration are deleted via cascade
+	query = fmt.Sprintf("DELETE FROM %s WHERE id IN(%s);", tableRegistrations, strings.Join(parameters, ","))
+	if _, err := tx.ExecContext(ctx, query, args...); err != nil {
+		return nil, nil, err
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, nil, err
+	}
+	changeIdsToPrune, err := changeIdsUnreferenced(ctx, m, changeIds...)
+	if err != nil {
+		return nil, nil, err
+	}
+	return registrationIds, changeIdsToPrune, nil
+}
+
+func (m *mysql) RegistrationsSeen(ctx context.Context, registrationIds ...string) error {
+	var parameters []string
+	var args []interface{}
+
+	if len(registrationIds) == 0 {
+		return nil
+	}
+	for _, registrationId := range registrationIds {
+		parameters = append(parameters, "?")
+		args = append(args, registrationId)
+	}
+	//KIM: this could affect no rows if the registrations don't exist so we
+	// shouldn't specifically check to see if rows were affected
+	query := fmt.Sprintf("UPDATE %s SET last_seen=NOW(6) WHERE id IN(%s);",
+		tableRegistrations, strings.Join(parameters, ","))
+	if _, err := m.ExecContext(ctx, query, args...); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *mysql) RegistrationsMetricsRead(ctx context.Context) ([]*data.RegistrationMetrics, error) {
+	query := fmt.Sprintf(`SELECT r.id,
+		(SELECT COUNT(*) FROM %s AS rc WHERE rc.registration_id = r.id),
+		(SELECT COUNT(*) FROM %s AS d WHERE d.registration_id = r.id),
+		(SELECT TIMESTAMPDIFF(MICROSECOND, MIN(c.when_changed), NOW(6)) FROM %s AS rc
+			JOIN %s AS c ON c.id = rc.change_id WHERE rc.registration_id = r.id),
+		UNIX_TIMESTAMP(r.last_acknowledged), UNIX_TIMESTAMP(r.last_seen)
+		FROM %s AS r;`, tableRegistrationChanges, tableDeadLetters,
+		tableRegistrationChanges, tableChanges, tableRegistrations)
+	rows, err := m.QueryContext(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var registrationsMetrics []*data.RegistrationMetrics
+	for rows.Next() {
+		registrationMetrics, err := registrationMetricsScan(rows.Scan)
+		if err != nil {
+			return nil, err
+		}
+		registrationsMetrics = append(registrationsMetrics, registrationMetrics)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return registrationsMetrics, nil
 }
@@ -46,7 +46,17 @@ func TestMetaMysql(t *testing.T) {
 
 	//execute tests
 	t.Run("Change CRUD", tests.TestChangeCRUD(m))
+	t.Run("Change Conflict", tests.TestChangeConflict(m))
+	t.Run("Change Payload", tests.TestChangePayload(m))
 	t.Run("Changes Search", tests.TestChangeSearch(m))
 	t.Run("Registration CRUD", tests.TestRegistrationCRUD(m))
 	t.Run("Change Registrations", tests.TestRegistrationChanges(m))
+	t.Run("Registration Filter", tests.TestRegistrationFilter(m))
+	t.Run("Webhook CRUD", tests.TestWebhookCRUD(m))
+	t.Run("Registration Change Lease", tests.TestRegistrationChangeLease(m))
+	t.Run("Registration Changes Attach", tests.TestRegistrationChangesAttach(m))
+	t.Run("Replay CRUD", tests.TestReplayCRUD(m))
+	t.Run("Replay Changes Read", tests.TestReplayChangesRead(m))
+	t.Run("Retention", tests.TestRetention(m))
+	t.Run("Registrations Metrics", tests.TestRegistrationsMetrics(m))
 }
@@ -11,6 +11,12 @@ const (
 	tableRegistrationChanges string = "registration_changes"
 	tableRegistrationsV1     string = "registrations_v1"
 	tableChangesV1           string = "changes_v1"
+	tableWebhooks            string = "registration_webhooks"
+	tableWebhooksV1          string = "registration_webhooks_v1"
+	tableDeadLetters         string = "registration_dead_letters"
+	tableDeadLettersV1       string = "registration_dead_letters_v1"
+	tableReplays             string = "registration_replays"
+	tableReplaysV1           string = "registration_replays_v1"
 )
 
 type Owner interface {
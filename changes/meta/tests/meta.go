@@ -71,6 +71,47 @@ func TestChangeCRUD(m interface {
 	}
 }
 
+func TestChangeConflict(m interface {
+	meta.Change
+}) func(*testing.T) {
+	return func(t *testing.T) {
+		ctx := context.TODO()
+
+		//create change
+		dataId := generateId()
+		dataVersion, dataType := rand.Intn(1000), "employee"
+		dataServiceName, dataAction := "employees", "update"
+		changePartial := data.ChangePartial{
+			DataId:          &dataId,
+			DataVersion:     &dataVersion,
+			DataType:        &dataType,
+			DataAction:      &dataAction,
+			DataServiceName: &dataServiceName,
+		}
+		changeCreated, err := m.ChangeCreate(ctx, changePartial)
+		assert.Nil(t, err)
+		changeId := changeCreated.Id
+		defer func() {
+			m.ChangesDelete(ctx, changeId)
+		}()
+
+		//create the same change (e.g. delivered more than once) and
+		// validate conflict
+		changeDuplicate, err := m.ChangeCreate(ctx, changePartial)
+		assert.ErrorIs(t, err, meta.ErrChangeConflictWrite)
+		assert.Nil(t, changeDuplicate)
+
+		//create change with a different version and validate no conflict
+		dataVersion++
+		changeCreated, err = m.ChangeCreate(ctx, changePartial)
+		assert.Nil(t, err)
+		if assert.NotNil(t, changeCreated) {
+			assert.Equal(t, dataVersion, changeCreated.DataVersion)
+			m.ChangesDelete(ctx, changeCreated.Id)
+		}
+	}
+}
+
 func TestChangeSearch(m interface {
 	meta.Change
 }) func(*testing.T) {
@@ -185,7 +226,7 @@ func TestRegistrationCRUD(m interface {
 
 		//upsert registration
 		registrationId := generateId()
-		err := m.RegistrationUpsert(ctx, registrationId)
+		err := m.RegistrationUpsert(ctx, registrationId, data.RegistrationFilter{})
 		assert.Nil(t, err)
 
 		//delete registration
@@ -208,7 +249,7 @@ func TestRegistrationChanges(m interface {
 
 		//upsert registration
 		registrationId := generateId()
-		err := m.RegistrationUpsert(ctx, registrationId)
+		err := m.RegistrationUpsert(ctx, registrationId, data.RegistrationFilter{})
 		assert.Nil(t, err)
 		defer func() {
 			m.RegistrationDelete(ctx, registrationId)
@@ -245,7 +286,7 @@ func TestRegistrationChanges(m interface {
 		assert.Nil(t, err)
 
 		//read registration changes
-		changesRead, err := m.RegistrationChangesRead(ctx, registrationId)
+		changesRead, err := m.RegistrationChangesRead(ctx, registrationId, 0, 0)
 		assert.Nil(t, err)
 		assert.Len(t, changesRead, 1)
 		assert.Contains(t, changesRead, changeId)
@@ -265,7 +306,7 @@ func TestRegistrationChanges(m interface {
 		assert.Nil(t, err)
 
 		//read registration changes
-		changesRead, err = m.RegistrationChangesRead(ctx, registrationId)
+		changesRead, err = m.RegistrationChangesRead(ctx, registrationId, 0, 0)
 		assert.Nil(t, err)
 		assert.Len(t, changesRead, 0)
 		assert.NotContains(t, changesRead, changeId)
@@ -275,3 +316,711 @@ func TestRegistrationChanges(m interface {
 		assert.Nil(t, err)
 	}
 }
+
+func TestRegistrationFilter(m interface {
+	meta.Change
+	meta.Registration
+	meta.RegistrationChange
+}) func(*testing.T) {
+	return func(t *testing.T) {
+		ctx := context.TODO()
+
+		//upsert registrations (one filtered, one not)
+		dataServiceName, dataType := generateId(), generateId()
+		registrationIdFiltered, registrationIdAll := generateId(), generateId()
+		err := m.RegistrationUpsert(ctx, registrationIdFiltered, data.RegistrationFilter{
+			ServiceNames: []string{dataServiceName},
+			Types:        []string{dataType},
+			Actions:      []string{"delete"},
+		})
+		assert.Nil(t, err)
+		defer func() {
+			m.RegistrationDelete(ctx, registrationIdFiltered)
+		}()
+		err = m.RegistrationUpsert(ctx, registrationIdAll, data.RegistrationFilter{})
+		assert.Nil(t, err)
+		defer func() {
+			m.RegistrationDelete(ctx, registrationIdAll)
+		}()
+
+		//upsert changes (only the delete should match the filter)
+		var changeIds []string
+		dataId := generateId()
+		for i, dataAction := range []string{"create", "delete"} {
+			dataVersion, dataAction := i+1, dataAction
+			changeCreated, err := m.ChangeCreate(ctx, data.ChangePartial{
+				DataId:          &dataId,
+				DataVersion:     &dataVersion,
+				DataType:        &dataType,
+				DataServiceName: &dataServiceName,
+				DataAction:      &dataAction,
+			})
+			assert.Nil(t, err)
+			if !assert.NotNil(t, changeCreated) {
+				return
+			}
+			err = m.RegistrationChangeUpsert(ctx, changeCreated.Id)
+			assert.Nil(t, err)
+			changeIds = append(changeIds, changeCreated.Id)
+		}
+		defer func() {
+			m.RegistrationChangeAcknowledge(ctx, registrationIdAll, changeIds...)
+			m.RegistrationChangeAcknowledge(ctx, registrationIdFiltered, changeIds...)
+			m.ChangesDelete(ctx, changeIds...)
+		}()
+
+		//read registration changes
+		changesRead, err := m.RegistrationChangesRead(ctx, registrationIdFiltered, 0, 0)
+		assert.Nil(t, err)
+		assert.Len(t, changesRead, 1)
+		assert.Contains(t, changesRead, changeIds[1])
+		changesRead, err = m.RegistrationChangesRead(ctx, registrationIdAll, 0, 0)
+		assert.Nil(t, err)
+		assert.Len(t, changesRead, 2)
+
+		//upsert the registration without a filter, subsequent changes
+		// should no longer be filtered
+		err = m.RegistrationUpsert(ctx, registrationIdFiltered, data.RegistrationFilter{})
+		assert.Nil(t, err)
+		dataVersion, dataAction := 3, "update"
+		changeCreated, err := m.ChangeCreate(ctx, data.ChangePartial{
+			DataId:          &dataId,
+			DataVersion:     &dataVersion,
+			DataType:        &dataType,
+			DataServiceName: &dataServiceName,
+			DataAction:      &dataAction,
+		})
+		assert.Nil(t, err)
+		if !assert.NotNil(t, changeCreated) {
+			return
+		}
+		changeIds = append(changeIds, changeCreated.Id)
+		err = m.RegistrationChangeUpsert(ctx, changeCreated.Id)
+		assert.Nil(t, err)
+		changesRead, err = m.RegistrationChangesRead(ctx, registrationIdFiltered, 0, 0)
+		assert.Nil(t, err)
+		assert.Len(t, changesRead, 2)
+		assert.Contains(t, changesRead, changeCreated.Id)
+	}
+}
+
+func TestWebhookCRUD(m interface {
+	meta.Registration
+	meta.Webhook
+}) func(*testing.T) {
+	return func(t *testing.T) {
+		ctx := context.TODO()
+
+		//upsert webhook (registration doesn't exist)
+		registrationId := generateId()
+		err := m.WebhookUpsert(ctx, data.Webhook{
+			RegistrationId: registrationId,
+			Url:            "http://localhost:8080/webhook",
+		})
+		assert.ErrorIs(t, err, meta.ErrRegistrationNotFound)
+
+		//upsert registration
+		err = m.RegistrationUpsert(ctx, registrationId, data.RegistrationFilter{})
+		assert.Nil(t, err)
+		defer func() {
+			m.RegistrationDelete(ctx, registrationId)
+		}()
+
+		//upsert webhook (url not provided)
+		err = m.WebhookUpsert(ctx, data.Webhook{RegistrationId: registrationId})
+		assert.ErrorIs(t, err, meta.ErrWebhookNotWritten)
+
+		//upsert webhook
+		webhook := data.Webhook{
+			RegistrationId: registrationId,
+			Url:            "http://localhost:8080/webhook",
+			Secret:         generateId(),
+		}
+		err = m.WebhookUpsert(ctx, webhook)
+		assert.Nil(t, err)
+		webhookRead, err := m.WebhookRead(ctx, registrationId)
+		assert.Nil(t, err)
+		assert.Equal(t, &webhook, webhookRead)
+
+		//update webhook
+		webhook.Url = "http://localhost:8081/webhook"
+		err = m.WebhookUpsert(ctx, webhook)
+		assert.Nil(t, err)
+		webhooks, err := m.WebhooksRead(ctx)
+		assert.Nil(t, err)
+		assert.Contains(t, webhooks, &webhook)
+
+		//delete webhook
+		err = m.WebhookDelete(ctx, registrationId)
+		assert.Nil(t, err)
+		_, err = m.WebhookRead(ctx, registrationId)
+		assert.ErrorIs(t, err, meta.ErrWebhookNotFound)
+		err = m.WebhookDelete(ctx, registrationId)
+		assert.ErrorIs(t, err, meta.ErrWebhookNotFound)
+
+		//deleting the registration should delete the webhook
+		err = m.WebhookUpsert(ctx, webhook)
+		assert.Nil(t, err)
+		err = m.RegistrationDelete(ctx, registrationId)
+		assert.Nil(t, err)
+		_, err = m.WebhookRead(ctx, registrationId)
+		assert.ErrorIs(t, err, meta.ErrWebhookNotFound)
+	}
+}
+
+func TestRegistrationChangeLease(m interface {
+	meta.Change
+	meta.Registration
+	meta.RegistrationChange
+	meta.DeadLetter
+}) func(*testing.T) {
+	return func(t *testing.T) {
+		const visibilityTimeout, maxAttempts = 500 * time.Millisecond, 2
+
+		ctx := context.TODO()
+
+		//upsert registration
+		registrationId := generateId()
+		err := m.RegistrationUpsert(ctx, registrationId, data.RegistrationFilter{})
+		assert.Nil(t, err)
+		defer func() {
+			m.RegistrationDelete(ctx, registrationId)
+		}()
+
+		//create change
+		dataId := generateId()
+		dataVersion, dataType := rand.Intn(1000), "employee"
+		dataServiceName, dataAction := "employees", "update"
+		changedBy := "test_registration_change_lease"
+		change, err := m.ChangeCreate(ctx, data.ChangePartial{
+			DataId:          &dataId,
+			DataVersion:     &dataVersion,
+			DataType:        &dataType,
+			DataAction:      &dataAction,
+			DataServiceName: &dataServiceName,
+			ChangedBy:       &changedBy,
+		})
+		assert.Nil(t, err)
+		if !assert.NotNil(t, change) {
+			return
+		}
+		defer func() {
+			m.ChangesDelete(ctx, change.Id)
+		}()
+		err = m.RegistrationChangeUpsert(ctx, change.Id)
+		assert.Nil(t, err)
+
+		//read changes (leased), the change shouldn't be visible until
+		// the visibility timeout elapses
+		changeIds, err := m.RegistrationChangesRead(ctx, registrationId, visibilityTimeout, maxAttempts)
+		assert.Nil(t, err)
+		assert.Equal(t, []string{change.Id}, changeIds)
+		changeIds, err = m.RegistrationChangesRead(ctx, registrationId, visibilityTimeout, maxAttempts)
+		assert.Nil(t, err)
+		assert.Empty(t, changeIds)
+		time.Sleep(2 * visibilityTimeout)
+		changeIds, err = m.RegistrationChangesRead(ctx, registrationId, visibilityTimeout, maxAttempts)
+		assert.Nil(t, err)
+		assert.Equal(t, []string{change.Id}, changeIds)
+
+		//once the lease expires again, the change has been delivered max
+		// attempts times so it should be dead-lettered
+		time.Sleep(2 * visibilityTimeout)
+		changeIds, err = m.RegistrationChangesRead(ctx, registrationId, visibilityTimeout, maxAttempts)
+		assert.Nil(t, err)
+		assert.Empty(t, changeIds)
+		deadLetters, err := m.DeadLettersRead(ctx, registrationId)
+		assert.Nil(t, err)
+		if assert.Len(t, deadLetters, 1) {
+			assert.Equal(t, registrationId, deadLetters[0].RegistrationId)
+			assert.Equal(t, change.Id, deadLetters[0].ChangeId)
+			assert.Equal(t, maxAttempts, deadLetters[0].Attempts)
+			assert.NotZero(t, deadLetters[0].DeadLettered)
+		}
+
+		//a dead-lettered change can't be deleted until it's acknowledged
+		err = m.ChangesDelete(ctx, change.Id)
+		assert.NotNil(t, err)
+		changeIdsToPrune, err := m.RegistrationChangeAcknowledge(ctx, registrationId, change.Id)
+		assert.Nil(t, err)
+		assert.Contains(t, changeIdsToPrune, change.Id)
+		deadLetters, err = m.DeadLettersRead(ctx, registrationId)
+		assert.Nil(t, err)
+		assert.Empty(t, deadLetters)
+		err = m.ChangesDelete(ctx, change.Id)
+		assert.Nil(t, err)
+
+		//read dead letters (registration doesn't exist)
+		_, err = m.DeadLettersRead(ctx, generateId())
+		assert.ErrorIs(t, err, meta.ErrRegistrationNotFound)
+	}
+}
+
+func TestChangePayload(m interface {
+	meta.Change
+}) func(*testing.T) {
+	return func(t *testing.T) {
+		type employee struct {
+			Id        string `json:"id"`
+			FirstName string `json:"first_name"`
+			LastName  string `json:"last_name"`
+		}
+
+		ctx := context.TODO()
+
+		//create changes with payloads, one changes the first name
+		// and the other changes the last name
+		dataId := generateId()
+		dataType, dataServiceName := "employee", "employees"
+		dataAction, changedBy := "update", "test_change_payload"
+		employeeBefore := &employee{Id: dataId, FirstName: "Antonio", LastName: "Alexander"}
+		employeeFirstName := &employee{Id: dataId, FirstName: "Tony", LastName: "Alexander"}
+		employeeLastName := &employee{Id: dataId, FirstName: "Tony", LastName: "Stark"}
+		var changesCreated []*data.Change
+		for i, employees := range [][2]*employee{
+			{employeeBefore, employeeFirstName},
+			{employeeFirstName, employeeLastName},
+		} {
+			payload, err := data.NewChangePayload(employees[0], employees[1])
+			assert.Nil(t, err)
+			dataVersion := i + 1
+			changeCreated, err := m.ChangeCreate(ctx, data.ChangePartial{
+				DataId:          &dataId,
+				DataVersion:     &dataVersion,
+				DataType:        &dataType,
+				DataAction:      &dataAction,
+				DataServiceName: &dataServiceName,
+				ChangedBy:       &changedBy,
+				Payload:         payload,
+			})
+			assert.Nil(t, err)
+			if !assert.NotNil(t, changeCreated) {
+				return
+			}
+			changesCreated = append(changesCreated, changeCreated)
+		}
+		defer func() {
+			for _, change := range changesCreated {
+				m.ChangesDelete(ctx, change.Id)
+			}
+		}()
+
+		//read change and validate payload
+		changeRead, err := m.ChangeRead(ctx, changesCreated[0].Id)
+		assert.Nil(t, err)
+		if assert.NotNil(t, changeRead) && assert.NotNil(t, changeRead.Payload) {
+			assert.JSONEq(t, `{"id":"`+dataId+`","first_name":"Tony","last_name":"Alexander"}`,
+				string(changeRead.Payload.Snapshot))
+			assert.Equal(t, []string{"first_name"}, changeRead.Payload.Fields())
+			if assert.Len(t, changeRead.Payload.Diff, 1) {
+				assert.JSONEq(t, `"Antonio"`, string(changeRead.Payload.Diff[0].Before))
+				assert.JSONEq(t, `"Tony"`, string(changeRead.Payload.Diff[0].After))
+			}
+		}
+
+		//search changes by field
+		changesRead, err := m.ChangesRead(ctx, data.ChangeSearch{
+			DataIds: []string{dataId},
+			Fields:  []string{"first_name"},
+		})
+		assert.Nil(t, err)
+		if assert.Len(t, changesRead, 1) {
+			assert.Equal(t, changesCreated[0].Id, changesRead[0].Id)
+		}
+		changesRead, err = m.ChangesRead(ctx, data.ChangeSearch{
+			DataIds: []string{dataId},
+			Fields:  []string{"first_name", "last_name"},
+		})
+		assert.Nil(t, err)
+		assert.Len(t, changesRead, 2)
+		changesRead, err = m.ChangesRead(ctx, data.ChangeSearch{
+			DataIds: []string{dataId},
+			Fields:  []string{"id"},
+		})
+		assert.Nil(t, err)
+		assert.Empty(t, changesRead)
+
+		//generate payload (data deleted), a nil pointer is treated
+		// as nil so the snapshot is the data before it was deleted
+		payload, err := data.NewChangePayload(employeeLastName, (*employee)(nil))
+		assert.Nil(t, err)
+		if assert.NotNil(t, payload) {
+			assert.JSONEq(t, `{"id":"`+dataId+`","first_name":"Tony","last_name":"Stark"}`,
+				string(payload.Snapshot))
+			assert.ElementsMatch(t, []string{"id", "first_name", "last_name"}, payload.Fields())
+		}
+	}
+}
+
+func TestRegistrationChangesAttach(m interface {
+	meta.Change
+	meta.Registration
+	meta.RegistrationChange
+}) func(*testing.T) {
+	return func(t *testing.T) {
+		ctx := context.TODO()
+
+		//create change (before the registration exists)
+		dataId := generateId()
+		dataVersion, dataType := rand.Intn(1000), "employee"
+		dataServiceName, dataAction := "employees", "create"
+		changedBy := "test_registration_changes_attach"
+		change, err := m.ChangeCreate(ctx, data.ChangePartial{
+			DataId:          &dataId,
+			DataVersion:     &dataVersion,
+			DataType:        &dataType,
+			DataAction:      &dataAction,
+			DataServiceName: &dataServiceName,
+			ChangedBy:       &changedBy,
+		})
+		assert.Nil(t, err)
+		if !assert.NotNil(t, change) {
+			return
+		}
+		defer func() {
+			m.ChangesDelete(ctx, change.Id)
+		}()
+
+		//attach change (registration doesn't exist)
+		registrationId := generateId()
+		err = m.RegistrationChangesAttach(ctx, registrationId, change.Id)
+		assert.ErrorIs(t, err, meta.ErrRegistrationNotFound)
+
+		//upsert registration (filter doesn't match the change)
+		err = m.RegistrationUpsert(ctx, registrationId, data.RegistrationFilter{
+			ServiceNames: []string{"timers"},
+		})
+		assert.Nil(t, err)
+		defer func() {
+			m.RegistrationDelete(ctx, registrationId)
+		}()
+		changeIds, err := m.RegistrationChangesRead(ctx, registrationId, 0, 0)
+		assert.Nil(t, err)
+		assert.Empty(t, changeIds)
+
+		//attach change (more than once), the filter is ignored
+		err = m.RegistrationChangesAttach(ctx, registrationId, change.Id)
+		assert.Nil(t, err)
+		err = m.RegistrationChangesAttach(ctx, registrationId, change.Id, generateId())
+		assert.Nil(t, err)
+		changeIds, err = m.RegistrationChangesRead(ctx, registrationId, 0, 0)
+		assert.Nil(t, err)
+		assert.Equal(t, []string{change.Id}, changeIds)
+
+		//acknowledge change
+		changeIdsToPrune, err := m.RegistrationChangeAcknowledge(ctx, registrationId, change.Id)
+		assert.Nil(t, err)
+		assert.Contains(t, changeIdsToPrune, change.Id)
+	}
+}
+
+func TestReplayCRUD(m interface {
+	meta.Registration
+	meta.Replay
+}) func(*testing.T) {
+	return func(t *testing.T) {
+		ctx := context.TODO()
+
+		//upsert replay (registration doesn't exist)
+		registrationId := generateId()
+		err := m.ReplayUpsert(ctx, data.Replay{
+			RegistrationId: registrationId,
+			Status:         data.ReplayStatusRunning,
+		})
+		assert.ErrorIs(t, err, meta.ErrRegistrationNotFound)
+
+		//upsert registration
+		err = m.RegistrationUpsert(ctx, registrationId, data.RegistrationFilter{})
+		assert.Nil(t, err)
+		defer func() {
+			m.RegistrationDelete(ctx, registrationId)
+		}()
+
+		//read replay (doesn't exist)
+		_, err = m.ReplayRead(ctx, registrationId)
+		assert.ErrorIs(t, err, meta.ErrReplayNotFound)
+
+		//upsert replay
+		since := time.Now().UnixNano()
+		replay := data.Replay{
+			RegistrationId: registrationId,
+			Search: data.ChangeSearch{
+				ServiceNames: []string{"employees"},
+				Since:        &since,
+			},
+			Status: data.ReplayStatusRunning,
+		}
+		err = m.ReplayUpsert(ctx, replay)
+		assert.Nil(t, err)
+		replayRead, err := m.ReplayRead(ctx, registrationId)
+		assert.Nil(t, err)
+		assert.Equal(t, &replay, replayRead)
+
+		//update replay
+		replay.LastChangeId, replay.LastWhenChanged = generateId(), time.Now().UnixNano()
+		replay.Replayed, replay.Status = 10, data.ReplayStatusCompleted
+		err = m.ReplayUpsert(ctx, replay)
+		assert.Nil(t, err)
+		replayRead, err = m.ReplayRead(ctx, registrationId)
+		assert.Nil(t, err)
+		assert.Equal(t, &replay, replayRead)
+		replays, err := m.ReplaysRead(ctx)
+		assert.Nil(t, err)
+		assert.Contains(t, replays, &replay)
+
+		//delete replay
+		err = m.ReplayDelete(ctx, registrationId)
+		assert.Nil(t, err)
+		err = m.ReplayDelete(ctx, registrationId)
+		assert.ErrorIs(t, err, meta.ErrReplayNotFound)
+
+		//delete registration (replay is deleted)
+		err = m.ReplayUpsert(ctx, replay)
+		assert.Nil(t, err)
+		err = m.RegistrationDelete(ctx, registrationId)
+		assert.Nil(t, err)
+		_, err = m.ReplayRead(ctx, registrationId)
+		assert.ErrorIs(t, err, meta.ErrReplayNotFound)
+	}
+}
+
+func TestReplayChangesRead(m interface {
+	meta.Change
+	meta.Registration
+	meta.Replay
+}) func(*testing.T) {
+	return func(t *testing.T) {
+		var changeIds []string
+
+		ctx := context.TODO()
+
+		//create changes (oldest first), only some of them match
+		// the registration's filter
+		dataType, dataServiceName := generateId(), "employees"
+		changedBy := "test_replay_changes_read"
+		tNow := time.Now()
+		for i, dataAction := range []string{"create", "delete", "create", "create"} {
+			dataId, dataVersion := generateId(), 1
+			whenChanged := tNow.Add(time.Duration(i) * time.Millisecond).UnixNano()
+			change, err := m.ChangeCreate(ctx, data.ChangePartial{
+				DataId:          &dataId,
+				DataVersion:     &dataVersion,
+				DataType:        &dataType,
+				DataAction:      &dataAction,
+				DataServiceName: &dataServiceName,
+				WhenChanged:     &whenChanged,
+				ChangedBy:       &changedBy,
+			})
+			assert.Nil(t, err)
+			if !assert.NotNil(t, change) {
+				return
+			}
+			changeIds = append(changeIds, change.Id)
+		}
+		defer func() {
+			m.ChangesDelete(ctx, changeIds...)
+		}()
+
+		//read changes (registration doesn't exist)
+		registrationId := generateId()
+		replay := data.Replay{
+			RegistrationId: registrationId,
+			Search:         data.ChangeSearch{Types: []string{dataType}},
+		}
+		_, err := m.ReplayChangesRead(ctx, replay, 2)
+		assert.ErrorIs(t, err, meta.ErrRegistrationNotFound)
+
+		//upsert registration
+		err = m.RegistrationUpsert(ctx, registrationId, data.RegistrationFilter{
+			Actions: []string{"create"},
+		})
+		assert.Nil(t, err)
+		defer func() {
+			m.RegistrationDelete(ctx, registrationId)
+		}()
+
+		//read changes in batches, the changes are read in the order
+		// they occured and must match both the search and the filter
+		var changeIdsRead []string
+		for i := 0; i < 3; i++ {
+			changes, err := m.ReplayChangesRead(ctx, replay, 2)
+			assert.Nil(t, err)
+			if len(changes) == 0 {
+				break
+			}
+			assert.LessOrEqual(t, len(changes), 2)
+			for _, change := range changes {
+				changeIdsRead = append(changeIdsRead, change.Id)
+			}
+			lastChange := changes[len(changes)-1]
+			replay.LastChangeId, replay.LastWhenChanged = lastChange.Id, lastChange.WhenChanged
+		}
+		assert.Equal(t, []string{changeIds[0], changeIds[2], changeIds[3]}, changeIdsRead)
+
+		//read changes (none left)
+		changes, err := m.ReplayChangesRead(ctx, replay, 2)
+		assert.Nil(t, err)
+		assert.Empty(t, changes)
+	}
+}
+
+func TestRetention(m interface {
+	meta.Change
+	meta.Registration
+	meta.RegistrationChange
+	meta.Retention
+}) func(*testing.T) {
+	return func(t *testing.T) {
+		ctx := context.TODO()
+
+		//upsert registration
+		registrationId, dataServiceName := generateId(), generateId()
+		err := m.RegistrationUpsert(ctx, registrationId, data.RegistrationFilter{
+			ServiceNames: []string{dataServiceName},
+		})
+		assert.Nil(t, err)
+		defer func() {
+			m.RegistrationDelete(ctx, registrationId)
+		}()
+
+		//create changes (oldest first)
+		var changeIds []string
+		tNow := time.Now()
+		for _, whenChanged := range []int64{
+			tNow.Add(-48 * time.Hour).UnixNano(),
+			tNow.Add(-2 * time.Minute).UnixNano(),
+			tNow.Add(-time.Minute).UnixNano(),
+		} {
+			whenChanged := whenChanged
+			dataId, dataType, dataAction := generateId(), "employee", "create"
+			change, err := m.ChangeCreate(ctx, data.ChangePartial{
+				DataId:          &dataId,
+				DataType:        &dataType,
+				DataAction:      &dataAction,
+				DataServiceName: &dataServiceName,
+				WhenChanged:     &whenChanged,
+			})
+			assert.Nil(t, err)
+			err = m.RegistrationChangeUpsert(ctx, change.Id)
+			assert.Nil(t, err)
+			changeIds = append(changeIds, change.Id)
+		}
+		defer func() {
+			m.RegistrationChangeAcknowledge(ctx, registrationId, changeIds...)
+			m.ChangesDelete(ctx, changeIds...)
+		}()
+
+		//expire changes (older than a day)
+		changeIdsExpired, err := m.ChangesExpire(ctx, 24*time.Hour)
+		assert.Nil(t, err)
+		assert.Contains(t, changeIdsExpired, changeIds[0])
+		assert.NotContains(t, changeIdsExpired, changeIds[1])
+		assert.NotContains(t, changeIdsExpired, changeIds[2])
+		_, err = m.ChangeRead(ctx, changeIds[0])
+		assert.ErrorIs(t, err, meta.ErrChangeNotFound)
+		changeIdsRead, err := m.RegistrationChangesRead(ctx, registrationId, 0, 0)
+		assert.Nil(t, err)
+		assert.ElementsMatch(t, changeIds[1:], changeIdsRead)
+
+		//trim changes (the oldest is trimmed)
+		changeIdsTrimmed, changeIdsToPrune, err := m.RegistrationChangesTrim(ctx, 1)
+		assert.Nil(t, err)
+		assert.Equal(t, []string{changeIds[1]}, changeIdsTrimmed[registrationId])
+		assert.Contains(t, changeIdsToPrune, changeIds[1])
+		changeIdsRead, err = m.RegistrationChangesRead(ctx, registrationId, 0, 0)
+		assert.Nil(t, err)
+		assert.Equal(t, changeIds[2:], changeIdsRead)
+		err = m.ChangesDelete(ctx, changeIdsToPrune...)
+		assert.Nil(t, err)
+
+		//expire registrations (registration was recently seen)
+		registrationIds, _, err := m.RegistrationsExpire(ctx, time.Hour)
+		assert.Nil(t, err)
+		assert.NotContains(t, registrationIds, registrationId)
+
+		//expire registrations (registration was marked as seen)
+		time.Sleep(time.Second)
+		err = m.RegistrationsSeen(ctx, registrationId, generateId())
+		assert.Nil(t, err)
+		registrationIds, _, err = m.RegistrationsExpire(ctx, 500*time.Millisecond)
+		assert.Nil(t, err)
+		assert.NotContains(t, registrationIds, registrationId)
+
+		//expire registrations (registration hasn't been seen)
+		time.Sleep(time.Second)
+		registrationIds, changeIdsToPrune, err = m.RegistrationsExpire(ctx, 500*time.Millisecond)
+		assert.Nil(t, err)
+		assert.Contains(t, registrationIds, registrationId)
+		assert.Contains(t, changeIdsToPrune, changeIds[2])
+		_, err = m.RegistrationChangesRead(ctx, registrationId, 0, 0)
+		assert.ErrorIs(t, err, meta.ErrRegistrationNotFound)
+	}
+}
+
+func TestRegistrationsMetrics(m interface {
+	meta.Change
+	meta.Registration
+	meta.RegistrationChange
+	meta.Metrics
+}) func(*testing.T) {
+	return func(t *testing.T) {
+		ctx := context.TODO()
+
+		//upsert registration
+		registrationId, dataServiceName := generateId(), generateId()
+		err := m.RegistrationUpsert(ctx, registrationId, data.RegistrationFilter{
+			ServiceNames: []string{dataServiceName},
+		})
+		assert.Nil(t, err)
+		defer func() {
+			m.RegistrationDelete(ctx, registrationId)
+		}()
+		metricsFx := func() *data.RegistrationMetrics {
+			registrationsMetrics, err := m.RegistrationsMetricsRead(ctx)
+			assert.Nil(t, err)
+			for _, registrationMetrics := range registrationsMetrics {
+				if registrationMetrics.RegistrationId == registrationId {
+					return registrationMetrics
+				}
+			}
+			return nil
+		}
+
+		//create change (a minute ago)
+		dataId, dataType, dataAction := generateId(), "employee", "create"
+		whenChanged := time.Now().Add(-time.Minute).UnixNano()
+		change, err := m.ChangeCreate(ctx, data.ChangePartial{
+			DataId:          &dataId,
+			DataType:        &dataType,
+			DataAction:      &dataAction,
+			DataServiceName: &dataServiceName,
+			WhenChanged:     &whenChanged,
+		})
+		assert.Nil(t, err)
+		defer func() {
+			m.ChangesDelete(ctx, change.Id)
+		}()
+		err = m.RegistrationChangeUpsert(ctx, change.Id)
+		assert.Nil(t, err)
+
+		//read metrics (change pending)
+		registrationMetrics := metricsFx()
+		if assert.NotNil(t, registrationMetrics) {
+			assert.Equal(t, 1, registrationMetrics.Pending)
+			assert.Zero(t, registrationMetrics.DeadLettered)
+			assert.GreaterOrEqual(t, registrationMetrics.OldestPendingAge, time.Minute.Milliseconds())
+			assert.Zero(t, registrationMetrics.LastAcknowledged)
+			assert.NotZero(t, registrationMetrics.LastSeen)
+		}
+
+		//acknowledge change
+		_, err = m.RegistrationChangeAcknowledge(ctx, registrationId, change.Id)
+		assert.Nil(t, err)
+		registrationMetrics = metricsFx()
+		if assert.NotNil(t, registrationMetrics) {
+			assert.Zero(t, registrationMetrics.Pending)
+			assert.Zero(t, registrationMetrics.OldestPendingAge)
+			assert.NotZero(t, registrationMetrics.LastAcknowledged)
+		}
+	}
+}
@@ -3,6 +3,7 @@ package meta
 import (
 	"context"
 	"errors"
+	"time"
 
 	data "github.com/antonio-alexander/go-bludgeon/changes/data"
 
@@ -18,6 +19,9 @@ const (
 	RegistrationNotFound         string = "registration not found"
 	RegistrationNotWritten       string = "registration not written; id not provided"
 	RegistrationChangeNotWritten string = "registration change not written; change id not provided"
+	WebhookNotFound              string = "webhook not found"
+	WebhookNotWritten            string = "webhook not written; url not provided"
+	ReplayNotFound               string = "replay not found"
 )
 
 // these are error variables used within the change meta
@@ -29,14 +33,29 @@ var (
 	ErrRegistrationNotFound         = internal_errors.NewNotFound(errors.New(RegistrationNotFound))
 	ErrRegistrationNotWritten       = internal_errors.NewNotFound(errors.New(RegistrationNotWritten))
 	ErrRegistrationChangeNotWritten = internal_errors.NewNotFound(errors.New(RegistrationChangeNotWritten))
+	ErrWebhookNotFound              = internal_errors.NewNotFound(errors.New(WebhookNotFound))
+	ErrWebhookNotWritten            = internal_errors.NewNotUpdated(errors.New(WebhookNotWritten))
+	ErrReplayNotFound               = internal_errors.NewNotFound(errors.New(ReplayNotFound))
 )
 
+// RegistrationChangeLease describes the delivery state of a change
+// for a given registration
+type RegistrationChangeLease struct {
+	Attempts    int   `json:"attempts,omitempty"`
+	LeasedUntil int64 `json:"leased_until,omitempty"`
+}
+
 // SerializedData provides a struct that describes the representation
 // of the data when serialized
 type SerializedData struct {
-	Changes             map[string]data.Change         `json:"changes"`
-	Registrations       map[string]struct{}            `json:"registrations"`
-	RegistrationChanges map[string]map[string]struct{} `json:"registration_changes"`
+	Changes                   map[string]data.Change                        `json:"changes"`
+	Registrations             map[string]data.RegistrationFilter            `json:"registrations"`
+	RegistrationChanges       map[string]map[string]RegistrationChangeLease `json:"registration_changes"`
+	Webhooks                  map[string]data.Webhook                       `json:"webhooks,omitempty"`
+	DeadLetters               map[string]map[string]data.DeadLetter         `json:"dead_letters,omitempty"`
+	Replays                   map[string]data.Replay                        `json:"replays,omitempty"`
+	RegistrationsSeen         map[string]int64                              `json:"registrations_seen,omitempty"`
+	RegistrationsAcknowledged map[string]int64                              `json:"registrations_acknowledged,omitempty"`
 }
 
 // Serializer is an interface that can be used to convert the contents of
@@ -61,12 +80,109 @@ type Change interface {
 }
 
 type Registration interface {
-	RegistrationUpsert(ctx context.Context, registrationId string) error
+	//RegistrationUpsert can be used to create or update a registration, only
+	// changes that match the filter will be attached to the registration
+	RegistrationUpsert(ctx context.Context, registrationId string, filter data.RegistrationFilter) error
 	RegistrationDelete(ctx context.Context, registrationId string) error
 }
 
 type RegistrationChange interface {
+	//RegistrationChangeUpsert can be used to attach a change to every
+	// registration whose filter it matches
 	RegistrationChangeUpsert(ctx context.Context, changeId string) error
-	RegistrationChangesRead(ctx context.Context, registrationId string) (changeIds []string, err error)
+
+	//RegistrationChangesAttach can be used to attach one or more existing
+	// changes to a registration regardless of its filter, changes that are
+	// already pending (or dead-lettered) for the registration are ignored
+	RegistrationChangesAttach(ctx context.Context, registrationId string, changeIds ...string) error
+
+	//RegistrationChangesRead can be used to lease the changes of a registration
+	// that aren't in-flight, leased changes aren't visible again until the visibility
+	// timeout elapses (a timeout of zero doesn't lease); changes that have already been
+	// delivered max attempts times are dead-lettered rather than read (zero is unlimited)
+	RegistrationChangesRead(ctx context.Context, registrationId string, visibilityTimeout time.Duration, maxAttempts int) (changeIds []string, err error)
+
+	//RegistrationChangeAcknowledge can be used to acknowledge one or more changes
+	// for a registration, acknowledging a dead-lettered change will discard it
 	RegistrationChangeAcknowledge(ctx context.Context, registrationId string, changeIds ...string) (changeIdsToPrune []string, err error)
 }
+
+// DeadLetter is an interface that groups functions to interact with the
+// changes that were dead-lettered (delivered too many times without being
+// acknowledged)
+type DeadLetter interface {
+	//DeadLettersRead can be used to read the dead-lettered changes
+	// of a registration
+	DeadLettersRead(ctx context.Context, registrationId string) ([]*data.DeadLetter, error)
+}
+
+// Webhook is an interface that groups functions to interact with the
+// webhooks of registrations (changes are delivered via a callback url)
+type Webhook interface {
+	//WebhookUpsert can be used to create or update the webhook of
+	// an existing registration
+	WebhookUpsert(ctx context.Context, webhook data.Webhook) error
+
+	//WebhookRead can be used to read the webhook of a registration
+	WebhookRead(ctx context.Context, registrationId string) (*data.Webhook, error)
+
+	//WebhooksRead can be used to read all webhooks
+	WebhooksRead(ctx context.Context) ([]*data.Webhook, error)
+
+	//WebhookDelete can be used to delete the webhook of a registration,
+	// the registration is unaffected
+	WebhookDelete(ctx context.Context, registrationId string) error
+}
+
+// Replay is an interface that groups functions to interact with the
+// replays of registrations (historical changes attached to a registration)
+type Replay interface {
+	//ReplayUpsert can be used to create or update the replay of an
+	// existing registration
+	ReplayUpsert(ctx context.Context, replay data.Replay) error
+
+	//ReplayRead can be used to read the replay of a registration
+	ReplayRead(ctx context.Context, registrationId string) (*data.Replay, error)
+
+	//ReplaysRead can be used to read all replays
+	ReplaysRead(ctx context.Context) ([]*data.Replay, error)
+
+	//ReplayDelete can be used to delete the replay of a registration
+	ReplayDelete(ctx context.Context, registrationId string) error
+
+	//ReplayChangesRead can be used to read the next batch (at most limit) of
+	// changes to replay: changes that match both the replay's search and the
+	// registration's filter and occured after the last change replayed, changes
+	// are returned in the order they occured (when_changed, then id)
+	ReplayChangesRead(ctx context.Context, replay data.Replay, limit int) ([]*data.Change, error)
+}
+
+// Retention is an interface that groups functions used to enforce the
+// retention of changes and registrations; a registration is seen when
+// it's upserted or its changes are read or acknowledged
+type Retention interface {
+	//ChangesExpire can be used to delete the changes older than the given
+	// max age, regardless of whether or not they've been acknowledged
+	ChangesExpire(ctx context.Context, maxAge time.Duration) (changeIds []string, err error)
+
+	//RegistrationChangesTrim can be used to remove the oldest pending changes
+	// of each registration with more than max pending changes
+	RegistrationChangesTrim(ctx context.Context, maxPending int) (changeIdsTrimmed map[string][]string, changeIdsToPrune []string, err error)
+
+	//RegistrationsExpire can be used to delete the registrations that haven't
+	// been seen within the given expiry
+	RegistrationsExpire(ctx context.Context, expiry time.Duration) (registrationIds []string, changeIdsToPrune []string, err error)
+
+	//RegistrationsSeen can be used to mark one or more registrations as seen
+	// (e.g. while a handler is attached), registrations that don't exist are
+	// ignored
+	RegistrationsSeen(ctx context.Context, registrationIds ...string) error
+}
+
+// Metrics is an interface that groups functions used to measure how far
+// behind each registration is
+type Metrics interface {
+	//RegistrationsMetricsRead can be used to read the delivery metrics
+	// of every registration
+	RegistrationsMetricsRead(ctx context.Context) ([]*data.RegistrationMetrics, error)
+}
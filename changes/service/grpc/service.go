@@ -0,0 +1,201 @@
+package service
+
+import (
+	"context"
+	"sync"
+
+	data "github.com/antonio-alexander/go-bludgeon/changes/data"
+	pb "github.com/antonio-alexander/go-bludgeon/changes/data/pb"
+	logic "github.com/antonio-alexander/go-bludgeon/changes/logic"
+
+	internal "github.com/antonio-alexander/go-bludgeon/internal"
+	server "github.com/antonio-alexander/go-bludgeon/internal/grpc/server"
+	logger "github.com/antonio-alexander/go-bludgeon/internal/logger"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+type grpcService struct {
+	sync.RWMutex
+	sync.WaitGroup
+	logger.Logger
+	pb.UnimplementedChangesServer
+	logic logic.Logic
+}
+
+// KIM: we don't need to expose this interface, but we need
+// to implement it for grpc's sake
+var _ pb.ChangesServer = &grpcService{}
+
+func New() interface {
+	internal.Parameterizer
+	server.Registerer
+} {
+	return &grpcService{
+		Logger: logger.NewNullLogger(),
+	}
+}
+
+func (s *grpcService) SetUtilities(parameters ...interface{}) {
+	for _, p := range parameters {
+		switch p := p.(type) {
+		case logger.Logger:
+			s.Logger = p
+		}
+	}
+}
+
+func (s *grpcService) SetParameters(parameters ...interface{}) {
+	for _, parameter := range parameters {
+		switch p := parameter.(type) {
+		case logic.Logic:
+			s.logic = p
+		}
+	}
+	switch {
+	case s.logic == nil:
+		panic("logic not set")
+	}
+}
+
+func (s *grpcService) Register(server grpc.ServiceRegistrar) {
+	pb.RegisterChangesServer(server, s)
+}
+
+func (s *grpcService) ChangeUpsert(ctx context.Context, request *pb.ChangeUpsertRequest) (*pb.ChangeUpsertResponse, error) {
+	change, err := s.logic.ChangeUpsert(ctx, *pb.ToChangePartial(request.GetChangePartial()))
+	return &pb.ChangeUpsertResponse{
+		Change: pb.FromChange(change),
+	}, err
+}
+
+func (s *grpcService) ChangeRead(ctx context.Context, request *pb.ChangeReadRequest) (*pb.ChangeReadResponse, error) {
+	change, err := s.logic.ChangeRead(ctx, request.GetId())
+	return &pb.ChangeReadResponse{
+		Change: pb.FromChange(change),
+	}, err
+}
+
+func (s *grpcService) ChangesRead(ctx context.Context, request *pb.ChangesReadRequest) (*pb.ChangesReadResponse, error) {
+	var search data.ChangeSearch
+
+	if changeSearch := pb.FromChangeSearch(request.GetChangeSearch()); changeSearch != nil {
+		search = *changeSearch
+	}
+	changes, err := s.logic.ChangesRead(ctx, search)
+	return &pb.ChangesReadResponse{
+		Changes: pb.FromChanges(changes),
+	}, err
+}
+
+func (s *grpcService) ChangeDelete(ctx context.Context, request *pb.ChangeDeleteRequest) (*pb.ChangeDeleteResponse, error) {
+	err := s.logic.ChangesDelete(ctx, request.GetId())
+	return &pb.ChangeDeleteResponse{}, err
+}
+
+func (s *grpcService) RegistrationUpsert(ctx context.Context, request *pb.RegistrationUpsertRequest) (*pb.RegistrationUpsertResponse, error) {
+	err := s.logic.RegistrationUpsert(ctx, request.GetRegistrationId(),
+		logic.RegistrationFilter(pb.ToRegistrationFilter(request.GetFilter())))
+	return &pb.RegistrationUpsertResponse{}, err
+}
+
+func (s *grpcService) RegistrationChangesRead(ctx context.Context, request *pb.RegistrationChangesReadRequest) (*pb.RegistrationChangesReadResponse, error) {
+	changes, err := s.logic.RegistrationChangesRead(ctx, request.GetRegistrationId())
+	return &pb.RegistrationChangesReadResponse{
+		Changes: pb.FromChanges(changes),
+	}, err
+}
+
+func (s *grpcService) RegistrationChangeAcknowledge(ctx context.Context, request *pb.RegistrationChangeAcknowledgeRequest) (*pb.RegistrationChangeAcknowledgeResponse, error) {
+	err := s.logic.RegistrationChangeAcknowledge(ctx, request.GetRegistrationId(),
+		request.GetChangeIds()...)
+	return &pb.RegistrationChangeAcknowledgeResponse{}, err
+}
+
+func (s *grpcService) RegistrationDelete(ctx context.Context, request *pb.RegistrationDeleteRequest) (*pb.RegistrationDeleteResponse, error) {
+	err := s.logic.RegistrationDelete(ctx, request.GetRegistrationId())
+	return &pb.RegistrationDeleteResponse{}, err
+}
+
+func (s *grpcService) MetricsRead(ctx context.Context, request *pb.MetricsReadRequest) (*pb.MetricsReadResponse, error) {
+	metrics, err := s.logic.MetricsRead(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.MetricsReadResponse{Metrics: pb.FromMetrics(metrics)}, nil
+}
+
+// Subscribe will create a handler (with the provided filter) and stream
+// changes to the caller until the stream is closed or a send fails, if the
+// handler's queue overflows, the overflow is sent to the caller and if the
+// handler was disconnected, the stream is closed
+func (s *grpcService) Subscribe(request *pb.SubscribeRequest, stream pb.Changes_SubscribeServer) error {
+	var mu sync.Mutex
+	var stopped bool
+
+	ctx := stream.Context()
+	errs, disconnected := make(chan error, 1), make(chan struct{})
+	sendFx := func(response *pb.SubscribeResponse) error {
+		mu.Lock()
+		defer mu.Unlock()
+
+		//KIM: the handler may still be called after the stream
+		// has returned, sending at that point isn't safe
+		if stopped {
+			return nil
+		}
+		if err := stream.Send(response); err != nil {
+			select {
+			default:
+			case errs <- err:
+			}
+			return err
+		}
+		return nil
+	}
+	handlerId, err := s.logic.HandlerCreate(ctx, func(ctx context.Context, handlerId string, changes []*data.Change) error {
+		return sendFx(&pb.SubscribeResponse{Changes: pb.FromChanges(changes)})
+	}, logic.HandlerFilter(pb.ToRegistrationFilter(request.GetFilter())),
+		logic.HandlerRegistrationId(request.GetRegistrationId()),
+		logic.HandlerOverflow(data.OverflowStrategy(request.GetOverflow()), request.GetOverflowTimeout()),
+		logic.HandlerOverflowFx(func(ctx context.Context, handlerId string, overflow *data.HandlerOverflow) {
+			if err := sendFx(&pb.SubscribeResponse{Overflow: pb.FromHandlerOverflow(overflow)}); err != nil {
+				s.Error(logAlias+"error while sending overflow for handler %s: %s", handlerId, err)
+			}
+			if overflow.Disconnected {
+				close(disconnected)
+			}
+		}))
+	if err != nil {
+		return err
+	}
+	defer func() {
+		mu.Lock()
+		stopped = true
+		mu.Unlock()
+		select {
+		default:
+			if err := s.logic.HandlerDelete(context.Background(), handlerId); err != nil {
+				s.Error(logAlias+"error while deleting handler %s: %s", handlerId, err)
+			}
+		case <-disconnected:
+		}
+		s.Debug(logAlias+"unsubscribed handler: %s", handlerId)
+	}()
+	//KIM: headers are sent immediately so the client can confirm
+	// it's subscribed before any changes have been sent
+	if err := stream.SendHeader(metadata.MD{}); err != nil {
+		return err
+	}
+	s.Debug(logAlias+"subscribed handler: %s", handlerId)
+	select {
+	case <-ctx.Done():
+		return nil
+	case err := <-errs:
+		return err
+	case <-disconnected:
+		s.Debug(logAlias+"handler %s disconnected because of an overflow", handlerId)
+		return nil
+	}
+}
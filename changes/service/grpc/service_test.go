@@ -0,0 +1,329 @@
+package service_test
+
+import (
+	"context"
+	"math/rand"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	client "github.com/antonio-alexander/go-bludgeon/changes/client"
+	grpcclient "github.com/antonio-alexander/go-bludgeon/changes/client/grpc"
+	data "github.com/antonio-alexander/go-bludgeon/changes/data"
+	logic "github.com/antonio-alexander/go-bludgeon/changes/logic"
+	memory "github.com/antonio-alexander/go-bludgeon/changes/meta/memory"
+	service "github.com/antonio-alexander/go-bludgeon/changes/service/grpc"
+
+	internal "github.com/antonio-alexander/go-bludgeon/internal"
+	internal_server "github.com/antonio-alexander/go-bludgeon/internal/grpc/server"
+	internal_logger "github.com/antonio-alexander/go-bludgeon/internal/logger"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	configLogger     = new(internal_logger.Configuration)
+	configServer     = new(internal_server.Configuration)
+	configGrpcClient = new(grpcclient.Configuration)
+)
+
+func init() {
+	envs := make(map[string]string)
+	for _, e := range os.Environ() {
+		if s := strings.Split(e, "="); len(s) > 1 {
+			envs[s[0]] = strings.Join(s[1:], "=")
+		}
+	}
+	configLogger.Default()
+	configLogger.FromEnv(envs)
+	configLogger.Prefix = "bludgeon_grpc_service_test"
+	configServer.Default()
+	configServer.FromEnv(envs)
+	configServer.Address = "localhost"
+	configServer.Port = "8083"
+	configGrpcClient.Default()
+	configGrpcClient.FromEnv(envs)
+	configGrpcClient.Address = "localhost"
+	configGrpcClient.Port = "8083"
+	rand.Seed(time.Now().UnixNano())
+}
+
+func generateId() string {
+	return uuid.Must(uuid.NewRandom()).String()
+}
+
+type grpcServiceTest struct {
+	meta interface {
+		internal.Initializer
+	}
+	logic interface {
+		internal.Initializer
+	}
+	server interface {
+		internal.Initializer
+		internal.Configurer
+	}
+	client interface {
+		client.Client
+		client.Handler
+		client.Metrics
+		internal.Initializer
+		internal.Configurer
+	}
+}
+
+func newGrpcServiceTest() *grpcServiceTest {
+	logger := internal_logger.New()
+	logger.Configure(configLogger)
+	meta := memory.New()
+	meta.SetUtilities(logger)
+	logic := logic.New()
+	logic.SetUtilities(logger)
+	logic.SetParameters(meta)
+	service := service.New()
+	service.SetUtilities(logger)
+	service.SetParameters(logic)
+	server := internal_server.New()
+	server.SetUtilities(logger)
+	server.SetParameters(service)
+	client := grpcclient.New()
+	client.SetUtilities(logger)
+	return &grpcServiceTest{
+		meta:   meta,
+		logic:  logic,
+		server: server,
+		client: client,
+	}
+}
+
+func (g *grpcServiceTest) initialize(t *testing.T) {
+	err := g.meta.Initialize()
+	assert.Nil(t, err)
+	err = g.logic.Initialize()
+	assert.Nil(t, err)
+	err = g.server.Configure(configServer)
+	assert.Nil(t, err)
+	err = g.server.Initialize()
+	assert.Nil(t, err)
+	err = g.client.Configure(configGrpcClient)
+	assert.Nil(t, err)
+	err = g.client.Initialize()
+	assert.Nil(t, err)
+}
+
+func (g *grpcServiceTest) shutdown(t *testing.T) {
+	g.client.Shutdown()
+	g.server.Shutdown()
+	g.logic.Shutdown()
+	g.meta.Shutdown()
+}
+
+func (g *grpcServiceTest) changeUpsert(t *testing.T, dataServiceName string) *data.Change {
+	dataId, dataType := generateId(), generateId()
+	dataVersion, dataAction := rand.Intn(1000), "create"
+	whenChanged, changedBy := time.Now().UnixNano(), "test_grpc_service"
+	change, err := g.client.ChangeUpsert(context.TODO(), data.ChangePartial{
+		WhenChanged:     &whenChanged,
+		ChangedBy:       &changedBy,
+		DataId:          &dataId,
+		DataServiceName: &dataServiceName,
+		DataType:        &dataType,
+		DataAction:      &dataAction,
+		DataVersion:     &dataVersion,
+	})
+	assert.Nil(t, err)
+	if assert.NotNil(t, change) {
+		assert.NotEmpty(t, change.Id)
+		assert.Equal(t, whenChanged, change.WhenChanged)
+		assert.Equal(t, changedBy, change.ChangedBy)
+		assert.Equal(t, dataId, change.DataId)
+		assert.Equal(t, dataServiceName, change.DataServiceName)
+		assert.Equal(t, dataType, change.DataType)
+		assert.Equal(t, dataAction, change.DataAction)
+		assert.Equal(t, dataVersion, change.DataVersion)
+	}
+	return change
+}
+
+func (g *grpcServiceTest) testChangeOperations(t *testing.T) {
+	ctx := context.TODO()
+
+	//upsert change
+	change := g.changeUpsert(t, generateId())
+	if change == nil {
+		return
+	}
+
+	//read change
+	changeRead, err := g.client.ChangeRead(ctx, change.Id)
+	assert.Nil(t, err)
+	assert.Equal(t, change, changeRead)
+
+	//read changes
+	changesRead, err := g.client.ChangesRead(ctx, data.ChangeSearch{
+		DataIds: []string{change.DataId},
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, []*data.Change{change}, changesRead)
+
+	//delete change
+	err = g.client.ChangeDelete(ctx, change.Id)
+	assert.Nil(t, err)
+	changeRead, err = g.client.ChangeRead(ctx, change.Id)
+	assert.NotNil(t, err)
+	assert.Nil(t, changeRead)
+}
+
+func (g *grpcServiceTest) testRegistrationOperations(t *testing.T) {
+	ctx := context.TODO()
+	dataServiceName := generateId()
+
+	//upsert registration
+	registrationId := generateId()
+	err := g.client.RegistrationUpsert(ctx, registrationId,
+		client.RegistrationFilter(data.RegistrationFilter{
+			ServiceNames: []string{dataServiceName},
+		}))
+	assert.Nil(t, err)
+	defer func() {
+		g.client.RegistrationDelete(ctx, registrationId)
+	}()
+
+	//upsert changes (only one matches the filter)
+	change := g.changeUpsert(t, dataServiceName)
+	if change == nil {
+		return
+	}
+	defer func() {
+		g.client.ChangeDelete(ctx, change.Id)
+	}()
+	if changeFiltered := g.changeUpsert(t, generateId()); changeFiltered != nil {
+		defer func() {
+			g.client.ChangeDelete(ctx, changeFiltered.Id)
+		}()
+	}
+
+	//read registration changes
+	changesRead, err := g.client.RegistrationChangesRead(ctx, registrationId)
+	assert.Nil(t, err)
+	assert.Equal(t, []*data.Change{change}, changesRead)
+
+	//acknowledge change
+	err = g.client.RegistrationChangeAcknowledge(ctx, registrationId, change.Id)
+	assert.Nil(t, err)
+	changesRead, err = g.client.RegistrationChangesRead(ctx, registrationId)
+	assert.Nil(t, err)
+	assert.Empty(t, changesRead)
+
+	//delete registration
+	err = g.client.RegistrationDelete(ctx, registrationId)
+	assert.Nil(t, err)
+}
+
+func (g *grpcServiceTest) testSubscribe(t *testing.T) {
+	ctx := context.TODO()
+	dataServiceName := generateId()
+	changesReceived := make(chan *data.Change, 2)
+
+	//create handler (filtered by service name)
+	handlerId, err := g.client.HandlerCreate(func(changes ...*data.Change) error {
+		for _, change := range changes {
+			changesReceived <- change
+		}
+		return nil
+	}, client.HandlerFilter(data.RegistrationFilter{ServiceNames: []string{dataServiceName}}))
+	assert.Nil(t, err)
+	defer func() {
+		g.client.HandlerDelete(handlerId)
+	}()
+	assert.Eventually(t, func() bool {
+		connected, err := g.client.HandlerConnected(handlerId)
+		return err == nil && connected
+	}, 10*time.Second, 100*time.Millisecond)
+
+	//upsert changes (only the second should be received)
+	if change := g.changeUpsert(t, generateId()); change != nil {
+		defer func() {
+			g.client.ChangeDelete(ctx, change.Id)
+		}()
+	}
+	change := g.changeUpsert(t, dataServiceName)
+	if change == nil {
+		return
+	}
+	defer func() {
+		g.client.ChangeDelete(ctx, change.Id)
+	}()
+
+	//validate change received
+	select {
+	case changeReceived := <-changesReceived:
+		assert.Equal(t, change, changeReceived)
+	case <-time.After(10 * time.Second):
+		assert.Fail(t, "unable to confirm change received")
+	}
+	select {
+	case changeReceived := <-changesReceived:
+		assert.Fail(t, "received filtered change", changeReceived.Id)
+	case <-time.After(time.Second):
+	}
+
+	//delete handler
+	err = g.client.HandlerDelete(handlerId)
+	assert.Nil(t, err)
+	_, err = g.client.HandlerConnected(handlerId)
+	assert.NotNil(t, err)
+}
+
+func (g *grpcServiceTest) testMetrics(t *testing.T) {
+	ctx := context.TODO()
+
+	//upsert registration and change
+	registrationId, dataServiceName := generateId(), generateId()
+	err := g.client.RegistrationUpsert(ctx, registrationId,
+		client.RegistrationFilter(data.RegistrationFilter{
+			ServiceNames: []string{dataServiceName},
+		}))
+	assert.Nil(t, err)
+	defer func() {
+		g.client.RegistrationDelete(ctx, registrationId)
+	}()
+	change := g.changeUpsert(t, dataServiceName)
+	if change == nil {
+		return
+	}
+	defer func() {
+		g.client.RegistrationChangeAcknowledge(ctx, registrationId, change.Id)
+	}()
+
+	//read metrics
+	metrics, err := g.client.MetricsRead(ctx)
+	assert.Nil(t, err)
+	if !assert.NotNil(t, metrics) {
+		return
+	}
+	var registrationMetrics *data.RegistrationMetrics
+	for _, r := range metrics.Registrations {
+		if r.RegistrationId == registrationId {
+			registrationMetrics = r
+		}
+	}
+	if assert.NotNil(t, registrationMetrics) {
+		assert.Equal(t, 1, registrationMetrics.Pending)
+		assert.False(t, registrationMetrics.Connected)
+	}
+}
+
+func TestGrpcService(t *testing.T) {
+	g := newGrpcServiceTest()
+
+	g.initialize(t)
+	defer g.shutdown(t)
+
+	t.Run("Change Operations", g.testChangeOperations)
+	t.Run("Registration Operations", g.testRegistrationOperations)
+	t.Run("Subscribe", g.testSubscribe)
+	t.Run("Metrics", g.testMetrics)
+}
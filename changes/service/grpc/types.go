@@ -0,0 +1,3 @@
+package service
+
+const logAlias string = "[grpc_service] "
@@ -1,28 +1,94 @@
 package service
 
-import "errors"
+import (
+	"errors"
+	"strconv"
+	"strings"
+	"text/template"
+
+	"github.com/antonio-alexander/go-bludgeon/changes/data"
+)
 
 const DefaultTopic string = "changes"
 
-const EnvNameChangesTopic string = "BLUDGEON_CHANGES_TOPIC"
+const (
+	EnvNameChangesTopic              string = "BLUDGEON_CHANGES_TOPIC"
+	EnvNameChangesTopicTemplate      string = "BLUDGEON_CHANGES_TOPIC_TEMPLATE"
+	EnvNameChangesFilterServiceNames string = "BLUDGEON_CHANGES_FILTER_SERVICE_NAMES"
+	EnvNameChangesFilterTypes        string = "BLUDGEON_CHANGES_FILTER_TYPES"
+	EnvNameChangesFilterActions      string = "BLUDGEON_CHANGES_FILTER_ACTIONS"
+	EnvNameChangesRegistrationId     string = "BLUDGEON_CHANGES_REGISTRATION_ID"
+	EnvNameChangesOverflow           string = "BLUDGEON_CHANGES_OVERFLOW"
+	EnvNameChangesOverflowTimeout    string = "BLUDGEON_CHANGES_OVERFLOW_TIMEOUT"
+	EnvNameChangesIngestTopic        string = "BLUDGEON_CHANGES_INGEST_TOPIC"
+)
 
 type Configuration struct {
 	Topic string
+
+	//TopicTemplate is used to generate a topic for each change from its fields, e.g.
+	// bludgeon.{{.DataServiceName}}.{{.DataType}}, changes are keyed by their data id;
+	// if empty, changes are published to Topic
+	TopicTemplate string
+
+	Filter  data.RegistrationFilter //only changes that match are published
+	Handler data.HandlerOptions     //the registration the handler is reported for and its overflow strategy (optional)
+
+	//IngestTopic is the topic changes (partials) are consumed from, if empty, changes
+	// aren't ingested; changes that can't be upserted are re-delivered by the kafka
+	// client (every BLUDGEON_KAFKA_RETRY_RATE) until they're upserted
+	IngestTopic string
 }
 
 func (c *Configuration) Validate() error {
 	if c.Topic == "" {
 		return errors.New("topic is empty")
 	}
+	if c.TopicTemplate != "" {
+		if _, err := template.New("topic").Option("missingkey=error").Parse(c.TopicTemplate); err != nil {
+			return errors.New("topic template is invalid: " + err.Error())
+		}
+	}
+	if !c.Handler.Overflow.Valid() {
+		return errors.New("overflow strategy not supported")
+	}
+	if c.IngestTopic != "" && c.IngestTopic == c.Topic {
+		return errors.New("ingest topic is the same as topic")
+	}
 	return nil
 }
 
 func (c *Configuration) Default() {
 	c.Topic = DefaultTopic
+	c.Filter = data.RegistrationFilter{}
 }
 
 func (c *Configuration) FromEnv(envs map[string]string) {
 	if topic := envs[EnvNameChangesTopic]; topic != "" {
 		c.Topic = topic
 	}
+	if topicTemplate := envs[EnvNameChangesTopicTemplate]; topicTemplate != "" {
+		c.TopicTemplate = topicTemplate
+	}
+	if serviceNames := envs[EnvNameChangesFilterServiceNames]; serviceNames != "" {
+		c.Filter.ServiceNames = strings.Split(serviceNames, ",")
+	}
+	if types := envs[EnvNameChangesFilterTypes]; types != "" {
+		c.Filter.Types = strings.Split(types, ",")
+	}
+	if actions := envs[EnvNameChangesFilterActions]; actions != "" {
+		c.Filter.Actions = strings.Split(actions, ",")
+	}
+	if registrationId := envs[EnvNameChangesRegistrationId]; registrationId != "" {
+		c.Handler.RegistrationId = registrationId
+	}
+	if overflow := envs[EnvNameChangesOverflow]; overflow != "" {
+		c.Handler.Overflow = data.OverflowStrategy(overflow)
+	}
+	if overflowTimeout := envs[EnvNameChangesOverflowTimeout]; overflowTimeout != "" {
+		c.Handler.OverflowTimeout, _ = strconv.ParseInt(overflowTimeout, 10, 64)
+	}
+	if ingestTopic := envs[EnvNameChangesIngestTopic]; ingestTopic != "" {
+		c.IngestTopic = ingestTopic
+	}
 }
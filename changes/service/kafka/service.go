@@ -2,11 +2,16 @@ package service
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
+	"regexp"
+	"strings"
 	"sync"
+	"text/template"
 
 	"github.com/antonio-alexander/go-bludgeon/changes/data"
 	"github.com/antonio-alexander/go-bludgeon/changes/logic"
+	"github.com/antonio-alexander/go-bludgeon/changes/meta"
 	"github.com/antonio-alexander/go-bludgeon/internal"
 
 	"github.com/antonio-alexander/go-bludgeon/internal/config"
@@ -14,6 +19,9 @@ import (
 	"github.com/antonio-alexander/go-bludgeon/internal/logger"
 )
 
+// topicInvalidCharacters matches characters that aren't valid in a topic name
+var topicInvalidCharacters = regexp.MustCompile(`[^a-zA-Z0-9._-]`)
+
 type kafkaService struct {
 	sync.RWMutex
 	sync.WaitGroup
@@ -24,6 +32,8 @@ type kafkaService struct {
 	logic       logic.Logic
 	muHandlers  sync.RWMutex
 	handlers    map[string]string
+	ingestId    string
+	topicTmpl   *template.Template
 	initialized bool
 	configured  bool
 	config      *Configuration
@@ -72,19 +82,122 @@ func (k *kafkaService) deleteHandler(topic string) {
 	delete(k.handlers, topic)
 }
 
+// changeTopic will generate the topic for a change using the topic template,
+// characters that aren't valid in a topic name are replaced with underscores
+func (k *kafkaService) changeTopic(change *data.Change) (string, error) {
+	topic := &strings.Builder{}
+	if err := k.topicTmpl.Execute(topic, change); err != nil {
+		return "", err
+	}
+	if topic.Len() == 0 {
+		return "", errors.New("topic is empty")
+	}
+	return topicInvalidCharacters.ReplaceAllString(topic.String(), "_"), nil
+}
+
+// handleFx will publish changes to the topic (or the topic generated using the
+// topic template), the trace and correlation ids of the context the changes were
+// upserted with are published as headers
 func (k *kafkaService) handleFx(topic string) logic.HandlerFx {
 	return func(ctx context.Context, handlerId string, changes []*data.Change) error {
-		if err := k.Client.Publish(topic, data.ToWrapper(
-			&data.ChangeDigest{Changes: changes},
-		)); err != nil {
-			k.Error(logAlias, "error while publishing to topic \"%s\", handler \"%s\": %s", topic, handlerId, err)
-			return err
+		headers := kafka.HeadersFromContext(ctx)
+		if k.topicTmpl == nil {
+			if err := k.Client.PublishWithHeaders(topic, "", headers, data.ToWrapper(
+				&data.ChangeDigest{Changes: changes},
+			)); err != nil {
+				k.Error(logAlias+"error while publishing to topic \"%s\", handler \"%s\": %s", topic, handlerId, err)
+				return err
+			}
+			k.Trace("published change(s) to topic \"%s\", handler \"%s\"", topic, handlerId)
+			return nil
+		}
+		//KIM: changes are keyed by their data id so the changes for a
+		// given entity are published to the same partition (in order)
+		for _, change := range changes {
+			changeTopic, err := k.changeTopic(change)
+			if err != nil {
+				k.Error(logAlias+"error while generating topic for change %s: %s", change.Id, err)
+				return err
+			}
+			if err := k.Client.PublishWithHeaders(changeTopic, change.DataId, headers, data.ToWrapper(change)); err != nil {
+				k.Error(logAlias+"error while publishing to topic \"%s\", handler \"%s\": %s", changeTopic, handlerId, err)
+				return err
+			}
+			k.Trace("published change %s to topic \"%s\", handler \"%s\"", change.Id, changeTopic, handlerId)
 		}
-		k.Trace("published change(s) to topic \"%s\", handler \"%s\"", topic, handlerId)
 		return nil
 	}
 }
 
+// overflowFx will publish the overflow to the topic so subscribers can resync,
+// if the handler was disconnected, it's re-created so publishing can continue
+func (k *kafkaService) overflowFx(topic string) logic.OverflowFx {
+	return func(ctx context.Context, handlerId string, overflow *data.HandlerOverflow) {
+		if err := k.Client.Publish(topic, data.ToWrapper(overflow)); err != nil {
+			k.Error(logAlias+"error while publishing overflow to topic \"%s\", handler \"%s\": %s", topic, handlerId, err)
+		}
+		if !overflow.Disconnected || k.ctx.Err() != nil {
+			return
+		}
+		handlerId, err := k.handlerCreate(topic)
+		if err != nil {
+			k.Error(logAlias+"error while re-creating handler for topic \"%s\": %s", topic, err)
+			return
+		}
+		k.Info(logAlias+"re-created handler \"%s\" for topic \"%s\"", handlerId, topic)
+	}
+}
+
+// ingestFx will upsert changes (partials) consumed from the ingest topic, if the
+// upsert fails, the error is returned so the message isn't acknowledged and is
+// re-delivered (at-least-once), changes that were already ingested are ignored;
+// the trace and correlation ids (headers) are propagated using the context
+func (k *kafkaService) ingestFx(topic string, headers kafka.Headers, bytes []byte) error {
+	//KIM: messages that can't be unwrapped are ignored since they'd
+	// fail the same way every time they're re-delivered
+	wrapper := &data.Wrapper{}
+	if err := json.Unmarshal(bytes, wrapper); err != nil {
+		k.Error(logAlias+"error while unmarshalling wrapper from topic \"%s\": %s", topic, err)
+		return nil
+	}
+	item, err := data.FromWrapper(wrapper)
+	if err != nil {
+		k.Error(logAlias+"error while unwrapping item from topic \"%s\": %s", topic, err)
+		return nil
+	}
+	changePartial, ok := item.(*data.ChangePartial)
+	if !ok {
+		k.Trace(logAlias+"received unsupported type from topic \"%s\": %T", topic, item)
+		return nil
+	}
+	ctx := kafka.ContextWithHeaders(k.ctx, headers)
+	change, err := k.logic.ChangeUpsert(ctx, *changePartial)
+	switch {
+	default:
+		k.Debug(logAlias+"error while ingesting change from topic \"%s\" (it will be re-delivered): %s", topic, err)
+		return err
+	case err == nil:
+		k.Trace(logAlias+"ingested change %s from topic \"%s\"", change.Id, topic)
+	case errors.Is(err, meta.ErrChangeConflictWrite):
+		//KIM: the change was delivered more than once
+		k.Debug(logAlias+"ignored change from topic \"%s\", it was already ingested", topic)
+	}
+	return nil
+}
+
+func (k *kafkaService) handlerCreate(topic string) (string, error) {
+	handlerId, err := k.logic.HandlerCreate(k.ctx, k.handleFx(topic),
+		logic.HandlerFilter(k.config.Filter),
+		logic.HandlerRegistrationId(k.config.Handler.RegistrationId),
+		logic.HandlerOverflow(k.config.Handler.Overflow, k.config.Handler.OverflowTimeout),
+		logic.HandlerOverflowFx(k.overflowFx(topic)))
+	if err != nil {
+		return "", err
+	}
+	k.writeHandler(topic, handlerId)
+	return handlerId, nil
+}
+
 func (k *kafkaService) SetUtilities(parameters ...interface{}) {
 	for _, parameter := range parameters {
 		switch p := parameter.(type) {
@@ -134,6 +247,14 @@ func (k *kafkaService) Configure(items ...interface{}) error {
 	if err := c.Validate(); err != nil {
 		return err
 	}
+	k.topicTmpl = nil
+	if c.TopicTemplate != "" {
+		topicTmpl, err := template.New("topic").Option("missingkey=error").Parse(c.TopicTemplate)
+		if err != nil {
+			return err
+		}
+		k.topicTmpl = topicTmpl
+	}
 	k.config = c
 	k.configured = true
 	return nil
@@ -150,12 +271,19 @@ func (k *kafkaService) Initialize() error {
 	}
 	k.ctx, k.cancel = context.WithCancel(context.Background())
 	topic := k.config.Topic
-	handlerId, err := k.logic.HandlerCreate(k.ctx, k.handleFx(topic))
+	handlerId, err := k.handlerCreate(topic)
 	if err != nil {
 		return err
 	}
-	k.writeHandler(topic, handlerId)
 	k.Info("created handler \"%s\" for topic \"%s\"", handlerId, topic)
+	if ingestTopic := k.config.IngestTopic; ingestTopic != "" {
+		ingestId, err := k.SubscribeWithHeaders(ingestTopic, k.ingestFx)
+		if err != nil {
+			return err
+		}
+		k.ingestId = ingestId
+		k.Info(logAlias+"ingesting changes from topic \"%s\"", ingestTopic)
+	}
 	k.initialized = true
 	k.Info(logAlias + "initialized")
 	return nil
@@ -168,6 +296,10 @@ func (k *kafkaService) Shutdown() {
 		return
 	}
 	k.cancel()
+	if k.ingestId != "" {
+		k.Unsubscribe(k.config.IngestTopic, k.ingestId)
+		k.ingestId = ""
+	}
 	for _, topic := range k.readTopics() {
 		handlerId, ok := k.readHandler(topic)
 		if !ok {
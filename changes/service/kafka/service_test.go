@@ -0,0 +1,302 @@
+package service_test
+
+import (
+	"context"
+	"encoding/json"
+	"math/rand"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	kafkaclient "github.com/antonio-alexander/go-bludgeon/changes/client/kafka"
+	data "github.com/antonio-alexander/go-bludgeon/changes/data"
+	logic "github.com/antonio-alexander/go-bludgeon/changes/logic"
+	meta "github.com/antonio-alexander/go-bludgeon/changes/meta"
+	memory "github.com/antonio-alexander/go-bludgeon/changes/meta/memory"
+	service_kafka "github.com/antonio-alexander/go-bludgeon/changes/service/kafka"
+	internal "github.com/antonio-alexander/go-bludgeon/internal"
+
+	internal_kafka "github.com/antonio-alexander/go-bludgeon/internal/kafka"
+	logger "github.com/antonio-alexander/go-bludgeon/internal/logger"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+var logConfig = new(logger.Configuration)
+
+type kafkaMemoryServiceTest struct {
+	changeTopic string
+	ingestTopic string
+	meta        interface {
+		meta.Change
+		internal.Shutdowner
+	}
+	logic interface {
+		logic.Logic
+		internal.Initializer
+	}
+	client interface {
+		internal_kafka.Client
+		internal.Initializer
+	}
+	testClient interface {
+		internal_kafka.Client
+		internal.Initializer
+	}
+	service interface {
+		internal.Initializer
+	}
+}
+
+func init() {
+	//get environment
+	envs := make(map[string]string)
+	for _, e := range os.Environ() {
+		if s := strings.Split(e, "="); len(s) > 1 {
+			envs[s[0]] = strings.Join(s[1:], "=")
+		}
+	}
+
+	//create logger config
+	logConfig.Default()
+	logConfig.FromEnv(envs)
+	logConfig.Level = logger.Trace
+	logConfig.Prefix = "test_service"
+
+	//seed
+	rand.Seed(time.Now().UnixNano())
+}
+
+func generateId() string {
+	return uuid.Must(uuid.NewRandom()).String()
+}
+
+// newMemoryKafkaConfig will create the configuration for a kafka client
+// that uses the in-memory broker (shared by all clients in the process)
+func newMemoryKafkaConfig() *internal_kafka.Configuration {
+	kafkaConfig := new(internal_kafka.Configuration)
+	kafkaConfig.Default()
+	kafkaConfig.InMemory = true
+	kafkaConfig.GroupId = generateId()
+	kafkaConfig.ConsumerOffset = internal_kafka.ConsumerOffsetOldest
+	kafkaConfig.RetryRate = 10 * time.Millisecond
+	return kafkaConfig
+}
+
+func newKafkaMemoryServiceTest() *kafkaMemoryServiceTest {
+	changeTopic := "test.changes." + generateId()
+	ingestTopic := "test.changes.ingest." + generateId()
+	logger := logger.New()
+	logger.Configure(logConfig)
+	meta := memory.New()
+	meta.SetParameters(logger)
+	logic := logic.New()
+	logic.SetParameters(logger, meta)
+	kafkaClient := internal_kafka.New()
+	kafkaClient.SetParameters(logger)
+	kafkaClient.Configure(newMemoryKafkaConfig())
+	service := service_kafka.New()
+	service.SetParameters(logger, logic, kafkaClient)
+	service.Configure(&service_kafka.Configuration{
+		Topic:       changeTopic,
+		IngestTopic: ingestTopic,
+	})
+	kafkaClientTest := internal_kafka.New()
+	kafkaClientTest.SetParameters(logger)
+	kafkaClientTest.Configure(newMemoryKafkaConfig())
+	return &kafkaMemoryServiceTest{
+		changeTopic: changeTopic,
+		ingestTopic: ingestTopic,
+		meta:        meta,
+		logic:       logic,
+		client:      kafkaClient,
+		testClient:  kafkaClientTest,
+		service:     service,
+	}
+}
+
+func (k *kafkaMemoryServiceTest) initialize(t *testing.T) {
+	err := k.client.Initialize()
+	assert.Nil(t, err)
+	err = k.testClient.Initialize()
+	assert.Nil(t, err)
+	err = k.logic.Initialize()
+	assert.Nil(t, err)
+	err = k.service.Initialize()
+	assert.Nil(t, err)
+}
+
+func (k *kafkaMemoryServiceTest) shutdown(t *testing.T) {
+	k.service.Shutdown()
+	k.client.Shutdown()
+	k.testClient.Shutdown()
+	k.logic.Shutdown()
+	k.meta.Shutdown()
+}
+
+// subscribe will subscribe to the change topic and send the changes
+// (published as change digests) to the returned channel
+func (k *kafkaMemoryServiceTest) subscribe(t *testing.T) <-chan *data.Change {
+	changes := make(chan *data.Change, 10)
+	handlerId, err := k.testClient.Subscribe(k.changeTopic, func(topic string, bytes []byte) {
+		wrapper := &data.Wrapper{}
+		if err := json.Unmarshal(bytes, wrapper); err != nil {
+			t.Logf("error while unmarshalling json: %s", err)
+			return
+		}
+		item, err := data.FromWrapper(wrapper)
+		if err != nil {
+			t.Logf("error during FromWrapper: %s", err)
+			return
+		}
+		if changeDigest, ok := item.(*data.ChangeDigest); ok {
+			for _, change := range changeDigest.Changes {
+				changes <- change
+			}
+		}
+	})
+	assert.Nil(t, err)
+	assert.NotEmpty(t, handlerId)
+	return changes
+}
+
+func (k *kafkaMemoryServiceTest) testChangeHandler(t *testing.T) {
+	ctx := context.TODO()
+	changes := k.subscribe(t)
+
+	//upsert change and validate change received
+	dataId, version := generateId(), rand.Int()
+	dataType, serviceName := "employee", "employees"
+	changeCreated, err := k.logic.ChangeUpsert(ctx, data.ChangePartial{
+		DataId:          &dataId,
+		DataVersion:     &version,
+		DataType:        &dataType,
+		DataServiceName: &serviceName,
+	})
+	assert.Nil(t, err)
+	select {
+	case <-time.After(10 * time.Second):
+		assert.Fail(t, "unable to confirm change received")
+	case change := <-changes:
+		assert.Equal(t, changeCreated.Id, change.Id)
+	}
+}
+
+func (k *kafkaMemoryServiceTest) testChangeIngest(t *testing.T) {
+	changes := k.subscribe(t)
+
+	//publish change (partial) to the ingest topic and validate
+	// that it's upserted and published
+	dataId, version := generateId(), rand.Int()
+	dataType, serviceName, dataAction := "employee", "employees", "update"
+	changePartial := &data.ChangePartial{
+		DataId:          &dataId,
+		DataVersion:     &version,
+		DataType:        &dataType,
+		DataServiceName: &serviceName,
+		DataAction:      &dataAction,
+	}
+	err := k.testClient.Publish(k.ingestTopic, data.ToWrapper(changePartial))
+	assert.Nil(t, err)
+	select {
+	case <-time.After(10 * time.Second):
+		assert.Fail(t, "unable to confirm change received")
+	case change := <-changes:
+		assert.Equal(t, dataId, change.DataId)
+		assert.Equal(t, version, change.DataVersion)
+	}
+
+	//publish the same change again (delivered more than once) and
+	// validate that it's ignored rather than upserted
+	err = k.testClient.Publish(k.ingestTopic, data.ToWrapper(changePartial))
+	assert.Nil(t, err)
+	select {
+	case <-time.After(time.Second):
+	case change := <-changes:
+		assert.Fail(t, "received duplicate change", change.Id)
+	}
+}
+
+func (k *kafkaMemoryServiceTest) testChangeTrace(t *testing.T) {
+	type changeHeaders struct {
+		headers internal_kafka.Headers
+		changes []*data.Change
+	}
+
+	changesReceived := make(chan changeHeaders, 10)
+
+	//create changes (kafka) client to upsert changes using the ingest topic
+	changesClient := kafkaclient.New()
+	err := changesClient.Configure(newMemoryKafkaConfig(), &kafkaclient.Configuration{
+		Topic:       k.changeTopic,
+		IngestTopic: k.ingestTopic,
+	})
+	assert.Nil(t, err)
+	err = changesClient.Initialize()
+	assert.Nil(t, err)
+	defer changesClient.Shutdown()
+
+	//subscribe to the change topic with headers
+	handlerId, err := k.testClient.SubscribeWithHeaders(k.changeTopic, func(topic string, headers internal_kafka.Headers, bytes []byte) error {
+		wrapper := &data.Wrapper{}
+		if err := json.Unmarshal(bytes, wrapper); err != nil {
+			t.Logf("error while unmarshalling json: %s", err)
+			return nil
+		}
+		item, err := data.FromWrapper(wrapper)
+		if err != nil {
+			t.Logf("error during FromWrapper: %s", err)
+			return nil
+		}
+		if changeDigest, ok := item.(*data.ChangeDigest); ok {
+			changesReceived <- changeHeaders{headers: headers, changes: changeDigest.Changes}
+		}
+		return nil
+	})
+	assert.Nil(t, err)
+	defer k.testClient.Unsubscribe(k.changeTopic, handlerId)
+
+	//upsert change with a trace and correlation id and validate that
+	// they're propagated (from ingest to publish)
+	traceId, correlationId := generateId(), generateId()
+	ctx := internal_kafka.ContextWithHeaders(context.TODO(), internal_kafka.Headers{
+		internal_kafka.HeaderTraceId:       traceId,
+		internal_kafka.HeaderCorrelationId: correlationId,
+	})
+	dataId, version := generateId(), rand.Int()
+	dataType, serviceName, dataAction := "employee", "employees", "delete"
+	_, err = changesClient.ChangeUpsert(ctx, data.ChangePartial{
+		DataId:          &dataId,
+		DataVersion:     &version,
+		DataType:        &dataType,
+		DataServiceName: &serviceName,
+		DataAction:      &dataAction,
+	})
+	assert.Nil(t, err)
+	for {
+		select {
+		case <-time.After(10 * time.Second):
+			assert.Fail(t, "unable to confirm change received")
+			return
+		case changeReceived := <-changesReceived:
+			if len(changeReceived.changes) == 0 || changeReceived.changes[0].DataId != dataId {
+				continue
+			}
+			assert.Equal(t, traceId, changeReceived.headers[internal_kafka.HeaderTraceId])
+			assert.Equal(t, correlationId, changeReceived.headers[internal_kafka.HeaderCorrelationId])
+			return
+		}
+	}
+}
+
+func TestChangesKafkaServiceInMemory(t *testing.T) {
+	k := newKafkaMemoryServiceTest()
+	k.initialize(t)
+	defer k.shutdown(t)
+
+	t.Run("Change Handler", k.testChangeHandler)
+	t.Run("Change Ingest", k.testChangeIngest)
+	t.Run("Change Trace", k.testChangeTrace)
+}
@@ -45,17 +45,24 @@ func (s *restServer) handleResponse(writer http.ResponseWriter, err error, item
 		default:
 			writer.WriteHeader(http.StatusInternalServerError)
 		case errors.Is(err, meta.ErrChangeNotFound) ||
-			errors.Is(err, meta.ErrRegistrationNotFound):
+			errors.Is(err, meta.ErrRegistrationNotFound) ||
+			errors.Is(err, meta.ErrWebhookNotFound) ||
+			errors.Is(err, meta.ErrReplayNotFound):
 			writer.WriteHeader(http.StatusNotFound)
 		case errors.Is(err, meta.ErrChangeNotWritten) ||
-			errors.Is(err, meta.ErrRegistrationNotWritten):
+			errors.Is(err, meta.ErrRegistrationNotWritten) ||
+			errors.Is(err, meta.ErrWebhookNotWritten):
 			writer.WriteHeader(http.StatusNotModified)
 		case errors.Is(err, meta.ErrChangeConflictWrite):
 			writer.WriteHeader(http.StatusConflict)
 		}
+		//KIM: the errors of meta are pointers (e.g. ErrChangeConflictWrite),
+		// the type must be kept so clients can identify the error
 		switch i := err.(type) {
 		case internal_errors.Error:
 			e = i
+		case *internal_errors.Error:
+			e = *i
 		default:
 			e = internal_errors.New(err.Error())
 		}
@@ -150,7 +157,12 @@ func (s *restServer) endpointRegistrationUpsert() func(http.ResponseWriter, *htt
 
 		if bytes, err = io.ReadAll(request.Body); err == nil {
 			if err = json.Unmarshal(bytes, &requestRegister); err == nil {
-				err = s.logic.RegistrationUpsert(request.Context(), requestRegister.RegistrationId)
+				err = s.logic.RegistrationUpsert(request.Context(), requestRegister.RegistrationId,
+					logic.RegistrationFilter(requestRegister.Filter))
+				if err == nil && requestRegister.Webhook != nil {
+					requestRegister.Webhook.RegistrationId = requestRegister.RegistrationId
+					err = s.logic.WebhookUpsert(request.Context(), *requestRegister.Webhook)
+				}
 			}
 		}
 		if err = s.handleResponse(writer, err, nil); err != nil {
@@ -205,8 +217,129 @@ func (s *restServer) endpointRegistrationDelete() func(http.ResponseWriter, *htt
 	}
 }
 
+func (s *restServer) endpointWebhookRead() func(http.ResponseWriter, *http.Request) {
+	return func(writer http.ResponseWriter, request *http.Request) {
+		registrationId, _ := valueFromPath(data.PathRegistrationId, rest.Vars(request))
+		webhookStatus, err := s.logic.WebhookStatusRead(request.Context(), registrationId)
+		if err = s.handleResponse(writer, err, webhookStatus); err != nil {
+			s.Error(logAlias+"webhook read -  %s", err)
+		}
+	}
+}
+
+func (s *restServer) endpointWebhookDelete() func(http.ResponseWriter, *http.Request) {
+	return func(writer http.ResponseWriter, request *http.Request) {
+		registrationId, _ := valueFromPath(data.PathRegistrationId, rest.Vars(request))
+		err := s.logic.WebhookDelete(request.Context(), registrationId)
+		if err = s.handleResponse(writer, err, nil); err != nil {
+			s.Error(logAlias+"webhook delete -  %s", err)
+			return
+		}
+		s.Debug(logAlias+"deleted webhook: %s", registrationId)
+	}
+}
+
+func (s *restServer) endpointDeadLettersRead() func(http.ResponseWriter, *http.Request) {
+	return func(writer http.ResponseWriter, request *http.Request) {
+		registrationId, _ := valueFromPath(data.PathRegistrationId, rest.Vars(request))
+		deadLetters, err := s.logic.DeadLettersRead(request.Context(), registrationId)
+		if err = s.handleResponse(writer, err, &data.DeadLetterDigest{DeadLetters: deadLetters}); err != nil {
+			s.Error(logAlias+"dead letters read -  %s", err)
+		}
+	}
+}
+
+func (s *restServer) endpointPrune() func(http.ResponseWriter, *http.Request) {
+	return func(writer http.ResponseWriter, request *http.Request) {
+		report, err := s.logic.Prune(request.Context())
+		if err = s.handleResponse(writer, err, report); err != nil {
+			s.Error(logAlias+"prune -  %s", err)
+		}
+	}
+}
+
+func (s *restServer) endpointPruneReportRead() func(http.ResponseWriter, *http.Request) {
+	return func(writer http.ResponseWriter, request *http.Request) {
+		report, err := s.logic.PruneReportRead(request.Context())
+		if err = s.handleResponse(writer, err, report); err != nil {
+			s.Error(logAlias+"prune report read -  %s", err)
+		}
+	}
+}
+
+func (s *restServer) endpointMetricsRead() func(http.ResponseWriter, *http.Request) {
+	return func(writer http.ResponseWriter, request *http.Request) {
+		metrics, err := s.logic.MetricsRead(request.Context())
+		if err = s.handleResponse(writer, err, metrics); err != nil {
+			s.Error(logAlias+"metrics read -  %s", err)
+		}
+	}
+}
+
+func (s *restServer) endpointReplayStart() func(http.ResponseWriter, *http.Request) {
+	return func(writer http.ResponseWriter, request *http.Request) {
+		var replay *data.Replay
+		var bytes []byte
+		var err error
+
+		registrationId, _ := valueFromPath(data.PathRegistrationId, rest.Vars(request))
+		replayRequest := &data.RequestReplay{}
+		if bytes, err = io.ReadAll(request.Body); err == nil {
+			if err = json.Unmarshal(bytes, replayRequest); err == nil {
+				if replay, err = s.logic.ReplayStart(request.Context(), registrationId, *replayRequest); err == nil {
+					s.Debug(logAlias+"started replay for %s", registrationId)
+				}
+			}
+		}
+		if err = s.handleResponse(writer, err, replay); err != nil {
+			s.Error(logAlias+"replay start -  %s", err)
+		}
+	}
+}
+
+func (s *restServer) endpointReplayRead() func(http.ResponseWriter, *http.Request) {
+	return func(writer http.ResponseWriter, request *http.Request) {
+		registrationId, _ := valueFromPath(data.PathRegistrationId, rest.Vars(request))
+		replay, err := s.logic.ReplayRead(request.Context(), registrationId)
+		if err = s.handleResponse(writer, err, replay); err != nil {
+			s.Error(logAlias+"replay read -  %s", err)
+		}
+	}
+}
+
+func (s *restServer) endpointReplayResume() func(http.ResponseWriter, *http.Request) {
+	return func(writer http.ResponseWriter, request *http.Request) {
+		registrationId, _ := valueFromPath(data.PathRegistrationId, rest.Vars(request))
+		replay, err := s.logic.ReplayResume(request.Context(), registrationId)
+		if err = s.handleResponse(writer, err, replay); err != nil {
+			s.Error(logAlias+"replay resume -  %s", err)
+			return
+		}
+		s.Debug(logAlias+"resumed replay for %s", registrationId)
+	}
+}
+
+func (s *restServer) endpointReplayStop() func(http.ResponseWriter, *http.Request) {
+	return func(writer http.ResponseWriter, request *http.Request) {
+		registrationId, _ := valueFromPath(data.PathRegistrationId, rest.Vars(request))
+		err := s.logic.ReplayStop(request.Context(), registrationId)
+		if err = s.handleResponse(writer, err, nil); err != nil {
+			s.Error(logAlias+"replay stop -  %s", err)
+			return
+		}
+		s.Debug(logAlias+"stopped replay for %s", registrationId)
+	}
+}
+
 func (s *restServer) endpointWebsocket() func(http.ResponseWriter, *http.Request) {
 	return func(writer http.ResponseWriter, request *http.Request) {
+		var filter data.RegistrationFilter
+		var options data.HandlerOptions
+
+		//KIM: the filter (and optionally the handler options) are provided
+		// as query parameters during the handshake (the upgrade request)
+		filter.FromParams(request.URL.Query())
+		options.FromParams(request.URL.Query())
 		ws := websocket.New(writer, request, s.Logger)
 		if ws == nil {
 			err := errors.New("unable to create websocket")
@@ -227,7 +360,18 @@ func (s *restServer) endpointWebsocket() func(http.ResponseWriter, *http.Request
 				}
 			}
 			return nil
-		})
+		}, logic.HandlerFilter(filter), logic.HandlerRegistrationId(options.RegistrationId),
+			logic.HandlerOverflow(options.Overflow, options.OverflowTimeout),
+			logic.HandlerOverflowFx(func(ctx context.Context, handlerId string, overflow *data.HandlerOverflow) {
+				//KIM: if the handler was disconnected, the websocket is closed
+				// once the overflow is sent so the client can resync
+				if err := ws.Write(data.ToWrapper(overflow)); err != nil {
+					s.Error(logAlias+"error while handling overflow: %s", err)
+				}
+				if overflow.Disconnected {
+					ws.Close()
+				}
+			}))
 		if err != nil {
 			err := errors.New("unable to create websocket")
 			if err := s.handleResponse(writer, err, nil); err != nil {
@@ -242,12 +386,22 @@ func (s *restServer) BuildRoutes() []rest.HandleFuncConfig {
 		{Route: data.RouteChangesWebsocket, HandleFx: s.endpointWebsocket()},
 		{Route: data.RouteChanges, Method: data.MethodChangeUpsert, HandleFx: s.endpointChangeUpsert()},
 		{Route: data.RouteChangesSearch, Method: data.MethodChangeRead, HandleFx: s.endpointChangesRead()},
+		{Route: data.RouteChangesPrune, Method: data.MethodPrune, HandleFx: s.endpointPrune()},
+		{Route: data.RouteChangesPrune, Method: data.MethodPruneReportRead, HandleFx: s.endpointPruneReportRead()},
+		{Route: data.RouteChangesMetrics, Method: data.MethodMetricsRead, HandleFx: s.endpointMetricsRead()},
 		{Route: data.RouteChangesParam, Method: data.MethodChangeRead, HandleFx: s.endpointChangeRead()},
 		{Route: data.RouteChangesParam, Method: data.MethodChangeDelete, HandleFx: s.endpointChangeDelete()},
 		{Route: data.RouteChangesRegistrationServiceIdAcknowledge, Method: data.MethodRegistrationChangeAcknowledge, HandleFx: s.endpointRegistrationChangeAcknowledge()},
 		{Route: data.RouteChangesRegistration, Method: data.MethodRegistrationUpsert, HandleFx: s.endpointRegistrationUpsert()},
 		{Route: data.RouteChangesRegistrationParamChanges, Method: data.MethodChangeRead, HandleFx: s.endpointRegistrationChangesRead()},
 		{Route: data.RouteChangesRegistrationParam, Method: data.MethodRegistrationDelete, HandleFx: s.endpointRegistrationDelete()},
+		{Route: data.RouteChangesRegistrationParamWebhook, Method: data.MethodWebhookRead, HandleFx: s.endpointWebhookRead()},
+		{Route: data.RouteChangesRegistrationParamWebhook, Method: data.MethodWebhookDelete, HandleFx: s.endpointWebhookDelete()},
+		{Route: data.RouteChangesRegistrationParamDeadLetters, Method: data.MethodDeadLettersRead, HandleFx: s.endpointDeadLettersRead()},
+		{Route: data.RouteChangesRegistrationParamReplay, Method: data.MethodReplayStart, HandleFx: s.endpointReplayStart()},
+		{Route: data.RouteChangesRegistrationParamReplay, Method: data.MethodReplayRead, HandleFx: s.endpointReplayRead()},
+		{Route: data.RouteChangesRegistrationParamReplay, Method: data.MethodReplayStop, HandleFx: s.endpointReplayStop()},
+		{Route: data.RouteChangesRegistrationParamReplayResume, Method: data.MethodReplayResume, HandleFx: s.endpointReplayResume()},
 	}
 }
 
@@ -29,7 +29,10 @@ import (
 	"github.com/stretchr/testify/assert"
 )
 
-var configServer = new(internal_server.Configuration)
+var (
+	configServer = new(internal_server.Configuration)
+	configLogic  = new(logic.Configuration)
+)
 
 type restServerTest struct {
 	server interface {
@@ -59,6 +62,12 @@ func init() {
 	configServer.Port = "9000"
 	configServer.AllowedMethods = []string{http.MethodDelete, http.MethodPatch, http.MethodPost, http.MethodPut, http.MethodGet}
 	configServer.ShutdownTimeout = 15 * time.Second
+	configLogic.Default()
+	configLogic.FromEnv(envs)
+	//KIM: the tests read registration changes more than once before
+	// acknowledging them, so changes aren't leased
+	configLogic.VisibilityTimeout = 0
+	configLogic.MaxDeliveryAttempts = 0
 }
 
 func newRestServerTest() *restServerTest {
@@ -72,6 +81,7 @@ func newRestServerTest() *restServerTest {
 	changesLogic := logic.New()
 	changesLogic.SetUtilities(logger)
 	changesLogic.SetParameters(changesMeta)
+	changesLogic.Configure(configLogic)
 	changesService := service.New()
 	changesService.SetUtilities(logger)
 	server := internal_server.New()
@@ -587,6 +597,75 @@ func (r *restServerTest) testChangeRegistration(t *testing.T) {
 	err = json.Unmarshal(bytes, changeDigest)
 	assert.Nil(t, err)
 	assert.Empty(t, changeDigest.Changes)
+
+	//read the registration dead letters
+	route = fmt.Sprintf(data.RouteChangesRegistrationParamDeadLettersf,
+		registrationId1)
+	bytes, statusCode, err = r.doRequest(route, data.MethodDeadLettersRead, nil)
+	assert.Nil(t, err)
+	assert.Equal(t, http.StatusOK, statusCode)
+	deadLetterDigest := &data.DeadLetterDigest{}
+	err = json.Unmarshal(bytes, deadLetterDigest)
+	assert.Nil(t, err)
+	assert.Empty(t, deadLetterDigest.DeadLetters)
+	route = fmt.Sprintf(data.RouteChangesRegistrationParamDeadLettersf,
+		r.generateId())
+	_, statusCode, err = r.doRequest(route, data.MethodDeadLettersRead, nil)
+	assert.Nil(t, err)
+	assert.Equal(t, http.StatusNotFound, statusCode)
+}
+
+func (r *restServerTest) testPrune(t *testing.T) {
+	//prune (the retention policy is disabled so nothing is removed)
+	bytes, statusCode, err := r.doRequest(data.RouteChangesPrune, data.MethodPrune, nil)
+	assert.Nil(t, err)
+	assert.Equal(t, http.StatusOK, statusCode)
+	report := &data.PruneReport{}
+	err = json.Unmarshal(bytes, report)
+	assert.Nil(t, err)
+	assert.NotZero(t, report.WhenPruned)
+	assert.Empty(t, report.ChangesExpired)
+	assert.Empty(t, report.ChangesPruned)
+
+	//read the report of the last prune
+	bytes, statusCode, err = r.doRequest(data.RouteChangesPrune, data.MethodPruneReportRead, nil)
+	assert.Nil(t, err)
+	assert.Equal(t, http.StatusOK, statusCode)
+	reportRead := &data.PruneReport{}
+	err = json.Unmarshal(bytes, reportRead)
+	assert.Nil(t, err)
+	assert.Equal(t, report, reportRead)
+}
+
+func (r *restServerTest) testMetrics(t *testing.T) {
+	//upsert registration
+	registrationId := r.generateId()
+	bytes, err := json.Marshal(&data.RequestRegister{
+		RegistrationId: registrationId,
+	})
+	assert.Nil(t, err)
+	_, statusCode, err := r.doRequest(data.RouteChangesRegistration, data.MethodRegistrationUpsert, bytes)
+	assert.Nil(t, err)
+	assert.Equal(t, http.StatusNoContent, statusCode)
+	defer func() {
+		r.doRequest(fmt.Sprintf(data.RouteChangesRegistrationParamf, registrationId), data.MethodRegistrationDelete, nil)
+	}()
+
+	//read metrics
+	bytes, statusCode, err = r.doRequest(data.RouteChangesMetrics, data.MethodMetricsRead, nil)
+	assert.Nil(t, err)
+	assert.Equal(t, http.StatusOK, statusCode)
+	metrics := &data.Metrics{}
+	err = json.Unmarshal(bytes, metrics)
+	assert.Nil(t, err)
+	var found bool
+	for _, registrationMetrics := range metrics.Registrations {
+		if registrationMetrics.RegistrationId == registrationId {
+			found = true
+			assert.Zero(t, registrationMetrics.Pending)
+		}
+	}
+	assert.True(t, found)
 }
 
 func TestChangesRestService(t *testing.T) {
@@ -598,4 +677,6 @@ func TestChangesRestService(t *testing.T) {
 	t.Run("Change Operations", r.testChangeOperations)
 	t.Run("Change Streaming", r.testChangeStreaming)
 	t.Run("Change Registration", r.testChangeRegistration)
+	t.Run("Prune", r.testPrune)
+	t.Run("Metrics", r.testMetrics)
 }
@@ -112,10 +112,16 @@ func (g *grpcClient) EmployeeRead(ctx context.Context, id string) (*data.Employe
 
 // EmployeeUpdate can be used to update the properties of a given employee
 func (g *grpcClient) EmployeeUpdate(ctx context.Context, id string, employeePartial data.EmployeePartial) (*data.Employee, error) {
-	response, err := g.EmployeesClient.EmployeeUpdate(ctx, &pb.EmployeeUpdateRequest{
+	request := &pb.EmployeeUpdateRequest{
 		Id:              id,
 		EmployeePartial: pb.FromEmployeePartial(&employeePartial),
-	})
+	}
+	if version := employeePartial.Version; version != nil {
+		request.VersionOneof = &pb.EmployeeUpdateRequest_Version{
+			Version: int32(*version),
+		}
+	}
+	response, err := g.EmployeesClient.EmployeeUpdate(ctx, request)
 	return pb.ToEmployee(response.GetEmployee()), err
 }
 
@@ -126,6 +132,13 @@ func (g *grpcClient) EmployeeDelete(ctx context.Context, id string) error {
 	return err
 }
 
+// EmployeeRestore can be used to restore a deleted employee (from
+// the trash)
+func (g *grpcClient) EmployeeRestore(ctx context.Context, id string) (*data.Employee, error) {
+	response, err := g.EmployeesClient.EmployeeRestore(ctx, &pb.EmployeeRestoreRequest{Id: id})
+	return pb.ToEmployee(response.GetEmployee()), err
+}
+
 // EmployeesRead can be used to read one or more employees, given a set of
 // search parameters
 func (g *grpcClient) EmployeesRead(ctx context.Context, search data.EmployeeSearch) ([]*data.Employee, error) {
@@ -134,3 +147,10 @@ func (g *grpcClient) EmployeesRead(ctx context.Context, search data.EmployeeSear
 	})
 	return pb.ToEmployees(response.GetEmployees()), err
 }
+
+// EmployeeHistory can be used to read every version of a given
+// employee (oldest first)
+func (g *grpcClient) EmployeeHistory(ctx context.Context, id string) ([]*data.Employee, error) {
+	response, err := g.EmployeesClient.EmployeeHistory(ctx, &pb.EmployeeHistoryRequest{Id: id})
+	return pb.ToEmployees(response.GetEmployees()), err
+}
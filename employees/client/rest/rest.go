@@ -166,6 +166,22 @@ func (r *restClient) EmployeeDelete(ctx context.Context, id string) error {
 	return nil
 }
 
+// EmployeeRestore can be used to restore a deleted employee (from
+// the trash)
+func (r *restClient) EmployeeRestore(ctx context.Context, id string) (*data.Employee, error) {
+	uri := fmt.Sprintf(urif, r.config.Address, r.config.Port,
+		fmt.Sprintf(data.RouteEmployeesIDRestoref, id))
+	bytes, err := r.doRequest(ctx, uri, http.MethodPut, nil)
+	if err != nil {
+		return nil, err
+	}
+	employee := &data.Employee{}
+	if err = json.Unmarshal(bytes, employee); err != nil {
+		return nil, err
+	}
+	return employee, nil
+}
+
 // EmployeesRead can be used to read one or more employees, given a set of
 // search parameters
 func (r *restClient) EmployeesRead(ctx context.Context, search data.EmployeeSearch) ([]*data.Employee, error) {
@@ -181,3 +197,19 @@ func (r *restClient) EmployeesRead(ctx context.Context, search data.EmployeeSear
 	}
 	return employees, nil
 }
+
+// EmployeeHistory can be used to read every version of a given
+// employee (oldest first)
+func (r *restClient) EmployeeHistory(ctx context.Context, id string) ([]*data.Employee, error) {
+	uri := fmt.Sprintf(urif, r.config.Address, r.config.Port,
+		fmt.Sprintf(data.RouteEmployeesIDHistoryf, id))
+	bytes, err := r.doRequest(ctx, uri, http.MethodGet, nil)
+	if err != nil {
+		return nil, err
+	}
+	var employees []*data.Employee
+	if err = json.Unmarshal(bytes, &employees); err != nil {
+		return nil, err
+	}
+	return employees, nil
+}
@@ -4,10 +4,14 @@ var ServiceName = "bludgeon_employees"
 
 // rest routes for employees
 const (
-	RouteEmployees       string = "/api/v1/employees"
-	RouteEmployeesSearch string = RouteEmployees + "/search"
-	RouteEmployeesID     string = RouteEmployees + "/{id}"
-	RouteEmployeesIDf    string = RouteEmployees + "/%s"
+	RouteEmployees           string = "/api/v1/employees"
+	RouteEmployeesSearch     string = RouteEmployees + "/search"
+	RouteEmployeesID         string = RouteEmployees + "/{id}"
+	RouteEmployeesIDf        string = RouteEmployees + "/%s"
+	RouteEmployeesIDHistory  string = RouteEmployeesID + "/history"
+	RouteEmployeesIDHistoryf string = RouteEmployeesIDf + "/history"
+	RouteEmployeesIDRestore  string = RouteEmployeesID + "/restore"
+	RouteEmployeesIDRestoref string = RouteEmployeesIDf + "/restore"
 )
 
 const PathID string = "id"
@@ -21,12 +25,15 @@ const (
 	ParameterLastNames      string = "last_names"
 	ParameterEmailAddress   string = "email_address"
 	ParameterEmailAddresses string = "email_addresses"
+	ParameterDeleted        string = "deleted"
 )
 
 // contracts for changes
 var (
-	ChangeTypeEmployee = "employee"
-	ChangeActionCreate = "create"
-	ChangeActionUpdate = "update"
-	ChangeActionDelete = "delete"
+	ChangeTypeEmployee  = "employee"
+	ChangeActionCreate  = "create"
+	ChangeActionUpdate  = "update"
+	ChangeActionDelete  = "delete"
+	ChangeActionRestore = "restore"
+	ChangeActionPurge   = "purge"
 )
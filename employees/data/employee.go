@@ -30,6 +30,10 @@ type Employee struct {
 	//An integer that's atomically incremented each time something is mutated
 	// example: 1
 	Version int `json:"version"`
+
+	//The time (unix nano) an employee was deleted (moved to the trash), zero if not deleted
+	// example: 1652417242000
+	DeletedAt int64 `json:"deleted_at"`
 }
 
 func (e *Employee) Type() string {
@@ -50,4 +54,9 @@ type EmployeePartial struct {
 	//The email address of an employee, this is optional, but can't conflict with existing employees
 	// example: Jane.Doe@foobar.duck
 	EmailAddress *string `json:"email_address,omitempty"`
+
+	//The expected (current) version of an employee, this is optional, but if provided
+	// the update will be rejected if the employee has since been modified
+	// example: 2
+	Version *int `json:"version,omitempty"`
 }
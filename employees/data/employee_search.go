@@ -2,6 +2,7 @@ package data
 
 import (
 	"fmt"
+	"strconv"
 	"strings"
 )
 
@@ -35,11 +36,18 @@ type EmployeeSearch struct {
 	//An array of one or more email addresses to search for
 	// in: query
 	EmailAddresses []string `json:"email_addresses,omitempty"`
+
+	//Set to true to search for deleted employees (the trash) only, deleted employees are omitted otherwise
+	// in: query
+	Deleted *bool `json:"deleted,omitempty"`
 }
 
 func (e *EmployeeSearch) ToParams() string {
 	//REVIEW: can we base64 encode the parameters?
-	const parameterf string = "%s=%s"
+	const (
+		parameterf     string = "%s=%s"
+		parameterBoolf string = "%s=%t"
+	)
 	var parameters []string
 	if len(e.IDs) > 0 {
 		parameters = append(parameters,
@@ -69,6 +77,10 @@ func (e *EmployeeSearch) ToParams() string {
 		parameters = append(parameters,
 			fmt.Sprintf(parameterf, ParameterEmailAddresses, strings.Join(e.EmailAddresses, ",")))
 	}
+	if deleted := e.Deleted; deleted != nil {
+		parameters = append(parameters,
+			fmt.Sprintf(parameterBoolf, ParameterDeleted, *deleted))
+	}
 	return "?" + strings.Join(parameters, "&")
 }
 
@@ -112,6 +124,11 @@ func (e *EmployeeSearch) FromParams(params map[string][]string) {
 					break
 				}
 			}
+		case ParameterDeleted:
+			if deleted, err := strconv.ParseBool(value[0]); err == nil {
+				e.Deleted = new(bool)
+				*e.Deleted = deleted
+			}
 		}
 	}
 }
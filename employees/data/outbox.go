@@ -0,0 +1,43 @@
+package data
+
+import "encoding/json"
+
+//OutboxChange describes a change that has been recorded alongside a mutation
+// but has yet to be delivered to the changes service
+type OutboxChange struct {
+	//The ID of the outbox change (v4 UUID)
+	// example: 86fa2f09-d260-11ec-bd5d-0242c0a8e002
+	ID string `json:"id"`
+
+	//The time the change occurred
+	// example: 1652417242000
+	WhenChanged int64 `json:"when_changed"`
+
+	//Identifies the someone that performed the change
+	// example: bludgeon_meta_memory
+	ChangedBy string `json:"changed_by"`
+
+	//The ID of the underlying data that has been changed (v4 UUID)
+	// example: 86fa2f09-d260-11ec-bd5d-0242c0a8e002
+	DataId string `json:"data_id"`
+
+	//A string that identifies the data type that was changed
+	// example: employee
+	DataType string `json:"data_type"`
+
+	//A string that identifies the action that has occured to the data
+	// example: create
+	DataAction string `json:"data_action"`
+
+	//The version of the data once changed
+	// example: 1
+	DataVersion int `json:"data_version"`
+
+	//The payload of the change (snapshot and diff) as json
+	// example: {"snapshot":{"id":"86fa2f09-d260-11ec-bd5d-0242c0a8e002"}}
+	Payload json.RawMessage `json:"payload,omitempty"`
+
+	//The time (unix nano) the change was added to the outbox
+	// example: 1652417242000
+	Enqueued int64 `json:"enqueued"`
+}
@@ -328,6 +328,12 @@ type EmployeeUpdateRequest struct {
 	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	// employee_partial
 	EmployeePartial *EmployeePartial `protobuf:"bytes,2,opt,name=employee_partial,json=employeePartial,proto3" json:"employee_partial,omitempty"`
+	// version_oneof
+	//
+	// Types that are assignable to VersionOneof:
+	//
+	//	*EmployeeUpdateRequest_Version
+	VersionOneof isEmployeeUpdateRequest_VersionOneof `protobuf_oneof:"version_oneof"`
 }
 
 func (x *EmployeeUpdateRequest) Reset() {
@@ -376,6 +382,31 @@ func (x *EmployeeUpdateRequest) GetEmployeePartial() *EmployeePartial {
 	return nil
 }
 
+func (m *EmployeeUpdateRequest) GetVersionOneof() isEmployeeUpdateRequest_VersionOneof {
+	if m != nil {
+		return m.VersionOneof
+	}
+	return nil
+}
+
+func (x *EmployeeUpdateRequest) GetVersion() int32 {
+	if x, ok := x.GetVersionOneof().(*EmployeeUpdateRequest_Version); ok {
+		return x.Version
+	}
+	return 0
+}
+
+type isEmployeeUpdateRequest_VersionOneof interface {
+	isEmployeeUpdateRequest_VersionOneof()
+}
+
+type EmployeeUpdateRequest_Version struct {
+	// version
+	Version int32 `protobuf:"varint,3,opt,name=version,proto3,oneof"`
+}
+
+func (*EmployeeUpdateRequest_Version) isEmployeeUpdateRequest_VersionOneof() {}
+
 // EmployeeUpdateResponse
 type EmployeeUpdateResponse struct {
 	state         protoimpl.MessageState
@@ -513,6 +544,202 @@ func (*EmployeeDeleteResponse) Descriptor() ([]byte, []int) {
 	return file_employees_proto_rawDescGZIP(), []int{9}
 }
 
+// EmployeeHistoryRequest
+type EmployeeHistoryRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// id
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *EmployeeHistoryRequest) Reset() {
+	*x = EmployeeHistoryRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_employees_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EmployeeHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EmployeeHistoryRequest) ProtoMessage() {}
+
+func (x *EmployeeHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_employees_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EmployeeHistoryRequest.ProtoReflect.Descriptor instead.
+func (*EmployeeHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_employees_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *EmployeeHistoryRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// EmployeeHistoryResponse
+type EmployeeHistoryResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// employees
+	Employees []*Employee `protobuf:"bytes,1,rep,name=employees,proto3" json:"employees,omitempty"`
+}
+
+func (x *EmployeeHistoryResponse) Reset() {
+	*x = EmployeeHistoryResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_employees_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EmployeeHistoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EmployeeHistoryResponse) ProtoMessage() {}
+
+func (x *EmployeeHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_employees_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EmployeeHistoryResponse.ProtoReflect.Descriptor instead.
+func (*EmployeeHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_employees_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *EmployeeHistoryResponse) GetEmployees() []*Employee {
+	if x != nil {
+		return x.Employees
+	}
+	return nil
+}
+
+// EmployeeRestoreRequest
+type EmployeeRestoreRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// id
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *EmployeeRestoreRequest) Reset() {
+	*x = EmployeeRestoreRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_employees_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EmployeeRestoreRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EmployeeRestoreRequest) ProtoMessage() {}
+
+func (x *EmployeeRestoreRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_employees_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EmployeeRestoreRequest.ProtoReflect.Descriptor instead.
+func (*EmployeeRestoreRequest) Descriptor() ([]byte, []int) {
+	return file_employees_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *EmployeeRestoreRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// EmployeeRestoreResponse
+type EmployeeRestoreResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// employee
+	Employee *Employee `protobuf:"bytes,1,opt,name=employee,proto3" json:"employee,omitempty"`
+}
+
+func (x *EmployeeRestoreResponse) Reset() {
+	*x = EmployeeRestoreResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_employees_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EmployeeRestoreResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EmployeeRestoreResponse) ProtoMessage() {}
+
+func (x *EmployeeRestoreResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_employees_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EmployeeRestoreResponse.ProtoReflect.Descriptor instead.
+func (*EmployeeRestoreResponse) Descriptor() ([]byte, []int) {
+	return file_employees_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *EmployeeRestoreResponse) GetEmployee() *Employee {
+	if x != nil {
+		return x.Employee
+	}
+	return nil
+}
+
 // EmployeePartial
 type EmployeePartial struct {
 	state         protoimpl.MessageState
@@ -542,7 +769,7 @@ type EmployeePartial struct {
 func (x *EmployeePartial) Reset() {
 	*x = EmployeePartial{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_employees_proto_msgTypes[10]
+		mi := &file_employees_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -555,7 +782,7 @@ func (x *EmployeePartial) String() string {
 func (*EmployeePartial) ProtoMessage() {}
 
 func (x *EmployeePartial) ProtoReflect() protoreflect.Message {
-	mi := &file_employees_proto_msgTypes[10]
+	mi := &file_employees_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -568,7 +795,7 @@ func (x *EmployeePartial) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EmployeePartial.ProtoReflect.Descriptor instead.
 func (*EmployeePartial) Descriptor() ([]byte, []int) {
-	return file_employees_proto_rawDescGZIP(), []int{10}
+	return file_employees_proto_rawDescGZIP(), []int{14}
 }
 
 func (m *EmployeePartial) GetFirstNameOneof() isEmployeePartial_FirstNameOneof {
@@ -666,12 +893,14 @@ type Employee struct {
 	LastUpdatedBy string `protobuf:"bytes,6,opt,name=last_updated_by,json=lastUpdatedBy,proto3" json:"last_updated_by,omitempty"`
 	// version
 	Version int32 `protobuf:"varint,7,opt,name=version,proto3" json:"version,omitempty"`
+	// deleted_at
+	DeletedAt int64 `protobuf:"varint,8,opt,name=deleted_at,json=deletedAt,proto3" json:"deleted_at,omitempty"`
 }
 
 func (x *Employee) Reset() {
 	*x = Employee{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_employees_proto_msgTypes[11]
+		mi := &file_employees_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -684,7 +913,7 @@ func (x *Employee) String() string {
 func (*Employee) ProtoMessage() {}
 
 func (x *Employee) ProtoReflect() protoreflect.Message {
-	mi := &file_employees_proto_msgTypes[11]
+	mi := &file_employees_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -697,7 +926,7 @@ func (x *Employee) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Employee.ProtoReflect.Descriptor instead.
 func (*Employee) Descriptor() ([]byte, []int) {
-	return file_employees_proto_rawDescGZIP(), []int{11}
+	return file_employees_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *Employee) GetId() string {
@@ -749,6 +978,13 @@ func (x *Employee) GetVersion() int32 {
 	return 0
 }
 
+func (x *Employee) GetDeletedAt() int64 {
+	if x != nil {
+		return x.DeletedAt
+	}
+	return 0
+}
+
 // EmployeeSearch
 type EmployeeSearch struct {
 	state         protoimpl.MessageState
@@ -781,12 +1017,18 @@ type EmployeeSearch struct {
 	EmailAddressOneof isEmployeeSearch_EmailAddressOneof `protobuf_oneof:"email_address_oneof"`
 	// email_addresses
 	EmailAddresses []string `protobuf:"bytes,7,rep,name=email_addresses,json=emailAddresses,proto3" json:"email_addresses,omitempty"`
+	// deleted_oneof
+	//
+	// Types that are assignable to DeletedOneof:
+	//
+	//	*EmployeeSearch_Deleted
+	DeletedOneof isEmployeeSearch_DeletedOneof `protobuf_oneof:"deleted_oneof"`
 }
 
 func (x *EmployeeSearch) Reset() {
 	*x = EmployeeSearch{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_employees_proto_msgTypes[12]
+		mi := &file_employees_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -799,7 +1041,7 @@ func (x *EmployeeSearch) String() string {
 func (*EmployeeSearch) ProtoMessage() {}
 
 func (x *EmployeeSearch) ProtoReflect() protoreflect.Message {
-	mi := &file_employees_proto_msgTypes[12]
+	mi := &file_employees_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -812,7 +1054,7 @@ func (x *EmployeeSearch) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EmployeeSearch.ProtoReflect.Descriptor instead.
 func (*EmployeeSearch) Descriptor() ([]byte, []int) {
-	return file_employees_proto_rawDescGZIP(), []int{12}
+	return file_employees_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *EmployeeSearch) GetIds() []string {
@@ -885,6 +1127,20 @@ func (x *EmployeeSearch) GetEmailAddresses() []string {
 	return nil
 }
 
+func (m *EmployeeSearch) GetDeletedOneof() isEmployeeSearch_DeletedOneof {
+	if m != nil {
+		return m.DeletedOneof
+	}
+	return nil
+}
+
+func (x *EmployeeSearch) GetDeleted() bool {
+	if x, ok := x.GetDeletedOneof().(*EmployeeSearch_Deleted); ok {
+		return x.Deleted
+	}
+	return false
+}
+
 type isEmployeeSearch_FirstNameOneof interface {
 	isEmployeeSearch_FirstNameOneof()
 }
@@ -918,6 +1174,17 @@ type EmployeeSearch_EmailAddress struct {
 
 func (*EmployeeSearch_EmailAddress) isEmployeeSearch_EmailAddressOneof() {}
 
+type isEmployeeSearch_DeletedOneof interface {
+	isEmployeeSearch_DeletedOneof()
+}
+
+type EmployeeSearch_Deleted struct {
+	// deleted
+	Deleted bool `protobuf:"varint,8,opt,name=deleted,proto3,oneof"`
+}
+
+func (*EmployeeSearch_Deleted) isEmployeeSearch_DeletedOneof() {}
+
 // Wrapper describes a basic data type for conversion of any
 // other data type
 type Wrapper struct {
@@ -934,7 +1201,7 @@ type Wrapper struct {
 func (x *Wrapper) Reset() {
 	*x = Wrapper{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_employees_proto_msgTypes[13]
+		mi := &file_employees_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -947,7 +1214,7 @@ func (x *Wrapper) String() string {
 func (*Wrapper) ProtoMessage() {}
 
 func (x *Wrapper) ProtoReflect() protoreflect.Message {
-	mi := &file_employees_proto_msgTypes[13]
+	mi := &file_employees_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -960,7 +1227,7 @@ func (x *Wrapper) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Wrapper.ProtoReflect.Descriptor instead.
 func (*Wrapper) Descriptor() ([]byte, []int) {
-	return file_employees_proto_rawDescGZIP(), []int{13}
+	return file_employees_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *Wrapper) GetType() string {
@@ -990,7 +1257,7 @@ type Bytes struct {
 func (x *Bytes) Reset() {
 	*x = Bytes{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_employees_proto_msgTypes[14]
+		mi := &file_employees_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1003,7 +1270,7 @@ func (x *Bytes) String() string {
 func (*Bytes) ProtoMessage() {}
 
 func (x *Bytes) ProtoReflect() protoreflect.Message {
-	mi := &file_employees_proto_msgTypes[14]
+	mi := &file_employees_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1016,7 +1283,7 @@ func (x *Bytes) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Bytes.ProtoReflect.Descriptor instead.
 func (*Bytes) Descriptor() ([]byte, []int) {
-	return file_employees_proto_rawDescGZIP(), []int{14}
+	return file_employees_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *Bytes) GetBytes() []byte {
@@ -1039,7 +1306,7 @@ type Error struct {
 func (x *Error) Reset() {
 	*x = Error{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_employees_proto_msgTypes[15]
+		mi := &file_employees_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1052,7 +1319,7 @@ func (x *Error) String() string {
 func (*Error) ProtoMessage() {}
 
 func (x *Error) ProtoReflect() protoreflect.Message {
-	mi := &file_employees_proto_msgTypes[15]
+	mi := &file_employees_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1065,7 +1332,7 @@ func (x *Error) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Error.ProtoReflect.Descriptor instead.
 func (*Error) Descriptor() ([]byte, []int) {
-	return file_employees_proto_rawDescGZIP(), []int{15}
+	return file_employees_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *Error) GetError() string {
@@ -1114,69 +1381,93 @@ var file_employees_proto_rawDesc = []byte{
 	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x67, 0x6f, 0x5f, 0x62, 0x6c, 0x75, 0x64,
 	0x67, 0x65, 0x6f, 0x6e, 0x5f, 0x65, 0x6d, 0x70, 0x6c, 0x6f, 0x79, 0x65, 0x65, 0x73, 0x2e, 0x45,
 	0x6d, 0x70, 0x6c, 0x6f, 0x79, 0x65, 0x65, 0x52, 0x09, 0x65, 0x6d, 0x70, 0x6c, 0x6f, 0x79, 0x65,
-	0x65, 0x73, 0x22, 0x7a, 0x0a, 0x15, 0x45, 0x6d, 0x70, 0x6c, 0x6f, 0x79, 0x65, 0x65, 0x55, 0x70,
-	0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x51, 0x0a, 0x10, 0x65,
-	0x6d, 0x70, 0x6c, 0x6f, 0x79, 0x65, 0x65, 0x5f, 0x70, 0x61, 0x72, 0x74, 0x69, 0x61, 0x6c, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x67, 0x6f, 0x5f, 0x62, 0x6c, 0x75, 0x64, 0x67,
-	0x65, 0x6f, 0x6e, 0x5f, 0x65, 0x6d, 0x70, 0x6c, 0x6f, 0x79, 0x65, 0x65, 0x73, 0x2e, 0x45, 0x6d,
-	0x70, 0x6c, 0x6f, 0x79, 0x65, 0x65, 0x50, 0x61, 0x72, 0x74, 0x69, 0x61, 0x6c, 0x52, 0x0f, 0x65,
-	0x6d, 0x70, 0x6c, 0x6f, 0x79, 0x65, 0x65, 0x50, 0x61, 0x72, 0x74, 0x69, 0x61, 0x6c, 0x22, 0x55,
-	0x0a, 0x16, 0x45, 0x6d, 0x70, 0x6c, 0x6f, 0x79, 0x65, 0x65, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3b, 0x0a, 0x08, 0x65, 0x6d, 0x70, 0x6c,
-	0x6f, 0x79, 0x65, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x67, 0x6f, 0x5f,
-	0x62, 0x6c, 0x75, 0x64, 0x67, 0x65, 0x6f, 0x6e, 0x5f, 0x65, 0x6d, 0x70, 0x6c, 0x6f, 0x79, 0x65,
-	0x65, 0x73, 0x2e, 0x45, 0x6d, 0x70, 0x6c, 0x6f, 0x79, 0x65, 0x65, 0x52, 0x08, 0x65, 0x6d, 0x70,
-	0x6c, 0x6f, 0x79, 0x65, 0x65, 0x22, 0x27, 0x0a, 0x15, 0x45, 0x6d, 0x70, 0x6c, 0x6f, 0x79, 0x65,
-	0x65, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e,
-	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x18,
-	0x0a, 0x16, 0x45, 0x6d, 0x70, 0x6c, 0x6f, 0x79, 0x65, 0x65, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xb6, 0x01, 0x0a, 0x0f, 0x45, 0x6d, 0x70,
-	0x6c, 0x6f, 0x79, 0x65, 0x65, 0x50, 0x61, 0x72, 0x74, 0x69, 0x61, 0x6c, 0x12, 0x1f, 0x0a, 0x0a,
-	0x66, 0x69, 0x72, 0x73, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x48, 0x00, 0x52, 0x09, 0x66, 0x69, 0x72, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1d, 0x0a,
-	0x09, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x65, 0x73, 0x22, 0xa7, 0x01, 0x0a, 0x15, 0x45, 0x6d, 0x70, 0x6c, 0x6f, 0x79, 0x65, 0x65, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x51, 0x0a, 0x10,
+	0x65, 0x6d, 0x70, 0x6c, 0x6f, 0x79, 0x65, 0x65, 0x5f, 0x70, 0x61, 0x72, 0x74, 0x69, 0x61, 0x6c,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x67, 0x6f, 0x5f, 0x62, 0x6c, 0x75, 0x64,
+	0x67, 0x65, 0x6f, 0x6e, 0x5f, 0x65, 0x6d, 0x70, 0x6c, 0x6f, 0x79, 0x65, 0x65, 0x73, 0x2e, 0x45,
+	0x6d, 0x70, 0x6c, 0x6f, 0x79, 0x65, 0x65, 0x50, 0x61, 0x72, 0x74, 0x69, 0x61, 0x6c, 0x52, 0x0f,
+	0x65, 0x6d, 0x70, 0x6c, 0x6f, 0x79, 0x65, 0x65, 0x50, 0x61, 0x72, 0x74, 0x69, 0x61, 0x6c, 0x12,
+	0x1a, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05,
+	0x48, 0x00, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x42, 0x0f, 0x0a, 0x0d, 0x76,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x6f, 0x6e, 0x65, 0x6f, 0x66, 0x22, 0x55, 0x0a, 0x16,
+	0x45, 0x6d, 0x70, 0x6c, 0x6f, 0x79, 0x65, 0x65, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3b, 0x0a, 0x08, 0x65, 0x6d, 0x70, 0x6c, 0x6f, 0x79,
+	0x65, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x67, 0x6f, 0x5f, 0x62, 0x6c,
+	0x75, 0x64, 0x67, 0x65, 0x6f, 0x6e, 0x5f, 0x65, 0x6d, 0x70, 0x6c, 0x6f, 0x79, 0x65, 0x65, 0x73,
+	0x2e, 0x45, 0x6d, 0x70, 0x6c, 0x6f, 0x79, 0x65, 0x65, 0x52, 0x08, 0x65, 0x6d, 0x70, 0x6c, 0x6f,
+	0x79, 0x65, 0x65, 0x22, 0x27, 0x0a, 0x15, 0x45, 0x6d, 0x70, 0x6c, 0x6f, 0x79, 0x65, 0x65, 0x44,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x18, 0x0a, 0x16,
+	0x45, 0x6d, 0x70, 0x6c, 0x6f, 0x79, 0x65, 0x65, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x28, 0x0a, 0x16, 0x45, 0x6d, 0x70, 0x6c, 0x6f, 0x79,
+	0x65, 0x65, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64,
+	0x22, 0x58, 0x0a, 0x17, 0x45, 0x6d, 0x70, 0x6c, 0x6f, 0x79, 0x65, 0x65, 0x48, 0x69, 0x73, 0x74,
+	0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3d, 0x0a, 0x09, 0x65,
+	0x6d, 0x70, 0x6c, 0x6f, 0x79, 0x65, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f,
+	0x2e, 0x67, 0x6f, 0x5f, 0x62, 0x6c, 0x75, 0x64, 0x67, 0x65, 0x6f, 0x6e, 0x5f, 0x65, 0x6d, 0x70,
+	0x6c, 0x6f, 0x79, 0x65, 0x65, 0x73, 0x2e, 0x45, 0x6d, 0x70, 0x6c, 0x6f, 0x79, 0x65, 0x65, 0x52,
+	0x09, 0x65, 0x6d, 0x70, 0x6c, 0x6f, 0x79, 0x65, 0x65, 0x73, 0x22, 0x28, 0x0a, 0x16, 0x45, 0x6d,
+	0x70, 0x6c, 0x6f, 0x79, 0x65, 0x65, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x02, 0x69, 0x64, 0x22, 0x56, 0x0a, 0x17, 0x45, 0x6d, 0x70, 0x6c, 0x6f, 0x79, 0x65, 0x65,
+	0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x3b, 0x0a, 0x08, 0x65, 0x6d, 0x70, 0x6c, 0x6f, 0x79, 0x65, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1f, 0x2e, 0x67, 0x6f, 0x5f, 0x62, 0x6c, 0x75, 0x64, 0x67, 0x65, 0x6f, 0x6e, 0x5f,
+	0x65, 0x6d, 0x70, 0x6c, 0x6f, 0x79, 0x65, 0x65, 0x73, 0x2e, 0x45, 0x6d, 0x70, 0x6c, 0x6f, 0x79,
+	0x65, 0x65, 0x52, 0x08, 0x65, 0x6d, 0x70, 0x6c, 0x6f, 0x79, 0x65, 0x65, 0x22, 0xb6, 0x01, 0x0a,
+	0x0f, 0x45, 0x6d, 0x70, 0x6c, 0x6f, 0x79, 0x65, 0x65, 0x50, 0x61, 0x72, 0x74, 0x69, 0x61, 0x6c,
+	0x12, 0x1f, 0x0a, 0x0a, 0x66, 0x69, 0x72, 0x73, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x09, 0x66, 0x69, 0x72, 0x73, 0x74, 0x4e, 0x61, 0x6d,
+	0x65, 0x12, 0x1d, 0x0a, 0x09, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x08, 0x6c, 0x61, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65,
+	0x12, 0x25, 0x0a, 0x0d, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x02, 0x52, 0x0c, 0x65, 0x6d, 0x61, 0x69, 0x6c,
+	0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x42, 0x12, 0x0a, 0x10, 0x66, 0x69, 0x72, 0x73, 0x74,
+	0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x5f, 0x6f, 0x6e, 0x65, 0x6f, 0x66, 0x42, 0x11, 0x0a, 0x0f, 0x6c,
+	0x61, 0x73, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x5f, 0x6f, 0x6e, 0x65, 0x6f, 0x66, 0x42, 0x15,
+	0x0a, 0x13, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x5f,
+	0x6f, 0x6e, 0x65, 0x6f, 0x66, 0x22, 0xff, 0x01, 0x0a, 0x08, 0x45, 0x6d, 0x70, 0x6c, 0x6f, 0x79,
+	0x65, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02,
+	0x69, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x66, 0x69, 0x72, 0x73, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x66, 0x69, 0x72, 0x73, 0x74, 0x4e, 0x61, 0x6d,
+	0x65, 0x12, 0x1b, 0x0a, 0x09, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6c, 0x61, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x23,
+	0x0a, 0x0d, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x41, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x75, 0x64, 0x70, 0x61,
+	0x74, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x6c, 0x61, 0x73, 0x74, 0x55,
+	0x64, 0x70, 0x61, 0x74, 0x65, 0x64, 0x12, 0x26, 0x0a, 0x0f, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x75,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0d, 0x6c, 0x61, 0x73, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x42, 0x79, 0x12, 0x18,
+	0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x64, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x64, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0xdd, 0x02, 0x0a, 0x0e, 0x45, 0x6d, 0x70, 0x6c,
+	0x6f, 0x79, 0x65, 0x65, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x12, 0x10, 0x0a, 0x03, 0x69, 0x64,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x03, 0x69, 0x64, 0x73, 0x12, 0x1f, 0x0a, 0x0b,
+	0x66, 0x69, 0x72, 0x73, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x0a, 0x66, 0x69, 0x72, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x12, 0x1f, 0x0a,
+	0x0a, 0x66, 0x69, 0x72, 0x73, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x48, 0x00, 0x52, 0x09, 0x66, 0x69, 0x72, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1d,
+	0x0a, 0x0a, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x09, 0x6c, 0x61, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x12, 0x1d, 0x0a,
+	0x09, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
 	0x48, 0x01, 0x52, 0x08, 0x6c, 0x61, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x25, 0x0a, 0x0d,
-	0x65, 0x6d, 0x61, 0x69, 0x6c, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20,
+	0x65, 0x6d, 0x61, 0x69, 0x6c, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x06, 0x20,
 	0x01, 0x28, 0x09, 0x48, 0x02, 0x52, 0x0c, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x41, 0x64, 0x64, 0x72,
-	0x65, 0x73, 0x73, 0x42, 0x12, 0x0a, 0x10, 0x66, 0x69, 0x72, 0x73, 0x74, 0x5f, 0x6e, 0x61, 0x6d,
-	0x65, 0x5f, 0x6f, 0x6e, 0x65, 0x6f, 0x66, 0x42, 0x11, 0x0a, 0x0f, 0x6c, 0x61, 0x73, 0x74, 0x5f,
-	0x6e, 0x61, 0x6d, 0x65, 0x5f, 0x6f, 0x6e, 0x65, 0x6f, 0x66, 0x42, 0x15, 0x0a, 0x13, 0x65, 0x6d,
-	0x61, 0x69, 0x6c, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x5f, 0x6f, 0x6e, 0x65, 0x6f,
-	0x66, 0x22, 0xe0, 0x01, 0x0a, 0x08, 0x45, 0x6d, 0x70, 0x6c, 0x6f, 0x79, 0x65, 0x65, 0x12, 0x0e,
-	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1d,
-	0x0a, 0x0a, 0x66, 0x69, 0x72, 0x73, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x09, 0x66, 0x69, 0x72, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1b, 0x0a,
-	0x09, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x08, 0x6c, 0x61, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x65, 0x6d,
-	0x61, 0x69, 0x6c, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0c, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12,
-	0x21, 0x0a, 0x0c, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x75, 0x64, 0x70, 0x61, 0x74, 0x65, 0x64, 0x18,
-	0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x6c, 0x61, 0x73, 0x74, 0x55, 0x64, 0x70, 0x61, 0x74,
-	0x65, 0x64, 0x12, 0x26, 0x0a, 0x0f, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74,
-	0x65, 0x64, 0x5f, 0x62, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6c, 0x61, 0x73,
-	0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x42, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65,
-	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x76, 0x65, 0x72,
-	0x73, 0x69, 0x6f, 0x6e, 0x22, 0xb0, 0x02, 0x0a, 0x0e, 0x45, 0x6d, 0x70, 0x6c, 0x6f, 0x79, 0x65,
-	0x65, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x12, 0x10, 0x0a, 0x03, 0x69, 0x64, 0x73, 0x18, 0x01,
-	0x20, 0x03, 0x28, 0x09, 0x52, 0x03, 0x69, 0x64, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x66, 0x69, 0x72,
-	0x73, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a,
-	0x66, 0x69, 0x72, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x12, 0x1f, 0x0a, 0x0a, 0x66, 0x69,
-	0x72, 0x73, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00,
-	0x52, 0x09, 0x66, 0x69, 0x72, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x6c,
-	0x61, 0x73, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52,
-	0x09, 0x6c, 0x61, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x12, 0x1d, 0x0a, 0x09, 0x6c, 0x61,
-	0x73, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52,
-	0x08, 0x6c, 0x61, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x25, 0x0a, 0x0d, 0x65, 0x6d, 0x61,
-	0x69, 0x6c, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09,
-	0x48, 0x02, 0x52, 0x0c, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
-	0x12, 0x27, 0x0a, 0x0f, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
-	0x73, 0x65, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0e, 0x65, 0x6d, 0x61, 0x69, 0x6c,
-	0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x42, 0x12, 0x0a, 0x10, 0x66, 0x69, 0x72,
-	0x73, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x5f, 0x6f, 0x6e, 0x65, 0x6f, 0x66, 0x42, 0x11, 0x0a,
-	0x0f, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x5f, 0x6f, 0x6e, 0x65, 0x6f, 0x66,
-	0x42, 0x15, 0x0a, 0x13, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
-	0x73, 0x5f, 0x6f, 0x6e, 0x65, 0x6f, 0x66, 0x22, 0x4d, 0x0a, 0x07, 0x57, 0x72, 0x61, 0x70, 0x70,
+	0x65, 0x73, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x5f, 0x61, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0e, 0x65, 0x6d,
+	0x61, 0x69, 0x6c, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x12, 0x1a, 0x0a, 0x07,
+	0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x48, 0x03, 0x52,
+	0x07, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x42, 0x12, 0x0a, 0x10, 0x66, 0x69, 0x72, 0x73,
+	0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x5f, 0x6f, 0x6e, 0x65, 0x6f, 0x66, 0x42, 0x11, 0x0a, 0x0f,
+	0x6c, 0x61, 0x73, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x5f, 0x6f, 0x6e, 0x65, 0x6f, 0x66, 0x42,
+	0x15, 0x0a, 0x13, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x5f, 0x6f, 0x6e, 0x65, 0x6f, 0x66, 0x42, 0x0f, 0x0a, 0x0d, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x64, 0x5f, 0x6f, 0x6e, 0x65, 0x6f, 0x66, 0x22, 0x4d, 0x0a, 0x07, 0x57, 0x72, 0x61, 0x70, 0x70,
 	0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
 	0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x2e, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61,
 	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
@@ -1185,7 +1476,7 @@ var file_employees_proto_rawDesc = []byte{
 	0x14, 0x0a, 0x05, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05,
 	0x62, 0x79, 0x74, 0x65, 0x73, 0x22, 0x1d, 0x0a, 0x05, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x14,
 	0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65,
-	0x72, 0x72, 0x6f, 0x72, 0x32, 0xbc, 0x04, 0x0a, 0x09, 0x45, 0x6d, 0x70, 0x6c, 0x6f, 0x79, 0x65,
+	0x72, 0x72, 0x6f, 0x72, 0x32, 0xa6, 0x06, 0x0a, 0x09, 0x45, 0x6d, 0x70, 0x6c, 0x6f, 0x79, 0x65,
 	0x65, 0x73, 0x12, 0x70, 0x0a, 0x0f, 0x65, 0x6d, 0x70, 0x6c, 0x6f, 0x79, 0x65, 0x65, 0x5f, 0x63,
 	0x72, 0x65, 0x61, 0x74, 0x65, 0x12, 0x2c, 0x2e, 0x67, 0x6f, 0x5f, 0x62, 0x6c, 0x75, 0x64, 0x67,
 	0x65, 0x6f, 0x6e, 0x5f, 0x65, 0x6d, 0x70, 0x6c, 0x6f, 0x79, 0x65, 0x65, 0x73, 0x2e, 0x45, 0x6d,
@@ -1221,11 +1512,26 @@ var file_employees_proto_rawDesc = []byte{
 	0x73, 0x74, 0x1a, 0x2d, 0x2e, 0x67, 0x6f, 0x5f, 0x62, 0x6c, 0x75, 0x64, 0x67, 0x65, 0x6f, 0x6e,
 	0x5f, 0x65, 0x6d, 0x70, 0x6c, 0x6f, 0x79, 0x65, 0x65, 0x73, 0x2e, 0x45, 0x6d, 0x70, 0x6c, 0x6f,
 	0x79, 0x65, 0x65, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x00, 0x42, 0x3c, 0x5a, 0x3a, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f,
-	0x6d, 0x2f, 0x61, 0x6e, 0x74, 0x6f, 0x6e, 0x69, 0x6f, 0x2d, 0x61, 0x6c, 0x65, 0x78, 0x61, 0x6e,
-	0x64, 0x65, 0x72, 0x2f, 0x67, 0x6f, 0x2d, 0x62, 0x6c, 0x75, 0x64, 0x67, 0x65, 0x6f, 0x6e, 0x2f,
-	0x65, 0x6d, 0x70, 0x6c, 0x6f, 0x79, 0x65, 0x65, 0x73, 0x2f, 0x64, 0x61, 0x74, 0x61, 0x2f, 0x70,
-	0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x65, 0x22, 0x00, 0x12, 0x73, 0x0a, 0x10, 0x65, 0x6d, 0x70, 0x6c, 0x6f, 0x79, 0x65, 0x65, 0x5f,
+	0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x2d, 0x2e, 0x67, 0x6f, 0x5f, 0x62, 0x6c, 0x75,
+	0x64, 0x67, 0x65, 0x6f, 0x6e, 0x5f, 0x65, 0x6d, 0x70, 0x6c, 0x6f, 0x79, 0x65, 0x65, 0x73, 0x2e,
+	0x45, 0x6d, 0x70, 0x6c, 0x6f, 0x79, 0x65, 0x65, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2e, 0x2e, 0x67, 0x6f, 0x5f, 0x62, 0x6c, 0x75, 0x64,
+	0x67, 0x65, 0x6f, 0x6e, 0x5f, 0x65, 0x6d, 0x70, 0x6c, 0x6f, 0x79, 0x65, 0x65, 0x73, 0x2e, 0x45,
+	0x6d, 0x70, 0x6c, 0x6f, 0x79, 0x65, 0x65, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x73, 0x0a, 0x10, 0x65, 0x6d, 0x70, 0x6c,
+	0x6f, 0x79, 0x65, 0x65, 0x5f, 0x72, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x12, 0x2d, 0x2e, 0x67,
+	0x6f, 0x5f, 0x62, 0x6c, 0x75, 0x64, 0x67, 0x65, 0x6f, 0x6e, 0x5f, 0x65, 0x6d, 0x70, 0x6c, 0x6f,
+	0x79, 0x65, 0x65, 0x73, 0x2e, 0x45, 0x6d, 0x70, 0x6c, 0x6f, 0x79, 0x65, 0x65, 0x52, 0x65, 0x73,
+	0x74, 0x6f, 0x72, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2e, 0x2e, 0x67, 0x6f,
+	0x5f, 0x62, 0x6c, 0x75, 0x64, 0x67, 0x65, 0x6f, 0x6e, 0x5f, 0x65, 0x6d, 0x70, 0x6c, 0x6f, 0x79,
+	0x65, 0x65, 0x73, 0x2e, 0x45, 0x6d, 0x70, 0x6c, 0x6f, 0x79, 0x65, 0x65, 0x52, 0x65, 0x73, 0x74,
+	0x6f, 0x72, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x42, 0x3c, 0x5a,
+	0x3a, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x6e, 0x74, 0x6f,
+	0x6e, 0x69, 0x6f, 0x2d, 0x61, 0x6c, 0x65, 0x78, 0x61, 0x6e, 0x64, 0x65, 0x72, 0x2f, 0x67, 0x6f,
+	0x2d, 0x62, 0x6c, 0x75, 0x64, 0x67, 0x65, 0x6f, 0x6e, 0x2f, 0x65, 0x6d, 0x70, 0x6c, 0x6f, 0x79,
+	0x65, 0x65, 0x73, 0x2f, 0x64, 0x61, 0x74, 0x61, 0x2f, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1240,50 +1546,60 @@ func file_employees_proto_rawDescGZIP() []byte {
 	return file_employees_proto_rawDescData
 }
 
-var file_employees_proto_msgTypes = make([]protoimpl.MessageInfo, 16)
+var file_employees_proto_msgTypes = make([]protoimpl.MessageInfo, 20)
 var file_employees_proto_goTypes = []interface{}{
-	(*EmployeeCreateRequest)(nil),  // 0: go_bludgeon_employees.EmployeeCreateRequest
-	(*EmployeeCreateResponse)(nil), // 1: go_bludgeon_employees.EmployeeCreateResponse
-	(*EmployeeReadRequest)(nil),    // 2: go_bludgeon_employees.EmployeeReadRequest
-	(*EmployeeReadResponse)(nil),   // 3: go_bludgeon_employees.EmployeeReadResponse
-	(*EmployeesReadRequest)(nil),   // 4: go_bludgeon_employees.EmployeesReadRequest
-	(*EmployeesReadResponse)(nil),  // 5: go_bludgeon_employees.EmployeesReadResponse
-	(*EmployeeUpdateRequest)(nil),  // 6: go_bludgeon_employees.EmployeeUpdateRequest
-	(*EmployeeUpdateResponse)(nil), // 7: go_bludgeon_employees.EmployeeUpdateResponse
-	(*EmployeeDeleteRequest)(nil),  // 8: go_bludgeon_employees.EmployeeDeleteRequest
-	(*EmployeeDeleteResponse)(nil), // 9: go_bludgeon_employees.EmployeeDeleteResponse
-	(*EmployeePartial)(nil),        // 10: go_bludgeon_employees.EmployeePartial
-	(*Employee)(nil),               // 11: go_bludgeon_employees.Employee
-	(*EmployeeSearch)(nil),         // 12: go_bludgeon_employees.EmployeeSearch
-	(*Wrapper)(nil),                // 13: go_bludgeon_employees.Wrapper
-	(*Bytes)(nil),                  // 14: go_bludgeon_employees.Bytes
-	(*Error)(nil),                  // 15: go_bludgeon_employees.Error
-	(*anypb.Any)(nil),              // 16: google.protobuf.Any
+	(*EmployeeCreateRequest)(nil),   // 0: go_bludgeon_employees.EmployeeCreateRequest
+	(*EmployeeCreateResponse)(nil),  // 1: go_bludgeon_employees.EmployeeCreateResponse
+	(*EmployeeReadRequest)(nil),     // 2: go_bludgeon_employees.EmployeeReadRequest
+	(*EmployeeReadResponse)(nil),    // 3: go_bludgeon_employees.EmployeeReadResponse
+	(*EmployeesReadRequest)(nil),    // 4: go_bludgeon_employees.EmployeesReadRequest
+	(*EmployeesReadResponse)(nil),   // 5: go_bludgeon_employees.EmployeesReadResponse
+	(*EmployeeUpdateRequest)(nil),   // 6: go_bludgeon_employees.EmployeeUpdateRequest
+	(*EmployeeUpdateResponse)(nil),  // 7: go_bludgeon_employees.EmployeeUpdateResponse
+	(*EmployeeDeleteRequest)(nil),   // 8: go_bludgeon_employees.EmployeeDeleteRequest
+	(*EmployeeDeleteResponse)(nil),  // 9: go_bludgeon_employees.EmployeeDeleteResponse
+	(*EmployeeHistoryRequest)(nil),  // 10: go_bludgeon_employees.EmployeeHistoryRequest
+	(*EmployeeHistoryResponse)(nil), // 11: go_bludgeon_employees.EmployeeHistoryResponse
+	(*EmployeeRestoreRequest)(nil),  // 12: go_bludgeon_employees.EmployeeRestoreRequest
+	(*EmployeeRestoreResponse)(nil), // 13: go_bludgeon_employees.EmployeeRestoreResponse
+	(*EmployeePartial)(nil),         // 14: go_bludgeon_employees.EmployeePartial
+	(*Employee)(nil),                // 15: go_bludgeon_employees.Employee
+	(*EmployeeSearch)(nil),          // 16: go_bludgeon_employees.EmployeeSearch
+	(*Wrapper)(nil),                 // 17: go_bludgeon_employees.Wrapper
+	(*Bytes)(nil),                   // 18: go_bludgeon_employees.Bytes
+	(*Error)(nil),                   // 19: go_bludgeon_employees.Error
+	(*anypb.Any)(nil),               // 20: google.protobuf.Any
 }
 var file_employees_proto_depIdxs = []int32{
-	10, // 0: go_bludgeon_employees.EmployeeCreateRequest.employee_partial:type_name -> go_bludgeon_employees.EmployeePartial
-	11, // 1: go_bludgeon_employees.EmployeeCreateResponse.employee:type_name -> go_bludgeon_employees.Employee
-	11, // 2: go_bludgeon_employees.EmployeeReadResponse.employee:type_name -> go_bludgeon_employees.Employee
-	12, // 3: go_bludgeon_employees.EmployeesReadRequest.employee_search:type_name -> go_bludgeon_employees.EmployeeSearch
-	11, // 4: go_bludgeon_employees.EmployeesReadResponse.employees:type_name -> go_bludgeon_employees.Employee
-	10, // 5: go_bludgeon_employees.EmployeeUpdateRequest.employee_partial:type_name -> go_bludgeon_employees.EmployeePartial
-	11, // 6: go_bludgeon_employees.EmployeeUpdateResponse.employee:type_name -> go_bludgeon_employees.Employee
-	16, // 7: go_bludgeon_employees.Wrapper.payload:type_name -> google.protobuf.Any
-	0,  // 8: go_bludgeon_employees.Employees.employee_create:input_type -> go_bludgeon_employees.EmployeeCreateRequest
-	2,  // 9: go_bludgeon_employees.Employees.employee_read:input_type -> go_bludgeon_employees.EmployeeReadRequest
-	4,  // 10: go_bludgeon_employees.Employees.employees_read:input_type -> go_bludgeon_employees.EmployeesReadRequest
-	6,  // 11: go_bludgeon_employees.Employees.employee_update:input_type -> go_bludgeon_employees.EmployeeUpdateRequest
-	8,  // 12: go_bludgeon_employees.Employees.employee_delete:input_type -> go_bludgeon_employees.EmployeeDeleteRequest
-	1,  // 13: go_bludgeon_employees.Employees.employee_create:output_type -> go_bludgeon_employees.EmployeeCreateResponse
-	3,  // 14: go_bludgeon_employees.Employees.employee_read:output_type -> go_bludgeon_employees.EmployeeReadResponse
-	5,  // 15: go_bludgeon_employees.Employees.employees_read:output_type -> go_bludgeon_employees.EmployeesReadResponse
-	7,  // 16: go_bludgeon_employees.Employees.employee_update:output_type -> go_bludgeon_employees.EmployeeUpdateResponse
-	9,  // 17: go_bludgeon_employees.Employees.employee_delete:output_type -> go_bludgeon_employees.EmployeeDeleteResponse
-	13, // [13:18] is the sub-list for method output_type
-	8,  // [8:13] is the sub-list for method input_type
-	8,  // [8:8] is the sub-list for extension type_name
-	8,  // [8:8] is the sub-list for extension extendee
-	0,  // [0:8] is the sub-list for field type_name
+	14, // 0: go_bludgeon_employees.EmployeeCreateRequest.employee_partial:type_name -> go_bludgeon_employees.EmployeePartial
+	15, // 1: go_bludgeon_employees.EmployeeCreateResponse.employee:type_name -> go_bludgeon_employees.Employee
+	15, // 2: go_bludgeon_employees.EmployeeReadResponse.employee:type_name -> go_bludgeon_employees.Employee
+	16, // 3: go_bludgeon_employees.EmployeesReadRequest.employee_search:type_name -> go_bludgeon_employees.EmployeeSearch
+	15, // 4: go_bludgeon_employees.EmployeesReadResponse.employees:type_name -> go_bludgeon_employees.Employee
+	14, // 5: go_bludgeon_employees.EmployeeUpdateRequest.employee_partial:type_name -> go_bludgeon_employees.EmployeePartial
+	15, // 6: go_bludgeon_employees.EmployeeUpdateResponse.employee:type_name -> go_bludgeon_employees.Employee
+	15, // 7: go_bludgeon_employees.EmployeeHistoryResponse.employees:type_name -> go_bludgeon_employees.Employee
+	15, // 8: go_bludgeon_employees.EmployeeRestoreResponse.employee:type_name -> go_bludgeon_employees.Employee
+	20, // 9: go_bludgeon_employees.Wrapper.payload:type_name -> google.protobuf.Any
+	0,  // 10: go_bludgeon_employees.Employees.employee_create:input_type -> go_bludgeon_employees.EmployeeCreateRequest
+	2,  // 11: go_bludgeon_employees.Employees.employee_read:input_type -> go_bludgeon_employees.EmployeeReadRequest
+	4,  // 12: go_bludgeon_employees.Employees.employees_read:input_type -> go_bludgeon_employees.EmployeesReadRequest
+	6,  // 13: go_bludgeon_employees.Employees.employee_update:input_type -> go_bludgeon_employees.EmployeeUpdateRequest
+	8,  // 14: go_bludgeon_employees.Employees.employee_delete:input_type -> go_bludgeon_employees.EmployeeDeleteRequest
+	10, // 15: go_bludgeon_employees.Employees.employee_history:input_type -> go_bludgeon_employees.EmployeeHistoryRequest
+	12, // 16: go_bludgeon_employees.Employees.employee_restore:input_type -> go_bludgeon_employees.EmployeeRestoreRequest
+	1,  // 17: go_bludgeon_employees.Employees.employee_create:output_type -> go_bludgeon_employees.EmployeeCreateResponse
+	3,  // 18: go_bludgeon_employees.Employees.employee_read:output_type -> go_bludgeon_employees.EmployeeReadResponse
+	5,  // 19: go_bludgeon_employees.Employees.employees_read:output_type -> go_bludgeon_employees.EmployeesReadResponse
+	7,  // 20: go_bludgeon_employees.Employees.employee_update:output_type -> go_bludgeon_employees.EmployeeUpdateResponse
+	9,  // 21: go_bludgeon_employees.Employees.employee_delete:output_type -> go_bludgeon_employees.EmployeeDeleteResponse
+	11, // 22: go_bludgeon_employees.Employees.employee_history:output_type -> go_bludgeon_employees.EmployeeHistoryResponse
+	13, // 23: go_bludgeon_employees.Employees.employee_restore:output_type -> go_bludgeon_employees.EmployeeRestoreResponse
+	17, // [17:24] is the sub-list for method output_type
+	10, // [10:17] is the sub-list for method input_type
+	10, // [10:10] is the sub-list for extension type_name
+	10, // [10:10] is the sub-list for extension extendee
+	0,  // [0:10] is the sub-list for field type_name
 }
 
 func init() { file_employees_proto_init() }
@@ -1413,7 +1729,7 @@ func file_employees_proto_init() {
 			}
 		}
 		file_employees_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EmployeePartial); i {
+			switch v := v.(*EmployeeHistoryRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1425,7 +1741,7 @@ func file_employees_proto_init() {
 			}
 		}
 		file_employees_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Employee); i {
+			switch v := v.(*EmployeeHistoryResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1437,7 +1753,7 @@ func file_employees_proto_init() {
 			}
 		}
 		file_employees_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EmployeeSearch); i {
+			switch v := v.(*EmployeeRestoreRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1449,7 +1765,7 @@ func file_employees_proto_init() {
 			}
 		}
 		file_employees_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Wrapper); i {
+			switch v := v.(*EmployeeRestoreResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1461,7 +1777,7 @@ func file_employees_proto_init() {
 			}
 		}
 		file_employees_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Bytes); i {
+			switch v := v.(*EmployeePartial); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1473,6 +1789,54 @@ func file_employees_proto_init() {
 			}
 		}
 		file_employees_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Employee); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_employees_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EmployeeSearch); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_employees_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Wrapper); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_employees_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Bytes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_employees_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Error); i {
 			case 0:
 				return &v.state
@@ -1485,15 +1849,19 @@ func file_employees_proto_init() {
 			}
 		}
 	}
-	file_employees_proto_msgTypes[10].OneofWrappers = []interface{}{
+	file_employees_proto_msgTypes[6].OneofWrappers = []interface{}{
+		(*EmployeeUpdateRequest_Version)(nil),
+	}
+	file_employees_proto_msgTypes[14].OneofWrappers = []interface{}{
 		(*EmployeePartial_FirstName)(nil),
 		(*EmployeePartial_LastName)(nil),
 		(*EmployeePartial_EmailAddress)(nil),
 	}
-	file_employees_proto_msgTypes[12].OneofWrappers = []interface{}{
+	file_employees_proto_msgTypes[16].OneofWrappers = []interface{}{
 		(*EmployeeSearch_FirstName)(nil),
 		(*EmployeeSearch_LastName)(nil),
 		(*EmployeeSearch_EmailAddress)(nil),
+		(*EmployeeSearch_Deleted)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -1501,7 +1869,7 @@ func file_employees_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_employees_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   16,
+			NumMessages:   20,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
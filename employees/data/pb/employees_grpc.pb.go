@@ -32,6 +32,10 @@ type EmployeesClient interface {
 	EmployeeUpdate(ctx context.Context, in *EmployeeUpdateRequest, opts ...grpc.CallOption) (*EmployeeUpdateResponse, error)
 	// employee_delete
 	EmployeeDelete(ctx context.Context, in *EmployeeDeleteRequest, opts ...grpc.CallOption) (*EmployeeDeleteResponse, error)
+	// employee_history
+	EmployeeHistory(ctx context.Context, in *EmployeeHistoryRequest, opts ...grpc.CallOption) (*EmployeeHistoryResponse, error)
+	// employee_restore
+	EmployeeRestore(ctx context.Context, in *EmployeeRestoreRequest, opts ...grpc.CallOption) (*EmployeeRestoreResponse, error)
 }
 
 type employeesClient struct {
@@ -87,6 +91,24 @@ func (c *employeesClient) EmployeeDelete(ctx context.Context, in *EmployeeDelete
 	return out, nil
 }
 
+func (c *employeesClient) EmployeeHistory(ctx context.Context, in *EmployeeHistoryRequest, opts ...grpc.CallOption) (*EmployeeHistoryResponse, error) {
+	out := new(EmployeeHistoryResponse)
+	err := c.cc.Invoke(ctx, "/go_bludgeon_employees.Employees/employee_history", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *employeesClient) EmployeeRestore(ctx context.Context, in *EmployeeRestoreRequest, opts ...grpc.CallOption) (*EmployeeRestoreResponse, error) {
+	out := new(EmployeeRestoreResponse)
+	err := c.cc.Invoke(ctx, "/go_bludgeon_employees.Employees/employee_restore", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // EmployeesServer is the server API for Employees service.
 // All implementations must embed UnimplementedEmployeesServer
 // for forward compatibility
@@ -101,6 +123,10 @@ type EmployeesServer interface {
 	EmployeeUpdate(context.Context, *EmployeeUpdateRequest) (*EmployeeUpdateResponse, error)
 	// employee_delete
 	EmployeeDelete(context.Context, *EmployeeDeleteRequest) (*EmployeeDeleteResponse, error)
+	// employee_history
+	EmployeeHistory(context.Context, *EmployeeHistoryRequest) (*EmployeeHistoryResponse, error)
+	// employee_restore
+	EmployeeRestore(context.Context, *EmployeeRestoreRequest) (*EmployeeRestoreResponse, error)
 	mustEmbedUnimplementedEmployeesServer()
 }
 
@@ -123,6 +149,12 @@ func (UnimplementedEmployeesServer) EmployeeUpdate(context.Context, *EmployeeUpd
 func (UnimplementedEmployeesServer) EmployeeDelete(context.Context, *EmployeeDeleteRequest) (*EmployeeDeleteResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method EmployeeDelete not implemented")
 }
+func (UnimplementedEmployeesServer) EmployeeHistory(context.Context, *EmployeeHistoryRequest) (*EmployeeHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EmployeeHistory not implemented")
+}
+func (UnimplementedEmployeesServer) EmployeeRestore(context.Context, *EmployeeRestoreRequest) (*EmployeeRestoreResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EmployeeRestore not implemented")
+}
 func (UnimplementedEmployeesServer) mustEmbedUnimplementedEmployeesServer() {}
 
 // UnsafeEmployeesServer may be embedded to opt out of forward compatibility for this service.
@@ -226,6 +258,42 @@ func _Employees_EmployeeDelete_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Employees_EmployeeHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EmployeeHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EmployeesServer).EmployeeHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/go_bludgeon_employees.Employees/employee_history",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EmployeesServer).EmployeeHistory(ctx, req.(*EmployeeHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Employees_EmployeeRestore_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EmployeeRestoreRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EmployeesServer).EmployeeRestore(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/go_bludgeon_employees.Employees/employee_restore",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EmployeesServer).EmployeeRestore(ctx, req.(*EmployeeRestoreRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Employees_ServiceDesc is the grpc.ServiceDesc for Employees service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -253,6 +321,14 @@ var Employees_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "employee_delete",
 			Handler:    _Employees_EmployeeDelete_Handler,
 		},
+		{
+			MethodName: "employee_history",
+			Handler:    _Employees_EmployeeHistory_Handler,
+		},
+		{
+			MethodName: "employee_restore",
+			Handler:    _Employees_EmployeeRestore_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "employees.proto",
@@ -57,6 +57,7 @@ func FromEmployee(e *data.Employee) *Employee {
 		LastUdpated:   e.LastUpdated,
 		LastUpdatedBy: e.LastUpdatedBy,
 		Version:       int32(e.Version),
+		DeletedAt:     e.DeletedAt,
 	}
 }
 
@@ -72,6 +73,7 @@ func ToEmployee(e *Employee) *data.Employee {
 		LastUpdated:   e.GetLastUdpated(),
 		LastUpdatedBy: e.GetLastUpdatedBy(),
 		Version:       int(e.GetVersion()),
+		DeletedAt:     e.GetDeletedAt(),
 	}
 }
 
@@ -113,6 +115,10 @@ func FromEmployeeSearch(e *EmployeeSearch) *data.EmployeeSearch {
 		s := e.GetEmailAddress()
 		employeeSearch.EmailAddress = &s
 	}
+	if e.DeletedOneof != nil {
+		b := e.GetDeleted()
+		employeeSearch.Deleted = &b
+	}
 	return employeeSearch
 }
 
@@ -141,5 +147,10 @@ func ToEmployeeSearch(e *data.EmployeeSearch) *EmployeeSearch {
 			EmailAddress: *e.EmailAddress,
 		}
 	}
+	if e.Deleted != nil {
+		employeeSearch.DeletedOneof = &EmployeeSearch_Deleted{
+			Deleted: *e.Deleted,
+		}
+	}
 	return employeeSearch
 }
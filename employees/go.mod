@@ -3,19 +3,21 @@ module github.com/antonio-alexander/go-bludgeon/employees
 go 1.19
 
 require (
-	github.com/antonio-alexander/go-bludgeon/changes v1.0.1
-	github.com/antonio-alexander/go-bludgeon/internal v1.4.0
-	github.com/go-sql-driver/mysql v1.6.0
+	github.com/antonio-alexander/go-bludgeon/changes v1.13.1
+	github.com/antonio-alexander/go-bludgeon/internal v1.8.0
+	github.com/go-sql-driver/mysql v1.7.0
 	github.com/google/uuid v1.3.0
 	github.com/gorilla/mux v1.8.0
 	github.com/pkg/errors v0.9.1
-	github.com/stretchr/testify v1.8.0
-	google.golang.org/grpc v1.48.0
-	google.golang.org/protobuf v1.28.0
+	github.com/stretchr/testify v1.8.2
+	google.golang.org/grpc v1.53.0
+	google.golang.org/protobuf v1.28.1
 )
 
 require (
 	github.com/Shopify/sarama v1.36.0 // indirect
+	github.com/antonio-alexander/go-queue v1.2.2 // indirect
+	github.com/antonio-alexander/go-stash v1.0.2 // indirect
 	github.com/davecgh/go-spew v1.1.1 // indirect
 	github.com/eapache/go-resiliency v1.3.0 // indirect
 	github.com/eapache/go-xerial-snappy v0.0.0-20180814174437-776d5712da21 // indirect
@@ -41,6 +43,6 @@ require (
 	golang.org/x/net v0.7.0 // indirect
 	golang.org/x/sys v0.5.0 // indirect
 	golang.org/x/text v0.7.0 // indirect
-	google.golang.org/genproto v0.0.0-20200825200019-8632dd797987 // indirect
+	google.golang.org/genproto v0.0.0-20230110181048-76db0878b65f // indirect
 	gopkg.in/yaml.v3 v3.0.1 // indirect
 )
@@ -5,7 +5,8 @@ import (
 )
 
 // swagger:route DELETE /employees/{id} employees delete
-// Deletes an employee using id.
+// Deletes an employee using id, the employee is moved to the trash and can be
+// restored until it's purged.
 //
 //     Consumes:
 //     - application/json
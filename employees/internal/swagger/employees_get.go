@@ -22,6 +22,10 @@ import (
 
 // swagger:response EmployeeGetResponseOk
 type EmployeeGetResponseOk struct {
+	// The version of the Employee, provide it as If-Match when updating
+	// in:header
+	ETag string `json:"ETag"`
+
 	// in:body
 	Body data.Employee
 }
@@ -0,0 +1,39 @@
+package swagger
+
+import (
+	"github.com/antonio-alexander/go-bludgeon/employees/data"
+	"github.com/antonio-alexander/go-bludgeon/internal/errors"
+)
+
+// swagger:route GET /employees/{id}/history employees read_history
+// Reads every version of an employee using their id (oldest first).
+//
+//     Consumes:
+//     - application/json
+//
+//     Produces:
+//     - application/json
+//
+//     Schemes: http
+//
+// responses:
+//   200: EmployeeGetHistoryResponseOk
+//   404: EmployeeGetHistoryResponseNotFound
+
+// swagger:response EmployeeGetHistoryResponseOk
+type EmployeeGetHistoryResponseOk struct {
+	// in:body
+	Body []data.Employee
+}
+
+// swagger:response EmployeeGetHistoryResponseNotFound
+type EmployeeGetHistoryResponseNotFound struct {
+	// in:body
+	Body errors.Error
+}
+
+// swagger:parameters employees read_history
+type EmployeeGetHistoryParams struct {
+	// in:path
+	ID string `json:"id"`
+}
@@ -18,11 +18,16 @@ import (
 //
 // responses:
 //   200: EmployeePutResponseOK
+//   409: EmployeePutResponseError
 //   500: EmployeePutResponseError
 
 // This is the response when an Employee is successfully updated, it will include all items of Employee that are user-editable as well as other items that are not user editable such as audit information and email address which can't be edited post creation.
 // swagger:response EmployeePutResponseOK
 type EmployeePutResponseOK struct {
+	// The version of the updated Employee
+	// in:header
+	ETag string `json:"ETag"`
+
 	// in:body
 	Body data.Employee
 }
@@ -41,6 +46,10 @@ type EmployeePutParams struct {
 	// in:path
 	ID string `json:"id"`
 
+	// The expected (current) version of the Employee, the update is rejected (409) if the Employee has since been modified
+	// in:header
+	IfMatch string `json:"If-Match"`
+
 	// This allows you to partially set values for certain properties of an Employee, the only required parameter (specifically for update) is the email address. Any omitted fields (other than email address) will not be set and be null (rather than just empty).
 	// in: body
 	Body data.EmployeePartial
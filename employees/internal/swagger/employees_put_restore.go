@@ -0,0 +1,46 @@
+package swagger
+
+import (
+	"github.com/antonio-alexander/go-bludgeon/employees/data"
+	"github.com/antonio-alexander/go-bludgeon/internal/errors"
+)
+
+// swagger:route PUT /employees/{id}/restore employees restore
+// Restores a deleted employee (from the trash) using their id.
+//
+//     Consumes:
+//     - application/json
+//
+//     Produces:
+//     - application/json
+//
+//     Schemes: http
+//
+// responses:
+//   200: EmployeePutRestoreResponseOk
+//   404: EmployeePutRestoreResponseNotFound
+
+// swagger:response EmployeePutRestoreResponseOk
+type EmployeePutRestoreResponseOk struct {
+	// The version of the Employee, provide it as If-Match when updating
+	// in:header
+	ETag string `json:"ETag"`
+
+	// in:body
+	Body data.Employee
+}
+
+// This is the response when you attempt to restore an Employee that doesn't exist
+// or hasn't been deleted
+// swagger:response EmployeePutRestoreResponseNotFound
+type EmployeePutRestoreResponseNotFound struct {
+	// in:body
+	Body errors.Error
+}
+
+// swagger:parameters employees restore
+type EmployeePutRestoreParams struct {
+	// The employee's id
+	// in:path
+	ID string `json:"id"`
+}
@@ -9,31 +9,61 @@ import (
 const (
 	FrequencyChangeRegistrationLessOrEqualToZero string = "change registration frequency less or equal to zero"
 	ChangesTimeoutReadLessOrEqualToZero          string = "changes timeout is less or equal to zero"
+	TrashRetentionLessThanZero                   string = "trash retention less than zero"
+	TrashPurgeRateLessOrEqualToZero              string = "trash purge rate less or equal to zero"
+	OutboxRelayRateLessOrEqualToZero             string = "outbox relay rate less or equal to zero"
+	OutboxRetryMinLessOrEqualToZero              string = "outbox retry minimum less or equal to zero"
+	OutboxRetryMaxLessThanMin                    string = "outbox retry maximum less than minimum"
 )
 
 const (
 	EnvNameFrequencyChangeRegistration string = "BLUDGEON_CHANGE_FREQUENCY_REGISTRATION"
 	EnvNameChangesTimeout              string = "BLUDGEON_CHANGE_TIMEOUT"
+	EnvNameTrashRetention              string = "BLUDGEON_TRASH_RETENTION"
+	EnvNameTrashPurgeRate              string = "BLUDGEON_TRASH_PURGE_RATE"
+	EnvNameOutboxRelayRate             string = "BLUDGEON_OUTBOX_RELAY_RATE"
+	EnvNameOutboxRetryMin              string = "BLUDGEON_OUTBOX_RETRY_MIN"
+	EnvNameOutboxRetryMax              string = "BLUDGEON_OUTBOX_RETRY_MAX"
 )
 
 const (
 	DefaultFrequencyChangeRegistration time.Duration = time.Second
 	DefaultChangesTimeout              time.Duration = 10 * time.Second
+	DefaultTrashRetention              time.Duration = 30 * 24 * time.Hour
+	DefaultTrashPurgeRate              time.Duration = time.Hour
+	DefaultOutboxRelayRate             time.Duration = 10 * time.Second
+	DefaultOutboxRetryMin              time.Duration = time.Second
+	DefaultOutboxRetryMax              time.Duration = time.Minute
 )
 
 var (
 	ErrFrequencyChangeRegistrationLessOrEqualToZero = errors.New(FrequencyChangeRegistrationLessOrEqualToZero)
 	ErrChangesTimeoutLessOrEqualToZero              = errors.New(ChangesTimeoutReadLessOrEqualToZero)
+	ErrTrashRetentionLessThanZero                   = errors.New(TrashRetentionLessThanZero)
+	ErrTrashPurgeRateLessOrEqualToZero              = errors.New(TrashPurgeRateLessOrEqualToZero)
+	ErrOutboxRelayRateLessOrEqualToZero             = errors.New(OutboxRelayRateLessOrEqualToZero)
+	ErrOutboxRetryMinLessOrEqualToZero              = errors.New(OutboxRetryMinLessOrEqualToZero)
+	ErrOutboxRetryMaxLessThanMin                    = errors.New(OutboxRetryMaxLessThanMin)
 )
 
 type Configuration struct {
 	FrequencyChangeRegistration time.Duration `json:"frequency_change_registration"`
 	ChangesTimeout              time.Duration `json:"changes_timeout"`
+	TrashRetention              time.Duration `json:"trash_retention"` //zero disables the purge job
+	TrashPurgeRate              time.Duration `json:"trash_purge_rate"`
+	OutboxRelayRate             time.Duration `json:"outbox_relay_rate"`
+	OutboxRetryMin              time.Duration `json:"outbox_retry_min"`
+	OutboxRetryMax              time.Duration `json:"outbox_retry_max"`
 }
 
 func (c *Configuration) Default() {
 	c.FrequencyChangeRegistration = DefaultFrequencyChangeRegistration
 	c.ChangesTimeout = DefaultChangesTimeout
+	c.TrashRetention = DefaultTrashRetention
+	c.TrashPurgeRate = DefaultTrashPurgeRate
+	c.OutboxRelayRate = DefaultOutboxRelayRate
+	c.OutboxRetryMin = DefaultOutboxRetryMin
+	c.OutboxRetryMax = DefaultOutboxRetryMax
 }
 
 func (c *Configuration) Validate() (err error) {
@@ -43,6 +73,21 @@ func (c *Configuration) Validate() (err error) {
 	if c.ChangesTimeout <= 0 {
 		return ErrChangesTimeoutLessOrEqualToZero
 	}
+	if c.TrashRetention < 0 {
+		return ErrTrashRetentionLessThanZero
+	}
+	if c.TrashRetention > 0 && c.TrashPurgeRate <= 0 {
+		return ErrTrashPurgeRateLessOrEqualToZero
+	}
+	if c.OutboxRelayRate <= 0 {
+		return ErrOutboxRelayRateLessOrEqualToZero
+	}
+	if c.OutboxRetryMin <= 0 {
+		return ErrOutboxRetryMinLessOrEqualToZero
+	}
+	if c.OutboxRetryMax < c.OutboxRetryMin {
+		return ErrOutboxRetryMaxLessThanMin
+	}
 	return
 }
 
@@ -55,4 +100,24 @@ func (c *Configuration) FromEnv(envs map[string]string) {
 		i, _ := strconv.ParseInt(s, 10, 64)
 		c.ChangesTimeout = time.Duration(i) * time.Second
 	}
+	if s, ok := envs[EnvNameTrashRetention]; ok && s != "" {
+		i, _ := strconv.ParseInt(s, 10, 64)
+		c.TrashRetention = time.Duration(i) * time.Second
+	}
+	if s, ok := envs[EnvNameTrashPurgeRate]; ok && s != "" {
+		i, _ := strconv.ParseInt(s, 10, 64)
+		c.TrashPurgeRate = time.Duration(i) * time.Second
+	}
+	if s, ok := envs[EnvNameOutboxRelayRate]; ok && s != "" {
+		i, _ := strconv.ParseInt(s, 10, 64)
+		c.OutboxRelayRate = time.Duration(i) * time.Second
+	}
+	if s, ok := envs[EnvNameOutboxRetryMin]; ok && s != "" {
+		i, _ := strconv.ParseInt(s, 10, 64)
+		c.OutboxRetryMin = time.Duration(i) * time.Second
+	}
+	if s, ok := envs[EnvNameOutboxRetryMax]; ok && s != "" {
+		i, _ := strconv.ParseInt(s, 10, 64)
+		c.OutboxRetryMax = time.Duration(i) * time.Second
+	}
 }
@@ -0,0 +1,96 @@
+package logic
+
+import (
+	"errors"
+	"time"
+
+	data "github.com/antonio-alexander/go-bludgeon/employees/data"
+
+	changesdata "github.com/antonio-alexander/go-bludgeon/changes/data"
+
+	internal_errors "github.com/antonio-alexander/go-bludgeon/internal/errors"
+)
+
+const outboxReadLimit int = 100
+
+// outboxChangeFromPartial can be used to convert a change partial into
+// an outbox change, optional fields that aren't set are left empty
+func outboxChangeFromPartial(changePartial changesdata.ChangePartial) (data.OutboxChange, error) {
+	var change data.OutboxChange
+
+	if changePartial.WhenChanged != nil {
+		change.WhenChanged = *changePartial.WhenChanged
+	}
+	if changePartial.ChangedBy != nil {
+		change.ChangedBy = *changePartial.ChangedBy
+	}
+	if changePartial.DataId != nil {
+		change.DataId = *changePartial.DataId
+	}
+	if changePartial.DataType != nil {
+		change.DataType = *changePartial.DataType
+	}
+	if changePartial.DataAction != nil {
+		change.DataAction = *changePartial.DataAction
+	}
+	if changePartial.DataVersion != nil {
+		change.DataVersion = *changePartial.DataVersion
+	}
+	if changePartial.Payload != nil {
+		bytes, err := changePartial.Payload.MarshalBinary()
+		if err != nil {
+			return data.OutboxChange{}, err
+		}
+		change.Payload = bytes
+	}
+	return change, nil
+}
+
+// outboxChangeToPartial can be used to convert an outbox change into
+// a change partial, empty optional fields are omitted
+func outboxChangeToPartial(change *data.OutboxChange) (changesdata.ChangePartial, error) {
+	changePartial := changesdata.ChangePartial{
+		DataId:          &change.DataId,
+		DataServiceName: &data.ServiceName,
+		DataType:        &change.DataType,
+		DataAction:      &change.DataAction,
+	}
+	if change.WhenChanged > 0 {
+		changePartial.WhenChanged = &change.WhenChanged
+	}
+	if change.ChangedBy != "" {
+		changePartial.ChangedBy = &change.ChangedBy
+	}
+	if change.DataVersion > 0 {
+		changePartial.DataVersion = &change.DataVersion
+	}
+	if len(change.Payload) > 0 {
+		changePartial.Payload = new(changesdata.ChangePayload)
+		if err := changePartial.Payload.UnmarshalBinary(change.Payload); err != nil {
+			return changesdata.ChangePartial{}, err
+		}
+	}
+	return changePartial, nil
+}
+
+// changeConflict can be used to determine if the changes service rejected
+// a change because it already exists (i.e. it was delivered more than once)
+func changeConflict(err error) bool {
+	var e interface{ Type() string }
+
+	return errors.As(err, &e) && e.Type() == internal_errors.ErrTypeConflict
+}
+
+// outboxBackoff can be used to determine how long to wait before
+// attempting to relay the outbox again, the backoff starts at the
+// minimum and doubles with each consecutive failure up to the maximum
+func outboxBackoff(backoff, min, max time.Duration) time.Duration {
+	switch {
+	case backoff < min:
+		return min
+	case backoff*2 > max:
+		return max
+	default:
+		return backoff * 2
+	}
+}
@@ -0,0 +1,118 @@
+package logic
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	data "github.com/antonio-alexander/go-bludgeon/employees/data"
+	meta "github.com/antonio-alexander/go-bludgeon/employees/meta"
+	metamemory "github.com/antonio-alexander/go-bludgeon/employees/meta/memory"
+
+	changesclient "github.com/antonio-alexander/go-bludgeon/changes/client"
+	changesdata "github.com/antonio-alexander/go-bludgeon/changes/data"
+
+	internal_errors "github.com/antonio-alexander/go-bludgeon/internal/errors"
+	logger "github.com/antonio-alexander/go-bludgeon/internal/logger"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// outboxTest is an outbox that can fail to acknowledge changes
+type outboxTest struct {
+	meta.Outbox
+	acknowledgeFailures int
+}
+
+func (o *outboxTest) OutboxAcknowledge(ctx context.Context, id string) error {
+	if o.acknowledgeFailures > 0 {
+		o.acknowledgeFailures--
+		return errors.New("acknowledge failed")
+	}
+	return o.Outbox.OutboxAcknowledge(ctx, id)
+}
+
+// changesClientTest is a changes client that behaves like the changes
+// service: a change that's upserted more than once is a conflict, it
+// can also queue changes (without confirming them)
+type changesClientTest struct {
+	changesclient.Client
+	changes map[string]*changesdata.Change
+	queue   bool
+}
+
+func (c *changesClientTest) ChangeUpsert(ctx context.Context, changePartial changesdata.ChangePartial) (*changesdata.Change, error) {
+	if c.queue {
+		return &changesdata.Change{DataId: *changePartial.DataId}, nil
+	}
+	key := fmt.Sprintf("%s:%s:%s:%d", *changePartial.DataId, *changePartial.DataType,
+		*changePartial.DataAction, *changePartial.DataVersion)
+	if _, ok := c.changes[key]; ok {
+		return nil, internal_errors.NewConflict(errors.New("cannot write change"))
+	}
+	change := &changesdata.Change{
+		Id:          fmt.Sprint(len(c.changes) + 1),
+		DataId:      *changePartial.DataId,
+		DataType:    *changePartial.DataType,
+		DataAction:  *changePartial.DataAction,
+		DataVersion: *changePartial.DataVersion,
+	}
+	c.changes[key] = change
+	return change, nil
+}
+
+func TestOutboxRelay(t *testing.T) {
+	ctx := context.TODO()
+	outbox := &outboxTest{Outbox: metamemory.New()}
+	changesClient := &changesClientTest{changes: make(map[string]*changesdata.Change)}
+	l := &logic{
+		Logger:        logger.NewNullLogger(),
+		outbox:        outbox,
+		changesClient: changesClient,
+		config:        &Configuration{ChangesTimeout: time.Second},
+	}
+
+	//enqueue a delete, its version identifies it if it's delivered
+	// more than once
+	id, version := "employee_a", 2
+	change, err := outboxChangeFromPartial(changesdata.ChangePartial{
+		DataId:      &id,
+		DataType:    &data.ChangeTypeEmployee,
+		DataAction:  &data.ChangeActionDelete,
+		DataVersion: &version,
+	})
+	assert.Nil(t, err)
+	_, err = outbox.OutboxEnqueue(ctx, change)
+	assert.Nil(t, err)
+
+	//relay outbox (change queued by the client), the change wasn't
+	// confirmed so it isn't acknowledged
+	changesClient.queue = true
+	err = l.relayOutbox()
+	assert.ErrorIs(t, err, ErrOutboxChangeNotConfirmed)
+	backlog, err := outbox.OutboxBacklog(ctx)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, backlog)
+	changesClient.queue = false
+
+	//relay outbox (acknowledge fails once), the change is delivered
+	// but remains in the outbox
+	outbox.acknowledgeFailures = 1
+	err = l.relayOutbox()
+	assert.NotNil(t, err)
+	backlog, err = outbox.OutboxBacklog(ctx)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, backlog)
+	assert.Len(t, changesClient.changes, 1)
+
+	//relay outbox, the change was already delivered (conflict) so
+	// it's acknowledged rather than stalling the outbox
+	err = l.relayOutbox()
+	assert.Nil(t, err)
+	backlog, err = outbox.OutboxBacklog(ctx)
+	assert.Nil(t, err)
+	assert.Zero(t, backlog)
+	assert.Len(t, changesClient.changes, 1)
+}
@@ -3,6 +3,7 @@ package logic
 import (
 	"context"
 	"sync"
+	"time"
 
 	data "github.com/antonio-alexander/go-bludgeon/employees/data"
 	meta "github.com/antonio-alexander/go-bludgeon/employees/meta"
@@ -20,7 +21,12 @@ type logic struct {
 	sync.RWMutex
 	logger.Logger
 	meta          meta.Employee
+	purger        meta.Purger
+	outbox        meta.Outbox
+	outboxSignal  chan struct{}
+	stopper       chan struct{}
 	changesClient changesclient.Client
+	initialized   bool
 	configured    bool
 	config        *Configuration
 }
@@ -32,27 +38,186 @@ func New() interface {
 	Logic
 	internal.Parameterizer
 	internal.Configurer
-	internal.Shutdowner
+	internal.Initializer
 } {
 	return &logic{
-		Logger: logger.NewNullLogger(),
+		Logger:       logger.NewNullLogger(),
+		outboxSignal: make(chan struct{}, 1),
 	}
 }
 
-func (l *logic) changeUpsert(changePartial changesdata.ChangePartial) {
+// changeUpsert will write the change (with a payload generated from the
+// employee before and after the mutation) to the outbox, it must be called
+// within an outbox transaction (see outboxTransaction) so the change is
+// written alongside the mutation, the relay is responsible for delivering
+// it to the changes service (and retrying until it succeeds)
+func (l *logic) changeUpsert(ctx context.Context, before, after *data.Employee, changePartial changesdata.ChangePartial) error {
+	changePayload, err := changesdata.NewChangePayload(before, after)
+	if err != nil {
+		return err
+	}
+	changePartial.Payload = changePayload
+	outboxChange, err := outboxChangeFromPartial(changePartial)
+	if err != nil {
+		return err
+	}
+	change, err := l.outbox.OutboxEnqueue(ctx, outboxChange)
+	if err != nil {
+		return err
+	}
+	l.Trace("Enqueued change: %s (%s:%s->%s)", change.ID, change.DataType, change.DataId, change.DataAction)
+	return nil
+}
+
+// outboxTransaction will execute the function within an outbox transaction
+// (the mutations and the changes enqueued are written together or not at
+// all) and signal the relay once it's complete
+func (l *logic) outboxTransaction(ctx context.Context, fx func(ctx context.Context) error) error {
+	if err := l.outbox.OutboxTransaction(ctx, fx); err != nil {
+		return err
+	}
+	select {
+	default:
+	case l.outboxSignal <- struct{}{}:
+	}
+	return nil
+}
+
+// relayOutbox will attempt to deliver the changes in the outbox (oldest
+// first) until it's empty, changes are only removed from the outbox once
+// the changes service has confirmed them, so delivery is at least once
+func (l *logic) relayOutbox() error {
+	for {
+		changes, err := l.outbox.OutboxRead(context.Background(), outboxReadLimit)
+		if err != nil {
+			return err
+		}
+		if len(changes) == 0 {
+			return nil
+		}
+		for _, change := range changes {
+			changePartial, err := outboxChangeToPartial(change)
+			if err != nil {
+				return err
+			}
+			ctx, cancel := context.WithTimeout(context.Background(), l.config.ChangesTimeout)
+			changeUpserted, err := l.changesClient.ChangeUpsert(ctx, changePartial)
+			cancel()
+			switch {
+			case err != nil && !changeConflict(err):
+				return err
+			case err != nil:
+				//KIM: the change has already been upserted (e.g. the
+				// acknowledgement failed after it was delivered)
+				l.Debug("Change already upserted: %s (%s:%s->%s)", change.ID, change.DataType,
+					change.DataId, change.DataAction)
+			case changeUpserted == nil || changeUpserted.Id == "":
+				//KIM: a change without an id wasn't confirmed by the changes
+				// service (e.g. it was queued by the client), it can't be
+				// acknowledged until it has been
+				return ErrOutboxChangeNotConfirmed
+			default:
+				l.Debug("Upserted change: %s (%s:%s->%s)", changeUpserted.Id, changeUpserted.DataType,
+					changeUpserted.DataId, changeUpserted.DataAction)
+			}
+			if err := l.outbox.OutboxAcknowledge(context.Background(), change.ID); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+func (l *logic) launchOutboxRelay() {
+	started := make(chan struct{})
 	l.Add(1)
 	go func() {
 		defer l.Done()
 
-		ctx, cancel := context.WithTimeout(context.Background(), l.config.ChangesTimeout)
-		defer cancel()
-		change, err := l.changesClient.ChangeUpsert(ctx, changePartial)
-		if err != nil {
-			l.Error("error while upserting change (%s:%s->%s): %s", *changePartial.DataType, *changePartial.DataId, *changePartial.DataAction, err)
-			return
+		var backoff time.Duration
+
+		close(started)
+		for {
+			//KIM: while backing off, signals are ignored so that
+			// new changes don't hammer an unavailable changes service
+			signal, wait := l.outboxSignal, l.config.OutboxRelayRate
+			if backoff > 0 {
+				signal, wait = nil, backoff
+			}
+			select {
+			case <-l.stopper:
+				return
+			case <-signal:
+			case <-time.After(wait):
+			}
+			if err := l.relayOutbox(); err != nil {
+				//KIM: employees doesn't expose a healthcheck (yet), so the
+				// backlog is reported alongside the error instead
+				backoff = outboxBackoff(backoff, l.config.OutboxRetryMin, l.config.OutboxRetryMax)
+				backlog, _ := l.outbox.OutboxBacklog(context.Background())
+				l.Error("error while relaying outbox (backlog %d, retrying in %v): %s", backlog, backoff, err)
+				continue
+			}
+			backoff = 0
+		}
+	}()
+	<-started
+}
+
+func (l *logic) purgeEmployees() {
+	var employees []*data.Employee
+
+	deletedBefore := time.Now().Add(-l.config.TrashRetention).UnixNano()
+	if err := l.outboxTransaction(context.Background(), func(ctx context.Context) error {
+		var err error
+
+		if employees, err = l.purger.EmployeesPurge(ctx, deletedBefore); err != nil {
+			return err
+		}
+		for _, employee := range employees {
+			tNow := time.Now().UnixNano()
+			if err := l.changeUpsert(ctx, employee, nil, changesdata.ChangePartial{
+				WhenChanged:     &tNow,
+				DataId:          &employee.ID,
+				DataVersion:     &employee.Version,
+				DataServiceName: &data.ServiceName,
+				DataType:        &data.ChangeTypeEmployee,
+				DataAction:      &data.ChangeActionPurge,
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}); err != nil {
+		l.Error("error while purging employees: %s", err)
+		return
+	}
+	for _, employee := range employees {
+		l.Debug("%s purged employee %s", LogAlias, employee.ID)
+	}
+}
+
+func (l *logic) launchTrashPurger() {
+	if l.config.TrashRetention <= 0 {
+		return
+	}
+	started := make(chan struct{})
+	l.Add(1)
+	go func() {
+		defer l.Done()
+
+		tPurge := time.NewTicker(l.config.TrashPurgeRate)
+		defer tPurge.Stop()
+		close(started)
+		for {
+			select {
+			case <-l.stopper:
+				return
+			case <-tPurge.C:
+				l.purgeEmployees()
+			}
 		}
-		l.Debug("Upserted change: %s (%s:%s->%s)", change.Id, change.DataType, change.DataId, change.DataAction)
 	}()
+	<-started
 }
 
 func (l *logic) SetParameters(parameters ...interface{}) {
@@ -60,13 +225,29 @@ func (l *logic) SetParameters(parameters ...interface{}) {
 		switch p := parameter.(type) {
 		case changesclient.Client:
 			l.changesClient = p
+		case interface {
+			meta.Employee
+			meta.Purger
+			meta.Outbox
+		}:
+			l.meta = p
+			l.purger = p
+			l.outbox = p
 		case meta.Employee:
 			l.meta = p
+		case meta.Purger:
+			l.purger = p
+		case meta.Outbox:
+			l.outbox = p
 		}
 	}
 	switch {
 	case l.meta == nil:
 		panic(PanicEmployeeMetaNotSet)
+	case l.purger == nil:
+		panic(PanicPurgerNotSet)
+	case l.outbox == nil:
+		panic(PanicOutboxNotSet)
 	case l.changesClient == nil:
 		panic(PanicChangesclientNotSet)
 	}
@@ -109,10 +290,34 @@ func (l *logic) Configure(items ...interface{}) error {
 	return nil
 }
 
+func (l *logic) Initialize() error {
+	l.Lock()
+	defer l.Unlock()
+
+	if l.initialized {
+		return nil
+	}
+	l.stopper = make(chan struct{})
+	l.launchTrashPurger()
+	l.launchOutboxRelay()
+	//KIM: changes left in the outbox (e.g. from before a restart)
+	// are relayed immediately rather than at the next tick
+	select {
+	default:
+	case l.outboxSignal <- struct{}{}:
+	}
+	l.initialized = true
+	return nil
+}
+
 func (l *logic) Shutdown() {
 	l.Lock()
 	defer l.Unlock()
 
+	if l.initialized {
+		close(l.stopper)
+		l.initialized = false
+	}
 	l.Wait()
 }
 
@@ -120,20 +325,27 @@ func (l *logic) Shutdown() {
 // the employee email address is required and must be unique
 // at the time of creation
 func (l *logic) EmployeeCreate(ctx context.Context, e data.EmployeePartial) (*data.Employee, error) {
-	employee, err := l.meta.EmployeeCreate(ctx, e)
-	if err != nil {
+	var employee *data.Employee
+
+	if err := l.outboxTransaction(ctx, func(ctx context.Context) error {
+		var err error
+
+		if employee, err = l.meta.EmployeeCreate(ctx, e); err != nil {
+			return err
+		}
+		return l.changeUpsert(ctx, nil, employee, changesdata.ChangePartial{
+			WhenChanged:     &employee.LastUpdated,
+			ChangedBy:       &employee.LastUpdatedBy,
+			DataId:          &employee.ID,
+			DataServiceName: &data.ServiceName,
+			DataType:        &data.ChangeTypeEmployee,
+			DataAction:      &data.ChangeActionCreate,
+			DataVersion:     &employee.Version,
+		})
+	}); err != nil {
 		return nil, err
 	}
 	l.Debug("%s created employee %s", LogAlias, employee.ID)
-	l.changeUpsert(changesdata.ChangePartial{
-		WhenChanged:     &employee.LastUpdated,
-		ChangedBy:       &employee.LastUpdatedBy,
-		DataId:          &employee.ID,
-		DataServiceName: &data.ServiceName,
-		DataType:        &data.ChangeTypeEmployee,
-		DataAction:      &data.ChangeActionCreate,
-		DataVersion:     &employee.Version,
-	})
 	return employee, nil
 }
 
@@ -153,42 +365,104 @@ func (l *logic) EmployeeRead(ctx context.Context, id string) (*data.Employee, er
 
 // EmployeeUpdate can be used to update the properties of a given employee
 func (l *logic) EmployeeUpdate(ctx context.Context, id string, e data.EmployeePartial) (*data.Employee, error) {
-	employee, err := l.meta.EmployeeUpdate(ctx, id, e)
-	if err != nil {
+	var employee *data.Employee
+
+	if err := l.outboxTransaction(ctx, func(ctx context.Context) error {
+		employeeBefore, err := l.meta.EmployeeRead(ctx, id)
+		if err != nil {
+			return err
+		}
+		if employee, err = l.meta.EmployeeUpdate(ctx, id, e); err != nil {
+			return err
+		}
+		return l.changeUpsert(ctx, employeeBefore, employee, changesdata.ChangePartial{
+			WhenChanged:     &employee.LastUpdated,
+			ChangedBy:       &employee.LastUpdatedBy,
+			DataId:          &employee.ID,
+			DataServiceName: &data.ServiceName,
+			DataType:        &data.ChangeTypeEmployee,
+			DataAction:      &data.ChangeActionUpdate,
+			DataVersion:     &employee.Version,
+		})
+	}); err != nil {
 		return nil, err
 	}
 	l.Debug("%s updated employee %s", LogAlias, employee.ID)
-	l.changeUpsert(changesdata.ChangePartial{
-		WhenChanged:     &employee.LastUpdated,
-		ChangedBy:       &employee.LastUpdatedBy,
-		DataId:          &employee.ID,
-		DataServiceName: &data.ServiceName,
-		DataType:        &data.ChangeTypeEmployee,
-		DataAction:      &data.ChangeActionUpdate,
-		DataVersion:     &employee.Version,
-	})
 	return employee, nil
 }
 
 // EmployeeDelete can be used to delete a single employee given a
-// valid id, logic will ensure that the id is not empty
+// valid id, logic will ensure that the id is not empty; the employee
+// is moved to the trash and purged once the retention has elapsed
 func (l *logic) EmployeeDelete(ctx context.Context, employeeId string) error {
 	if employeeId == "" {
 		return ErrEmployeeIDNotProvided
 	}
-	if err := l.meta.EmployeeDelete(ctx, employeeId); err != nil {
+	if err := l.outboxTransaction(ctx, func(ctx context.Context) error {
+		employee, err := l.meta.EmployeeRead(ctx, employeeId)
+		if err != nil {
+			return err
+		}
+		if err := l.meta.EmployeeDelete(ctx, employeeId); err != nil {
+			return err
+		}
+		//KIM: deleting an employee (moving it to the trash) increments its
+		// version, the version is what allows the changes service to
+		// identify a delete that's delivered more than once
+		version := employee.Version + 1
+		return l.changeUpsert(ctx, employee, nil, changesdata.ChangePartial{
+			DataId:          &employeeId,
+			DataVersion:     &version,
+			DataServiceName: &data.ServiceName,
+			DataType:        &data.ChangeTypeEmployee,
+			DataAction:      &data.ChangeActionDelete,
+		})
+	}); err != nil {
 		return err
 	}
 	l.Debug("%s deleted employee %s", LogAlias, employeeId)
-	l.changeUpsert(changesdata.ChangePartial{
-		DataId:          &employeeId,
-		DataServiceName: &data.ServiceName,
-		DataType:        &data.ChangeTypeEmployee,
-		DataAction:      &data.ChangeActionDelete,
-	})
 	return nil
 }
 
+// EmployeeRestore can be used to restore a deleted employee (from the
+// trash), logic will ensure that the id is not empty
+func (l *logic) EmployeeRestore(ctx context.Context, id string) (*data.Employee, error) {
+	if id == "" {
+		return nil, ErrEmployeeIDNotProvided
+	}
+	var employee *data.Employee
+
+	deleted := true
+	if err := l.outboxTransaction(ctx, func(ctx context.Context) error {
+		employeesBefore, err := l.meta.EmployeesRead(ctx, data.EmployeeSearch{
+			IDs:     []string{id},
+			Deleted: &deleted,
+		})
+		if err != nil {
+			return err
+		}
+		if len(employeesBefore) <= 0 {
+			return meta.ErrEmployeeNotFound
+		}
+		if employee, err = l.meta.EmployeeRestore(ctx, id); err != nil {
+			return err
+		}
+		return l.changeUpsert(ctx, employeesBefore[0], employee, changesdata.ChangePartial{
+			WhenChanged:     &employee.LastUpdated,
+			ChangedBy:       &employee.LastUpdatedBy,
+			DataId:          &employee.ID,
+			DataServiceName: &data.ServiceName,
+			DataType:        &data.ChangeTypeEmployee,
+			DataAction:      &data.ChangeActionRestore,
+			DataVersion:     &employee.Version,
+		})
+	}); err != nil {
+		return nil, err
+	}
+	l.Debug("%s restored employee %s", LogAlias, employee.ID)
+	return employee, nil
+}
+
 // EmployeesRead can be used to read one or more employees, given a set of
 // search parameters
 func (l *logic) EmployeesRead(ctx context.Context, search data.EmployeeSearch) ([]*data.Employee, error) {
@@ -199,3 +473,17 @@ func (l *logic) EmployeesRead(ctx context.Context, search data.EmployeeSearch) (
 	l.Debug("%s read %d employees", LogAlias, len(employees))
 	return employees, nil
 }
+
+// EmployeeHistory can be used to read every version of a given employee
+// (oldest first), logic will ensure that the id is not empty
+func (l *logic) EmployeeHistory(ctx context.Context, id string) ([]*data.Employee, error) {
+	if id == "" {
+		return nil, ErrEmployeeIDNotProvided
+	}
+	employees, err := l.meta.EmployeeHistory(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	l.Debug("%s read %d versions of employee %s", LogAlias, len(employees), id)
+	return employees, nil
+}
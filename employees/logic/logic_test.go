@@ -35,7 +35,7 @@ const filename string = "bludgeon_logic.json"
 var (
 	configMetaMysql          = new(internal_mysql.Configuration)
 	configMetaFile           = new(internal_file.Configuration)
-	configChangesClientRest  = new(changesclientrest.Configuration)
+	configChangesClientRest  = changesclientrest.NewConfiguration()
 	configChangesClientKafka = new(changesclientkafka.Configuration)
 	configKafkaClient        = new(internal_kafka.Configuration)
 	configLogic              = new(logic.Configuration)
@@ -258,6 +258,11 @@ func (l *logicTest) TestChanges(t *testing.T) {
 	assert.Equal(t, data.ChangeActionUpdate, changesRead[0].DataAction)
 	assert.Equal(t, data.ServiceName, changesRead[0].DataServiceName)
 	assert.Equal(t, data.ChangeTypeEmployee, changesRead[0].DataType)
+	if assert.NotNil(t, changesRead[0].Payload) {
+		assert.True(t, changesRead[0].Payload.HasField("first_name"))
+		assert.True(t, changesRead[0].Payload.HasField("last_name"))
+		assert.False(t, changesRead[0].Payload.HasField("email_address"))
+	}
 
 	// delete employee
 	err = l.EmployeeDelete(ctx, employeeId)
@@ -280,6 +285,9 @@ func (l *logicTest) TestChanges(t *testing.T) {
 	assert.Equal(t, data.ChangeActionDelete, changesRead[0].DataAction)
 	assert.Equal(t, data.ServiceName, changesRead[0].DataServiceName)
 	assert.Equal(t, data.ChangeTypeEmployee, changesRead[0].DataType)
+	if assert.NotNil(t, changesRead[0].Payload) {
+		assert.NotEmpty(t, changesRead[0].Payload.Snapshot)
+	}
 }
 
 func testLogic(t *testing.T, metaType, protocol string) {
@@ -10,10 +10,13 @@ const (
 	LogAlias                 string = "Logic"
 	PanicEmployeeMetaNotSet  string = "employee meta not set"
 	PanicChangesclientNotSet string = "changes client not set"
+	PanicPurgerNotSet        string = "purger not set"
+	PanicOutboxNotSet        string = "outbox not set"
 )
 
 var (
-	ErrEmployeeIDNotProvided = errors.New("employee id not provided")
+	ErrEmployeeIDNotProvided    = errors.New("employee id not provided")
+	ErrOutboxChangeNotConfirmed = errors.New("outbox change not confirmed by the changes service")
 )
 
 // Logic is an interface that provides functionality to interact with
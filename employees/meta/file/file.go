@@ -14,6 +14,12 @@ import (
 	internal_file "github.com/antonio-alexander/go-bludgeon/internal/meta/file"
 )
 
+type contextKey string
+
+// contextKeyTransaction is used to store the file (pointer) that's
+// locked for the duration of an outbox transaction
+const contextKeyTransaction contextKey = "transaction"
+
 type file struct {
 	sync.RWMutex
 	logger.Logger
@@ -22,10 +28,14 @@ type file struct {
 	internal.Initializer
 	meta.Serializer
 	meta.Employee
+	meta.Purger
+	meta.Outbox
 }
 
 func New() interface {
 	meta.Employee
+	meta.Purger
+	meta.Outbox
 	internal.Configurer
 	internal.Initializer
 	internal.Parameterizer
@@ -39,6 +49,8 @@ func New() interface {
 		Initializer: internalFile,
 		Serializer:  memory,
 		Employee:    memory,
+		Purger:      memory,
+		Outbox:      memory,
 	}
 }
 
@@ -48,11 +60,19 @@ func (m *file) SetParameters(parameters ...interface{}) {
 		case interface {
 			meta.Serializer
 			meta.Employee
+			meta.Purger
+			meta.Outbox
 		}:
 			m.Serializer = p
 			m.Employee = p
+			m.Purger = p
+			m.Outbox = p
 		case meta.Employee:
 			m.Employee = p
+		case meta.Purger:
+			m.Purger = p
+		case meta.Outbox:
+			m.Outbox = p
 		case meta.Serializer:
 			m.Serializer = p
 		}
@@ -68,7 +88,13 @@ func (m *file) SetUtilities(parameters ...interface{}) {
 	}
 }
 
-func (m *file) write() error {
+// write will serialize the memory and write it to the file, if the
+// context is within an outbox transaction, the memory is written once
+// the transaction is complete
+func (m *file) write(ctx context.Context) error {
+	if ctx != nil && ctx.Value(contextKeyTransaction) == m {
+		return nil
+	}
 	serializedData, err := m.Serialize()
 	if err != nil {
 		return err
@@ -76,6 +102,17 @@ func (m *file) write() error {
 	return m.Write(serializedData)
 }
 
+// lock will lock the file and return the function to unlock it, if
+// the context is within an outbox transaction, the file is already
+// locked
+func (m *file) lock(ctx context.Context) func() {
+	if ctx != nil && ctx.Value(contextKeyTransaction) == m {
+		return func() {}
+	}
+	m.Lock()
+	return m.Unlock
+}
+
 // Initialize
 func (m *file) Initialize() error {
 	m.Lock()
@@ -108,39 +145,96 @@ func (m *file) Shutdown() {
 }
 
 func (m *file) EmployeeCreate(ctx context.Context, e data.EmployeePartial) (*data.Employee, error) {
-	m.Lock()
-	defer m.Unlock()
+	defer m.lock(ctx)()
 	employee, err := m.Employee.EmployeeCreate(ctx, e)
 	if err != nil {
 		return nil, err
 	}
-	if err := m.write(); err != nil {
+	if err := m.write(ctx); err != nil {
 		return nil, err
 	}
 	return employee, nil
 }
 
 func (m *file) EmployeeUpdate(ctx context.Context, id string, e data.EmployeePartial) (*data.Employee, error) {
-	m.Lock()
-	defer m.Unlock()
+	defer m.lock(ctx)()
 	employee, err := m.Employee.EmployeeUpdate(ctx, id, e)
 	if err != nil {
 		return nil, err
 	}
-	if err := m.write(); err != nil {
+	if err := m.write(ctx); err != nil {
 		return nil, err
 	}
 	return employee, nil
 }
 
 func (m *file) EmployeeDelete(ctx context.Context, id string) error {
-	m.Lock()
-	defer m.Unlock()
+	defer m.lock(ctx)()
 	if err := m.Employee.EmployeeDelete(ctx, id); err != nil {
 		return err
 	}
-	if err := m.write(); err != nil {
+	if err := m.write(ctx); err != nil {
 		return err
 	}
 	return nil
 }
+
+func (m *file) EmployeeRestore(ctx context.Context, id string) (*data.Employee, error) {
+	defer m.lock(ctx)()
+	employee, err := m.Employee.EmployeeRestore(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if err := m.write(ctx); err != nil {
+		return nil, err
+	}
+	return employee, nil
+}
+
+func (m *file) EmployeesPurge(ctx context.Context, deletedBefore int64) ([]*data.Employee, error) {
+	defer m.lock(ctx)()
+	employees, err := m.Purger.EmployeesPurge(ctx, deletedBefore)
+	if err != nil {
+		return nil, err
+	}
+	if len(employees) > 0 {
+		if err := m.write(ctx); err != nil {
+			return nil, err
+		}
+	}
+	return employees, nil
+}
+
+func (m *file) OutboxEnqueue(ctx context.Context, change data.OutboxChange) (*data.OutboxChange, error) {
+	defer m.lock(ctx)()
+	outboxChange, err := m.Outbox.OutboxEnqueue(ctx, change)
+	if err != nil {
+		return nil, err
+	}
+	if err := m.write(ctx); err != nil {
+		return nil, err
+	}
+	return outboxChange, nil
+}
+
+func (m *file) OutboxAcknowledge(ctx context.Context, id string) error {
+	defer m.lock(ctx)()
+	if err := m.Outbox.OutboxAcknowledge(ctx, id); err != nil {
+		return err
+	}
+	return m.write(ctx)
+}
+
+func (m *file) OutboxTransaction(ctx context.Context, fx func(ctx context.Context) error) error {
+	if ctx != nil && ctx.Value(contextKeyTransaction) == m {
+		return fx(ctx)
+	}
+	m.Lock()
+	defer m.Unlock()
+	//KIM: the mutations (and their changes) are written to the
+	// file once rather than once per mutation
+	if err := m.Outbox.OutboxTransaction(context.WithValue(ctx, contextKeyTransaction, m), fx); err != nil {
+		return err
+	}
+	return m.write(ctx)
+}
@@ -46,4 +46,8 @@ func TestMetaFile(t *testing.T) {
 	defer meta.Shutdown()
 
 	t.Run("Employee CRUD", tests.TestEmployeeCRUD(meta))
+	t.Run("Employee History", tests.TestEmployeeHistory(meta))
+	t.Run("Employee Trash", tests.TestEmployeeTrash(meta))
+	t.Run("Outbox", tests.TestOutbox(meta))
+	t.Run("Outbox Transaction", tests.TestOutboxTransaction(meta))
 }
@@ -1,6 +1,8 @@
 package memory
 
 import (
+	"encoding/json"
+
 	data "github.com/antonio-alexander/go-bludgeon/employees/data"
 
 	"github.com/google/uuid"
@@ -23,5 +25,20 @@ func copyEmployee(e *data.Employee) *data.Employee {
 		LastUpdated:   e.LastUpdated,
 		LastUpdatedBy: e.LastUpdatedBy,
 		Version:       e.Version,
+		DeletedAt:     e.DeletedAt,
+	}
+}
+
+func copyOutboxChange(c *data.OutboxChange) *data.OutboxChange {
+	return &data.OutboxChange{
+		ID:          c.ID,
+		WhenChanged: c.WhenChanged,
+		ChangedBy:   c.ChangedBy,
+		DataId:      c.DataId,
+		DataType:    c.DataType,
+		DataAction:  c.DataAction,
+		DataVersion: c.DataVersion,
+		Payload:     append(json.RawMessage(nil), c.Payload...),
+		Enqueued:    c.Enqueued,
 	}
 }
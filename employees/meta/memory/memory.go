@@ -2,6 +2,7 @@ package memory
 
 import (
 	"context"
+	"sort"
 	"strings"
 	"sync"
 	"time"
@@ -16,23 +17,56 @@ import (
 
 const lastUpdatedBy string = "bludgeon_meta_memory"
 
+type contextKey string
+
+// contextKeyTransaction is used to store the memory (pointer) that's
+// locked for the duration of an outbox transaction
+const contextKeyTransaction contextKey = "transaction"
+
 type memory struct {
-	sync.RWMutex                            //mutex for threadsafe functionality
-	logger.Logger                           //logger
-	employees     map[string]*data.Employee //map to store employees
+	sync.RWMutex                                 //mutex for threadsafe functionality
+	logger.Logger                                //logger
+	employees        map[string]*data.Employee   //map to store employees
+	employeesHistory map[string][]*data.Employee //map to store every version of an employee
+	outbox           []*data.OutboxChange        //changes yet to be delivered (oldest first)
 }
 
 func New() interface {
 	meta.Employee
 	meta.Serializer
+	meta.Purger
+	meta.Outbox
 	internal.Initializer
 	internal.Configurer
 	internal.Parameterizer
 } {
 	return &memory{
-		employees: make(map[string]*data.Employee),
-		Logger:    logger.NewNullLogger(),
+		employees:        make(map[string]*data.Employee),
+		employeesHistory: make(map[string][]*data.Employee),
+		Logger:           logger.NewNullLogger(),
+	}
+}
+
+// lock will lock the memory (for writing) and return the function to
+// unlock it, if the context is within an outbox transaction, the memory
+// is already locked
+func (m *memory) lock(ctx context.Context) func() {
+	if ctx != nil && ctx.Value(contextKeyTransaction) == m {
+		return func() {}
+	}
+	m.Lock()
+	return m.Unlock
+}
+
+// rlock will lock the memory (for reading) and return the function to
+// unlock it, if the context is within an outbox transaction, the memory
+// is already locked
+func (m *memory) rlock(ctx context.Context) func() {
+	if ctx != nil && ctx.Value(contextKeyTransaction) == m {
+		return func() {}
 	}
+	m.RLock()
+	return m.RUnlock
 }
 
 func (m *memory) validateEmployee(e data.EmployeePartial, create bool, ids ...string) error {
@@ -60,6 +94,10 @@ func (m *memory) validateEmployee(e data.EmployeePartial, create bool, ids ...st
 	return nil
 }
 
+func (m *memory) employeeAudit(employee *data.Employee) {
+	m.employeesHistory[employee.ID] = append(m.employeesHistory[employee.ID], copyEmployee(employee))
+}
+
 func (m *memory) SetParameters(parameters ...interface{}) {
 	//
 }
@@ -89,11 +127,12 @@ func (m *memory) Shutdown() {
 	m.Lock()
 	defer m.Unlock()
 	m.employees = nil
+	m.employeesHistory = nil
+	m.outbox = nil
 }
 
 func (m *memory) EmployeeCreate(ctx context.Context, e data.EmployeePartial) (*data.Employee, error) {
-	m.Lock()
-	defer m.Unlock()
+	defer m.lock(ctx)()
 	if err := m.validateEmployee(e, true); err != nil {
 		return nil, err
 	}
@@ -116,30 +155,32 @@ func (m *memory) EmployeeCreate(ctx context.Context, e data.EmployeePartial) (*d
 		employee.LastName = *e.LastName
 	}
 	m.employees[id] = employee
+	m.employeeAudit(employee)
 	return copyEmployee(employee), nil
 }
 
 func (m *memory) EmployeeRead(ctx context.Context, id string) (*data.Employee, error) {
-	m.RLock()
-	defer m.RUnlock()
+	defer m.rlock(ctx)()
 	employee, ok := m.employees[id]
-	if !ok {
+	if !ok || employee.DeletedAt > 0 {
 		return nil, meta.ErrEmployeeNotFound
 	}
 	return copyEmployee(employee), nil
 }
 
 func (m *memory) EmployeeUpdate(ctx context.Context, id string, e data.EmployeePartial) (*data.Employee, error) {
-	m.Lock()
-	defer m.Unlock()
+	defer m.lock(ctx)()
 	updated := false
 	if err := m.validateEmployee(e, false, id); err != nil {
 		return nil, err
 	}
 	employee, ok := m.employees[id]
-	if !ok {
+	if !ok || employee.DeletedAt > 0 {
 		return nil, meta.ErrEmployeeNotFound
 	}
+	if e.Version != nil && *e.Version != employee.Version {
+		return nil, meta.ErrEmployeeConflictVersion
+	}
 	if e.EmailAddress != nil {
 		employee.EmailAddress = *e.EmailAddress
 		updated = true
@@ -157,24 +198,60 @@ func (m *memory) EmployeeUpdate(ctx context.Context, id string, e data.EmployeeP
 	}
 	employee.LastUpdated = time.Now().UnixNano()
 	employee.Version++
+	m.employeeAudit(employee)
 	return copyEmployee(employee), nil
 }
 
 func (m *memory) EmployeeDelete(ctx context.Context, id string) error {
-	m.Lock()
-	defer m.Unlock()
-	if _, ok := m.employees[id]; !ok {
+	defer m.lock(ctx)()
+	employee, ok := m.employees[id]
+	if !ok || employee.DeletedAt > 0 {
 		return meta.ErrEmployeeNotFound
 	}
-	delete(m.employees, id)
+	employee.DeletedAt = time.Now().UnixNano()
+	employee.LastUpdated = employee.DeletedAt
+	employee.Version++
+	m.employeeAudit(employee)
 	return nil
 }
 
+func (m *memory) EmployeeRestore(ctx context.Context, id string) (*data.Employee, error) {
+	defer m.lock(ctx)()
+	employee, ok := m.employees[id]
+	if !ok || employee.DeletedAt <= 0 {
+		return nil, meta.ErrEmployeeNotFound
+	}
+	employee.DeletedAt = 0
+	employee.LastUpdated = time.Now().UnixNano()
+	employee.Version++
+	m.employeeAudit(employee)
+	return copyEmployee(employee), nil
+}
+
+func (m *memory) EmployeesPurge(ctx context.Context, deletedBefore int64) ([]*data.Employee, error) {
+	defer m.lock(ctx)()
+	var employees []*data.Employee
+	for id, employee := range m.employees {
+		if employee.DeletedAt <= 0 || employee.DeletedAt > deletedBefore {
+			continue
+		}
+		delete(m.employees, id)
+		delete(m.employeesHistory, id)
+		employees = append(employees, copyEmployee(employee))
+	}
+	sort.Slice(employees, func(i, j int) bool {
+		return employees[i].ID < employees[j].ID
+	})
+	return employees, nil
+}
+
 func (m *memory) EmployeesRead(ctx context.Context, search data.EmployeeSearch) ([]*data.Employee, error) {
-	m.RLock()
-	defer m.RUnlock()
+	defer m.rlock(ctx)()
 	searchFx := func(e *data.Employee) bool {
 		//KIM: this is an inclusive search and is computationally expensive
+		if deleted := search.Deleted != nil && *search.Deleted; (e.DeletedAt > 0) != deleted {
+			return false
+		}
 		if len(search.IDs) > 0 {
 			found := false
 			for _, id := range search.IDs {
@@ -249,15 +326,37 @@ func (m *memory) EmployeesRead(ctx context.Context, search data.EmployeeSearch)
 	return employees, nil
 }
 
+func (m *memory) EmployeeHistory(ctx context.Context, id string) ([]*data.Employee, error) {
+	defer m.rlock(ctx)()
+	history, ok := m.employeesHistory[id]
+	if !ok || len(history) == 0 {
+		return nil, meta.ErrEmployeeNotFound
+	}
+	employees := make([]*data.Employee, 0, len(history))
+	for _, employee := range history {
+		employees = append(employees, copyEmployee(employee))
+	}
+	return employees, nil
+}
+
 func (m *memory) Serialize() (*meta.SerializedData, error) {
 	m.Lock()
 	defer m.Unlock()
 	serializedData := &meta.SerializedData{
-		Employees: make(map[string]data.Employee),
+		Employees:        make(map[string]data.Employee),
+		EmployeesHistory: make(map[string][]data.Employee),
 	}
 	for id, employee := range m.employees {
 		serializedData.Employees[id] = *employee
 	}
+	for id, history := range m.employeesHistory {
+		for _, employee := range history {
+			serializedData.EmployeesHistory[id] = append(serializedData.EmployeesHistory[id], *employee)
+		}
+	}
+	for _, change := range m.outbox {
+		serializedData.Outbox = append(serializedData.Outbox, *change)
+	}
 	return serializedData, nil
 }
 
@@ -268,8 +367,65 @@ func (m *memory) Deserialize(serializedData *meta.SerializedData) error {
 		return errors.New("serialized data is nil")
 	}
 	m.employees = make(map[string]*data.Employee)
+	m.employeesHistory = make(map[string][]*data.Employee)
 	for id, employee := range serializedData.Employees {
 		m.employees[id] = &employee
 	}
+	for id, history := range serializedData.EmployeesHistory {
+		for _, employee := range history {
+			m.employeesHistory[id] = append(m.employeesHistory[id], copyEmployee(&employee))
+		}
+	}
+	m.outbox = nil
+	for i := range serializedData.Outbox {
+		m.outbox = append(m.outbox, copyOutboxChange(&serializedData.Outbox[i]))
+	}
 	return nil
 }
+
+func (m *memory) OutboxEnqueue(ctx context.Context, change data.OutboxChange) (*data.OutboxChange, error) {
+	defer m.lock(ctx)()
+	id, err := generateID()
+	if err != nil {
+		return nil, err
+	}
+	change.ID = id
+	change.Enqueued = time.Now().UnixNano()
+	m.outbox = append(m.outbox, copyOutboxChange(&change))
+	return copyOutboxChange(&change), nil
+}
+
+func (m *memory) OutboxRead(ctx context.Context, limit int) ([]*data.OutboxChange, error) {
+	defer m.rlock(ctx)()
+	var changes []*data.OutboxChange
+	for _, change := range m.outbox {
+		if limit > 0 && len(changes) >= limit {
+			break
+		}
+		changes = append(changes, copyOutboxChange(change))
+	}
+	return changes, nil
+}
+
+func (m *memory) OutboxAcknowledge(ctx context.Context, id string) error {
+	defer m.lock(ctx)()
+	for i, change := range m.outbox {
+		if change.ID == id {
+			m.outbox = append(m.outbox[:i], m.outbox[i+1:]...)
+			return nil
+		}
+	}
+	return meta.ErrOutboxChangeNotFound
+}
+
+func (m *memory) OutboxBacklog(ctx context.Context) (int, error) {
+	defer m.rlock(ctx)()
+	return len(m.outbox), nil
+}
+
+func (m *memory) OutboxTransaction(ctx context.Context, fx func(ctx context.Context) error) error {
+	defer m.lock(ctx)()
+	//KIM: mutations can't be rolled back, but since the memory is
+	// locked, the mutations and their changes are seen together
+	return fx(context.WithValue(ctx, contextKeyTransaction, m))
+}
@@ -18,4 +18,8 @@ func TestMetaMemory(t *testing.T) {
 	defer meta.Shutdown()
 
 	t.Run("Employee CRUD", tests.TestEmployeeCRUD(meta))
+	t.Run("Employee History", tests.TestEmployeeHistory(meta))
+	t.Run("Employee Trash", tests.TestEmployeeTrash(meta))
+	t.Run("Outbox", tests.TestOutbox(meta))
+	t.Run("Outbox Transaction", tests.TestOutboxTransaction(meta))
 }
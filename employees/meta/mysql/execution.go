@@ -3,6 +3,7 @@ package mysql
 import (
 	"context"
 	"database/sql"
+	"encoding/json"
 	"fmt"
 
 	"github.com/antonio-alexander/go-bludgeon/employees/data"
@@ -25,7 +26,7 @@ func rowsAffected(result sql.Result, errIfNoRowsAffected error) error {
 
 func employeeScan(scanFx func(...interface{}) error) (*data.Employee, error) {
 	var firstName, lastName sql.NullString
-	var lastUpdated sql.NullFloat64
+	var lastUpdated, deletedAt sql.NullFloat64
 
 	employee := new(data.Employee)
 	if err := scanFx(
@@ -36,6 +37,7 @@ func employeeScan(scanFx func(...interface{}) error) (*data.Employee, error) {
 		&employee.Version,
 		&lastUpdated,
 		&employee.LastUpdatedBy,
+		&deletedAt,
 	); err != nil {
 		switch {
 		default:
@@ -46,9 +48,40 @@ func employeeScan(scanFx func(...interface{}) error) (*data.Employee, error) {
 	}
 	employee.FirstName, employee.LastName = firstName.String, lastName.String
 	employee.LastUpdated = int64(lastUpdated.Float64 * 1000)
+	employee.DeletedAt = int64(deletedAt.Float64 * 1000)
 	return employee, nil
 }
 
+func outboxChangeScan(scanFx func(...interface{}) error) (*data.OutboxChange, error) {
+	var enqueued sql.NullFloat64
+	var payload sql.NullString
+
+	change := &data.OutboxChange{}
+	if err := scanFx(
+		&change.ID,
+		&change.WhenChanged,
+		&change.ChangedBy,
+		&change.DataId,
+		&change.DataType,
+		&change.DataAction,
+		&change.DataVersion,
+		&payload,
+		&enqueued,
+	); err != nil {
+		switch {
+		default:
+			return nil, err
+		case err == sql.ErrNoRows:
+			return nil, meta.ErrOutboxChangeNotFound
+		}
+	}
+	if payload.Valid && payload.String != "" {
+		change.Payload = json.RawMessage(payload.String)
+	}
+	change.Enqueued = int64(enqueued.Float64 * 1000)
+	return change, nil
+}
+
 func employeeRead(ctx context.Context, db interface {
 	ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error)
 	QueryRowContext(ctx context.Context, query string, args ...interface{}) *sql.Row
@@ -61,8 +94,9 @@ func employeeRead(ctx context.Context, db interface {
 	case int64:
 		condition = fmt.Sprintf("employee_id = (SELECT id FROM %s WHERE aux_id = ?)", tableEmployees)
 	}
+	//KIM: deleted employees (in the trash) can't be read
 	query := fmt.Sprintf(`SELECT employee_id, first_name, last_name, email_address,
-		version, last_updated, last_updated_by FROM %s WHERE %s;`,
+		version, last_updated, last_updated_by, deleted_at FROM %s WHERE %s AND deleted_at IS NULL;`,
 		tableEmployeesV1, condition)
 	row := db.QueryRowContext(ctx, query, id)
 	employee, err := employeeScan(row.Scan)
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/antonio-alexander/go-bludgeon/employees/data"
 	"github.com/antonio-alexander/go-bludgeon/employees/meta"
@@ -19,9 +20,12 @@ import (
 )
 
 const (
-	tableEmployees   string = "employees"
-	tableEmployeesV1 string = "employees_v1"
-	lastUpdatedBy    string = "bludgeon_meta_mysql"
+	tableEmployees         string = "employees"
+	tableEmployeesV1       string = "employees_v1"
+	tableEmployeesAuditV1  string = "employees_audit_v1"
+	tableEmployeesOutbox   string = "employees_outbox"
+	tableEmployeesOutboxV1 string = "employees_outbox_v1"
+	lastUpdatedBy          string = "bludgeon_meta_mysql"
 )
 
 type mysql struct {
@@ -31,8 +35,45 @@ type mysql struct {
 	*internal_mysql.DB
 }
 
+type contextKey string
+
+// contextKeyTransaction is the key of the transaction stored in the
+// context of an outbox transaction
+const contextKeyTransaction contextKey = "transaction"
+
+// transaction is stored in the context of an outbox transaction, mutations
+// made with that context share its (sql) transaction
+type transaction struct {
+	*sql.Tx
+	mysql *mysql
+}
+
+// tx is the transaction returned by begin, if it's shared (i.e., it's the
+// transaction of an outbox transaction) it's only committed (or rolled
+// back) by the outbox transaction
+type tx struct {
+	*sql.Tx
+	shared bool
+}
+
+func (t *tx) Commit() error {
+	if t.shared {
+		return nil
+	}
+	return t.Tx.Commit()
+}
+
+func (t *tx) Rollback() error {
+	if t.shared {
+		return nil
+	}
+	return t.Tx.Rollback()
+}
+
 func New() interface {
 	meta.Employee
+	meta.Purger
+	meta.Outbox
 	internal.Configurer
 	internal.Initializer
 	internal.Parameterizer
@@ -80,7 +121,7 @@ func (m *mysql) EmployeeCreate(ctx context.Context, employeePartial data.Employe
 	args = append(args, lastUpdatedBy)
 	values = append(values, "?")
 	columns = append(columns, "last_updated_by")
-	tx, err := m.Begin()
+	tx, err := m.begin(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -117,7 +158,7 @@ func (m *mysql) EmployeeCreate(ctx context.Context, employeePartial data.Employe
 }
 
 func (m *mysql) EmployeeRead(ctx context.Context, id string) (*data.Employee, error) {
-	return employeeRead(ctx, m, id)
+	return employeeRead(ctx, m.db(ctx), id)
 }
 
 func (m *mysql) EmployeeUpdate(ctx context.Context, id string, employeePartial data.EmployeePartial) (*data.Employee, error) {
@@ -141,13 +182,19 @@ func (m *mysql) EmployeeUpdate(ctx context.Context, id string, employeePartial d
 	}
 	args = append(args, lastUpdatedBy)
 	updates = append(updates, "last_updated_by = ?")
-	tx, err := m.Begin()
+	tx, err := m.begin(ctx)
 	if err != nil {
 		return nil, err
 	}
 	defer tx.Rollback()
+	conditions := []string{"id=?", "deleted_at IS NULL"}
 	args = append(args, id)
-	query := fmt.Sprintf("UPDATE %s SET %s WHERE id=?;", tableEmployees, strings.Join(updates, ","))
+	if version := employeePartial.Version; version != nil {
+		conditions = append(conditions, "version=?")
+		args = append(args, *version)
+	}
+	query := fmt.Sprintf("UPDATE %s SET %s WHERE %s;", tableEmployees,
+		strings.Join(updates, ","), strings.Join(conditions, " AND "))
 	result, err := tx.ExecContext(ctx, query, args...)
 	if err != nil {
 		switch err := err.(type) {
@@ -163,6 +210,17 @@ func (m *mysql) EmployeeUpdate(ctx context.Context, id string, employeePartial d
 		}
 	}
 	if err := rowsAffected(result, meta.ErrEmployeeNotUpdated); err != nil {
+		//KIM: no rows are affected if the employee doesn't exist
+		// or if its version doesn't match
+		if version := employeePartial.Version; version != nil {
+			employee, errRead := employeeRead(ctx, tx, id)
+			if errRead != nil {
+				return nil, errRead
+			}
+			if employee.Version != *version {
+				return nil, meta.ErrEmployeeConflictVersion
+			}
+		}
 		return nil, err
 	}
 	employee, err := employeeRead(ctx, tx, id)
@@ -175,19 +233,110 @@ func (m *mysql) EmployeeUpdate(ctx context.Context, id string, employeePartial d
 	return employee, nil
 }
 
+// EmployeeDelete can be used to delete a single employee given a valid
+// id, the employee is moved to the trash and can be restored until it's
+// purged
 func (m *mysql) EmployeeDelete(ctx context.Context, id string) error {
-	query := fmt.Sprintf("DELETE FROM %s WHERE id = ?", tableEmployees)
-	result, err := m.ExecContext(ctx, query, id)
+	query := fmt.Sprintf("UPDATE %s SET deleted_at = CURRENT_TIMESTAMP(6) WHERE id = ? AND deleted_at IS NULL;", tableEmployees)
+	result, err := m.db(ctx).ExecContext(ctx, query, id)
 	if err != nil {
 		return err
 	}
 	return rowsAffected(result, meta.ErrEmployeeNotFound)
 }
 
+// EmployeeRestore can be used to restore a deleted employee (from the trash)
+func (m *mysql) EmployeeRestore(ctx context.Context, id string) (*data.Employee, error) {
+	tx, err := m.begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+	query := fmt.Sprintf("UPDATE %s SET deleted_at = NULL WHERE id = ? AND deleted_at IS NOT NULL;", tableEmployees)
+	result, err := tx.ExecContext(ctx, query, id)
+	if err != nil {
+		return nil, err
+	}
+	if err := rowsAffected(result, meta.ErrEmployeeNotFound); err != nil {
+		return nil, err
+	}
+	employee, err := employeeRead(ctx, tx, id)
+	if err != nil {
+		return nil, err
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return employee, nil
+}
+
+// EmployeesPurge can be used to permanently delete employees that were
+// deleted at or before the given time, the purged employees (as they
+// were before being purged) are returned
+func (m *mysql) EmployeesPurge(ctx context.Context, deletedBefore int64) ([]*data.Employee, error) {
+	var employees []*data.Employee
+	var parameters []string
+	var args []interface{}
+
+	tx, err := m.begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+	query := fmt.Sprintf("SELECT id FROM %s WHERE deleted_at IS NOT NULL AND deleted_at <= ? ORDER BY id FOR UPDATE;",
+		tableEmployees)
+	rows, err := tx.QueryContext(ctx, query, time.Unix(0, deletedBefore))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		args = append(args, id)
+		parameters = append(parameters, "?")
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	if len(args) <= 0 {
+		return nil, nil
+	}
+	query = fmt.Sprintf(`SELECT employee_id, first_name, last_name, email_address,
+		version, last_updated, last_updated_by, deleted_at FROM %s WHERE employee_id IN(%s) ORDER BY employee_id;`,
+		tableEmployeesV1, strings.Join(parameters, ","))
+	rows, err = tx.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		employee, err := employeeScan(rows.Scan)
+		if err != nil {
+			return nil, err
+		}
+		employees = append(employees, employee)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	//KIM: the audit is removed by the ON DELETE CASCADE of its
+	// foreign key
+	query = fmt.Sprintf("DELETE FROM %s WHERE id IN(%s);", tableEmployees, strings.Join(parameters, ","))
+	if _, err := tx.ExecContext(ctx, query, args...); err != nil {
+		return nil, err
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return employees, nil
+}
+
 func (m *mysql) EmployeesRead(ctx context.Context, search data.EmployeeSearch) ([]*data.Employee, error) {
 	var searchParameters []string
 	var args []interface{}
-	var query string
 
 	if ids := search.IDs; len(ids) > 0 {
 		var parameters []string
@@ -233,15 +382,15 @@ func (m *mysql) EmployeesRead(ctx context.Context, search data.EmployeeSearch) (
 		}
 		searchParameters = append(searchParameters, fmt.Sprintf("first_name IN(%s)", strings.Join(parameters, ",")))
 	}
-	if len(searchParameters) > 0 {
-		query = fmt.Sprintf(`SELECT employee_id, first_name, last_name, email_address,
-		version, last_updated, last_updated_by FROM %s WHERE %s`,
-			tableEmployeesV1, strings.Join(searchParameters, " AND "))
-	} else {
-		query = fmt.Sprintf(`SELECT employee_id, first_name, last_name, email_address,
-		version, last_updated, last_updated_by FROM %s`, tableEmployeesV1)
+	deletedCondition := "deleted_at IS NULL"
+	if deleted := search.Deleted; deleted != nil && *deleted {
+		deletedCondition = "deleted_at IS NOT NULL"
 	}
-	rows, err := m.QueryContext(ctx, query, args...)
+	searchParameters = append(searchParameters, deletedCondition)
+	query := fmt.Sprintf(`SELECT employee_id, first_name, last_name, email_address,
+		version, last_updated, last_updated_by, deleted_at FROM %s WHERE %s`,
+		tableEmployeesV1, strings.Join(searchParameters, " AND "))
+	rows, err := m.db(ctx).QueryContext(ctx, query, args...)
 	if err != nil {
 		switch {
 		default:
@@ -267,3 +416,164 @@ func (m *mysql) EmployeesRead(ctx context.Context, search data.EmployeeSearch) (
 	}
 	return employees, nil
 }
+
+func (m *mysql) EmployeeHistory(ctx context.Context, id string) ([]*data.Employee, error) {
+	var employees []*data.Employee
+
+	query := fmt.Sprintf(`SELECT employee_id, first_name, last_name, email_address,
+		version, last_updated, last_updated_by, deleted_at FROM %s WHERE employee_id = ? ORDER BY version ASC;`,
+		tableEmployeesAuditV1)
+	rows, err := m.db(ctx).QueryContext(ctx, query, id)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		employee, err := employeeScan(rows.Scan)
+		if err != nil {
+			return nil, err
+		}
+		employees = append(employees, employee)
+	}
+	if len(employees) <= 0 {
+		return nil, meta.ErrEmployeeNotFound
+	}
+	return employees, nil
+}
+
+// OutboxEnqueue can be used to add a change to the end of the outbox
+func (m *mysql) OutboxEnqueue(ctx context.Context, change data.OutboxChange) (*data.OutboxChange, error) {
+	tx, err := m.begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+	query := fmt.Sprintf(`INSERT INTO %s(when_changed, changed_by, data_id, data_type, data_action, data_version, payload)
+		VALUES(?, ?, ?, ?, ?, ?, ?);`, tableEmployeesOutbox)
+	result, err := tx.ExecContext(ctx, query, change.WhenChanged, change.ChangedBy,
+		change.DataId, change.DataType, change.DataAction, change.DataVersion,
+		sql.NullString{String: string(change.Payload), Valid: len(change.Payload) > 0})
+	if err != nil {
+		return nil, err
+	}
+	auxID, err := result.LastInsertId()
+	if err != nil {
+		return nil, err
+	}
+	query = fmt.Sprintf(`SELECT outbox_id, when_changed, changed_by, data_id, data_type, data_action,
+		data_version, payload, enqueued FROM %s WHERE aux_id = ?;`, tableEmployeesOutboxV1)
+	outboxChange, err := outboxChangeScan(tx.QueryRowContext(ctx, query, auxID).Scan)
+	if err != nil {
+		return nil, err
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return outboxChange, nil
+}
+
+// OutboxRead can be used to read up to limit of the oldest changes
+// in the outbox (oldest first)
+func (m *mysql) OutboxRead(ctx context.Context, limit int) ([]*data.OutboxChange, error) {
+	var changes []*data.OutboxChange
+	var args []interface{}
+
+	query := fmt.Sprintf(`SELECT outbox_id, when_changed, changed_by, data_id, data_type, data_action,
+		data_version, payload, enqueued FROM %s ORDER BY aux_id ASC`, tableEmployeesOutboxV1)
+	if limit > 0 {
+		query, args = query+" LIMIT ?", append(args, limit)
+	}
+	rows, err := m.db(ctx).QueryContext(ctx, query+";", args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		change, err := outboxChangeScan(rows.Scan)
+		if err != nil {
+			return nil, err
+		}
+		changes = append(changes, change)
+	}
+	return changes, rows.Err()
+}
+
+// OutboxAcknowledge can be used to remove a change from the outbox
+// once it's been delivered
+func (m *mysql) OutboxAcknowledge(ctx context.Context, id string) error {
+	query := fmt.Sprintf("DELETE FROM %s WHERE id = ?;", tableEmployeesOutbox)
+	result, err := m.db(ctx).ExecContext(ctx, query, id)
+	if err != nil {
+		return err
+	}
+	return rowsAffected(result, meta.ErrOutboxChangeNotFound)
+}
+
+// OutboxBacklog can be used to read the number of changes in the
+// outbox that have yet to be delivered
+func (m *mysql) OutboxBacklog(ctx context.Context) (int, error) {
+	var backlog int
+
+	query := fmt.Sprintf("SELECT COUNT(*) FROM %s;", tableEmployeesOutbox)
+	if err := m.db(ctx).QueryRowContext(ctx, query).Scan(&backlog); err != nil {
+		return 0, err
+	}
+	return backlog, nil
+}
+
+// transaction will return the (sql) transaction of the context if it's
+// the context of an outbox transaction
+func (m *mysql) transaction(ctx context.Context) (*sql.Tx, bool) {
+	if ctx == nil {
+		return nil, false
+	}
+	t, ok := ctx.Value(contextKeyTransaction).(*transaction)
+	if !ok || t.mysql != m {
+		return nil, false
+	}
+	return t.Tx, true
+}
+
+// begin will begin a transaction, if the context is the context of an outbox
+// transaction, its transaction is shared
+func (m *mysql) begin(ctx context.Context) (*tx, error) {
+	if t, ok := m.transaction(ctx); ok {
+		return &tx{Tx: t, shared: true}, nil
+	}
+	t, err := m.Begin()
+	if err != nil {
+		return nil, err
+	}
+	return &tx{Tx: t}, nil
+}
+
+// db will return the transaction of the context if it's the context of
+// an outbox transaction (so its mutations can be read), otherwise the
+// database is returned
+func (m *mysql) db(ctx context.Context) interface {
+	Exec(query string, args ...interface{}) (sql.Result, error)
+	ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error)
+	QueryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error)
+	QueryRow(query string, args ...interface{}) *sql.Row
+	QueryRowContext(ctx context.Context, query string, args ...interface{}) *sql.Row
+} {
+	if t, ok := m.transaction(ctx); ok {
+		return t
+	}
+	return m.DB
+}
+
+func (m *mysql) OutboxTransaction(ctx context.Context, fx func(ctx context.Context) error) error {
+	tx, err := m.begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+	if !tx.shared {
+		ctx = context.WithValue(ctx, contextKeyTransaction, &transaction{Tx: tx.Tx, mysql: m})
+	}
+	if err := fx(ctx); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
@@ -50,4 +50,8 @@ func TestMetaMysql(t *testing.T) {
 
 	//test
 	t.Run("Employee CRUD", tests.TestEmployeeCRUD(meta))
+	t.Run("Employee History", tests.TestEmployeeHistory(meta))
+	t.Run("Employee Trash", tests.TestEmployeeTrash(meta))
+	t.Run("Outbox", tests.TestOutbox(meta))
+	t.Run("Outbox Transaction", tests.TestOutboxTransaction(meta))
 }
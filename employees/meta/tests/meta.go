@@ -2,6 +2,7 @@ package tests
 
 import (
 	"context"
+	"errors"
 	"math/rand"
 	"reflect"
 	"testing"
@@ -83,6 +84,20 @@ func TestEmployeeCRUD(m meta.Employee) func(*testing.T) {
 		assert.Equal(t, firstName, employeeUpdated.FirstName)
 		assert.Greater(t, employeeUpdated.Version, employee.Version)
 		assert.Greater(t, employeeUpdated.LastUpdated, employee.LastUpdated)
+		//update (stale version)
+		lastName = randomString(25)
+		_, err = m.EmployeeUpdate(ctx, employee.ID, data.EmployeePartial{
+			LastName: &lastName,
+			Version:  &employee.Version,
+		})
+		assert.True(t, errors.Is(err, meta.ErrEmployeeConflictVersion))
+		//update (current version)
+		employeeUpdated, err = m.EmployeeUpdate(ctx, employee.ID, data.EmployeePartial{
+			LastName: &lastName,
+			Version:  &employeeUpdated.Version,
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, lastName, employeeUpdated.LastName)
 		//delete
 		err = m.EmployeeDelete(ctx, employee.ID)
 		assert.Nil(t, err)
@@ -109,3 +124,247 @@ func TestEmployeesRead(m interface {
 		//TODO: create test
 	}
 }
+
+func TestEmployeeHistory(m meta.Employee) func(*testing.T) {
+	return func(t *testing.T) {
+		firstName, firstNameUpdated := randomString(15), randomString(15)
+		emailAddress := randomString(20) + "@foobar.duck"
+		ctx := context.TODO()
+
+		//create and update employee
+		employee, err := m.EmployeeCreate(ctx, data.EmployeePartial{
+			FirstName:    &firstName,
+			EmailAddress: &emailAddress,
+		})
+		assert.Nil(t, err)
+		_, err = m.EmployeeUpdate(ctx, employee.ID, data.EmployeePartial{
+			FirstName: &firstNameUpdated,
+		})
+		assert.Nil(t, err)
+		//read history
+		employees, err := m.EmployeeHistory(ctx, employee.ID)
+		assert.Nil(t, err)
+		if assert.Len(t, employees, 2) {
+			assert.Equal(t, 1, employees[0].Version)
+			assert.Equal(t, firstName, employees[0].FirstName)
+			assert.Equal(t, 2, employees[1].Version)
+			assert.Equal(t, firstNameUpdated, employees[1].FirstName)
+			for _, employee := range employees {
+				assert.NotEmpty(t, employee.LastUpdatedBy)
+				assert.NotZero(t, employee.LastUpdated)
+			}
+		}
+		//delete employee and read history, the history is kept
+		// until the employee is purged
+		err = m.EmployeeDelete(ctx, employee.ID)
+		assert.Nil(t, err)
+		employees, err = m.EmployeeHistory(ctx, employee.ID)
+		assert.Nil(t, err)
+		if assert.Len(t, employees, 3) {
+			assert.Equal(t, 3, employees[2].Version)
+			assert.NotZero(t, employees[2].DeletedAt)
+		}
+		_, err = m.EmployeeHistory(ctx, randomString(36))
+		assert.True(t, errors.Is(err, meta.ErrEmployeeNotFound))
+	}
+}
+
+func TestEmployeeTrash(m interface {
+	meta.Employee
+	meta.Purger
+}) func(*testing.T) {
+	return func(t *testing.T) {
+		firstName := randomString(15)
+		emailAddress := randomString(20) + "@foobar.duck"
+		ctx := context.TODO()
+
+		//create employee
+		employee, err := m.EmployeeCreate(ctx, data.EmployeePartial{
+			FirstName:    &firstName,
+			EmailAddress: &emailAddress,
+		})
+		assert.Nil(t, err)
+		//delete employee, it should only be found when searching
+		// for deleted employees
+		err = m.EmployeeDelete(ctx, employee.ID)
+		assert.Nil(t, err)
+		err = m.EmployeeDelete(ctx, employee.ID)
+		assert.True(t, errors.Is(err, meta.ErrEmployeeNotFound))
+		_, err = m.EmployeeRead(ctx, employee.ID)
+		assert.True(t, errors.Is(err, meta.ErrEmployeeNotFound))
+		_, err = m.EmployeeUpdate(ctx, employee.ID, data.EmployeePartial{
+			FirstName: &firstName,
+		})
+		assert.True(t, errors.Is(err, meta.ErrEmployeeNotFound))
+		employees, err := m.EmployeesRead(ctx, data.EmployeeSearch{
+			EmailAddress: &emailAddress,
+		})
+		assert.Nil(t, err)
+		assert.Len(t, employees, 0)
+		deleted := true
+		employees, err = m.EmployeesRead(ctx, data.EmployeeSearch{
+			EmailAddress: &emailAddress,
+			Deleted:      &deleted,
+		})
+		assert.Nil(t, err)
+		if assert.Len(t, employees, 1) {
+			assert.Equal(t, employee.ID, employees[0].ID)
+			assert.NotZero(t, employees[0].DeletedAt)
+		}
+		//restore employee
+		employeeRestored, err := m.EmployeeRestore(ctx, employee.ID)
+		assert.Nil(t, err)
+		if assert.NotNil(t, employeeRestored) {
+			assert.Zero(t, employeeRestored.DeletedAt)
+			assert.Greater(t, employeeRestored.Version, employee.Version)
+		}
+		_, err = m.EmployeeRestore(ctx, employee.ID)
+		assert.True(t, errors.Is(err, meta.ErrEmployeeNotFound))
+		employeeRead, err := m.EmployeeRead(ctx, employee.ID)
+		assert.Nil(t, err)
+		assert.Equal(t, employeeRestored, employeeRead)
+		//delete and purge employee
+		err = m.EmployeeDelete(ctx, employee.ID)
+		assert.Nil(t, err)
+		employeesPurged, err := m.EmployeesPurge(ctx, time.Now().Add(-time.Hour).UnixNano())
+		assert.Nil(t, err)
+		for _, employeePurged := range employeesPurged {
+			assert.NotEqual(t, employee.ID, employeePurged.ID)
+		}
+		employeesPurged, err = m.EmployeesPurge(ctx, time.Now().Add(time.Second).UnixNano())
+		assert.Nil(t, err)
+		found := false
+		for _, employeePurged := range employeesPurged {
+			if employeePurged.ID == employee.ID {
+				assert.Equal(t, employeeRestored.EmailAddress, employeePurged.EmailAddress)
+				assert.NotZero(t, employeePurged.DeletedAt)
+				found = true
+			}
+		}
+		assert.True(t, found)
+		_, err = m.EmployeeRestore(ctx, employee.ID)
+		assert.True(t, errors.Is(err, meta.ErrEmployeeNotFound))
+		_, err = m.EmployeeHistory(ctx, employee.ID)
+		assert.True(t, errors.Is(err, meta.ErrEmployeeNotFound))
+	}
+}
+
+func TestOutbox(m meta.Outbox) func(*testing.T) {
+	return func(t *testing.T) {
+		var changes []*data.OutboxChange
+
+		ctx := context.TODO()
+
+		//KIM: the outbox may not be empty, so the backlog is
+		// compared relative to what's already there
+		backlog, err := m.OutboxBacklog(ctx)
+		assert.Nil(t, err)
+		//enqueue changes
+		for _, action := range []string{data.ChangeActionCreate, data.ChangeActionUpdate, data.ChangeActionDelete} {
+			change, err := m.OutboxEnqueue(ctx, data.OutboxChange{
+				WhenChanged: time.Now().UnixNano(),
+				ChangedBy:   randomString(10),
+				DataId:      randomString(36),
+				DataType:    data.ChangeTypeEmployee,
+				DataAction:  action,
+				DataVersion: 1,
+			})
+			assert.Nil(t, err)
+			if assert.NotNil(t, change) {
+				assert.NotEmpty(t, change.ID)
+				assert.NotZero(t, change.Enqueued)
+				assert.Equal(t, action, change.DataAction)
+				changes = append(changes, change)
+			}
+		}
+		backlogEnqueued, err := m.OutboxBacklog(ctx)
+		assert.Nil(t, err)
+		assert.Equal(t, backlog+len(changes), backlogEnqueued)
+		//read changes, they should be read oldest first
+		changesRead, err := m.OutboxRead(ctx, 0)
+		assert.Nil(t, err)
+		if assert.GreaterOrEqual(t, len(changesRead), len(changes)) {
+			changesRead = changesRead[len(changesRead)-len(changes):]
+			for i, change := range changes {
+				assert.Equal(t, change.ID, changesRead[i].ID)
+				assert.Equal(t, change.DataId, changesRead[i].DataId)
+				assert.Equal(t, change.ChangedBy, changesRead[i].ChangedBy)
+			}
+		}
+		changesRead, err = m.OutboxRead(ctx, 1)
+		assert.Nil(t, err)
+		assert.Len(t, changesRead, 1)
+		//acknowledge changes
+		for _, change := range changes {
+			err := m.OutboxAcknowledge(ctx, change.ID)
+			assert.Nil(t, err)
+			err = m.OutboxAcknowledge(ctx, change.ID)
+			assert.True(t, errors.Is(err, meta.ErrOutboxChangeNotFound))
+		}
+		backlogAcknowledged, err := m.OutboxBacklog(ctx)
+		assert.Nil(t, err)
+		assert.Equal(t, backlog, backlogAcknowledged)
+	}
+}
+
+func TestOutboxTransaction(m interface {
+	meta.Employee
+	meta.Outbox
+}) func(*testing.T) {
+	return func(t *testing.T) {
+		var employee *data.Employee
+		var change *data.OutboxChange
+
+		ctx := context.TODO()
+
+		backlog, err := m.OutboxBacklog(ctx)
+		assert.Nil(t, err)
+		//create employee and enqueue its change within a transaction, the
+		// employee should be readable within the transaction
+		firstName, lastName := randomString(15), randomString(15)
+		emailAddress := randomString(20) + "@foobar.duck"
+		err = m.OutboxTransaction(ctx, func(ctx context.Context) error {
+			var err error
+
+			if employee, err = m.EmployeeCreate(ctx, data.EmployeePartial{
+				FirstName:    &firstName,
+				LastName:     &lastName,
+				EmailAddress: &emailAddress,
+			}); err != nil {
+				return err
+			}
+			if _, err := m.EmployeeRead(ctx, employee.ID); err != nil {
+				return err
+			}
+			change, err = m.OutboxEnqueue(ctx, data.OutboxChange{
+				WhenChanged: employee.LastUpdated,
+				ChangedBy:   employee.LastUpdatedBy,
+				DataId:      employee.ID,
+				DataType:    data.ChangeTypeEmployee,
+				DataAction:  data.ChangeActionCreate,
+				DataVersion: employee.Version,
+			})
+			return err
+		})
+		assert.Nil(t, err)
+		if !assert.NotNil(t, employee) || !assert.NotNil(t, change) {
+			return
+		}
+		employeeRead, err := m.EmployeeRead(ctx, employee.ID)
+		assert.Nil(t, err)
+		assert.Equal(t, employee, employeeRead)
+		backlogEnqueued, err := m.OutboxBacklog(ctx)
+		assert.Nil(t, err)
+		assert.Equal(t, backlog+1, backlogEnqueued)
+		err = m.OutboxAcknowledge(ctx, change.ID)
+		assert.Nil(t, err)
+		//validate that an error within the transaction is returned
+		err = m.OutboxTransaction(ctx, func(ctx context.Context) error {
+			return m.EmployeeDelete(ctx, randomString(36))
+		})
+		assert.True(t, errors.Is(err, meta.ErrEmployeeNotFound))
+		//delete employee
+		err = m.EmployeeDelete(ctx, employee.ID)
+		assert.Nil(t, err)
+	}
+}
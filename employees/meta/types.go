@@ -9,26 +9,32 @@ import (
 
 // these constants are used to generate employee specific errors
 const (
-	EmployeeNotFound       string = "employee not found"
-	EmployeeNotUpdated     string = "employee not updated"
-	EmployeeNotCreated     string = "employee not created, email address not provided"
-	EmployeeConflictCreate string = "cannot create employee; email address in use"
-	EmployeeConflictUpdate string = "cannot update employee; email address in use"
+	EmployeeNotFound        string = "employee not found"
+	EmployeeNotUpdated      string = "employee not updated"
+	EmployeeNotCreated      string = "employee not created, email address not provided"
+	EmployeeConflictCreate  string = "cannot create employee; email address in use"
+	EmployeeConflictUpdate  string = "cannot update employee; email address in use"
+	EmployeeConflictVersion string = "cannot update employee; version mismatch"
+	OutboxChangeNotFound    string = "outbox change not found"
 )
 
 // these are error variables used within the employee meta
 var (
-	ErrEmployeeNotFound       = errors.NewNotFound(errors.New(EmployeeNotFound))
-	ErrEmployeeNotUpdated     = errors.NewNotUpdated(errors.New(EmployeeNotUpdated))
-	ErrEmployeeNotCreated     = errors.NewNotCreated(errors.New(EmployeeNotCreated))
-	ErrEmployeeConflictCreate = errors.NewConflict(errors.New(EmployeeConflictCreate))
-	ErrEmployeeConflictUpdate = errors.NewConflict(errors.New(EmployeeConflictUpdate))
+	ErrEmployeeNotFound        = errors.NewNotFound(errors.New(EmployeeNotFound))
+	ErrEmployeeNotUpdated      = errors.NewNotUpdated(errors.New(EmployeeNotUpdated))
+	ErrEmployeeNotCreated      = errors.NewNotCreated(errors.New(EmployeeNotCreated))
+	ErrEmployeeConflictCreate  = errors.NewConflict(errors.New(EmployeeConflictCreate))
+	ErrEmployeeConflictUpdate  = errors.NewConflict(errors.New(EmployeeConflictUpdate))
+	ErrEmployeeConflictVersion = errors.NewConflict(errors.New(EmployeeConflictVersion))
+	ErrOutboxChangeNotFound    = errors.NewNotFound(errors.New(OutboxChangeNotFound))
 )
 
 // SerializedData provides a struct that describes the representation
 // of the data when serialized
 type SerializedData struct {
-	Employees map[string]data.Employee `json:"employees"`
+	Employees        map[string]data.Employee   `json:"employees"`
+	EmployeesHistory map[string][]data.Employee `json:"employees_history,omitempty"`
+	Outbox           []data.OutboxChange        `json:"outbox,omitempty"`
 }
 
 // Serializer is an interface that can be used to convert the contents of
@@ -59,10 +65,54 @@ type Employee interface {
 	EmployeeUpdate(ctx context.Context, id string, e data.EmployeePartial) (*data.Employee, error)
 
 	//EmployeeDelete can be used to delete a single employee given a
-	// valid id
+	// valid id, the employee is moved to the trash and can be restored
+	// until it's purged
 	EmployeeDelete(ctx context.Context, id string) error
 
+	//EmployeeRestore can be used to restore a deleted employee (from
+	// the trash)
+	EmployeeRestore(ctx context.Context, id string) (*data.Employee, error)
+
 	//EmployeesRead can be used to read one or more employees, given a set of
 	// search parameters
 	EmployeesRead(ctx context.Context, search data.EmployeeSearch) ([]*data.Employee, error)
+
+	//EmployeeHistory can be used to read every version of a given
+	// employee (oldest first)
+	EmployeeHistory(ctx context.Context, id string) ([]*data.Employee, error)
+}
+
+// Purger provides an interface that can be used to permanently remove
+// deleted employees
+type Purger interface {
+	//EmployeesPurge can be used to permanently delete employees that
+	// were deleted at or before the given time, the purged employees
+	// (as they were before being purged) are returned
+	EmployeesPurge(ctx context.Context, deletedBefore int64) ([]*data.Employee, error)
+}
+
+// Outbox provides an interface that can be used to durably store
+// changes until they've been delivered
+type Outbox interface {
+	//OutboxEnqueue can be used to add a change to the end of the outbox
+	OutboxEnqueue(ctx context.Context, change data.OutboxChange) (*data.OutboxChange, error)
+
+	//OutboxRead can be used to read up to limit of the oldest changes
+	// in the outbox (oldest first)
+	OutboxRead(ctx context.Context, limit int) ([]*data.OutboxChange, error)
+
+	//OutboxAcknowledge can be used to remove a change from the outbox
+	// once it's been delivered
+	OutboxAcknowledge(ctx context.Context, id string) error
+
+	//OutboxBacklog can be used to read the number of changes in the
+	// outbox that have yet to be delivered
+	OutboxBacklog(ctx context.Context) (int, error)
+
+	//OutboxTransaction can be used to mutate data and enqueue the changes
+	// of those mutations atomically, the mutations and enqueues must use
+	// the context provided to the function (mysql uses a transaction that's
+	// rolled back if the function returns an error, memory and file are
+	// locked for the duration of the function)
+	OutboxTransaction(ctx context.Context, fx func(ctx context.Context) error) error
 }
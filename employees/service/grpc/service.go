@@ -83,7 +83,12 @@ func (s *grpcService) EmployeesRead(ctx context.Context, request *pb.EmployeesRe
 }
 
 func (s *grpcService) EmployeeUpdate(ctx context.Context, request *pb.EmployeeUpdateRequest) (*pb.EmployeeUpdateResponse, error) {
-	employee, err := s.logic.EmployeeUpdate(ctx, request.GetId(), *pb.ToEmployeePartial(request.GetEmployeePartial()))
+	employeePartial := pb.ToEmployeePartial(request.GetEmployeePartial())
+	if request.VersionOneof != nil {
+		version := int(request.GetVersion())
+		employeePartial.Version = &version
+	}
+	employee, err := s.logic.EmployeeUpdate(ctx, request.GetId(), *employeePartial)
 	return &pb.EmployeeUpdateResponse{
 		Employee: pb.FromEmployee(employee),
 	}, err
@@ -93,3 +98,17 @@ func (s *grpcService) EmployeeDelete(ctx context.Context, request *pb.EmployeeDe
 	err := s.logic.EmployeeDelete(ctx, request.GetId())
 	return &pb.EmployeeDeleteResponse{}, err
 }
+
+func (s *grpcService) EmployeeHistory(ctx context.Context, request *pb.EmployeeHistoryRequest) (*pb.EmployeeHistoryResponse, error) {
+	employees, err := s.logic.EmployeeHistory(ctx, request.GetId())
+	return &pb.EmployeeHistoryResponse{
+		Employees: pb.FromEmployees(employees),
+	}, err
+}
+
+func (s *grpcService) EmployeeRestore(ctx context.Context, request *pb.EmployeeRestoreRequest) (*pb.EmployeeRestoreResponse, error) {
+	employee, err := s.logic.EmployeeRestore(ctx, request.GetId())
+	return &pb.EmployeeRestoreResponse{
+		Employee: pb.FromEmployee(employee),
+	}, err
+}
@@ -40,7 +40,7 @@ var (
 	configLogger            = new(internal_logger.Configuration)
 	configServer            = new(internal_server.Configuration)
 	configLogic             = new(logic.Configuration)
-	configChangesClientRest = new(changesclientrest.Configuration)
+	configChangesClientRest = changesclientrest.NewConfiguration()
 	letterRunes             = []rune("abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ")
 )
 
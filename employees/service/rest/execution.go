@@ -3,6 +3,8 @@ package service
 import (
 	"encoding/json"
 	"net/http"
+	"strconv"
+	"strings"
 
 	"github.com/antonio-alexander/go-bludgeon/employees/data"
 	"github.com/antonio-alexander/go-bludgeon/employees/meta"
@@ -12,6 +14,18 @@ import (
 	"github.com/pkg/errors"
 )
 
+// header constants
+const (
+	headerETag    string = "ETag"
+	headerIfMatch string = "If-Match"
+)
+
+// error constants
+const ifMatchInvalid string = "if-match header invalid; expected a version"
+
+// error variables
+var ErrIfMatchInvalid = errors.New(ifMatchInvalid)
+
 func handleResponse(writer http.ResponseWriter, err error, bytes []byte) error {
 	if err != nil {
 		var e internal_errors.Error
@@ -23,7 +37,10 @@ func handleResponse(writer http.ResponseWriter, err error, bytes []byte) error {
 			writer.WriteHeader(http.StatusNotFound)
 		case errors.Is(err, meta.ErrEmployeeNotUpdated):
 			writer.WriteHeader(http.StatusNotModified)
-		case errors.Is(err, meta.ErrEmployeeConflictCreate) || errors.Is(err, meta.ErrEmployeeConflictUpdate):
+		case errors.Is(err, ErrIfMatchInvalid):
+			writer.WriteHeader(http.StatusBadRequest)
+		case errors.Is(err, meta.ErrEmployeeConflictCreate) || errors.Is(err, meta.ErrEmployeeConflictUpdate) ||
+			errors.Is(err, meta.ErrEmployeeConflictVersion):
 			writer.WriteHeader(http.StatusConflict)
 		}
 		switch v := err.(type) {
@@ -54,3 +71,23 @@ func idFromPath(pathVariables map[string]string) string {
 	}
 	return id
 }
+
+// versionFromIfMatch can be used to read the expected version from the
+// If-Match header, nil is returned if the header is absent or a wildcard
+func versionFromIfMatch(request *http.Request) (*int, error) {
+	ifMatch := strings.TrimSpace(request.Header.Get(headerIfMatch))
+	if ifMatch == "" || ifMatch == "*" {
+		return nil, nil
+	}
+	ifMatch = strings.Trim(strings.TrimPrefix(ifMatch, "W/"), `"`)
+	version, err := strconv.Atoi(ifMatch)
+	if err != nil {
+		return nil, ErrIfMatchInvalid
+	}
+	return &version, nil
+}
+
+// setETag can be used to set the ETag header to the provided version
+func setETag(writer http.ResponseWriter, version int) {
+	writer.Header().Set(headerETag, strconv.Quote(strconv.Itoa(version)))
+}
@@ -59,6 +59,7 @@ func (s *restServer) endpointEmployeeRead() func(http.ResponseWriter, *http.Requ
 		id := idFromPath(mux.Vars(request))
 		if bytes, err = io.ReadAll(request.Body); err == nil {
 			if employee, err = s.logic.EmployeeRead(ctx, id); err == nil {
+				setETag(writer, employee.Version)
 				bytes, err = json.Marshal(employee)
 			}
 		}
@@ -92,6 +93,7 @@ func (s *restServer) endpointEmployeeUpdate() func(http.ResponseWriter, *http.Re
 	return func(writer http.ResponseWriter, request *http.Request) {
 		var employeePartial data.EmployeePartial
 		var employee *data.Employee
+		var version *int
 		var bytes []byte
 		var err error
 
@@ -99,8 +101,14 @@ func (s *restServer) endpointEmployeeUpdate() func(http.ResponseWriter, *http.Re
 		id := idFromPath(mux.Vars(request))
 		if bytes, err = io.ReadAll(request.Body); err == nil {
 			if err = json.Unmarshal(bytes, &employeePartial); err == nil {
-				if employee, err = s.logic.EmployeeUpdate(ctx, id, employeePartial); err == nil {
-					bytes, err = json.Marshal(employee)
+				if version, err = versionFromIfMatch(request); err == nil {
+					if version != nil {
+						employeePartial.Version = version
+					}
+					if employee, err = s.logic.EmployeeUpdate(ctx, id, employeePartial); err == nil {
+						setETag(writer, employee.Version)
+						bytes, err = json.Marshal(employee)
+					}
 				}
 			}
 		}
@@ -124,6 +132,41 @@ func (s *restServer) endpointEmployeeDelete() func(http.ResponseWriter, *http.Re
 	}
 }
 
+func (s *restServer) endpointEmployeeHistory() func(http.ResponseWriter, *http.Request) {
+	return func(writer http.ResponseWriter, request *http.Request) {
+		var employees []*data.Employee
+		var bytes []byte
+		var err error
+
+		ctx := request.Context()
+		id := idFromPath(mux.Vars(request))
+		if employees, err = s.logic.EmployeeHistory(ctx, id); err == nil {
+			bytes, err = json.Marshal(employees)
+		}
+		if err = handleResponse(writer, err, bytes); err != nil {
+			s.Error("employee history -  %s", err)
+		}
+	}
+}
+
+func (s *restServer) endpointEmployeeRestore() func(http.ResponseWriter, *http.Request) {
+	return func(writer http.ResponseWriter, request *http.Request) {
+		var employee *data.Employee
+		var bytes []byte
+		var err error
+
+		ctx := request.Context()
+		id := idFromPath(mux.Vars(request))
+		if employee, err = s.logic.EmployeeRestore(ctx, id); err == nil {
+			setETag(writer, employee.Version)
+			bytes, err = json.Marshal(employee)
+		}
+		if err = handleResponse(writer, err, bytes); err != nil {
+			s.Error("employee restore -  %s", err)
+		}
+	}
+}
+
 func (s *restServer) SetParameters(parameters ...interface{}) {
 	for _, parameter := range parameters {
 		switch p := parameter.(type) {
@@ -153,5 +196,7 @@ func (s *restServer) BuildRoutes() []server.HandleFuncConfig {
 		{Route: data.RouteEmployeesID, Method: http.MethodGet, HandleFx: s.endpointEmployeeRead()},
 		{Route: data.RouteEmployeesID, Method: http.MethodPut, HandleFx: s.endpointEmployeeUpdate()},
 		{Route: data.RouteEmployeesID, Method: http.MethodDelete, HandleFx: s.endpointEmployeeDelete()},
+		{Route: data.RouteEmployeesIDHistory, Method: http.MethodGet, HandleFx: s.endpointEmployeeHistory()},
+		{Route: data.RouteEmployeesIDRestore, Method: http.MethodPut, HandleFx: s.endpointEmployeeRestore()},
 	}
 }
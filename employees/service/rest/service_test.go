@@ -40,7 +40,7 @@ var (
 	configMetaFile      = new(internal_file.Configuration)
 	configLogger        = new(internal_logger.Configuration)
 	configServer        = new(internal_server.Configuration)
-	configChangesClient = new(changesclientrest.Configuration)
+	configChangesClient = changesclientrest.NewConfiguration()
 	configLogic         = new(logic.Configuration)
 )
 
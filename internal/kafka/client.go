@@ -2,7 +2,6 @@ package kafka
 
 import (
 	"context"
-	"encoding"
 	"regexp"
 	"strings"
 	"sync"
@@ -13,6 +12,7 @@ import (
 	"github.com/antonio-alexander/go-bludgeon/internal/logger"
 
 	"github.com/Shopify/sarama"
+	"github.com/google/uuid"
 	"github.com/pkg/errors"
 )
 
@@ -28,6 +28,7 @@ type kafka struct {
 	producer      sarama.SyncProducer
 	consumerGroup sarama.ConsumerGroup
 	consumer      sarama.Consumer
+	broker        *memoryBroker
 	initialized   bool
 	configured    bool
 	config        *Configuration
@@ -50,103 +51,382 @@ func New() interface {
 	return k
 }
 
-func (k *kafka) launchConsumer(topic string, stopper chan struct{}) error {
-	var partitionConsumers []sarama.PartitionConsumer
+func headersToSarama(headers Headers) []sarama.RecordHeader {
+	recordHeaders := make([]sarama.RecordHeader, 0, len(headers))
+	for key, value := range headers {
+		recordHeaders = append(recordHeaders, sarama.RecordHeader{
+			Key:   []byte(key),
+			Value: []byte(value),
+		})
+	}
+	return recordHeaders
+}
 
-	//REVIEW: what's the likelihood that new partitions are created?
-	// should we continually check for new partitions for a given topic?
-	partitions, err := k.consumer.Partitions(topic)
-	if err != nil {
-		return err
+func headersFromSarama(recordHeaders []*sarama.RecordHeader) Headers {
+	headers := make(Headers, len(recordHeaders))
+	for _, recordHeader := range recordHeaders {
+		if recordHeader != nil {
+			headers[string(recordHeader.Key)] = string(recordHeader.Value)
+		}
 	}
-	for _, partition := range partitions {
-		partitionConsumer, err := k.consumer.ConsumePartition(topic, partition, sarama.OffsetNewest)
-		if err != nil {
-			for _, partitionConsumer := range partitionConsumers {
-				partitionConsumer.Close()
+	return headers
+}
+
+// contextStopper will return a context that's cancelled when the
+// client is shutdown or the stopper is closed
+func (k *kafka) contextStopper(stopper chan struct{}) (context.Context, context.CancelFunc) {
+	ctx, cancel := context.WithCancel(k.ctx)
+	k.Add(1)
+	go func() {
+		defer k.Done()
+
+		select {
+		case <-stopper:
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+	return ctx, cancel
+}
+
+// handle will execute the handlers for the topic, handlers that return an error
+// are retried (every RetryRate) until they succeed or the context is done, it
+// returns true once every handler has succeeded
+func (k *kafka) handle(ctx context.Context, topic string, headers Headers, bytes []byte) bool {
+	handlers := k.readHandlers(topic)
+	for {
+		var mu sync.Mutex
+
+		failed := make(map[string]HandleHeadersFx)
+		wg := new(sync.WaitGroup)
+		for handlerId, handler := range handlers {
+			wg.Add(1)
+			go func(handlerId string, handler HandleHeadersFx) {
+				defer wg.Done()
+
+				if err := handler(topic, headers, bytes); err != nil {
+					k.Error(logAlias+"error while handling message for topic \"%s\", handlerId \"%s\": %s", topic, handlerId, err)
+					mu.Lock()
+					failed[handlerId] = handler
+					mu.Unlock()
+				}
+			}(handlerId, handler)
+		}
+		wg.Wait()
+		if len(failed) == 0 {
+			return true
+		}
+		select {
+		case <-ctx.Done():
+			return false
+		case <-time.After(k.config.RetryRate):
+		}
+		//KIM: handlers that have been unsubscribed aren't retried
+		handlers = make(map[string]HandleHeadersFx)
+		for handlerId, handler := range k.readHandlers(topic) {
+			if _, ok := failed[handlerId]; ok {
+				handlers[handlerId] = handler
 			}
+		}
+	}
+}
+
+// launchPartitionConsumer will consume the partition starting at the committed offset
+// (or the initial offset if one hasn't been committed), the offset is committed once
+// every handler has succeeded; if the offset manager is nil (no group id), offsets
+// aren't committed and the partition is consumed from the initial offset
+func (k *kafka) launchPartitionConsumer(ctx context.Context, wg *sync.WaitGroup, offsetManager sarama.OffsetManager, topic string, partition int32, initialOffset int64) error {
+	var partitionOffsetManager sarama.PartitionOffsetManager
+	var chOffsetErrors <-chan *sarama.ConsumerError
+	var err error
+
+	offset := initialOffset
+	if offsetManager != nil {
+		if partitionOffsetManager, err = offsetManager.ManagePartition(topic, partition); err != nil {
 			return err
 		}
-		partitionConsumers = append(partitionConsumers, partitionConsumer)
-	}
-	for i, partitionConsumer := range partitionConsumers {
-		started := make(chan struct{})
-		k.Add(1)
-		go func(partition int32, partitionConsumer sarama.PartitionConsumer) {
-			defer k.Done()
-			defer func() {
-				k.Trace(logAlias+"stopped partition consumer for topic \"%s\" (%d)", topic, partition)
-			}()
-
-			k.Trace(logAlias+"launched partition consumer for topic \"%s\" (%d)", topic, partition)
-			chMessages := partitionConsumer.Messages()
-			close(started)
-			for {
-				select {
-				case <-k.ctx.Done():
+		chOffsetErrors = partitionOffsetManager.Errors()
+		offset, _ = partitionOffsetManager.NextOffset()
+	}
+	if offsetManager != nil && offset < 0 {
+		//KIM: if an offset hasn't been committed, the initial offset is
+		// committed so messages published from here on aren't skipped
+		// if we're restarted before a message is handled
+		if offset, err = k.client.GetOffset(topic, partition, initialOffset); err != nil {
+			partitionOffsetManager.AsyncClose()
+			return err
+		}
+		partitionOffsetManager.MarkOffset(offset, "")
+		offsetManager.Commit()
+	}
+	partitionConsumer, err := k.consumer.ConsumePartition(topic, partition, offset)
+	if errors.Is(err, sarama.ErrOffsetOutOfRange) {
+		//KIM: the committed offset is no longer available (e.g. it was
+		// deleted by retention), so we start at the initial offset
+		k.Error(logAlias+"committed offset %d for topic \"%s\" (%d) out of range", offset, topic, partition)
+		partitionConsumer, err = k.consumer.ConsumePartition(topic, partition, initialOffset)
+	}
+	if err != nil {
+		if partitionOffsetManager != nil {
+			partitionOffsetManager.AsyncClose()
+		}
+		return err
+	}
+	started := make(chan struct{})
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		defer func() {
+			if err := partitionConsumer.Close(); err != nil {
+				k.Error(logAlias+"error while closing partition consumer for topic \"%s\" (%d): %s", topic, partition, err)
+			}
+			//KIM: the partition offset manager is released when the
+			// offset manager is closed
+			if partitionOffsetManager != nil {
+				partitionOffsetManager.AsyncClose()
+			}
+			k.Trace(logAlias+"stopped partition consumer for topic \"%s\" (%d)", topic, partition)
+		}()
+
+		k.Trace(logAlias+"launched partition consumer for topic \"%s\" (%d)", topic, partition)
+		chMessages, chErrors := partitionConsumer.Messages(), partitionConsumer.Errors()
+		close(started)
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case err := <-chErrors:
+				if err != nil {
+					k.Error(logAlias+"error while consuming topic \"%s\" (%d): %s", topic, partition, err)
+				}
+			case err := <-chOffsetErrors:
+				if err != nil {
+					k.Error(logAlias+"error while committing offset for topic \"%s\" (%d): %s", topic, partition, err)
+				}
+			case msg, ok := <-chMessages:
+				if !ok {
 					return
-				case msg := <-chMessages:
-					wg := new(sync.WaitGroup)
-					for _, handler := range k.readHandlers(topic) {
-						wg.Add(1)
-						go func(handler HandleFx) {
-							defer wg.Done()
-
-							handler(topic, msg.Value)
-						}(handler)
-					}
-					wg.Wait()
+				}
+				if !k.handle(ctx, topic, headersFromSarama(msg.Headers), msg.Value) {
+					return
+				}
+				if partitionOffsetManager != nil {
+					partitionOffsetManager.MarkOffset(msg.Offset+1, "")
+					offsetManager.Commit()
 				}
 			}
-		}(partitions[i], partitionConsumer)
-		<-started
-	}
+		}
+	}()
+	<-started
 	return nil
 }
 
-func (k *kafka) launchConsumerGroup(topic string, stopper chan struct{}) error {
-	chErr := make(chan error)
-	ctx, cancel := context.WithCancel(k.ctx)
-	started := make(chan struct{})
-	k.Add(2)
+func (k *kafka) launchConsumer(topic string, stopper chan struct{}) error {
+	var offsetManager sarama.OffsetManager
+	var err error
+
+	if k.config.GroupId != "" {
+		if offsetManager, err = sarama.NewOffsetManagerFromClient(k.config.GroupId, k.client); err != nil {
+			return err
+		}
+	}
+	ctx, cancel := k.contextStopper(stopper)
+	wg := new(sync.WaitGroup)
+	//KIM: this is done until the partition consumers have been launched
+	// so the wait group can't be waited on while they're being added
+	wg.Add(1)
+	k.Add(1)
 	go func() {
 		defer k.Done()
 
-		select {
-		case <-k.ctx.Done():
-			cancel()
-		case <-stopper:
-			cancel()
-		case <-ctx.Done():
+		<-ctx.Done()
+		wg.Wait()
+		if offsetManager != nil {
+			if err := offsetManager.Close(); err != nil {
+				k.Error(logAlias+"error while closing offset manager for topic \"%s\": %s", topic, err)
+			}
 		}
+		k.Trace(logAlias+"stopped consumer for topic \"%s\"", topic)
 	}()
-	go func(ctx context.Context) {
+	defer wg.Done()
+
+	launched := make(map[int32]struct{})
+	launchFx := func(initialOffset int64) error {
+		partitions, err := k.consumer.Partitions(topic)
+		if err != nil {
+			return err
+		}
+		for _, partition := range partitions {
+			if _, ok := launched[partition]; ok {
+				continue
+			}
+			if err := k.launchPartitionConsumer(ctx, wg, offsetManager,
+				topic, partition, initialOffset); err != nil {
+				return err
+			}
+			launched[partition] = struct{}{}
+		}
+		return nil
+	}
+	if err := launchFx(k.config.initialOffset()); err != nil {
+		cancel()
+		return err
+	}
+	if k.config.PartitionRefreshRate <= 0 {
+		return nil
+	}
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+
+		tRefresh := time.NewTicker(k.config.PartitionRefreshRate)
+		defer tRefresh.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-tRefresh.C:
+				//KIM: messages published to new partitions were published after
+				// we subscribed, so they're consumed from the oldest offset
+				if err := k.client.RefreshMetadata(topic); err != nil {
+					k.Error(logAlias+"error while refreshing partitions for topic \"%s\": %s", topic, err)
+					continue
+				}
+				if err := launchFx(sarama.OffsetOldest); err != nil {
+					k.Error(logAlias+"error while launching partition consumer for topic \"%s\": %s", topic, err)
+				}
+			}
+		}
+	}()
+	return nil
+}
+
+func (k *kafka) launchConsumerGroup(topic string, stopper chan struct{}) error {
+	ctx, cancel := k.contextStopper(stopper)
+	started := make(chan struct{})
+	k.Add(1)
+	go func() {
 		defer k.Done()
 		defer func() {
+			cancel()
 			k.Trace(logAlias+"stopped consumer group for topic \"%s\"", topic)
 		}()
 
 		topics := []string{topic}
 		close(started)
 		k.Trace(logAlias+"launched partition consumer group for topic \"%s\"", topic)
-		//KIM: this blocks, so it needs to be in a go routine
-		if err := k.consumerGroup.Consume(ctx, topics, k); err != nil {
-			cancel()
+		for {
+			//KIM: this blocks, so it needs to be in a go routine, it returns
+			// when the group is rebalanced so it's called again to join the
+			// new generation (and receive its claims)
+			if err := k.consumerGroup.Consume(ctx, topics, k); err != nil {
+				if errors.Is(err, sarama.ErrClosedConsumerGroup) {
+					return
+				}
+				k.Error(logAlias+"error while consuming group for topic \"%s\": %s", topic, err)
+				select {
+				case <-ctx.Done():
+					return
+				case <-time.After(k.config.RetryRate):
+				}
+			}
 			select {
 			default:
-				chErr <- err
-			case <-chErr:
+			case <-ctx.Done():
+				return
 			}
 		}
-	}(ctx)
+	}()
 	<-started
-	select {
-	default:
-		close(chErr)
-		return nil
-	case err := <-chErr:
-		return err
+	return nil
+}
+
+// launchMemoryConsumer will consume the topic from the in-memory broker, if using
+// a consumer group, only the partitions assigned to this client are consumed, offsets
+// are committed once every handler has succeeded
+func (k *kafka) launchMemoryConsumer(topic string, stopper chan struct{}) {
+	var memberId string
+
+	nPartitions, groupId := k.config.InMemoryPartitions, k.config.GroupId
+	oldest := k.config.ConsumerOffset == ConsumerOffsetOldest
+	offsets := make(map[int32]int64)
+	for partition, offset := range k.broker.initialOffsets(topic, nPartitions, oldest) {
+		if groupId == "" {
+			offsets[int32(partition)] = offset
+			continue
+		}
+		committed, ok := k.broker.offsetRead(groupId, topic, int32(partition))
+		if !ok {
+			//KIM: the initial offset is committed so messages published from
+			// here on aren't skipped if we're restarted before they're handled
+			k.broker.offsetCommit(groupId, topic, int32(partition), offset)
+			committed = offset
+		}
+		offsets[int32(partition)] = committed
 	}
+	if k.config.ConsumerGroup {
+		memberId = k.config.ClientId + "-" + uuid.Must(uuid.NewRandom()).String()
+		k.broker.groupJoin(groupId, topic, memberId, nPartitions, oldest)
+	}
+	ctx, cancel := k.contextStopper(stopper)
+	started := make(chan struct{})
+	k.Add(1)
+	go func() {
+		defer k.Done()
+		defer func() {
+			cancel()
+			if memberId != "" {
+				k.broker.groupLeave(groupId, topic, memberId)
+			}
+			k.Trace(logAlias+"stopped in-memory consumer for topic \"%s\"", topic)
+		}()
+
+		generation := -1
+		assignmentFx := func() []int32 {
+			if memberId == "" {
+				var partitions []int32
+				for partition := range offsets {
+					partitions = append(partitions, partition)
+				}
+				return partitions
+			}
+			//KIM: if the group has changed (a rebalance), consumption
+			// restarts from the committed offsets
+			partitions, g := k.broker.groupAssignment(groupId, topic, memberId)
+			if g != generation {
+				generation = g
+				for _, partition := range partitions {
+					offsets[partition], _ = k.broker.offsetRead(groupId, topic, partition)
+				}
+				k.Trace(logAlias+"rebalanced in-memory consumer for topic \"%s\": %v", topic, partitions)
+			}
+			return partitions
+		}
+		close(started)
+		k.Trace(logAlias+"launched in-memory consumer for topic \"%s\"", topic)
+		for {
+			//KIM: the signal is read before fetching so that messages
+			// published while fetching aren't missed
+			signal := k.broker.signal(topic, nPartitions)
+			for _, partition := range assignmentFx() {
+				for _, message := range k.broker.fetch(topic, partition, offsets[partition]) {
+					if !k.handle(ctx, topic, message.headers, message.value) {
+						return
+					}
+					offsets[partition] = message.offset + 1
+					if groupId != "" {
+						k.broker.offsetCommit(groupId, topic, partition, offsets[partition])
+					}
+				}
+			}
+			select {
+			case <-ctx.Done():
+				return
+			case <-signal:
+			}
+		}
+	}()
+	<-started
 }
 
 // Setup is run at the beginning of a new session, before ConsumeClaim.
@@ -160,21 +440,35 @@ func (k *kafka) Cleanup(sarama.ConsumerGroupSession) error { return nil }
 // Once the Messages() channel is closed, the Handler must finish its processing
 // loop and exit.
 func (k *kafka) ConsumeClaim(session sarama.ConsumerGroupSession, claim sarama.ConsumerGroupClaim) error {
-	wg := new(sync.WaitGroup)
-	topic := claim.Topic()
-	handlers := k.readHandlers(topic)
-	for msg := range claim.Messages() {
-		session.MarkMessage(msg, "")
-		for _, handler := range handlers {
-			wg.Add(1)
-			go func(handler HandleFx, bytes []byte) {
-				defer wg.Done()
-				handler(topic, bytes)
-			}(handler, msg.Value)
+	topic, partition := claim.Topic(), claim.Partition()
+	if initialOffset := claim.InitialOffset(); initialOffset < 0 {
+		//KIM: if an offset hasn't been committed, the initial offset is
+		// committed so messages published from here on aren't skipped
+		// if the group is rebalanced before a message is handled
+		offset, err := k.client.GetOffset(topic, partition, initialOffset)
+		if err != nil {
+			return err
+		}
+		session.MarkOffset(topic, partition, offset, "")
+		session.Commit()
+	}
+	for {
+		select {
+		case <-session.Context().Done():
+			//KIM: the session is done when the group is rebalanced, messages
+			// that haven't been committed are consumed by the new owner
+			return nil
+		case msg, ok := <-claim.Messages():
+			if !ok {
+				return nil
+			}
+			if !k.handle(session.Context(), topic, headersFromSarama(msg.Headers), msg.Value) {
+				return nil
+			}
+			session.MarkMessage(msg, "")
+			session.Commit()
 		}
 	}
-	wg.Wait()
-	return nil
 }
 
 func (k *kafka) SetParameters(parameters ...interface{}) {
@@ -236,6 +530,12 @@ func (k *kafka) Initialize() error {
 	if !k.configured {
 		return errors.New("not configured")
 	}
+	if k.config.InMemory {
+		k.broker = defaultMemoryBroker
+		k.ctx, k.cancel = context.WithCancel(context.Background())
+		k.initialized = true
+		return nil
+	}
 	if k.config.EnableLog {
 		sarama.Logger = k
 	}
@@ -276,6 +576,11 @@ func (k *kafka) Shutdown() {
 	k.cancel()
 	k.deleteTopics()
 	k.Wait()
+	if k.broker != nil {
+		k.broker = nil
+		k.initialized, k.configured = false, false
+		return
+	}
 	if k.config.ConsumerGroup {
 		if err := k.consumerGroup.Close(); err != nil {
 			k.Error(logAlias+"error while closing consumer group: %s", err)
@@ -297,55 +602,100 @@ func (k *kafka) Shutdown() {
 }
 
 func (k *kafka) Publish(topic string, item interface{}) error {
-	var byteEncoder sarama.Encoder
+	return k.publish(topic, sarama.ByteEncoder{}, nil, item)
+}
 
+func (k *kafka) PublishKeyed(topic, key string, item interface{}) error {
+	return k.publish(topic, sarama.StringEncoder(key), nil, item)
+}
+
+func (k *kafka) PublishWithHeaders(topic, key string, headers Headers, item interface{}) error {
+	if key == "" {
+		return k.publish(topic, sarama.ByteEncoder{}, headers, item)
+	}
+	return k.publish(topic, sarama.StringEncoder(key), headers, item)
+}
+
+func (k *kafka) publish(topic string, key sarama.Encoder, headers Headers, item interface{}) error {
 	if !k.initialized {
 		return errors.New("not initialized")
 	}
 
-	switch v := item.(type) {
-	default:
-		return errors.New("unsupported type")
-	case encoding.BinaryMarshaler:
-		bytes, err := v.MarshalBinary()
-		if err != nil {
-			return err
+	contentType := headers[HeaderContentType]
+	if contentType == "" {
+		contentType = k.config.ContentType
+	}
+	codec, err := CodecRead(contentType)
+	if err != nil {
+		return err
+	}
+	bytes, err := codec.Marshal(item)
+	if err != nil {
+		return err
+	}
+	//KIM: the headers are copied so the content type can be
+	// set without modifying the caller's headers
+	messageHeaders := Headers{HeaderContentType: codec.ContentType()}
+	for key, value := range headers {
+		if key != HeaderContentType {
+			messageHeaders[key] = value
 		}
-		byteEncoder = sarama.ByteEncoder(bytes)
-	case []byte:
-		byteEncoder = sarama.ByteEncoder(v)
 	}
-	_, _, err := k.producer.SendMessage(&sarama.ProducerMessage{
+	if k.broker != nil {
+		keyBytes, _ := key.Encode()
+		k.broker.publish(topic, k.config.InMemoryPartitions, keyBytes, messageHeaders, bytes)
+		return nil
+	}
+	_, _, err = k.producer.SendMessage(&sarama.ProducerMessage{
 		Topic:     topic,
-		Key:       sarama.ByteEncoder{},
-		Headers:   []sarama.RecordHeader{},
+		Key:       key,
+		Headers:   headersToSarama(messageHeaders),
 		Timestamp: time.Now(),
 		Offset:    sarama.OffsetNewest,
 		Partition: 0,
-		Value:     byteEncoder,
+		Value:     sarama.ByteEncoder(bytes),
 	})
 	return err
 }
 
 func (k *kafka) Subscribe(topic string, handler HandleFx) (string, error) {
+	return k.SubscribeAck(topic, func(topic string, bytes []byte) error {
+		handler(topic, bytes)
+		return nil
+	})
+}
+
+func (k *kafka) SubscribeAck(topic string, handler HandleAckFx) (string, error) {
+	return k.SubscribeWithHeaders(topic, func(topic string, _ Headers, bytes []byte) error {
+		return handler(topic, bytes)
+	})
+}
+
+func (k *kafka) SubscribeWithHeaders(topic string, handler HandleHeadersFx) (string, error) {
 	if !k.initialized {
 		return "", errors.New("not initialized")
 	}
 	stopper, newTopic := k.upsertTopic(topic)
+	//KIM: the handler is written before the consumer is launched so
+	// that messages aren't consumed (and committed) without a handler
+	handlerId := k.writeHandler(topic, handler)
 	if newTopic {
 		k.Trace(logAlias+"subscribed to topic \"%s\"", topic)
 		switch {
 		default:
 			if err := k.launchConsumer(topic, stopper); err != nil {
+				k.deleteHandlers(topic, handlerId)
 				return "", err
 			}
+		case k.broker != nil:
+			k.launchMemoryConsumer(topic, stopper)
 		case k.config.ConsumerGroup:
 			if err := k.launchConsumerGroup(topic, stopper); err != nil {
+				k.deleteHandlers(topic, handlerId)
 				return "", err
 			}
 		}
 	}
-	handlerId := k.writeHandler(topic, handler)
 	k.Trace(logAlias+"subscribed to topic \"%s\", handlerId \"%s\"", topic, handlerId)
 	return handlerId, nil
 }
@@ -368,10 +718,16 @@ func (k *kafka) Topics(regEx *regexp.Regexp) ([]string, error) {
 	}
 	if regEx != nil {
 		var matchedtopics []string
+		var topics []string
+		var err error
 
-		topics, err := k.client.Topics()
-		if err != nil {
-			return nil, err
+		switch {
+		default:
+			if topics, err = k.client.Topics(); err != nil {
+				return nil, err
+			}
+		case k.broker != nil:
+			topics = k.broker.topicsRead()
 		}
 		for _, topic := range topics {
 			if matches := regEx.FindAllString(topic, 1); len(matches) > 0 {
@@ -380,5 +736,8 @@ func (k *kafka) Topics(regEx *regexp.Regexp) ([]string, error) {
 		}
 		return matchedtopics, nil
 	}
+	if k.broker != nil {
+		return k.broker.topicsRead(), nil
+	}
 	return k.client.Topics()
 }
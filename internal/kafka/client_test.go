@@ -1,6 +1,7 @@
 package kafka_test
 
 import (
+	"errors"
 	"math/rand"
 	"os"
 	"reflect"
@@ -49,6 +50,7 @@ func init() {
 	kafkaConfig = new(internal_kafka.Configuration)
 	kafkaConfig.Default()
 	kafkaConfig.Brokers = kafkaBrokers
+	kafkaConfig.GroupId = generateId()
 	kafkaConfig.FromEnv(envs)
 
 	//create sarama config
@@ -199,6 +201,61 @@ func (k *kafkaClientTest) TestPublishConsumer(t *testing.T) {
 	wg.Wait()
 }
 
+func (k *kafkaClientTest) TestPublishKeyed(t *testing.T) {
+	//generate dynamic constants
+	testTopic := "test.kafka-client"
+	testKey, testBytes := generateId(), []byte(randomString(25))
+	messagesReceived := make(chan *sarama.ConsumerMessage, 2)
+
+	//create sarama consumer and subscribe to topic/partitions
+	consumer, err := sarama.NewConsumerFromClient(k.saramaClient)
+	assert.Nil(t, err)
+	defer func() {
+		if err := consumer.Close(); err != nil {
+			t.Logf("error while closing consumer: %s", err)
+		}
+	}()
+	partitions, err := consumer.Partitions(testTopic)
+	assert.Nil(t, err)
+	for _, partition := range partitions {
+		partitionConsumer, err := consumer.ConsumePartition(testTopic, partition, sarama.OffsetNewest)
+		assert.Nil(t, err)
+		defer func() {
+			if err := partitionConsumer.Close(); err != nil {
+				t.Logf("error while closing partition consumer: %s", err)
+			}
+		}()
+		go func(partitionConsumer sarama.PartitionConsumer) {
+			for m := range partitionConsumer.Messages() {
+				if reflect.DeepEqual(m.Value, testBytes) {
+					messagesReceived <- m
+				}
+			}
+		}(partitionConsumer)
+	}
+
+	//publish keyed message (twice)
+	for i := 0; i < 2; i++ {
+		err = k.kafkaClient.PublishKeyed(testTopic, testKey, testBytes)
+		assert.Nil(t, err)
+	}
+
+	//confirm receipt of messages (with the key, on the same partition)
+	var partition int32 = -1
+	for i := 0; i < 2; i++ {
+		select {
+		case m := <-messagesReceived:
+			assert.Equal(t, testKey, string(m.Key))
+			if partition >= 0 {
+				assert.Equal(t, partition, m.Partition)
+			}
+			partition = m.Partition
+		case <-time.After(10 * time.Second):
+			assert.Fail(t, "unable to confirm message received")
+		}
+	}
+}
+
 func (k *kafkaClientTest) TestSubscribeConsumer(t *testing.T) {
 	var wg sync.WaitGroup
 
@@ -258,17 +315,88 @@ func (k *kafkaClientTest) TestSubscribeConsumer(t *testing.T) {
 	wg.Wait()
 }
 
+func (k *kafkaClientTest) TestSubscribeAck(t *testing.T) {
+	var wg sync.WaitGroup
+	var mu sync.Mutex
+
+	//generate dynamic constants
+	testTopic := "test.kafka-client.ack"
+	start, stopper := make(chan struct{}), make(chan struct{})
+	testBytes := []byte(randomString(25))
+	messageReceived := make(chan struct{})
+	attempts := 0
+
+	//subscribe with a handler that fails twice before succeeding
+	handlerId, err := k.kafkaClient.SubscribeAck(testTopic, func(topic string, bytes []byte) error {
+		if topic != testTopic || !reflect.DeepEqual(bytes, testBytes) {
+			return nil
+		}
+		mu.Lock()
+		defer mu.Unlock()
+		if attempts++; attempts < 3 {
+			return errors.New("failed")
+		}
+		select {
+		default:
+			close(messageReceived)
+		case <-messageReceived:
+		}
+		return nil
+	})
+	assert.Nil(t, err)
+	defer func() { k.kafkaClient.Unsubscribe(testTopic, handlerId) }()
+
+	//periodically publish data
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+
+		tPublish := time.NewTicker(time.Second)
+		defer tPublish.Stop()
+		<-start
+		for {
+			select {
+			case <-stopper:
+				return
+			case <-messageReceived:
+				return
+			case <-tPublish.C:
+				err := k.kafkaClient.Publish(testTopic, testBytes)
+				assert.Nil(t, err)
+			}
+		}
+	}()
+
+	//start the go routines
+	close(start)
+	select {
+	case <-messageReceived:
+	case <-time.After(10 * time.Second):
+		assert.Fail(t, "unable to confirm message received")
+	}
+	mu.Lock()
+	assert.GreaterOrEqual(t, attempts, 3)
+	mu.Unlock()
+
+	//clean up
+	close(stopper)
+	wg.Wait()
+}
+
 func TestKafkaClient(t *testing.T) {
 	k := newKafkaClientTest()
 
 	consumerGroup := false
 	k.initialize(t, consumerGroup)
 	t.Run("Test Publish (Consumer)", k.TestPublishConsumer)
+	t.Run("Test Publish Keyed (Consumer)", k.TestPublishKeyed)
 	t.Run("Test Subscribe (Consumer)", k.TestSubscribeConsumer)
+	t.Run("Test Subscribe Ack (Consumer)", k.TestSubscribeAck)
 	k.shutdown(t)
 
 	consumerGroup = true
 	k.initialize(t, consumerGroup)
 	t.Run("Test Subscribe (Consumer Group)", k.TestSubscribeConsumer)
+	t.Run("Test Subscribe Ack (Consumer Group)", k.TestSubscribeAck)
 	k.shutdown(t)
 }
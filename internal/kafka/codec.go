@@ -0,0 +1,138 @@
+package kafka
+
+import (
+	"encoding"
+	"encoding/json"
+	"sync"
+
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	ContentTypeJSON     string = "application/json"
+	ContentTypeProtobuf string = "application/x-protobuf"
+	ContentTypeRaw      string = "application/octet-stream"
+)
+
+// Codec is used to marshal items that are published and unmarshal messages
+// that are consumed, the codec is selected using the content-type header
+type Codec interface {
+	ContentType() string
+	Marshal(item interface{}) ([]byte, error)
+	Unmarshal(bytes []byte, item interface{}) error
+}
+
+var codecs = struct {
+	sync.RWMutex
+	codecs map[string]Codec
+}{
+	codecs: map[string]Codec{
+		ContentTypeJSON:     &codecJSON{},
+		ContentTypeProtobuf: &codecProtobuf{},
+		ContentTypeRaw:      &codecRaw{},
+	},
+}
+
+// CodecRegister can be used to register a codec (or replace an
+// existing codec) for its content type
+func CodecRegister(codec Codec) {
+	codecs.Lock()
+	defer codecs.Unlock()
+
+	codecs.codecs[codec.ContentType()] = codec
+}
+
+// CodecRead will return the codec registered for the content type
+func CodecRead(contentType string) (Codec, error) {
+	codecs.RLock()
+	defer codecs.RUnlock()
+
+	codec, ok := codecs.codecs[contentType]
+	if !ok {
+		return nil, errors.Wrap(ErrCodecNotFound, contentType)
+	}
+	return codec, nil
+}
+
+// Decode can be used to unmarshal the bytes of a consumed message into the item
+// using the codec for its content-type header, if the message doesn't have a
+// content-type, the bytes are unmarshalled as raw bytes
+func Decode(headers Headers, bytes []byte, item interface{}) error {
+	contentType := headers[HeaderContentType]
+	if contentType == "" {
+		contentType = ContentTypeRaw
+	}
+	codec, err := CodecRead(contentType)
+	if err != nil {
+		return err
+	}
+	return codec.Unmarshal(bytes, item)
+}
+
+type codecJSON struct{}
+
+func (c *codecJSON) ContentType() string {
+	return ContentTypeJSON
+}
+
+func (c *codecJSON) Marshal(item interface{}) ([]byte, error) {
+	return json.Marshal(item)
+}
+
+func (c *codecJSON) Unmarshal(bytes []byte, item interface{}) error {
+	return json.Unmarshal(bytes, item)
+}
+
+type codecProtobuf struct{}
+
+func (c *codecProtobuf) ContentType() string {
+	return ContentTypeProtobuf
+}
+
+func (c *codecProtobuf) Marshal(item interface{}) ([]byte, error) {
+	message, ok := item.(proto.Message)
+	if !ok {
+		return nil, ErrUnsupportedType
+	}
+	return proto.Marshal(message)
+}
+
+func (c *codecProtobuf) Unmarshal(bytes []byte, item interface{}) error {
+	message, ok := item.(proto.Message)
+	if !ok {
+		return ErrUnsupportedType
+	}
+	return proto.Unmarshal(bytes, message)
+}
+
+// codecRaw publishes bytes as-is (or items that can marshal
+// themselves into bytes)
+type codecRaw struct{}
+
+func (c *codecRaw) ContentType() string {
+	return ContentTypeRaw
+}
+
+func (c *codecRaw) Marshal(item interface{}) ([]byte, error) {
+	switch v := item.(type) {
+	default:
+		return nil, ErrUnsupportedType
+	case encoding.BinaryMarshaler:
+		return v.MarshalBinary()
+	case []byte:
+		return v, nil
+	}
+}
+
+func (c *codecRaw) Unmarshal(bytes []byte, item interface{}) error {
+	switch v := item.(type) {
+	default:
+		return ErrUnsupportedType
+	case encoding.BinaryUnmarshaler:
+		return v.UnmarshalBinary(bytes)
+	case *[]byte:
+		*v = append((*v)[:0], bytes...)
+		return nil
+	}
+}
@@ -3,6 +3,7 @@ package kafka
 import (
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/Shopify/sarama"
 	"github.com/google/uuid"
@@ -15,33 +16,91 @@ const (
 	EnvNameKafkaGroupId       string = "BLUDGEON_KAFKA_GROUP_ID"
 	EnvNameKafkaConsumerGroup string = "BLUDGEON_KAFKA_CONSUMER_GROUP"
 	EnvNameKafkaEnableLog     string = "BLUDGEON_KAFKA_ENABLE_LOG"
+	EnvNameKafkaInMemory      string = "BLUDGEON_KAFKA_IN_MEMORY"
+	EnvNameKafkaPartitions    string = "BLUDGEON_KAFKA_IN_MEMORY_PARTITIONS"
+	EnvNameKafkaOffset        string = "BLUDGEON_KAFKA_CONSUMER_OFFSET"
+	EnvNameKafkaRetryRate     string = "BLUDGEON_KAFKA_RETRY_RATE"
+	EnvNameKafkaRefreshRate   string = "BLUDGEON_KAFKA_PARTITION_REFRESH_RATE"
+	EnvNameKafkaContentType   string = "BLUDGEON_KAFKA_CONTENT_TYPE"
 )
 
 const (
-	NoBrokersConfigured  string = "no brokers configured"
-	NoClientIdConfigured string = "no client id configured"
-	NoGroupIdConfigured  string = "no group id configured"
+	ConsumerOffsetNewest string = "newest"
+	ConsumerOffsetOldest string = "oldest"
+)
+
+const (
+	DefaultInMemoryPartitions    int           = 1
+	DefaultInMemoryPartitionSize int           = 10000
+	DefaultConsumerOffset        string        = ConsumerOffsetNewest
+	DefaultRetryRate             time.Duration = time.Second
+	DefaultPartitionRefreshRate  time.Duration = 10 * time.Second
+	DefaultContentType           string        = ContentTypeRaw
+)
+
+const (
+	NoBrokersConfigured   string = "no brokers configured"
+	NoClientIdConfigured  string = "no client id configured"
+	NoGroupIdConfigured   string = "no group id configured"
+	PartitionsLessThanOne string = "partitions less than one"
+	InvalidConsumerOffset string = "invalid consumer offset"
+	RetryRateNotPositive  string = "retry rate must be greater than zero"
 )
 
 var (
-	ErrNoBrokersConfigured  = errors.New(NoBrokersConfigured)
-	ErrNoClientIdConfigured = errors.New(NoClientIdConfigured)
-	ErrNoGroupIdConfigured  = errors.New(NoGroupIdConfigured)
+	ErrNoBrokersConfigured   = errors.New(NoBrokersConfigured)
+	ErrNoClientIdConfigured  = errors.New(NoClientIdConfigured)
+	ErrNoGroupIdConfigured   = errors.New(NoGroupIdConfigured)
+	ErrPartitionsLessThanOne = errors.New(PartitionsLessThanOne)
+	ErrInvalidConsumerOffset = errors.New(InvalidConsumerOffset)
+	ErrRetryRateNotPositive  = errors.New(RetryRateNotPositive)
 )
 
 type Configuration struct {
-	Brokers       []string `json:"brokers"`
-	ClientId      string   `json:"client_id"`
-	GroupId       string   `json:"group_id"`
-	EnableLog     bool     `json:"enable_log"`
-	ConsumerGroup bool     `json:"consumer_group"`
+	Brokers  []string `json:"brokers"`
+	ClientId string   `json:"client_id"`
+
+	//GroupId is the (stable) id offsets are committed under, it's required
+	// when using a consumer group; without a consumer group, offsets are only
+	// committed if it's set, otherwise consumption starts at ConsumerOffset
+	GroupId       string `json:"group_id"`
+	EnableLog     bool   `json:"enable_log"`
+	ConsumerGroup bool   `json:"consumer_group"`
+
+	//InMemory will use an in-process broker (shared by all clients in the process)
+	// rather than connecting to the brokers, InMemoryPartitions is the number of
+	// partitions topics are created with
+	InMemory           bool `json:"in_memory"`
+	InMemoryPartitions int  `json:"in_memory_partitions"`
+
+	//ConsumerOffset is where consumption starts (newest or oldest) for
+	// partitions without a committed offset
+	ConsumerOffset string `json:"consumer_offset"`
+
+	//RetryRate is how often a message is re-delivered to a handler that
+	// returned an error, the offset isn't committed until it succeeds
+	RetryRate time.Duration `json:"retry_rate"`
+
+	//PartitionRefreshRate is how often the partitions of a topic are
+	// read to consume new partitions, if zero, partitions aren't refreshed
+	PartitionRefreshRate time.Duration `json:"partition_refresh_rate"`
+
+	//ContentType is the content type (codec) used to marshal items published
+	// without a content-type header
+	ContentType string `json:"content_type"`
 }
 
 func (c *Configuration) Default() {
 	c.ClientId = uuid.Must(uuid.NewRandom()).String()
-	c.GroupId = uuid.Must(uuid.NewRandom()).String()
+	c.GroupId = ""
 	c.EnableLog = false
 	c.ConsumerGroup = false
+	c.InMemory = false
+	c.InMemoryPartitions = DefaultInMemoryPartitions
+	c.ConsumerOffset = DefaultConsumerOffset
+	c.RetryRate = DefaultRetryRate
+	c.PartitionRefreshRate = DefaultPartitionRefreshRate
+	c.ContentType = DefaultContentType
 }
 
 func (c *Configuration) FromEnv(envs map[string]string) {
@@ -60,21 +119,67 @@ func (c *Configuration) FromEnv(envs map[string]string) {
 	if s, ok := envs[EnvNameKafkaEnableLog]; ok && s != "" {
 		c.EnableLog, _ = strconv.ParseBool(s)
 	}
+	if s, ok := envs[EnvNameKafkaInMemory]; ok && s != "" {
+		c.InMemory, _ = strconv.ParseBool(s)
+	}
+	if s, ok := envs[EnvNameKafkaPartitions]; ok && s != "" {
+		c.InMemoryPartitions, _ = strconv.Atoi(s)
+	}
+	if s, ok := envs[EnvNameKafkaOffset]; ok && s != "" {
+		c.ConsumerOffset = strings.ToLower(s)
+	}
+	if s, ok := envs[EnvNameKafkaRetryRate]; ok && s != "" {
+		i, _ := strconv.ParseInt(s, 10, 64)
+		c.RetryRate = time.Duration(i) * time.Second
+	}
+	if s, ok := envs[EnvNameKafkaRefreshRate]; ok && s != "" {
+		i, _ := strconv.ParseInt(s, 10, 64)
+		c.PartitionRefreshRate = time.Duration(i) * time.Second
+	}
+	if s, ok := envs[EnvNameKafkaContentType]; ok && s != "" {
+		c.ContentType = s
+	}
 }
 
 func (c *Configuration) Validate() error {
-	if len(c.Brokers) == 0 {
+	if len(c.Brokers) == 0 && !c.InMemory {
 		return ErrNoBrokersConfigured
 	}
+	if c.InMemory && c.InMemoryPartitions < 1 {
+		return ErrPartitionsLessThanOne
+	}
+	//KIM: offsets are shared by the members of a consumer group so the
+	// group id must be stable, otherwise messages that were consumed
+	// before a restart would be consumed again (or skipped)
 	if c.ConsumerGroup && c.GroupId == "" {
 		return ErrNoGroupIdConfigured
 	}
 	if c.ClientId == "" {
 		return ErrNoClientIdConfigured
 	}
+	switch c.ConsumerOffset {
+	default:
+		return ErrInvalidConsumerOffset
+	case ConsumerOffsetNewest, ConsumerOffsetOldest:
+	}
+	if c.RetryRate <= 0 {
+		return ErrRetryRateNotPositive
+	}
+	if _, err := CodecRead(c.ContentType); err != nil {
+		return err
+	}
 	return nil
 }
 
+// initialOffset returns the (sarama) offset to start consuming from
+// for partitions without a committed offset
+func (c *Configuration) initialOffset() int64 {
+	if c.ConsumerOffset == ConsumerOffsetOldest {
+		return sarama.OffsetOldest
+	}
+	return sarama.OffsetNewest
+}
+
 func (c *Configuration) ToSarama() ([]string, *sarama.Config) {
 	config := sarama.NewConfig()
 	config.ClientID = c.ClientId
@@ -83,5 +188,12 @@ func (c *Configuration) ToSarama() ([]string, *sarama.Config) {
 	config.Producer.Return.Successes = true
 	config.ChannelBufferSize = 1024
 	config.Consumer.Return.Errors = true
+	//KIM: offsets are committed manually once every handler has
+	// successfully handled the message (at-least-once)
+	config.Consumer.Offsets.AutoCommit.Enable = false
+	config.Consumer.Offsets.Initial = c.initialOffset()
+	if c.PartitionRefreshRate > 0 {
+		config.Metadata.RefreshFrequency = c.PartitionRefreshRate
+	}
 	return c.Brokers, config
 }
@@ -0,0 +1,40 @@
+package kafka
+
+import "context"
+
+type contextKey string
+
+const contextKeyHeaders contextKey = "headers"
+
+// propagatedHeaders are the headers that are stored in (and read from) a context
+// so they can be propagated from a consumed message to the messages it causes
+var propagatedHeaders = []string{HeaderTraceId, HeaderCorrelationId}
+
+// ContextWithHeaders will return a context with the trace and correlation ids
+// of the headers, if the headers have neither, the context is returned as-is
+func ContextWithHeaders(ctx context.Context, headers Headers) context.Context {
+	propagated := HeadersFromContext(ctx)
+	for _, key := range propagatedHeaders {
+		if value := headers[key]; value != "" {
+			propagated[key] = value
+		}
+	}
+	if len(propagated) == 0 {
+		return ctx
+	}
+	return context.WithValue(ctx, contextKeyHeaders, propagated)
+}
+
+// HeadersFromContext will return the trace and correlation ids stored in
+// the context as headers (a copy that can be modified)
+func HeadersFromContext(ctx context.Context) Headers {
+	headers := make(Headers)
+	if ctx == nil {
+		return headers
+	}
+	propagated, _ := ctx.Value(contextKeyHeaders).(Headers)
+	for key, value := range propagated {
+		headers[key] = value
+	}
+	return headers
+}
@@ -0,0 +1,311 @@
+package kafka
+
+import (
+	"hash/fnv"
+	"sort"
+	"sync"
+)
+
+// memoryMessage is a message stored in a partition of the in-memory broker
+type memoryMessage struct {
+	key     []byte
+	headers Headers
+	value   []byte
+	offset  int64
+}
+
+type memoryPartition struct {
+	messages []*memoryMessage
+	offset   int64 //the offset of the next message
+}
+
+// oldest will return the offset of the oldest message available
+func (p *memoryPartition) oldest() int64 {
+	if len(p.messages) == 0 {
+		return p.offset
+	}
+	return p.messages[0].offset
+}
+
+type memoryTopic struct {
+	partitions []*memoryPartition
+	next       int           //the partition for the next message without a key
+	signal     chan struct{} //closed (and replaced) when the topic changes
+}
+
+// memoryGroup tracks the members and the committed offsets of a consumer
+// group, the generation is incremented each time a member joins or leaves
+// so members know to re-read their assignment (a rebalance)
+type memoryGroup struct {
+	offsets    map[string][]int64
+	members    map[string][]string
+	generation map[string]int
+}
+
+// memoryBroker is an in-process stand-in for a kafka broker, it supports
+// topics with partitions, consumer groups and committed offsets
+type memoryBroker struct {
+	sync.RWMutex
+	partitionSize int
+	topics        map[string]*memoryTopic
+	groups        map[string]*memoryGroup
+}
+
+// defaultMemoryBroker is shared by all clients in the process so
+// that they can publish and consume to/from the same topics
+var defaultMemoryBroker = newMemoryBroker(DefaultInMemoryPartitionSize)
+
+func newMemoryBroker(partitionSize int) *memoryBroker {
+	return &memoryBroker{
+		partitionSize: partitionSize,
+		topics:        make(map[string]*memoryTopic),
+		groups:        make(map[string]*memoryGroup),
+	}
+}
+
+// topicUpsert will create the topic if it doesn't exist, the number of
+// partitions is set when the topic is created (the lock must be held)
+func (m *memoryBroker) topicUpsert(topic string, nPartitions int) *memoryTopic {
+	t, ok := m.topics[topic]
+	if ok {
+		return t
+	}
+	if nPartitions < 1 {
+		nPartitions = 1
+	}
+	t = &memoryTopic{signal: make(chan struct{})}
+	for i := 0; i < nPartitions; i++ {
+		t.partitions = append(t.partitions, &memoryPartition{})
+	}
+	m.topics[topic] = t
+	return t
+}
+
+// topicSignal will wake up any consumers waiting on the topic (the lock must be held)
+func (m *memoryBroker) topicSignal(t *memoryTopic) {
+	close(t.signal)
+	t.signal = make(chan struct{})
+}
+
+func (m *memoryBroker) groupRead(groupId string) *memoryGroup {
+	g, ok := m.groups[groupId]
+	if !ok {
+		g = &memoryGroup{
+			offsets:    make(map[string][]int64),
+			members:    make(map[string][]string),
+			generation: make(map[string]int),
+		}
+		m.groups[groupId] = g
+	}
+	return g
+}
+
+// publish will append a message to a partition of the topic, messages with a key
+// are always published to the same partition, messages without one are published
+// to each partition in turn
+func (m *memoryBroker) publish(topic string, nPartitions int, key []byte, headers Headers, value []byte) (int32, int64) {
+	m.Lock()
+	defer m.Unlock()
+
+	t := m.topicUpsert(topic, nPartitions)
+	partition := t.next
+	if len(key) > 0 {
+		hash := fnv.New32a()
+		hash.Write(key)
+		partition = int(hash.Sum32() % uint32(len(t.partitions)))
+	} else {
+		t.next = (t.next + 1) % len(t.partitions)
+	}
+	p := t.partitions[partition]
+	message := &memoryMessage{key: key, headers: headers, value: value, offset: p.offset}
+	p.messages = append(p.messages, message)
+	p.offset++
+	//KIM: the oldest messages are discarded once the partition is
+	// full (similar to retention)
+	if m.partitionSize > 0 && len(p.messages) > m.partitionSize {
+		p.messages = p.messages[len(p.messages)-m.partitionSize:]
+	}
+	m.topicSignal(t)
+	return int32(partition), message.offset
+}
+
+// fetch will read the messages from the partition starting at the offset, if the
+// offset has been discarded, it will start at the oldest message available
+func (m *memoryBroker) fetch(topic string, partition int32, offset int64) []*memoryMessage {
+	m.RLock()
+	defer m.RUnlock()
+
+	t, ok := m.topics[topic]
+	if !ok || int(partition) >= len(t.partitions) {
+		return nil
+	}
+	p := t.partitions[partition]
+	if len(p.messages) == 0 || offset >= p.offset {
+		return nil
+	}
+	i := offset - p.messages[0].offset
+	if i < 0 {
+		i = 0
+	}
+	messages := make([]*memoryMessage, len(p.messages[i:]))
+	copy(messages, p.messages[i:])
+	return messages
+}
+
+// partitions will return the number of partitions for the topic, creating
+// it if it doesn't exist
+func (m *memoryBroker) partitions(topic string, nPartitions int) int {
+	m.Lock()
+	defer m.Unlock()
+
+	return len(m.topicUpsert(topic, nPartitions).partitions)
+}
+
+// initialOffsets will return the offset of the next message (newest) or the
+// oldest message available (oldest) for each partition of the topic
+func (m *memoryBroker) initialOffsets(topic string, nPartitions int, oldest bool) []int64 {
+	m.Lock()
+	defer m.Unlock()
+
+	var offsets []int64
+	for _, p := range m.topicUpsert(topic, nPartitions).partitions {
+		if oldest {
+			offsets = append(offsets, p.oldest())
+			continue
+		}
+		offsets = append(offsets, p.offset)
+	}
+	return offsets
+}
+
+// signal will return a channel that's closed the next time a message is
+// published to the topic or the members of a group for the topic change
+func (m *memoryBroker) signal(topic string, nPartitions int) <-chan struct{} {
+	m.Lock()
+	defer m.Unlock()
+
+	return m.topicUpsert(topic, nPartitions).signal
+}
+
+// groupJoin will add the member to the group for the topic, partitions without
+// a committed offset are set to the newest (or oldest) offset
+func (m *memoryBroker) groupJoin(groupId, topic, memberId string, nPartitions int, oldest bool) {
+	m.Lock()
+	defer m.Unlock()
+
+	t, g := m.topicUpsert(topic, nPartitions), m.groupRead(groupId)
+	offsets := g.offsets[topic]
+	for i, p := range t.partitions {
+		if i >= len(offsets) {
+			offsets = append(offsets, -1)
+		}
+		if offsets[i] >= 0 {
+			continue
+		}
+		offsets[i] = p.offset
+		if oldest {
+			offsets[i] = p.oldest()
+		}
+	}
+	g.offsets[topic] = offsets
+	for _, member := range g.members[topic] {
+		if member == memberId {
+			return
+		}
+	}
+	g.members[topic] = append(g.members[topic], memberId)
+	sort.Strings(g.members[topic])
+	g.generation[topic]++
+	m.topicSignal(t)
+}
+
+// groupLeave will remove the member from the group for the topic, its
+// partitions are assigned to the remaining members
+func (m *memoryBroker) groupLeave(groupId, topic, memberId string) {
+	m.Lock()
+	defer m.Unlock()
+
+	g, ok := m.groups[groupId]
+	if !ok {
+		return
+	}
+	members := g.members[topic]
+	for i, member := range members {
+		if member == memberId {
+			g.members[topic] = append(members[:i:i], members[i+1:]...)
+			g.generation[topic]++
+			if t, ok := m.topics[topic]; ok {
+				m.topicSignal(t)
+			}
+			return
+		}
+	}
+}
+
+// groupAssignment will return the partitions assigned to the member and the
+// generation of the group, partitions are assigned round-robin
+func (m *memoryBroker) groupAssignment(groupId, topic, memberId string) ([]int32, int) {
+	m.RLock()
+	defer m.RUnlock()
+
+	g, ok := m.groups[groupId]
+	if !ok {
+		return nil, 0
+	}
+	t, ok := m.topics[topic]
+	if !ok {
+		return nil, g.generation[topic]
+	}
+	var partitions []int32
+	members := g.members[topic]
+	for i, member := range members {
+		if member != memberId {
+			continue
+		}
+		for partition := i; partition < len(t.partitions); partition += len(members) {
+			partitions = append(partitions, int32(partition))
+		}
+	}
+	return partitions, g.generation[topic]
+}
+
+// offsetCommit will commit the offset (of the next message to consume)
+// for the group
+func (m *memoryBroker) offsetCommit(groupId, topic string, partition int32, offset int64) {
+	m.Lock()
+	defer m.Unlock()
+
+	g := m.groupRead(groupId)
+	offsets := g.offsets[topic]
+	for int(partition) >= len(offsets) {
+		offsets = append(offsets, -1)
+	}
+	offsets[partition] = offset
+	g.offsets[topic] = offsets
+}
+
+// offsetRead will read the committed offset for the group, it will
+// return false if an offset hasn't been committed
+func (m *memoryBroker) offsetRead(groupId, topic string, partition int32) (int64, bool) {
+	m.RLock()
+	defer m.RUnlock()
+
+	g, ok := m.groups[groupId]
+	if !ok || int(partition) >= len(g.offsets[topic]) || g.offsets[topic][partition] < 0 {
+		return 0, false
+	}
+	return g.offsets[topic][partition], true
+}
+
+// topicsRead will return the names of all of the topics
+func (m *memoryBroker) topicsRead() []string {
+	m.RLock()
+	defer m.RUnlock()
+
+	var topics []string
+	for topic := range m.topics {
+		topics = append(topics, topic)
+	}
+	sort.Strings(topics)
+	return topics
+}
@@ -0,0 +1,429 @@
+package kafka_test
+
+import (
+	"context"
+	"errors"
+	"regexp"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	internal "github.com/antonio-alexander/go-bludgeon/internal"
+	internal_kafka "github.com/antonio-alexander/go-bludgeon/internal/kafka"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+func newMemoryConfig(consumerGroup bool, groupId string) *internal_kafka.Configuration {
+	config := new(internal_kafka.Configuration)
+	config.Default()
+	config.InMemory = true
+	config.InMemoryPartitions = 4
+	config.ConsumerGroup = consumerGroup
+	config.GroupId = groupId
+	if groupId == "" {
+		config.GroupId = uuid.Must(uuid.NewRandom()).String()
+	}
+	config.RetryRate = 10 * time.Millisecond
+	return config
+}
+
+func newMemoryClient(t *testing.T, config *internal_kafka.Configuration) interface {
+	internal_kafka.Client
+	internal.Initializer
+	internal.Configurer
+} {
+	kafkaClient := internal_kafka.New()
+	err := kafkaClient.Configure(config)
+	assert.Nil(t, err)
+	err = kafkaClient.Initialize()
+	assert.Nil(t, err)
+	return kafkaClient
+}
+
+// messagesReceive will read messages until n have been received or the timeout elapses
+func messagesReceive(t *testing.T, messages chan string, n int) []string {
+	var received []string
+
+	for i := 0; i < n; i++ {
+		select {
+		case message := <-messages:
+			received = append(received, message)
+		case <-time.After(10 * time.Second):
+			assert.Fail(t, "unable to confirm message received")
+			return received
+		}
+	}
+	return received
+}
+
+func testMemoryPublishSubscribe(t *testing.T) {
+	testTopic := "test.memory." + generateId()
+	messages := make(chan string, 10)
+
+	//create client and subscribe
+	kafkaClient := newMemoryClient(t, newMemoryConfig(false, ""))
+	defer kafkaClient.Shutdown()
+	handlerId, err := kafkaClient.Subscribe(testTopic, func(topic string, bytes []byte) {
+		messages <- string(bytes)
+	})
+	assert.Nil(t, err)
+	assert.NotEmpty(t, handlerId)
+
+	//publish messages (keyed and not keyed)
+	var published []string
+	for i := 0; i < 5; i++ {
+		message := randomString(25)
+		err = kafkaClient.Publish(testTopic, []byte(message))
+		assert.Nil(t, err)
+		published = append(published, message)
+	}
+	testKey := generateId()
+	for i := 0; i < 5; i++ {
+		message := strconv.Itoa(i)
+		err = kafkaClient.PublishKeyed(testTopic, testKey, []byte(message))
+		assert.Nil(t, err)
+		published = append(published, message)
+	}
+
+	//validate messages received (keyed messages are received in order)
+	received := messagesReceive(t, messages, len(published))
+	assert.ElementsMatch(t, published, received)
+	var keyed []string
+	for _, message := range received {
+		if _, err := strconv.Atoi(message); err == nil {
+			keyed = append(keyed, message)
+		}
+	}
+	assert.Equal(t, []string{"0", "1", "2", "3", "4"}, keyed)
+
+	//validate topics
+	topics, err := kafkaClient.Topics(regexp.MustCompile(regexp.QuoteMeta(testTopic)))
+	assert.Nil(t, err)
+	assert.Equal(t, []string{testTopic}, topics)
+
+	//unsubscribe and validate messages no longer received
+	kafkaClient.Unsubscribe(testTopic, handlerId)
+	err = kafkaClient.Publish(testTopic, []byte(randomString(25)))
+	assert.Nil(t, err)
+	select {
+	case <-messages:
+		assert.Fail(t, "unexpected message received")
+	case <-time.After(100 * time.Millisecond):
+	}
+}
+
+func testMemoryConsumerGroup(t *testing.T) {
+	var mu sync.Mutex
+
+	testTopic, groupId := "test.memory."+generateId(), generateId()
+	messages := make(chan string, 100)
+	receivedBy := make(map[string]int)
+
+	//create clients (in the same group) and subscribe
+	var kafkaClients []interface {
+		internal_kafka.Client
+		internal.Initializer
+		internal.Configurer
+	}
+	for i := 0; i < 2; i++ {
+		clientId := strconv.Itoa(i)
+		kafkaClient := newMemoryClient(t, newMemoryConfig(true, groupId))
+		_, err := kafkaClient.Subscribe(testTopic, func(topic string, bytes []byte) {
+			mu.Lock()
+			receivedBy[clientId]++
+			mu.Unlock()
+			messages <- string(bytes)
+		})
+		assert.Nil(t, err)
+		kafkaClients = append(kafkaClients, kafkaClient)
+	}
+
+	//publish messages and validate each is received once
+	var published []string
+	for i := 0; i < 20; i++ {
+		message := randomString(25)
+		err := kafkaClients[0].Publish(testTopic, []byte(message))
+		assert.Nil(t, err)
+		published = append(published, message)
+	}
+	received := messagesReceive(t, messages, len(published))
+	assert.ElementsMatch(t, published, received)
+	select {
+	case <-messages:
+		assert.Fail(t, "unexpected message received")
+	case <-time.After(100 * time.Millisecond):
+	}
+	mu.Lock()
+	assert.NotZero(t, receivedBy["0"])
+	assert.NotZero(t, receivedBy["1"])
+	mu.Unlock()
+
+	//shutdown clients, publish messages while the group has no members
+	for _, kafkaClient := range kafkaClients {
+		kafkaClient.Shutdown()
+	}
+	kafkaClient := newMemoryClient(t, newMemoryConfig(false, ""))
+	defer kafkaClient.Shutdown()
+	published = nil
+	for i := 0; i < 5; i++ {
+		message := randomString(25)
+		err := kafkaClient.Publish(testTopic, []byte(message))
+		assert.Nil(t, err)
+		published = append(published, message)
+	}
+
+	//re-join the group and validate consumption resumes from the committed offsets
+	kafkaClientGroup := newMemoryClient(t, newMemoryConfig(true, groupId))
+	defer kafkaClientGroup.Shutdown()
+	_, err := kafkaClientGroup.Subscribe(testTopic, func(topic string, bytes []byte) {
+		messages <- string(bytes)
+	})
+	assert.Nil(t, err)
+	received = messagesReceive(t, messages, len(published))
+	assert.ElementsMatch(t, published, received)
+}
+
+func testMemoryAcknowledge(t *testing.T) {
+	testTopic, groupId := "test.memory."+generateId(), generateId()
+	messages := make(chan string, 10)
+
+	//create client and subscribe with a handler that fails twice
+	kafkaClient := newMemoryClient(t, newMemoryConfig(false, groupId))
+	attempts := 0
+	_, err := kafkaClient.SubscribeAck(testTopic, func(topic string, bytes []byte) error {
+		if attempts++; attempts < 3 {
+			return errors.New("failed")
+		}
+		messages <- string(bytes)
+		return nil
+	})
+	assert.Nil(t, err)
+
+	//publish message and validate it's re-delivered until acknowledged
+	message := randomString(25)
+	err = kafkaClient.Publish(testTopic, []byte(message))
+	assert.Nil(t, err)
+	received := messagesReceive(t, messages, 1)
+	assert.Equal(t, []string{message}, received)
+	assert.Equal(t, 3, attempts)
+
+	//subscribe with a handler that always fails, publish a message
+	// and shutdown once it's been attempted
+	kafkaClient.Unsubscribe(testTopic)
+	attempted := make(chan struct{}, 100)
+	_, err = kafkaClient.SubscribeAck(testTopic, func(topic string, bytes []byte) error {
+		attempted <- struct{}{}
+		return errors.New("failed")
+	})
+	assert.Nil(t, err)
+	message = randomString(25)
+	err = kafkaClient.Publish(testTopic, []byte(message))
+	assert.Nil(t, err)
+	select {
+	case <-attempted:
+	case <-time.After(10 * time.Second):
+		assert.Fail(t, "unable to confirm message attempted")
+	}
+	kafkaClient.Shutdown()
+
+	//validate that the message (not acknowledged) is consumed
+	// after re-subscribing
+	kafkaClient = newMemoryClient(t, newMemoryConfig(false, groupId))
+	defer kafkaClient.Shutdown()
+	_, err = kafkaClient.Subscribe(testTopic, func(topic string, bytes []byte) {
+		messages <- string(bytes)
+	})
+	assert.Nil(t, err)
+	received = messagesReceive(t, messages, 1)
+	assert.Equal(t, []string{message}, received)
+}
+
+func testMemoryConsumerOffset(t *testing.T) {
+	testTopic := "test.memory." + generateId()
+	messages := make(chan string, 10)
+
+	//publish messages before subscribing
+	kafkaClient := newMemoryClient(t, newMemoryConfig(false, ""))
+	defer kafkaClient.Shutdown()
+	var published []string
+	for i := 0; i < 5; i++ {
+		message := randomString(25)
+		err := kafkaClient.Publish(testTopic, []byte(message))
+		assert.Nil(t, err)
+		published = append(published, message)
+	}
+
+	//validate messages published before subscribing aren't consumed (newest)
+	_, err := kafkaClient.Subscribe(testTopic, func(topic string, bytes []byte) {
+		messages <- string(bytes)
+	})
+	assert.Nil(t, err)
+	select {
+	case <-messages:
+		assert.Fail(t, "unexpected message received")
+	case <-time.After(100 * time.Millisecond):
+	}
+
+	//validate messages published before subscribing are consumed (oldest)
+	for _, consumerGroup := range []bool{false, true} {
+		config := newMemoryConfig(consumerGroup, "")
+		config.ConsumerOffset = internal_kafka.ConsumerOffsetOldest
+		kafkaClientOldest := newMemoryClient(t, config)
+		_, err = kafkaClientOldest.Subscribe(testTopic, func(topic string, bytes []byte) {
+			messages <- string(bytes)
+		})
+		assert.Nil(t, err)
+		received := messagesReceive(t, messages, len(published))
+		assert.ElementsMatch(t, published, received)
+		kafkaClientOldest.Shutdown()
+	}
+}
+
+func testMemoryGroupId(t *testing.T) {
+	testTopic := "test.memory." + generateId()
+	messages := make(chan string, 10)
+
+	//validate a group id is required for a consumer group
+	config := newMemoryConfig(true, "")
+	config.GroupId = ""
+	err := config.Validate()
+	assert.ErrorIs(t, err, internal_kafka.ErrNoGroupIdConfigured)
+
+	//publish messages before subscribing
+	kafkaClient := newMemoryClient(t, newMemoryConfig(false, ""))
+	defer kafkaClient.Shutdown()
+	var published []string
+	for i := 0; i < 5; i++ {
+		message := randomString(25)
+		err := kafkaClient.Publish(testTopic, []byte(message))
+		assert.Nil(t, err)
+		published = append(published, message)
+	}
+
+	//validate that without a group id, offsets aren't committed so
+	// messages are consumed from the consumer offset every time
+	for i := 0; i < 2; i++ {
+		config := newMemoryConfig(false, "")
+		config.GroupId = ""
+		config.ConsumerOffset = internal_kafka.ConsumerOffsetOldest
+		kafkaClientWithoutGroupId := newMemoryClient(t, config)
+		_, err = kafkaClientWithoutGroupId.Subscribe(testTopic, func(topic string, bytes []byte) {
+			messages <- string(bytes)
+		})
+		assert.Nil(t, err)
+		received := messagesReceive(t, messages, len(published))
+		assert.ElementsMatch(t, published, received)
+		kafkaClientWithoutGroupId.Shutdown()
+	}
+}
+
+func testMemoryHeaders(t *testing.T) {
+	type testItem struct {
+		Id string `json:"id"`
+	}
+	type testMessage struct {
+		headers internal_kafka.Headers
+		bytes   []byte
+	}
+
+	testTopic := "test.memory." + generateId()
+	messages := make(chan testMessage, 10)
+
+	//create client and subscribe with headers
+	kafkaClient := newMemoryClient(t, newMemoryConfig(false, ""))
+	defer kafkaClient.Shutdown()
+	_, err := kafkaClient.SubscribeWithHeaders(testTopic, func(topic string, headers internal_kafka.Headers, bytes []byte) error {
+		messages <- testMessage{headers: headers, bytes: bytes}
+		return nil
+	})
+	assert.Nil(t, err)
+
+	//publish item (json) and validate headers and item received
+	traceId, correlationId := generateId(), generateId()
+	item := &testItem{Id: generateId()}
+	headers := internal_kafka.Headers{
+		internal_kafka.HeaderContentType:   internal_kafka.ContentTypeJSON,
+		internal_kafka.HeaderTraceId:       traceId,
+		internal_kafka.HeaderCorrelationId: correlationId,
+	}
+	err = kafkaClient.PublishWithHeaders(testTopic, item.Id, headers, item)
+	assert.Nil(t, err)
+	select {
+	case message := <-messages:
+		assert.Equal(t, headers, message.headers)
+		itemReceived := &testItem{}
+		err = internal_kafka.Decode(message.headers, message.bytes, itemReceived)
+		assert.Nil(t, err)
+		assert.Equal(t, item, itemReceived)
+	case <-time.After(10 * time.Second):
+		assert.Fail(t, "unable to confirm message received")
+	}
+
+	//publish item (protobuf) and validate item received
+	itemProtobuf := wrapperspb.String(randomString(25))
+	err = kafkaClient.PublishWithHeaders(testTopic, "", internal_kafka.Headers{
+		internal_kafka.HeaderContentType: internal_kafka.ContentTypeProtobuf,
+	}, itemProtobuf)
+	assert.Nil(t, err)
+	select {
+	case message := <-messages:
+		itemReceived := &wrapperspb.StringValue{}
+		err = internal_kafka.Decode(message.headers, message.bytes, itemReceived)
+		assert.Nil(t, err)
+		assert.Equal(t, itemProtobuf.GetValue(), itemReceived.GetValue())
+	case <-time.After(10 * time.Second):
+		assert.Fail(t, "unable to confirm message received")
+	}
+
+	//publish bytes (without headers) and validate content type (raw)
+	bytes := []byte(randomString(25))
+	err = kafkaClient.Publish(testTopic, bytes)
+	assert.Nil(t, err)
+	select {
+	case message := <-messages:
+		assert.Equal(t, internal_kafka.ContentTypeRaw, message.headers[internal_kafka.HeaderContentType])
+		var bytesReceived []byte
+		err = internal_kafka.Decode(message.headers, message.bytes, &bytesReceived)
+		assert.Nil(t, err)
+		assert.Equal(t, bytes, bytesReceived)
+	case <-time.After(10 * time.Second):
+		assert.Fail(t, "unable to confirm message received")
+	}
+
+	//publish bytes with the headers of a context and validate that the
+	// trace and correlation ids (only) are propagated through the context
+	ctx := internal_kafka.ContextWithHeaders(context.Background(), headers)
+	err = kafkaClient.PublishWithHeaders(testTopic, "", internal_kafka.HeadersFromContext(ctx), bytes)
+	assert.Nil(t, err)
+	select {
+	case message := <-messages:
+		ctx := internal_kafka.ContextWithHeaders(context.Background(), message.headers)
+		assert.Equal(t, internal_kafka.Headers{
+			internal_kafka.HeaderTraceId:       traceId,
+			internal_kafka.HeaderCorrelationId: correlationId,
+		}, internal_kafka.HeadersFromContext(ctx))
+	case <-time.After(10 * time.Second):
+		assert.Fail(t, "unable to confirm message received")
+	}
+
+	//validate errors for unsupported types and content types
+	err = kafkaClient.Publish(testTopic, item)
+	assert.ErrorIs(t, err, internal_kafka.ErrUnsupportedType)
+	err = kafkaClient.PublishWithHeaders(testTopic, "", internal_kafka.Headers{
+		internal_kafka.HeaderContentType: "application/unknown",
+	}, item)
+	assert.ErrorIs(t, err, internal_kafka.ErrCodecNotFound)
+}
+
+func TestKafkaClientMemory(t *testing.T) {
+	t.Run("Test Publish/Subscribe", testMemoryPublishSubscribe)
+	t.Run("Test Consumer Group", testMemoryConsumerGroup)
+	t.Run("Test Acknowledge", testMemoryAcknowledge)
+	t.Run("Test Consumer Offset", testMemoryConsumerOffset)
+	t.Run("Test Group Id", testMemoryGroupId)
+	t.Run("Test Headers", testMemoryHeaders)
+}
@@ -9,14 +9,14 @@ import (
 type topicHandlers struct {
 	sync.RWMutex
 	topics        map[string]chan struct{}       //topics
-	handlers      map[string]HandleFx            //handlers
+	handlers      map[string]HandleHeadersFx     //handlers
 	topicHandlers map[string]map[string]struct{} //handlers indexed by topic
 }
 
 func newTopicHandlers() *topicHandlers {
 	return &topicHandlers{
 		topics:        make(map[string]chan struct{}),
-		handlers:      make(map[string]HandleFx),
+		handlers:      make(map[string]HandleHeadersFx),
 		topicHandlers: make(map[string]map[string]struct{}),
 	}
 }
@@ -33,17 +33,17 @@ func (t *topicHandlers) upsertTopic(topic string) (chan struct{}, bool) {
 	return stopper, false
 }
 
-func (t *topicHandlers) readHandlers(topic string) map[string]HandleFx {
+func (t *topicHandlers) readHandlers(topic string) map[string]HandleHeadersFx {
 	t.RLock()
 	defer t.RUnlock()
-	handlers := make(map[string]HandleFx)
+	handlers := make(map[string]HandleHeadersFx)
 	for handlerId := range t.topicHandlers[topic] {
 		handlers[handlerId] = t.handlers[handlerId]
 	}
 	return handlers
 }
 
-func (t *topicHandlers) writeHandler(topic string, handler HandleFx) (handlerId string) {
+func (t *topicHandlers) writeHandler(topic string, handler HandleHeadersFx) (handlerId string) {
 	t.Lock()
 	defer t.Unlock()
 	handlerId = uuid.Must(uuid.NewRandom()).String()
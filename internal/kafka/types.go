@@ -7,17 +7,55 @@ import (
 )
 
 const (
-	logAlias  string = "[kafka_client] "
-	ConfigNil string = "config is nil"
+	logAlias        string = "[kafka_client] "
+	ConfigNil       string = "config is nil"
+	CodecNotFound   string = "codec not found"
+	UnsupportedType string = "unsupported type"
 )
 
-var ErrConfigNil = errors.New(ConfigNil)
+var (
+	ErrConfigNil       = errors.New(ConfigNil)
+	ErrCodecNotFound   = errors.New(CodecNotFound)
+	ErrUnsupportedType = errors.New(UnsupportedType)
+)
+
+const (
+	HeaderContentType   string = "content-type"
+	HeaderTraceId       string = "trace-id"
+	HeaderCorrelationId string = "correlation-id"
+)
+
+// Headers are the (kafka) headers of a message, the content-type header
+// is used to select the codec for the message
+type Headers map[string]string
 
 type HandleFx func(topic string, bytes []byte)
 
+// HandleAckFx is a handler that acknowledges a message by returning nil, if
+// it returns an error the message is re-delivered (to that handler) until it
+// succeeds, offsets are only committed once every handler has succeeded
+type HandleAckFx func(topic string, bytes []byte) error
+
+// HandleHeadersFx is a handler that acknowledges a message (see HandleAckFx)
+// and is provided the headers of the message
+type HandleHeadersFx func(topic string, headers Headers, bytes []byte) error
+
 type Client interface {
 	Publish(topic string, item interface{}) (err error)
+	//PublishKeyed can be used to publish an item with a key, items with
+	// the same key are published to the same partition (and stay in order)
+	PublishKeyed(topic, key string, item interface{}) (err error)
+	//PublishWithHeaders can be used to publish an item with a key (optional) and
+	// headers, the item is marshalled using the codec for the content-type header
+	// (or the configured content type if not provided)
+	PublishWithHeaders(topic, key string, headers Headers, item interface{}) (err error)
 	Subscribe(topic string, handler HandleFx) (handlerId string, err error)
+	//SubscribeAck can be used to subscribe with a handler that acknowledges
+	// messages, messages that aren't acknowledged are re-delivered
+	SubscribeAck(topic string, handler HandleAckFx) (handlerId string, err error)
+	//SubscribeWithHeaders can be used to subscribe with a handler that's provided
+	// the headers of each message, use Decode to unmarshal the message
+	SubscribeWithHeaders(topic string, handler HandleHeadersFx) (handlerId string, err error)
 	Unsubscribe(topic string, handlerIds ...string)
 	Topics(regEx *regexp.Regexp) ([]string, error)
 }
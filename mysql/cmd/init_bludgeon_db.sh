@@ -12,6 +12,8 @@ done
 #load the configuration into the sql database
 mysql -uroot < /bludgeon/bludgeon_security.sql
 mysql -uroot < /bludgeon/bludgeon_employees.sql
+mysql -uroot < /bludgeon/bludgeon_projects.sql
+mysql -uroot < /bludgeon/bludgeon_billing.sql
 mysql -uroot < /bludgeon/bludgeon_timers.sql
 mysql -uroot < /bludgeon/bludgeon_time_slices.sql
 mysql -uroot < /bludgeon/bludgeon_changes.sql
@@ -0,0 +1,53 @@
+-- DROP DATABASE IF EXISTS bludgeon;
+CREATE DATABASE IF NOT EXISTS bludgeon;
+
+USE bludgeon;
+
+-- DROP TABLE IF EXISTS rate_cards;
+CREATE TABLE IF NOT EXISTS rate_cards (
+    id VARCHAR(36) PRIMARY KEY NOT NULL DEFAULT (UUID()),
+    employee_id VARCHAR(36),
+    project_id VARCHAR(36),
+    rate BIGINT NOT NULL,
+    currency VARCHAR(3) NOT NULL,
+    effective_from DATETIME(6) NOT NULL,
+    effective_to DATETIME(6),
+    aux_id BIGINT AUTO_INCREMENT,
+    version INT NOT NULL DEFAULT 1,
+    last_updated DATETIME(6) NOT NULL DEFAULT CURRENT_TIMESTAMP(6),
+    last_updated_by TEXT NOT NULL DEFAULT CURRENT_USER,
+    INDEX(aux_id)
+) ENGINE = InnoDB;
+
+-- DROP TRIGGER IF EXISTS rate_cards_audit_info_update;
+CREATE TRIGGER rate_cards_audit_info_update
+BEFORE UPDATE ON rate_cards FOR EACH ROW
+    SET new.id = old.id, new.aux_id = old.aux_id, new.version = old.version+1, new.last_updated = CURRENT_TIMESTAMP(6), new.last_updated_by = CURRENT_USER;
+
+-- DROP TABLE IF EXISTS invoices;
+CREATE TABLE IF NOT EXISTS invoices (
+    id VARCHAR(36) PRIMARY KEY NOT NULL DEFAULT (UUID()),
+    employee_id VARCHAR(36),
+    project_id VARCHAR(36),
+    start DATETIME(6) NOT NULL,
+    finish DATETIME(6) NOT NULL,
+    currency VARCHAR(3) NOT NULL,
+    total BIGINT NOT NULL DEFAULT 0,
+    aux_id BIGINT AUTO_INCREMENT,
+    version INT NOT NULL DEFAULT 1,
+    last_updated DATETIME(6) NOT NULL DEFAULT CURRENT_TIMESTAMP(6),
+    last_updated_by TEXT NOT NULL DEFAULT CURRENT_USER,
+    INDEX(aux_id)
+) ENGINE = InnoDB;
+
+-- DROP TABLE IF EXISTS invoice_line_items;
+CREATE TABLE IF NOT EXISTS invoice_line_items (
+    invoice_id VARCHAR(36) NOT NULL,
+    timer_id VARCHAR(36) NOT NULL,
+    rate_card_id VARCHAR(36) NOT NULL,
+    elapsed_time BIGINT NOT NULL,
+    rate BIGINT NOT NULL,
+    amount BIGINT NOT NULL,
+    PRIMARY KEY (invoice_id, timer_id),
+    FOREIGN KEY (invoice_id) REFERENCES invoices(id) ON DELETE CASCADE
+) ENGINE = InnoDB;
@@ -14,6 +14,7 @@ CREATE TABLE IF NOT EXISTS changes (
     action TEXT,
     when_changed DATETIME(6) NOT NULL DEFAULT CURRENT_TIMESTAMP(6),
     changed_by TEXT NOT NULL DEFAULT CURRENT_USER,
+    payload JSON,
     INDEX(aux_id),
     UNIQUE(data_id, version, type, service, action)
 ) ENGINE = InnoDB;
@@ -22,6 +23,11 @@ CREATE TABLE IF NOT EXISTS changes (
 CREATE TABLE IF NOT EXISTS registrations (
     id VARCHAR(36) PRIMARY KEY NOT NULL,
     aux_id BIGINT AUTO_INCREMENT,
+    filter_service_names JSON,
+    filter_types JSON,
+    filter_actions JSON,
+    last_seen DATETIME(6) NOT NULL DEFAULT CURRENT_TIMESTAMP(6),
+    last_acknowledged DATETIME(6),
     INDEX(aux_id)
 ) ENGINE = InnoDB;
 
@@ -29,10 +35,50 @@ CREATE TABLE IF NOT EXISTS registrations (
 CREATE TABLE IF NOT EXISTS registration_changes (
     registration_id VARCHAR(36) NOT NULL,
     change_id VARCHAR(36) NOT NULL,
+    attempts INT NOT NULL DEFAULT 0,
+    leased_until DATETIME(6),
     FOREIGN KEY (registration_id)
         REFERENCES registrations(id)
         ON DELETE CASCADE,
     FOREIGN KEY (change_id)
         REFERENCES changes(id),
     PRIMARY KEY(registration_id, change_id)
-) ENGINE = InnoDB;
\ No newline at end of file
+) ENGINE = InnoDB;
+
+-- DROP TABLE IF EXISTS registration_dead_letters;
+CREATE TABLE IF NOT EXISTS registration_dead_letters (
+    registration_id VARCHAR(36) NOT NULL,
+    change_id VARCHAR(36) NOT NULL,
+    attempts INT NOT NULL DEFAULT 0,
+    dead_lettered DATETIME(6) NOT NULL DEFAULT CURRENT_TIMESTAMP(6),
+    FOREIGN KEY (registration_id)
+        REFERENCES registrations(id)
+        ON DELETE CASCADE,
+    FOREIGN KEY (change_id)
+        REFERENCES changes(id),
+    PRIMARY KEY(registration_id, change_id)
+) ENGINE = InnoDB;
+
+-- DROP TABLE IF EXISTS registration_webhooks;
+CREATE TABLE IF NOT EXISTS registration_webhooks (
+    registration_id VARCHAR(36) PRIMARY KEY NOT NULL,
+    url TEXT NOT NULL,
+    secret TEXT,
+    FOREIGN KEY (registration_id)
+        REFERENCES registrations(id)
+        ON DELETE CASCADE
+) ENGINE = InnoDB;
+
+-- DROP TABLE IF EXISTS registration_replays;
+CREATE TABLE IF NOT EXISTS registration_replays (
+    registration_id VARCHAR(36) PRIMARY KEY NOT NULL,
+    search JSON,
+    status TEXT NOT NULL,
+    last_change_id VARCHAR(36),
+    last_when_changed BIGINT NOT NULL DEFAULT 0,
+    replayed INT NOT NULL DEFAULT 0,
+    error TEXT,
+    FOREIGN KEY (registration_id)
+        REFERENCES registrations(id)
+        ON DELETE CASCADE
+) ENGINE = InnoDB;
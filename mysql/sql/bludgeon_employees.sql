@@ -9,6 +9,7 @@ CREATE TABLE IF NOT EXISTS employees (
     first_name TEXT DEFAULT '',
     last_name TEXT DEFAULT '',
     email_address TEXT NOT NULL,
+    deleted_at DATETIME(6),
     aux_id BIGINT AUTO_INCREMENT,
     version INT NOT NULL DEFAULT 1,
     last_updated DATETIME(6) NOT NULL DEFAULT CURRENT_TIMESTAMP(6),
@@ -28,6 +29,7 @@ CREATE TABLE IF NOT EXISTS employees_audit (
     first_name TEXT,
     last_name TEXT,
     email_address TEXT,
+    deleted_at DATETIME(6),
     version INT NOT NULL,
     last_updated DATETIME(6) NOT NULL,
     last_updated_by TEXT NOT NULL,
@@ -38,11 +40,26 @@ CREATE TABLE IF NOT EXISTS employees_audit (
 -- DROP TRIGGER IF EXISTS employees_audit_insert;
 CREATE TRIGGER employees_audit_insert
 AFTER INSERT ON employees FOR EACH ROW
-    INSERT INTO employees_audit(employee_id, first_name, last_name, email_address, version, last_updated, last_updated_by)
-     VALUES (new.id, new.first_name,  new.last_name, new.email_address, new.version, new.last_updated, new.last_updated_by);
+    INSERT INTO employees_audit(employee_id, first_name, last_name, email_address, deleted_at, version, last_updated, last_updated_by)
+     VALUES (new.id, new.first_name,  new.last_name, new.email_address, new.deleted_at, new.version, new.last_updated, new.last_updated_by);
 
 -- DROP TRIGGER IF EXISTS employees_audit_update;
 CREATE TRIGGER employees_audit_update
 AFTER UPDATE ON employees FOR EACH ROW
-    INSERT INTO employees_audit(employee_id, first_name, last_name, email_address, version, last_updated, last_updated_by)
-     VALUES(new.id, new.first_name,  new.last_name, new.email_address, new.version, new.last_updated, new.last_updated_by);
+    INSERT INTO employees_audit(employee_id, first_name, last_name, email_address, deleted_at, version, last_updated, last_updated_by)
+     VALUES(new.id, new.first_name,  new.last_name, new.email_address, new.deleted_at, new.version, new.last_updated, new.last_updated_by);
+
+-- DROP TABLE IF EXISTS employees_outbox;
+CREATE TABLE IF NOT EXISTS employees_outbox (
+    id VARCHAR(36) PRIMARY KEY NOT NULL DEFAULT (UUID()),
+    when_changed BIGINT NOT NULL DEFAULT 0,
+    changed_by TEXT NOT NULL DEFAULT "",
+    data_id VARCHAR(36) NOT NULL,
+    data_type TEXT NOT NULL,
+    data_action TEXT NOT NULL,
+    data_version INT NOT NULL DEFAULT 0,
+    payload JSON,
+    aux_id BIGINT AUTO_INCREMENT,
+    enqueued DATETIME(6) NOT NULL DEFAULT CURRENT_TIMESTAMP(6),
+    INDEX(aux_id)
+) ENGINE = InnoDB;
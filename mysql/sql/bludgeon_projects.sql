@@ -0,0 +1,48 @@
+-- DROP DATABASE IF EXISTS bludgeon;
+CREATE DATABASE IF NOT EXISTS bludgeon;
+
+USE bludgeon;
+
+-- DROP TABLE IF EXISTS projects;
+CREATE TABLE IF NOT EXISTS projects (
+    id VARCHAR(36) PRIMARY KEY NOT NULL DEFAULT (UUID()),
+    name VARCHAR(255) NOT NULL,
+    description TEXT NOT NULL DEFAULT "",
+    archived BOOLEAN NOT NULL DEFAULT FALSE,
+    aux_id BIGINT AUTO_INCREMENT,
+    version INT NOT NULL DEFAULT 1,
+    last_updated DATETIME(6) NOT NULL DEFAULT CURRENT_TIMESTAMP(6),
+    last_updated_by TEXT NOT NULL DEFAULT CURRENT_USER,
+    INDEX(aux_id),
+    UNIQUE(name)
+) ENGINE = InnoDB;
+
+-- DROP TRIGGER IF EXISTS projects_audit_info_update;
+CREATE TRIGGER projects_audit_info_update
+BEFORE UPDATE ON projects FOR EACH ROW
+    SET new.id = old.id, new.aux_id = old.aux_id, new.version = old.version+1, new.last_updated = CURRENT_TIMESTAMP(6), new.last_updated_by = CURRENT_USER;
+
+-- DROP TABLE IF EXISTS projects_audit;
+CREATE TABLE IF NOT EXISTS projects_audit (
+    project_id VARCHAR(36) NOT NULL,
+    name VARCHAR(255),
+    description TEXT,
+    archived BOOLEAN,
+    version INT NOT NULL,
+    last_updated DATETIME(6) NOT NULL,
+    last_updated_by TEXT NOT NULL,
+    PRIMARY KEY (project_id, version),
+    FOREIGN KEY (project_id) REFERENCES projects(id) ON DELETE CASCADE
+) ENGINE = InnoDB;
+
+-- DROP TRIGGER IF EXISTS projects_audit_insert;
+CREATE TRIGGER projects_audit_insert
+AFTER INSERT ON projects FOR EACH ROW
+    INSERT INTO projects_audit(project_id, name, description, archived, version, last_updated, last_updated_by)
+     VALUES(new.id, new.name, new.description, new.archived, new.version, new.last_updated, new.last_updated_by);
+
+-- DROP TRIGGER IF EXISTS projects_audit_update;
+CREATE TRIGGER projects_audit_update
+AFTER UPDATE ON projects FOR EACH ROW
+    INSERT INTO projects_audit(project_id, name, description, archived, version, last_updated, last_updated_by)
+    VALUES(new.id, new.name, new.description, new.archived, new.version, new.last_updated, new.last_updated_by);
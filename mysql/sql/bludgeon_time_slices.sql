@@ -36,7 +36,7 @@ DELIMITER $$
 CREATE TRIGGER validate_time_slice_start_insert
 BEFORE INSERT
     ON time_slices FOR EACH ROW BEGIN
-        IF (SELECT COUNT(*) FROM (SELECT id, start, finish FROM (SELECT id, start, finish FROM time_slices WHERE timer_id = new.timer_id ) AS timer_time_slices WHERE new.start BETWEEN timer_time_slices.start AND timer_time_slices.finish) AS conflict_time_slices) > 0
+        IF (SELECT COUNT(*) FROM (SELECT id, start, finish FROM (SELECT id, start, finish FROM time_slices WHERE timer_id = new.timer_id ) AS timer_time_slices WHERE new.start >= timer_time_slices.start AND new.start < timer_time_slices.finish) AS conflict_time_slices) > 0
         THEN
             SIGNAL SQLSTATE '45000'
                 SET MESSAGE_TEXT = 'Cannot insert time slice, start conflicts with existing time slices';
@@ -49,7 +49,7 @@ DELIMITER $$
 CREATE TRIGGER validate_time_slice_start_update
 BEFORE INSERT
     ON time_slices FOR EACH ROW BEGIN
-        IF (SELECT COUNT(*) FROM (SELECT id, start, finish FROM (SELECT id, start, finish FROM time_slices WHERE timer_id = new.timer_id ) AS timer_time_slices WHERE new.start BETWEEN timer_time_slices.start AND timer_time_slices.finish AND id <> new.id) AS conflict_time_slices) > 0
+        IF (SELECT COUNT(*) FROM (SELECT id, start, finish FROM (SELECT id, start, finish FROM time_slices WHERE timer_id = new.timer_id ) AS timer_time_slices WHERE new.start >= timer_time_slices.start AND new.start < timer_time_slices.finish AND id <> new.id) AS conflict_time_slices) > 0
         THEN
             SIGNAL SQLSTATE '45000'
                 SET MESSAGE_TEXT = 'Cannot update time slice, start conflicts with existing time slices';
@@ -62,7 +62,7 @@ DELIMITER $$
 CREATE TRIGGER validate_active_time_slice_insert
 BEFORE INSERT
     ON time_slices FOR EACH ROW BEGIN
-        IF (SELECT COUNT(*) FROM (SELECT id FROM time_slices WHERE timer_id = new.timer_id AND finish IS NULL) AS validate_active_time_slice) > 0
+        IF new.finish IS NULL AND (SELECT COUNT(*) FROM (SELECT id FROM time_slices WHERE timer_id = new.timer_id AND finish IS NULL) AS validate_active_time_slice) > 0
         THEN
             SIGNAL SQLSTATE '45000'
                 SET MESSAGE_TEXT = 'Cannot insert time slice, active time slice already exists for timer';
@@ -75,7 +75,7 @@ DELIMITER $$
 CREATE TRIGGER validate_active_time_slice_update
 BEFORE UPDATE
     ON time_slices FOR EACH ROW BEGIN
-        IF (SELECT COUNT(*) FROM (SELECT id FROM time_slices WHERE timer_id = new.timer_id AND finish IS NULL AND id <> new.id) AS validate_active_time_slice) > 0
+        IF new.finish IS NULL AND (SELECT COUNT(*) FROM (SELECT id FROM time_slices WHERE timer_id = new.timer_id AND finish IS NULL AND id <> new.id) AS validate_active_time_slice) > 0
         THEN
             SIGNAL SQLSTATE '45000'
                 SET MESSAGE_TEXT = 'Cannot update time slice, active time slice already exists for timer';
@@ -101,7 +101,7 @@ CREATE TABLE IF NOT EXISTS time_slices_audit (
     last_updated DATETIME(6) NOT NULL,
     last_updated_by TEXT NOT NULL,
     PRIMARY KEY (time_slice_id, version),
-    FOREIGN KEY (time_slice_id) REFERENCES time_slices(id) ON DELETE CASCADE
+    FOREIGN KEY (timer_id) REFERENCES timers(id) ON DELETE CASCADE
 ) ENGINE = InnoDB;
 
 -- DROP TRIGGER IF EXISTS time_slices_audit_insert;
@@ -10,10 +10,15 @@ CREATE TABLE IF NOT EXISTS timers (
     archived BOOLEAN NOT NULL DEFAULT FALSE,
     completed BOOLEAN NOT NULL DEFAULT FALSE,
     employee_id VARCHAR(36),
+    project_id VARCHAR(36),
+    invoice_id VARCHAR(36),
+    deleted_at DATETIME(6),
     aux_id BIGINT AUTO_INCREMENT,
     version INT NOT NULL DEFAULT 1,
     last_updated DATETIME(6) NOT NULL DEFAULT CURRENT_TIMESTAMP(6),
     last_updated_by TEXT NOT NULL DEFAULT CURRENT_USER,
+    FOREIGN KEY (project_id) REFERENCES projects(id) ON DELETE SET NULL,
+    FOREIGN KEY (invoice_id) REFERENCES invoices(id) ON DELETE SET NULL,
     INDEX(aux_id)
 ) ENGINE = InnoDB;
 
@@ -25,10 +30,17 @@ BEFORE UPDATE ON timers FOR EACH ROW
 -- DROP TABLE IF EXISTS timers_audit;
 CREATE TABLE IF NOT EXISTS timers_audit (
     timer_id VARCHAR(36) NOT NULL,
+    start DATETIME(6),
+    finish DATETIME(6),
+    elapsed_time DECIMAL(20, 6),
+    active_time_slice_id VARCHAR(36),
     comment TEXT ,
     archived BOOLEAN,
     completed BOOLEAN,
     employee_id VARCHAR(36),
+    project_id VARCHAR(36),
+    invoice_id VARCHAR(36),
+    deleted_at DATETIME(6),
     version INT NOT NULL,
     last_updated DATETIME(6) NOT NULL,
     last_updated_by TEXT NOT NULL,
@@ -39,11 +51,36 @@ CREATE TABLE IF NOT EXISTS timers_audit (
 -- DROP TRIGGER IF EXISTS timers_audit_insert;
 CREATE TRIGGER timers_audit_insert
 AFTER INSERT ON timers FOR EACH ROW
-    INSERT INTO timers_audit(timer_id, comment, archived, completed, employee_id, version, last_updated, last_updated_by)
-     VALUES(new.id, new.comment, new.archived, new.completed, new.employee_id, new.version, new.last_updated, new.last_updated_by);
+    INSERT INTO timers_audit(timer_id, start, finish, elapsed_time, active_time_slice_id, comment, archived, completed, employee_id, project_id, invoice_id, deleted_at, version, last_updated, last_updated_by)
+    VALUES(new.id,
+        (SELECT MIN(start) FROM time_slices WHERE timer_id = new.id),
+        IF(new.completed, (SELECT MAX(finish) FROM time_slices WHERE timer_id = new.id), NULL),
+        (SELECT SUM(TIME_TO_SEC(elapsed_time)) FROM time_slices WHERE timer_id = new.id),
+        (SELECT id FROM time_slices WHERE finish IS NULL AND timer_id = new.id),
+        new.comment, new.archived, new.completed, new.employee_id, new.project_id, new.invoice_id, new.deleted_at, new.version, new.last_updated, new.last_updated_by);
 
 -- DROP TRIGGER IF EXISTS timers_audit_update;
 CREATE TRIGGER timers_audit_update
 AFTER UPDATE ON timers FOR EACH ROW
-    INSERT INTO timers_audit(timer_id, comment, archived, completed, employee_id, version, last_updated, last_updated_by)
-    VALUES(new.id, new.comment, new.archived, new.completed, new.employee_id, new.version, new.last_updated, new.last_updated_by);
+    INSERT INTO timers_audit(timer_id, start, finish, elapsed_time, active_time_slice_id, comment, archived, completed, employee_id, project_id, invoice_id, deleted_at, version, last_updated, last_updated_by)
+    VALUES(new.id,
+        (SELECT MIN(start) FROM time_slices WHERE timer_id = new.id),
+        IF(new.completed, (SELECT MAX(finish) FROM time_slices WHERE timer_id = new.id), NULL),
+        (SELECT SUM(TIME_TO_SEC(elapsed_time)) FROM time_slices WHERE timer_id = new.id),
+        (SELECT id FROM time_slices WHERE finish IS NULL AND timer_id = new.id),
+        new.comment, new.archived, new.completed, new.employee_id, new.project_id, new.invoice_id, new.deleted_at, new.version, new.last_updated, new.last_updated_by);
+
+-- DROP TABLE IF EXISTS timers_outbox;
+CREATE TABLE IF NOT EXISTS timers_outbox (
+    id VARCHAR(36) PRIMARY KEY NOT NULL DEFAULT (UUID()),
+    when_changed BIGINT NOT NULL DEFAULT 0,
+    changed_by TEXT NOT NULL DEFAULT "",
+    data_id VARCHAR(36) NOT NULL,
+    data_type TEXT NOT NULL,
+    data_action TEXT NOT NULL,
+    data_version INT NOT NULL DEFAULT 0,
+    payload JSON,
+    aux_id BIGINT AUTO_INCREMENT,
+    enqueued DATETIME(6) NOT NULL DEFAULT CURRENT_TIMESTAMP(6),
+    INDEX(aux_id)
+) ENGINE = InnoDB;
@@ -12,10 +12,41 @@ SELECT
     email_address,
     version,
     UNIX_TIMESTAMP(last_updated) AS last_updated,
-    last_updated_by
+    last_updated_by,
+    UNIX_TIMESTAMP(deleted_at) AS deleted_at
 FROM
     employees;
 
+-- DROP VIEW IF EXISTS employees_audit_v1;
+CREATE VIEW employees_audit_v1 AS
+SELECT
+    employee_id,
+    first_name,
+    last_name,
+    email_address,
+    version,
+    UNIX_TIMESTAMP(last_updated) AS last_updated,
+    last_updated_by,
+    UNIX_TIMESTAMP(deleted_at) AS deleted_at
+FROM
+    employees_audit;
+
+-- DROP VIEW IF EXISTS employees_outbox_v1;
+CREATE VIEW employees_outbox_v1 AS
+SELECT
+    id AS outbox_id,
+    when_changed,
+    changed_by,
+    data_id,
+    data_type,
+    data_action,
+    data_version,
+    payload,
+    aux_id,
+    UNIX_TIMESTAMP(enqueued) AS enqueued
+FROM
+    employees_outbox;
+
 -- DROP VIEW IF EXISTS timers_v1;
 CREATE VIEW timers_v1 AS
 SELECT
@@ -27,10 +58,13 @@ SELECT
     archived,
     completed,
     timers.employee_id AS employee_id,
+    timers.project_id AS project_id,
+    timers.invoice_id AS invoice_id,
     (SELECT id FROM time_slices WHERE finish IS NULL AND timer_id = timers.id) AS active_time_slice_id,
     version,
     UNIX_TIMESTAMP(last_updated) AS last_updated,
-    last_updated_by
+    last_updated_by,
+    UNIX_TIMESTAMP(deleted_at) AS deleted_at
 FROM 
     timers;
 
@@ -49,6 +83,103 @@ SELECT
 FROM
     time_slices;
 
+-- DROP VIEW IF EXISTS timers_audit_v1;
+CREATE VIEW timers_audit_v1 AS
+SELECT
+    timer_id,
+    UNIX_TIMESTAMP(start) AS start,
+    UNIX_TIMESTAMP(finish) AS finish,
+    elapsed_time,
+    comment,
+    archived,
+    completed,
+    employee_id,
+    project_id,
+    invoice_id,
+    active_time_slice_id,
+    version,
+    UNIX_TIMESTAMP(last_updated) AS last_updated,
+    last_updated_by,
+    UNIX_TIMESTAMP(deleted_at) AS deleted_at
+FROM
+    timers_audit;
+
+-- DROP VIEW IF EXISTS time_slices_audit_v1;
+CREATE VIEW time_slices_audit_v1 AS
+SELECT
+    time_slice_id,
+    UNIX_TIMESTAMP(start) AS start,
+    UNIX_TIMESTAMP(finish) AS finish,
+    completed,
+    TIME_TO_SEC(elapsed_time) AS elapsed_time,
+    timer_id,
+    version,
+    UNIX_TIMESTAMP(last_updated) AS last_updated,
+    last_updated_by
+FROM
+    time_slices_audit;
+
+-- DROP VIEW IF EXISTS projects_v1;
+CREATE VIEW projects_v1 AS
+SELECT
+    id AS project_id,
+    name,
+    description,
+    archived,
+    version,
+    UNIX_TIMESTAMP(last_updated) AS last_updated,
+    last_updated_by
+FROM
+    projects;
+
+-- DROP VIEW IF EXISTS rate_cards_v1;
+CREATE VIEW rate_cards_v1 AS
+SELECT
+    id AS rate_card_id,
+    employee_id,
+    project_id,
+    rate,
+    currency,
+    UNIX_TIMESTAMP(effective_from) AS effective_from,
+    UNIX_TIMESTAMP(effective_to) AS effective_to,
+    version,
+    UNIX_TIMESTAMP(last_updated) AS last_updated,
+    last_updated_by
+FROM
+    rate_cards;
+
+-- DROP VIEW IF EXISTS invoices_v1;
+CREATE VIEW invoices_v1 AS
+SELECT
+    id AS invoice_id,
+    employee_id,
+    project_id,
+    UNIX_TIMESTAMP(start) AS start,
+    UNIX_TIMESTAMP(finish) AS finish,
+    currency,
+    total,
+    version,
+    UNIX_TIMESTAMP(last_updated) AS last_updated,
+    last_updated_by
+FROM
+    invoices;
+
+-- DROP VIEW IF EXISTS timers_outbox_v1;
+CREATE VIEW timers_outbox_v1 AS
+SELECT
+    id AS outbox_id,
+    when_changed,
+    changed_by,
+    data_id,
+    data_type,
+    data_action,
+    data_version,
+    payload,
+    aux_id,
+    UNIX_TIMESTAMP(enqueued) AS enqueued
+FROM
+    timers_outbox;
+
 -- DROP VIEW IF EXISTS changes_v1;
 CREATE VIEW changes_v1 AS
 SELECT
@@ -59,13 +190,49 @@ SELECT
     service,
     action,
     UNIX_TIMESTAMP(when_changed) AS when_changed,
-    changed_by
+    changed_by,
+    payload
 FROM
     changes;
 
 -- DROP VIEW IF EXISTS registrations_v1;
 CREATE VIEW registrations_v1 AS
 SELECT
-    id AS registration_id
+    id AS registration_id,
+    filter_service_names,
+    filter_types,
+    filter_actions
 FROM
     registrations;
+
+-- DROP VIEW IF EXISTS registration_webhooks_v1;
+CREATE VIEW registration_webhooks_v1 AS
+SELECT
+    registration_id,
+    url,
+    secret
+FROM
+    registration_webhooks;
+
+-- DROP VIEW IF EXISTS registration_dead_letters_v1;
+CREATE VIEW registration_dead_letters_v1 AS
+SELECT
+    registration_id,
+    change_id,
+    attempts,
+    UNIX_TIMESTAMP(dead_lettered) AS dead_lettered
+FROM
+    registration_dead_letters;
+
+-- DROP VIEW IF EXISTS registration_replays_v1;
+CREATE VIEW registration_replays_v1 AS
+SELECT
+    registration_id,
+    search,
+    status,
+    last_change_id,
+    last_when_changed,
+    replayed,
+    error
+FROM
+    registration_replays;
@@ -19,6 +19,10 @@ type grpcClient struct {
 	logger.Logger
 	timersClient     pb.TimersClient
 	timeSlicesClient pb.TimeSlicesClient
+	projectsClient   pb.ProjectsClient
+	rateCardsClient  pb.RateCardsClient
+	invoicesClient   pb.InvoicesClient
+	timesheetsClient pb.TimesheetsClient
 	client           interface {
 		internal.Configurer
 		internal.Initializer
@@ -88,6 +92,10 @@ func (g *grpcClient) Initialize() error {
 	}
 	g.timersClient = pb.NewTimersClient(g.client)
 	g.timeSlicesClient = pb.NewTimeSlicesClient(g.client)
+	g.projectsClient = pb.NewProjectsClient(g.client)
+	g.rateCardsClient = pb.NewRateCardsClient(g.client)
+	g.invoicesClient = pb.NewInvoicesClient(g.client)
+	g.timesheetsClient = pb.NewTimesheetsClient(g.client)
 	return nil
 }
 
@@ -124,21 +132,52 @@ func (g *grpcClient) TimersRead(ctx context.Context, search data.TimerSearch) ([
 	return pb.ToTimers(response.GetTimers()), err
 }
 
-// TimerStart can be used to start a given timer or do nothing
-// if the timer is already started
-func (g *grpcClient) TimerStart(ctx context.Context, id string) (*data.Timer, error) {
-	response, err := g.timersClient.TimerStart(ctx, &pb.TimerStartRequest{
+// TimerHistory can be used to read every version of a given
+// timer (oldest first)
+func (g *grpcClient) TimerHistory(ctx context.Context, id string) ([]*data.Timer, error) {
+	response, err := g.timersClient.TimerHistory(ctx, &pb.TimerHistoryRequest{
+		Id: id,
+	})
+	return pb.ToTimers(response.GetTimers()), err
+}
+
+// TimerRestore can be used to restore a deleted timer (from the trash)
+func (g *grpcClient) TimerRestore(ctx context.Context, id string) (*data.Timer, error) {
+	response, err := g.timersClient.TimerRestore(ctx, &pb.TimerRestoreRequest{
 		Id: id,
 	})
 	return pb.ToTimer(response.GetTimer()), err
 }
 
+// TimerStart can be used to start a given timer or do nothing
+// if the timer is already started, if start time is zero the
+// current time is used
+func (g *grpcClient) TimerStart(ctx context.Context, id string, startTime int64) (*data.Timer, error) {
+	request := &pb.TimerStartRequest{
+		Id: id,
+	}
+	if startTime > 0 {
+		request.StartOneof = &pb.TimerStartRequest_Start{
+			Start: startTime,
+		}
+	}
+	response, err := g.timersClient.TimerStart(ctx, request)
+	return pb.ToTimer(response.GetTimer()), err
+}
+
 // TimerStop can be used to stop a given timer or do nothing
-// if the timer is not started
-func (g *grpcClient) TimerStop(ctx context.Context, id string) (*data.Timer, error) {
-	response, err := g.timersClient.TimerStop(ctx, &pb.TimerStopRequest{
+// if the timer is not started, if finish time is zero the
+// current time is used
+func (g *grpcClient) TimerStop(ctx context.Context, id string, finishTime int64) (*data.Timer, error) {
+	request := &pb.TimerStopRequest{
 		Id: id,
-	})
+	}
+	if finishTime > 0 {
+		request.FinishOneof = &pb.TimerStopRequest_Finish{
+			Finish: finishTime,
+		}
+	}
+	response, err := g.timersClient.TimerStop(ctx, request)
 	return pb.ToTimer(response.GetTimer()), err
 }
 
@@ -163,10 +202,16 @@ func (g *grpcClient) TimerSubmit(ctx context.Context, timerID string, finishTime
 }
 
 func (g *grpcClient) TimerUpdate(ctx context.Context, id string, timerPartial data.TimerPartial) (*data.Timer, error) {
-	response, err := g.timersClient.TimerUpdate(ctx, &pb.TimerUpdateRequest{
+	request := &pb.TimerUpdateRequest{
 		Id:           id,
 		TimerPartial: pb.FromTimerPartial(&timerPartial),
-	})
+	}
+	if version := timerPartial.Version; version != nil {
+		request.VersionOneof = &pb.TimerUpdateRequest_Version{
+			Version: int32(*version),
+		}
+	}
+	response, err := g.timersClient.TimerUpdate(ctx, request)
 	return pb.ToTimer(response.GetTimer()), err
 }
 
@@ -187,9 +232,16 @@ func (g *grpcClient) TimeSliceRead(ctx context.Context, id string) (*data.TimeSl
 
 // TimeSliceUpdate can be used to update an existing time slice
 func (g *grpcClient) TimeSliceUpdate(ctx context.Context, id string, timeSlicePartial data.TimeSlicePartial) (*data.TimeSlice, error) {
-	response, err := g.timeSlicesClient.TimeSliceUpdate(ctx, &pb.TimeSliceUpdateRequest{
+	request := &pb.TimeSliceUpdateRequest{
 		Id:               id,
-		TimeSlicePartial: pb.FromTimeSlicePartial(&timeSlicePartial)})
+		TimeSlicePartial: pb.FromTimeSlicePartial(&timeSlicePartial),
+	}
+	if version := timeSlicePartial.Version; version != nil {
+		request.VersionOneof = &pb.TimeSliceUpdateRequest_Version{
+			Version: int32(*version),
+		}
+	}
+	response, err := g.timeSlicesClient.TimeSliceUpdate(ctx, request)
 	return pb.ToTimeSlice(response.GetTimeSlice()), err
 }
 
@@ -207,3 +259,151 @@ func (g *grpcClient) TimeSlicesRead(ctx context.Context, search data.TimeSliceSe
 	})
 	return pb.ToTimeSlices(response.GetTimeSlices()), err
 }
+
+// TimeSliceHistory can be used to read every version of a given
+// time slice (oldest first)
+func (g *grpcClient) TimeSliceHistory(ctx context.Context, id string) ([]*data.TimeSlice, error) {
+	response, err := g.timeSlicesClient.TimeSliceHistory(ctx, &pb.TimeSliceHistoryRequest{
+		Id: id,
+	})
+	return pb.ToTimeSlices(response.GetTimeSlices()), err
+}
+
+// TimeSliceSplit can be used to split an existing time slice at the
+// given time, the existing time slice will start at the split time
+// and a new time slice is created for the time before it
+func (g *grpcClient) TimeSliceSplit(ctx context.Context, id string, splitTime int64) ([]*data.TimeSlice, error) {
+	response, err := g.timeSlicesClient.TimeSliceSplit(ctx, &pb.TimeSliceSplitRequest{
+		Id:        id,
+		SplitTime: splitTime,
+	})
+	return pb.ToTimeSlices(response.GetTimeSlices()), err
+}
+
+// TimeSlicesMerge can be used to merge two or more adjacent time slices
+// of the same timer, the latest time slice is kept (and will start at
+// the earliest start) while the others are deleted
+func (g *grpcClient) TimeSlicesMerge(ctx context.Context, ids []string) (*data.TimeSlice, error) {
+	response, err := g.timeSlicesClient.TimeSlicesMerge(ctx, &pb.TimeSlicesMergeRequest{
+		Ids: ids,
+	})
+	return pb.ToTimeSlice(response.GetTimeSlice()), err
+}
+
+// ProjectCreate can be used to create a project, the name
+// is required and must be unique
+func (g *grpcClient) ProjectCreate(ctx context.Context, projectPartial data.ProjectPartial) (*data.Project, error) {
+	response, err := g.projectsClient.ProjectCreate(ctx, &pb.ProjectCreateRequest{
+		ProjectPartial: pb.FromProjectPartial(&projectPartial),
+	})
+	return pb.ToProject(response.GetProject()), err
+}
+
+// ProjectRead can be used to read an existing project
+func (g *grpcClient) ProjectRead(ctx context.Context, id string) (*data.Project, error) {
+	response, err := g.projectsClient.ProjectRead(ctx, &pb.ProjectReadRequest{Id: id})
+	return pb.ToProject(response.GetProject()), err
+}
+
+// ProjectUpdate can be used to update an existing project
+func (g *grpcClient) ProjectUpdate(ctx context.Context, id string, projectPartial data.ProjectPartial) (*data.Project, error) {
+	response, err := g.projectsClient.ProjectUpdate(ctx, &pb.ProjectUpdateRequest{
+		Id:             id,
+		ProjectPartial: pb.FromProjectPartial(&projectPartial),
+	})
+	return pb.ToProject(response.GetProject()), err
+}
+
+// ProjectDelete can be used to delete a project if it exists
+func (g *grpcClient) ProjectDelete(ctx context.Context, id string) error {
+	_, err := g.projectsClient.ProjectDelete(ctx, &pb.ProjectDeleteRequest{Id: id})
+	return err
+}
+
+// ProjectsRead can be used to read zero or more projects depending
+// on the search criteria
+func (g *grpcClient) ProjectsRead(ctx context.Context, search data.ProjectSearch) ([]*data.Project, error) {
+	response, err := g.projectsClient.ProjectsRead(ctx, &pb.ProjectsReadRequest{
+		ProjectSearch: pb.ToProjectSearch(&search),
+	})
+	return pb.ToProjects(response.GetProjects()), err
+}
+
+// RateCardCreate can be used to create a rate card, the rate
+// and currency are required
+func (g *grpcClient) RateCardCreate(ctx context.Context, rateCardPartial data.RateCardPartial) (*data.RateCard, error) {
+	response, err := g.rateCardsClient.RateCardCreate(ctx, &pb.RateCardCreateRequest{
+		RateCardPartial: pb.FromRateCardPartial(&rateCardPartial),
+	})
+	return pb.ToRateCard(response.GetRateCard()), err
+}
+
+// RateCardRead can be used to read an existing rate card
+func (g *grpcClient) RateCardRead(ctx context.Context, id string) (*data.RateCard, error) {
+	response, err := g.rateCardsClient.RateCardRead(ctx, &pb.RateCardReadRequest{Id: id})
+	return pb.ToRateCard(response.GetRateCard()), err
+}
+
+// RateCardUpdate can be used to update an existing rate card
+func (g *grpcClient) RateCardUpdate(ctx context.Context, id string, rateCardPartial data.RateCardPartial) (*data.RateCard, error) {
+	response, err := g.rateCardsClient.RateCardUpdate(ctx, &pb.RateCardUpdateRequest{
+		Id:              id,
+		RateCardPartial: pb.FromRateCardPartial(&rateCardPartial),
+	})
+	return pb.ToRateCard(response.GetRateCard()), err
+}
+
+// RateCardDelete can be used to delete a rate card if it exists
+func (g *grpcClient) RateCardDelete(ctx context.Context, id string) error {
+	_, err := g.rateCardsClient.RateCardDelete(ctx, &pb.RateCardDeleteRequest{Id: id})
+	return err
+}
+
+// RateCardsRead can be used to read zero or more rate cards depending
+// on the search criteria
+func (g *grpcClient) RateCardsRead(ctx context.Context, search data.RateCardSearch) ([]*data.RateCard, error) {
+	response, err := g.rateCardsClient.RateCardsRead(ctx, &pb.RateCardsReadRequest{
+		RateCardSearch: pb.ToRateCardSearch(&search),
+	})
+	return pb.ToRateCards(response.GetRateCards()), err
+}
+
+// InvoiceCreate can be used to generate an invoice for all completed
+// and non-archived timers that haven't been invoiced and finished
+// within the start/finish of the invoice partial
+func (g *grpcClient) InvoiceCreate(ctx context.Context, invoicePartial data.InvoicePartial) (*data.Invoice, error) {
+	response, err := g.invoicesClient.InvoiceCreate(ctx, &pb.InvoiceCreateRequest{
+		InvoicePartial: pb.FromInvoicePartial(&invoicePartial),
+	})
+	return pb.ToInvoice(response.GetInvoice()), err
+}
+
+// InvoiceRead can be used to read an existing invoice
+func (g *grpcClient) InvoiceRead(ctx context.Context, id string) (*data.Invoice, error) {
+	response, err := g.invoicesClient.InvoiceRead(ctx, &pb.InvoiceReadRequest{Id: id})
+	return pb.ToInvoice(response.GetInvoice()), err
+}
+
+// InvoiceDelete can be used to delete an invoice if it exists
+func (g *grpcClient) InvoiceDelete(ctx context.Context, id string) error {
+	_, err := g.invoicesClient.InvoiceDelete(ctx, &pb.InvoiceDeleteRequest{Id: id})
+	return err
+}
+
+// InvoicesRead can be used to read zero or more invoices depending
+// on the search criteria
+func (g *grpcClient) InvoicesRead(ctx context.Context, search data.InvoiceSearch) ([]*data.Invoice, error) {
+	response, err := g.invoicesClient.InvoicesRead(ctx, &pb.InvoicesReadRequest{
+		InvoiceSearch: pb.ToInvoiceSearch(&search),
+	})
+	return pb.ToInvoices(response.GetInvoices()), err
+}
+
+// TimesheetsRead can be used to total the time spent per employee
+// per calendar day and ISO week
+func (g *grpcClient) TimesheetsRead(ctx context.Context, search data.TimesheetSearch) ([]*data.Timesheet, error) {
+	response, err := g.timesheetsClient.TimesheetsRead(ctx, &pb.TimesheetsReadRequest{
+		TimesheetSearch: pb.ToTimesheetSearch(&search),
+	})
+	return pb.ToTimesheets(response.GetTimesheets()), err
+}
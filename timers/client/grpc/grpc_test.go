@@ -85,7 +85,7 @@ func (r *grpcClientTest) TestTimers(t *testing.T) {
 	assert.Nil(t, err)
 	assert.Equal(t, timer, timerRead)
 	//start the timer
-	timerStarted, err := r.client.TimerStart(ctx, timerID)
+	timerStarted, err := r.client.TimerStart(ctx, timerID, 0)
 	assert.Nil(t, err)
 	assert.NotEmpty(t, timerStarted.ActiveTimeSliceID)
 	assert.NotZero(t, timerStarted.Start)
@@ -96,7 +96,7 @@ func (r *grpcClientTest) TestTimers(t *testing.T) {
 	assert.Nil(t, err)
 	assert.GreaterOrEqual(t, timerRead.ElapsedTime, int64(time.Second))
 	//stop the timer
-	timerStopped, err := r.client.TimerStop(ctx, timerID)
+	timerStopped, err := r.client.TimerStop(ctx, timerID, 0)
 	assert.Nil(t, err)
 	//read the timer
 	timerRead, err = r.client.TimerRead(ctx, timerID)
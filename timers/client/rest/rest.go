@@ -157,12 +157,50 @@ func (r *restClient) TimersRead(ctx context.Context, search data.TimerSearch) ([
 	return timers, nil
 }
 
+// TimerHistory can be used to read every version of a given
+// timer (oldest first)
+func (r *restClient) TimerHistory(ctx context.Context, id string) ([]*data.Timer, error) {
+	uri := fmt.Sprintf("http://%s:%s"+data.RouteTimersIDHistoryf,
+		r.config.Address, r.config.Port, id)
+	bytes, err := r.doRequest(ctx, uri, http.MethodGet, nil)
+	if err != nil {
+		return nil, err
+	}
+	var timers []*data.Timer
+	if err = json.Unmarshal(bytes, &timers); err != nil {
+		return nil, err
+	}
+	return timers, nil
+}
+
+// TimerRestore can be used to restore a deleted timer (from the trash)
+func (r *restClient) TimerRestore(ctx context.Context, id string) (*data.Timer, error) {
+	uri := fmt.Sprintf("http://%s:%s"+data.RouteTimersIDRestoref,
+		r.config.Address, r.config.Port, id)
+	bytes, err := r.doRequest(ctx, uri, http.MethodPut, nil)
+	if err != nil {
+		return nil, err
+	}
+	timer := &data.Timer{}
+	if err = json.Unmarshal(bytes, timer); err != nil {
+		return nil, err
+	}
+	return timer, nil
+}
+
 // TimerStart can be used to start a given timer or do nothing
-// if the timer is already started
-func (r *restClient) TimerStart(ctx context.Context, id string) (*data.Timer, error) {
+// if the timer is already started, if start time is zero the
+// current time is used
+func (r *restClient) TimerStart(ctx context.Context, id string, startTime int64) (*data.Timer, error) {
+	bytes, err := json.Marshal(&data.Contract{
+		Start: startTime,
+	})
+	if err != nil {
+		return nil, err
+	}
 	uri := fmt.Sprintf("http://%s:%s"+data.RouteTimersIDStartf,
 		r.config.Address, r.config.Port, id)
-	bytes, err := r.doRequest(ctx, uri, http.MethodPut, nil)
+	bytes, err = r.doRequest(ctx, uri, http.MethodPut, bytes)
 	if err != nil {
 		return nil, err
 	}
@@ -174,11 +212,18 @@ func (r *restClient) TimerStart(ctx context.Context, id string) (*data.Timer, er
 }
 
 // TimerStop can be used to stop a given timer or do nothing
-// if the timer is not started
-func (r *restClient) TimerStop(ctx context.Context, id string) (*data.Timer, error) {
+// if the timer is not started, if finish time is zero the
+// current time is used
+func (r *restClient) TimerStop(ctx context.Context, id string, finishTime int64) (*data.Timer, error) {
+	bytes, err := json.Marshal(&data.Contract{
+		Finish: finishTime,
+	})
+	if err != nil {
+		return nil, err
+	}
 	uri := fmt.Sprintf("http://%s:%s"+data.RouteTimersIDStopf,
 		r.config.Address, r.config.Port, id)
-	bytes, err := r.doRequest(ctx, uri, http.MethodPut, nil)
+	bytes, err = r.doRequest(ctx, uri, http.MethodPut, bytes)
 	if err != nil {
 		return nil, err
 	}
@@ -324,6 +369,68 @@ func (r *restClient) TimeSliceDelete(ctx context.Context, id string) error {
 	return nil
 }
 
+// TimeSliceHistory can be used to read every version of a given
+// time slice (oldest first)
+func (r *restClient) TimeSliceHistory(ctx context.Context, id string) ([]*data.TimeSlice, error) {
+	uri := fmt.Sprintf("http://%s:%s"+data.RouteTimeSlicesIDHistoryf,
+		r.config.Address, r.config.Port, id)
+	bytes, err := r.doRequest(ctx, uri, http.MethodGet, nil)
+	if err != nil {
+		return nil, err
+	}
+	var timeSlices []*data.TimeSlice
+	if err = json.Unmarshal(bytes, &timeSlices); err != nil {
+		return nil, err
+	}
+	return timeSlices, nil
+}
+
+// TimeSliceSplit can be used to split an existing time slice at the
+// given time, the existing time slice will start at the split time
+// and a new time slice is created for the time before it
+func (r *restClient) TimeSliceSplit(ctx context.Context, id string, splitTime int64) ([]*data.TimeSlice, error) {
+	bytes, err := json.Marshal(&data.Contract{
+		SplitTime: splitTime,
+	})
+	if err != nil {
+		return nil, err
+	}
+	uri := fmt.Sprintf("http://%s:%s"+data.RouteTimeSlicesIDSplitf,
+		r.config.Address, r.config.Port, id)
+	bytes, err = r.doRequest(ctx, uri, http.MethodPut, bytes)
+	if err != nil {
+		return nil, err
+	}
+	var timeSlices []*data.TimeSlice
+	if err = json.Unmarshal(bytes, &timeSlices); err != nil {
+		return nil, err
+	}
+	return timeSlices, nil
+}
+
+// TimeSlicesMerge can be used to merge two or more adjacent time slices
+// of the same timer, the latest time slice is kept (and will start at
+// the earliest start) while the others are deleted
+func (r *restClient) TimeSlicesMerge(ctx context.Context, ids []string) (*data.TimeSlice, error) {
+	bytes, err := json.Marshal(&data.Contract{
+		IDs: ids,
+	})
+	if err != nil {
+		return nil, err
+	}
+	uri := fmt.Sprintf("http://%s:%s"+data.RouteTimeSlicesMerge,
+		r.config.Address, r.config.Port)
+	bytes, err = r.doRequest(ctx, uri, http.MethodPost, bytes)
+	if err != nil {
+		return nil, err
+	}
+	timeSlice := new(data.TimeSlice)
+	if err = json.Unmarshal(bytes, timeSlice); err != nil {
+		return nil, err
+	}
+	return timeSlice, nil
+}
+
 // TimeSlicesRead can be used to read zero or more time slices depending on the
 // search criteria
 func (r *restClient) TimeSlicesRead(ctx context.Context, search data.TimeSliceSearch) ([]*data.TimeSlice, error) {
@@ -339,3 +446,236 @@ func (r *restClient) TimeSlicesRead(ctx context.Context, search data.TimeSliceSe
 	}
 	return timeSlices, nil
 }
+
+// ProjectCreate can be used to create a project, the name
+// is required and must be unique
+func (r *restClient) ProjectCreate(ctx context.Context, projectPartial data.ProjectPartial) (*data.Project, error) {
+	bytes, err := json.Marshal(&projectPartial)
+	if err != nil {
+		return nil, err
+	}
+	uri := fmt.Sprintf("http://%s:%s"+data.RouteProjects, r.config.Address, r.config.Port)
+	bytes, err = r.doRequest(ctx, uri, http.MethodPost, bytes)
+	if err != nil {
+		return nil, err
+	}
+	project := new(data.Project)
+	if err = json.Unmarshal(bytes, project); err != nil {
+		return nil, err
+	}
+	return project, nil
+}
+
+// ProjectRead can be used to read an existing project
+func (r *restClient) ProjectRead(ctx context.Context, id string) (*data.Project, error) {
+	uri := fmt.Sprintf("http://%s:%s"+data.RouteProjectsIDf,
+		r.config.Address, r.config.Port, id)
+	bytes, err := r.doRequest(ctx, uri, http.MethodGet, nil)
+	if err != nil {
+		return nil, err
+	}
+	project := new(data.Project)
+	if err = json.Unmarshal(bytes, project); err != nil {
+		return nil, err
+	}
+	return project, nil
+}
+
+// ProjectUpdate can be used to update an existing project
+func (r *restClient) ProjectUpdate(ctx context.Context, id string, projectPartial data.ProjectPartial) (*data.Project, error) {
+	bytes, err := json.Marshal(&projectPartial)
+	if err != nil {
+		return nil, err
+	}
+	uri := fmt.Sprintf("http://%s:%s"+data.RouteProjectsIDf,
+		r.config.Address, r.config.Port, id)
+	bytes, err = r.doRequest(ctx, uri, http.MethodPut, bytes)
+	if err != nil {
+		return nil, err
+	}
+	project := new(data.Project)
+	if err = json.Unmarshal(bytes, project); err != nil {
+		return nil, err
+	}
+	return project, nil
+}
+
+// ProjectDelete can be used to delete a project if it exists
+func (r *restClient) ProjectDelete(ctx context.Context, id string) error {
+	uri := fmt.Sprintf("http://%s:%s"+data.RouteProjectsIDf,
+		r.config.Address, r.config.Port, id)
+	if _, err := r.doRequest(ctx, uri, http.MethodDelete, nil); err != nil {
+		return err
+	}
+	return nil
+}
+
+// ProjectsRead can be used to read zero or more projects depending
+// on the search criteria
+func (r *restClient) ProjectsRead(ctx context.Context, search data.ProjectSearch) ([]*data.Project, error) {
+	uri := fmt.Sprintf("http://%s:%s"+data.RouteProjectsSearch+"%s",
+		r.config.Address, r.config.Port, search.ToParams())
+	bytes, err := r.doRequest(ctx, uri, http.MethodGet, nil)
+	if err != nil {
+		return nil, err
+	}
+	var projects []*data.Project
+	if err = json.Unmarshal(bytes, &projects); err != nil {
+		return nil, err
+	}
+	return projects, nil
+}
+
+// RateCardCreate can be used to create a rate card, the rate
+// and currency are required
+func (r *restClient) RateCardCreate(ctx context.Context, rateCardPartial data.RateCardPartial) (*data.RateCard, error) {
+	bytes, err := json.Marshal(&rateCardPartial)
+	if err != nil {
+		return nil, err
+	}
+	uri := fmt.Sprintf("http://%s:%s"+data.RouteRateCards, r.config.Address, r.config.Port)
+	bytes, err = r.doRequest(ctx, uri, http.MethodPost, bytes)
+	if err != nil {
+		return nil, err
+	}
+	rateCard := new(data.RateCard)
+	if err = json.Unmarshal(bytes, rateCard); err != nil {
+		return nil, err
+	}
+	return rateCard, nil
+}
+
+// RateCardRead can be used to read an existing rate card
+func (r *restClient) RateCardRead(ctx context.Context, id string) (*data.RateCard, error) {
+	uri := fmt.Sprintf("http://%s:%s"+data.RouteRateCardsIDf,
+		r.config.Address, r.config.Port, id)
+	bytes, err := r.doRequest(ctx, uri, http.MethodGet, nil)
+	if err != nil {
+		return nil, err
+	}
+	rateCard := new(data.RateCard)
+	if err = json.Unmarshal(bytes, rateCard); err != nil {
+		return nil, err
+	}
+	return rateCard, nil
+}
+
+// RateCardUpdate can be used to update an existing rate card
+func (r *restClient) RateCardUpdate(ctx context.Context, id string, rateCardPartial data.RateCardPartial) (*data.RateCard, error) {
+	bytes, err := json.Marshal(&rateCardPartial)
+	if err != nil {
+		return nil, err
+	}
+	uri := fmt.Sprintf("http://%s:%s"+data.RouteRateCardsIDf,
+		r.config.Address, r.config.Port, id)
+	bytes, err = r.doRequest(ctx, uri, http.MethodPut, bytes)
+	if err != nil {
+		return nil, err
+	}
+	rateCard := new(data.RateCard)
+	if err = json.Unmarshal(bytes, rateCard); err != nil {
+		return nil, err
+	}
+	return rateCard, nil
+}
+
+// RateCardDelete can be used to delete a rate card if it exists
+func (r *restClient) RateCardDelete(ctx context.Context, id string) error {
+	uri := fmt.Sprintf("http://%s:%s"+data.RouteRateCardsIDf,
+		r.config.Address, r.config.Port, id)
+	if _, err := r.doRequest(ctx, uri, http.MethodDelete, nil); err != nil {
+		return err
+	}
+	return nil
+}
+
+// RateCardsRead can be used to read zero or more rate cards depending
+// on the search criteria
+func (r *restClient) RateCardsRead(ctx context.Context, search data.RateCardSearch) ([]*data.RateCard, error) {
+	uri := fmt.Sprintf("http://%s:%s"+data.RouteRateCardsSearch+"%s",
+		r.config.Address, r.config.Port, search.ToParams())
+	bytes, err := r.doRequest(ctx, uri, http.MethodGet, nil)
+	if err != nil {
+		return nil, err
+	}
+	var rateCards []*data.RateCard
+	if err = json.Unmarshal(bytes, &rateCards); err != nil {
+		return nil, err
+	}
+	return rateCards, nil
+}
+
+// InvoiceCreate can be used to generate an invoice for all completed
+// and non-archived timers that haven't been invoiced and finished
+// within the start/finish of the invoice partial
+func (r *restClient) InvoiceCreate(ctx context.Context, invoicePartial data.InvoicePartial) (*data.Invoice, error) {
+	bytes, err := json.Marshal(&invoicePartial)
+	if err != nil {
+		return nil, err
+	}
+	uri := fmt.Sprintf("http://%s:%s"+data.RouteInvoices, r.config.Address, r.config.Port)
+	bytes, err = r.doRequest(ctx, uri, http.MethodPost, bytes)
+	if err != nil {
+		return nil, err
+	}
+	invoice := new(data.Invoice)
+	if err = json.Unmarshal(bytes, invoice); err != nil {
+		return nil, err
+	}
+	return invoice, nil
+}
+
+// InvoiceRead can be used to read an existing invoice
+func (r *restClient) InvoiceRead(ctx context.Context, id string) (*data.Invoice, error) {
+	uri := fmt.Sprintf("http://%s:%s"+data.RouteInvoicesIDf,
+		r.config.Address, r.config.Port, id)
+	bytes, err := r.doRequest(ctx, uri, http.MethodGet, nil)
+	if err != nil {
+		return nil, err
+	}
+	invoice := new(data.Invoice)
+	if err = json.Unmarshal(bytes, invoice); err != nil {
+		return nil, err
+	}
+	return invoice, nil
+}
+
+// InvoiceDelete can be used to delete an invoice if it exists
+func (r *restClient) InvoiceDelete(ctx context.Context, id string) error {
+	uri := fmt.Sprintf("http://%s:%s"+data.RouteInvoicesIDf,
+		r.config.Address, r.config.Port, id)
+	if _, err := r.doRequest(ctx, uri, http.MethodDelete, nil); err != nil {
+		return err
+	}
+	return nil
+}
+
+// InvoicesRead can be used to read zero or more invoices depending
+// on the search criteria
+func (r *restClient) InvoicesRead(ctx context.Context, search data.InvoiceSearch) ([]*data.Invoice, error) {
+	uri := fmt.Sprintf("http://%s:%s"+data.RouteInvoicesSearch+"%s",
+		r.config.Address, r.config.Port, search.ToParams())
+	bytes, err := r.doRequest(ctx, uri, http.MethodGet, nil)
+	if err != nil {
+		return nil, err
+	}
+	var invoices []*data.Invoice
+	if err = json.Unmarshal(bytes, &invoices); err != nil {
+		return nil, err
+	}
+	return invoices, nil
+}
+
+func (r *restClient) TimesheetsRead(ctx context.Context, search data.TimesheetSearch) ([]*data.Timesheet, error) {
+	uri := fmt.Sprintf("http://%s:%s"+data.RouteTimesheets+"%s",
+		r.config.Address, r.config.Port, search.ToParams())
+	bytes, err := r.doRequest(ctx, uri, http.MethodGet, nil)
+	if err != nil {
+		return nil, err
+	}
+	var timesheets []*data.Timesheet
+	if err = json.Unmarshal(bytes, &timesheets); err != nil {
+		return nil, err
+	}
+	return timesheets, nil
+}
@@ -1,8 +1,34 @@
 package client
 
-import "github.com/antonio-alexander/go-bludgeon/timers/meta"
+import (
+	"context"
+
+	"github.com/antonio-alexander/go-bludgeon/timers/data"
+	"github.com/antonio-alexander/go-bludgeon/timers/meta"
+)
 
 type Client interface {
 	meta.TimeSlice
 	meta.Timer
+	meta.Project
+	meta.RateCard
+
+	//InvoiceCreate can be used to generate an invoice for all completed
+	// and non-archived timers that haven't been invoiced and finished
+	// within the start/finish of the invoice partial
+	InvoiceCreate(ctx context.Context, invoicePartial data.InvoicePartial) (*data.Invoice, error)
+
+	//InvoiceRead can be used to read an existing invoice
+	InvoiceRead(ctx context.Context, id string) (*data.Invoice, error)
+
+	//InvoiceDelete can be used to delete an invoice if it exists
+	InvoiceDelete(ctx context.Context, id string) error
+
+	//InvoicesRead can be used to read zero or more invoices depending
+	// on the search criteria
+	InvoicesRead(ctx context.Context, search data.InvoiceSearch) ([]*data.Invoice, error)
+
+	//TimesheetsRead can be used to total the time spent per employee
+	// per calendar day and ISO week
+	TimesheetsRead(ctx context.Context, search data.TimesheetSearch) ([]*data.Timesheet, error)
 }
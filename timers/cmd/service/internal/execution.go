@@ -48,6 +48,11 @@ func parameterize(config *Configuration) (interface {
 		internal.Parameterizer
 		meta.Timer
 		meta.TimeSlice
+		meta.Project
+		meta.RateCard
+		meta.Invoice
+		meta.Purger
+		meta.Outbox
 	}
 	var parameters []interface{}
 	var changesClient interface {
@@ -118,12 +123,20 @@ func parameterize(config *Configuration) (interface {
 }
 
 func configure(pwd string, envs map[string]string, parameters ...interface{}) error {
+	//KIM: changes are relayed from the outbox and are only acknowledged once
+	// the changes service has confirmed them, so the changes client must not
+	// queue them (in memory) if the changes service is unavailable
+	envsConfigure := make(map[string]string, len(envs)+1)
+	for key, value := range envs {
+		envsConfigure[key] = value
+	}
+	envsConfigure[changesclientrest.EnvNameDisableQueue] = "true"
 	//TODO: allow this to be able to accept configuration from a json
 	// file
 	for _, p := range parameters {
 		switch p := p.(type) {
 		case internal.Configurer:
-			if err := p.Configure(internal_config.Envs(envs)); err != nil {
+			if err := p.Configure(internal_config.Envs(envsConfigure)); err != nil {
 				return err
 			}
 		}
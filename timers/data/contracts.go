@@ -4,25 +4,47 @@ var ServiceName = "bludgeon_timers"
 
 // route constants
 const (
-	RouteBase             string = "/api/v1"
-	RouteTimers           string = RouteBase + "/timers"
-	RouteTimersSearch     string = RouteTimers + "/search"
-	RouteTimersID         string = RouteTimers + "/{id}"
-	RouteTimersIDStart    string = RouteTimersID + "/start"
-	RouteTimersIDStop     string = RouteTimersID + "/stop"
-	RouteTimersIDSubmit   string = RouteTimersID + "/submit"
-	RouteTimersIDComment  string = RouteTimersID + "/comment"
-	RouteTimersIDArchive  string = RouteTimersID + "/archive"
-	RouteTimersIDf        string = RouteTimers + "/%s"
-	RouteTimersIDStartf   string = RouteTimersIDf + "/start"
-	RouteTimersIDStopf    string = RouteTimersIDf + "/stop"
-	RouteTimersIDSubmitf  string = RouteTimersIDf + "/submit"
-	RouteTimersIDCommentf string = RouteTimersIDf + "/comment"
-	RouteTimersIDArchivef string = RouteTimersIDf + "/archive"
-	RouteTimeSlices       string = RouteBase + "/time_slices"
-	RouteTimeSlicesSearch string = RouteTimeSlices + "/search"
-	RouteTimeSlicesID     string = RouteTimeSlices + "/{id}"
-	RouteTimeSlicesIDf    string = RouteTimeSlices + "/%s"
+	RouteBase                 string = "/api/v1"
+	RouteTimers               string = RouteBase + "/timers"
+	RouteTimersSearch         string = RouteTimers + "/search"
+	RouteTimersID             string = RouteTimers + "/{id}"
+	RouteTimersIDStart        string = RouteTimersID + "/start"
+	RouteTimersIDStop         string = RouteTimersID + "/stop"
+	RouteTimersIDSubmit       string = RouteTimersID + "/submit"
+	RouteTimersIDComment      string = RouteTimersID + "/comment"
+	RouteTimersIDArchive      string = RouteTimersID + "/archive"
+	RouteTimersIDHistory      string = RouteTimersID + "/history"
+	RouteTimersIDRestore      string = RouteTimersID + "/restore"
+	RouteTimersIDf            string = RouteTimers + "/%s"
+	RouteTimersIDStartf       string = RouteTimersIDf + "/start"
+	RouteTimersIDStopf        string = RouteTimersIDf + "/stop"
+	RouteTimersIDSubmitf      string = RouteTimersIDf + "/submit"
+	RouteTimersIDCommentf     string = RouteTimersIDf + "/comment"
+	RouteTimersIDArchivef     string = RouteTimersIDf + "/archive"
+	RouteTimersIDHistoryf     string = RouteTimersIDf + "/history"
+	RouteTimersIDRestoref     string = RouteTimersIDf + "/restore"
+	RouteTimeSlices           string = RouteBase + "/time_slices"
+	RouteTimeSlicesSearch     string = RouteTimeSlices + "/search"
+	RouteTimeSlicesID         string = RouteTimeSlices + "/{id}"
+	RouteTimeSlicesIDSplit    string = RouteTimeSlicesID + "/split"
+	RouteTimeSlicesIDHistory  string = RouteTimeSlicesID + "/history"
+	RouteTimeSlicesMerge      string = RouteTimeSlices + "/merge"
+	RouteTimeSlicesIDf        string = RouteTimeSlices + "/%s"
+	RouteTimeSlicesIDSplitf   string = RouteTimeSlicesIDf + "/split"
+	RouteTimeSlicesIDHistoryf string = RouteTimeSlicesIDf + "/history"
+	RouteProjects             string = RouteBase + "/projects"
+	RouteProjectsSearch       string = RouteProjects + "/search"
+	RouteProjectsID           string = RouteProjects + "/{id}"
+	RouteProjectsIDf          string = RouteProjects + "/%s"
+	RouteRateCards            string = RouteBase + "/rate_cards"
+	RouteRateCardsSearch      string = RouteRateCards + "/search"
+	RouteRateCardsID          string = RouteRateCards + "/{id}"
+	RouteRateCardsIDf         string = RouteRateCards + "/%s"
+	RouteInvoices             string = RouteBase + "/invoices"
+	RouteInvoicesSearch       string = RouteInvoices + "/search"
+	RouteInvoicesID           string = RouteInvoices + "/{id}"
+	RouteInvoicesIDf          string = RouteInvoices + "/%s"
+	RouteTimesheets           string = RouteBase + "/timesheets"
 )
 
 // path constants
@@ -37,25 +59,67 @@ const (
 	ParameterArchived    string = "archived"
 	ParameterTimerID     string = "timer_id"
 	ParameterTimerIDs    string = "timer_ids"
+	ParameterProjectID   string = "project_id"
+	ParameterProjectIDs  string = "project_ids"
+	ParameterNames       string = "names"
+	ParameterEffectiveAt string = "effective_at"
+	ParameterInvoiced    string = "invoiced"
+	ParameterStart       string = "start"
+	ParameterFinish      string = "finish"
+	ParameterTimezone    string = "timezone"
+	ParameterLimit       string = "limit"
+	ParameterCursor      string = "cursor"
+	ParameterSort        string = "sort"
+	ParameterDeleted     string = "deleted"
+)
+
+// sort constants
+const (
+	SortAscending  string = "asc"
+	SortDescending string = "desc"
 )
 
 // Contract is used for requests that don't have a
 // solid data type to communicate data in the body
 // of a request
 type Contract struct {
+	//Start provides the start time for a
+	// timer (or time slice)
+	// example: 1653719229
+	Start int64 `json:"start_time,omitempty"`
+
 	//Finish provides the finish time for a
 	// timer (or time slice)
 	// example: 1653719229
 	Finish int64 `json:"finish_time,omitempty"`
+
+	//SplitTime provides the time to split a
+	// time slice at
+	// example: 1653719229
+	SplitTime int64 `json:"split_time,omitempty"`
+
+	//IDs provides the ids of the time slices
+	// to merge
+	IDs []string `json:"ids,omitempty"`
 }
 
 // contracts for changes
 var (
-	ChangeTypeTimer    = "timer"
-	ChangeActionStart  = "start"
-	ChangeActionStop   = "stop"
-	ChangeActionSubmit = "submit"
-	ChangeActionCreate = "create"
-	ChangeActionUpdate = "update"
-	ChangeActionDelete = "delete"
+	ChangeTypeTimer      = "timer"
+	ChangeTypeProject    = "project"
+	ChangeTypeRateCard   = "rate_card"
+	ChangeTypeInvoice    = "invoice"
+	ChangeTypeTimeSlice  = "time_slice"
+	ChangeActionStart    = "start"
+	ChangeActionStop     = "stop"
+	ChangeActionSubmit   = "submit"
+	ChangeActionCreate   = "create"
+	ChangeActionUpdate   = "update"
+	ChangeActionDelete   = "delete"
+	ChangeActionInvoice  = "invoice"
+	ChangeActionIdleStop = "idle_stop"
+	ChangeActionSplit    = "split"
+	ChangeActionMerge    = "merge"
+	ChangeActionRestore  = "restore"
+	ChangeActionPurge    = "purge"
 )
@@ -0,0 +1,95 @@
+package data
+
+// swagger:model Invoice
+//Invoice describes a collection of completed (and non-archived) timers that have
+// been billed, once a timer has been included in an invoice it can't be included
+// in another invoice unless the invoice is deleted
+type Invoice struct {
+	//The ID of the invoice (v4 UUID)
+	// example: "0d2b4c9e-7a1f-4e63-b5a2-5f8e9c1d3a77"
+	ID string `json:"id"`
+
+	//The ID of the employee the invoice was generated for (v4 UUID)
+	// example: "2e3a4156-b415-4120-982f-399182e99588"
+	EmployeeID string `json:"employee_id"`
+
+	//The ID of the project the invoice was generated for (v4 UUID)
+	// example: "8f6a3d52-5c1e-4d0b-9a5e-3a4b7b2f1c90"
+	ProjectID string `json:"project_id"`
+
+	//The start of the date range (unix nano) of the invoice
+	// example: 1653719208000000000
+	Start int64 `json:"start"`
+
+	//The finish of the date range (unix nano) of the invoice
+	// example: 1654324008000000000
+	Finish int64 `json:"finish"`
+
+	//The currency of the invoice (ISO 4217)
+	// example: USD
+	Currency string `json:"currency"`
+
+	//The total amount of the invoice in the smallest unit of the currency
+	// example: 52500
+	Total int64 `json:"total"`
+
+	//The line items of the invoice, one per timer
+	LineItems []InvoiceLineItem `json:"line_items"`
+
+	//LastUpdated represents the last time (unix nano) something was mutated
+	// example: 1652417242000
+	LastUpdated int64 `json:"last_updated"`
+
+	//LastUpdatedBy will identify the last someone who mutated something
+	// example: bludgeon_employee_memory
+	LastUpdatedBy string `json:"last_updated_by"`
+
+	//Version is an integer that's atomically incremented each time something i smutated
+	// example: 1
+	Version int `json:"version"`
+}
+
+// swagger:model InvoiceLineItem
+//InvoiceLineItem describes the amount billed for a single timer
+type InvoiceLineItem struct {
+	//The ID of the timer that was billed (v4 UUID)
+	// example: "24dfe1eb-26a7-41db-a647-fe6cc5e77ab8"
+	TimerID string `json:"timer_id"`
+
+	//The ID of the rate card used to bill the timer (v4 UUID)
+	// example: "3b0c1f7e-2f5d-4a8f-8a8e-0c6a0d6e2b11"
+	RateCardID string `json:"rate_card_id"`
+
+	//The elapsed time (nanoseconds) of the timer that was billed
+	// example: 12600000000000
+	ElapsedTime int64 `json:"elapsed_time"`
+
+	//The rate per hour in the smallest unit of the currency
+	// example: 15000
+	Rate int64 `json:"rate"`
+
+	//The amount billed in the smallest unit of the currency
+	// example: 52500
+	Amount int64 `json:"amount"`
+}
+
+// swagger:model InvoicePartial
+//InvoicePartial describes the parameters used to generate an invoice, the
+// start and finish are required
+type InvoicePartial struct {
+	//The ID of the employee to generate the invoice for (v4 UUID)
+	// example: "2e3a4156-b415-4120-982f-399182e99588"
+	EmployeeID *string `json:"employee_id,omitempty"`
+
+	//The ID of the project to generate the invoice for (v4 UUID)
+	// example: "8f6a3d52-5c1e-4d0b-9a5e-3a4b7b2f1c90"
+	ProjectID *string `json:"project_id,omitempty"`
+
+	//The start of the date range (unix nano) of the invoice, inclusive
+	// example: 1653719208000000000
+	Start *int64 `json:"start,omitempty"`
+
+	//The finish of the date range (unix nano) of the invoice, exclusive
+	// example: 1654324008000000000
+	Finish *int64 `json:"finish,omitempty"`
+}
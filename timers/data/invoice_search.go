@@ -0,0 +1,64 @@
+package data
+
+import (
+	"fmt"
+	"strings"
+)
+
+// swagger:model InvoiceSearch
+//InvoiceSearch can be used to inclusively search for one or more
+// invoices
+type InvoiceSearch struct {
+	//An array of one or more ids to search for
+	// in:query
+	IDs []string `json:"ids,omitempty"`
+
+	//Set to search for invoices associated with one or more employees
+	// in:query
+	EmployeeIDs []string `json:"employee_ids,omitempty"`
+
+	//Set to search for invoices associated with one or more projects
+	// in:query
+	ProjectIDs []string `json:"project_ids,omitempty"`
+}
+
+//ToParams can be used to generate a parameter string from
+// a valid invoice search pointer
+func (i *InvoiceSearch) ToParams() string {
+	const parameterf string = "%s=%s"
+	var parameters []string
+	if len(i.IDs) > 0 {
+		parameters = append(parameters,
+			fmt.Sprintf(parameterf, ParameterIDs, strings.Join(i.IDs, ",")))
+	}
+	if len(i.EmployeeIDs) > 0 {
+		parameters = append(parameters,
+			fmt.Sprintf(parameterf, ParameterEmployeeIDs, strings.Join(i.EmployeeIDs, ",")))
+	}
+	if len(i.ProjectIDs) > 0 {
+		parameters = append(parameters,
+			fmt.Sprintf(parameterf, ParameterProjectIDs, strings.Join(i.ProjectIDs, ",")))
+	}
+	return "?" + strings.Join(parameters, "&")
+}
+
+//FromParams can be used to convert a set of params into an invoice
+// search pointer
+func (i *InvoiceSearch) FromParams(params map[string][]string) {
+	for key, value := range params {
+		switch strings.ToLower(key) {
+		case ParameterIDs:
+			for _, value := range value {
+				i.IDs = append(i.IDs, strings.Split(value, ",")...)
+			}
+		case ParameterEmployeeIDs:
+			for _, value := range value {
+				i.EmployeeIDs = append(i.EmployeeIDs, strings.Split(value, ",")...)
+			}
+		case ParameterProjectIDs:
+			for _, value := range value {
+				i.ProjectIDs = append(i.ProjectIDs, strings.Split(value, ",")...)
+			}
+		}
+	}
+}
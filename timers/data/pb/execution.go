@@ -32,6 +32,11 @@ func FromTimerPartial(t *data.TimerPartial) *TimerPartial {
 			Finish: *t.Finish,
 		}
 	}
+	if t.ProjectID != nil {
+		TimerPartial.ProjectIdOneof = &TimerPartial_ProjectId{
+			ProjectId: *t.ProjectID,
+		}
+	}
 	return TimerPartial
 }
 
@@ -60,6 +65,10 @@ func ToTimerPartial(t *TimerPartial) *data.TimerPartial {
 		s := t.GetFinish()
 		TimerPartial.Finish = &s
 	}
+	if t.ProjectIdOneof != nil {
+		s := t.GetProjectId()
+		TimerPartial.ProjectID = &s
+	}
 	return TimerPartial
 }
 
@@ -74,12 +83,15 @@ func FromTimer(t *data.Timer) *Timer {
 		Finish:            t.Finish,
 		ElapsedTime:       t.ElapsedTime,
 		EmployeeId:        t.EmployeeID,
+		ProjectId:         t.ProjectID,
+		InvoiceId:         t.InvoiceID,
 		ActiveTimeSliceId: t.ActiveTimeSliceID,
 		Id:                t.ID,
 		Comment:           t.Comment,
 		LastUpdated:       t.LastUpdated,
 		LastUpdatedBy:     t.LastUpdatedBy,
 		Version:           int32(t.Version),
+		DeletedAt:         t.DeletedAt,
 	}
 }
 
@@ -94,12 +106,15 @@ func ToTimer(t *Timer) *data.Timer {
 		Finish:            t.GetFinish(),
 		ElapsedTime:       t.GetElapsedTime(),
 		EmployeeID:        t.GetEmployeeId(),
+		ProjectID:         t.GetProjectId(),
+		InvoiceID:         t.GetInvoiceId(),
 		ActiveTimeSliceID: t.GetActiveTimeSliceId(),
 		ID:                t.GetId(),
 		Comment:           t.GetComment(),
 		LastUpdated:       t.GetLastUpdated(),
 		LastUpdatedBy:     t.GetLastUpdatedBy(),
 		Version:           int(t.GetVersion()),
+		DeletedAt:         t.GetDeletedAt(),
 	}
 }
 
@@ -126,11 +141,16 @@ func FromTimerSearch(t *TimerSearch) *data.TimerSearch {
 	TimerSearch := &data.TimerSearch{
 		IDs:         t.GetIds(),
 		EmployeeIDs: t.GetEmployeeIds(),
+		ProjectIDs:  t.GetProjectIds(),
 	}
 	if t.EmployeeIdOneof != nil {
 		s := t.GetEmployeeId()
 		TimerSearch.EmployeeID = &s
 	}
+	if t.ProjectIdOneof != nil {
+		s := t.GetProjectId()
+		TimerSearch.ProjectID = &s
+	}
 	if t.CompletedOneof != nil {
 		s := t.GetCompleted()
 		TimerSearch.Completed = &s
@@ -139,6 +159,34 @@ func FromTimerSearch(t *TimerSearch) *data.TimerSearch {
 		s := t.GetArchived()
 		TimerSearch.Archived = &s
 	}
+	if t.InvoicedOneof != nil {
+		s := t.GetInvoiced()
+		TimerSearch.Invoiced = &s
+	}
+	if t.StartOneof != nil {
+		s := t.GetStart()
+		TimerSearch.Start = &s
+	}
+	if t.FinishOneof != nil {
+		s := t.GetFinish()
+		TimerSearch.Finish = &s
+	}
+	if t.LimitOneof != nil {
+		s := int(t.GetLimit())
+		TimerSearch.Limit = &s
+	}
+	if t.CursorOneof != nil {
+		s := t.GetCursor()
+		TimerSearch.Cursor = &s
+	}
+	if t.SortOneof != nil {
+		s := t.GetSort()
+		TimerSearch.Sort = &s
+	}
+	if t.DeletedOneof != nil {
+		s := t.GetDeleted()
+		TimerSearch.Deleted = &s
+	}
 	return TimerSearch
 }
 
@@ -149,12 +197,18 @@ func ToTimerSearch(t *data.TimerSearch) *TimerSearch {
 	TimerSearch := &TimerSearch{
 		Ids:         t.IDs,
 		EmployeeIds: t.EmployeeIDs,
+		ProjectIds:  t.ProjectIDs,
 	}
 	if t.EmployeeID != nil {
 		TimerSearch.EmployeeIdOneof = &TimerSearch_EmployeeId{
 			EmployeeId: *t.EmployeeID,
 		}
 	}
+	if t.ProjectID != nil {
+		TimerSearch.ProjectIdOneof = &TimerSearch_ProjectId{
+			ProjectId: *t.ProjectID,
+		}
+	}
 	if t.Completed != nil {
 		TimerSearch.CompletedOneof = &TimerSearch_Completed{
 			Completed: *t.Completed,
@@ -165,6 +219,41 @@ func ToTimerSearch(t *data.TimerSearch) *TimerSearch {
 			Archived: *t.Archived,
 		}
 	}
+	if t.Invoiced != nil {
+		TimerSearch.InvoicedOneof = &TimerSearch_Invoiced{
+			Invoiced: *t.Invoiced,
+		}
+	}
+	if t.Start != nil {
+		TimerSearch.StartOneof = &TimerSearch_Start{
+			Start: *t.Start,
+		}
+	}
+	if t.Finish != nil {
+		TimerSearch.FinishOneof = &TimerSearch_Finish{
+			Finish: *t.Finish,
+		}
+	}
+	if t.Limit != nil {
+		TimerSearch.LimitOneof = &TimerSearch_Limit{
+			Limit: int32(*t.Limit),
+		}
+	}
+	if t.Cursor != nil {
+		TimerSearch.CursorOneof = &TimerSearch_Cursor{
+			Cursor: *t.Cursor,
+		}
+	}
+	if t.Sort != nil {
+		TimerSearch.SortOneof = &TimerSearch_Sort{
+			Sort: *t.Sort,
+		}
+	}
+	if t.Deleted != nil {
+		TimerSearch.DeletedOneof = &TimerSearch_Deleted{
+			Deleted: *t.Deleted,
+		}
+	}
 	return TimerSearch
 }
 
@@ -290,6 +379,26 @@ func FromTimeSliceSearch(t *TimeSliceSearch) *data.TimeSliceSearch {
 		s := t.GetTimerId()
 		TimeSliceSearch.TimerID = &s
 	}
+	if t.StartOneof != nil {
+		s := t.GetStart()
+		TimeSliceSearch.Start = &s
+	}
+	if t.FinishOneof != nil {
+		s := t.GetFinish()
+		TimeSliceSearch.Finish = &s
+	}
+	if t.LimitOneof != nil {
+		s := int(t.GetLimit())
+		TimeSliceSearch.Limit = &s
+	}
+	if t.CursorOneof != nil {
+		s := t.GetCursor()
+		TimeSliceSearch.Cursor = &s
+	}
+	if t.SortOneof != nil {
+		s := t.GetSort()
+		TimeSliceSearch.Sort = &s
+	}
 	return TimeSliceSearch
 }
 
@@ -311,5 +420,570 @@ func ToTimeSliceSearch(t *data.TimeSliceSearch) *TimeSliceSearch {
 			Completed: *t.Completed,
 		}
 	}
+	if t.Start != nil {
+		TimeSliceSearch.StartOneof = &TimeSliceSearch_Start{
+			Start: *t.Start,
+		}
+	}
+	if t.Finish != nil {
+		TimeSliceSearch.FinishOneof = &TimeSliceSearch_Finish{
+			Finish: *t.Finish,
+		}
+	}
+	if t.Limit != nil {
+		TimeSliceSearch.LimitOneof = &TimeSliceSearch_Limit{
+			Limit: int32(*t.Limit),
+		}
+	}
+	if t.Cursor != nil {
+		TimeSliceSearch.CursorOneof = &TimeSliceSearch_Cursor{
+			Cursor: *t.Cursor,
+		}
+	}
+	if t.Sort != nil {
+		TimeSliceSearch.SortOneof = &TimeSliceSearch_Sort{
+			Sort: *t.Sort,
+		}
+	}
 	return TimeSliceSearch
 }
+
+func FromProjectPartial(p *data.ProjectPartial) *ProjectPartial {
+	if p == nil {
+		return nil
+	}
+	ProjectPartial := &ProjectPartial{}
+	if p.Name != nil {
+		ProjectPartial.NameOneof = &ProjectPartial_Name{
+			Name: *p.Name,
+		}
+	}
+	if p.Description != nil {
+		ProjectPartial.DescriptionOneof = &ProjectPartial_Description{
+			Description: *p.Description,
+		}
+	}
+	if p.Archived != nil {
+		ProjectPartial.ArchivedOneof = &ProjectPartial_Archived{
+			Archived: *p.Archived,
+		}
+	}
+	return ProjectPartial
+}
+
+func ToProjectPartial(p *ProjectPartial) *data.ProjectPartial {
+	if p == nil {
+		return nil
+	}
+	ProjectPartial := &data.ProjectPartial{}
+	if p.NameOneof != nil {
+		s := p.GetName()
+		ProjectPartial.Name = &s
+	}
+	if p.DescriptionOneof != nil {
+		s := p.GetDescription()
+		ProjectPartial.Description = &s
+	}
+	if p.ArchivedOneof != nil {
+		s := p.GetArchived()
+		ProjectPartial.Archived = &s
+	}
+	return ProjectPartial
+}
+
+func FromProject(p *data.Project) *Project {
+	if p == nil {
+		return nil
+	}
+	return &Project{
+		Id:            p.ID,
+		Name:          p.Name,
+		Description:   p.Description,
+		Archived:      p.Archived,
+		LastUpdated:   p.LastUpdated,
+		LastUpdatedBy: p.LastUpdatedBy,
+		Version:       int32(p.Version),
+	}
+}
+
+func ToProject(p *Project) *data.Project {
+	if p == nil {
+		return nil
+	}
+	return &data.Project{
+		ID:            p.GetId(),
+		Name:          p.GetName(),
+		Description:   p.GetDescription(),
+		Archived:      p.GetArchived(),
+		LastUpdated:   p.GetLastUpdated(),
+		LastUpdatedBy: p.GetLastUpdatedBy(),
+		Version:       int(p.GetVersion()),
+	}
+}
+
+func FromProjects(p []*data.Project) []*Project {
+	var Projects []*Project
+	for _, p := range p {
+		Projects = append(Projects, FromProject(p))
+	}
+	return Projects
+}
+
+func ToProjects(p []*Project) []*data.Project {
+	var Projects []*data.Project
+	for _, p := range p {
+		Projects = append(Projects, ToProject(p))
+	}
+	return Projects
+}
+
+func FromProjectSearch(p *ProjectSearch) *data.ProjectSearch {
+	if p == nil {
+		return nil
+	}
+	ProjectSearch := &data.ProjectSearch{
+		IDs:   p.GetIds(),
+		Names: p.GetNames(),
+	}
+	if p.ArchivedOneof != nil {
+		s := p.GetArchived()
+		ProjectSearch.Archived = &s
+	}
+	return ProjectSearch
+}
+
+func ToProjectSearch(p *data.ProjectSearch) *ProjectSearch {
+	if p == nil {
+		return nil
+	}
+	ProjectSearch := &ProjectSearch{
+		Ids:   p.IDs,
+		Names: p.Names,
+	}
+	if p.Archived != nil {
+		ProjectSearch.ArchivedOneof = &ProjectSearch_Archived{
+			Archived: *p.Archived,
+		}
+	}
+	return ProjectSearch
+}
+
+func FromRateCardPartial(r *data.RateCardPartial) *RateCardPartial {
+	if r == nil {
+		return nil
+	}
+	RateCardPartial := &RateCardPartial{}
+	if r.EmployeeID != nil {
+		RateCardPartial.EmployeeIdOneof = &RateCardPartial_EmployeeId{
+			EmployeeId: *r.EmployeeID,
+		}
+	}
+	if r.ProjectID != nil {
+		RateCardPartial.ProjectIdOneof = &RateCardPartial_ProjectId{
+			ProjectId: *r.ProjectID,
+		}
+	}
+	if r.Rate != nil {
+		RateCardPartial.RateOneof = &RateCardPartial_Rate{
+			Rate: *r.Rate,
+		}
+	}
+	if r.Currency != nil {
+		RateCardPartial.CurrencyOneof = &RateCardPartial_Currency{
+			Currency: *r.Currency,
+		}
+	}
+	if r.EffectiveFrom != nil {
+		RateCardPartial.EffectiveFromOneof = &RateCardPartial_EffectiveFrom{
+			EffectiveFrom: *r.EffectiveFrom,
+		}
+	}
+	if r.EffectiveTo != nil {
+		RateCardPartial.EffectiveToOneof = &RateCardPartial_EffectiveTo{
+			EffectiveTo: *r.EffectiveTo,
+		}
+	}
+	return RateCardPartial
+}
+
+func ToRateCardPartial(r *RateCardPartial) *data.RateCardPartial {
+	if r == nil {
+		return nil
+	}
+	RateCardPartial := &data.RateCardPartial{}
+	if r.EmployeeIdOneof != nil {
+		s := r.GetEmployeeId()
+		RateCardPartial.EmployeeID = &s
+	}
+	if r.ProjectIdOneof != nil {
+		s := r.GetProjectId()
+		RateCardPartial.ProjectID = &s
+	}
+	if r.RateOneof != nil {
+		s := r.GetRate()
+		RateCardPartial.Rate = &s
+	}
+	if r.CurrencyOneof != nil {
+		s := r.GetCurrency()
+		RateCardPartial.Currency = &s
+	}
+	if r.EffectiveFromOneof != nil {
+		s := r.GetEffectiveFrom()
+		RateCardPartial.EffectiveFrom = &s
+	}
+	if r.EffectiveToOneof != nil {
+		s := r.GetEffectiveTo()
+		RateCardPartial.EffectiveTo = &s
+	}
+	return RateCardPartial
+}
+
+func FromRateCard(r *data.RateCard) *RateCard {
+	if r == nil {
+		return nil
+	}
+	return &RateCard{
+		Id:            r.ID,
+		EmployeeId:    r.EmployeeID,
+		ProjectId:     r.ProjectID,
+		Rate:          r.Rate,
+		Currency:      r.Currency,
+		EffectiveFrom: r.EffectiveFrom,
+		EffectiveTo:   r.EffectiveTo,
+		LastUpdated:   r.LastUpdated,
+		LastUpdatedBy: r.LastUpdatedBy,
+		Version:       int32(r.Version),
+	}
+}
+
+func ToRateCard(r *RateCard) *data.RateCard {
+	if r == nil {
+		return nil
+	}
+	return &data.RateCard{
+		ID:            r.GetId(),
+		EmployeeID:    r.GetEmployeeId(),
+		ProjectID:     r.GetProjectId(),
+		Rate:          r.GetRate(),
+		Currency:      r.GetCurrency(),
+		EffectiveFrom: r.GetEffectiveFrom(),
+		EffectiveTo:   r.GetEffectiveTo(),
+		LastUpdated:   r.GetLastUpdated(),
+		LastUpdatedBy: r.GetLastUpdatedBy(),
+		Version:       int(r.GetVersion()),
+	}
+}
+
+func FromRateCards(r []*data.RateCard) []*RateCard {
+	var RateCards []*RateCard
+	for _, r := range r {
+		RateCards = append(RateCards, FromRateCard(r))
+	}
+	return RateCards
+}
+
+func ToRateCards(r []*RateCard) []*data.RateCard {
+	var RateCards []*data.RateCard
+	for _, r := range r {
+		RateCards = append(RateCards, ToRateCard(r))
+	}
+	return RateCards
+}
+
+func FromRateCardSearch(r *RateCardSearch) *data.RateCardSearch {
+	if r == nil {
+		return nil
+	}
+	RateCardSearch := &data.RateCardSearch{
+		IDs:         r.GetIds(),
+		EmployeeIDs: r.GetEmployeeIds(),
+		ProjectIDs:  r.GetProjectIds(),
+	}
+	if r.EffectiveAtOneof != nil {
+		s := r.GetEffectiveAt()
+		RateCardSearch.EffectiveAt = &s
+	}
+	return RateCardSearch
+}
+
+func ToRateCardSearch(r *data.RateCardSearch) *RateCardSearch {
+	if r == nil {
+		return nil
+	}
+	RateCardSearch := &RateCardSearch{
+		Ids:         r.IDs,
+		EmployeeIds: r.EmployeeIDs,
+		ProjectIds:  r.ProjectIDs,
+	}
+	if r.EffectiveAt != nil {
+		RateCardSearch.EffectiveAtOneof = &RateCardSearch_EffectiveAt{
+			EffectiveAt: *r.EffectiveAt,
+		}
+	}
+	return RateCardSearch
+}
+
+func FromInvoicePartial(i *data.InvoicePartial) *InvoicePartial {
+	if i == nil {
+		return nil
+	}
+	InvoicePartial := &InvoicePartial{}
+	if i.EmployeeID != nil {
+		InvoicePartial.EmployeeIdOneof = &InvoicePartial_EmployeeId{
+			EmployeeId: *i.EmployeeID,
+		}
+	}
+	if i.ProjectID != nil {
+		InvoicePartial.ProjectIdOneof = &InvoicePartial_ProjectId{
+			ProjectId: *i.ProjectID,
+		}
+	}
+	if i.Start != nil {
+		InvoicePartial.StartOneof = &InvoicePartial_Start{
+			Start: *i.Start,
+		}
+	}
+	if i.Finish != nil {
+		InvoicePartial.FinishOneof = &InvoicePartial_Finish{
+			Finish: *i.Finish,
+		}
+	}
+	return InvoicePartial
+}
+
+func ToInvoicePartial(i *InvoicePartial) *data.InvoicePartial {
+	if i == nil {
+		return nil
+	}
+	InvoicePartial := &data.InvoicePartial{}
+	if i.EmployeeIdOneof != nil {
+		s := i.GetEmployeeId()
+		InvoicePartial.EmployeeID = &s
+	}
+	if i.ProjectIdOneof != nil {
+		s := i.GetProjectId()
+		InvoicePartial.ProjectID = &s
+	}
+	if i.StartOneof != nil {
+		s := i.GetStart()
+		InvoicePartial.Start = &s
+	}
+	if i.FinishOneof != nil {
+		s := i.GetFinish()
+		InvoicePartial.Finish = &s
+	}
+	return InvoicePartial
+}
+
+func FromInvoice(i *data.Invoice) *Invoice {
+	if i == nil {
+		return nil
+	}
+	Invoice := &Invoice{
+		Id:            i.ID,
+		EmployeeId:    i.EmployeeID,
+		ProjectId:     i.ProjectID,
+		Start:         i.Start,
+		Finish:        i.Finish,
+		Currency:      i.Currency,
+		Total:         i.Total,
+		LastUpdated:   i.LastUpdated,
+		LastUpdatedBy: i.LastUpdatedBy,
+		Version:       int32(i.Version),
+	}
+	for _, lineItem := range i.LineItems {
+		Invoice.LineItems = append(Invoice.LineItems, &InvoiceLineItem{
+			TimerId:     lineItem.TimerID,
+			RateCardId:  lineItem.RateCardID,
+			ElapsedTime: lineItem.ElapsedTime,
+			Rate:        lineItem.Rate,
+			Amount:      lineItem.Amount,
+		})
+	}
+	return Invoice
+}
+
+func ToInvoice(i *Invoice) *data.Invoice {
+	if i == nil {
+		return nil
+	}
+	Invoice := &data.Invoice{
+		ID:            i.GetId(),
+		EmployeeID:    i.GetEmployeeId(),
+		ProjectID:     i.GetProjectId(),
+		Start:         i.GetStart(),
+		Finish:        i.GetFinish(),
+		Currency:      i.GetCurrency(),
+		Total:         i.GetTotal(),
+		LastUpdated:   i.GetLastUpdated(),
+		LastUpdatedBy: i.GetLastUpdatedBy(),
+		Version:       int(i.GetVersion()),
+	}
+	for _, lineItem := range i.GetLineItems() {
+		Invoice.LineItems = append(Invoice.LineItems, data.InvoiceLineItem{
+			TimerID:     lineItem.GetTimerId(),
+			RateCardID:  lineItem.GetRateCardId(),
+			ElapsedTime: lineItem.GetElapsedTime(),
+			Rate:        lineItem.GetRate(),
+			Amount:      lineItem.GetAmount(),
+		})
+	}
+	return Invoice
+}
+
+func FromInvoices(i []*data.Invoice) []*Invoice {
+	var Invoices []*Invoice
+	for _, i := range i {
+		Invoices = append(Invoices, FromInvoice(i))
+	}
+	return Invoices
+}
+
+func ToInvoices(i []*Invoice) []*data.Invoice {
+	var Invoices []*data.Invoice
+	for _, i := range i {
+		Invoices = append(Invoices, ToInvoice(i))
+	}
+	return Invoices
+}
+
+func FromInvoiceSearch(i *InvoiceSearch) *data.InvoiceSearch {
+	if i == nil {
+		return nil
+	}
+	return &data.InvoiceSearch{
+		IDs:         i.GetIds(),
+		EmployeeIDs: i.GetEmployeeIds(),
+		ProjectIDs:  i.GetProjectIds(),
+	}
+}
+
+func ToInvoiceSearch(i *data.InvoiceSearch) *InvoiceSearch {
+	if i == nil {
+		return nil
+	}
+	return &InvoiceSearch{
+		Ids:         i.IDs,
+		EmployeeIds: i.EmployeeIDs,
+		ProjectIds:  i.ProjectIDs,
+	}
+}
+
+func FromTimesheet(t *data.Timesheet) *Timesheet {
+	if t == nil {
+		return nil
+	}
+	Timesheet := &Timesheet{
+		EmployeeId:  t.EmployeeID,
+		Timezone:    t.Timezone,
+		Start:       t.Start,
+		Finish:      t.Finish,
+		ElapsedTime: t.ElapsedTime,
+	}
+	for _, day := range t.Days {
+		Timesheet.Days = append(Timesheet.Days, &TimesheetDay{
+			Date:        day.Date,
+			ElapsedTime: day.ElapsedTime,
+		})
+	}
+	for _, week := range t.Weeks {
+		Timesheet.Weeks = append(Timesheet.Weeks, &TimesheetWeek{
+			Year:        int32(week.Year),
+			Week:        int32(week.Week),
+			ElapsedTime: week.ElapsedTime,
+		})
+	}
+	return Timesheet
+}
+
+func ToTimesheet(t *Timesheet) *data.Timesheet {
+	if t == nil {
+		return nil
+	}
+	Timesheet := &data.Timesheet{
+		EmployeeID:  t.GetEmployeeId(),
+		Timezone:    t.GetTimezone(),
+		Start:       t.GetStart(),
+		Finish:      t.GetFinish(),
+		ElapsedTime: t.GetElapsedTime(),
+		Days:        []data.TimesheetDay{},
+		Weeks:       []data.TimesheetWeek{},
+	}
+	for _, day := range t.GetDays() {
+		Timesheet.Days = append(Timesheet.Days, data.TimesheetDay{
+			Date:        day.GetDate(),
+			ElapsedTime: day.GetElapsedTime(),
+		})
+	}
+	for _, week := range t.GetWeeks() {
+		Timesheet.Weeks = append(Timesheet.Weeks, data.TimesheetWeek{
+			Year:        int(week.GetYear()),
+			Week:        int(week.GetWeek()),
+			ElapsedTime: week.GetElapsedTime(),
+		})
+	}
+	return Timesheet
+}
+
+func FromTimesheets(t []*data.Timesheet) []*Timesheet {
+	var Timesheets []*Timesheet
+	for _, t := range t {
+		Timesheets = append(Timesheets, FromTimesheet(t))
+	}
+	return Timesheets
+}
+
+func ToTimesheets(t []*Timesheet) []*data.Timesheet {
+	var Timesheets []*data.Timesheet
+	for _, t := range t {
+		Timesheets = append(Timesheets, ToTimesheet(t))
+	}
+	return Timesheets
+}
+
+func FromTimesheetSearch(t *TimesheetSearch) *data.TimesheetSearch {
+	if t == nil {
+		return nil
+	}
+	TimesheetSearch := &data.TimesheetSearch{
+		EmployeeIDs: t.GetEmployeeIds(),
+	}
+	if t.StartOneof != nil {
+		s := t.GetStart()
+		TimesheetSearch.Start = &s
+	}
+	if t.FinishOneof != nil {
+		s := t.GetFinish()
+		TimesheetSearch.Finish = &s
+	}
+	if t.TimezoneOneof != nil {
+		s := t.GetTimezone()
+		TimesheetSearch.Timezone = &s
+	}
+	return TimesheetSearch
+}
+
+func ToTimesheetSearch(t *data.TimesheetSearch) *TimesheetSearch {
+	if t == nil {
+		return nil
+	}
+	TimesheetSearch := &TimesheetSearch{
+		EmployeeIds: t.EmployeeIDs,
+	}
+	if t.Start != nil {
+		TimesheetSearch.StartOneof = &TimesheetSearch_Start{
+			Start: *t.Start,
+		}
+	}
+	if t.Finish != nil {
+		TimesheetSearch.FinishOneof = &TimesheetSearch_Finish{
+			Finish: *t.Finish,
+		}
+	}
+	if t.Timezone != nil {
+		TimesheetSearch.TimezoneOneof = &TimesheetSearch_Timezone{
+			Timezone: *t.Timezone,
+		}
+	}
+	return TimesheetSearch
+}
@@ -0,0 +1,1211 @@
+//
+//go_bludgeon_timers defines a set of types for use with the timers service
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.28.0
+// 	protoc        v3.20.1
+// source: invoices.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// InvoiceCreateRequest
+type InvoiceCreateRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// invoice_partial
+	InvoicePartial *InvoicePartial `protobuf:"bytes,1,opt,name=invoice_partial,json=invoicePartial,proto3" json:"invoice_partial,omitempty"`
+}
+
+func (x *InvoiceCreateRequest) Reset() {
+	*x = InvoiceCreateRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_invoices_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *InvoiceCreateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InvoiceCreateRequest) ProtoMessage() {}
+
+func (x *InvoiceCreateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_invoices_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InvoiceCreateRequest.ProtoReflect.Descriptor instead.
+func (*InvoiceCreateRequest) Descriptor() ([]byte, []int) {
+	return file_invoices_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *InvoiceCreateRequest) GetInvoicePartial() *InvoicePartial {
+	if x != nil {
+		return x.InvoicePartial
+	}
+	return nil
+}
+
+// InvoiceCreateResponse
+type InvoiceCreateResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// invoice
+	Invoice *Invoice `protobuf:"bytes,1,opt,name=invoice,proto3" json:"invoice,omitempty"`
+}
+
+func (x *InvoiceCreateResponse) Reset() {
+	*x = InvoiceCreateResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_invoices_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *InvoiceCreateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InvoiceCreateResponse) ProtoMessage() {}
+
+func (x *InvoiceCreateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_invoices_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InvoiceCreateResponse.ProtoReflect.Descriptor instead.
+func (*InvoiceCreateResponse) Descriptor() ([]byte, []int) {
+	return file_invoices_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *InvoiceCreateResponse) GetInvoice() *Invoice {
+	if x != nil {
+		return x.Invoice
+	}
+	return nil
+}
+
+// InvoiceReadRequest
+type InvoiceReadRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// id
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *InvoiceReadRequest) Reset() {
+	*x = InvoiceReadRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_invoices_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *InvoiceReadRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InvoiceReadRequest) ProtoMessage() {}
+
+func (x *InvoiceReadRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_invoices_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InvoiceReadRequest.ProtoReflect.Descriptor instead.
+func (*InvoiceReadRequest) Descriptor() ([]byte, []int) {
+	return file_invoices_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *InvoiceReadRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// InvoiceReadResponse
+type InvoiceReadResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// invoice
+	Invoice *Invoice `protobuf:"bytes,1,opt,name=invoice,proto3" json:"invoice,omitempty"`
+}
+
+func (x *InvoiceReadResponse) Reset() {
+	*x = InvoiceReadResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_invoices_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *InvoiceReadResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InvoiceReadResponse) ProtoMessage() {}
+
+func (x *InvoiceReadResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_invoices_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InvoiceReadResponse.ProtoReflect.Descriptor instead.
+func (*InvoiceReadResponse) Descriptor() ([]byte, []int) {
+	return file_invoices_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *InvoiceReadResponse) GetInvoice() *Invoice {
+	if x != nil {
+		return x.Invoice
+	}
+	return nil
+}
+
+// InvoiceDeleteRequest
+type InvoiceDeleteRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// id
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *InvoiceDeleteRequest) Reset() {
+	*x = InvoiceDeleteRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_invoices_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *InvoiceDeleteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InvoiceDeleteRequest) ProtoMessage() {}
+
+func (x *InvoiceDeleteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_invoices_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InvoiceDeleteRequest.ProtoReflect.Descriptor instead.
+func (*InvoiceDeleteRequest) Descriptor() ([]byte, []int) {
+	return file_invoices_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *InvoiceDeleteRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// InvoiceDeleteResponse
+type InvoiceDeleteResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *InvoiceDeleteResponse) Reset() {
+	*x = InvoiceDeleteResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_invoices_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *InvoiceDeleteResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InvoiceDeleteResponse) ProtoMessage() {}
+
+func (x *InvoiceDeleteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_invoices_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InvoiceDeleteResponse.ProtoReflect.Descriptor instead.
+func (*InvoiceDeleteResponse) Descriptor() ([]byte, []int) {
+	return file_invoices_proto_rawDescGZIP(), []int{5}
+}
+
+// InvoicesReadRequest
+type InvoicesReadRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// invoice_search
+	InvoiceSearch *InvoiceSearch `protobuf:"bytes,1,opt,name=invoice_search,json=invoiceSearch,proto3" json:"invoice_search,omitempty"`
+}
+
+func (x *InvoicesReadRequest) Reset() {
+	*x = InvoicesReadRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_invoices_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *InvoicesReadRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InvoicesReadRequest) ProtoMessage() {}
+
+func (x *InvoicesReadRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_invoices_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InvoicesReadRequest.ProtoReflect.Descriptor instead.
+func (*InvoicesReadRequest) Descriptor() ([]byte, []int) {
+	return file_invoices_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *InvoicesReadRequest) GetInvoiceSearch() *InvoiceSearch {
+	if x != nil {
+		return x.InvoiceSearch
+	}
+	return nil
+}
+
+// InvoicesReadResponse
+type InvoicesReadResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// invoices
+	Invoices []*Invoice `protobuf:"bytes,1,rep,name=invoices,proto3" json:"invoices,omitempty"`
+}
+
+func (x *InvoicesReadResponse) Reset() {
+	*x = InvoicesReadResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_invoices_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *InvoicesReadResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InvoicesReadResponse) ProtoMessage() {}
+
+func (x *InvoicesReadResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_invoices_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InvoicesReadResponse.ProtoReflect.Descriptor instead.
+func (*InvoicesReadResponse) Descriptor() ([]byte, []int) {
+	return file_invoices_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *InvoicesReadResponse) GetInvoices() []*Invoice {
+	if x != nil {
+		return x.Invoices
+	}
+	return nil
+}
+
+// InvoiceSearch
+type InvoiceSearch struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// ids
+	Ids []string `protobuf:"bytes,1,rep,name=ids,proto3" json:"ids,omitempty"`
+	// employee_ids
+	EmployeeIds []string `protobuf:"bytes,2,rep,name=employee_ids,json=employeeIds,proto3" json:"employee_ids,omitempty"`
+	// project_ids
+	ProjectIds []string `protobuf:"bytes,3,rep,name=project_ids,json=projectIds,proto3" json:"project_ids,omitempty"`
+}
+
+func (x *InvoiceSearch) Reset() {
+	*x = InvoiceSearch{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_invoices_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *InvoiceSearch) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InvoiceSearch) ProtoMessage() {}
+
+func (x *InvoiceSearch) ProtoReflect() protoreflect.Message {
+	mi := &file_invoices_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InvoiceSearch.ProtoReflect.Descriptor instead.
+func (*InvoiceSearch) Descriptor() ([]byte, []int) {
+	return file_invoices_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *InvoiceSearch) GetIds() []string {
+	if x != nil {
+		return x.Ids
+	}
+	return nil
+}
+
+func (x *InvoiceSearch) GetEmployeeIds() []string {
+	if x != nil {
+		return x.EmployeeIds
+	}
+	return nil
+}
+
+func (x *InvoiceSearch) GetProjectIds() []string {
+	if x != nil {
+		return x.ProjectIds
+	}
+	return nil
+}
+
+// InvoicePartial
+type InvoicePartial struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// employee_id_oneof
+	//
+	// Types that are assignable to EmployeeIdOneof:
+	//
+	//	*InvoicePartial_EmployeeId
+	EmployeeIdOneof isInvoicePartial_EmployeeIdOneof `protobuf_oneof:"employee_id_oneof"`
+	// project_id_oneof
+	//
+	// Types that are assignable to ProjectIdOneof:
+	//
+	//	*InvoicePartial_ProjectId
+	ProjectIdOneof isInvoicePartial_ProjectIdOneof `protobuf_oneof:"project_id_oneof"`
+	// start_oneof
+	//
+	// Types that are assignable to StartOneof:
+	//
+	//	*InvoicePartial_Start
+	StartOneof isInvoicePartial_StartOneof `protobuf_oneof:"start_oneof"`
+	// finish_oneof
+	//
+	// Types that are assignable to FinishOneof:
+	//
+	//	*InvoicePartial_Finish
+	FinishOneof isInvoicePartial_FinishOneof `protobuf_oneof:"finish_oneof"`
+}
+
+func (x *InvoicePartial) Reset() {
+	*x = InvoicePartial{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_invoices_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *InvoicePartial) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InvoicePartial) ProtoMessage() {}
+
+func (x *InvoicePartial) ProtoReflect() protoreflect.Message {
+	mi := &file_invoices_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InvoicePartial.ProtoReflect.Descriptor instead.
+func (*InvoicePartial) Descriptor() ([]byte, []int) {
+	return file_invoices_proto_rawDescGZIP(), []int{9}
+}
+
+func (m *InvoicePartial) GetEmployeeIdOneof() isInvoicePartial_EmployeeIdOneof {
+	if m != nil {
+		return m.EmployeeIdOneof
+	}
+	return nil
+}
+
+func (x *InvoicePartial) GetEmployeeId() string {
+	if x, ok := x.GetEmployeeIdOneof().(*InvoicePartial_EmployeeId); ok {
+		return x.EmployeeId
+	}
+	return ""
+}
+
+func (m *InvoicePartial) GetProjectIdOneof() isInvoicePartial_ProjectIdOneof {
+	if m != nil {
+		return m.ProjectIdOneof
+	}
+	return nil
+}
+
+func (x *InvoicePartial) GetProjectId() string {
+	if x, ok := x.GetProjectIdOneof().(*InvoicePartial_ProjectId); ok {
+		return x.ProjectId
+	}
+	return ""
+}
+
+func (m *InvoicePartial) GetStartOneof() isInvoicePartial_StartOneof {
+	if m != nil {
+		return m.StartOneof
+	}
+	return nil
+}
+
+func (x *InvoicePartial) GetStart() int64 {
+	if x, ok := x.GetStartOneof().(*InvoicePartial_Start); ok {
+		return x.Start
+	}
+	return 0
+}
+
+func (m *InvoicePartial) GetFinishOneof() isInvoicePartial_FinishOneof {
+	if m != nil {
+		return m.FinishOneof
+	}
+	return nil
+}
+
+func (x *InvoicePartial) GetFinish() int64 {
+	if x, ok := x.GetFinishOneof().(*InvoicePartial_Finish); ok {
+		return x.Finish
+	}
+	return 0
+}
+
+type isInvoicePartial_EmployeeIdOneof interface {
+	isInvoicePartial_EmployeeIdOneof()
+}
+
+type InvoicePartial_EmployeeId struct {
+	// employee_id
+	EmployeeId string `protobuf:"bytes,1,opt,name=employee_id,json=employeeId,proto3,oneof"`
+}
+
+func (*InvoicePartial_EmployeeId) isInvoicePartial_EmployeeIdOneof() {}
+
+type isInvoicePartial_ProjectIdOneof interface {
+	isInvoicePartial_ProjectIdOneof()
+}
+
+type InvoicePartial_ProjectId struct {
+	// project_id
+	ProjectId string `protobuf:"bytes,2,opt,name=project_id,json=projectId,proto3,oneof"`
+}
+
+func (*InvoicePartial_ProjectId) isInvoicePartial_ProjectIdOneof() {}
+
+type isInvoicePartial_StartOneof interface {
+	isInvoicePartial_StartOneof()
+}
+
+type InvoicePartial_Start struct {
+	// start
+	Start int64 `protobuf:"varint,3,opt,name=start,proto3,oneof"`
+}
+
+func (*InvoicePartial_Start) isInvoicePartial_StartOneof() {}
+
+type isInvoicePartial_FinishOneof interface {
+	isInvoicePartial_FinishOneof()
+}
+
+type InvoicePartial_Finish struct {
+	// finish
+	Finish int64 `protobuf:"varint,4,opt,name=finish,proto3,oneof"`
+}
+
+func (*InvoicePartial_Finish) isInvoicePartial_FinishOneof() {}
+
+// InvoiceLineItem
+type InvoiceLineItem struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// timer_id
+	TimerId string `protobuf:"bytes,1,opt,name=timer_id,json=timerId,proto3" json:"timer_id,omitempty"`
+	// rate_card_id
+	RateCardId string `protobuf:"bytes,2,opt,name=rate_card_id,json=rateCardId,proto3" json:"rate_card_id,omitempty"`
+	// elapsed_time
+	ElapsedTime int64 `protobuf:"varint,3,opt,name=elapsed_time,json=elapsedTime,proto3" json:"elapsed_time,omitempty"`
+	// rate
+	Rate int64 `protobuf:"varint,4,opt,name=rate,proto3" json:"rate,omitempty"`
+	// amount
+	Amount int64 `protobuf:"varint,5,opt,name=amount,proto3" json:"amount,omitempty"`
+}
+
+func (x *InvoiceLineItem) Reset() {
+	*x = InvoiceLineItem{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_invoices_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *InvoiceLineItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InvoiceLineItem) ProtoMessage() {}
+
+func (x *InvoiceLineItem) ProtoReflect() protoreflect.Message {
+	mi := &file_invoices_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InvoiceLineItem.ProtoReflect.Descriptor instead.
+func (*InvoiceLineItem) Descriptor() ([]byte, []int) {
+	return file_invoices_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *InvoiceLineItem) GetTimerId() string {
+	if x != nil {
+		return x.TimerId
+	}
+	return ""
+}
+
+func (x *InvoiceLineItem) GetRateCardId() string {
+	if x != nil {
+		return x.RateCardId
+	}
+	return ""
+}
+
+func (x *InvoiceLineItem) GetElapsedTime() int64 {
+	if x != nil {
+		return x.ElapsedTime
+	}
+	return 0
+}
+
+func (x *InvoiceLineItem) GetRate() int64 {
+	if x != nil {
+		return x.Rate
+	}
+	return 0
+}
+
+func (x *InvoiceLineItem) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+// Invoice
+type Invoice struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// id
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// employee_id
+	EmployeeId string `protobuf:"bytes,2,opt,name=employee_id,json=employeeId,proto3" json:"employee_id,omitempty"`
+	// project_id
+	ProjectId string `protobuf:"bytes,3,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	// start
+	Start int64 `protobuf:"varint,4,opt,name=start,proto3" json:"start,omitempty"`
+	// finish
+	Finish int64 `protobuf:"varint,5,opt,name=finish,proto3" json:"finish,omitempty"`
+	// currency
+	Currency string `protobuf:"bytes,6,opt,name=currency,proto3" json:"currency,omitempty"`
+	// total
+	Total int64 `protobuf:"varint,7,opt,name=total,proto3" json:"total,omitempty"`
+	// line_items
+	LineItems []*InvoiceLineItem `protobuf:"bytes,8,rep,name=line_items,json=lineItems,proto3" json:"line_items,omitempty"`
+	// last_updated
+	LastUpdated int64 `protobuf:"varint,9,opt,name=last_updated,json=lastUpdated,proto3" json:"last_updated,omitempty"`
+	// last_updated_by
+	LastUpdatedBy string `protobuf:"bytes,10,opt,name=last_updated_by,json=lastUpdatedBy,proto3" json:"last_updated_by,omitempty"`
+	// version
+	Version int32 `protobuf:"varint,11,opt,name=version,proto3" json:"version,omitempty"`
+}
+
+func (x *Invoice) Reset() {
+	*x = Invoice{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_invoices_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Invoice) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Invoice) ProtoMessage() {}
+
+func (x *Invoice) ProtoReflect() protoreflect.Message {
+	mi := &file_invoices_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Invoice.ProtoReflect.Descriptor instead.
+func (*Invoice) Descriptor() ([]byte, []int) {
+	return file_invoices_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *Invoice) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Invoice) GetEmployeeId() string {
+	if x != nil {
+		return x.EmployeeId
+	}
+	return ""
+}
+
+func (x *Invoice) GetProjectId() string {
+	if x != nil {
+		return x.ProjectId
+	}
+	return ""
+}
+
+func (x *Invoice) GetStart() int64 {
+	if x != nil {
+		return x.Start
+	}
+	return 0
+}
+
+func (x *Invoice) GetFinish() int64 {
+	if x != nil {
+		return x.Finish
+	}
+	return 0
+}
+
+func (x *Invoice) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *Invoice) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *Invoice) GetLineItems() []*InvoiceLineItem {
+	if x != nil {
+		return x.LineItems
+	}
+	return nil
+}
+
+func (x *Invoice) GetLastUpdated() int64 {
+	if x != nil {
+		return x.LastUpdated
+	}
+	return 0
+}
+
+func (x *Invoice) GetLastUpdatedBy() string {
+	if x != nil {
+		return x.LastUpdatedBy
+	}
+	return ""
+}
+
+func (x *Invoice) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+var File_invoices_proto protoreflect.FileDescriptor
+
+var file_invoices_proto_rawDesc = []byte{
+	0x0a, 0x0e, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x12, 0x12, 0x67, 0x6f, 0x5f, 0x62, 0x6c, 0x75, 0x64, 0x67, 0x65, 0x6f, 0x6e, 0x5f, 0x74, 0x69,
+	0x6d, 0x65, 0x72, 0x73, 0x22, 0x63, 0x0a, 0x14, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x4b, 0x0a, 0x0f,
+	0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x5f, 0x70, 0x61, 0x72, 0x74, 0x69, 0x61, 0x6c, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x67, 0x6f, 0x5f, 0x62, 0x6c, 0x75, 0x64, 0x67,
+	0x65, 0x6f, 0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x72, 0x73, 0x2e, 0x49, 0x6e, 0x76, 0x6f, 0x69,
+	0x63, 0x65, 0x50, 0x61, 0x72, 0x74, 0x69, 0x61, 0x6c, 0x52, 0x0e, 0x69, 0x6e, 0x76, 0x6f, 0x69,
+	0x63, 0x65, 0x50, 0x61, 0x72, 0x74, 0x69, 0x61, 0x6c, 0x22, 0x4e, 0x0a, 0x15, 0x49, 0x6e, 0x76,
+	0x6f, 0x69, 0x63, 0x65, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x35, 0x0a, 0x07, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x6f, 0x5f, 0x62, 0x6c, 0x75, 0x64, 0x67, 0x65, 0x6f,
+	0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x72, 0x73, 0x2e, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65,
+	0x52, 0x07, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x22, 0x24, 0x0a, 0x12, 0x49, 0x6e, 0x76,
+	0x6f, 0x69, 0x63, 0x65, 0x52, 0x65, 0x61, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22,
+	0x4c, 0x0a, 0x13, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x52, 0x65, 0x61, 0x64, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x35, 0x0a, 0x07, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x6f, 0x5f, 0x62, 0x6c, 0x75,
+	0x64, 0x67, 0x65, 0x6f, 0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x72, 0x73, 0x2e, 0x49, 0x6e, 0x76,
+	0x6f, 0x69, 0x63, 0x65, 0x52, 0x07, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x22, 0x26, 0x0a,
+	0x14, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x17, 0x0a, 0x15, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x5f,
+	0x0a, 0x13, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x73, 0x52, 0x65, 0x61, 0x64, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x48, 0x0a, 0x0e, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65,
+	0x5f, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e,
+	0x67, 0x6f, 0x5f, 0x62, 0x6c, 0x75, 0x64, 0x67, 0x65, 0x6f, 0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65,
+	0x72, 0x73, 0x2e, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68,
+	0x52, 0x0d, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x22,
+	0x4f, 0x0a, 0x14, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x73, 0x52, 0x65, 0x61, 0x64, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x37, 0x0a, 0x08, 0x69, 0x6e, 0x76, 0x6f, 0x69,
+	0x63, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x6f, 0x5f, 0x62,
+	0x6c, 0x75, 0x64, 0x67, 0x65, 0x6f, 0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x72, 0x73, 0x2e, 0x49,
+	0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x52, 0x08, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x73,
+	0x22, 0x65, 0x0a, 0x0d, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x53, 0x65, 0x61, 0x72, 0x63,
+	0x68, 0x12, 0x10, 0x0a, 0x03, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x03,
+	0x69, 0x64, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x65, 0x6d, 0x70, 0x6c, 0x6f, 0x79, 0x65, 0x65, 0x5f,
+	0x69, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x65, 0x6d, 0x70, 0x6c, 0x6f,
+	0x79, 0x65, 0x65, 0x49, 0x64, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63,
+	0x74, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x72, 0x6f,
+	0x6a, 0x65, 0x63, 0x74, 0x49, 0x64, 0x73, 0x22, 0xce, 0x01, 0x0a, 0x0e, 0x49, 0x6e, 0x76, 0x6f,
+	0x69, 0x63, 0x65, 0x50, 0x61, 0x72, 0x74, 0x69, 0x61, 0x6c, 0x12, 0x21, 0x0a, 0x0b, 0x65, 0x6d,
+	0x70, 0x6c, 0x6f, 0x79, 0x65, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48,
+	0x00, 0x52, 0x0a, 0x65, 0x6d, 0x70, 0x6c, 0x6f, 0x79, 0x65, 0x65, 0x49, 0x64, 0x12, 0x1f, 0x0a,
+	0x0a, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x48, 0x01, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x49, 0x64, 0x12, 0x16,
+	0x0a, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x48, 0x02, 0x52,
+	0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x12, 0x18, 0x0a, 0x06, 0x66, 0x69, 0x6e, 0x69, 0x73, 0x68,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x48, 0x03, 0x52, 0x06, 0x66, 0x69, 0x6e, 0x69, 0x73, 0x68,
+	0x42, 0x13, 0x0a, 0x11, 0x65, 0x6d, 0x70, 0x6c, 0x6f, 0x79, 0x65, 0x65, 0x5f, 0x69, 0x64, 0x5f,
+	0x6f, 0x6e, 0x65, 0x6f, 0x66, 0x42, 0x12, 0x0a, 0x10, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74,
+	0x5f, 0x69, 0x64, 0x5f, 0x6f, 0x6e, 0x65, 0x6f, 0x66, 0x42, 0x0d, 0x0a, 0x0b, 0x73, 0x74, 0x61,
+	0x72, 0x74, 0x5f, 0x6f, 0x6e, 0x65, 0x6f, 0x66, 0x42, 0x0e, 0x0a, 0x0c, 0x66, 0x69, 0x6e, 0x69,
+	0x73, 0x68, 0x5f, 0x6f, 0x6e, 0x65, 0x6f, 0x66, 0x22, 0x9d, 0x01, 0x0a, 0x0f, 0x49, 0x6e, 0x76,
+	0x6f, 0x69, 0x63, 0x65, 0x4c, 0x69, 0x6e, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x19, 0x0a, 0x08,
+	0x74, 0x69, 0x6d, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x74, 0x69, 0x6d, 0x65, 0x72, 0x49, 0x64, 0x12, 0x20, 0x0a, 0x0c, 0x72, 0x61, 0x74, 0x65, 0x5f,
+	0x63, 0x61, 0x72, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x72,
+	0x61, 0x74, 0x65, 0x43, 0x61, 0x72, 0x64, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x65, 0x6c, 0x61,
+	0x70, 0x73, 0x65, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0b, 0x65, 0x6c, 0x61, 0x70, 0x73, 0x65, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04,
+	0x72, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x72, 0x61, 0x74, 0x65,
+	0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0xe2, 0x02, 0x0a, 0x07, 0x49, 0x6e, 0x76,
+	0x6f, 0x69, 0x63, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x02, 0x69, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x65, 0x6d, 0x70, 0x6c, 0x6f, 0x79, 0x65, 0x65,
+	0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x65, 0x6d, 0x70, 0x6c, 0x6f,
+	0x79, 0x65, 0x65, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74,
+	0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x6a, 0x65,
+	0x63, 0x74, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x69,
+	0x6e, 0x69, 0x73, 0x68, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x66, 0x69, 0x6e, 0x69,
+	0x73, 0x68, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x14,
+	0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x74,
+	0x6f, 0x74, 0x61, 0x6c, 0x12, 0x42, 0x0a, 0x0a, 0x6c, 0x69, 0x6e, 0x65, 0x5f, 0x69, 0x74, 0x65,
+	0x6d, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x67, 0x6f, 0x5f, 0x62, 0x6c,
+	0x75, 0x64, 0x67, 0x65, 0x6f, 0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x72, 0x73, 0x2e, 0x49, 0x6e,
+	0x76, 0x6f, 0x69, 0x63, 0x65, 0x4c, 0x69, 0x6e, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x09, 0x6c,
+	0x69, 0x6e, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x6c, 0x61, 0x73, 0x74,
+	0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b,
+	0x6c, 0x61, 0x73, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x12, 0x26, 0x0a, 0x0f, 0x6c,
+	0x61, 0x73, 0x74, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x18, 0x0a,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6c, 0x61, 0x73, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x64, 0x42, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x0b,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x32, 0xa5, 0x03,
+	0x0a, 0x08, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x73, 0x12, 0x67, 0x0a, 0x0e, 0x69, 0x6e,
+	0x76, 0x6f, 0x69, 0x63, 0x65, 0x5f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x12, 0x28, 0x2e, 0x67,
+	0x6f, 0x5f, 0x62, 0x6c, 0x75, 0x64, 0x67, 0x65, 0x6f, 0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x72,
+	0x73, 0x2e, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x67, 0x6f, 0x5f, 0x62, 0x6c, 0x75, 0x64,
+	0x67, 0x65, 0x6f, 0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x72, 0x73, 0x2e, 0x49, 0x6e, 0x76, 0x6f,
+	0x69, 0x63, 0x65, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x12, 0x61, 0x0a, 0x0c, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x5f, 0x72,
+	0x65, 0x61, 0x64, 0x12, 0x26, 0x2e, 0x67, 0x6f, 0x5f, 0x62, 0x6c, 0x75, 0x64, 0x67, 0x65, 0x6f,
+	0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x72, 0x73, 0x2e, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65,
+	0x52, 0x65, 0x61, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x67, 0x6f,
+	0x5f, 0x62, 0x6c, 0x75, 0x64, 0x67, 0x65, 0x6f, 0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x72, 0x73,
+	0x2e, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x52, 0x65, 0x61, 0x64, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x67, 0x0a, 0x0e, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63,
+	0x65, 0x5f, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x12, 0x28, 0x2e, 0x67, 0x6f, 0x5f, 0x62, 0x6c,
+	0x75, 0x64, 0x67, 0x65, 0x6f, 0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x72, 0x73, 0x2e, 0x49, 0x6e,
+	0x76, 0x6f, 0x69, 0x63, 0x65, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x29, 0x2e, 0x67, 0x6f, 0x5f, 0x62, 0x6c, 0x75, 0x64, 0x67, 0x65, 0x6f, 0x6e,
+	0x5f, 0x74, 0x69, 0x6d, 0x65, 0x72, 0x73, 0x2e, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x44,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x64, 0x0a, 0x0d, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x73, 0x5f, 0x72, 0x65, 0x61, 0x64,
+	0x12, 0x27, 0x2e, 0x67, 0x6f, 0x5f, 0x62, 0x6c, 0x75, 0x64, 0x67, 0x65, 0x6f, 0x6e, 0x5f, 0x74,
+	0x69, 0x6d, 0x65, 0x72, 0x73, 0x2e, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x73, 0x52, 0x65,
+	0x61, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x67, 0x6f, 0x5f, 0x62,
+	0x6c, 0x75, 0x64, 0x67, 0x65, 0x6f, 0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x72, 0x73, 0x2e, 0x49,
+	0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x73, 0x52, 0x65, 0x61, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x42, 0x39, 0x5a, 0x37, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
+	0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x6e, 0x74, 0x6f, 0x6e, 0x69, 0x6f, 0x2d, 0x61, 0x6c, 0x65, 0x78,
+	0x61, 0x6e, 0x64, 0x65, 0x72, 0x2f, 0x67, 0x6f, 0x2d, 0x62, 0x6c, 0x75, 0x64, 0x67, 0x65, 0x6f,
+	0x6e, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x72, 0x73, 0x2f, 0x64, 0x61, 0x74, 0x61, 0x2f, 0x70, 0x62,
+	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_invoices_proto_rawDescOnce sync.Once
+	file_invoices_proto_rawDescData = file_invoices_proto_rawDesc
+)
+
+func file_invoices_proto_rawDescGZIP() []byte {
+	file_invoices_proto_rawDescOnce.Do(func() {
+		file_invoices_proto_rawDescData = protoimpl.X.CompressGZIP(file_invoices_proto_rawDescData)
+	})
+	return file_invoices_proto_rawDescData
+}
+
+var file_invoices_proto_msgTypes = make([]protoimpl.MessageInfo, 12)
+var file_invoices_proto_goTypes = []interface{}{
+	(*InvoiceCreateRequest)(nil),  // 0: go_bludgeon_timers.InvoiceCreateRequest
+	(*InvoiceCreateResponse)(nil), // 1: go_bludgeon_timers.InvoiceCreateResponse
+	(*InvoiceReadRequest)(nil),    // 2: go_bludgeon_timers.InvoiceReadRequest
+	(*InvoiceReadResponse)(nil),   // 3: go_bludgeon_timers.InvoiceReadResponse
+	(*InvoiceDeleteRequest)(nil),  // 4: go_bludgeon_timers.InvoiceDeleteRequest
+	(*InvoiceDeleteResponse)(nil), // 5: go_bludgeon_timers.InvoiceDeleteResponse
+	(*InvoicesReadRequest)(nil),   // 6: go_bludgeon_timers.InvoicesReadRequest
+	(*InvoicesReadResponse)(nil),  // 7: go_bludgeon_timers.InvoicesReadResponse
+	(*InvoiceSearch)(nil),         // 8: go_bludgeon_timers.InvoiceSearch
+	(*InvoicePartial)(nil),        // 9: go_bludgeon_timers.InvoicePartial
+	(*InvoiceLineItem)(nil),       // 10: go_bludgeon_timers.InvoiceLineItem
+	(*Invoice)(nil),               // 11: go_bludgeon_timers.Invoice
+}
+var file_invoices_proto_depIdxs = []int32{
+	9,  // 0: go_bludgeon_timers.InvoiceCreateRequest.invoice_partial:type_name -> go_bludgeon_timers.InvoicePartial
+	11, // 1: go_bludgeon_timers.InvoiceCreateResponse.invoice:type_name -> go_bludgeon_timers.Invoice
+	11, // 2: go_bludgeon_timers.InvoiceReadResponse.invoice:type_name -> go_bludgeon_timers.Invoice
+	8,  // 3: go_bludgeon_timers.InvoicesReadRequest.invoice_search:type_name -> go_bludgeon_timers.InvoiceSearch
+	11, // 4: go_bludgeon_timers.InvoicesReadResponse.invoices:type_name -> go_bludgeon_timers.Invoice
+	10, // 5: go_bludgeon_timers.Invoice.line_items:type_name -> go_bludgeon_timers.InvoiceLineItem
+	0,  // 6: go_bludgeon_timers.Invoices.invoice_create:input_type -> go_bludgeon_timers.InvoiceCreateRequest
+	2,  // 7: go_bludgeon_timers.Invoices.invoice_read:input_type -> go_bludgeon_timers.InvoiceReadRequest
+	4,  // 8: go_bludgeon_timers.Invoices.invoice_delete:input_type -> go_bludgeon_timers.InvoiceDeleteRequest
+	6,  // 9: go_bludgeon_timers.Invoices.invoices_read:input_type -> go_bludgeon_timers.InvoicesReadRequest
+	1,  // 10: go_bludgeon_timers.Invoices.invoice_create:output_type -> go_bludgeon_timers.InvoiceCreateResponse
+	3,  // 11: go_bludgeon_timers.Invoices.invoice_read:output_type -> go_bludgeon_timers.InvoiceReadResponse
+	5,  // 12: go_bludgeon_timers.Invoices.invoice_delete:output_type -> go_bludgeon_timers.InvoiceDeleteResponse
+	7,  // 13: go_bludgeon_timers.Invoices.invoices_read:output_type -> go_bludgeon_timers.InvoicesReadResponse
+	10, // [10:14] is the sub-list for method output_type
+	6,  // [6:10] is the sub-list for method input_type
+	6,  // [6:6] is the sub-list for extension type_name
+	6,  // [6:6] is the sub-list for extension extendee
+	0,  // [0:6] is the sub-list for field type_name
+}
+
+func init() { file_invoices_proto_init() }
+func file_invoices_proto_init() {
+	if File_invoices_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_invoices_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InvoiceCreateRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_invoices_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InvoiceCreateResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_invoices_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InvoiceReadRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_invoices_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InvoiceReadResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_invoices_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InvoiceDeleteRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_invoices_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InvoiceDeleteResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_invoices_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InvoicesReadRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_invoices_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InvoicesReadResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_invoices_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InvoiceSearch); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_invoices_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InvoicePartial); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_invoices_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InvoiceLineItem); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_invoices_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Invoice); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_invoices_proto_msgTypes[9].OneofWrappers = []interface{}{
+		(*InvoicePartial_EmployeeId)(nil),
+		(*InvoicePartial_ProjectId)(nil),
+		(*InvoicePartial_Start)(nil),
+		(*InvoicePartial_Finish)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_invoices_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   12,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_invoices_proto_goTypes,
+		DependencyIndexes: file_invoices_proto_depIdxs,
+		MessageInfos:      file_invoices_proto_msgTypes,
+	}.Build()
+	File_invoices_proto = out.File
+	file_invoices_proto_rawDesc = nil
+	file_invoices_proto_goTypes = nil
+	file_invoices_proto_depIdxs = nil
+}
@@ -0,0 +1,221 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.2.0
+// - protoc             v3.20.1
+// source: invoices.proto
+
+package pb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// InvoicesClient is the client API for Invoices service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type InvoicesClient interface {
+	// invoice_create
+	InvoiceCreate(ctx context.Context, in *InvoiceCreateRequest, opts ...grpc.CallOption) (*InvoiceCreateResponse, error)
+	// invoice_read
+	InvoiceRead(ctx context.Context, in *InvoiceReadRequest, opts ...grpc.CallOption) (*InvoiceReadResponse, error)
+	// invoice_delete
+	InvoiceDelete(ctx context.Context, in *InvoiceDeleteRequest, opts ...grpc.CallOption) (*InvoiceDeleteResponse, error)
+	// invoices_read
+	InvoicesRead(ctx context.Context, in *InvoicesReadRequest, opts ...grpc.CallOption) (*InvoicesReadResponse, error)
+}
+
+type invoicesClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewInvoicesClient(cc grpc.ClientConnInterface) InvoicesClient {
+	return &invoicesClient{cc}
+}
+
+func (c *invoicesClient) InvoiceCreate(ctx context.Context, in *InvoiceCreateRequest, opts ...grpc.CallOption) (*InvoiceCreateResponse, error) {
+	out := new(InvoiceCreateResponse)
+	err := c.cc.Invoke(ctx, "/go_bludgeon_timers.Invoices/invoice_create", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *invoicesClient) InvoiceRead(ctx context.Context, in *InvoiceReadRequest, opts ...grpc.CallOption) (*InvoiceReadResponse, error) {
+	out := new(InvoiceReadResponse)
+	err := c.cc.Invoke(ctx, "/go_bludgeon_timers.Invoices/invoice_read", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *invoicesClient) InvoiceDelete(ctx context.Context, in *InvoiceDeleteRequest, opts ...grpc.CallOption) (*InvoiceDeleteResponse, error) {
+	out := new(InvoiceDeleteResponse)
+	err := c.cc.Invoke(ctx, "/go_bludgeon_timers.Invoices/invoice_delete", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *invoicesClient) InvoicesRead(ctx context.Context, in *InvoicesReadRequest, opts ...grpc.CallOption) (*InvoicesReadResponse, error) {
+	out := new(InvoicesReadResponse)
+	err := c.cc.Invoke(ctx, "/go_bludgeon_timers.Invoices/invoices_read", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// InvoicesServer is the server API for Invoices service.
+// All implementations must embed UnimplementedInvoicesServer
+// for forward compatibility
+type InvoicesServer interface {
+	// invoice_create
+	InvoiceCreate(context.Context, *InvoiceCreateRequest) (*InvoiceCreateResponse, error)
+	// invoice_read
+	InvoiceRead(context.Context, *InvoiceReadRequest) (*InvoiceReadResponse, error)
+	// invoice_delete
+	InvoiceDelete(context.Context, *InvoiceDeleteRequest) (*InvoiceDeleteResponse, error)
+	// invoices_read
+	InvoicesRead(context.Context, *InvoicesReadRequest) (*InvoicesReadResponse, error)
+	mustEmbedUnimplementedInvoicesServer()
+}
+
+// UnimplementedInvoicesServer must be embedded to have forward compatible implementations.
+type UnimplementedInvoicesServer struct {
+}
+
+func (UnimplementedInvoicesServer) InvoiceCreate(context.Context, *InvoiceCreateRequest) (*InvoiceCreateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InvoiceCreate not implemented")
+}
+func (UnimplementedInvoicesServer) InvoiceRead(context.Context, *InvoiceReadRequest) (*InvoiceReadResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InvoiceRead not implemented")
+}
+func (UnimplementedInvoicesServer) InvoiceDelete(context.Context, *InvoiceDeleteRequest) (*InvoiceDeleteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InvoiceDelete not implemented")
+}
+func (UnimplementedInvoicesServer) InvoicesRead(context.Context, *InvoicesReadRequest) (*InvoicesReadResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InvoicesRead not implemented")
+}
+func (UnimplementedInvoicesServer) mustEmbedUnimplementedInvoicesServer() {}
+
+// UnsafeInvoicesServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to InvoicesServer will
+// result in compilation errors.
+type UnsafeInvoicesServer interface {
+	mustEmbedUnimplementedInvoicesServer()
+}
+
+func RegisterInvoicesServer(s grpc.ServiceRegistrar, srv InvoicesServer) {
+	s.RegisterService(&Invoices_ServiceDesc, srv)
+}
+
+func _Invoices_InvoiceCreate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InvoiceCreateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InvoicesServer).InvoiceCreate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/go_bludgeon_timers.Invoices/invoice_create",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InvoicesServer).InvoiceCreate(ctx, req.(*InvoiceCreateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Invoices_InvoiceRead_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InvoiceReadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InvoicesServer).InvoiceRead(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/go_bludgeon_timers.Invoices/invoice_read",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InvoicesServer).InvoiceRead(ctx, req.(*InvoiceReadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Invoices_InvoiceDelete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InvoiceDeleteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InvoicesServer).InvoiceDelete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/go_bludgeon_timers.Invoices/invoice_delete",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InvoicesServer).InvoiceDelete(ctx, req.(*InvoiceDeleteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Invoices_InvoicesRead_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InvoicesReadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InvoicesServer).InvoicesRead(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/go_bludgeon_timers.Invoices/invoices_read",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InvoicesServer).InvoicesRead(ctx, req.(*InvoicesReadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Invoices_ServiceDesc is the grpc.ServiceDesc for Invoices service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Invoices_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "go_bludgeon_timers.Invoices",
+	HandlerType: (*InvoicesServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "invoice_create",
+			Handler:    _Invoices_InvoiceCreate_Handler,
+		},
+		{
+			MethodName: "invoice_read",
+			Handler:    _Invoices_InvoiceRead_Handler,
+		},
+		{
+			MethodName: "invoice_delete",
+			Handler:    _Invoices_InvoiceDelete_Handler,
+		},
+		{
+			MethodName: "invoices_read",
+			Handler:    _Invoices_InvoicesRead_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "invoices.proto",
+}
@@ -0,0 +1,1203 @@
+//
+//go_bludgeon_timers defines a set of types for use with the timers service
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.28.0
+// 	protoc        v3.20.1
+// source: projects.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// ProjectCreateRequest
+type ProjectCreateRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// project_partial
+	ProjectPartial *ProjectPartial `protobuf:"bytes,1,opt,name=project_partial,json=projectPartial,proto3" json:"project_partial,omitempty"`
+}
+
+func (x *ProjectCreateRequest) Reset() {
+	*x = ProjectCreateRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_projects_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProjectCreateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProjectCreateRequest) ProtoMessage() {}
+
+func (x *ProjectCreateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_projects_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProjectCreateRequest.ProtoReflect.Descriptor instead.
+func (*ProjectCreateRequest) Descriptor() ([]byte, []int) {
+	return file_projects_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ProjectCreateRequest) GetProjectPartial() *ProjectPartial {
+	if x != nil {
+		return x.ProjectPartial
+	}
+	return nil
+}
+
+// ProjectCreateResponse
+type ProjectCreateResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// project
+	Project *Project `protobuf:"bytes,1,opt,name=project,proto3" json:"project,omitempty"`
+}
+
+func (x *ProjectCreateResponse) Reset() {
+	*x = ProjectCreateResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_projects_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProjectCreateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProjectCreateResponse) ProtoMessage() {}
+
+func (x *ProjectCreateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_projects_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProjectCreateResponse.ProtoReflect.Descriptor instead.
+func (*ProjectCreateResponse) Descriptor() ([]byte, []int) {
+	return file_projects_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ProjectCreateResponse) GetProject() *Project {
+	if x != nil {
+		return x.Project
+	}
+	return nil
+}
+
+// ProjectReadRequest
+type ProjectReadRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// id
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *ProjectReadRequest) Reset() {
+	*x = ProjectReadRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_projects_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProjectReadRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProjectReadRequest) ProtoMessage() {}
+
+func (x *ProjectReadRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_projects_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProjectReadRequest.ProtoReflect.Descriptor instead.
+func (*ProjectReadRequest) Descriptor() ([]byte, []int) {
+	return file_projects_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ProjectReadRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// ProjectReadResponse
+type ProjectReadResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// project
+	Project *Project `protobuf:"bytes,1,opt,name=project,proto3" json:"project,omitempty"`
+}
+
+func (x *ProjectReadResponse) Reset() {
+	*x = ProjectReadResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_projects_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProjectReadResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProjectReadResponse) ProtoMessage() {}
+
+func (x *ProjectReadResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_projects_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProjectReadResponse.ProtoReflect.Descriptor instead.
+func (*ProjectReadResponse) Descriptor() ([]byte, []int) {
+	return file_projects_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ProjectReadResponse) GetProject() *Project {
+	if x != nil {
+		return x.Project
+	}
+	return nil
+}
+
+// ProjectUpdateRequest
+type ProjectUpdateRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// id
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// project_partial
+	ProjectPartial *ProjectPartial `protobuf:"bytes,2,opt,name=project_partial,json=projectPartial,proto3" json:"project_partial,omitempty"`
+}
+
+func (x *ProjectUpdateRequest) Reset() {
+	*x = ProjectUpdateRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_projects_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProjectUpdateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProjectUpdateRequest) ProtoMessage() {}
+
+func (x *ProjectUpdateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_projects_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProjectUpdateRequest.ProtoReflect.Descriptor instead.
+func (*ProjectUpdateRequest) Descriptor() ([]byte, []int) {
+	return file_projects_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ProjectUpdateRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ProjectUpdateRequest) GetProjectPartial() *ProjectPartial {
+	if x != nil {
+		return x.ProjectPartial
+	}
+	return nil
+}
+
+// ProjectUpdateResponse
+type ProjectUpdateResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// project
+	Project *Project `protobuf:"bytes,1,opt,name=project,proto3" json:"project,omitempty"`
+}
+
+func (x *ProjectUpdateResponse) Reset() {
+	*x = ProjectUpdateResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_projects_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProjectUpdateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProjectUpdateResponse) ProtoMessage() {}
+
+func (x *ProjectUpdateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_projects_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProjectUpdateResponse.ProtoReflect.Descriptor instead.
+func (*ProjectUpdateResponse) Descriptor() ([]byte, []int) {
+	return file_projects_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ProjectUpdateResponse) GetProject() *Project {
+	if x != nil {
+		return x.Project
+	}
+	return nil
+}
+
+// ProjectDeleteRequest
+type ProjectDeleteRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// id
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *ProjectDeleteRequest) Reset() {
+	*x = ProjectDeleteRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_projects_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProjectDeleteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProjectDeleteRequest) ProtoMessage() {}
+
+func (x *ProjectDeleteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_projects_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProjectDeleteRequest.ProtoReflect.Descriptor instead.
+func (*ProjectDeleteRequest) Descriptor() ([]byte, []int) {
+	return file_projects_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ProjectDeleteRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// ProjectDeleteResponse
+type ProjectDeleteResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ProjectDeleteResponse) Reset() {
+	*x = ProjectDeleteResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_projects_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProjectDeleteResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProjectDeleteResponse) ProtoMessage() {}
+
+func (x *ProjectDeleteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_projects_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProjectDeleteResponse.ProtoReflect.Descriptor instead.
+func (*ProjectDeleteResponse) Descriptor() ([]byte, []int) {
+	return file_projects_proto_rawDescGZIP(), []int{7}
+}
+
+// ProjectsReadRequest
+type ProjectsReadRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// project_search
+	ProjectSearch *ProjectSearch `protobuf:"bytes,1,opt,name=project_search,json=projectSearch,proto3" json:"project_search,omitempty"`
+}
+
+func (x *ProjectsReadRequest) Reset() {
+	*x = ProjectsReadRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_projects_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProjectsReadRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProjectsReadRequest) ProtoMessage() {}
+
+func (x *ProjectsReadRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_projects_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProjectsReadRequest.ProtoReflect.Descriptor instead.
+func (*ProjectsReadRequest) Descriptor() ([]byte, []int) {
+	return file_projects_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ProjectsReadRequest) GetProjectSearch() *ProjectSearch {
+	if x != nil {
+		return x.ProjectSearch
+	}
+	return nil
+}
+
+// ProjectsReadResponse
+type ProjectsReadResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// projects
+	Projects []*Project `protobuf:"bytes,1,rep,name=projects,proto3" json:"projects,omitempty"`
+}
+
+func (x *ProjectsReadResponse) Reset() {
+	*x = ProjectsReadResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_projects_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProjectsReadResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProjectsReadResponse) ProtoMessage() {}
+
+func (x *ProjectsReadResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_projects_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProjectsReadResponse.ProtoReflect.Descriptor instead.
+func (*ProjectsReadResponse) Descriptor() ([]byte, []int) {
+	return file_projects_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ProjectsReadResponse) GetProjects() []*Project {
+	if x != nil {
+		return x.Projects
+	}
+	return nil
+}
+
+// ProjectSearch
+type ProjectSearch struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// ids
+	Ids []string `protobuf:"bytes,1,rep,name=ids,proto3" json:"ids,omitempty"`
+	// names
+	Names []string `protobuf:"bytes,2,rep,name=names,proto3" json:"names,omitempty"`
+	// archived_oneof
+	//
+	// Types that are assignable to ArchivedOneof:
+	//
+	//	*ProjectSearch_Archived
+	ArchivedOneof isProjectSearch_ArchivedOneof `protobuf_oneof:"archived_oneof"`
+}
+
+func (x *ProjectSearch) Reset() {
+	*x = ProjectSearch{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_projects_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProjectSearch) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProjectSearch) ProtoMessage() {}
+
+func (x *ProjectSearch) ProtoReflect() protoreflect.Message {
+	mi := &file_projects_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProjectSearch.ProtoReflect.Descriptor instead.
+func (*ProjectSearch) Descriptor() ([]byte, []int) {
+	return file_projects_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ProjectSearch) GetIds() []string {
+	if x != nil {
+		return x.Ids
+	}
+	return nil
+}
+
+func (x *ProjectSearch) GetNames() []string {
+	if x != nil {
+		return x.Names
+	}
+	return nil
+}
+
+func (m *ProjectSearch) GetArchivedOneof() isProjectSearch_ArchivedOneof {
+	if m != nil {
+		return m.ArchivedOneof
+	}
+	return nil
+}
+
+func (x *ProjectSearch) GetArchived() bool {
+	if x, ok := x.GetArchivedOneof().(*ProjectSearch_Archived); ok {
+		return x.Archived
+	}
+	return false
+}
+
+type isProjectSearch_ArchivedOneof interface {
+	isProjectSearch_ArchivedOneof()
+}
+
+type ProjectSearch_Archived struct {
+	// archived
+	Archived bool `protobuf:"varint,3,opt,name=archived,proto3,oneof"`
+}
+
+func (*ProjectSearch_Archived) isProjectSearch_ArchivedOneof() {}
+
+// ProjectPartial
+type ProjectPartial struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// name_oneof
+	//
+	// Types that are assignable to NameOneof:
+	//
+	//	*ProjectPartial_Name
+	NameOneof isProjectPartial_NameOneof `protobuf_oneof:"name_oneof"`
+	// description_oneof
+	//
+	// Types that are assignable to DescriptionOneof:
+	//
+	//	*ProjectPartial_Description
+	DescriptionOneof isProjectPartial_DescriptionOneof `protobuf_oneof:"description_oneof"`
+	// archived_oneof
+	//
+	// Types that are assignable to ArchivedOneof:
+	//
+	//	*ProjectPartial_Archived
+	ArchivedOneof isProjectPartial_ArchivedOneof `protobuf_oneof:"archived_oneof"`
+}
+
+func (x *ProjectPartial) Reset() {
+	*x = ProjectPartial{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_projects_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProjectPartial) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProjectPartial) ProtoMessage() {}
+
+func (x *ProjectPartial) ProtoReflect() protoreflect.Message {
+	mi := &file_projects_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProjectPartial.ProtoReflect.Descriptor instead.
+func (*ProjectPartial) Descriptor() ([]byte, []int) {
+	return file_projects_proto_rawDescGZIP(), []int{11}
+}
+
+func (m *ProjectPartial) GetNameOneof() isProjectPartial_NameOneof {
+	if m != nil {
+		return m.NameOneof
+	}
+	return nil
+}
+
+func (x *ProjectPartial) GetName() string {
+	if x, ok := x.GetNameOneof().(*ProjectPartial_Name); ok {
+		return x.Name
+	}
+	return ""
+}
+
+func (m *ProjectPartial) GetDescriptionOneof() isProjectPartial_DescriptionOneof {
+	if m != nil {
+		return m.DescriptionOneof
+	}
+	return nil
+}
+
+func (x *ProjectPartial) GetDescription() string {
+	if x, ok := x.GetDescriptionOneof().(*ProjectPartial_Description); ok {
+		return x.Description
+	}
+	return ""
+}
+
+func (m *ProjectPartial) GetArchivedOneof() isProjectPartial_ArchivedOneof {
+	if m != nil {
+		return m.ArchivedOneof
+	}
+	return nil
+}
+
+func (x *ProjectPartial) GetArchived() bool {
+	if x, ok := x.GetArchivedOneof().(*ProjectPartial_Archived); ok {
+		return x.Archived
+	}
+	return false
+}
+
+type isProjectPartial_NameOneof interface {
+	isProjectPartial_NameOneof()
+}
+
+type ProjectPartial_Name struct {
+	// name
+	Name string `protobuf:"bytes,1,opt,name=name,proto3,oneof"`
+}
+
+func (*ProjectPartial_Name) isProjectPartial_NameOneof() {}
+
+type isProjectPartial_DescriptionOneof interface {
+	isProjectPartial_DescriptionOneof()
+}
+
+type ProjectPartial_Description struct {
+	// description
+	Description string `protobuf:"bytes,2,opt,name=description,proto3,oneof"`
+}
+
+func (*ProjectPartial_Description) isProjectPartial_DescriptionOneof() {}
+
+type isProjectPartial_ArchivedOneof interface {
+	isProjectPartial_ArchivedOneof()
+}
+
+type ProjectPartial_Archived struct {
+	// archived
+	Archived bool `protobuf:"varint,3,opt,name=archived,proto3,oneof"`
+}
+
+func (*ProjectPartial_Archived) isProjectPartial_ArchivedOneof() {}
+
+// Project
+type Project struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// id
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// name
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// description
+	Description string `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	// archived
+	Archived bool `protobuf:"varint,4,opt,name=archived,proto3" json:"archived,omitempty"`
+	// last_updated
+	LastUpdated int64 `protobuf:"varint,5,opt,name=last_updated,json=lastUpdated,proto3" json:"last_updated,omitempty"`
+	// last_updated_by
+	LastUpdatedBy string `protobuf:"bytes,6,opt,name=last_updated_by,json=lastUpdatedBy,proto3" json:"last_updated_by,omitempty"`
+	// version
+	Version int32 `protobuf:"varint,7,opt,name=version,proto3" json:"version,omitempty"`
+}
+
+func (x *Project) Reset() {
+	*x = Project{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_projects_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Project) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Project) ProtoMessage() {}
+
+func (x *Project) ProtoReflect() protoreflect.Message {
+	mi := &file_projects_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Project.ProtoReflect.Descriptor instead.
+func (*Project) Descriptor() ([]byte, []int) {
+	return file_projects_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *Project) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Project) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Project) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Project) GetArchived() bool {
+	if x != nil {
+		return x.Archived
+	}
+	return false
+}
+
+func (x *Project) GetLastUpdated() int64 {
+	if x != nil {
+		return x.LastUpdated
+	}
+	return 0
+}
+
+func (x *Project) GetLastUpdatedBy() string {
+	if x != nil {
+		return x.LastUpdatedBy
+	}
+	return ""
+}
+
+func (x *Project) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+var File_projects_proto protoreflect.FileDescriptor
+
+var file_projects_proto_rawDesc = []byte{
+	0x0a, 0x0e, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x12, 0x12, 0x67, 0x6f, 0x5f, 0x62, 0x6c, 0x75, 0x64, 0x67, 0x65, 0x6f, 0x6e, 0x5f, 0x74, 0x69,
+	0x6d, 0x65, 0x72, 0x73, 0x22, 0x63, 0x0a, 0x14, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x4b, 0x0a, 0x0f,
+	0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x70, 0x61, 0x72, 0x74, 0x69, 0x61, 0x6c, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x67, 0x6f, 0x5f, 0x62, 0x6c, 0x75, 0x64, 0x67,
+	0x65, 0x6f, 0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x72, 0x73, 0x2e, 0x50, 0x72, 0x6f, 0x6a, 0x65,
+	0x63, 0x74, 0x50, 0x61, 0x72, 0x74, 0x69, 0x61, 0x6c, 0x52, 0x0e, 0x70, 0x72, 0x6f, 0x6a, 0x65,
+	0x63, 0x74, 0x50, 0x61, 0x72, 0x74, 0x69, 0x61, 0x6c, 0x22, 0x4e, 0x0a, 0x15, 0x50, 0x72, 0x6f,
+	0x6a, 0x65, 0x63, 0x74, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x35, 0x0a, 0x07, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x6f, 0x5f, 0x62, 0x6c, 0x75, 0x64, 0x67, 0x65, 0x6f,
+	0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x72, 0x73, 0x2e, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74,
+	0x52, 0x07, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x22, 0x24, 0x0a, 0x12, 0x50, 0x72, 0x6f,
+	0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x61, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22,
+	0x4c, 0x0a, 0x13, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x61, 0x64, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x35, 0x0a, 0x07, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63,
+	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x6f, 0x5f, 0x62, 0x6c, 0x75,
+	0x64, 0x67, 0x65, 0x6f, 0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x72, 0x73, 0x2e, 0x50, 0x72, 0x6f,
+	0x6a, 0x65, 0x63, 0x74, 0x52, 0x07, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x22, 0x73, 0x0a,
+	0x14, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x4b, 0x0a, 0x0f, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74,
+	0x5f, 0x70, 0x61, 0x72, 0x74, 0x69, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22,
+	0x2e, 0x67, 0x6f, 0x5f, 0x62, 0x6c, 0x75, 0x64, 0x67, 0x65, 0x6f, 0x6e, 0x5f, 0x74, 0x69, 0x6d,
+	0x65, 0x72, 0x73, 0x2e, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x50, 0x61, 0x72, 0x74, 0x69,
+	0x61, 0x6c, 0x52, 0x0e, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x50, 0x61, 0x72, 0x74, 0x69,
+	0x61, 0x6c, 0x22, 0x4e, 0x0a, 0x15, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x35, 0x0a, 0x07, 0x70,
+	0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67,
+	0x6f, 0x5f, 0x62, 0x6c, 0x75, 0x64, 0x67, 0x65, 0x6f, 0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x72,
+	0x73, 0x2e, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x07, 0x70, 0x72, 0x6f, 0x6a, 0x65,
+	0x63, 0x74, 0x22, 0x26, 0x0a, 0x14, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x17, 0x0a, 0x15, 0x50, 0x72,
+	0x6f, 0x6a, 0x65, 0x63, 0x74, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x5f, 0x0a, 0x13, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x52,
+	0x65, 0x61, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x48, 0x0a, 0x0e, 0x70, 0x72,
+	0x6f, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x21, 0x2e, 0x67, 0x6f, 0x5f, 0x62, 0x6c, 0x75, 0x64, 0x67, 0x65, 0x6f, 0x6e,
+	0x5f, 0x74, 0x69, 0x6d, 0x65, 0x72, 0x73, 0x2e, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x53,
+	0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x0d, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x53, 0x65,
+	0x61, 0x72, 0x63, 0x68, 0x22, 0x4f, 0x0a, 0x14, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x73,
+	0x52, 0x65, 0x61, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x37, 0x0a, 0x08,
+	0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b,
+	0x2e, 0x67, 0x6f, 0x5f, 0x62, 0x6c, 0x75, 0x64, 0x67, 0x65, 0x6f, 0x6e, 0x5f, 0x74, 0x69, 0x6d,
+	0x65, 0x72, 0x73, 0x2e, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x08, 0x70, 0x72, 0x6f,
+	0x6a, 0x65, 0x63, 0x74, 0x73, 0x22, 0x67, 0x0a, 0x0d, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74,
+	0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x12, 0x10, 0x0a, 0x03, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x03, 0x69, 0x64, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x6e, 0x61, 0x6d, 0x65,
+	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x12, 0x1c,
+	0x0a, 0x08, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08,
+	0x48, 0x00, 0x52, 0x08, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x42, 0x10, 0x0a, 0x0e,
+	0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x5f, 0x6f, 0x6e, 0x65, 0x6f, 0x66, 0x22, 0x9d,
+	0x01, 0x0a, 0x0e, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x50, 0x61, 0x72, 0x74, 0x69, 0x61,
+	0x6c, 0x12, 0x14, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48,
+	0x00, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x22, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x0b,
+	0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1c, 0x0a, 0x08, 0x61,
+	0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x48, 0x02, 0x52,
+	0x08, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x42, 0x0c, 0x0a, 0x0a, 0x6e, 0x61, 0x6d,
+	0x65, 0x5f, 0x6f, 0x6e, 0x65, 0x6f, 0x66, 0x42, 0x13, 0x0a, 0x11, 0x64, 0x65, 0x73, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6f, 0x6e, 0x65, 0x6f, 0x66, 0x42, 0x10, 0x0a, 0x0e,
+	0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x5f, 0x6f, 0x6e, 0x65, 0x6f, 0x66, 0x22, 0xd0,
+	0x01, 0x0a, 0x07, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x20,
+	0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x1a, 0x0a, 0x08, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x08, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x12, 0x21, 0x0a, 0x0c,
+	0x6c, 0x61, 0x73, 0x74, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0b, 0x6c, 0x61, 0x73, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x12,
+	0x26, 0x0a, 0x0f, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f,
+	0x62, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6c, 0x61, 0x73, 0x74, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x64, 0x42, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x32, 0x8e, 0x04, 0x0a, 0x08, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x12, 0x67,
+	0x0a, 0x0e, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x12, 0x28, 0x2e, 0x67, 0x6f, 0x5f, 0x62, 0x6c, 0x75, 0x64, 0x67, 0x65, 0x6f, 0x6e, 0x5f, 0x74,
+	0x69, 0x6d, 0x65, 0x72, 0x73, 0x2e, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x67, 0x6f, 0x5f,
+	0x62, 0x6c, 0x75, 0x64, 0x67, 0x65, 0x6f, 0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x72, 0x73, 0x2e,
+	0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x61, 0x0a, 0x0c, 0x70, 0x72, 0x6f, 0x6a, 0x65,
+	0x63, 0x74, 0x5f, 0x72, 0x65, 0x61, 0x64, 0x12, 0x26, 0x2e, 0x67, 0x6f, 0x5f, 0x62, 0x6c, 0x75,
+	0x64, 0x67, 0x65, 0x6f, 0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x72, 0x73, 0x2e, 0x50, 0x72, 0x6f,
+	0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x61, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x27, 0x2e, 0x67, 0x6f, 0x5f, 0x62, 0x6c, 0x75, 0x64, 0x67, 0x65, 0x6f, 0x6e, 0x5f, 0x74, 0x69,
+	0x6d, 0x65, 0x72, 0x73, 0x2e, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x61, 0x64,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x67, 0x0a, 0x0e, 0x70, 0x72,
+	0x6f, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x28, 0x2e, 0x67,
+	0x6f, 0x5f, 0x62, 0x6c, 0x75, 0x64, 0x67, 0x65, 0x6f, 0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x72,
+	0x73, 0x2e, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x67, 0x6f, 0x5f, 0x62, 0x6c, 0x75, 0x64,
+	0x67, 0x65, 0x6f, 0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x72, 0x73, 0x2e, 0x50, 0x72, 0x6f, 0x6a,
+	0x65, 0x63, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x12, 0x67, 0x0a, 0x0e, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x64,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x12, 0x28, 0x2e, 0x67, 0x6f, 0x5f, 0x62, 0x6c, 0x75, 0x64, 0x67,
+	0x65, 0x6f, 0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x72, 0x73, 0x2e, 0x50, 0x72, 0x6f, 0x6a, 0x65,
+	0x63, 0x74, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x29, 0x2e, 0x67, 0x6f, 0x5f, 0x62, 0x6c, 0x75, 0x64, 0x67, 0x65, 0x6f, 0x6e, 0x5f, 0x74, 0x69,
+	0x6d, 0x65, 0x72, 0x73, 0x2e, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x64, 0x0a, 0x0d,
+	0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x5f, 0x72, 0x65, 0x61, 0x64, 0x12, 0x27, 0x2e,
+	0x67, 0x6f, 0x5f, 0x62, 0x6c, 0x75, 0x64, 0x67, 0x65, 0x6f, 0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65,
+	0x72, 0x73, 0x2e, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x52, 0x65, 0x61, 0x64, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x67, 0x6f, 0x5f, 0x62, 0x6c, 0x75, 0x64,
+	0x67, 0x65, 0x6f, 0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x72, 0x73, 0x2e, 0x50, 0x72, 0x6f, 0x6a,
+	0x65, 0x63, 0x74, 0x73, 0x52, 0x65, 0x61, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x42, 0x39, 0x5a, 0x37, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
+	0x2f, 0x61, 0x6e, 0x74, 0x6f, 0x6e, 0x69, 0x6f, 0x2d, 0x61, 0x6c, 0x65, 0x78, 0x61, 0x6e, 0x64,
+	0x65, 0x72, 0x2f, 0x67, 0x6f, 0x2d, 0x62, 0x6c, 0x75, 0x64, 0x67, 0x65, 0x6f, 0x6e, 0x2f, 0x74,
+	0x69, 0x6d, 0x65, 0x72, 0x73, 0x2f, 0x64, 0x61, 0x74, 0x61, 0x2f, 0x70, 0x62, 0x62, 0x06, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_projects_proto_rawDescOnce sync.Once
+	file_projects_proto_rawDescData = file_projects_proto_rawDesc
+)
+
+func file_projects_proto_rawDescGZIP() []byte {
+	file_projects_proto_rawDescOnce.Do(func() {
+		file_projects_proto_rawDescData = protoimpl.X.CompressGZIP(file_projects_proto_rawDescData)
+	})
+	return file_projects_proto_rawDescData
+}
+
+var file_projects_proto_msgTypes = make([]protoimpl.MessageInfo, 13)
+var file_projects_proto_goTypes = []interface{}{
+	(*ProjectCreateRequest)(nil),  // 0: go_bludgeon_timers.ProjectCreateRequest
+	(*ProjectCreateResponse)(nil), // 1: go_bludgeon_timers.ProjectCreateResponse
+	(*ProjectReadRequest)(nil),    // 2: go_bludgeon_timers.ProjectReadRequest
+	(*ProjectReadResponse)(nil),   // 3: go_bludgeon_timers.ProjectReadResponse
+	(*ProjectUpdateRequest)(nil),  // 4: go_bludgeon_timers.ProjectUpdateRequest
+	(*ProjectUpdateResponse)(nil), // 5: go_bludgeon_timers.ProjectUpdateResponse
+	(*ProjectDeleteRequest)(nil),  // 6: go_bludgeon_timers.ProjectDeleteRequest
+	(*ProjectDeleteResponse)(nil), // 7: go_bludgeon_timers.ProjectDeleteResponse
+	(*ProjectsReadRequest)(nil),   // 8: go_bludgeon_timers.ProjectsReadRequest
+	(*ProjectsReadResponse)(nil),  // 9: go_bludgeon_timers.ProjectsReadResponse
+	(*ProjectSearch)(nil),         // 10: go_bludgeon_timers.ProjectSearch
+	(*ProjectPartial)(nil),        // 11: go_bludgeon_timers.ProjectPartial
+	(*Project)(nil),               // 12: go_bludgeon_timers.Project
+}
+var file_projects_proto_depIdxs = []int32{
+	11, // 0: go_bludgeon_timers.ProjectCreateRequest.project_partial:type_name -> go_bludgeon_timers.ProjectPartial
+	12, // 1: go_bludgeon_timers.ProjectCreateResponse.project:type_name -> go_bludgeon_timers.Project
+	12, // 2: go_bludgeon_timers.ProjectReadResponse.project:type_name -> go_bludgeon_timers.Project
+	11, // 3: go_bludgeon_timers.ProjectUpdateRequest.project_partial:type_name -> go_bludgeon_timers.ProjectPartial
+	12, // 4: go_bludgeon_timers.ProjectUpdateResponse.project:type_name -> go_bludgeon_timers.Project
+	10, // 5: go_bludgeon_timers.ProjectsReadRequest.project_search:type_name -> go_bludgeon_timers.ProjectSearch
+	12, // 6: go_bludgeon_timers.ProjectsReadResponse.projects:type_name -> go_bludgeon_timers.Project
+	0,  // 7: go_bludgeon_timers.Projects.project_create:input_type -> go_bludgeon_timers.ProjectCreateRequest
+	2,  // 8: go_bludgeon_timers.Projects.project_read:input_type -> go_bludgeon_timers.ProjectReadRequest
+	4,  // 9: go_bludgeon_timers.Projects.project_update:input_type -> go_bludgeon_timers.ProjectUpdateRequest
+	6,  // 10: go_bludgeon_timers.Projects.project_delete:input_type -> go_bludgeon_timers.ProjectDeleteRequest
+	8,  // 11: go_bludgeon_timers.Projects.projects_read:input_type -> go_bludgeon_timers.ProjectsReadRequest
+	1,  // 12: go_bludgeon_timers.Projects.project_create:output_type -> go_bludgeon_timers.ProjectCreateResponse
+	3,  // 13: go_bludgeon_timers.Projects.project_read:output_type -> go_bludgeon_timers.ProjectReadResponse
+	5,  // 14: go_bludgeon_timers.Projects.project_update:output_type -> go_bludgeon_timers.ProjectUpdateResponse
+	7,  // 15: go_bludgeon_timers.Projects.project_delete:output_type -> go_bludgeon_timers.ProjectDeleteResponse
+	9,  // 16: go_bludgeon_timers.Projects.projects_read:output_type -> go_bludgeon_timers.ProjectsReadResponse
+	12, // [12:17] is the sub-list for method output_type
+	7,  // [7:12] is the sub-list for method input_type
+	7,  // [7:7] is the sub-list for extension type_name
+	7,  // [7:7] is the sub-list for extension extendee
+	0,  // [0:7] is the sub-list for field type_name
+}
+
+func init() { file_projects_proto_init() }
+func file_projects_proto_init() {
+	if File_projects_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_projects_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProjectCreateRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_projects_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProjectCreateResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_projects_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProjectReadRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_projects_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProjectReadResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_projects_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProjectUpdateRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_projects_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProjectUpdateResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_projects_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProjectDeleteRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_projects_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProjectDeleteResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_projects_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProjectsReadRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_projects_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProjectsReadResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_projects_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProjectSearch); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_projects_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProjectPartial); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_projects_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Project); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_projects_proto_msgTypes[10].OneofWrappers = []interface{}{
+		(*ProjectSearch_Archived)(nil),
+	}
+	file_projects_proto_msgTypes[11].OneofWrappers = []interface{}{
+		(*ProjectPartial_Name)(nil),
+		(*ProjectPartial_Description)(nil),
+		(*ProjectPartial_Archived)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_projects_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   13,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_projects_proto_goTypes,
+		DependencyIndexes: file_projects_proto_depIdxs,
+		MessageInfos:      file_projects_proto_msgTypes,
+	}.Build()
+	File_projects_proto = out.File
+	file_projects_proto_rawDesc = nil
+	file_projects_proto_goTypes = nil
+	file_projects_proto_depIdxs = nil
+}
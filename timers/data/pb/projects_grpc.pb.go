@@ -0,0 +1,259 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.2.0
+// - protoc             v3.20.1
+// source: projects.proto
+
+package pb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// ProjectsClient is the client API for Projects service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type ProjectsClient interface {
+	// project_create
+	ProjectCreate(ctx context.Context, in *ProjectCreateRequest, opts ...grpc.CallOption) (*ProjectCreateResponse, error)
+	// project_read
+	ProjectRead(ctx context.Context, in *ProjectReadRequest, opts ...grpc.CallOption) (*ProjectReadResponse, error)
+	// project_update
+	ProjectUpdate(ctx context.Context, in *ProjectUpdateRequest, opts ...grpc.CallOption) (*ProjectUpdateResponse, error)
+	// project_delete
+	ProjectDelete(ctx context.Context, in *ProjectDeleteRequest, opts ...grpc.CallOption) (*ProjectDeleteResponse, error)
+	// projects_read
+	ProjectsRead(ctx context.Context, in *ProjectsReadRequest, opts ...grpc.CallOption) (*ProjectsReadResponse, error)
+}
+
+type projectsClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewProjectsClient(cc grpc.ClientConnInterface) ProjectsClient {
+	return &projectsClient{cc}
+}
+
+func (c *projectsClient) ProjectCreate(ctx context.Context, in *ProjectCreateRequest, opts ...grpc.CallOption) (*ProjectCreateResponse, error) {
+	out := new(ProjectCreateResponse)
+	err := c.cc.Invoke(ctx, "/go_bludgeon_timers.Projects/project_create", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *projectsClient) ProjectRead(ctx context.Context, in *ProjectReadRequest, opts ...grpc.CallOption) (*ProjectReadResponse, error) {
+	out := new(ProjectReadResponse)
+	err := c.cc.Invoke(ctx, "/go_bludgeon_timers.Projects/project_read", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *projectsClient) ProjectUpdate(ctx context.Context, in *ProjectUpdateRequest, opts ...grpc.CallOption) (*ProjectUpdateResponse, error) {
+	out := new(ProjectUpdateResponse)
+	err := c.cc.Invoke(ctx, "/go_bludgeon_timers.Projects/project_update", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *projectsClient) ProjectDelete(ctx context.Context, in *ProjectDeleteRequest, opts ...grpc.CallOption) (*ProjectDeleteResponse, error) {
+	out := new(ProjectDeleteResponse)
+	err := c.cc.Invoke(ctx, "/go_bludgeon_timers.Projects/project_delete", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *projectsClient) ProjectsRead(ctx context.Context, in *ProjectsReadRequest, opts ...grpc.CallOption) (*ProjectsReadResponse, error) {
+	out := new(ProjectsReadResponse)
+	err := c.cc.Invoke(ctx, "/go_bludgeon_timers.Projects/projects_read", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ProjectsServer is the server API for Projects service.
+// All implementations must embed UnimplementedProjectsServer
+// for forward compatibility
+type ProjectsServer interface {
+	// project_create
+	ProjectCreate(context.Context, *ProjectCreateRequest) (*ProjectCreateResponse, error)
+	// project_read
+	ProjectRead(context.Context, *ProjectReadRequest) (*ProjectReadResponse, error)
+	// project_update
+	ProjectUpdate(context.Context, *ProjectUpdateRequest) (*ProjectUpdateResponse, error)
+	// project_delete
+	ProjectDelete(context.Context, *ProjectDeleteRequest) (*ProjectDeleteResponse, error)
+	// projects_read
+	ProjectsRead(context.Context, *ProjectsReadRequest) (*ProjectsReadResponse, error)
+	mustEmbedUnimplementedProjectsServer()
+}
+
+// UnimplementedProjectsServer must be embedded to have forward compatible implementations.
+type UnimplementedProjectsServer struct {
+}
+
+func (UnimplementedProjectsServer) ProjectCreate(context.Context, *ProjectCreateRequest) (*ProjectCreateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProjectCreate not implemented")
+}
+func (UnimplementedProjectsServer) ProjectRead(context.Context, *ProjectReadRequest) (*ProjectReadResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProjectRead not implemented")
+}
+func (UnimplementedProjectsServer) ProjectUpdate(context.Context, *ProjectUpdateRequest) (*ProjectUpdateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProjectUpdate not implemented")
+}
+func (UnimplementedProjectsServer) ProjectDelete(context.Context, *ProjectDeleteRequest) (*ProjectDeleteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProjectDelete not implemented")
+}
+func (UnimplementedProjectsServer) ProjectsRead(context.Context, *ProjectsReadRequest) (*ProjectsReadResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProjectsRead not implemented")
+}
+func (UnimplementedProjectsServer) mustEmbedUnimplementedProjectsServer() {}
+
+// UnsafeProjectsServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ProjectsServer will
+// result in compilation errors.
+type UnsafeProjectsServer interface {
+	mustEmbedUnimplementedProjectsServer()
+}
+
+func RegisterProjectsServer(s grpc.ServiceRegistrar, srv ProjectsServer) {
+	s.RegisterService(&Projects_ServiceDesc, srv)
+}
+
+func _Projects_ProjectCreate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProjectCreateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProjectsServer).ProjectCreate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/go_bludgeon_timers.Projects/project_create",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProjectsServer).ProjectCreate(ctx, req.(*ProjectCreateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Projects_ProjectRead_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProjectReadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProjectsServer).ProjectRead(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/go_bludgeon_timers.Projects/project_read",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProjectsServer).ProjectRead(ctx, req.(*ProjectReadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Projects_ProjectUpdate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProjectUpdateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProjectsServer).ProjectUpdate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/go_bludgeon_timers.Projects/project_update",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProjectsServer).ProjectUpdate(ctx, req.(*ProjectUpdateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Projects_ProjectDelete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProjectDeleteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProjectsServer).ProjectDelete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/go_bludgeon_timers.Projects/project_delete",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProjectsServer).ProjectDelete(ctx, req.(*ProjectDeleteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Projects_ProjectsRead_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProjectsReadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProjectsServer).ProjectsRead(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/go_bludgeon_timers.Projects/projects_read",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProjectsServer).ProjectsRead(ctx, req.(*ProjectsReadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Projects_ServiceDesc is the grpc.ServiceDesc for Projects service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Projects_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "go_bludgeon_timers.Projects",
+	HandlerType: (*ProjectsServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "project_create",
+			Handler:    _Projects_ProjectCreate_Handler,
+		},
+		{
+			MethodName: "project_read",
+			Handler:    _Projects_ProjectRead_Handler,
+		},
+		{
+			MethodName: "project_update",
+			Handler:    _Projects_ProjectUpdate_Handler,
+		},
+		{
+			MethodName: "project_delete",
+			Handler:    _Projects_ProjectDelete_Handler,
+		},
+		{
+			MethodName: "projects_read",
+			Handler:    _Projects_ProjectsRead_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "projects.proto",
+}
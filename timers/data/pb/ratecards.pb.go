@@ -0,0 +1,1360 @@
+//
+//go_bludgeon_timers defines a set of types for use with the timers service
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.28.0
+// 	protoc        v3.20.1
+// source: ratecards.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// RateCardCreateRequest
+type RateCardCreateRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// rate_card_partial
+	RateCardPartial *RateCardPartial `protobuf:"bytes,1,opt,name=rate_card_partial,json=rateCardPartial,proto3" json:"rate_card_partial,omitempty"`
+}
+
+func (x *RateCardCreateRequest) Reset() {
+	*x = RateCardCreateRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ratecards_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RateCardCreateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RateCardCreateRequest) ProtoMessage() {}
+
+func (x *RateCardCreateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ratecards_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RateCardCreateRequest.ProtoReflect.Descriptor instead.
+func (*RateCardCreateRequest) Descriptor() ([]byte, []int) {
+	return file_ratecards_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *RateCardCreateRequest) GetRateCardPartial() *RateCardPartial {
+	if x != nil {
+		return x.RateCardPartial
+	}
+	return nil
+}
+
+// RateCardCreateResponse
+type RateCardCreateResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// rate_card
+	RateCard *RateCard `protobuf:"bytes,1,opt,name=rate_card,json=rateCard,proto3" json:"rate_card,omitempty"`
+}
+
+func (x *RateCardCreateResponse) Reset() {
+	*x = RateCardCreateResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ratecards_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RateCardCreateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RateCardCreateResponse) ProtoMessage() {}
+
+func (x *RateCardCreateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ratecards_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RateCardCreateResponse.ProtoReflect.Descriptor instead.
+func (*RateCardCreateResponse) Descriptor() ([]byte, []int) {
+	return file_ratecards_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *RateCardCreateResponse) GetRateCard() *RateCard {
+	if x != nil {
+		return x.RateCard
+	}
+	return nil
+}
+
+// RateCardReadRequest
+type RateCardReadRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// id
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *RateCardReadRequest) Reset() {
+	*x = RateCardReadRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ratecards_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RateCardReadRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RateCardReadRequest) ProtoMessage() {}
+
+func (x *RateCardReadRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ratecards_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RateCardReadRequest.ProtoReflect.Descriptor instead.
+func (*RateCardReadRequest) Descriptor() ([]byte, []int) {
+	return file_ratecards_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *RateCardReadRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// RateCardReadResponse
+type RateCardReadResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// rate_card
+	RateCard *RateCard `protobuf:"bytes,1,opt,name=rate_card,json=rateCard,proto3" json:"rate_card,omitempty"`
+}
+
+func (x *RateCardReadResponse) Reset() {
+	*x = RateCardReadResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ratecards_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RateCardReadResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RateCardReadResponse) ProtoMessage() {}
+
+func (x *RateCardReadResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ratecards_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RateCardReadResponse.ProtoReflect.Descriptor instead.
+func (*RateCardReadResponse) Descriptor() ([]byte, []int) {
+	return file_ratecards_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *RateCardReadResponse) GetRateCard() *RateCard {
+	if x != nil {
+		return x.RateCard
+	}
+	return nil
+}
+
+// RateCardUpdateRequest
+type RateCardUpdateRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// id
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// rate_card_partial
+	RateCardPartial *RateCardPartial `protobuf:"bytes,2,opt,name=rate_card_partial,json=rateCardPartial,proto3" json:"rate_card_partial,omitempty"`
+}
+
+func (x *RateCardUpdateRequest) Reset() {
+	*x = RateCardUpdateRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ratecards_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RateCardUpdateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RateCardUpdateRequest) ProtoMessage() {}
+
+func (x *RateCardUpdateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ratecards_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RateCardUpdateRequest.ProtoReflect.Descriptor instead.
+func (*RateCardUpdateRequest) Descriptor() ([]byte, []int) {
+	return file_ratecards_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *RateCardUpdateRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *RateCardUpdateRequest) GetRateCardPartial() *RateCardPartial {
+	if x != nil {
+		return x.RateCardPartial
+	}
+	return nil
+}
+
+// RateCardUpdateResponse
+type RateCardUpdateResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// rate_card
+	RateCard *RateCard `protobuf:"bytes,1,opt,name=rate_card,json=rateCard,proto3" json:"rate_card,omitempty"`
+}
+
+func (x *RateCardUpdateResponse) Reset() {
+	*x = RateCardUpdateResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ratecards_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RateCardUpdateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RateCardUpdateResponse) ProtoMessage() {}
+
+func (x *RateCardUpdateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ratecards_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RateCardUpdateResponse.ProtoReflect.Descriptor instead.
+func (*RateCardUpdateResponse) Descriptor() ([]byte, []int) {
+	return file_ratecards_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *RateCardUpdateResponse) GetRateCard() *RateCard {
+	if x != nil {
+		return x.RateCard
+	}
+	return nil
+}
+
+// RateCardDeleteRequest
+type RateCardDeleteRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// id
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *RateCardDeleteRequest) Reset() {
+	*x = RateCardDeleteRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ratecards_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RateCardDeleteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RateCardDeleteRequest) ProtoMessage() {}
+
+func (x *RateCardDeleteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ratecards_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RateCardDeleteRequest.ProtoReflect.Descriptor instead.
+func (*RateCardDeleteRequest) Descriptor() ([]byte, []int) {
+	return file_ratecards_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *RateCardDeleteRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// RateCardDeleteResponse
+type RateCardDeleteResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *RateCardDeleteResponse) Reset() {
+	*x = RateCardDeleteResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ratecards_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RateCardDeleteResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RateCardDeleteResponse) ProtoMessage() {}
+
+func (x *RateCardDeleteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ratecards_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RateCardDeleteResponse.ProtoReflect.Descriptor instead.
+func (*RateCardDeleteResponse) Descriptor() ([]byte, []int) {
+	return file_ratecards_proto_rawDescGZIP(), []int{7}
+}
+
+// RateCardsReadRequest
+type RateCardsReadRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// rate_card_search
+	RateCardSearch *RateCardSearch `protobuf:"bytes,1,opt,name=rate_card_search,json=rateCardSearch,proto3" json:"rate_card_search,omitempty"`
+}
+
+func (x *RateCardsReadRequest) Reset() {
+	*x = RateCardsReadRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ratecards_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RateCardsReadRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RateCardsReadRequest) ProtoMessage() {}
+
+func (x *RateCardsReadRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ratecards_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RateCardsReadRequest.ProtoReflect.Descriptor instead.
+func (*RateCardsReadRequest) Descriptor() ([]byte, []int) {
+	return file_ratecards_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *RateCardsReadRequest) GetRateCardSearch() *RateCardSearch {
+	if x != nil {
+		return x.RateCardSearch
+	}
+	return nil
+}
+
+// RateCardsReadResponse
+type RateCardsReadResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// rate_cards
+	RateCards []*RateCard `protobuf:"bytes,1,rep,name=rate_cards,json=rateCards,proto3" json:"rate_cards,omitempty"`
+}
+
+func (x *RateCardsReadResponse) Reset() {
+	*x = RateCardsReadResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ratecards_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RateCardsReadResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RateCardsReadResponse) ProtoMessage() {}
+
+func (x *RateCardsReadResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ratecards_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RateCardsReadResponse.ProtoReflect.Descriptor instead.
+func (*RateCardsReadResponse) Descriptor() ([]byte, []int) {
+	return file_ratecards_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *RateCardsReadResponse) GetRateCards() []*RateCard {
+	if x != nil {
+		return x.RateCards
+	}
+	return nil
+}
+
+// RateCardSearch
+type RateCardSearch struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// ids
+	Ids []string `protobuf:"bytes,1,rep,name=ids,proto3" json:"ids,omitempty"`
+	// employee_ids
+	EmployeeIds []string `protobuf:"bytes,2,rep,name=employee_ids,json=employeeIds,proto3" json:"employee_ids,omitempty"`
+	// project_ids
+	ProjectIds []string `protobuf:"bytes,3,rep,name=project_ids,json=projectIds,proto3" json:"project_ids,omitempty"`
+	// effective_at_oneof
+	//
+	// Types that are assignable to EffectiveAtOneof:
+	//
+	//	*RateCardSearch_EffectiveAt
+	EffectiveAtOneof isRateCardSearch_EffectiveAtOneof `protobuf_oneof:"effective_at_oneof"`
+}
+
+func (x *RateCardSearch) Reset() {
+	*x = RateCardSearch{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ratecards_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RateCardSearch) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RateCardSearch) ProtoMessage() {}
+
+func (x *RateCardSearch) ProtoReflect() protoreflect.Message {
+	mi := &file_ratecards_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RateCardSearch.ProtoReflect.Descriptor instead.
+func (*RateCardSearch) Descriptor() ([]byte, []int) {
+	return file_ratecards_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *RateCardSearch) GetIds() []string {
+	if x != nil {
+		return x.Ids
+	}
+	return nil
+}
+
+func (x *RateCardSearch) GetEmployeeIds() []string {
+	if x != nil {
+		return x.EmployeeIds
+	}
+	return nil
+}
+
+func (x *RateCardSearch) GetProjectIds() []string {
+	if x != nil {
+		return x.ProjectIds
+	}
+	return nil
+}
+
+func (m *RateCardSearch) GetEffectiveAtOneof() isRateCardSearch_EffectiveAtOneof {
+	if m != nil {
+		return m.EffectiveAtOneof
+	}
+	return nil
+}
+
+func (x *RateCardSearch) GetEffectiveAt() int64 {
+	if x, ok := x.GetEffectiveAtOneof().(*RateCardSearch_EffectiveAt); ok {
+		return x.EffectiveAt
+	}
+	return 0
+}
+
+type isRateCardSearch_EffectiveAtOneof interface {
+	isRateCardSearch_EffectiveAtOneof()
+}
+
+type RateCardSearch_EffectiveAt struct {
+	// effective_at
+	EffectiveAt int64 `protobuf:"varint,4,opt,name=effective_at,json=effectiveAt,proto3,oneof"`
+}
+
+func (*RateCardSearch_EffectiveAt) isRateCardSearch_EffectiveAtOneof() {}
+
+// RateCardPartial
+type RateCardPartial struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// employee_id_oneof
+	//
+	// Types that are assignable to EmployeeIdOneof:
+	//
+	//	*RateCardPartial_EmployeeId
+	EmployeeIdOneof isRateCardPartial_EmployeeIdOneof `protobuf_oneof:"employee_id_oneof"`
+	// project_id_oneof
+	//
+	// Types that are assignable to ProjectIdOneof:
+	//
+	//	*RateCardPartial_ProjectId
+	ProjectIdOneof isRateCardPartial_ProjectIdOneof `protobuf_oneof:"project_id_oneof"`
+	// rate_oneof
+	//
+	// Types that are assignable to RateOneof:
+	//
+	//	*RateCardPartial_Rate
+	RateOneof isRateCardPartial_RateOneof `protobuf_oneof:"rate_oneof"`
+	// currency_oneof
+	//
+	// Types that are assignable to CurrencyOneof:
+	//
+	//	*RateCardPartial_Currency
+	CurrencyOneof isRateCardPartial_CurrencyOneof `protobuf_oneof:"currency_oneof"`
+	// effective_from_oneof
+	//
+	// Types that are assignable to EffectiveFromOneof:
+	//
+	//	*RateCardPartial_EffectiveFrom
+	EffectiveFromOneof isRateCardPartial_EffectiveFromOneof `protobuf_oneof:"effective_from_oneof"`
+	// effective_to_oneof
+	//
+	// Types that are assignable to EffectiveToOneof:
+	//
+	//	*RateCardPartial_EffectiveTo
+	EffectiveToOneof isRateCardPartial_EffectiveToOneof `protobuf_oneof:"effective_to_oneof"`
+}
+
+func (x *RateCardPartial) Reset() {
+	*x = RateCardPartial{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ratecards_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RateCardPartial) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RateCardPartial) ProtoMessage() {}
+
+func (x *RateCardPartial) ProtoReflect() protoreflect.Message {
+	mi := &file_ratecards_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RateCardPartial.ProtoReflect.Descriptor instead.
+func (*RateCardPartial) Descriptor() ([]byte, []int) {
+	return file_ratecards_proto_rawDescGZIP(), []int{11}
+}
+
+func (m *RateCardPartial) GetEmployeeIdOneof() isRateCardPartial_EmployeeIdOneof {
+	if m != nil {
+		return m.EmployeeIdOneof
+	}
+	return nil
+}
+
+func (x *RateCardPartial) GetEmployeeId() string {
+	if x, ok := x.GetEmployeeIdOneof().(*RateCardPartial_EmployeeId); ok {
+		return x.EmployeeId
+	}
+	return ""
+}
+
+func (m *RateCardPartial) GetProjectIdOneof() isRateCardPartial_ProjectIdOneof {
+	if m != nil {
+		return m.ProjectIdOneof
+	}
+	return nil
+}
+
+func (x *RateCardPartial) GetProjectId() string {
+	if x, ok := x.GetProjectIdOneof().(*RateCardPartial_ProjectId); ok {
+		return x.ProjectId
+	}
+	return ""
+}
+
+func (m *RateCardPartial) GetRateOneof() isRateCardPartial_RateOneof {
+	if m != nil {
+		return m.RateOneof
+	}
+	return nil
+}
+
+func (x *RateCardPartial) GetRate() int64 {
+	if x, ok := x.GetRateOneof().(*RateCardPartial_Rate); ok {
+		return x.Rate
+	}
+	return 0
+}
+
+func (m *RateCardPartial) GetCurrencyOneof() isRateCardPartial_CurrencyOneof {
+	if m != nil {
+		return m.CurrencyOneof
+	}
+	return nil
+}
+
+func (x *RateCardPartial) GetCurrency() string {
+	if x, ok := x.GetCurrencyOneof().(*RateCardPartial_Currency); ok {
+		return x.Currency
+	}
+	return ""
+}
+
+func (m *RateCardPartial) GetEffectiveFromOneof() isRateCardPartial_EffectiveFromOneof {
+	if m != nil {
+		return m.EffectiveFromOneof
+	}
+	return nil
+}
+
+func (x *RateCardPartial) GetEffectiveFrom() int64 {
+	if x, ok := x.GetEffectiveFromOneof().(*RateCardPartial_EffectiveFrom); ok {
+		return x.EffectiveFrom
+	}
+	return 0
+}
+
+func (m *RateCardPartial) GetEffectiveToOneof() isRateCardPartial_EffectiveToOneof {
+	if m != nil {
+		return m.EffectiveToOneof
+	}
+	return nil
+}
+
+func (x *RateCardPartial) GetEffectiveTo() int64 {
+	if x, ok := x.GetEffectiveToOneof().(*RateCardPartial_EffectiveTo); ok {
+		return x.EffectiveTo
+	}
+	return 0
+}
+
+type isRateCardPartial_EmployeeIdOneof interface {
+	isRateCardPartial_EmployeeIdOneof()
+}
+
+type RateCardPartial_EmployeeId struct {
+	// employee_id
+	EmployeeId string `protobuf:"bytes,1,opt,name=employee_id,json=employeeId,proto3,oneof"`
+}
+
+func (*RateCardPartial_EmployeeId) isRateCardPartial_EmployeeIdOneof() {}
+
+type isRateCardPartial_ProjectIdOneof interface {
+	isRateCardPartial_ProjectIdOneof()
+}
+
+type RateCardPartial_ProjectId struct {
+	// project_id
+	ProjectId string `protobuf:"bytes,2,opt,name=project_id,json=projectId,proto3,oneof"`
+}
+
+func (*RateCardPartial_ProjectId) isRateCardPartial_ProjectIdOneof() {}
+
+type isRateCardPartial_RateOneof interface {
+	isRateCardPartial_RateOneof()
+}
+
+type RateCardPartial_Rate struct {
+	// rate
+	Rate int64 `protobuf:"varint,3,opt,name=rate,proto3,oneof"`
+}
+
+func (*RateCardPartial_Rate) isRateCardPartial_RateOneof() {}
+
+type isRateCardPartial_CurrencyOneof interface {
+	isRateCardPartial_CurrencyOneof()
+}
+
+type RateCardPartial_Currency struct {
+	// currency
+	Currency string `protobuf:"bytes,4,opt,name=currency,proto3,oneof"`
+}
+
+func (*RateCardPartial_Currency) isRateCardPartial_CurrencyOneof() {}
+
+type isRateCardPartial_EffectiveFromOneof interface {
+	isRateCardPartial_EffectiveFromOneof()
+}
+
+type RateCardPartial_EffectiveFrom struct {
+	// effective_from
+	EffectiveFrom int64 `protobuf:"varint,5,opt,name=effective_from,json=effectiveFrom,proto3,oneof"`
+}
+
+func (*RateCardPartial_EffectiveFrom) isRateCardPartial_EffectiveFromOneof() {}
+
+type isRateCardPartial_EffectiveToOneof interface {
+	isRateCardPartial_EffectiveToOneof()
+}
+
+type RateCardPartial_EffectiveTo struct {
+	// effective_to
+	EffectiveTo int64 `protobuf:"varint,6,opt,name=effective_to,json=effectiveTo,proto3,oneof"`
+}
+
+func (*RateCardPartial_EffectiveTo) isRateCardPartial_EffectiveToOneof() {}
+
+// RateCard
+type RateCard struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// id
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// employee_id
+	EmployeeId string `protobuf:"bytes,2,opt,name=employee_id,json=employeeId,proto3" json:"employee_id,omitempty"`
+	// project_id
+	ProjectId string `protobuf:"bytes,3,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	// rate
+	Rate int64 `protobuf:"varint,4,opt,name=rate,proto3" json:"rate,omitempty"`
+	// currency
+	Currency string `protobuf:"bytes,5,opt,name=currency,proto3" json:"currency,omitempty"`
+	// effective_from
+	EffectiveFrom int64 `protobuf:"varint,6,opt,name=effective_from,json=effectiveFrom,proto3" json:"effective_from,omitempty"`
+	// effective_to
+	EffectiveTo int64 `protobuf:"varint,7,opt,name=effective_to,json=effectiveTo,proto3" json:"effective_to,omitempty"`
+	// last_updated
+	LastUpdated int64 `protobuf:"varint,8,opt,name=last_updated,json=lastUpdated,proto3" json:"last_updated,omitempty"`
+	// last_updated_by
+	LastUpdatedBy string `protobuf:"bytes,9,opt,name=last_updated_by,json=lastUpdatedBy,proto3" json:"last_updated_by,omitempty"`
+	// version
+	Version int32 `protobuf:"varint,10,opt,name=version,proto3" json:"version,omitempty"`
+}
+
+func (x *RateCard) Reset() {
+	*x = RateCard{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ratecards_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RateCard) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RateCard) ProtoMessage() {}
+
+func (x *RateCard) ProtoReflect() protoreflect.Message {
+	mi := &file_ratecards_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RateCard.ProtoReflect.Descriptor instead.
+func (*RateCard) Descriptor() ([]byte, []int) {
+	return file_ratecards_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *RateCard) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *RateCard) GetEmployeeId() string {
+	if x != nil {
+		return x.EmployeeId
+	}
+	return ""
+}
+
+func (x *RateCard) GetProjectId() string {
+	if x != nil {
+		return x.ProjectId
+	}
+	return ""
+}
+
+func (x *RateCard) GetRate() int64 {
+	if x != nil {
+		return x.Rate
+	}
+	return 0
+}
+
+func (x *RateCard) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *RateCard) GetEffectiveFrom() int64 {
+	if x != nil {
+		return x.EffectiveFrom
+	}
+	return 0
+}
+
+func (x *RateCard) GetEffectiveTo() int64 {
+	if x != nil {
+		return x.EffectiveTo
+	}
+	return 0
+}
+
+func (x *RateCard) GetLastUpdated() int64 {
+	if x != nil {
+		return x.LastUpdated
+	}
+	return 0
+}
+
+func (x *RateCard) GetLastUpdatedBy() string {
+	if x != nil {
+		return x.LastUpdatedBy
+	}
+	return ""
+}
+
+func (x *RateCard) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+var File_ratecards_proto protoreflect.FileDescriptor
+
+var file_ratecards_proto_rawDesc = []byte{
+	0x0a, 0x0f, 0x72, 0x61, 0x74, 0x65, 0x63, 0x61, 0x72, 0x64, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x12, 0x12, 0x67, 0x6f, 0x5f, 0x62, 0x6c, 0x75, 0x64, 0x67, 0x65, 0x6f, 0x6e, 0x5f, 0x74,
+	0x69, 0x6d, 0x65, 0x72, 0x73, 0x22, 0x68, 0x0a, 0x15, 0x52, 0x61, 0x74, 0x65, 0x43, 0x61, 0x72,
+	0x64, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x4f,
+	0x0a, 0x11, 0x72, 0x61, 0x74, 0x65, 0x5f, 0x63, 0x61, 0x72, 0x64, 0x5f, 0x70, 0x61, 0x72, 0x74,
+	0x69, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x67, 0x6f, 0x5f, 0x62,
+	0x6c, 0x75, 0x64, 0x67, 0x65, 0x6f, 0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x72, 0x73, 0x2e, 0x52,
+	0x61, 0x74, 0x65, 0x43, 0x61, 0x72, 0x64, 0x50, 0x61, 0x72, 0x74, 0x69, 0x61, 0x6c, 0x52, 0x0f,
+	0x72, 0x61, 0x74, 0x65, 0x43, 0x61, 0x72, 0x64, 0x50, 0x61, 0x72, 0x74, 0x69, 0x61, 0x6c, 0x22,
+	0x53, 0x0a, 0x16, 0x52, 0x61, 0x74, 0x65, 0x43, 0x61, 0x72, 0x64, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x39, 0x0a, 0x09, 0x72, 0x61, 0x74,
+	0x65, 0x5f, 0x63, 0x61, 0x72, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67,
+	0x6f, 0x5f, 0x62, 0x6c, 0x75, 0x64, 0x67, 0x65, 0x6f, 0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x72,
+	0x73, 0x2e, 0x52, 0x61, 0x74, 0x65, 0x43, 0x61, 0x72, 0x64, 0x52, 0x08, 0x72, 0x61, 0x74, 0x65,
+	0x43, 0x61, 0x72, 0x64, 0x22, 0x25, 0x0a, 0x13, 0x52, 0x61, 0x74, 0x65, 0x43, 0x61, 0x72, 0x64,
+	0x52, 0x65, 0x61, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x51, 0x0a, 0x14, 0x52,
+	0x61, 0x74, 0x65, 0x43, 0x61, 0x72, 0x64, 0x52, 0x65, 0x61, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x39, 0x0a, 0x09, 0x72, 0x61, 0x74, 0x65, 0x5f, 0x63, 0x61, 0x72, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x5f, 0x62, 0x6c, 0x75, 0x64,
+	0x67, 0x65, 0x6f, 0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x72, 0x73, 0x2e, 0x52, 0x61, 0x74, 0x65,
+	0x43, 0x61, 0x72, 0x64, 0x52, 0x08, 0x72, 0x61, 0x74, 0x65, 0x43, 0x61, 0x72, 0x64, 0x22, 0x78,
+	0x0a, 0x15, 0x52, 0x61, 0x74, 0x65, 0x43, 0x61, 0x72, 0x64, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x4f, 0x0a, 0x11, 0x72, 0x61, 0x74, 0x65, 0x5f,
+	0x63, 0x61, 0x72, 0x64, 0x5f, 0x70, 0x61, 0x72, 0x74, 0x69, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x23, 0x2e, 0x67, 0x6f, 0x5f, 0x62, 0x6c, 0x75, 0x64, 0x67, 0x65, 0x6f, 0x6e,
+	0x5f, 0x74, 0x69, 0x6d, 0x65, 0x72, 0x73, 0x2e, 0x52, 0x61, 0x74, 0x65, 0x43, 0x61, 0x72, 0x64,
+	0x50, 0x61, 0x72, 0x74, 0x69, 0x61, 0x6c, 0x52, 0x0f, 0x72, 0x61, 0x74, 0x65, 0x43, 0x61, 0x72,
+	0x64, 0x50, 0x61, 0x72, 0x74, 0x69, 0x61, 0x6c, 0x22, 0x53, 0x0a, 0x16, 0x52, 0x61, 0x74, 0x65,
+	0x43, 0x61, 0x72, 0x64, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x39, 0x0a, 0x09, 0x72, 0x61, 0x74, 0x65, 0x5f, 0x63, 0x61, 0x72, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x5f, 0x62, 0x6c, 0x75, 0x64, 0x67,
+	0x65, 0x6f, 0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x72, 0x73, 0x2e, 0x52, 0x61, 0x74, 0x65, 0x43,
+	0x61, 0x72, 0x64, 0x52, 0x08, 0x72, 0x61, 0x74, 0x65, 0x43, 0x61, 0x72, 0x64, 0x22, 0x27, 0x0a,
+	0x15, 0x52, 0x61, 0x74, 0x65, 0x43, 0x61, 0x72, 0x64, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x18, 0x0a, 0x16, 0x52, 0x61, 0x74, 0x65, 0x43, 0x61,
+	0x72, 0x64, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x64, 0x0a, 0x14, 0x52, 0x61, 0x74, 0x65, 0x43, 0x61, 0x72, 0x64, 0x73, 0x52, 0x65, 0x61,
+	0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x4c, 0x0a, 0x10, 0x72, 0x61, 0x74, 0x65,
+	0x5f, 0x63, 0x61, 0x72, 0x64, 0x5f, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x22, 0x2e, 0x67, 0x6f, 0x5f, 0x62, 0x6c, 0x75, 0x64, 0x67, 0x65, 0x6f, 0x6e,
+	0x5f, 0x74, 0x69, 0x6d, 0x65, 0x72, 0x73, 0x2e, 0x52, 0x61, 0x74, 0x65, 0x43, 0x61, 0x72, 0x64,
+	0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x0e, 0x72, 0x61, 0x74, 0x65, 0x43, 0x61, 0x72, 0x64,
+	0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x22, 0x54, 0x0a, 0x15, 0x52, 0x61, 0x74, 0x65, 0x43, 0x61,
+	0x72, 0x64, 0x73, 0x52, 0x65, 0x61, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x3b, 0x0a, 0x0a, 0x72, 0x61, 0x74, 0x65, 0x5f, 0x63, 0x61, 0x72, 0x64, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x5f, 0x62, 0x6c, 0x75, 0x64, 0x67, 0x65, 0x6f,
+	0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x72, 0x73, 0x2e, 0x52, 0x61, 0x74, 0x65, 0x43, 0x61, 0x72,
+	0x64, 0x52, 0x09, 0x72, 0x61, 0x74, 0x65, 0x43, 0x61, 0x72, 0x64, 0x73, 0x22, 0xa1, 0x01, 0x0a,
+	0x0e, 0x52, 0x61, 0x74, 0x65, 0x43, 0x61, 0x72, 0x64, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x12,
+	0x10, 0x0a, 0x03, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x03, 0x69, 0x64,
+	0x73, 0x12, 0x21, 0x0a, 0x0c, 0x65, 0x6d, 0x70, 0x6c, 0x6f, 0x79, 0x65, 0x65, 0x5f, 0x69, 0x64,
+	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x65, 0x6d, 0x70, 0x6c, 0x6f, 0x79, 0x65,
+	0x65, 0x49, 0x64, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x5f,
+	0x69, 0x64, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x72, 0x6f, 0x6a, 0x65,
+	0x63, 0x74, 0x49, 0x64, 0x73, 0x12, 0x23, 0x0a, 0x0c, 0x65, 0x66, 0x66, 0x65, 0x63, 0x74, 0x69,
+	0x76, 0x65, 0x5f, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x48, 0x00, 0x52, 0x0b, 0x65,
+	0x66, 0x66, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x41, 0x74, 0x42, 0x14, 0x0a, 0x12, 0x65, 0x66,
+	0x66, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x61, 0x74, 0x5f, 0x6f, 0x6e, 0x65, 0x6f, 0x66,
+	0x22, 0xce, 0x02, 0x0a, 0x0f, 0x52, 0x61, 0x74, 0x65, 0x43, 0x61, 0x72, 0x64, 0x50, 0x61, 0x72,
+	0x74, 0x69, 0x61, 0x6c, 0x12, 0x21, 0x0a, 0x0b, 0x65, 0x6d, 0x70, 0x6c, 0x6f, 0x79, 0x65, 0x65,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0a, 0x65, 0x6d, 0x70,
+	0x6c, 0x6f, 0x79, 0x65, 0x65, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x6a, 0x65,
+	0x63, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x09, 0x70,
+	0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x04, 0x72, 0x61, 0x74, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x48, 0x02, 0x52, 0x04, 0x72, 0x61, 0x74, 0x65, 0x12, 0x1c,
+	0x0a, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x48, 0x03, 0x52, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x27, 0x0a, 0x0e,
+	0x65, 0x66, 0x66, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x03, 0x48, 0x04, 0x52, 0x0d, 0x65, 0x66, 0x66, 0x65, 0x63, 0x74, 0x69, 0x76,
+	0x65, 0x46, 0x72, 0x6f, 0x6d, 0x12, 0x23, 0x0a, 0x0c, 0x65, 0x66, 0x66, 0x65, 0x63, 0x74, 0x69,
+	0x76, 0x65, 0x5f, 0x74, 0x6f, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x48, 0x05, 0x52, 0x0b, 0x65,
+	0x66, 0x66, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x54, 0x6f, 0x42, 0x13, 0x0a, 0x11, 0x65, 0x6d,
+	0x70, 0x6c, 0x6f, 0x79, 0x65, 0x65, 0x5f, 0x69, 0x64, 0x5f, 0x6f, 0x6e, 0x65, 0x6f, 0x66, 0x42,
+	0x12, 0x0a, 0x10, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x5f, 0x6f, 0x6e,
+	0x65, 0x6f, 0x66, 0x42, 0x0c, 0x0a, 0x0a, 0x72, 0x61, 0x74, 0x65, 0x5f, 0x6f, 0x6e, 0x65, 0x6f,
+	0x66, 0x42, 0x10, 0x0a, 0x0e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x6f, 0x6e,
+	0x65, 0x6f, 0x66, 0x42, 0x16, 0x0a, 0x14, 0x65, 0x66, 0x66, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65,
+	0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x6f, 0x6e, 0x65, 0x6f, 0x66, 0x42, 0x14, 0x0a, 0x12, 0x65,
+	0x66, 0x66, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x74, 0x6f, 0x5f, 0x6f, 0x6e, 0x65, 0x6f,
+	0x66, 0x22, 0xb9, 0x02, 0x0a, 0x08, 0x52, 0x61, 0x74, 0x65, 0x43, 0x61, 0x72, 0x64, 0x12, 0x0e,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1f,
+	0x0a, 0x0b, 0x65, 0x6d, 0x70, 0x6c, 0x6f, 0x79, 0x65, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0a, 0x65, 0x6d, 0x70, 0x6c, 0x6f, 0x79, 0x65, 0x65, 0x49, 0x64, 0x12,
+	0x1d, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x49, 0x64, 0x12, 0x12,
+	0x0a, 0x04, 0x72, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x72, 0x61,
+	0x74, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x25,
+	0x0a, 0x0e, 0x65, 0x66, 0x66, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x66, 0x72, 0x6f, 0x6d,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x65, 0x66, 0x66, 0x65, 0x63, 0x74, 0x69, 0x76,
+	0x65, 0x46, 0x72, 0x6f, 0x6d, 0x12, 0x21, 0x0a, 0x0c, 0x65, 0x66, 0x66, 0x65, 0x63, 0x74, 0x69,
+	0x76, 0x65, 0x5f, 0x74, 0x6f, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x65, 0x66, 0x66,
+	0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x54, 0x6f, 0x12, 0x21, 0x0a, 0x0c, 0x6c, 0x61, 0x73, 0x74,
+	0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b,
+	0x6c, 0x61, 0x73, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x12, 0x26, 0x0a, 0x0f, 0x6c,
+	0x61, 0x73, 0x74, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x18, 0x09,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6c, 0x61, 0x73, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x64, 0x42, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x0a,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x32, 0xa3, 0x04,
+	0x0a, 0x09, 0x52, 0x61, 0x74, 0x65, 0x43, 0x61, 0x72, 0x64, 0x73, 0x12, 0x6b, 0x0a, 0x10, 0x72,
+	0x61, 0x74, 0x65, 0x5f, 0x63, 0x61, 0x72, 0x64, 0x5f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x12,
+	0x29, 0x2e, 0x67, 0x6f, 0x5f, 0x62, 0x6c, 0x75, 0x64, 0x67, 0x65, 0x6f, 0x6e, 0x5f, 0x74, 0x69,
+	0x6d, 0x65, 0x72, 0x73, 0x2e, 0x52, 0x61, 0x74, 0x65, 0x43, 0x61, 0x72, 0x64, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x67, 0x6f, 0x5f,
+	0x62, 0x6c, 0x75, 0x64, 0x67, 0x65, 0x6f, 0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x72, 0x73, 0x2e,
+	0x52, 0x61, 0x74, 0x65, 0x43, 0x61, 0x72, 0x64, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x65, 0x0a, 0x0e, 0x72, 0x61, 0x74, 0x65,
+	0x5f, 0x63, 0x61, 0x72, 0x64, 0x5f, 0x72, 0x65, 0x61, 0x64, 0x12, 0x27, 0x2e, 0x67, 0x6f, 0x5f,
+	0x62, 0x6c, 0x75, 0x64, 0x67, 0x65, 0x6f, 0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x72, 0x73, 0x2e,
+	0x52, 0x61, 0x74, 0x65, 0x43, 0x61, 0x72, 0x64, 0x52, 0x65, 0x61, 0x64, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x67, 0x6f, 0x5f, 0x62, 0x6c, 0x75, 0x64, 0x67, 0x65, 0x6f,
+	0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x72, 0x73, 0x2e, 0x52, 0x61, 0x74, 0x65, 0x43, 0x61, 0x72,
+	0x64, 0x52, 0x65, 0x61, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x6b, 0x0a, 0x10, 0x72, 0x61, 0x74, 0x65, 0x5f, 0x63, 0x61, 0x72, 0x64, 0x5f, 0x75, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x12, 0x29, 0x2e, 0x67, 0x6f, 0x5f, 0x62, 0x6c, 0x75, 0x64, 0x67, 0x65, 0x6f,
+	0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x72, 0x73, 0x2e, 0x52, 0x61, 0x74, 0x65, 0x43, 0x61, 0x72,
+	0x64, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a,
+	0x2e, 0x67, 0x6f, 0x5f, 0x62, 0x6c, 0x75, 0x64, 0x67, 0x65, 0x6f, 0x6e, 0x5f, 0x74, 0x69, 0x6d,
+	0x65, 0x72, 0x73, 0x2e, 0x52, 0x61, 0x74, 0x65, 0x43, 0x61, 0x72, 0x64, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x6b, 0x0a, 0x10,
+	0x72, 0x61, 0x74, 0x65, 0x5f, 0x63, 0x61, 0x72, 0x64, 0x5f, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x12, 0x29, 0x2e, 0x67, 0x6f, 0x5f, 0x62, 0x6c, 0x75, 0x64, 0x67, 0x65, 0x6f, 0x6e, 0x5f, 0x74,
+	0x69, 0x6d, 0x65, 0x72, 0x73, 0x2e, 0x52, 0x61, 0x74, 0x65, 0x43, 0x61, 0x72, 0x64, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x67, 0x6f,
+	0x5f, 0x62, 0x6c, 0x75, 0x64, 0x67, 0x65, 0x6f, 0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x72, 0x73,
+	0x2e, 0x52, 0x61, 0x74, 0x65, 0x43, 0x61, 0x72, 0x64, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x68, 0x0a, 0x0f, 0x72, 0x61, 0x74,
+	0x65, 0x5f, 0x63, 0x61, 0x72, 0x64, 0x73, 0x5f, 0x72, 0x65, 0x61, 0x64, 0x12, 0x28, 0x2e, 0x67,
+	0x6f, 0x5f, 0x62, 0x6c, 0x75, 0x64, 0x67, 0x65, 0x6f, 0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x72,
+	0x73, 0x2e, 0x52, 0x61, 0x74, 0x65, 0x43, 0x61, 0x72, 0x64, 0x73, 0x52, 0x65, 0x61, 0x64, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x67, 0x6f, 0x5f, 0x62, 0x6c, 0x75, 0x64,
+	0x67, 0x65, 0x6f, 0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x72, 0x73, 0x2e, 0x52, 0x61, 0x74, 0x65,
+	0x43, 0x61, 0x72, 0x64, 0x73, 0x52, 0x65, 0x61, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x42, 0x39, 0x5a, 0x37, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f,
+	0x6d, 0x2f, 0x61, 0x6e, 0x74, 0x6f, 0x6e, 0x69, 0x6f, 0x2d, 0x61, 0x6c, 0x65, 0x78, 0x61, 0x6e,
+	0x64, 0x65, 0x72, 0x2f, 0x67, 0x6f, 0x2d, 0x62, 0x6c, 0x75, 0x64, 0x67, 0x65, 0x6f, 0x6e, 0x2f,
+	0x74, 0x69, 0x6d, 0x65, 0x72, 0x73, 0x2f, 0x64, 0x61, 0x74, 0x61, 0x2f, 0x70, 0x62, 0x62, 0x06,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_ratecards_proto_rawDescOnce sync.Once
+	file_ratecards_proto_rawDescData = file_ratecards_proto_rawDesc
+)
+
+func file_ratecards_proto_rawDescGZIP() []byte {
+	file_ratecards_proto_rawDescOnce.Do(func() {
+		file_ratecards_proto_rawDescData = protoimpl.X.CompressGZIP(file_ratecards_proto_rawDescData)
+	})
+	return file_ratecards_proto_rawDescData
+}
+
+var file_ratecards_proto_msgTypes = make([]protoimpl.MessageInfo, 13)
+var file_ratecards_proto_goTypes = []interface{}{
+	(*RateCardCreateRequest)(nil),  // 0: go_bludgeon_timers.RateCardCreateRequest
+	(*RateCardCreateResponse)(nil), // 1: go_bludgeon_timers.RateCardCreateResponse
+	(*RateCardReadRequest)(nil),    // 2: go_bludgeon_timers.RateCardReadRequest
+	(*RateCardReadResponse)(nil),   // 3: go_bludgeon_timers.RateCardReadResponse
+	(*RateCardUpdateRequest)(nil),  // 4: go_bludgeon_timers.RateCardUpdateRequest
+	(*RateCardUpdateResponse)(nil), // 5: go_bludgeon_timers.RateCardUpdateResponse
+	(*RateCardDeleteRequest)(nil),  // 6: go_bludgeon_timers.RateCardDeleteRequest
+	(*RateCardDeleteResponse)(nil), // 7: go_bludgeon_timers.RateCardDeleteResponse
+	(*RateCardsReadRequest)(nil),   // 8: go_bludgeon_timers.RateCardsReadRequest
+	(*RateCardsReadResponse)(nil),  // 9: go_bludgeon_timers.RateCardsReadResponse
+	(*RateCardSearch)(nil),         // 10: go_bludgeon_timers.RateCardSearch
+	(*RateCardPartial)(nil),        // 11: go_bludgeon_timers.RateCardPartial
+	(*RateCard)(nil),               // 12: go_bludgeon_timers.RateCard
+}
+var file_ratecards_proto_depIdxs = []int32{
+	11, // 0: go_bludgeon_timers.RateCardCreateRequest.rate_card_partial:type_name -> go_bludgeon_timers.RateCardPartial
+	12, // 1: go_bludgeon_timers.RateCardCreateResponse.rate_card:type_name -> go_bludgeon_timers.RateCard
+	12, // 2: go_bludgeon_timers.RateCardReadResponse.rate_card:type_name -> go_bludgeon_timers.RateCard
+	11, // 3: go_bludgeon_timers.RateCardUpdateRequest.rate_card_partial:type_name -> go_bludgeon_timers.RateCardPartial
+	12, // 4: go_bludgeon_timers.RateCardUpdateResponse.rate_card:type_name -> go_bludgeon_timers.RateCard
+	10, // 5: go_bludgeon_timers.RateCardsReadRequest.rate_card_search:type_name -> go_bludgeon_timers.RateCardSearch
+	12, // 6: go_bludgeon_timers.RateCardsReadResponse.rate_cards:type_name -> go_bludgeon_timers.RateCard
+	0,  // 7: go_bludgeon_timers.RateCards.rate_card_create:input_type -> go_bludgeon_timers.RateCardCreateRequest
+	2,  // 8: go_bludgeon_timers.RateCards.rate_card_read:input_type -> go_bludgeon_timers.RateCardReadRequest
+	4,  // 9: go_bludgeon_timers.RateCards.rate_card_update:input_type -> go_bludgeon_timers.RateCardUpdateRequest
+	6,  // 10: go_bludgeon_timers.RateCards.rate_card_delete:input_type -> go_bludgeon_timers.RateCardDeleteRequest
+	8,  // 11: go_bludgeon_timers.RateCards.rate_cards_read:input_type -> go_bludgeon_timers.RateCardsReadRequest
+	1,  // 12: go_bludgeon_timers.RateCards.rate_card_create:output_type -> go_bludgeon_timers.RateCardCreateResponse
+	3,  // 13: go_bludgeon_timers.RateCards.rate_card_read:output_type -> go_bludgeon_timers.RateCardReadResponse
+	5,  // 14: go_bludgeon_timers.RateCards.rate_card_update:output_type -> go_bludgeon_timers.RateCardUpdateResponse
+	7,  // 15: go_bludgeon_timers.RateCards.rate_card_delete:output_type -> go_bludgeon_timers.RateCardDeleteResponse
+	9,  // 16: go_bludgeon_timers.RateCards.rate_cards_read:output_type -> go_bludgeon_timers.RateCardsReadResponse
+	12, // [12:17] is the sub-list for method output_type
+	7,  // [7:12] is the sub-list for method input_type
+	7,  // [7:7] is the sub-list for extension type_name
+	7,  // [7:7] is the sub-list for extension extendee
+	0,  // [0:7] is the sub-list for field type_name
+}
+
+func init() { file_ratecards_proto_init() }
+func file_ratecards_proto_init() {
+	if File_ratecards_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_ratecards_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RateCardCreateRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ratecards_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RateCardCreateResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ratecards_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RateCardReadRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ratecards_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RateCardReadResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ratecards_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RateCardUpdateRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ratecards_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RateCardUpdateResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ratecards_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RateCardDeleteRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ratecards_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RateCardDeleteResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ratecards_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RateCardsReadRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ratecards_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RateCardsReadResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ratecards_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RateCardSearch); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ratecards_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RateCardPartial); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ratecards_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RateCard); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_ratecards_proto_msgTypes[10].OneofWrappers = []interface{}{
+		(*RateCardSearch_EffectiveAt)(nil),
+	}
+	file_ratecards_proto_msgTypes[11].OneofWrappers = []interface{}{
+		(*RateCardPartial_EmployeeId)(nil),
+		(*RateCardPartial_ProjectId)(nil),
+		(*RateCardPartial_Rate)(nil),
+		(*RateCardPartial_Currency)(nil),
+		(*RateCardPartial_EffectiveFrom)(nil),
+		(*RateCardPartial_EffectiveTo)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_ratecards_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   13,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_ratecards_proto_goTypes,
+		DependencyIndexes: file_ratecards_proto_depIdxs,
+		MessageInfos:      file_ratecards_proto_msgTypes,
+	}.Build()
+	File_ratecards_proto = out.File
+	file_ratecards_proto_rawDesc = nil
+	file_ratecards_proto_goTypes = nil
+	file_ratecards_proto_depIdxs = nil
+}
@@ -0,0 +1,259 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.2.0
+// - protoc             v3.20.1
+// source: ratecards.proto
+
+package pb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// RateCardsClient is the client API for RateCards service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type RateCardsClient interface {
+	// rate_card_create
+	RateCardCreate(ctx context.Context, in *RateCardCreateRequest, opts ...grpc.CallOption) (*RateCardCreateResponse, error)
+	// rate_card_read
+	RateCardRead(ctx context.Context, in *RateCardReadRequest, opts ...grpc.CallOption) (*RateCardReadResponse, error)
+	// rate_card_update
+	RateCardUpdate(ctx context.Context, in *RateCardUpdateRequest, opts ...grpc.CallOption) (*RateCardUpdateResponse, error)
+	// rate_card_delete
+	RateCardDelete(ctx context.Context, in *RateCardDeleteRequest, opts ...grpc.CallOption) (*RateCardDeleteResponse, error)
+	// rate_cards_read
+	RateCardsRead(ctx context.Context, in *RateCardsReadRequest, opts ...grpc.CallOption) (*RateCardsReadResponse, error)
+}
+
+type rateCardsClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewRateCardsClient(cc grpc.ClientConnInterface) RateCardsClient {
+	return &rateCardsClient{cc}
+}
+
+func (c *rateCardsClient) RateCardCreate(ctx context.Context, in *RateCardCreateRequest, opts ...grpc.CallOption) (*RateCardCreateResponse, error) {
+	out := new(RateCardCreateResponse)
+	err := c.cc.Invoke(ctx, "/go_bludgeon_timers.RateCards/rate_card_create", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rateCardsClient) RateCardRead(ctx context.Context, in *RateCardReadRequest, opts ...grpc.CallOption) (*RateCardReadResponse, error) {
+	out := new(RateCardReadResponse)
+	err := c.cc.Invoke(ctx, "/go_bludgeon_timers.RateCards/rate_card_read", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rateCardsClient) RateCardUpdate(ctx context.Context, in *RateCardUpdateRequest, opts ...grpc.CallOption) (*RateCardUpdateResponse, error) {
+	out := new(RateCardUpdateResponse)
+	err := c.cc.Invoke(ctx, "/go_bludgeon_timers.RateCards/rate_card_update", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rateCardsClient) RateCardDelete(ctx context.Context, in *RateCardDeleteRequest, opts ...grpc.CallOption) (*RateCardDeleteResponse, error) {
+	out := new(RateCardDeleteResponse)
+	err := c.cc.Invoke(ctx, "/go_bludgeon_timers.RateCards/rate_card_delete", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rateCardsClient) RateCardsRead(ctx context.Context, in *RateCardsReadRequest, opts ...grpc.CallOption) (*RateCardsReadResponse, error) {
+	out := new(RateCardsReadResponse)
+	err := c.cc.Invoke(ctx, "/go_bludgeon_timers.RateCards/rate_cards_read", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// RateCardsServer is the server API for RateCards service.
+// All implementations must embed UnimplementedRateCardsServer
+// for forward compatibility
+type RateCardsServer interface {
+	// rate_card_create
+	RateCardCreate(context.Context, *RateCardCreateRequest) (*RateCardCreateResponse, error)
+	// rate_card_read
+	RateCardRead(context.Context, *RateCardReadRequest) (*RateCardReadResponse, error)
+	// rate_card_update
+	RateCardUpdate(context.Context, *RateCardUpdateRequest) (*RateCardUpdateResponse, error)
+	// rate_card_delete
+	RateCardDelete(context.Context, *RateCardDeleteRequest) (*RateCardDeleteResponse, error)
+	// rate_cards_read
+	RateCardsRead(context.Context, *RateCardsReadRequest) (*RateCardsReadResponse, error)
+	mustEmbedUnimplementedRateCardsServer()
+}
+
+// UnimplementedRateCardsServer must be embedded to have forward compatible implementations.
+type UnimplementedRateCardsServer struct {
+}
+
+func (UnimplementedRateCardsServer) RateCardCreate(context.Context, *RateCardCreateRequest) (*RateCardCreateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RateCardCreate not implemented")
+}
+func (UnimplementedRateCardsServer) RateCardRead(context.Context, *RateCardReadRequest) (*RateCardReadResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RateCardRead not implemented")
+}
+func (UnimplementedRateCardsServer) RateCardUpdate(context.Context, *RateCardUpdateRequest) (*RateCardUpdateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RateCardUpdate not implemented")
+}
+func (UnimplementedRateCardsServer) RateCardDelete(context.Context, *RateCardDeleteRequest) (*RateCardDeleteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RateCardDelete not implemented")
+}
+func (UnimplementedRateCardsServer) RateCardsRead(context.Context, *RateCardsReadRequest) (*RateCardsReadResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RateCardsRead not implemented")
+}
+func (UnimplementedRateCardsServer) mustEmbedUnimplementedRateCardsServer() {}
+
+// UnsafeRateCardsServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to RateCardsServer will
+// result in compilation errors.
+type UnsafeRateCardsServer interface {
+	mustEmbedUnimplementedRateCardsServer()
+}
+
+func RegisterRateCardsServer(s grpc.ServiceRegistrar, srv RateCardsServer) {
+	s.RegisterService(&RateCards_ServiceDesc, srv)
+}
+
+func _RateCards_RateCardCreate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RateCardCreateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RateCardsServer).RateCardCreate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/go_bludgeon_timers.RateCards/rate_card_create",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RateCardsServer).RateCardCreate(ctx, req.(*RateCardCreateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RateCards_RateCardRead_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RateCardReadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RateCardsServer).RateCardRead(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/go_bludgeon_timers.RateCards/rate_card_read",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RateCardsServer).RateCardRead(ctx, req.(*RateCardReadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RateCards_RateCardUpdate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RateCardUpdateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RateCardsServer).RateCardUpdate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/go_bludgeon_timers.RateCards/rate_card_update",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RateCardsServer).RateCardUpdate(ctx, req.(*RateCardUpdateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RateCards_RateCardDelete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RateCardDeleteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RateCardsServer).RateCardDelete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/go_bludgeon_timers.RateCards/rate_card_delete",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RateCardsServer).RateCardDelete(ctx, req.(*RateCardDeleteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RateCards_RateCardsRead_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RateCardsReadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RateCardsServer).RateCardsRead(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/go_bludgeon_timers.RateCards/rate_cards_read",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RateCardsServer).RateCardsRead(ctx, req.(*RateCardsReadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// RateCards_ServiceDesc is the grpc.ServiceDesc for RateCards service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var RateCards_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "go_bludgeon_timers.RateCards",
+	HandlerType: (*RateCardsServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "rate_card_create",
+			Handler:    _RateCards_RateCardCreate_Handler,
+		},
+		{
+			MethodName: "rate_card_read",
+			Handler:    _RateCards_RateCardRead_Handler,
+		},
+		{
+			MethodName: "rate_card_update",
+			Handler:    _RateCards_RateCardUpdate_Handler,
+		},
+		{
+			MethodName: "rate_card_delete",
+			Handler:    _RateCards_RateCardDelete_Handler,
+		},
+		{
+			MethodName: "rate_cards_read",
+			Handler:    _RateCards_RateCardsRead_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "ratecards.proto",
+}
@@ -229,6 +229,12 @@ type TimerUpdateRequest struct {
 	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	// timer_partial
 	TimerPartial *TimerPartial `protobuf:"bytes,2,opt,name=timer_partial,json=timerPartial,proto3" json:"timer_partial,omitempty"`
+	// version_oneof
+	//
+	// Types that are assignable to VersionOneof:
+	//
+	//	*TimerUpdateRequest_Version
+	VersionOneof isTimerUpdateRequest_VersionOneof `protobuf_oneof:"version_oneof"`
 }
 
 func (x *TimerUpdateRequest) Reset() {
@@ -277,6 +283,31 @@ func (x *TimerUpdateRequest) GetTimerPartial() *TimerPartial {
 	return nil
 }
 
+func (m *TimerUpdateRequest) GetVersionOneof() isTimerUpdateRequest_VersionOneof {
+	if m != nil {
+		return m.VersionOneof
+	}
+	return nil
+}
+
+func (x *TimerUpdateRequest) GetVersion() int32 {
+	if x, ok := x.GetVersionOneof().(*TimerUpdateRequest_Version); ok {
+		return x.Version
+	}
+	return 0
+}
+
+type isTimerUpdateRequest_VersionOneof interface {
+	isTimerUpdateRequest_VersionOneof()
+}
+
+type TimerUpdateRequest_Version struct {
+	// version
+	Version int32 `protobuf:"varint,3,opt,name=version,proto3,oneof"`
+}
+
+func (*TimerUpdateRequest_Version) isTimerUpdateRequest_VersionOneof() {}
+
 // TimerUpdateResponse
 type TimerUpdateResponse struct {
 	state         protoimpl.MessageState
@@ -414,6 +445,104 @@ func (*TimerDeleteResponse) Descriptor() ([]byte, []int) {
 	return file_timers_proto_rawDescGZIP(), []int{7}
 }
 
+// TimerRestoreRequest
+type TimerRestoreRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// id
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *TimerRestoreRequest) Reset() {
+	*x = TimerRestoreRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_timers_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TimerRestoreRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TimerRestoreRequest) ProtoMessage() {}
+
+func (x *TimerRestoreRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_timers_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TimerRestoreRequest.ProtoReflect.Descriptor instead.
+func (*TimerRestoreRequest) Descriptor() ([]byte, []int) {
+	return file_timers_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *TimerRestoreRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// TimerRestoreResponse
+type TimerRestoreResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// timer
+	Timer *Timer `protobuf:"bytes,1,opt,name=timer,proto3" json:"timer,omitempty"`
+}
+
+func (x *TimerRestoreResponse) Reset() {
+	*x = TimerRestoreResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_timers_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TimerRestoreResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TimerRestoreResponse) ProtoMessage() {}
+
+func (x *TimerRestoreResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_timers_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TimerRestoreResponse.ProtoReflect.Descriptor instead.
+func (*TimerRestoreResponse) Descriptor() ([]byte, []int) {
+	return file_timers_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *TimerRestoreResponse) GetTimer() *Timer {
+	if x != nil {
+		return x.Timer
+	}
+	return nil
+}
+
 // TimersReadRequest
 type TimersReadRequest struct {
 	state         protoimpl.MessageState
@@ -427,7 +556,7 @@ type TimersReadRequest struct {
 func (x *TimersReadRequest) Reset() {
 	*x = TimersReadRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_timers_proto_msgTypes[8]
+		mi := &file_timers_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -440,7 +569,7 @@ func (x *TimersReadRequest) String() string {
 func (*TimersReadRequest) ProtoMessage() {}
 
 func (x *TimersReadRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_timers_proto_msgTypes[8]
+	mi := &file_timers_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -453,7 +582,7 @@ func (x *TimersReadRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TimersReadRequest.ProtoReflect.Descriptor instead.
 func (*TimersReadRequest) Descriptor() ([]byte, []int) {
-	return file_timers_proto_rawDescGZIP(), []int{8}
+	return file_timers_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *TimersReadRequest) GetTimerSearch() *TimerSearch {
@@ -476,7 +605,7 @@ type TimersReadResponse struct {
 func (x *TimersReadResponse) Reset() {
 	*x = TimersReadResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_timers_proto_msgTypes[9]
+		mi := &file_timers_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -489,7 +618,7 @@ func (x *TimersReadResponse) String() string {
 func (*TimersReadResponse) ProtoMessage() {}
 
 func (x *TimersReadResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_timers_proto_msgTypes[9]
+	mi := &file_timers_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -502,7 +631,7 @@ func (x *TimersReadResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TimersReadResponse.ProtoReflect.Descriptor instead.
 func (*TimersReadResponse) Descriptor() ([]byte, []int) {
-	return file_timers_proto_rawDescGZIP(), []int{9}
+	return file_timers_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *TimersReadResponse) GetTimers() []*Timer {
@@ -512,6 +641,104 @@ func (x *TimersReadResponse) GetTimers() []*Timer {
 	return nil
 }
 
+// TimerHistoryRequest
+type TimerHistoryRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// id
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *TimerHistoryRequest) Reset() {
+	*x = TimerHistoryRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_timers_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TimerHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TimerHistoryRequest) ProtoMessage() {}
+
+func (x *TimerHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_timers_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TimerHistoryRequest.ProtoReflect.Descriptor instead.
+func (*TimerHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_timers_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *TimerHistoryRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// TimerHistoryResponse
+type TimerHistoryResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// timers
+	Timers []*Timer `protobuf:"bytes,1,rep,name=timers,proto3" json:"timers,omitempty"`
+}
+
+func (x *TimerHistoryResponse) Reset() {
+	*x = TimerHistoryResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_timers_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TimerHistoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TimerHistoryResponse) ProtoMessage() {}
+
+func (x *TimerHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_timers_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TimerHistoryResponse.ProtoReflect.Descriptor instead.
+func (*TimerHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_timers_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *TimerHistoryResponse) GetTimers() []*Timer {
+	if x != nil {
+		return x.Timers
+	}
+	return nil
+}
+
 // TimerStartRequest
 type TimerStartRequest struct {
 	state         protoimpl.MessageState
@@ -520,12 +747,18 @@ type TimerStartRequest struct {
 
 	// id
 	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// start_oneof
+	//
+	// Types that are assignable to StartOneof:
+	//
+	//	*TimerStartRequest_Start
+	StartOneof isTimerStartRequest_StartOneof `protobuf_oneof:"start_oneof"`
 }
 
 func (x *TimerStartRequest) Reset() {
 	*x = TimerStartRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_timers_proto_msgTypes[10]
+		mi := &file_timers_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -538,7 +771,7 @@ func (x *TimerStartRequest) String() string {
 func (*TimerStartRequest) ProtoMessage() {}
 
 func (x *TimerStartRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_timers_proto_msgTypes[10]
+	mi := &file_timers_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -551,7 +784,7 @@ func (x *TimerStartRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TimerStartRequest.ProtoReflect.Descriptor instead.
 func (*TimerStartRequest) Descriptor() ([]byte, []int) {
-	return file_timers_proto_rawDescGZIP(), []int{10}
+	return file_timers_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *TimerStartRequest) GetId() string {
@@ -561,6 +794,31 @@ func (x *TimerStartRequest) GetId() string {
 	return ""
 }
 
+func (m *TimerStartRequest) GetStartOneof() isTimerStartRequest_StartOneof {
+	if m != nil {
+		return m.StartOneof
+	}
+	return nil
+}
+
+func (x *TimerStartRequest) GetStart() int64 {
+	if x, ok := x.GetStartOneof().(*TimerStartRequest_Start); ok {
+		return x.Start
+	}
+	return 0
+}
+
+type isTimerStartRequest_StartOneof interface {
+	isTimerStartRequest_StartOneof()
+}
+
+type TimerStartRequest_Start struct {
+	// start
+	Start int64 `protobuf:"varint,2,opt,name=start,proto3,oneof"`
+}
+
+func (*TimerStartRequest_Start) isTimerStartRequest_StartOneof() {}
+
 // TimerStartResponse
 type TimerStartResponse struct {
 	state         protoimpl.MessageState
@@ -574,7 +832,7 @@ type TimerStartResponse struct {
 func (x *TimerStartResponse) Reset() {
 	*x = TimerStartResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_timers_proto_msgTypes[11]
+		mi := &file_timers_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -587,7 +845,7 @@ func (x *TimerStartResponse) String() string {
 func (*TimerStartResponse) ProtoMessage() {}
 
 func (x *TimerStartResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_timers_proto_msgTypes[11]
+	mi := &file_timers_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -600,7 +858,7 @@ func (x *TimerStartResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TimerStartResponse.ProtoReflect.Descriptor instead.
 func (*TimerStartResponse) Descriptor() ([]byte, []int) {
-	return file_timers_proto_rawDescGZIP(), []int{11}
+	return file_timers_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *TimerStartResponse) GetTimer() *Timer {
@@ -618,12 +876,18 @@ type TimerStopRequest struct {
 
 	// id
 	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// finish_oneof
+	//
+	// Types that are assignable to FinishOneof:
+	//
+	//	*TimerStopRequest_Finish
+	FinishOneof isTimerStopRequest_FinishOneof `protobuf_oneof:"finish_oneof"`
 }
 
 func (x *TimerStopRequest) Reset() {
 	*x = TimerStopRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_timers_proto_msgTypes[12]
+		mi := &file_timers_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -636,7 +900,7 @@ func (x *TimerStopRequest) String() string {
 func (*TimerStopRequest) ProtoMessage() {}
 
 func (x *TimerStopRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_timers_proto_msgTypes[12]
+	mi := &file_timers_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -649,7 +913,7 @@ func (x *TimerStopRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TimerStopRequest.ProtoReflect.Descriptor instead.
 func (*TimerStopRequest) Descriptor() ([]byte, []int) {
-	return file_timers_proto_rawDescGZIP(), []int{12}
+	return file_timers_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *TimerStopRequest) GetId() string {
@@ -659,6 +923,31 @@ func (x *TimerStopRequest) GetId() string {
 	return ""
 }
 
+func (m *TimerStopRequest) GetFinishOneof() isTimerStopRequest_FinishOneof {
+	if m != nil {
+		return m.FinishOneof
+	}
+	return nil
+}
+
+func (x *TimerStopRequest) GetFinish() int64 {
+	if x, ok := x.GetFinishOneof().(*TimerStopRequest_Finish); ok {
+		return x.Finish
+	}
+	return 0
+}
+
+type isTimerStopRequest_FinishOneof interface {
+	isTimerStopRequest_FinishOneof()
+}
+
+type TimerStopRequest_Finish struct {
+	// finish
+	Finish int64 `protobuf:"varint,2,opt,name=finish,proto3,oneof"`
+}
+
+func (*TimerStopRequest_Finish) isTimerStopRequest_FinishOneof() {}
+
 // TimerStopResponse
 type TimerStopResponse struct {
 	state         protoimpl.MessageState
@@ -672,7 +961,7 @@ type TimerStopResponse struct {
 func (x *TimerStopResponse) Reset() {
 	*x = TimerStopResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_timers_proto_msgTypes[13]
+		mi := &file_timers_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -685,7 +974,7 @@ func (x *TimerStopResponse) String() string {
 func (*TimerStopResponse) ProtoMessage() {}
 
 func (x *TimerStopResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_timers_proto_msgTypes[13]
+	mi := &file_timers_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -698,7 +987,7 @@ func (x *TimerStopResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TimerStopResponse.ProtoReflect.Descriptor instead.
 func (*TimerStopResponse) Descriptor() ([]byte, []int) {
-	return file_timers_proto_rawDescGZIP(), []int{13}
+	return file_timers_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *TimerStopResponse) GetTimer() *Timer {
@@ -727,7 +1016,7 @@ type TimerSubmitRequest struct {
 func (x *TimerSubmitRequest) Reset() {
 	*x = TimerSubmitRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_timers_proto_msgTypes[14]
+		mi := &file_timers_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -740,7 +1029,7 @@ func (x *TimerSubmitRequest) String() string {
 func (*TimerSubmitRequest) ProtoMessage() {}
 
 func (x *TimerSubmitRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_timers_proto_msgTypes[14]
+	mi := &file_timers_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -753,7 +1042,7 @@ func (x *TimerSubmitRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TimerSubmitRequest.ProtoReflect.Descriptor instead.
 func (*TimerSubmitRequest) Descriptor() ([]byte, []int) {
-	return file_timers_proto_rawDescGZIP(), []int{14}
+	return file_timers_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *TimerSubmitRequest) GetId() string {
@@ -801,7 +1090,7 @@ type TimerSubmitResponse struct {
 func (x *TimerSubmitResponse) Reset() {
 	*x = TimerSubmitResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_timers_proto_msgTypes[15]
+		mi := &file_timers_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -814,7 +1103,7 @@ func (x *TimerSubmitResponse) String() string {
 func (*TimerSubmitResponse) ProtoMessage() {}
 
 func (x *TimerSubmitResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_timers_proto_msgTypes[15]
+	mi := &file_timers_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -827,7 +1116,7 @@ func (x *TimerSubmitResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TimerSubmitResponse.ProtoReflect.Descriptor instead.
 func (*TimerSubmitResponse) Descriptor() ([]byte, []int) {
-	return file_timers_proto_rawDescGZIP(), []int{15}
+	return file_timers_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *TimerSubmitResponse) GetTimer() *Timer {
@@ -852,7 +1141,7 @@ type TimerUpdateCommentRequest struct {
 func (x *TimerUpdateCommentRequest) Reset() {
 	*x = TimerUpdateCommentRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_timers_proto_msgTypes[16]
+		mi := &file_timers_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -865,7 +1154,7 @@ func (x *TimerUpdateCommentRequest) String() string {
 func (*TimerUpdateCommentRequest) ProtoMessage() {}
 
 func (x *TimerUpdateCommentRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_timers_proto_msgTypes[16]
+	mi := &file_timers_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -878,7 +1167,7 @@ func (x *TimerUpdateCommentRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TimerUpdateCommentRequest.ProtoReflect.Descriptor instead.
 func (*TimerUpdateCommentRequest) Descriptor() ([]byte, []int) {
-	return file_timers_proto_rawDescGZIP(), []int{16}
+	return file_timers_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *TimerUpdateCommentRequest) GetId() string {
@@ -908,7 +1197,7 @@ type TimerUpdateCommentResponse struct {
 func (x *TimerUpdateCommentResponse) Reset() {
 	*x = TimerUpdateCommentResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_timers_proto_msgTypes[17]
+		mi := &file_timers_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -921,7 +1210,7 @@ func (x *TimerUpdateCommentResponse) String() string {
 func (*TimerUpdateCommentResponse) ProtoMessage() {}
 
 func (x *TimerUpdateCommentResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_timers_proto_msgTypes[17]
+	mi := &file_timers_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -934,7 +1223,7 @@ func (x *TimerUpdateCommentResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TimerUpdateCommentResponse.ProtoReflect.Descriptor instead.
 func (*TimerUpdateCommentResponse) Descriptor() ([]byte, []int) {
-	return file_timers_proto_rawDescGZIP(), []int{17}
+	return file_timers_proto_rawDescGZIP(), []int{21}
 }
 
 func (x *TimerUpdateCommentResponse) GetTimer() *Timer {
@@ -959,7 +1248,7 @@ type TimerArchiveRequest struct {
 func (x *TimerArchiveRequest) Reset() {
 	*x = TimerArchiveRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_timers_proto_msgTypes[18]
+		mi := &file_timers_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -972,7 +1261,7 @@ func (x *TimerArchiveRequest) String() string {
 func (*TimerArchiveRequest) ProtoMessage() {}
 
 func (x *TimerArchiveRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_timers_proto_msgTypes[18]
+	mi := &file_timers_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -985,7 +1274,7 @@ func (x *TimerArchiveRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TimerArchiveRequest.ProtoReflect.Descriptor instead.
 func (*TimerArchiveRequest) Descriptor() ([]byte, []int) {
-	return file_timers_proto_rawDescGZIP(), []int{18}
+	return file_timers_proto_rawDescGZIP(), []int{22}
 }
 
 func (x *TimerArchiveRequest) GetId() string {
@@ -1015,7 +1304,7 @@ type TimerArchiveResponse struct {
 func (x *TimerArchiveResponse) Reset() {
 	*x = TimerArchiveResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_timers_proto_msgTypes[19]
+		mi := &file_timers_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1028,7 +1317,7 @@ func (x *TimerArchiveResponse) String() string {
 func (*TimerArchiveResponse) ProtoMessage() {}
 
 func (x *TimerArchiveResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_timers_proto_msgTypes[19]
+	mi := &file_timers_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1041,7 +1330,7 @@ func (x *TimerArchiveResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TimerArchiveResponse.ProtoReflect.Descriptor instead.
 func (*TimerArchiveResponse) Descriptor() ([]byte, []int) {
-	return file_timers_proto_rawDescGZIP(), []int{19}
+	return file_timers_proto_rawDescGZIP(), []int{23}
 }
 
 func (x *TimerArchiveResponse) GetTimer() *Timer {
@@ -1079,96 +1368,265 @@ type TimerSearch struct {
 	ArchivedOneof isTimerSearch_ArchivedOneof `protobuf_oneof:"archived_oneof"`
 	// ids
 	Ids []string `protobuf:"bytes,5,rep,name=ids,proto3" json:"ids,omitempty"`
+	// project_id_oneof
+	//
+	// Types that are assignable to ProjectIdOneof:
+	//
+	//	*TimerSearch_ProjectId
+	ProjectIdOneof isTimerSearch_ProjectIdOneof `protobuf_oneof:"project_id_oneof"`
+	// project_ids
+	ProjectIds []string `protobuf:"bytes,7,rep,name=project_ids,json=projectIds,proto3" json:"project_ids,omitempty"`
+	// invoiced_oneof
+	//
+	// Types that are assignable to InvoicedOneof:
+	//
+	//	*TimerSearch_Invoiced
+	InvoicedOneof isTimerSearch_InvoicedOneof `protobuf_oneof:"invoiced_oneof"`
+	// start_oneof
+	//
+	// Types that are assignable to StartOneof:
+	//
+	//	*TimerSearch_Start
+	StartOneof isTimerSearch_StartOneof `protobuf_oneof:"start_oneof"`
+	// finish_oneof
+	//
+	// Types that are assignable to FinishOneof:
+	//
+	//	*TimerSearch_Finish
+	FinishOneof isTimerSearch_FinishOneof `protobuf_oneof:"finish_oneof"`
+	// limit_oneof
+	//
+	// Types that are assignable to LimitOneof:
+	//
+	//	*TimerSearch_Limit
+	LimitOneof isTimerSearch_LimitOneof `protobuf_oneof:"limit_oneof"`
+	// cursor_oneof
+	//
+	// Types that are assignable to CursorOneof:
+	//
+	//	*TimerSearch_Cursor
+	CursorOneof isTimerSearch_CursorOneof `protobuf_oneof:"cursor_oneof"`
+	// sort_oneof
+	//
+	// Types that are assignable to SortOneof:
+	//
+	//	*TimerSearch_Sort
+	SortOneof isTimerSearch_SortOneof `protobuf_oneof:"sort_oneof"`
+	// deleted_oneof
+	//
+	// Types that are assignable to DeletedOneof:
+	//
+	//	*TimerSearch_Deleted
+	DeletedOneof isTimerSearch_DeletedOneof `protobuf_oneof:"deleted_oneof"`
 }
 
 func (x *TimerSearch) Reset() {
 	*x = TimerSearch{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_timers_proto_msgTypes[20]
+		mi := &file_timers_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *TimerSearch) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *TimerSearch) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TimerSearch) ProtoMessage() {}
+
+func (x *TimerSearch) ProtoReflect() protoreflect.Message {
+	mi := &file_timers_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TimerSearch.ProtoReflect.Descriptor instead.
+func (*TimerSearch) Descriptor() ([]byte, []int) {
+	return file_timers_proto_rawDescGZIP(), []int{24}
+}
+
+func (m *TimerSearch) GetEmployeeIdOneof() isTimerSearch_EmployeeIdOneof {
+	if m != nil {
+		return m.EmployeeIdOneof
+	}
+	return nil
+}
+
+func (x *TimerSearch) GetEmployeeId() string {
+	if x, ok := x.GetEmployeeIdOneof().(*TimerSearch_EmployeeId); ok {
+		return x.EmployeeId
+	}
+	return ""
+}
+
+func (x *TimerSearch) GetEmployeeIds() []string {
+	if x != nil {
+		return x.EmployeeIds
+	}
+	return nil
+}
+
+func (m *TimerSearch) GetCompletedOneof() isTimerSearch_CompletedOneof {
+	if m != nil {
+		return m.CompletedOneof
+	}
+	return nil
+}
+
+func (x *TimerSearch) GetCompleted() bool {
+	if x, ok := x.GetCompletedOneof().(*TimerSearch_Completed); ok {
+		return x.Completed
+	}
+	return false
+}
+
+func (m *TimerSearch) GetArchivedOneof() isTimerSearch_ArchivedOneof {
+	if m != nil {
+		return m.ArchivedOneof
+	}
+	return nil
+}
+
+func (x *TimerSearch) GetArchived() bool {
+	if x, ok := x.GetArchivedOneof().(*TimerSearch_Archived); ok {
+		return x.Archived
+	}
+	return false
+}
+
+func (x *TimerSearch) GetIds() []string {
+	if x != nil {
+		return x.Ids
+	}
+	return nil
+}
+
+func (m *TimerSearch) GetProjectIdOneof() isTimerSearch_ProjectIdOneof {
+	if m != nil {
+		return m.ProjectIdOneof
+	}
+	return nil
+}
+
+func (x *TimerSearch) GetProjectId() string {
+	if x, ok := x.GetProjectIdOneof().(*TimerSearch_ProjectId); ok {
+		return x.ProjectId
+	}
+	return ""
+}
+
+func (x *TimerSearch) GetProjectIds() []string {
+	if x != nil {
+		return x.ProjectIds
+	}
+	return nil
+}
+
+func (m *TimerSearch) GetInvoicedOneof() isTimerSearch_InvoicedOneof {
+	if m != nil {
+		return m.InvoicedOneof
+	}
+	return nil
 }
 
-func (*TimerSearch) ProtoMessage() {}
+func (x *TimerSearch) GetInvoiced() bool {
+	if x, ok := x.GetInvoicedOneof().(*TimerSearch_Invoiced); ok {
+		return x.Invoiced
+	}
+	return false
+}
 
-func (x *TimerSearch) ProtoReflect() protoreflect.Message {
-	mi := &file_timers_proto_msgTypes[20]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (m *TimerSearch) GetStartOneof() isTimerSearch_StartOneof {
+	if m != nil {
+		return m.StartOneof
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use TimerSearch.ProtoReflect.Descriptor instead.
-func (*TimerSearch) Descriptor() ([]byte, []int) {
-	return file_timers_proto_rawDescGZIP(), []int{20}
+func (x *TimerSearch) GetStart() int64 {
+	if x, ok := x.GetStartOneof().(*TimerSearch_Start); ok {
+		return x.Start
+	}
+	return 0
 }
 
-func (m *TimerSearch) GetEmployeeIdOneof() isTimerSearch_EmployeeIdOneof {
+func (m *TimerSearch) GetFinishOneof() isTimerSearch_FinishOneof {
 	if m != nil {
-		return m.EmployeeIdOneof
+		return m.FinishOneof
 	}
 	return nil
 }
 
-func (x *TimerSearch) GetEmployeeId() string {
-	if x, ok := x.GetEmployeeIdOneof().(*TimerSearch_EmployeeId); ok {
-		return x.EmployeeId
+func (x *TimerSearch) GetFinish() int64 {
+	if x, ok := x.GetFinishOneof().(*TimerSearch_Finish); ok {
+		return x.Finish
 	}
-	return ""
+	return 0
 }
 
-func (x *TimerSearch) GetEmployeeIds() []string {
-	if x != nil {
-		return x.EmployeeIds
+func (m *TimerSearch) GetLimitOneof() isTimerSearch_LimitOneof {
+	if m != nil {
+		return m.LimitOneof
 	}
 	return nil
 }
 
-func (m *TimerSearch) GetCompletedOneof() isTimerSearch_CompletedOneof {
+func (x *TimerSearch) GetLimit() int32 {
+	if x, ok := x.GetLimitOneof().(*TimerSearch_Limit); ok {
+		return x.Limit
+	}
+	return 0
+}
+
+func (m *TimerSearch) GetCursorOneof() isTimerSearch_CursorOneof {
 	if m != nil {
-		return m.CompletedOneof
+		return m.CursorOneof
 	}
 	return nil
 }
 
-func (x *TimerSearch) GetCompleted() bool {
-	if x, ok := x.GetCompletedOneof().(*TimerSearch_Completed); ok {
-		return x.Completed
+func (x *TimerSearch) GetCursor() string {
+	if x, ok := x.GetCursorOneof().(*TimerSearch_Cursor); ok {
+		return x.Cursor
 	}
-	return false
+	return ""
 }
 
-func (m *TimerSearch) GetArchivedOneof() isTimerSearch_ArchivedOneof {
+func (m *TimerSearch) GetSortOneof() isTimerSearch_SortOneof {
 	if m != nil {
-		return m.ArchivedOneof
+		return m.SortOneof
 	}
 	return nil
 }
 
-func (x *TimerSearch) GetArchived() bool {
-	if x, ok := x.GetArchivedOneof().(*TimerSearch_Archived); ok {
-		return x.Archived
+func (x *TimerSearch) GetSort() string {
+	if x, ok := x.GetSortOneof().(*TimerSearch_Sort); ok {
+		return x.Sort
 	}
-	return false
+	return ""
 }
 
-func (x *TimerSearch) GetIds() []string {
-	if x != nil {
-		return x.Ids
+func (m *TimerSearch) GetDeletedOneof() isTimerSearch_DeletedOneof {
+	if m != nil {
+		return m.DeletedOneof
 	}
 	return nil
 }
 
+func (x *TimerSearch) GetDeleted() bool {
+	if x, ok := x.GetDeletedOneof().(*TimerSearch_Deleted); ok {
+		return x.Deleted
+	}
+	return false
+}
+
 type isTimerSearch_EmployeeIdOneof interface {
 	isTimerSearch_EmployeeIdOneof()
 }
@@ -1202,6 +1660,94 @@ type TimerSearch_Archived struct {
 
 func (*TimerSearch_Archived) isTimerSearch_ArchivedOneof() {}
 
+type isTimerSearch_ProjectIdOneof interface {
+	isTimerSearch_ProjectIdOneof()
+}
+
+type TimerSearch_ProjectId struct {
+	// project_id
+	ProjectId string `protobuf:"bytes,6,opt,name=project_id,json=projectId,proto3,oneof"`
+}
+
+func (*TimerSearch_ProjectId) isTimerSearch_ProjectIdOneof() {}
+
+type isTimerSearch_InvoicedOneof interface {
+	isTimerSearch_InvoicedOneof()
+}
+
+type TimerSearch_Invoiced struct {
+	// invoiced
+	Invoiced bool `protobuf:"varint,8,opt,name=invoiced,proto3,oneof"`
+}
+
+func (*TimerSearch_Invoiced) isTimerSearch_InvoicedOneof() {}
+
+type isTimerSearch_StartOneof interface {
+	isTimerSearch_StartOneof()
+}
+
+type TimerSearch_Start struct {
+	// start
+	Start int64 `protobuf:"varint,9,opt,name=start,proto3,oneof"`
+}
+
+func (*TimerSearch_Start) isTimerSearch_StartOneof() {}
+
+type isTimerSearch_FinishOneof interface {
+	isTimerSearch_FinishOneof()
+}
+
+type TimerSearch_Finish struct {
+	// finish
+	Finish int64 `protobuf:"varint,10,opt,name=finish,proto3,oneof"`
+}
+
+func (*TimerSearch_Finish) isTimerSearch_FinishOneof() {}
+
+type isTimerSearch_LimitOneof interface {
+	isTimerSearch_LimitOneof()
+}
+
+type TimerSearch_Limit struct {
+	// limit
+	Limit int32 `protobuf:"varint,11,opt,name=limit,proto3,oneof"`
+}
+
+func (*TimerSearch_Limit) isTimerSearch_LimitOneof() {}
+
+type isTimerSearch_CursorOneof interface {
+	isTimerSearch_CursorOneof()
+}
+
+type TimerSearch_Cursor struct {
+	// cursor
+	Cursor string `protobuf:"bytes,12,opt,name=cursor,proto3,oneof"`
+}
+
+func (*TimerSearch_Cursor) isTimerSearch_CursorOneof() {}
+
+type isTimerSearch_SortOneof interface {
+	isTimerSearch_SortOneof()
+}
+
+type TimerSearch_Sort struct {
+	// sort
+	Sort string `protobuf:"bytes,13,opt,name=sort,proto3,oneof"`
+}
+
+func (*TimerSearch_Sort) isTimerSearch_SortOneof() {}
+
+type isTimerSearch_DeletedOneof interface {
+	isTimerSearch_DeletedOneof()
+}
+
+type TimerSearch_Deleted struct {
+	// deleted
+	Deleted bool `protobuf:"varint,14,opt,name=deleted,proto3,oneof"`
+}
+
+func (*TimerSearch_Deleted) isTimerSearch_DeletedOneof() {}
+
 // TimerPartial
 type TimerPartial struct {
 	state         protoimpl.MessageState
@@ -1238,12 +1784,18 @@ type TimerPartial struct {
 	//
 	//	*TimerPartial_Finish
 	FinishOneof isTimerPartial_FinishOneof `protobuf_oneof:"finish_oneof"`
+	// project_id_oneof
+	//
+	// Types that are assignable to ProjectIdOneof:
+	//
+	//	*TimerPartial_ProjectId
+	ProjectIdOneof isTimerPartial_ProjectIdOneof `protobuf_oneof:"project_id_oneof"`
 }
 
 func (x *TimerPartial) Reset() {
 	*x = TimerPartial{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_timers_proto_msgTypes[21]
+		mi := &file_timers_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1256,7 +1808,7 @@ func (x *TimerPartial) String() string {
 func (*TimerPartial) ProtoMessage() {}
 
 func (x *TimerPartial) ProtoReflect() protoreflect.Message {
-	mi := &file_timers_proto_msgTypes[21]
+	mi := &file_timers_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1269,7 +1821,7 @@ func (x *TimerPartial) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TimerPartial.ProtoReflect.Descriptor instead.
 func (*TimerPartial) Descriptor() ([]byte, []int) {
-	return file_timers_proto_rawDescGZIP(), []int{21}
+	return file_timers_proto_rawDescGZIP(), []int{25}
 }
 
 func (m *TimerPartial) GetCompletedOneof() isTimerPartial_CompletedOneof {
@@ -1342,6 +1894,20 @@ func (x *TimerPartial) GetFinish() int64 {
 	return 0
 }
 
+func (m *TimerPartial) GetProjectIdOneof() isTimerPartial_ProjectIdOneof {
+	if m != nil {
+		return m.ProjectIdOneof
+	}
+	return nil
+}
+
+func (x *TimerPartial) GetProjectId() string {
+	if x, ok := x.GetProjectIdOneof().(*TimerPartial_ProjectId); ok {
+		return x.ProjectId
+	}
+	return ""
+}
+
 type isTimerPartial_CompletedOneof interface {
 	isTimerPartial_CompletedOneof()
 }
@@ -1397,6 +1963,17 @@ type TimerPartial_Finish struct {
 
 func (*TimerPartial_Finish) isTimerPartial_FinishOneof() {}
 
+type isTimerPartial_ProjectIdOneof interface {
+	isTimerPartial_ProjectIdOneof()
+}
+
+type TimerPartial_ProjectId struct {
+	// project_id
+	ProjectId string `protobuf:"bytes,6,opt,name=project_id,json=projectId,proto3,oneof"`
+}
+
+func (*TimerPartial_ProjectId) isTimerPartial_ProjectIdOneof() {}
+
 // Timer
 type Timer struct {
 	state         protoimpl.MessageState
@@ -1427,12 +2004,18 @@ type Timer struct {
 	LastUpdatedBy string `protobuf:"bytes,11,opt,name=last_updated_by,json=lastUpdatedBy,proto3" json:"last_updated_by,omitempty"`
 	// version
 	Version int32 `protobuf:"varint,12,opt,name=version,proto3" json:"version,omitempty"`
+	// project_id
+	ProjectId string `protobuf:"bytes,13,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	// invoice_id
+	InvoiceId string `protobuf:"bytes,14,opt,name=invoice_id,json=invoiceId,proto3" json:"invoice_id,omitempty"`
+	// deleted_at
+	DeletedAt int64 `protobuf:"varint,15,opt,name=deleted_at,json=deletedAt,proto3" json:"deleted_at,omitempty"`
 }
 
 func (x *Timer) Reset() {
 	*x = Timer{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_timers_proto_msgTypes[22]
+		mi := &file_timers_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1445,7 +2028,7 @@ func (x *Timer) String() string {
 func (*Timer) ProtoMessage() {}
 
 func (x *Timer) ProtoReflect() protoreflect.Message {
-	mi := &file_timers_proto_msgTypes[22]
+	mi := &file_timers_proto_msgTypes[26]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1458,7 +2041,7 @@ func (x *Timer) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Timer.ProtoReflect.Descriptor instead.
 func (*Timer) Descriptor() ([]byte, []int) {
-	return file_timers_proto_rawDescGZIP(), []int{22}
+	return file_timers_proto_rawDescGZIP(), []int{26}
 }
 
 func (x *Timer) GetCompleted() bool {
@@ -1545,6 +2128,27 @@ func (x *Timer) GetVersion() int32 {
 	return 0
 }
 
+func (x *Timer) GetProjectId() string {
+	if x != nil {
+		return x.ProjectId
+	}
+	return ""
+}
+
+func (x *Timer) GetInvoiceId() string {
+	if x != nil {
+		return x.InvoiceId
+	}
+	return ""
+}
+
+func (x *Timer) GetDeletedAt() int64 {
+	if x != nil {
+		return x.DeletedAt
+	}
+	return 0
+}
+
 var File_timers_proto protoreflect.FileDescriptor
 
 var file_timers_proto_rawDesc = []byte{
@@ -1567,179 +2171,247 @@ var file_timers_proto_rawDesc = []byte{
 	0x12, 0x2f, 0x0a, 0x05, 0x74, 0x69, 0x6d, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
 	0x19, 0x2e, 0x67, 0x6f, 0x5f, 0x62, 0x6c, 0x75, 0x64, 0x67, 0x65, 0x6f, 0x6e, 0x5f, 0x74, 0x69,
 	0x6d, 0x65, 0x72, 0x73, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x72, 0x52, 0x05, 0x74, 0x69, 0x6d, 0x65,
-	0x72, 0x22, 0x6b, 0x0a, 0x12, 0x54, 0x69, 0x6d, 0x65, 0x72, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x45, 0x0a, 0x0d, 0x74, 0x69, 0x6d, 0x65, 0x72,
-	0x5f, 0x70, 0x61, 0x72, 0x74, 0x69, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20,
-	0x2e, 0x67, 0x6f, 0x5f, 0x62, 0x6c, 0x75, 0x64, 0x67, 0x65, 0x6f, 0x6e, 0x5f, 0x74, 0x69, 0x6d,
-	0x65, 0x72, 0x73, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x72, 0x50, 0x61, 0x72, 0x74, 0x69, 0x61, 0x6c,
-	0x52, 0x0c, 0x74, 0x69, 0x6d, 0x65, 0x72, 0x50, 0x61, 0x72, 0x74, 0x69, 0x61, 0x6c, 0x22, 0x46,
-	0x0a, 0x13, 0x54, 0x69, 0x6d, 0x65, 0x72, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2f, 0x0a, 0x05, 0x74, 0x69, 0x6d, 0x65, 0x72, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x5f, 0x62, 0x6c, 0x75, 0x64, 0x67, 0x65,
-	0x6f, 0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x72, 0x73, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x72, 0x52,
-	0x05, 0x74, 0x69, 0x6d, 0x65, 0x72, 0x22, 0x24, 0x0a, 0x12, 0x54, 0x69, 0x6d, 0x65, 0x72, 0x44,
-	0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02,
-	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x15, 0x0a, 0x13,
-	0x54, 0x69, 0x6d, 0x65, 0x72, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x57, 0x0a, 0x11, 0x54, 0x69, 0x6d, 0x65, 0x72, 0x73, 0x52, 0x65, 0x61,
-	0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x42, 0x0a, 0x0c, 0x74, 0x69, 0x6d, 0x65,
-	0x72, 0x5f, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f,
-	0x2e, 0x67, 0x6f, 0x5f, 0x62, 0x6c, 0x75, 0x64, 0x67, 0x65, 0x6f, 0x6e, 0x5f, 0x74, 0x69, 0x6d,
-	0x65, 0x72, 0x73, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x72, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52,
-	0x0b, 0x74, 0x69, 0x6d, 0x65, 0x72, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x22, 0x47, 0x0a, 0x12,
-	0x54, 0x69, 0x6d, 0x65, 0x72, 0x73, 0x52, 0x65, 0x61, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x31, 0x0a, 0x06, 0x74, 0x69, 0x6d, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x72, 0x22, 0x98, 0x01, 0x0a, 0x12, 0x54, 0x69, 0x6d, 0x65, 0x72, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x45, 0x0a, 0x0d, 0x74, 0x69, 0x6d, 0x65,
+	0x72, 0x5f, 0x70, 0x61, 0x72, 0x74, 0x69, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x20, 0x2e, 0x67, 0x6f, 0x5f, 0x62, 0x6c, 0x75, 0x64, 0x67, 0x65, 0x6f, 0x6e, 0x5f, 0x74, 0x69,
+	0x6d, 0x65, 0x72, 0x73, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x72, 0x50, 0x61, 0x72, 0x74, 0x69, 0x61,
+	0x6c, 0x52, 0x0c, 0x74, 0x69, 0x6d, 0x65, 0x72, 0x50, 0x61, 0x72, 0x74, 0x69, 0x61, 0x6c, 0x12,
+	0x1a, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05,
+	0x48, 0x00, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x42, 0x0f, 0x0a, 0x0d, 0x76,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x6f, 0x6e, 0x65, 0x6f, 0x66, 0x22, 0x46, 0x0a, 0x13,
+	0x54, 0x69, 0x6d, 0x65, 0x72, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x2f, 0x0a, 0x05, 0x74, 0x69, 0x6d, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01,
 	0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x5f, 0x62, 0x6c, 0x75, 0x64, 0x67, 0x65, 0x6f, 0x6e,
-	0x5f, 0x74, 0x69, 0x6d, 0x65, 0x72, 0x73, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x72, 0x52, 0x06, 0x74,
-	0x69, 0x6d, 0x65, 0x72, 0x73, 0x22, 0x23, 0x0a, 0x11, 0x54, 0x69, 0x6d, 0x65, 0x72, 0x53, 0x74,
-	0x61, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x45, 0x0a, 0x12, 0x54, 0x69,
-	0x6d, 0x65, 0x72, 0x53, 0x74, 0x61, 0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x5f, 0x74, 0x69, 0x6d, 0x65, 0x72, 0x73, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x72, 0x52, 0x05, 0x74,
+	0x69, 0x6d, 0x65, 0x72, 0x22, 0x24, 0x0a, 0x12, 0x54, 0x69, 0x6d, 0x65, 0x72, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x15, 0x0a, 0x13, 0x54, 0x69,
+	0x6d, 0x65, 0x72, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x25, 0x0a, 0x13, 0x54, 0x69, 0x6d, 0x65, 0x72, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x47, 0x0a, 0x14, 0x54, 0x69, 0x6d, 0x65,
+	0x72, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
 	0x12, 0x2f, 0x0a, 0x05, 0x74, 0x69, 0x6d, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
 	0x19, 0x2e, 0x67, 0x6f, 0x5f, 0x62, 0x6c, 0x75, 0x64, 0x67, 0x65, 0x6f, 0x6e, 0x5f, 0x74, 0x69,
 	0x6d, 0x65, 0x72, 0x73, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x72, 0x52, 0x05, 0x74, 0x69, 0x6d, 0x65,
-	0x72, 0x22, 0x22, 0x0a, 0x10, 0x54, 0x69, 0x6d, 0x65, 0x72, 0x53, 0x74, 0x6f, 0x70, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x44, 0x0a, 0x11, 0x54, 0x69, 0x6d, 0x65, 0x72, 0x53, 0x74,
-	0x6f, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2f, 0x0a, 0x05, 0x74, 0x69,
-	0x6d, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x5f, 0x62,
-	0x6c, 0x75, 0x64, 0x67, 0x65, 0x6f, 0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x72, 0x73, 0x2e, 0x54,
-	0x69, 0x6d, 0x65, 0x72, 0x52, 0x05, 0x74, 0x69, 0x6d, 0x65, 0x72, 0x22, 0x4e, 0x0a, 0x12, 0x54,
-	0x69, 0x6d, 0x65, 0x72, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x72, 0x22, 0x57, 0x0a, 0x11, 0x54, 0x69, 0x6d, 0x65, 0x72, 0x73, 0x52, 0x65, 0x61, 0x64, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x42, 0x0a, 0x0c, 0x74, 0x69, 0x6d, 0x65, 0x72, 0x5f,
+	0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x67,
+	0x6f, 0x5f, 0x62, 0x6c, 0x75, 0x64, 0x67, 0x65, 0x6f, 0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x72,
+	0x73, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x72, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x0b, 0x74,
+	0x69, 0x6d, 0x65, 0x72, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x22, 0x47, 0x0a, 0x12, 0x54, 0x69,
+	0x6d, 0x65, 0x72, 0x73, 0x52, 0x65, 0x61, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x31, 0x0a, 0x06, 0x74, 0x69, 0x6d, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x19, 0x2e, 0x67, 0x6f, 0x5f, 0x62, 0x6c, 0x75, 0x64, 0x67, 0x65, 0x6f, 0x6e, 0x5f, 0x74,
+	0x69, 0x6d, 0x65, 0x72, 0x73, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x72, 0x52, 0x06, 0x74, 0x69, 0x6d,
+	0x65, 0x72, 0x73, 0x22, 0x25, 0x0a, 0x13, 0x54, 0x69, 0x6d, 0x65, 0x72, 0x48, 0x69, 0x73, 0x74,
+	0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x49, 0x0a, 0x14, 0x54, 0x69,
+	0x6d, 0x65, 0x72, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x31, 0x0a, 0x06, 0x74, 0x69, 0x6d, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x5f, 0x62, 0x6c, 0x75, 0x64, 0x67, 0x65, 0x6f, 0x6e,
+	0x5f, 0x74, 0x69, 0x6d, 0x65, 0x72, 0x73, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x72, 0x52, 0x06, 0x74,
+	0x69, 0x6d, 0x65, 0x72, 0x73, 0x22, 0x4a, 0x0a, 0x11, 0x54, 0x69, 0x6d, 0x65, 0x72, 0x53, 0x74,
+	0x61, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x16, 0x0a, 0x05, 0x73, 0x74,
+	0x61, 0x72, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x48, 0x00, 0x52, 0x05, 0x73, 0x74, 0x61,
+	0x72, 0x74, 0x42, 0x0d, 0x0a, 0x0b, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x6f, 0x6e, 0x65, 0x6f,
+	0x66, 0x22, 0x45, 0x0a, 0x12, 0x54, 0x69, 0x6d, 0x65, 0x72, 0x53, 0x74, 0x61, 0x72, 0x74, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2f, 0x0a, 0x05, 0x74, 0x69, 0x6d, 0x65, 0x72,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x5f, 0x62, 0x6c, 0x75, 0x64,
+	0x67, 0x65, 0x6f, 0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x72, 0x73, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x72, 0x52, 0x05, 0x74, 0x69, 0x6d, 0x65, 0x72, 0x22, 0x4c, 0x0a, 0x10, 0x54, 0x69, 0x6d, 0x65,
+	0x72, 0x53, 0x74, 0x6f, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x18, 0x0a, 0x06,
+	0x66, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x48, 0x00, 0x52, 0x06,
+	0x66, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x42, 0x0e, 0x0a, 0x0c, 0x66, 0x69, 0x6e, 0x69, 0x73, 0x68,
+	0x5f, 0x6f, 0x6e, 0x65, 0x6f, 0x66, 0x22, 0x44, 0x0a, 0x11, 0x54, 0x69, 0x6d, 0x65, 0x72, 0x53,
+	0x74, 0x6f, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2f, 0x0a, 0x05, 0x74,
+	0x69, 0x6d, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x5f,
+	0x62, 0x6c, 0x75, 0x64, 0x67, 0x65, 0x6f, 0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x72, 0x73, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x72, 0x52, 0x05, 0x74, 0x69, 0x6d, 0x65, 0x72, 0x22, 0x4e, 0x0a, 0x12,
+	0x54, 0x69, 0x6d, 0x65, 0x72, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02,
+	0x69, 0x64, 0x12, 0x18, 0x0a, 0x06, 0x66, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x03, 0x48, 0x00, 0x52, 0x06, 0x66, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x42, 0x0e, 0x0a, 0x0c,
+	0x66, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x5f, 0x6f, 0x6e, 0x65, 0x6f, 0x66, 0x22, 0x46, 0x0a, 0x13,
+	0x54, 0x69, 0x6d, 0x65, 0x72, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x2f, 0x0a, 0x05, 0x74, 0x69, 0x6d, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x5f, 0x62, 0x6c, 0x75, 0x64, 0x67, 0x65, 0x6f, 0x6e,
+	0x5f, 0x74, 0x69, 0x6d, 0x65, 0x72, 0x73, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x72, 0x52, 0x05, 0x74,
+	0x69, 0x6d, 0x65, 0x72, 0x22, 0x45, 0x0a, 0x19, 0x54, 0x69, 0x6d, 0x65, 0x72, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
 	0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69,
-	0x64, 0x12, 0x18, 0x0a, 0x06, 0x66, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x03, 0x48, 0x00, 0x52, 0x06, 0x66, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x42, 0x0e, 0x0a, 0x0c, 0x66,
-	0x69, 0x6e, 0x69, 0x73, 0x68, 0x5f, 0x6f, 0x6e, 0x65, 0x6f, 0x66, 0x22, 0x46, 0x0a, 0x13, 0x54,
-	0x69, 0x6d, 0x65, 0x72, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x2f, 0x0a, 0x05, 0x74, 0x69, 0x6d, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x5f, 0x62, 0x6c, 0x75, 0x64, 0x67, 0x65, 0x6f, 0x6e, 0x5f,
-	0x74, 0x69, 0x6d, 0x65, 0x72, 0x73, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x72, 0x52, 0x05, 0x74, 0x69,
-	0x6d, 0x65, 0x72, 0x22, 0x45, 0x0a, 0x19, 0x54, 0x69, 0x6d, 0x65, 0x72, 0x55, 0x70, 0x64, 0x61,
-	0x74, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64,
-	0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0x4d, 0x0a, 0x1a, 0x54, 0x69,
-	0x6d, 0x65, 0x72, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2f, 0x0a, 0x05, 0x74, 0x69, 0x6d, 0x65,
-	0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x5f, 0x62, 0x6c, 0x75,
-	0x64, 0x67, 0x65, 0x6f, 0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x72, 0x73, 0x2e, 0x54, 0x69, 0x6d,
-	0x65, 0x72, 0x52, 0x05, 0x74, 0x69, 0x6d, 0x65, 0x72, 0x22, 0x3f, 0x0a, 0x13, 0x54, 0x69, 0x6d,
-	0x65, 0x72, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64,
-	0x12, 0x18, 0x0a, 0x07, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x08, 0x52, 0x07, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x22, 0x47, 0x0a, 0x14, 0x54, 0x69,
-	0x6d, 0x65, 0x72, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x2f, 0x0a, 0x05, 0x74, 0x69, 0x6d, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x5f, 0x62, 0x6c, 0x75, 0x64, 0x67, 0x65, 0x6f, 0x6e, 0x5f,
-	0x74, 0x69, 0x6d, 0x65, 0x72, 0x73, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x72, 0x52, 0x05, 0x74, 0x69,
-	0x6d, 0x65, 0x72, 0x22, 0xdd, 0x01, 0x0a, 0x0b, 0x54, 0x69, 0x6d, 0x65, 0x72, 0x53, 0x65, 0x61,
-	0x72, 0x63, 0x68, 0x12, 0x21, 0x0a, 0x0b, 0x65, 0x6d, 0x70, 0x6c, 0x6f, 0x79, 0x65, 0x65, 0x5f,
-	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0a, 0x65, 0x6d, 0x70, 0x6c,
-	0x6f, 0x79, 0x65, 0x65, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x65, 0x6d, 0x70, 0x6c, 0x6f, 0x79,
-	0x65, 0x65, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x65, 0x6d,
-	0x70, 0x6c, 0x6f, 0x79, 0x65, 0x65, 0x49, 0x64, 0x73, 0x12, 0x1e, 0x0a, 0x09, 0x63, 0x6f, 0x6d,
-	0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x48, 0x01, 0x52, 0x09,
-	0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x12, 0x1c, 0x0a, 0x08, 0x61, 0x72, 0x63,
-	0x68, 0x69, 0x76, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x48, 0x02, 0x52, 0x08, 0x61,
-	0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x69, 0x64, 0x73, 0x18, 0x05,
-	0x20, 0x03, 0x28, 0x09, 0x52, 0x03, 0x69, 0x64, 0x73, 0x42, 0x13, 0x0a, 0x11, 0x65, 0x6d, 0x70,
-	0x6c, 0x6f, 0x79, 0x65, 0x65, 0x5f, 0x69, 0x64, 0x5f, 0x6f, 0x6e, 0x65, 0x6f, 0x66, 0x42, 0x11,
-	0x0a, 0x0f, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x5f, 0x6f, 0x6e, 0x65, 0x6f,
-	0x66, 0x42, 0x10, 0x0a, 0x0e, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x5f, 0x6f, 0x6e,
-	0x65, 0x6f, 0x66, 0x22, 0x80, 0x02, 0x0a, 0x0c, 0x54, 0x69, 0x6d, 0x65, 0x72, 0x50, 0x61, 0x72,
-	0x74, 0x69, 0x61, 0x6c, 0x12, 0x1e, 0x0a, 0x09, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x48, 0x00, 0x52, 0x09, 0x63, 0x6f, 0x6d, 0x70, 0x6c,
-	0x65, 0x74, 0x65, 0x64, 0x12, 0x1c, 0x0a, 0x08, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x48, 0x01, 0x52, 0x08, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76,
-	0x65, 0x64, 0x12, 0x21, 0x0a, 0x0b, 0x65, 0x6d, 0x70, 0x6c, 0x6f, 0x79, 0x65, 0x65, 0x5f, 0x69,
-	0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x02, 0x52, 0x0a, 0x65, 0x6d, 0x70, 0x6c, 0x6f,
-	0x79, 0x65, 0x65, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x48, 0x03, 0x52, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
-	0x74, 0x12, 0x18, 0x0a, 0x06, 0x66, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x18, 0x05, 0x20, 0x01, 0x28,
-	0x03, 0x48, 0x04, 0x52, 0x06, 0x66, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x42, 0x11, 0x0a, 0x0f, 0x63,
-	0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x5f, 0x6f, 0x6e, 0x65, 0x6f, 0x66, 0x42, 0x10,
-	0x0a, 0x0e, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x5f, 0x6f, 0x6e, 0x65, 0x6f, 0x66,
+	0x64, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0x4d, 0x0a, 0x1a, 0x54,
+	0x69, 0x6d, 0x65, 0x72, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2f, 0x0a, 0x05, 0x74, 0x69, 0x6d,
+	0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x5f, 0x62, 0x6c,
+	0x75, 0x64, 0x67, 0x65, 0x6f, 0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x72, 0x73, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x72, 0x52, 0x05, 0x74, 0x69, 0x6d, 0x65, 0x72, 0x22, 0x3f, 0x0a, 0x13, 0x54, 0x69,
+	0x6d, 0x65, 0x72, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69,
+	0x64, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x07, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x22, 0x47, 0x0a, 0x14, 0x54,
+	0x69, 0x6d, 0x65, 0x72, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x2f, 0x0a, 0x05, 0x74, 0x69, 0x6d, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x5f, 0x62, 0x6c, 0x75, 0x64, 0x67, 0x65, 0x6f, 0x6e,
+	0x5f, 0x74, 0x69, 0x6d, 0x65, 0x72, 0x73, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x72, 0x52, 0x05, 0x74,
+	0x69, 0x6d, 0x65, 0x72, 0x22, 0xd6, 0x04, 0x0a, 0x0b, 0x54, 0x69, 0x6d, 0x65, 0x72, 0x53, 0x65,
+	0x61, 0x72, 0x63, 0x68, 0x12, 0x21, 0x0a, 0x0b, 0x65, 0x6d, 0x70, 0x6c, 0x6f, 0x79, 0x65, 0x65,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0a, 0x65, 0x6d, 0x70,
+	0x6c, 0x6f, 0x79, 0x65, 0x65, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x65, 0x6d, 0x70, 0x6c, 0x6f,
+	0x79, 0x65, 0x65, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x65,
+	0x6d, 0x70, 0x6c, 0x6f, 0x79, 0x65, 0x65, 0x49, 0x64, 0x73, 0x12, 0x1e, 0x0a, 0x09, 0x63, 0x6f,
+	0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x48, 0x01, 0x52,
+	0x09, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x12, 0x1c, 0x0a, 0x08, 0x61, 0x72,
+	0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x48, 0x02, 0x52, 0x08,
+	0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x69, 0x64, 0x73, 0x18,
+	0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x03, 0x69, 0x64, 0x73, 0x12, 0x1f, 0x0a, 0x0a, 0x70, 0x72,
+	0x6f, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x48, 0x03,
+	0x52, 0x09, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x70,
+	0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x0a, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x49, 0x64, 0x73, 0x12, 0x1c, 0x0a, 0x08,
+	0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x48, 0x04,
+	0x52, 0x08, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x64, 0x12, 0x16, 0x0a, 0x05, 0x73, 0x74,
+	0x61, 0x72, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x48, 0x05, 0x52, 0x05, 0x73, 0x74, 0x61,
+	0x72, 0x74, 0x12, 0x18, 0x0a, 0x06, 0x66, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x18, 0x0a, 0x20, 0x01,
+	0x28, 0x03, 0x48, 0x06, 0x52, 0x06, 0x66, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x12, 0x16, 0x0a, 0x05,
+	0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x05, 0x48, 0x07, 0x52, 0x05, 0x6c,
+	0x69, 0x6d, 0x69, 0x74, 0x12, 0x18, 0x0a, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x0c,
+	0x20, 0x01, 0x28, 0x09, 0x48, 0x08, 0x52, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x12, 0x14,
+	0x0a, 0x04, 0x73, 0x6f, 0x72, 0x74, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x48, 0x09, 0x52, 0x04,
+	0x73, 0x6f, 0x72, 0x74, 0x12, 0x1a, 0x0a, 0x07, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x18,
+	0x0e, 0x20, 0x01, 0x28, 0x08, 0x48, 0x0a, 0x52, 0x07, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64,
 	0x42, 0x13, 0x0a, 0x11, 0x65, 0x6d, 0x70, 0x6c, 0x6f, 0x79, 0x65, 0x65, 0x5f, 0x69, 0x64, 0x5f,
-	0x6f, 0x6e, 0x65, 0x6f, 0x66, 0x42, 0x0f, 0x0a, 0x0d, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74,
-	0x5f, 0x6f, 0x6e, 0x65, 0x6f, 0x66, 0x42, 0x0e, 0x0a, 0x0c, 0x66, 0x69, 0x6e, 0x69, 0x73, 0x68,
-	0x5f, 0x6f, 0x6e, 0x65, 0x6f, 0x66, 0x22, 0xf3, 0x02, 0x0a, 0x05, 0x54, 0x69, 0x6d, 0x65, 0x72,
-	0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x08, 0x52, 0x09, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x12, 0x1a,
+	0x6f, 0x6e, 0x65, 0x6f, 0x66, 0x42, 0x11, 0x0a, 0x0f, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74,
+	0x65, 0x64, 0x5f, 0x6f, 0x6e, 0x65, 0x6f, 0x66, 0x42, 0x10, 0x0a, 0x0e, 0x61, 0x72, 0x63, 0x68,
+	0x69, 0x76, 0x65, 0x64, 0x5f, 0x6f, 0x6e, 0x65, 0x6f, 0x66, 0x42, 0x12, 0x0a, 0x10, 0x70, 0x72,
+	0x6f, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x5f, 0x6f, 0x6e, 0x65, 0x6f, 0x66, 0x42, 0x10,
+	0x0a, 0x0e, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x64, 0x5f, 0x6f, 0x6e, 0x65, 0x6f, 0x66,
+	0x42, 0x0d, 0x0a, 0x0b, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x6f, 0x6e, 0x65, 0x6f, 0x66, 0x42,
+	0x0e, 0x0a, 0x0c, 0x66, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x5f, 0x6f, 0x6e, 0x65, 0x6f, 0x66, 0x42,
+	0x0d, 0x0a, 0x0b, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x5f, 0x6f, 0x6e, 0x65, 0x6f, 0x66, 0x42, 0x0e,
+	0x0a, 0x0c, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x5f, 0x6f, 0x6e, 0x65, 0x6f, 0x66, 0x42, 0x0c,
+	0x0a, 0x0a, 0x73, 0x6f, 0x72, 0x74, 0x5f, 0x6f, 0x6e, 0x65, 0x6f, 0x66, 0x42, 0x0f, 0x0a, 0x0d,
+	0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x5f, 0x6f, 0x6e, 0x65, 0x6f, 0x66, 0x22, 0xb5, 0x02,
+	0x0a, 0x0c, 0x54, 0x69, 0x6d, 0x65, 0x72, 0x50, 0x61, 0x72, 0x74, 0x69, 0x61, 0x6c, 0x12, 0x1e,
+	0x0a, 0x09, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x08, 0x48, 0x00, 0x52, 0x09, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x12, 0x1c,
 	0x0a, 0x08, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08,
-	0x52, 0x08, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74,
-	0x61, 0x72, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74,
-	0x12, 0x16, 0x0a, 0x06, 0x66, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03,
-	0x52, 0x06, 0x66, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x12, 0x21, 0x0a, 0x0c, 0x65, 0x6c, 0x61, 0x70,
-	0x73, 0x65, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b,
-	0x65, 0x6c, 0x61, 0x70, 0x73, 0x65, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x65,
-	0x6d, 0x70, 0x6c, 0x6f, 0x79, 0x65, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0a, 0x65, 0x6d, 0x70, 0x6c, 0x6f, 0x79, 0x65, 0x65, 0x49, 0x64, 0x12, 0x2f, 0x0a, 0x14,
-	0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x73, 0x6c, 0x69, 0x63,
-	0x65, 0x5f, 0x69, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x61, 0x63, 0x74, 0x69,
-	0x76, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x53, 0x6c, 0x69, 0x63, 0x65, 0x49, 0x64, 0x12, 0x0e, 0x0a,
-	0x02, 0x69, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x18, 0x0a,
-	0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
-	0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x6c, 0x61, 0x73, 0x74, 0x5f,
-	0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x6c,
-	0x61, 0x73, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x12, 0x26, 0x0a, 0x0f, 0x6c, 0x61,
-	0x73, 0x74, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x18, 0x0b, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0d, 0x6c, 0x61, 0x73, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64,
-	0x42, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x0c, 0x20,
-	0x01, 0x28, 0x05, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x32, 0x8e, 0x06, 0x0a,
-	0x06, 0x54, 0x69, 0x6d, 0x65, 0x72, 0x73, 0x12, 0x61, 0x0a, 0x0c, 0x74, 0x69, 0x6d, 0x65, 0x72,
-	0x5f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x12, 0x26, 0x2e, 0x67, 0x6f, 0x5f, 0x62, 0x6c, 0x75,
-	0x64, 0x67, 0x65, 0x6f, 0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x72, 0x73, 0x2e, 0x54, 0x69, 0x6d,
-	0x65, 0x72, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x27, 0x2e, 0x67, 0x6f, 0x5f, 0x62, 0x6c, 0x75, 0x64, 0x67, 0x65, 0x6f, 0x6e, 0x5f, 0x74, 0x69,
-	0x6d, 0x65, 0x72, 0x73, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x72, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5b, 0x0a, 0x0a, 0x74, 0x69,
-	0x6d, 0x65, 0x72, 0x5f, 0x72, 0x65, 0x61, 0x64, 0x12, 0x24, 0x2e, 0x67, 0x6f, 0x5f, 0x62, 0x6c,
-	0x75, 0x64, 0x67, 0x65, 0x6f, 0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x72, 0x73, 0x2e, 0x54, 0x69,
-	0x6d, 0x65, 0x72, 0x52, 0x65, 0x61, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25,
-	0x2e, 0x67, 0x6f, 0x5f, 0x62, 0x6c, 0x75, 0x64, 0x67, 0x65, 0x6f, 0x6e, 0x5f, 0x74, 0x69, 0x6d,
-	0x65, 0x72, 0x73, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x72, 0x52, 0x65, 0x61, 0x64, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x61, 0x0a, 0x0c, 0x74, 0x69, 0x6d, 0x65, 0x72,
-	0x5f, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x12, 0x26, 0x2e, 0x67, 0x6f, 0x5f, 0x62, 0x6c, 0x75,
-	0x64, 0x67, 0x65, 0x6f, 0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x72, 0x73, 0x2e, 0x54, 0x69, 0x6d,
-	0x65, 0x72, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x27, 0x2e, 0x67, 0x6f, 0x5f, 0x62, 0x6c, 0x75, 0x64, 0x67, 0x65, 0x6f, 0x6e, 0x5f, 0x74, 0x69,
-	0x6d, 0x65, 0x72, 0x73, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x72, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5e, 0x0a, 0x0b, 0x74, 0x69,
-	0x6d, 0x65, 0x72, 0x73, 0x5f, 0x72, 0x65, 0x61, 0x64, 0x12, 0x25, 0x2e, 0x67, 0x6f, 0x5f, 0x62,
-	0x6c, 0x75, 0x64, 0x67, 0x65, 0x6f, 0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x72, 0x73, 0x2e, 0x54,
-	0x69, 0x6d, 0x65, 0x72, 0x73, 0x52, 0x65, 0x61, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x26, 0x2e, 0x67, 0x6f, 0x5f, 0x62, 0x6c, 0x75, 0x64, 0x67, 0x65, 0x6f, 0x6e, 0x5f, 0x74,
-	0x69, 0x6d, 0x65, 0x72, 0x73, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x72, 0x73, 0x52, 0x65, 0x61, 0x64,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x61, 0x0a, 0x0c, 0x74, 0x69,
-	0x6d, 0x65, 0x72, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x26, 0x2e, 0x67, 0x6f, 0x5f,
+	0x48, 0x01, 0x52, 0x08, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x12, 0x21, 0x0a, 0x0b,
+	0x65, 0x6d, 0x70, 0x6c, 0x6f, 0x79, 0x65, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x48, 0x02, 0x52, 0x0a, 0x65, 0x6d, 0x70, 0x6c, 0x6f, 0x79, 0x65, 0x65, 0x49, 0x64, 0x12,
+	0x1a, 0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x48, 0x03, 0x52, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x18, 0x0a, 0x06, 0x66,
+	0x69, 0x6e, 0x69, 0x73, 0x68, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x48, 0x04, 0x52, 0x06, 0x66,
+	0x69, 0x6e, 0x69, 0x73, 0x68, 0x12, 0x1f, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74,
+	0x5f, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x48, 0x05, 0x52, 0x09, 0x70, 0x72, 0x6f,
+	0x6a, 0x65, 0x63, 0x74, 0x49, 0x64, 0x42, 0x11, 0x0a, 0x0f, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65,
+	0x74, 0x65, 0x64, 0x5f, 0x6f, 0x6e, 0x65, 0x6f, 0x66, 0x42, 0x10, 0x0a, 0x0e, 0x61, 0x72, 0x63,
+	0x68, 0x69, 0x76, 0x65, 0x64, 0x5f, 0x6f, 0x6e, 0x65, 0x6f, 0x66, 0x42, 0x13, 0x0a, 0x11, 0x65,
+	0x6d, 0x70, 0x6c, 0x6f, 0x79, 0x65, 0x65, 0x5f, 0x69, 0x64, 0x5f, 0x6f, 0x6e, 0x65, 0x6f, 0x66,
+	0x42, 0x0f, 0x0a, 0x0d, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x6f, 0x6e, 0x65, 0x6f,
+	0x66, 0x42, 0x0e, 0x0a, 0x0c, 0x66, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x5f, 0x6f, 0x6e, 0x65, 0x6f,
+	0x66, 0x42, 0x12, 0x0a, 0x10, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x5f,
+	0x6f, 0x6e, 0x65, 0x6f, 0x66, 0x22, 0xd0, 0x03, 0x0a, 0x05, 0x54, 0x69, 0x6d, 0x65, 0x72, 0x12,
+	0x1c, 0x0a, 0x09, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x09, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x12, 0x1a, 0x0a,
+	0x08, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x08, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61,
+	0x72, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x12,
+	0x16, 0x0a, 0x06, 0x66, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x06, 0x66, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x12, 0x21, 0x0a, 0x0c, 0x65, 0x6c, 0x61, 0x70, 0x73,
+	0x65, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x65,
+	0x6c, 0x61, 0x70, 0x73, 0x65, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x65, 0x6d,
+	0x70, 0x6c, 0x6f, 0x79, 0x65, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0a, 0x65, 0x6d, 0x70, 0x6c, 0x6f, 0x79, 0x65, 0x65, 0x49, 0x64, 0x12, 0x2f, 0x0a, 0x14, 0x61,
+	0x63, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x73, 0x6c, 0x69, 0x63, 0x65,
+	0x5f, 0x69, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x61, 0x63, 0x74, 0x69, 0x76,
+	0x65, 0x54, 0x69, 0x6d, 0x65, 0x53, 0x6c, 0x69, 0x63, 0x65, 0x49, 0x64, 0x12, 0x0e, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x18, 0x0a, 0x07,
+	0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63,
+	0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x75,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x6c, 0x61,
+	0x73, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x12, 0x26, 0x0a, 0x0f, 0x6c, 0x61, 0x73,
+	0x74, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x18, 0x0b, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0d, 0x6c, 0x61, 0x73, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x42,
+	0x79, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x0c, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x70,
+	0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x69, 0x6e,
+	0x76, 0x6f, 0x69, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x64, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x64,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x41, 0x74, 0x32, 0xda, 0x07, 0x0a, 0x06, 0x54, 0x69, 0x6d,
+	0x65, 0x72, 0x73, 0x12, 0x61, 0x0a, 0x0c, 0x74, 0x69, 0x6d, 0x65, 0x72, 0x5f, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x12, 0x26, 0x2e, 0x67, 0x6f, 0x5f, 0x62, 0x6c, 0x75, 0x64, 0x67, 0x65, 0x6f,
+	0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x72, 0x73, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x72, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x67, 0x6f,
+	0x5f, 0x62, 0x6c, 0x75, 0x64, 0x67, 0x65, 0x6f, 0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x72, 0x73,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x72, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5b, 0x0a, 0x0a, 0x74, 0x69, 0x6d, 0x65, 0x72, 0x5f,
+	0x72, 0x65, 0x61, 0x64, 0x12, 0x24, 0x2e, 0x67, 0x6f, 0x5f, 0x62, 0x6c, 0x75, 0x64, 0x67, 0x65,
+	0x6f, 0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x72, 0x73, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x72, 0x52,
+	0x65, 0x61, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x67, 0x6f, 0x5f,
 	0x62, 0x6c, 0x75, 0x64, 0x67, 0x65, 0x6f, 0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x72, 0x73, 0x2e,
-	0x54, 0x69, 0x6d, 0x65, 0x72, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x27, 0x2e, 0x67, 0x6f, 0x5f, 0x62, 0x6c, 0x75, 0x64, 0x67, 0x65, 0x6f, 0x6e,
-	0x5f, 0x74, 0x69, 0x6d, 0x65, 0x72, 0x73, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x72, 0x55, 0x70, 0x64,
-	0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5e, 0x0a,
-	0x0b, 0x74, 0x69, 0x6d, 0x65, 0x72, 0x5f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x12, 0x25, 0x2e, 0x67,
-	0x6f, 0x5f, 0x62, 0x6c, 0x75, 0x64, 0x67, 0x65, 0x6f, 0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x72,
-	0x73, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x72, 0x53, 0x74, 0x61, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x67, 0x6f, 0x5f, 0x62, 0x6c, 0x75, 0x64, 0x67, 0x65, 0x6f,
-	0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x72, 0x73, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x72, 0x53, 0x74,
-	0x61, 0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5b, 0x0a,
-	0x0a, 0x74, 0x69, 0x6d, 0x65, 0x72, 0x5f, 0x73, 0x74, 0x6f, 0x70, 0x12, 0x24, 0x2e, 0x67, 0x6f,
+	0x54, 0x69, 0x6d, 0x65, 0x72, 0x52, 0x65, 0x61, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x12, 0x61, 0x0a, 0x0c, 0x74, 0x69, 0x6d, 0x65, 0x72, 0x5f, 0x64, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x12, 0x26, 0x2e, 0x67, 0x6f, 0x5f, 0x62, 0x6c, 0x75, 0x64, 0x67, 0x65, 0x6f,
+	0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x72, 0x73, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x72, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x67, 0x6f,
 	0x5f, 0x62, 0x6c, 0x75, 0x64, 0x67, 0x65, 0x6f, 0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x72, 0x73,
-	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x72, 0x53, 0x74, 0x6f, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x25, 0x2e, 0x67, 0x6f, 0x5f, 0x62, 0x6c, 0x75, 0x64, 0x67, 0x65, 0x6f, 0x6e, 0x5f,
-	0x74, 0x69, 0x6d, 0x65, 0x72, 0x73, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x72, 0x53, 0x74, 0x6f, 0x70,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x61, 0x0a, 0x0c, 0x74, 0x69,
-	0x6d, 0x65, 0x72, 0x5f, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x12, 0x26, 0x2e, 0x67, 0x6f, 0x5f,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x72, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5e, 0x0a, 0x0b, 0x74, 0x69, 0x6d, 0x65, 0x72, 0x73,
+	0x5f, 0x72, 0x65, 0x61, 0x64, 0x12, 0x25, 0x2e, 0x67, 0x6f, 0x5f, 0x62, 0x6c, 0x75, 0x64, 0x67,
+	0x65, 0x6f, 0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x72, 0x73, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x72,
+	0x73, 0x52, 0x65, 0x61, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x67,
+	0x6f, 0x5f, 0x62, 0x6c, 0x75, 0x64, 0x67, 0x65, 0x6f, 0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x72,
+	0x73, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x72, 0x73, 0x52, 0x65, 0x61, 0x64, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x61, 0x0a, 0x0c, 0x74, 0x69, 0x6d, 0x65, 0x72, 0x5f,
+	0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x26, 0x2e, 0x67, 0x6f, 0x5f, 0x62, 0x6c, 0x75, 0x64,
+	0x67, 0x65, 0x6f, 0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x72, 0x73, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x72, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27,
+	0x2e, 0x67, 0x6f, 0x5f, 0x62, 0x6c, 0x75, 0x64, 0x67, 0x65, 0x6f, 0x6e, 0x5f, 0x74, 0x69, 0x6d,
+	0x65, 0x72, 0x73, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x72, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5e, 0x0a, 0x0b, 0x74, 0x69, 0x6d,
+	0x65, 0x72, 0x5f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x12, 0x25, 0x2e, 0x67, 0x6f, 0x5f, 0x62, 0x6c,
+	0x75, 0x64, 0x67, 0x65, 0x6f, 0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x72, 0x73, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x72, 0x53, 0x74, 0x61, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x26, 0x2e, 0x67, 0x6f, 0x5f, 0x62, 0x6c, 0x75, 0x64, 0x67, 0x65, 0x6f, 0x6e, 0x5f, 0x74, 0x69,
+	0x6d, 0x65, 0x72, 0x73, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x72, 0x53, 0x74, 0x61, 0x72, 0x74, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5b, 0x0a, 0x0a, 0x74, 0x69, 0x6d,
+	0x65, 0x72, 0x5f, 0x73, 0x74, 0x6f, 0x70, 0x12, 0x24, 0x2e, 0x67, 0x6f, 0x5f, 0x62, 0x6c, 0x75,
+	0x64, 0x67, 0x65, 0x6f, 0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x72, 0x73, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x72, 0x53, 0x74, 0x6f, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e,
+	0x67, 0x6f, 0x5f, 0x62, 0x6c, 0x75, 0x64, 0x67, 0x65, 0x6f, 0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65,
+	0x72, 0x73, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x72, 0x53, 0x74, 0x6f, 0x70, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x61, 0x0a, 0x0c, 0x74, 0x69, 0x6d, 0x65, 0x72, 0x5f,
+	0x73, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x12, 0x26, 0x2e, 0x67, 0x6f, 0x5f, 0x62, 0x6c, 0x75, 0x64,
+	0x67, 0x65, 0x6f, 0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x72, 0x73, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x72, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27,
+	0x2e, 0x67, 0x6f, 0x5f, 0x62, 0x6c, 0x75, 0x64, 0x67, 0x65, 0x6f, 0x6e, 0x5f, 0x74, 0x69, 0x6d,
+	0x65, 0x72, 0x73, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x72, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x64, 0x0a, 0x0d, 0x74, 0x69, 0x6d,
+	0x65, 0x72, 0x5f, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x27, 0x2e, 0x67, 0x6f, 0x5f,
 	0x62, 0x6c, 0x75, 0x64, 0x67, 0x65, 0x6f, 0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x72, 0x73, 0x2e,
-	0x54, 0x69, 0x6d, 0x65, 0x72, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x27, 0x2e, 0x67, 0x6f, 0x5f, 0x62, 0x6c, 0x75, 0x64, 0x67, 0x65, 0x6f, 0x6e,
-	0x5f, 0x74, 0x69, 0x6d, 0x65, 0x72, 0x73, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x72, 0x53, 0x75, 0x62,
-	0x6d, 0x69, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x42, 0x39, 0x5a,
-	0x37, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x6e, 0x74, 0x6f,
-	0x6e, 0x69, 0x6f, 0x2d, 0x61, 0x6c, 0x65, 0x78, 0x61, 0x6e, 0x64, 0x65, 0x72, 0x2f, 0x67, 0x6f,
-	0x2d, 0x62, 0x6c, 0x75, 0x64, 0x67, 0x65, 0x6f, 0x6e, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x72, 0x73,
-	0x2f, 0x64, 0x61, 0x74, 0x61, 0x2f, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x54, 0x69, 0x6d, 0x65, 0x72, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x67, 0x6f, 0x5f, 0x62, 0x6c, 0x75, 0x64, 0x67, 0x65, 0x6f,
+	0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x72, 0x73, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x72, 0x48, 0x69,
+	0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x64, 0x0a, 0x0d, 0x74, 0x69, 0x6d, 0x65, 0x72, 0x5f, 0x72, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65,
+	0x12, 0x27, 0x2e, 0x67, 0x6f, 0x5f, 0x62, 0x6c, 0x75, 0x64, 0x67, 0x65, 0x6f, 0x6e, 0x5f, 0x74,
+	0x69, 0x6d, 0x65, 0x72, 0x73, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x72, 0x52, 0x65, 0x73, 0x74, 0x6f,
+	0x72, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x67, 0x6f, 0x5f, 0x62,
+	0x6c, 0x75, 0x64, 0x67, 0x65, 0x6f, 0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x72, 0x73, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x72, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x42, 0x39, 0x5a, 0x37, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
+	0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x6e, 0x74, 0x6f, 0x6e, 0x69, 0x6f, 0x2d, 0x61, 0x6c, 0x65, 0x78,
+	0x61, 0x6e, 0x64, 0x65, 0x72, 0x2f, 0x67, 0x6f, 0x2d, 0x62, 0x6c, 0x75, 0x64, 0x67, 0x65, 0x6f,
+	0x6e, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x72, 0x73, 0x2f, 0x64, 0x61, 0x74, 0x61, 0x2f, 0x70, 0x62,
+	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1754,7 +2426,7 @@ func file_timers_proto_rawDescGZIP() []byte {
 	return file_timers_proto_rawDescData
 }
 
-var file_timers_proto_msgTypes = make([]protoimpl.MessageInfo, 23)
+var file_timers_proto_msgTypes = make([]protoimpl.MessageInfo, 27)
 var file_timers_proto_goTypes = []interface{}{
 	(*TimerCreateRequest)(nil),         // 0: go_bludgeon_timers.TimerCreateRequest
 	(*TimerCreateResponse)(nil),        // 1: go_bludgeon_timers.TimerCreateResponse
@@ -1764,56 +2436,66 @@ var file_timers_proto_goTypes = []interface{}{
 	(*TimerUpdateResponse)(nil),        // 5: go_bludgeon_timers.TimerUpdateResponse
 	(*TimerDeleteRequest)(nil),         // 6: go_bludgeon_timers.TimerDeleteRequest
 	(*TimerDeleteResponse)(nil),        // 7: go_bludgeon_timers.TimerDeleteResponse
-	(*TimersReadRequest)(nil),          // 8: go_bludgeon_timers.TimersReadRequest
-	(*TimersReadResponse)(nil),         // 9: go_bludgeon_timers.TimersReadResponse
-	(*TimerStartRequest)(nil),          // 10: go_bludgeon_timers.TimerStartRequest
-	(*TimerStartResponse)(nil),         // 11: go_bludgeon_timers.TimerStartResponse
-	(*TimerStopRequest)(nil),           // 12: go_bludgeon_timers.TimerStopRequest
-	(*TimerStopResponse)(nil),          // 13: go_bludgeon_timers.TimerStopResponse
-	(*TimerSubmitRequest)(nil),         // 14: go_bludgeon_timers.TimerSubmitRequest
-	(*TimerSubmitResponse)(nil),        // 15: go_bludgeon_timers.TimerSubmitResponse
-	(*TimerUpdateCommentRequest)(nil),  // 16: go_bludgeon_timers.TimerUpdateCommentRequest
-	(*TimerUpdateCommentResponse)(nil), // 17: go_bludgeon_timers.TimerUpdateCommentResponse
-	(*TimerArchiveRequest)(nil),        // 18: go_bludgeon_timers.TimerArchiveRequest
-	(*TimerArchiveResponse)(nil),       // 19: go_bludgeon_timers.TimerArchiveResponse
-	(*TimerSearch)(nil),                // 20: go_bludgeon_timers.TimerSearch
-	(*TimerPartial)(nil),               // 21: go_bludgeon_timers.TimerPartial
-	(*Timer)(nil),                      // 22: go_bludgeon_timers.Timer
+	(*TimerRestoreRequest)(nil),        // 8: go_bludgeon_timers.TimerRestoreRequest
+	(*TimerRestoreResponse)(nil),       // 9: go_bludgeon_timers.TimerRestoreResponse
+	(*TimersReadRequest)(nil),          // 10: go_bludgeon_timers.TimersReadRequest
+	(*TimersReadResponse)(nil),         // 11: go_bludgeon_timers.TimersReadResponse
+	(*TimerHistoryRequest)(nil),        // 12: go_bludgeon_timers.TimerHistoryRequest
+	(*TimerHistoryResponse)(nil),       // 13: go_bludgeon_timers.TimerHistoryResponse
+	(*TimerStartRequest)(nil),          // 14: go_bludgeon_timers.TimerStartRequest
+	(*TimerStartResponse)(nil),         // 15: go_bludgeon_timers.TimerStartResponse
+	(*TimerStopRequest)(nil),           // 16: go_bludgeon_timers.TimerStopRequest
+	(*TimerStopResponse)(nil),          // 17: go_bludgeon_timers.TimerStopResponse
+	(*TimerSubmitRequest)(nil),         // 18: go_bludgeon_timers.TimerSubmitRequest
+	(*TimerSubmitResponse)(nil),        // 19: go_bludgeon_timers.TimerSubmitResponse
+	(*TimerUpdateCommentRequest)(nil),  // 20: go_bludgeon_timers.TimerUpdateCommentRequest
+	(*TimerUpdateCommentResponse)(nil), // 21: go_bludgeon_timers.TimerUpdateCommentResponse
+	(*TimerArchiveRequest)(nil),        // 22: go_bludgeon_timers.TimerArchiveRequest
+	(*TimerArchiveResponse)(nil),       // 23: go_bludgeon_timers.TimerArchiveResponse
+	(*TimerSearch)(nil),                // 24: go_bludgeon_timers.TimerSearch
+	(*TimerPartial)(nil),               // 25: go_bludgeon_timers.TimerPartial
+	(*Timer)(nil),                      // 26: go_bludgeon_timers.Timer
 }
 var file_timers_proto_depIdxs = []int32{
-	21, // 0: go_bludgeon_timers.TimerCreateRequest.timer_partial:type_name -> go_bludgeon_timers.TimerPartial
-	22, // 1: go_bludgeon_timers.TimerCreateResponse.timer:type_name -> go_bludgeon_timers.Timer
-	22, // 2: go_bludgeon_timers.TimerReadResponse.timer:type_name -> go_bludgeon_timers.Timer
-	21, // 3: go_bludgeon_timers.TimerUpdateRequest.timer_partial:type_name -> go_bludgeon_timers.TimerPartial
-	22, // 4: go_bludgeon_timers.TimerUpdateResponse.timer:type_name -> go_bludgeon_timers.Timer
-	20, // 5: go_bludgeon_timers.TimersReadRequest.timer_search:type_name -> go_bludgeon_timers.TimerSearch
-	22, // 6: go_bludgeon_timers.TimersReadResponse.timers:type_name -> go_bludgeon_timers.Timer
-	22, // 7: go_bludgeon_timers.TimerStartResponse.timer:type_name -> go_bludgeon_timers.Timer
-	22, // 8: go_bludgeon_timers.TimerStopResponse.timer:type_name -> go_bludgeon_timers.Timer
-	22, // 9: go_bludgeon_timers.TimerSubmitResponse.timer:type_name -> go_bludgeon_timers.Timer
-	22, // 10: go_bludgeon_timers.TimerUpdateCommentResponse.timer:type_name -> go_bludgeon_timers.Timer
-	22, // 11: go_bludgeon_timers.TimerArchiveResponse.timer:type_name -> go_bludgeon_timers.Timer
-	0,  // 12: go_bludgeon_timers.Timers.timer_create:input_type -> go_bludgeon_timers.TimerCreateRequest
-	2,  // 13: go_bludgeon_timers.Timers.timer_read:input_type -> go_bludgeon_timers.TimerReadRequest
-	6,  // 14: go_bludgeon_timers.Timers.timer_delete:input_type -> go_bludgeon_timers.TimerDeleteRequest
-	8,  // 15: go_bludgeon_timers.Timers.timers_read:input_type -> go_bludgeon_timers.TimersReadRequest
-	4,  // 16: go_bludgeon_timers.Timers.timer_update:input_type -> go_bludgeon_timers.TimerUpdateRequest
-	10, // 17: go_bludgeon_timers.Timers.timer_start:input_type -> go_bludgeon_timers.TimerStartRequest
-	12, // 18: go_bludgeon_timers.Timers.timer_stop:input_type -> go_bludgeon_timers.TimerStopRequest
-	14, // 19: go_bludgeon_timers.Timers.timer_submit:input_type -> go_bludgeon_timers.TimerSubmitRequest
-	1,  // 20: go_bludgeon_timers.Timers.timer_create:output_type -> go_bludgeon_timers.TimerCreateResponse
-	3,  // 21: go_bludgeon_timers.Timers.timer_read:output_type -> go_bludgeon_timers.TimerReadResponse
-	7,  // 22: go_bludgeon_timers.Timers.timer_delete:output_type -> go_bludgeon_timers.TimerDeleteResponse
-	9,  // 23: go_bludgeon_timers.Timers.timers_read:output_type -> go_bludgeon_timers.TimersReadResponse
-	5,  // 24: go_bludgeon_timers.Timers.timer_update:output_type -> go_bludgeon_timers.TimerUpdateResponse
-	11, // 25: go_bludgeon_timers.Timers.timer_start:output_type -> go_bludgeon_timers.TimerStartResponse
-	13, // 26: go_bludgeon_timers.Timers.timer_stop:output_type -> go_bludgeon_timers.TimerStopResponse
-	15, // 27: go_bludgeon_timers.Timers.timer_submit:output_type -> go_bludgeon_timers.TimerSubmitResponse
-	20, // [20:28] is the sub-list for method output_type
-	12, // [12:20] is the sub-list for method input_type
-	12, // [12:12] is the sub-list for extension type_name
-	12, // [12:12] is the sub-list for extension extendee
-	0,  // [0:12] is the sub-list for field type_name
+	25, // 0: go_bludgeon_timers.TimerCreateRequest.timer_partial:type_name -> go_bludgeon_timers.TimerPartial
+	26, // 1: go_bludgeon_timers.TimerCreateResponse.timer:type_name -> go_bludgeon_timers.Timer
+	26, // 2: go_bludgeon_timers.TimerReadResponse.timer:type_name -> go_bludgeon_timers.Timer
+	25, // 3: go_bludgeon_timers.TimerUpdateRequest.timer_partial:type_name -> go_bludgeon_timers.TimerPartial
+	26, // 4: go_bludgeon_timers.TimerUpdateResponse.timer:type_name -> go_bludgeon_timers.Timer
+	26, // 5: go_bludgeon_timers.TimerRestoreResponse.timer:type_name -> go_bludgeon_timers.Timer
+	24, // 6: go_bludgeon_timers.TimersReadRequest.timer_search:type_name -> go_bludgeon_timers.TimerSearch
+	26, // 7: go_bludgeon_timers.TimersReadResponse.timers:type_name -> go_bludgeon_timers.Timer
+	26, // 8: go_bludgeon_timers.TimerHistoryResponse.timers:type_name -> go_bludgeon_timers.Timer
+	26, // 9: go_bludgeon_timers.TimerStartResponse.timer:type_name -> go_bludgeon_timers.Timer
+	26, // 10: go_bludgeon_timers.TimerStopResponse.timer:type_name -> go_bludgeon_timers.Timer
+	26, // 11: go_bludgeon_timers.TimerSubmitResponse.timer:type_name -> go_bludgeon_timers.Timer
+	26, // 12: go_bludgeon_timers.TimerUpdateCommentResponse.timer:type_name -> go_bludgeon_timers.Timer
+	26, // 13: go_bludgeon_timers.TimerArchiveResponse.timer:type_name -> go_bludgeon_timers.Timer
+	0,  // 14: go_bludgeon_timers.Timers.timer_create:input_type -> go_bludgeon_timers.TimerCreateRequest
+	2,  // 15: go_bludgeon_timers.Timers.timer_read:input_type -> go_bludgeon_timers.TimerReadRequest
+	6,  // 16: go_bludgeon_timers.Timers.timer_delete:input_type -> go_bludgeon_timers.TimerDeleteRequest
+	10, // 17: go_bludgeon_timers.Timers.timers_read:input_type -> go_bludgeon_timers.TimersReadRequest
+	4,  // 18: go_bludgeon_timers.Timers.timer_update:input_type -> go_bludgeon_timers.TimerUpdateRequest
+	14, // 19: go_bludgeon_timers.Timers.timer_start:input_type -> go_bludgeon_timers.TimerStartRequest
+	16, // 20: go_bludgeon_timers.Timers.timer_stop:input_type -> go_bludgeon_timers.TimerStopRequest
+	18, // 21: go_bludgeon_timers.Timers.timer_submit:input_type -> go_bludgeon_timers.TimerSubmitRequest
+	12, // 22: go_bludgeon_timers.Timers.timer_history:input_type -> go_bludgeon_timers.TimerHistoryRequest
+	8,  // 23: go_bludgeon_timers.Timers.timer_restore:input_type -> go_bludgeon_timers.TimerRestoreRequest
+	1,  // 24: go_bludgeon_timers.Timers.timer_create:output_type -> go_bludgeon_timers.TimerCreateResponse
+	3,  // 25: go_bludgeon_timers.Timers.timer_read:output_type -> go_bludgeon_timers.TimerReadResponse
+	7,  // 26: go_bludgeon_timers.Timers.timer_delete:output_type -> go_bludgeon_timers.TimerDeleteResponse
+	11, // 27: go_bludgeon_timers.Timers.timers_read:output_type -> go_bludgeon_timers.TimersReadResponse
+	5,  // 28: go_bludgeon_timers.Timers.timer_update:output_type -> go_bludgeon_timers.TimerUpdateResponse
+	15, // 29: go_bludgeon_timers.Timers.timer_start:output_type -> go_bludgeon_timers.TimerStartResponse
+	17, // 30: go_bludgeon_timers.Timers.timer_stop:output_type -> go_bludgeon_timers.TimerStopResponse
+	19, // 31: go_bludgeon_timers.Timers.timer_submit:output_type -> go_bludgeon_timers.TimerSubmitResponse
+	13, // 32: go_bludgeon_timers.Timers.timer_history:output_type -> go_bludgeon_timers.TimerHistoryResponse
+	9,  // 33: go_bludgeon_timers.Timers.timer_restore:output_type -> go_bludgeon_timers.TimerRestoreResponse
+	24, // [24:34] is the sub-list for method output_type
+	14, // [14:24] is the sub-list for method input_type
+	14, // [14:14] is the sub-list for extension type_name
+	14, // [14:14] is the sub-list for extension extendee
+	0,  // [0:14] is the sub-list for field type_name
 }
 
 func init() { file_timers_proto_init() }
@@ -1919,7 +2601,7 @@ func file_timers_proto_init() {
 			}
 		}
 		file_timers_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TimersReadRequest); i {
+			switch v := v.(*TimerRestoreRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1931,7 +2613,7 @@ func file_timers_proto_init() {
 			}
 		}
 		file_timers_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TimersReadResponse); i {
+			switch v := v.(*TimerRestoreResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1943,7 +2625,7 @@ func file_timers_proto_init() {
 			}
 		}
 		file_timers_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TimerStartRequest); i {
+			switch v := v.(*TimersReadRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1955,7 +2637,7 @@ func file_timers_proto_init() {
 			}
 		}
 		file_timers_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TimerStartResponse); i {
+			switch v := v.(*TimersReadResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1967,7 +2649,7 @@ func file_timers_proto_init() {
 			}
 		}
 		file_timers_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TimerStopRequest); i {
+			switch v := v.(*TimerHistoryRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1979,7 +2661,7 @@ func file_timers_proto_init() {
 			}
 		}
 		file_timers_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TimerStopResponse); i {
+			switch v := v.(*TimerHistoryResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1991,7 +2673,7 @@ func file_timers_proto_init() {
 			}
 		}
 		file_timers_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TimerSubmitRequest); i {
+			switch v := v.(*TimerStartRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2003,7 +2685,7 @@ func file_timers_proto_init() {
 			}
 		}
 		file_timers_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TimerSubmitResponse); i {
+			switch v := v.(*TimerStartResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2015,7 +2697,7 @@ func file_timers_proto_init() {
 			}
 		}
 		file_timers_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TimerUpdateCommentRequest); i {
+			switch v := v.(*TimerStopRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2027,7 +2709,7 @@ func file_timers_proto_init() {
 			}
 		}
 		file_timers_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TimerUpdateCommentResponse); i {
+			switch v := v.(*TimerStopResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2039,7 +2721,7 @@ func file_timers_proto_init() {
 			}
 		}
 		file_timers_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TimerArchiveRequest); i {
+			switch v := v.(*TimerSubmitRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2051,7 +2733,7 @@ func file_timers_proto_init() {
 			}
 		}
 		file_timers_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TimerArchiveResponse); i {
+			switch v := v.(*TimerSubmitResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2063,7 +2745,7 @@ func file_timers_proto_init() {
 			}
 		}
 		file_timers_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TimerSearch); i {
+			switch v := v.(*TimerUpdateCommentRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2075,7 +2757,7 @@ func file_timers_proto_init() {
 			}
 		}
 		file_timers_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TimerPartial); i {
+			switch v := v.(*TimerUpdateCommentResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2087,6 +2769,54 @@ func file_timers_proto_init() {
 			}
 		}
 		file_timers_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TimerArchiveRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_timers_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TimerArchiveResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_timers_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TimerSearch); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_timers_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TimerPartial); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_timers_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Timer); i {
 			case 0:
 				return &v.state
@@ -2099,20 +2829,38 @@ func file_timers_proto_init() {
 			}
 		}
 	}
+	file_timers_proto_msgTypes[4].OneofWrappers = []interface{}{
+		(*TimerUpdateRequest_Version)(nil),
+	}
 	file_timers_proto_msgTypes[14].OneofWrappers = []interface{}{
+		(*TimerStartRequest_Start)(nil),
+	}
+	file_timers_proto_msgTypes[16].OneofWrappers = []interface{}{
+		(*TimerStopRequest_Finish)(nil),
+	}
+	file_timers_proto_msgTypes[18].OneofWrappers = []interface{}{
 		(*TimerSubmitRequest_Finish)(nil),
 	}
-	file_timers_proto_msgTypes[20].OneofWrappers = []interface{}{
+	file_timers_proto_msgTypes[24].OneofWrappers = []interface{}{
 		(*TimerSearch_EmployeeId)(nil),
 		(*TimerSearch_Completed)(nil),
 		(*TimerSearch_Archived)(nil),
-	}
-	file_timers_proto_msgTypes[21].OneofWrappers = []interface{}{
+		(*TimerSearch_ProjectId)(nil),
+		(*TimerSearch_Invoiced)(nil),
+		(*TimerSearch_Start)(nil),
+		(*TimerSearch_Finish)(nil),
+		(*TimerSearch_Limit)(nil),
+		(*TimerSearch_Cursor)(nil),
+		(*TimerSearch_Sort)(nil),
+		(*TimerSearch_Deleted)(nil),
+	}
+	file_timers_proto_msgTypes[25].OneofWrappers = []interface{}{
 		(*TimerPartial_Completed)(nil),
 		(*TimerPartial_Archived)(nil),
 		(*TimerPartial_EmployeeId)(nil),
 		(*TimerPartial_Comment)(nil),
 		(*TimerPartial_Finish)(nil),
+		(*TimerPartial_ProjectId)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -2120,7 +2868,7 @@ func file_timers_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_timers_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   23,
+			NumMessages:   27,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
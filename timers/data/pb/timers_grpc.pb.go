@@ -38,6 +38,10 @@ type TimersClient interface {
 	TimerStop(ctx context.Context, in *TimerStopRequest, opts ...grpc.CallOption) (*TimerStopResponse, error)
 	// timer_submit
 	TimerSubmit(ctx context.Context, in *TimerSubmitRequest, opts ...grpc.CallOption) (*TimerSubmitResponse, error)
+	// timer_history
+	TimerHistory(ctx context.Context, in *TimerHistoryRequest, opts ...grpc.CallOption) (*TimerHistoryResponse, error)
+	// timer_restore
+	TimerRestore(ctx context.Context, in *TimerRestoreRequest, opts ...grpc.CallOption) (*TimerRestoreResponse, error)
 }
 
 type timersClient struct {
@@ -120,6 +124,24 @@ func (c *timersClient) TimerSubmit(ctx context.Context, in *TimerSubmitRequest,
 	return out, nil
 }
 
+func (c *timersClient) TimerHistory(ctx context.Context, in *TimerHistoryRequest, opts ...grpc.CallOption) (*TimerHistoryResponse, error) {
+	out := new(TimerHistoryResponse)
+	err := c.cc.Invoke(ctx, "/go_bludgeon_timers.Timers/timer_history", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *timersClient) TimerRestore(ctx context.Context, in *TimerRestoreRequest, opts ...grpc.CallOption) (*TimerRestoreResponse, error) {
+	out := new(TimerRestoreResponse)
+	err := c.cc.Invoke(ctx, "/go_bludgeon_timers.Timers/timer_restore", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // TimersServer is the server API for Timers service.
 // All implementations must embed UnimplementedTimersServer
 // for forward compatibility
@@ -140,6 +162,10 @@ type TimersServer interface {
 	TimerStop(context.Context, *TimerStopRequest) (*TimerStopResponse, error)
 	// timer_submit
 	TimerSubmit(context.Context, *TimerSubmitRequest) (*TimerSubmitResponse, error)
+	// timer_history
+	TimerHistory(context.Context, *TimerHistoryRequest) (*TimerHistoryResponse, error)
+	// timer_restore
+	TimerRestore(context.Context, *TimerRestoreRequest) (*TimerRestoreResponse, error)
 	mustEmbedUnimplementedTimersServer()
 }
 
@@ -171,6 +197,12 @@ func (UnimplementedTimersServer) TimerStop(context.Context, *TimerStopRequest) (
 func (UnimplementedTimersServer) TimerSubmit(context.Context, *TimerSubmitRequest) (*TimerSubmitResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method TimerSubmit not implemented")
 }
+func (UnimplementedTimersServer) TimerHistory(context.Context, *TimerHistoryRequest) (*TimerHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TimerHistory not implemented")
+}
+func (UnimplementedTimersServer) TimerRestore(context.Context, *TimerRestoreRequest) (*TimerRestoreResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TimerRestore not implemented")
+}
 func (UnimplementedTimersServer) mustEmbedUnimplementedTimersServer() {}
 
 // UnsafeTimersServer may be embedded to opt out of forward compatibility for this service.
@@ -328,6 +360,42 @@ func _Timers_TimerSubmit_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Timers_TimerHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TimerHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TimersServer).TimerHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/go_bludgeon_timers.Timers/timer_history",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TimersServer).TimerHistory(ctx, req.(*TimerHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Timers_TimerRestore_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TimerRestoreRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TimersServer).TimerRestore(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/go_bludgeon_timers.Timers/timer_restore",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TimersServer).TimerRestore(ctx, req.(*TimerRestoreRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Timers_ServiceDesc is the grpc.ServiceDesc for Timers service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -367,6 +435,14 @@ var Timers_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "timer_submit",
 			Handler:    _Timers_TimerSubmit_Handler,
 		},
+		{
+			MethodName: "timer_history",
+			Handler:    _Timers_TimerHistory_Handler,
+		},
+		{
+			MethodName: "timer_restore",
+			Handler:    _Timers_TimerRestore_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "timers.proto",
@@ -0,0 +1,701 @@
+//
+//go_bludgeon_timers defines a set of types for use with the timers service
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.28.0
+// 	protoc        v3.20.1
+// source: timesheets.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// TimesheetsReadRequest
+type TimesheetsReadRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// timesheet_search
+	TimesheetSearch *TimesheetSearch `protobuf:"bytes,1,opt,name=timesheet_search,json=timesheetSearch,proto3" json:"timesheet_search,omitempty"`
+}
+
+func (x *TimesheetsReadRequest) Reset() {
+	*x = TimesheetsReadRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_timesheets_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TimesheetsReadRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TimesheetsReadRequest) ProtoMessage() {}
+
+func (x *TimesheetsReadRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_timesheets_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TimesheetsReadRequest.ProtoReflect.Descriptor instead.
+func (*TimesheetsReadRequest) Descriptor() ([]byte, []int) {
+	return file_timesheets_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *TimesheetsReadRequest) GetTimesheetSearch() *TimesheetSearch {
+	if x != nil {
+		return x.TimesheetSearch
+	}
+	return nil
+}
+
+// TimesheetsReadResponse
+type TimesheetsReadResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// timesheets
+	Timesheets []*Timesheet `protobuf:"bytes,1,rep,name=timesheets,proto3" json:"timesheets,omitempty"`
+}
+
+func (x *TimesheetsReadResponse) Reset() {
+	*x = TimesheetsReadResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_timesheets_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TimesheetsReadResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TimesheetsReadResponse) ProtoMessage() {}
+
+func (x *TimesheetsReadResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_timesheets_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TimesheetsReadResponse.ProtoReflect.Descriptor instead.
+func (*TimesheetsReadResponse) Descriptor() ([]byte, []int) {
+	return file_timesheets_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *TimesheetsReadResponse) GetTimesheets() []*Timesheet {
+	if x != nil {
+		return x.Timesheets
+	}
+	return nil
+}
+
+// TimesheetSearch
+type TimesheetSearch struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// employee_ids
+	EmployeeIds []string `protobuf:"bytes,1,rep,name=employee_ids,json=employeeIds,proto3" json:"employee_ids,omitempty"`
+	// start_oneof
+	//
+	// Types that are assignable to StartOneof:
+	//
+	//	*TimesheetSearch_Start
+	StartOneof isTimesheetSearch_StartOneof `protobuf_oneof:"start_oneof"`
+	// finish_oneof
+	//
+	// Types that are assignable to FinishOneof:
+	//
+	//	*TimesheetSearch_Finish
+	FinishOneof isTimesheetSearch_FinishOneof `protobuf_oneof:"finish_oneof"`
+	// timezone_oneof
+	//
+	// Types that are assignable to TimezoneOneof:
+	//
+	//	*TimesheetSearch_Timezone
+	TimezoneOneof isTimesheetSearch_TimezoneOneof `protobuf_oneof:"timezone_oneof"`
+}
+
+func (x *TimesheetSearch) Reset() {
+	*x = TimesheetSearch{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_timesheets_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TimesheetSearch) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TimesheetSearch) ProtoMessage() {}
+
+func (x *TimesheetSearch) ProtoReflect() protoreflect.Message {
+	mi := &file_timesheets_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TimesheetSearch.ProtoReflect.Descriptor instead.
+func (*TimesheetSearch) Descriptor() ([]byte, []int) {
+	return file_timesheets_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *TimesheetSearch) GetEmployeeIds() []string {
+	if x != nil {
+		return x.EmployeeIds
+	}
+	return nil
+}
+
+func (m *TimesheetSearch) GetStartOneof() isTimesheetSearch_StartOneof {
+	if m != nil {
+		return m.StartOneof
+	}
+	return nil
+}
+
+func (x *TimesheetSearch) GetStart() int64 {
+	if x, ok := x.GetStartOneof().(*TimesheetSearch_Start); ok {
+		return x.Start
+	}
+	return 0
+}
+
+func (m *TimesheetSearch) GetFinishOneof() isTimesheetSearch_FinishOneof {
+	if m != nil {
+		return m.FinishOneof
+	}
+	return nil
+}
+
+func (x *TimesheetSearch) GetFinish() int64 {
+	if x, ok := x.GetFinishOneof().(*TimesheetSearch_Finish); ok {
+		return x.Finish
+	}
+	return 0
+}
+
+func (m *TimesheetSearch) GetTimezoneOneof() isTimesheetSearch_TimezoneOneof {
+	if m != nil {
+		return m.TimezoneOneof
+	}
+	return nil
+}
+
+func (x *TimesheetSearch) GetTimezone() string {
+	if x, ok := x.GetTimezoneOneof().(*TimesheetSearch_Timezone); ok {
+		return x.Timezone
+	}
+	return ""
+}
+
+type isTimesheetSearch_StartOneof interface {
+	isTimesheetSearch_StartOneof()
+}
+
+type TimesheetSearch_Start struct {
+	// start
+	Start int64 `protobuf:"varint,2,opt,name=start,proto3,oneof"`
+}
+
+func (*TimesheetSearch_Start) isTimesheetSearch_StartOneof() {}
+
+type isTimesheetSearch_FinishOneof interface {
+	isTimesheetSearch_FinishOneof()
+}
+
+type TimesheetSearch_Finish struct {
+	// finish
+	Finish int64 `protobuf:"varint,3,opt,name=finish,proto3,oneof"`
+}
+
+func (*TimesheetSearch_Finish) isTimesheetSearch_FinishOneof() {}
+
+type isTimesheetSearch_TimezoneOneof interface {
+	isTimesheetSearch_TimezoneOneof()
+}
+
+type TimesheetSearch_Timezone struct {
+	// timezone
+	Timezone string `protobuf:"bytes,4,opt,name=timezone,proto3,oneof"`
+}
+
+func (*TimesheetSearch_Timezone) isTimesheetSearch_TimezoneOneof() {}
+
+// TimesheetDay
+type TimesheetDay struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// date
+	Date string `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"`
+	// elapsed_time
+	ElapsedTime int64 `protobuf:"varint,2,opt,name=elapsed_time,json=elapsedTime,proto3" json:"elapsed_time,omitempty"`
+}
+
+func (x *TimesheetDay) Reset() {
+	*x = TimesheetDay{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_timesheets_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TimesheetDay) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TimesheetDay) ProtoMessage() {}
+
+func (x *TimesheetDay) ProtoReflect() protoreflect.Message {
+	mi := &file_timesheets_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TimesheetDay.ProtoReflect.Descriptor instead.
+func (*TimesheetDay) Descriptor() ([]byte, []int) {
+	return file_timesheets_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *TimesheetDay) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *TimesheetDay) GetElapsedTime() int64 {
+	if x != nil {
+		return x.ElapsedTime
+	}
+	return 0
+}
+
+// TimesheetWeek
+type TimesheetWeek struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// year
+	Year int32 `protobuf:"varint,1,opt,name=year,proto3" json:"year,omitempty"`
+	// week
+	Week int32 `protobuf:"varint,2,opt,name=week,proto3" json:"week,omitempty"`
+	// elapsed_time
+	ElapsedTime int64 `protobuf:"varint,3,opt,name=elapsed_time,json=elapsedTime,proto3" json:"elapsed_time,omitempty"`
+}
+
+func (x *TimesheetWeek) Reset() {
+	*x = TimesheetWeek{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_timesheets_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TimesheetWeek) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TimesheetWeek) ProtoMessage() {}
+
+func (x *TimesheetWeek) ProtoReflect() protoreflect.Message {
+	mi := &file_timesheets_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TimesheetWeek.ProtoReflect.Descriptor instead.
+func (*TimesheetWeek) Descriptor() ([]byte, []int) {
+	return file_timesheets_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *TimesheetWeek) GetYear() int32 {
+	if x != nil {
+		return x.Year
+	}
+	return 0
+}
+
+func (x *TimesheetWeek) GetWeek() int32 {
+	if x != nil {
+		return x.Week
+	}
+	return 0
+}
+
+func (x *TimesheetWeek) GetElapsedTime() int64 {
+	if x != nil {
+		return x.ElapsedTime
+	}
+	return 0
+}
+
+// Timesheet
+type Timesheet struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// employee_id
+	EmployeeId string `protobuf:"bytes,1,opt,name=employee_id,json=employeeId,proto3" json:"employee_id,omitempty"`
+	// timezone
+	Timezone string `protobuf:"bytes,2,opt,name=timezone,proto3" json:"timezone,omitempty"`
+	// start
+	Start int64 `protobuf:"varint,3,opt,name=start,proto3" json:"start,omitempty"`
+	// finish
+	Finish int64 `protobuf:"varint,4,opt,name=finish,proto3" json:"finish,omitempty"`
+	// elapsed_time
+	ElapsedTime int64 `protobuf:"varint,5,opt,name=elapsed_time,json=elapsedTime,proto3" json:"elapsed_time,omitempty"`
+	// days
+	Days []*TimesheetDay `protobuf:"bytes,6,rep,name=days,proto3" json:"days,omitempty"`
+	// weeks
+	Weeks []*TimesheetWeek `protobuf:"bytes,7,rep,name=weeks,proto3" json:"weeks,omitempty"`
+}
+
+func (x *Timesheet) Reset() {
+	*x = Timesheet{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_timesheets_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Timesheet) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Timesheet) ProtoMessage() {}
+
+func (x *Timesheet) ProtoReflect() protoreflect.Message {
+	mi := &file_timesheets_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Timesheet.ProtoReflect.Descriptor instead.
+func (*Timesheet) Descriptor() ([]byte, []int) {
+	return file_timesheets_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *Timesheet) GetEmployeeId() string {
+	if x != nil {
+		return x.EmployeeId
+	}
+	return ""
+}
+
+func (x *Timesheet) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+func (x *Timesheet) GetStart() int64 {
+	if x != nil {
+		return x.Start
+	}
+	return 0
+}
+
+func (x *Timesheet) GetFinish() int64 {
+	if x != nil {
+		return x.Finish
+	}
+	return 0
+}
+
+func (x *Timesheet) GetElapsedTime() int64 {
+	if x != nil {
+		return x.ElapsedTime
+	}
+	return 0
+}
+
+func (x *Timesheet) GetDays() []*TimesheetDay {
+	if x != nil {
+		return x.Days
+	}
+	return nil
+}
+
+func (x *Timesheet) GetWeeks() []*TimesheetWeek {
+	if x != nil {
+		return x.Weeks
+	}
+	return nil
+}
+
+var File_timesheets_proto protoreflect.FileDescriptor
+
+var file_timesheets_proto_rawDesc = []byte{
+	0x0a, 0x10, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x68, 0x65, 0x65, 0x74, 0x73, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x12, 0x12, 0x67, 0x6f, 0x5f, 0x62, 0x6c, 0x75, 0x64, 0x67, 0x65, 0x6f, 0x6e, 0x5f,
+	0x74, 0x69, 0x6d, 0x65, 0x72, 0x73, 0x22, 0x67, 0x0a, 0x15, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x68,
+	0x65, 0x65, 0x74, 0x73, 0x52, 0x65, 0x61, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x4e, 0x0a, 0x10, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x68, 0x65, 0x65, 0x74, 0x5f, 0x73, 0x65, 0x61,
+	0x72, 0x63, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x67, 0x6f, 0x5f, 0x62,
+	0x6c, 0x75, 0x64, 0x67, 0x65, 0x6f, 0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x72, 0x73, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x68, 0x65, 0x65, 0x74, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x0f,
+	0x74, 0x69, 0x6d, 0x65, 0x73, 0x68, 0x65, 0x65, 0x74, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x22,
+	0x57, 0x0a, 0x16, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x68, 0x65, 0x65, 0x74, 0x73, 0x52, 0x65, 0x61,
+	0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3d, 0x0a, 0x0a, 0x74, 0x69, 0x6d,
+	0x65, 0x73, 0x68, 0x65, 0x65, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e,
+	0x67, 0x6f, 0x5f, 0x62, 0x6c, 0x75, 0x64, 0x67, 0x65, 0x6f, 0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65,
+	0x72, 0x73, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x68, 0x65, 0x65, 0x74, 0x52, 0x0a, 0x74, 0x69,
+	0x6d, 0x65, 0x73, 0x68, 0x65, 0x65, 0x74, 0x73, 0x22, 0xb5, 0x01, 0x0a, 0x0f, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x68, 0x65, 0x65, 0x74, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x12, 0x21, 0x0a, 0x0c,
+	0x65, 0x6d, 0x70, 0x6c, 0x6f, 0x79, 0x65, 0x65, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x0b, 0x65, 0x6d, 0x70, 0x6c, 0x6f, 0x79, 0x65, 0x65, 0x49, 0x64, 0x73, 0x12,
+	0x16, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x48, 0x00,
+	0x52, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x12, 0x18, 0x0a, 0x06, 0x66, 0x69, 0x6e, 0x69, 0x73,
+	0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x48, 0x01, 0x52, 0x06, 0x66, 0x69, 0x6e, 0x69, 0x73,
+	0x68, 0x12, 0x1c, 0x0a, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x7a, 0x6f, 0x6e, 0x65, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x48, 0x02, 0x52, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x7a, 0x6f, 0x6e, 0x65, 0x42,
+	0x0d, 0x0a, 0x0b, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x6f, 0x6e, 0x65, 0x6f, 0x66, 0x42, 0x0e,
+	0x0a, 0x0c, 0x66, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x5f, 0x6f, 0x6e, 0x65, 0x6f, 0x66, 0x42, 0x10,
+	0x0a, 0x0e, 0x74, 0x69, 0x6d, 0x65, 0x7a, 0x6f, 0x6e, 0x65, 0x5f, 0x6f, 0x6e, 0x65, 0x6f, 0x66,
+	0x22, 0x45, 0x0a, 0x0c, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x68, 0x65, 0x65, 0x74, 0x44, 0x61, 0x79,
+	0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x64, 0x61, 0x74, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x65, 0x6c, 0x61, 0x70, 0x73, 0x65, 0x64, 0x5f,
+	0x74, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x65, 0x6c, 0x61, 0x70,
+	0x73, 0x65, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x22, 0x5a, 0x0a, 0x0d, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x68, 0x65, 0x65, 0x74, 0x57, 0x65, 0x65, 0x6b, 0x12, 0x12, 0x0a, 0x04, 0x79, 0x65, 0x61, 0x72,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x79, 0x65, 0x61, 0x72, 0x12, 0x12, 0x0a, 0x04,
+	0x77, 0x65, 0x65, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x77, 0x65, 0x65, 0x6b,
+	0x12, 0x21, 0x0a, 0x0c, 0x65, 0x6c, 0x61, 0x70, 0x73, 0x65, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x65, 0x6c, 0x61, 0x70, 0x73, 0x65, 0x64, 0x54,
+	0x69, 0x6d, 0x65, 0x22, 0x88, 0x02, 0x0a, 0x09, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x68, 0x65, 0x65,
+	0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x65, 0x6d, 0x70, 0x6c, 0x6f, 0x79, 0x65, 0x65, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x65, 0x6d, 0x70, 0x6c, 0x6f, 0x79, 0x65, 0x65,
+	0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x7a, 0x6f, 0x6e, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x7a, 0x6f, 0x6e, 0x65, 0x12, 0x14,
+	0x0a, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x73,
+	0x74, 0x61, 0x72, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x66, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x12, 0x21, 0x0a, 0x0c,
+	0x65, 0x6c, 0x61, 0x70, 0x73, 0x65, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0b, 0x65, 0x6c, 0x61, 0x70, 0x73, 0x65, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x12,
+	0x34, 0x0a, 0x04, 0x64, 0x61, 0x79, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e,
+	0x67, 0x6f, 0x5f, 0x62, 0x6c, 0x75, 0x64, 0x67, 0x65, 0x6f, 0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65,
+	0x72, 0x73, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x68, 0x65, 0x65, 0x74, 0x44, 0x61, 0x79, 0x52,
+	0x04, 0x64, 0x61, 0x79, 0x73, 0x12, 0x37, 0x0a, 0x05, 0x77, 0x65, 0x65, 0x6b, 0x73, 0x18, 0x07,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x67, 0x6f, 0x5f, 0x62, 0x6c, 0x75, 0x64, 0x67, 0x65,
+	0x6f, 0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x72, 0x73, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x68,
+	0x65, 0x65, 0x74, 0x57, 0x65, 0x65, 0x6b, 0x52, 0x05, 0x77, 0x65, 0x65, 0x6b, 0x73, 0x32, 0x78,
+	0x0a, 0x0a, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x68, 0x65, 0x65, 0x74, 0x73, 0x12, 0x6a, 0x0a, 0x0f,
+	0x74, 0x69, 0x6d, 0x65, 0x73, 0x68, 0x65, 0x65, 0x74, 0x73, 0x5f, 0x72, 0x65, 0x61, 0x64, 0x12,
+	0x29, 0x2e, 0x67, 0x6f, 0x5f, 0x62, 0x6c, 0x75, 0x64, 0x67, 0x65, 0x6f, 0x6e, 0x5f, 0x74, 0x69,
+	0x6d, 0x65, 0x72, 0x73, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x68, 0x65, 0x65, 0x74, 0x73, 0x52,
+	0x65, 0x61, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x67, 0x6f, 0x5f,
+	0x62, 0x6c, 0x75, 0x64, 0x67, 0x65, 0x6f, 0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x72, 0x73, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x68, 0x65, 0x65, 0x74, 0x73, 0x52, 0x65, 0x61, 0x64, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x42, 0x39, 0x5a, 0x37, 0x67, 0x69, 0x74, 0x68,
+	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x6e, 0x74, 0x6f, 0x6e, 0x69, 0x6f, 0x2d, 0x61,
+	0x6c, 0x65, 0x78, 0x61, 0x6e, 0x64, 0x65, 0x72, 0x2f, 0x67, 0x6f, 0x2d, 0x62, 0x6c, 0x75, 0x64,
+	0x67, 0x65, 0x6f, 0x6e, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x72, 0x73, 0x2f, 0x64, 0x61, 0x74, 0x61,
+	0x2f, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_timesheets_proto_rawDescOnce sync.Once
+	file_timesheets_proto_rawDescData = file_timesheets_proto_rawDesc
+)
+
+func file_timesheets_proto_rawDescGZIP() []byte {
+	file_timesheets_proto_rawDescOnce.Do(func() {
+		file_timesheets_proto_rawDescData = protoimpl.X.CompressGZIP(file_timesheets_proto_rawDescData)
+	})
+	return file_timesheets_proto_rawDescData
+}
+
+var file_timesheets_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_timesheets_proto_goTypes = []interface{}{
+	(*TimesheetsReadRequest)(nil),  // 0: go_bludgeon_timers.TimesheetsReadRequest
+	(*TimesheetsReadResponse)(nil), // 1: go_bludgeon_timers.TimesheetsReadResponse
+	(*TimesheetSearch)(nil),        // 2: go_bludgeon_timers.TimesheetSearch
+	(*TimesheetDay)(nil),           // 3: go_bludgeon_timers.TimesheetDay
+	(*TimesheetWeek)(nil),          // 4: go_bludgeon_timers.TimesheetWeek
+	(*Timesheet)(nil),              // 5: go_bludgeon_timers.Timesheet
+}
+var file_timesheets_proto_depIdxs = []int32{
+	2, // 0: go_bludgeon_timers.TimesheetsReadRequest.timesheet_search:type_name -> go_bludgeon_timers.TimesheetSearch
+	5, // 1: go_bludgeon_timers.TimesheetsReadResponse.timesheets:type_name -> go_bludgeon_timers.Timesheet
+	3, // 2: go_bludgeon_timers.Timesheet.days:type_name -> go_bludgeon_timers.TimesheetDay
+	4, // 3: go_bludgeon_timers.Timesheet.weeks:type_name -> go_bludgeon_timers.TimesheetWeek
+	0, // 4: go_bludgeon_timers.Timesheets.timesheets_read:input_type -> go_bludgeon_timers.TimesheetsReadRequest
+	1, // 5: go_bludgeon_timers.Timesheets.timesheets_read:output_type -> go_bludgeon_timers.TimesheetsReadResponse
+	5, // [5:6] is the sub-list for method output_type
+	4, // [4:5] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
+}
+
+func init() { file_timesheets_proto_init() }
+func file_timesheets_proto_init() {
+	if File_timesheets_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_timesheets_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TimesheetsReadRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_timesheets_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TimesheetsReadResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_timesheets_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TimesheetSearch); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_timesheets_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TimesheetDay); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_timesheets_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TimesheetWeek); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_timesheets_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Timesheet); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_timesheets_proto_msgTypes[2].OneofWrappers = []interface{}{
+		(*TimesheetSearch_Start)(nil),
+		(*TimesheetSearch_Finish)(nil),
+		(*TimesheetSearch_Timezone)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_timesheets_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   6,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_timesheets_proto_goTypes,
+		DependencyIndexes: file_timesheets_proto_depIdxs,
+		MessageInfos:      file_timesheets_proto_msgTypes,
+	}.Build()
+	File_timesheets_proto = out.File
+	file_timesheets_proto_rawDesc = nil
+	file_timesheets_proto_goTypes = nil
+	file_timesheets_proto_depIdxs = nil
+}
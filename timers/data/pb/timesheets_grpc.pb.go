@@ -0,0 +1,107 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.2.0
+// - protoc             v3.20.1
+// source: timesheets.proto
+
+package pb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// TimesheetsClient is the client API for Timesheets service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type TimesheetsClient interface {
+	// timesheets_read
+	TimesheetsRead(ctx context.Context, in *TimesheetsReadRequest, opts ...grpc.CallOption) (*TimesheetsReadResponse, error)
+}
+
+type timesheetsClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewTimesheetsClient(cc grpc.ClientConnInterface) TimesheetsClient {
+	return &timesheetsClient{cc}
+}
+
+func (c *timesheetsClient) TimesheetsRead(ctx context.Context, in *TimesheetsReadRequest, opts ...grpc.CallOption) (*TimesheetsReadResponse, error) {
+	out := new(TimesheetsReadResponse)
+	err := c.cc.Invoke(ctx, "/go_bludgeon_timers.Timesheets/timesheets_read", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// TimesheetsServer is the server API for Timesheets service.
+// All implementations must embed UnimplementedTimesheetsServer
+// for forward compatibility
+type TimesheetsServer interface {
+	// timesheets_read
+	TimesheetsRead(context.Context, *TimesheetsReadRequest) (*TimesheetsReadResponse, error)
+	mustEmbedUnimplementedTimesheetsServer()
+}
+
+// UnimplementedTimesheetsServer must be embedded to have forward compatible implementations.
+type UnimplementedTimesheetsServer struct {
+}
+
+func (UnimplementedTimesheetsServer) TimesheetsRead(context.Context, *TimesheetsReadRequest) (*TimesheetsReadResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TimesheetsRead not implemented")
+}
+func (UnimplementedTimesheetsServer) mustEmbedUnimplementedTimesheetsServer() {}
+
+// UnsafeTimesheetsServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to TimesheetsServer will
+// result in compilation errors.
+type UnsafeTimesheetsServer interface {
+	mustEmbedUnimplementedTimesheetsServer()
+}
+
+func RegisterTimesheetsServer(s grpc.ServiceRegistrar, srv TimesheetsServer) {
+	s.RegisterService(&Timesheets_ServiceDesc, srv)
+}
+
+func _Timesheets_TimesheetsRead_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TimesheetsReadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TimesheetsServer).TimesheetsRead(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/go_bludgeon_timers.Timesheets/timesheets_read",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TimesheetsServer).TimesheetsRead(ctx, req.(*TimesheetsReadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Timesheets_ServiceDesc is the grpc.ServiceDesc for Timesheets service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Timesheets_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "go_bludgeon_timers.Timesheets",
+	HandlerType: (*TimesheetsServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "timesheets_read",
+			Handler:    _Timesheets_TimesheetsRead_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "timesheets.proto",
+}
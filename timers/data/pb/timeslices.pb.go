@@ -229,6 +229,12 @@ type TimeSliceUpdateRequest struct {
 	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	// time_slice_partial
 	TimeSlicePartial *TimeSlicePartial `protobuf:"bytes,2,opt,name=time_slice_partial,json=timeSlicePartial,proto3" json:"time_slice_partial,omitempty"`
+	// version_oneof
+	//
+	// Types that are assignable to VersionOneof:
+	//
+	//	*TimeSliceUpdateRequest_Version
+	VersionOneof isTimeSliceUpdateRequest_VersionOneof `protobuf_oneof:"version_oneof"`
 }
 
 func (x *TimeSliceUpdateRequest) Reset() {
@@ -277,6 +283,31 @@ func (x *TimeSliceUpdateRequest) GetTimeSlicePartial() *TimeSlicePartial {
 	return nil
 }
 
+func (m *TimeSliceUpdateRequest) GetVersionOneof() isTimeSliceUpdateRequest_VersionOneof {
+	if m != nil {
+		return m.VersionOneof
+	}
+	return nil
+}
+
+func (x *TimeSliceUpdateRequest) GetVersion() int32 {
+	if x, ok := x.GetVersionOneof().(*TimeSliceUpdateRequest_Version); ok {
+		return x.Version
+	}
+	return 0
+}
+
+type isTimeSliceUpdateRequest_VersionOneof interface {
+	isTimeSliceUpdateRequest_VersionOneof()
+}
+
+type TimeSliceUpdateRequest_Version struct {
+	// version
+	Version int32 `protobuf:"varint,3,opt,name=version,proto3,oneof"`
+}
+
+func (*TimeSliceUpdateRequest_Version) isTimeSliceUpdateRequest_VersionOneof() {}
+
 // TimeSliceUpdateResponse
 type TimeSliceUpdateResponse struct {
 	state         protoimpl.MessageState
@@ -512,6 +543,309 @@ func (x *TimeSlicesReadResponse) GetTimeSlices() []*TimeSlice {
 	return nil
 }
 
+// TimeSliceSplitRequest
+type TimeSliceSplitRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// id
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// split_time
+	SplitTime int64 `protobuf:"varint,2,opt,name=split_time,json=splitTime,proto3" json:"split_time,omitempty"`
+}
+
+func (x *TimeSliceSplitRequest) Reset() {
+	*x = TimeSliceSplitRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_timeslices_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TimeSliceSplitRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TimeSliceSplitRequest) ProtoMessage() {}
+
+func (x *TimeSliceSplitRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_timeslices_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TimeSliceSplitRequest.ProtoReflect.Descriptor instead.
+func (*TimeSliceSplitRequest) Descriptor() ([]byte, []int) {
+	return file_timeslices_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *TimeSliceSplitRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *TimeSliceSplitRequest) GetSplitTime() int64 {
+	if x != nil {
+		return x.SplitTime
+	}
+	return 0
+}
+
+// TimeSliceSplitResponse
+type TimeSliceSplitResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// time_slices
+	TimeSlices []*TimeSlice `protobuf:"bytes,1,rep,name=time_slices,json=timeSlices,proto3" json:"time_slices,omitempty"`
+}
+
+func (x *TimeSliceSplitResponse) Reset() {
+	*x = TimeSliceSplitResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_timeslices_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TimeSliceSplitResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TimeSliceSplitResponse) ProtoMessage() {}
+
+func (x *TimeSliceSplitResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_timeslices_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TimeSliceSplitResponse.ProtoReflect.Descriptor instead.
+func (*TimeSliceSplitResponse) Descriptor() ([]byte, []int) {
+	return file_timeslices_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *TimeSliceSplitResponse) GetTimeSlices() []*TimeSlice {
+	if x != nil {
+		return x.TimeSlices
+	}
+	return nil
+}
+
+// TimeSlicesMergeRequest
+type TimeSlicesMergeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// ids
+	Ids []string `protobuf:"bytes,1,rep,name=ids,proto3" json:"ids,omitempty"`
+}
+
+func (x *TimeSlicesMergeRequest) Reset() {
+	*x = TimeSlicesMergeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_timeslices_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TimeSlicesMergeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TimeSlicesMergeRequest) ProtoMessage() {}
+
+func (x *TimeSlicesMergeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_timeslices_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TimeSlicesMergeRequest.ProtoReflect.Descriptor instead.
+func (*TimeSlicesMergeRequest) Descriptor() ([]byte, []int) {
+	return file_timeslices_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *TimeSlicesMergeRequest) GetIds() []string {
+	if x != nil {
+		return x.Ids
+	}
+	return nil
+}
+
+// TimeSlicesMergeResponse
+type TimeSlicesMergeResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// time_slice
+	TimeSlice *TimeSlice `protobuf:"bytes,1,opt,name=time_slice,json=timeSlice,proto3" json:"time_slice,omitempty"`
+}
+
+func (x *TimeSlicesMergeResponse) Reset() {
+	*x = TimeSlicesMergeResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_timeslices_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TimeSlicesMergeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TimeSlicesMergeResponse) ProtoMessage() {}
+
+func (x *TimeSlicesMergeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_timeslices_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TimeSlicesMergeResponse.ProtoReflect.Descriptor instead.
+func (*TimeSlicesMergeResponse) Descriptor() ([]byte, []int) {
+	return file_timeslices_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *TimeSlicesMergeResponse) GetTimeSlice() *TimeSlice {
+	if x != nil {
+		return x.TimeSlice
+	}
+	return nil
+}
+
+// TimeSliceHistoryRequest
+type TimeSliceHistoryRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// id
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *TimeSliceHistoryRequest) Reset() {
+	*x = TimeSliceHistoryRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_timeslices_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TimeSliceHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TimeSliceHistoryRequest) ProtoMessage() {}
+
+func (x *TimeSliceHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_timeslices_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TimeSliceHistoryRequest.ProtoReflect.Descriptor instead.
+func (*TimeSliceHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_timeslices_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *TimeSliceHistoryRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// TimeSliceHistoryResponse
+type TimeSliceHistoryResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// time_slices
+	TimeSlices []*TimeSlice `protobuf:"bytes,1,rep,name=time_slices,json=timeSlices,proto3" json:"time_slices,omitempty"`
+}
+
+func (x *TimeSliceHistoryResponse) Reset() {
+	*x = TimeSliceHistoryResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_timeslices_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TimeSliceHistoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TimeSliceHistoryResponse) ProtoMessage() {}
+
+func (x *TimeSliceHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_timeslices_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TimeSliceHistoryResponse.ProtoReflect.Descriptor instead.
+func (*TimeSliceHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_timeslices_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *TimeSliceHistoryResponse) GetTimeSlices() []*TimeSlice {
+	if x != nil {
+		return x.TimeSlices
+	}
+	return nil
+}
+
 // TimeSlicePartial
 type TimeSlicePartial struct {
 	state         protoimpl.MessageState
@@ -547,7 +881,7 @@ type TimeSlicePartial struct {
 func (x *TimeSlicePartial) Reset() {
 	*x = TimeSlicePartial{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_timeslices_proto_msgTypes[10]
+		mi := &file_timeslices_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -560,7 +894,7 @@ func (x *TimeSlicePartial) String() string {
 func (*TimeSlicePartial) ProtoMessage() {}
 
 func (x *TimeSlicePartial) ProtoReflect() protoreflect.Message {
-	mi := &file_timeslices_proto_msgTypes[10]
+	mi := &file_timeslices_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -573,7 +907,7 @@ func (x *TimeSlicePartial) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TimeSlicePartial.ProtoReflect.Descriptor instead.
 func (*TimeSlicePartial) Descriptor() ([]byte, []int) {
-	return file_timeslices_proto_rawDescGZIP(), []int{10}
+	return file_timeslices_proto_rawDescGZIP(), []int{16}
 }
 
 func (m *TimeSlicePartial) GetTimerIdOneof() isTimeSlicePartial_TimerIdOneof {
@@ -705,7 +1039,7 @@ type TimeSlice struct {
 func (x *TimeSlice) Reset() {
 	*x = TimeSlice{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_timeslices_proto_msgTypes[11]
+		mi := &file_timeslices_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -718,7 +1052,7 @@ func (x *TimeSlice) String() string {
 func (*TimeSlice) ProtoMessage() {}
 
 func (x *TimeSlice) ProtoReflect() protoreflect.Message {
-	mi := &file_timeslices_proto_msgTypes[11]
+	mi := &file_timeslices_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -731,7 +1065,7 @@ func (x *TimeSlice) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TimeSlice.ProtoReflect.Descriptor instead.
 func (*TimeSlice) Descriptor() ([]byte, []int) {
-	return file_timeslices_proto_rawDescGZIP(), []int{11}
+	return file_timeslices_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *TimeSlice) GetCompleted() bool {
@@ -819,12 +1153,42 @@ type TimeSliceSearch struct {
 	TimerIds []string `protobuf:"bytes,3,rep,name=timer_ids,json=timerIds,proto3" json:"timer_ids,omitempty"`
 	// ids
 	Ids []string `protobuf:"bytes,4,rep,name=ids,proto3" json:"ids,omitempty"`
+	// start_oneof
+	//
+	// Types that are assignable to StartOneof:
+	//
+	//	*TimeSliceSearch_Start
+	StartOneof isTimeSliceSearch_StartOneof `protobuf_oneof:"start_oneof"`
+	// finish_oneof
+	//
+	// Types that are assignable to FinishOneof:
+	//
+	//	*TimeSliceSearch_Finish
+	FinishOneof isTimeSliceSearch_FinishOneof `protobuf_oneof:"finish_oneof"`
+	// limit_oneof
+	//
+	// Types that are assignable to LimitOneof:
+	//
+	//	*TimeSliceSearch_Limit
+	LimitOneof isTimeSliceSearch_LimitOneof `protobuf_oneof:"limit_oneof"`
+	// cursor_oneof
+	//
+	// Types that are assignable to CursorOneof:
+	//
+	//	*TimeSliceSearch_Cursor
+	CursorOneof isTimeSliceSearch_CursorOneof `protobuf_oneof:"cursor_oneof"`
+	// sort_oneof
+	//
+	// Types that are assignable to SortOneof:
+	//
+	//	*TimeSliceSearch_Sort
+	SortOneof isTimeSliceSearch_SortOneof `protobuf_oneof:"sort_oneof"`
 }
 
 func (x *TimeSliceSearch) Reset() {
 	*x = TimeSliceSearch{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_timeslices_proto_msgTypes[12]
+		mi := &file_timeslices_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -837,7 +1201,7 @@ func (x *TimeSliceSearch) String() string {
 func (*TimeSliceSearch) ProtoMessage() {}
 
 func (x *TimeSliceSearch) ProtoReflect() protoreflect.Message {
-	mi := &file_timeslices_proto_msgTypes[12]
+	mi := &file_timeslices_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -850,7 +1214,7 @@ func (x *TimeSliceSearch) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TimeSliceSearch.ProtoReflect.Descriptor instead.
 func (*TimeSliceSearch) Descriptor() ([]byte, []int) {
-	return file_timeslices_proto_rawDescGZIP(), []int{12}
+	return file_timeslices_proto_rawDescGZIP(), []int{18}
 }
 
 func (m *TimeSliceSearch) GetCompletedOneof() isTimeSliceSearch_CompletedOneof {
@@ -895,6 +1259,76 @@ func (x *TimeSliceSearch) GetIds() []string {
 	return nil
 }
 
+func (m *TimeSliceSearch) GetStartOneof() isTimeSliceSearch_StartOneof {
+	if m != nil {
+		return m.StartOneof
+	}
+	return nil
+}
+
+func (x *TimeSliceSearch) GetStart() int64 {
+	if x, ok := x.GetStartOneof().(*TimeSliceSearch_Start); ok {
+		return x.Start
+	}
+	return 0
+}
+
+func (m *TimeSliceSearch) GetFinishOneof() isTimeSliceSearch_FinishOneof {
+	if m != nil {
+		return m.FinishOneof
+	}
+	return nil
+}
+
+func (x *TimeSliceSearch) GetFinish() int64 {
+	if x, ok := x.GetFinishOneof().(*TimeSliceSearch_Finish); ok {
+		return x.Finish
+	}
+	return 0
+}
+
+func (m *TimeSliceSearch) GetLimitOneof() isTimeSliceSearch_LimitOneof {
+	if m != nil {
+		return m.LimitOneof
+	}
+	return nil
+}
+
+func (x *TimeSliceSearch) GetLimit() int32 {
+	if x, ok := x.GetLimitOneof().(*TimeSliceSearch_Limit); ok {
+		return x.Limit
+	}
+	return 0
+}
+
+func (m *TimeSliceSearch) GetCursorOneof() isTimeSliceSearch_CursorOneof {
+	if m != nil {
+		return m.CursorOneof
+	}
+	return nil
+}
+
+func (x *TimeSliceSearch) GetCursor() string {
+	if x, ok := x.GetCursorOneof().(*TimeSliceSearch_Cursor); ok {
+		return x.Cursor
+	}
+	return ""
+}
+
+func (m *TimeSliceSearch) GetSortOneof() isTimeSliceSearch_SortOneof {
+	if m != nil {
+		return m.SortOneof
+	}
+	return nil
+}
+
+func (x *TimeSliceSearch) GetSort() string {
+	if x, ok := x.GetSortOneof().(*TimeSliceSearch_Sort); ok {
+		return x.Sort
+	}
+	return ""
+}
+
 type isTimeSliceSearch_CompletedOneof interface {
 	isTimeSliceSearch_CompletedOneof()
 }
@@ -917,6 +1351,61 @@ type TimeSliceSearch_TimerId struct {
 
 func (*TimeSliceSearch_TimerId) isTimeSliceSearch_TimerIdOneof() {}
 
+type isTimeSliceSearch_StartOneof interface {
+	isTimeSliceSearch_StartOneof()
+}
+
+type TimeSliceSearch_Start struct {
+	// start
+	Start int64 `protobuf:"varint,5,opt,name=start,proto3,oneof"`
+}
+
+func (*TimeSliceSearch_Start) isTimeSliceSearch_StartOneof() {}
+
+type isTimeSliceSearch_FinishOneof interface {
+	isTimeSliceSearch_FinishOneof()
+}
+
+type TimeSliceSearch_Finish struct {
+	// finish
+	Finish int64 `protobuf:"varint,6,opt,name=finish,proto3,oneof"`
+}
+
+func (*TimeSliceSearch_Finish) isTimeSliceSearch_FinishOneof() {}
+
+type isTimeSliceSearch_LimitOneof interface {
+	isTimeSliceSearch_LimitOneof()
+}
+
+type TimeSliceSearch_Limit struct {
+	// limit
+	Limit int32 `protobuf:"varint,7,opt,name=limit,proto3,oneof"`
+}
+
+func (*TimeSliceSearch_Limit) isTimeSliceSearch_LimitOneof() {}
+
+type isTimeSliceSearch_CursorOneof interface {
+	isTimeSliceSearch_CursorOneof()
+}
+
+type TimeSliceSearch_Cursor struct {
+	// cursor
+	Cursor string `protobuf:"bytes,8,opt,name=cursor,proto3,oneof"`
+}
+
+func (*TimeSliceSearch_Cursor) isTimeSliceSearch_CursorOneof() {}
+
+type isTimeSliceSearch_SortOneof interface {
+	isTimeSliceSearch_SortOneof()
+}
+
+type TimeSliceSearch_Sort struct {
+	// sort
+	Sort string `protobuf:"bytes,9,opt,name=sort,proto3,oneof"`
+}
+
+func (*TimeSliceSearch_Sort) isTimeSliceSearch_SortOneof() {}
+
 var File_timeslices_proto protoreflect.FileDescriptor
 
 var file_timeslices_proto_rawDesc = []byte{
@@ -942,116 +1431,180 @@ var file_timeslices_proto_rawDesc = []byte{
 	0x0a, 0x0a, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x73, 0x6c, 0x69, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01,
 	0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x67, 0x6f, 0x5f, 0x62, 0x6c, 0x75, 0x64, 0x67, 0x65, 0x6f, 0x6e,
 	0x5f, 0x74, 0x69, 0x6d, 0x65, 0x72, 0x73, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x53, 0x6c, 0x69, 0x63,
-	0x65, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x53, 0x6c, 0x69, 0x63, 0x65, 0x22, 0x7c, 0x0a, 0x16,
-	0x54, 0x69, 0x6d, 0x65, 0x53, 0x6c, 0x69, 0x63, 0x65, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x52, 0x0a, 0x12, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x73,
-	0x6c, 0x69, 0x63, 0x65, 0x5f, 0x70, 0x61, 0x72, 0x74, 0x69, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x24, 0x2e, 0x67, 0x6f, 0x5f, 0x62, 0x6c, 0x75, 0x64, 0x67, 0x65, 0x6f, 0x6e,
-	0x5f, 0x74, 0x69, 0x6d, 0x65, 0x72, 0x73, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x53, 0x6c, 0x69, 0x63,
-	0x65, 0x50, 0x61, 0x72, 0x74, 0x69, 0x61, 0x6c, 0x52, 0x10, 0x74, 0x69, 0x6d, 0x65, 0x53, 0x6c,
-	0x69, 0x63, 0x65, 0x50, 0x61, 0x72, 0x74, 0x69, 0x61, 0x6c, 0x22, 0x57, 0x0a, 0x17, 0x54, 0x69,
-	0x6d, 0x65, 0x53, 0x6c, 0x69, 0x63, 0x65, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3c, 0x0a, 0x0a, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x73, 0x6c,
-	0x69, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x67, 0x6f, 0x5f, 0x62,
-	0x6c, 0x75, 0x64, 0x67, 0x65, 0x6f, 0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x72, 0x73, 0x2e, 0x54,
-	0x69, 0x6d, 0x65, 0x53, 0x6c, 0x69, 0x63, 0x65, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x53, 0x6c,
-	0x69, 0x63, 0x65, 0x22, 0x28, 0x0a, 0x16, 0x54, 0x69, 0x6d, 0x65, 0x53, 0x6c, 0x69, 0x63, 0x65,
-	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a,
-	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x19, 0x0a,
-	0x17, 0x54, 0x69, 0x6d, 0x65, 0x53, 0x6c, 0x69, 0x63, 0x65, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x68, 0x0a, 0x15, 0x54, 0x69, 0x6d, 0x65,
-	0x53, 0x6c, 0x69, 0x63, 0x65, 0x73, 0x52, 0x65, 0x61, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x4f, 0x0a, 0x11, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x73, 0x6c, 0x69, 0x63, 0x65, 0x5f,
-	0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x67,
-	0x6f, 0x5f, 0x62, 0x6c, 0x75, 0x64, 0x67, 0x65, 0x6f, 0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x72,
-	0x73, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x53, 0x6c, 0x69, 0x63, 0x65, 0x53, 0x65, 0x61, 0x72, 0x63,
-	0x68, 0x52, 0x0f, 0x74, 0x69, 0x6d, 0x65, 0x53, 0x6c, 0x69, 0x63, 0x65, 0x53, 0x65, 0x61, 0x72,
-	0x63, 0x68, 0x22, 0x58, 0x0a, 0x16, 0x54, 0x69, 0x6d, 0x65, 0x53, 0x6c, 0x69, 0x63, 0x65, 0x73,
-	0x52, 0x65, 0x61, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3e, 0x0a, 0x0b,
+	0x65, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x53, 0x6c, 0x69, 0x63, 0x65, 0x22, 0xa9, 0x01, 0x0a,
+	0x16, 0x54, 0x69, 0x6d, 0x65, 0x53, 0x6c, 0x69, 0x63, 0x65, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x52, 0x0a, 0x12, 0x74, 0x69, 0x6d, 0x65, 0x5f,
+	0x73, 0x6c, 0x69, 0x63, 0x65, 0x5f, 0x70, 0x61, 0x72, 0x74, 0x69, 0x61, 0x6c, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x67, 0x6f, 0x5f, 0x62, 0x6c, 0x75, 0x64, 0x67, 0x65, 0x6f,
+	0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x72, 0x73, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x53, 0x6c, 0x69,
+	0x63, 0x65, 0x50, 0x61, 0x72, 0x74, 0x69, 0x61, 0x6c, 0x52, 0x10, 0x74, 0x69, 0x6d, 0x65, 0x53,
+	0x6c, 0x69, 0x63, 0x65, 0x50, 0x61, 0x72, 0x74, 0x69, 0x61, 0x6c, 0x12, 0x1a, 0x0a, 0x07, 0x76,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x48, 0x00, 0x52, 0x07,
+	0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x42, 0x0f, 0x0a, 0x0d, 0x76, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x5f, 0x6f, 0x6e, 0x65, 0x6f, 0x66, 0x22, 0x57, 0x0a, 0x17, 0x54, 0x69, 0x6d, 0x65,
+	0x53, 0x6c, 0x69, 0x63, 0x65, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x3c, 0x0a, 0x0a, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x73, 0x6c, 0x69, 0x63,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x67, 0x6f, 0x5f, 0x62, 0x6c, 0x75,
+	0x64, 0x67, 0x65, 0x6f, 0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x72, 0x73, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x53, 0x6c, 0x69, 0x63, 0x65, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x53, 0x6c, 0x69, 0x63,
+	0x65, 0x22, 0x28, 0x0a, 0x16, 0x54, 0x69, 0x6d, 0x65, 0x53, 0x6c, 0x69, 0x63, 0x65, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x19, 0x0a, 0x17, 0x54,
+	0x69, 0x6d, 0x65, 0x53, 0x6c, 0x69, 0x63, 0x65, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x68, 0x0a, 0x15, 0x54, 0x69, 0x6d, 0x65, 0x53, 0x6c,
+	0x69, 0x63, 0x65, 0x73, 0x52, 0x65, 0x61, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x4f, 0x0a, 0x11, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x73, 0x6c, 0x69, 0x63, 0x65, 0x5f, 0x73, 0x65,
+	0x61, 0x72, 0x63, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x67, 0x6f, 0x5f,
+	0x62, 0x6c, 0x75, 0x64, 0x67, 0x65, 0x6f, 0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x72, 0x73, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x53, 0x6c, 0x69, 0x63, 0x65, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52,
+	0x0f, 0x74, 0x69, 0x6d, 0x65, 0x53, 0x6c, 0x69, 0x63, 0x65, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68,
+	0x22, 0x58, 0x0a, 0x16, 0x54, 0x69, 0x6d, 0x65, 0x53, 0x6c, 0x69, 0x63, 0x65, 0x73, 0x52, 0x65,
+	0x61, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3e, 0x0a, 0x0b, 0x74, 0x69,
+	0x6d, 0x65, 0x5f, 0x73, 0x6c, 0x69, 0x63, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x1d, 0x2e, 0x67, 0x6f, 0x5f, 0x62, 0x6c, 0x75, 0x64, 0x67, 0x65, 0x6f, 0x6e, 0x5f, 0x74, 0x69,
+	0x6d, 0x65, 0x72, 0x73, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x53, 0x6c, 0x69, 0x63, 0x65, 0x52, 0x0a,
+	0x74, 0x69, 0x6d, 0x65, 0x53, 0x6c, 0x69, 0x63, 0x65, 0x73, 0x22, 0x46, 0x0a, 0x15, 0x54, 0x69,
+	0x6d, 0x65, 0x53, 0x6c, 0x69, 0x63, 0x65, 0x53, 0x70, 0x6c, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x02, 0x69, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x70, 0x6c, 0x69, 0x74, 0x5f, 0x74, 0x69, 0x6d,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x73, 0x70, 0x6c, 0x69, 0x74, 0x54, 0x69,
+	0x6d, 0x65, 0x22, 0x58, 0x0a, 0x16, 0x54, 0x69, 0x6d, 0x65, 0x53, 0x6c, 0x69, 0x63, 0x65, 0x53,
+	0x70, 0x6c, 0x69, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3e, 0x0a, 0x0b,
 	0x74, 0x69, 0x6d, 0x65, 0x5f, 0x73, 0x6c, 0x69, 0x63, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
 	0x0b, 0x32, 0x1d, 0x2e, 0x67, 0x6f, 0x5f, 0x62, 0x6c, 0x75, 0x64, 0x67, 0x65, 0x6f, 0x6e, 0x5f,
 	0x74, 0x69, 0x6d, 0x65, 0x72, 0x73, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x53, 0x6c, 0x69, 0x63, 0x65,
-	0x52, 0x0a, 0x74, 0x69, 0x6d, 0x65, 0x53, 0x6c, 0x69, 0x63, 0x65, 0x73, 0x22, 0xc5, 0x01, 0x0a,
-	0x10, 0x54, 0x69, 0x6d, 0x65, 0x53, 0x6c, 0x69, 0x63, 0x65, 0x50, 0x61, 0x72, 0x74, 0x69, 0x61,
-	0x6c, 0x12, 0x1b, 0x0a, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1e,
-	0x0a, 0x09, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x08, 0x48, 0x01, 0x52, 0x09, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x12, 0x16,
-	0x0a, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x48, 0x02, 0x52,
-	0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x12, 0x18, 0x0a, 0x06, 0x66, 0x69, 0x6e, 0x69, 0x73, 0x68,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x48, 0x03, 0x52, 0x06, 0x66, 0x69, 0x6e, 0x69, 0x73, 0x68,
-	0x42, 0x10, 0x0a, 0x0e, 0x74, 0x69, 0x6d, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x5f, 0x6f, 0x6e, 0x65,
-	0x6f, 0x66, 0x42, 0x11, 0x0a, 0x0f, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x5f,
-	0x6f, 0x6e, 0x65, 0x6f, 0x66, 0x42, 0x0d, 0x0a, 0x0b, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x6f,
-	0x6e, 0x65, 0x6f, 0x66, 0x42, 0x0e, 0x0a, 0x0c, 0x66, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x5f, 0x6f,
-	0x6e, 0x65, 0x6f, 0x66, 0x22, 0x8a, 0x02, 0x0a, 0x09, 0x54, 0x69, 0x6d, 0x65, 0x53, 0x6c, 0x69,
-	0x63, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64,
-	0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52,
-	0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x69, 0x6e, 0x69, 0x73, 0x68,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x66, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x12, 0x21,
-	0x0a, 0x0c, 0x65, 0x6c, 0x61, 0x70, 0x73, 0x65, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x65, 0x6c, 0x61, 0x70, 0x73, 0x65, 0x64, 0x54, 0x69, 0x6d,
-	0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69,
-	0x64, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x06, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x72, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c,
-	0x6c, 0x61, 0x73, 0x74, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x18, 0x07, 0x20, 0x01,
-	0x28, 0x03, 0x52, 0x0b, 0x6c, 0x61, 0x73, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x12,
-	0x26, 0x0a, 0x0f, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f,
-	0x62, 0x79, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6c, 0x61, 0x73, 0x74, 0x55, 0x70,
-	0x64, 0x61, 0x74, 0x65, 0x64, 0x42, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69,
-	0x6f, 0x6e, 0x18, 0x09, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f,
-	0x6e, 0x22, 0xa2, 0x01, 0x0a, 0x0f, 0x54, 0x69, 0x6d, 0x65, 0x53, 0x6c, 0x69, 0x63, 0x65, 0x53,
-	0x65, 0x61, 0x72, 0x63, 0x68, 0x12, 0x1e, 0x0a, 0x09, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74,
-	0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x48, 0x00, 0x52, 0x09, 0x63, 0x6f, 0x6d, 0x70,
-	0x6c, 0x65, 0x74, 0x65, 0x64, 0x12, 0x1b, 0x0a, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x72, 0x5f, 0x69,
-	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x72,
-	0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x73, 0x18,
-	0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x72, 0x49, 0x64, 0x73, 0x12,
-	0x10, 0x0a, 0x03, 0x69, 0x64, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x03, 0x69, 0x64,
-	0x73, 0x42, 0x11, 0x0a, 0x0f, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x5f, 0x6f,
-	0x6e, 0x65, 0x6f, 0x66, 0x42, 0x10, 0x0a, 0x0e, 0x74, 0x69, 0x6d, 0x65, 0x72, 0x5f, 0x69, 0x64,
-	0x5f, 0x6f, 0x6e, 0x65, 0x6f, 0x66, 0x32, 0xb3, 0x04, 0x0a, 0x0a, 0x54, 0x69, 0x6d, 0x65, 0x53,
-	0x6c, 0x69, 0x63, 0x65, 0x73, 0x12, 0x6e, 0x0a, 0x11, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x73, 0x6c,
-	0x69, 0x63, 0x65, 0x5f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x12, 0x2a, 0x2e, 0x67, 0x6f, 0x5f,
+	0x52, 0x0a, 0x74, 0x69, 0x6d, 0x65, 0x53, 0x6c, 0x69, 0x63, 0x65, 0x73, 0x22, 0x2a, 0x0a, 0x16,
+	0x54, 0x69, 0x6d, 0x65, 0x53, 0x6c, 0x69, 0x63, 0x65, 0x73, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x03, 0x69, 0x64, 0x73, 0x22, 0x57, 0x0a, 0x17, 0x54, 0x69, 0x6d, 0x65,
+	0x53, 0x6c, 0x69, 0x63, 0x65, 0x73, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x3c, 0x0a, 0x0a, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x73, 0x6c, 0x69, 0x63,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x67, 0x6f, 0x5f, 0x62, 0x6c, 0x75,
+	0x64, 0x67, 0x65, 0x6f, 0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x72, 0x73, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x53, 0x6c, 0x69, 0x63, 0x65, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x53, 0x6c, 0x69, 0x63,
+	0x65, 0x22, 0x29, 0x0a, 0x17, 0x54, 0x69, 0x6d, 0x65, 0x53, 0x6c, 0x69, 0x63, 0x65, 0x48, 0x69,
+	0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x5a, 0x0a, 0x18,
+	0x54, 0x69, 0x6d, 0x65, 0x53, 0x6c, 0x69, 0x63, 0x65, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3e, 0x0a, 0x0b, 0x74, 0x69, 0x6d, 0x65,
+	0x5f, 0x73, 0x6c, 0x69, 0x63, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e,
+	0x67, 0x6f, 0x5f, 0x62, 0x6c, 0x75, 0x64, 0x67, 0x65, 0x6f, 0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65,
+	0x72, 0x73, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x53, 0x6c, 0x69, 0x63, 0x65, 0x52, 0x0a, 0x74, 0x69,
+	0x6d, 0x65, 0x53, 0x6c, 0x69, 0x63, 0x65, 0x73, 0x22, 0xc5, 0x01, 0x0a, 0x10, 0x54, 0x69, 0x6d,
+	0x65, 0x53, 0x6c, 0x69, 0x63, 0x65, 0x50, 0x61, 0x72, 0x74, 0x69, 0x61, 0x6c, 0x12, 0x1b, 0x0a,
+	0x08, 0x74, 0x69, 0x6d, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48,
+	0x00, 0x52, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1e, 0x0a, 0x09, 0x63, 0x6f,
+	0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x48, 0x01, 0x52,
+	0x09, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x12, 0x16, 0x0a, 0x05, 0x73, 0x74,
+	0x61, 0x72, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x48, 0x02, 0x52, 0x05, 0x73, 0x74, 0x61,
+	0x72, 0x74, 0x12, 0x18, 0x0a, 0x06, 0x66, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x03, 0x48, 0x03, 0x52, 0x06, 0x66, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x42, 0x10, 0x0a, 0x0e,
+	0x74, 0x69, 0x6d, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x5f, 0x6f, 0x6e, 0x65, 0x6f, 0x66, 0x42, 0x11,
+	0x0a, 0x0f, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x5f, 0x6f, 0x6e, 0x65, 0x6f,
+	0x66, 0x42, 0x0d, 0x0a, 0x0b, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x6f, 0x6e, 0x65, 0x6f, 0x66,
+	0x42, 0x0e, 0x0a, 0x0c, 0x66, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x5f, 0x6f, 0x6e, 0x65, 0x6f, 0x66,
+	0x22, 0x8a, 0x02, 0x0a, 0x09, 0x54, 0x69, 0x6d, 0x65, 0x53, 0x6c, 0x69, 0x63, 0x65, 0x12, 0x1c,
+	0x0a, 0x09, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x09, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x12, 0x14, 0x0a, 0x05,
+	0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x73, 0x74, 0x61,
+	0x72, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x06, 0x66, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x12, 0x21, 0x0a, 0x0c, 0x65, 0x6c,
+	0x61, 0x70, 0x73, 0x65, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0b, 0x65, 0x6c, 0x61, 0x70, 0x73, 0x65, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x19, 0x0a,
+	0x08, 0x74, 0x69, 0x6d, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x74, 0x69, 0x6d, 0x65, 0x72, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x6c, 0x61, 0x73, 0x74,
+	0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b,
+	0x6c, 0x61, 0x73, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x12, 0x26, 0x0a, 0x0f, 0x6c,
+	0x61, 0x73, 0x74, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6c, 0x61, 0x73, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x64, 0x42, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x09,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0xe8, 0x02,
+	0x0a, 0x0f, 0x54, 0x69, 0x6d, 0x65, 0x53, 0x6c, 0x69, 0x63, 0x65, 0x53, 0x65, 0x61, 0x72, 0x63,
+	0x68, 0x12, 0x1e, 0x0a, 0x09, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x08, 0x48, 0x00, 0x52, 0x09, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65,
+	0x64, 0x12, 0x1b, 0x0a, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1b,
+	0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x72, 0x49, 0x64, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x69,
+	0x64, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x03, 0x69, 0x64, 0x73, 0x12, 0x16, 0x0a,
+	0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x48, 0x02, 0x52, 0x05,
+	0x73, 0x74, 0x61, 0x72, 0x74, 0x12, 0x18, 0x0a, 0x06, 0x66, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x03, 0x48, 0x03, 0x52, 0x06, 0x66, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x12,
+	0x16, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x48, 0x04,
+	0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x18, 0x0a, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f,
+	0x72, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x48, 0x05, 0x52, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f,
+	0x72, 0x12, 0x14, 0x0a, 0x04, 0x73, 0x6f, 0x72, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x48,
+	0x06, 0x52, 0x04, 0x73, 0x6f, 0x72, 0x74, 0x42, 0x11, 0x0a, 0x0f, 0x63, 0x6f, 0x6d, 0x70, 0x6c,
+	0x65, 0x74, 0x65, 0x64, 0x5f, 0x6f, 0x6e, 0x65, 0x6f, 0x66, 0x42, 0x10, 0x0a, 0x0e, 0x74, 0x69,
+	0x6d, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x5f, 0x6f, 0x6e, 0x65, 0x6f, 0x66, 0x42, 0x0d, 0x0a, 0x0b,
+	0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x6f, 0x6e, 0x65, 0x6f, 0x66, 0x42, 0x0e, 0x0a, 0x0c, 0x66,
+	0x69, 0x6e, 0x69, 0x73, 0x68, 0x5f, 0x6f, 0x6e, 0x65, 0x6f, 0x66, 0x42, 0x0d, 0x0a, 0x0b, 0x6c,
+	0x69, 0x6d, 0x69, 0x74, 0x5f, 0x6f, 0x6e, 0x65, 0x6f, 0x66, 0x42, 0x0e, 0x0a, 0x0c, 0x63, 0x75,
+	0x72, 0x73, 0x6f, 0x72, 0x5f, 0x6f, 0x6e, 0x65, 0x6f, 0x66, 0x42, 0x0c, 0x0a, 0x0a, 0x73, 0x6f,
+	0x72, 0x74, 0x5f, 0x6f, 0x6e, 0x65, 0x6f, 0x66, 0x32, 0x83, 0x07, 0x0a, 0x0a, 0x54, 0x69, 0x6d,
+	0x65, 0x53, 0x6c, 0x69, 0x63, 0x65, 0x73, 0x12, 0x6e, 0x0a, 0x11, 0x74, 0x69, 0x6d, 0x65, 0x5f,
+	0x73, 0x6c, 0x69, 0x63, 0x65, 0x5f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x12, 0x2a, 0x2e, 0x67,
+	0x6f, 0x5f, 0x62, 0x6c, 0x75, 0x64, 0x67, 0x65, 0x6f, 0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x72,
+	0x73, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x53, 0x6c, 0x69, 0x63, 0x65, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x67, 0x6f, 0x5f, 0x62, 0x6c,
+	0x75, 0x64, 0x67, 0x65, 0x6f, 0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x72, 0x73, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x53, 0x6c, 0x69, 0x63, 0x65, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x68, 0x0a, 0x0f, 0x74, 0x69, 0x6d, 0x65, 0x5f,
+	0x73, 0x6c, 0x69, 0x63, 0x65, 0x5f, 0x72, 0x65, 0x61, 0x64, 0x12, 0x28, 0x2e, 0x67, 0x6f, 0x5f,
 	0x62, 0x6c, 0x75, 0x64, 0x67, 0x65, 0x6f, 0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x72, 0x73, 0x2e,
-	0x54, 0x69, 0x6d, 0x65, 0x53, 0x6c, 0x69, 0x63, 0x65, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x67, 0x6f, 0x5f, 0x62, 0x6c, 0x75, 0x64,
+	0x54, 0x69, 0x6d, 0x65, 0x53, 0x6c, 0x69, 0x63, 0x65, 0x52, 0x65, 0x61, 0x64, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x67, 0x6f, 0x5f, 0x62, 0x6c, 0x75, 0x64, 0x67, 0x65,
+	0x6f, 0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x72, 0x73, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x53, 0x6c,
+	0x69, 0x63, 0x65, 0x52, 0x65, 0x61, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x12, 0x6e, 0x0a, 0x11, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x73, 0x6c, 0x69, 0x63, 0x65, 0x5f,
+	0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x2a, 0x2e, 0x67, 0x6f, 0x5f, 0x62, 0x6c, 0x75, 0x64,
 	0x67, 0x65, 0x6f, 0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x72, 0x73, 0x2e, 0x54, 0x69, 0x6d, 0x65,
-	0x53, 0x6c, 0x69, 0x63, 0x65, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x68, 0x0a, 0x0f, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x73, 0x6c,
-	0x69, 0x63, 0x65, 0x5f, 0x72, 0x65, 0x61, 0x64, 0x12, 0x28, 0x2e, 0x67, 0x6f, 0x5f, 0x62, 0x6c,
-	0x75, 0x64, 0x67, 0x65, 0x6f, 0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x72, 0x73, 0x2e, 0x54, 0x69,
-	0x6d, 0x65, 0x53, 0x6c, 0x69, 0x63, 0x65, 0x52, 0x65, 0x61, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x29, 0x2e, 0x67, 0x6f, 0x5f, 0x62, 0x6c, 0x75, 0x64, 0x67, 0x65, 0x6f, 0x6e,
+	0x53, 0x6c, 0x69, 0x63, 0x65, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x67, 0x6f, 0x5f, 0x62, 0x6c, 0x75, 0x64, 0x67, 0x65, 0x6f, 0x6e,
 	0x5f, 0x74, 0x69, 0x6d, 0x65, 0x72, 0x73, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x53, 0x6c, 0x69, 0x63,
-	0x65, 0x52, 0x65, 0x61, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
-	0x6e, 0x0a, 0x11, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x73, 0x6c, 0x69, 0x63, 0x65, 0x5f, 0x75, 0x70,
-	0x64, 0x61, 0x74, 0x65, 0x12, 0x2a, 0x2e, 0x67, 0x6f, 0x5f, 0x62, 0x6c, 0x75, 0x64, 0x67, 0x65,
-	0x6f, 0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x72, 0x73, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x53, 0x6c,
-	0x69, 0x63, 0x65, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x2b, 0x2e, 0x67, 0x6f, 0x5f, 0x62, 0x6c, 0x75, 0x64, 0x67, 0x65, 0x6f, 0x6e, 0x5f, 0x74,
-	0x69, 0x6d, 0x65, 0x72, 0x73, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x53, 0x6c, 0x69, 0x63, 0x65, 0x55,
-	0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
-	0x6e, 0x0a, 0x11, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x73, 0x6c, 0x69, 0x63, 0x65, 0x5f, 0x64, 0x65,
-	0x6c, 0x65, 0x74, 0x65, 0x12, 0x2a, 0x2e, 0x67, 0x6f, 0x5f, 0x62, 0x6c, 0x75, 0x64, 0x67, 0x65,
-	0x6f, 0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x72, 0x73, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x53, 0x6c,
-	0x69, 0x63, 0x65, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x2b, 0x2e, 0x67, 0x6f, 0x5f, 0x62, 0x6c, 0x75, 0x64, 0x67, 0x65, 0x6f, 0x6e, 0x5f, 0x74,
-	0x69, 0x6d, 0x65, 0x72, 0x73, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x53, 0x6c, 0x69, 0x63, 0x65, 0x44,
-	0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
-	0x6b, 0x0a, 0x10, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x73, 0x6c, 0x69, 0x63, 0x65, 0x73, 0x5f, 0x72,
-	0x65, 0x61, 0x64, 0x12, 0x29, 0x2e, 0x67, 0x6f, 0x5f, 0x62, 0x6c, 0x75, 0x64, 0x67, 0x65, 0x6f,
-	0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x72, 0x73, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x53, 0x6c, 0x69,
-	0x63, 0x65, 0x73, 0x52, 0x65, 0x61, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a,
+	0x65, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x12, 0x6e, 0x0a, 0x11, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x73, 0x6c, 0x69, 0x63, 0x65, 0x5f,
+	0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x12, 0x2a, 0x2e, 0x67, 0x6f, 0x5f, 0x62, 0x6c, 0x75, 0x64,
+	0x67, 0x65, 0x6f, 0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x72, 0x73, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x53, 0x6c, 0x69, 0x63, 0x65, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x67, 0x6f, 0x5f, 0x62, 0x6c, 0x75, 0x64, 0x67, 0x65, 0x6f, 0x6e,
+	0x5f, 0x74, 0x69, 0x6d, 0x65, 0x72, 0x73, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x53, 0x6c, 0x69, 0x63,
+	0x65, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x12, 0x6b, 0x0a, 0x10, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x73, 0x6c, 0x69, 0x63, 0x65, 0x73,
+	0x5f, 0x72, 0x65, 0x61, 0x64, 0x12, 0x29, 0x2e, 0x67, 0x6f, 0x5f, 0x62, 0x6c, 0x75, 0x64, 0x67,
+	0x65, 0x6f, 0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x72, 0x73, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x53,
+	0x6c, 0x69, 0x63, 0x65, 0x73, 0x52, 0x65, 0x61, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x2a, 0x2e, 0x67, 0x6f, 0x5f, 0x62, 0x6c, 0x75, 0x64, 0x67, 0x65, 0x6f, 0x6e, 0x5f, 0x74,
+	0x69, 0x6d, 0x65, 0x72, 0x73, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x53, 0x6c, 0x69, 0x63, 0x65, 0x73,
+	0x52, 0x65, 0x61, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x6b,
+	0x0a, 0x10, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x73, 0x6c, 0x69, 0x63, 0x65, 0x5f, 0x73, 0x70, 0x6c,
+	0x69, 0x74, 0x12, 0x29, 0x2e, 0x67, 0x6f, 0x5f, 0x62, 0x6c, 0x75, 0x64, 0x67, 0x65, 0x6f, 0x6e,
+	0x5f, 0x74, 0x69, 0x6d, 0x65, 0x72, 0x73, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x53, 0x6c, 0x69, 0x63,
+	0x65, 0x53, 0x70, 0x6c, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e,
+	0x67, 0x6f, 0x5f, 0x62, 0x6c, 0x75, 0x64, 0x67, 0x65, 0x6f, 0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65,
+	0x72, 0x73, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x53, 0x6c, 0x69, 0x63, 0x65, 0x53, 0x70, 0x6c, 0x69,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x6e, 0x0a, 0x11, 0x74,
+	0x69, 0x6d, 0x65, 0x5f, 0x73, 0x6c, 0x69, 0x63, 0x65, 0x73, 0x5f, 0x6d, 0x65, 0x72, 0x67, 0x65,
+	0x12, 0x2a, 0x2e, 0x67, 0x6f, 0x5f, 0x62, 0x6c, 0x75, 0x64, 0x67, 0x65, 0x6f, 0x6e, 0x5f, 0x74,
+	0x69, 0x6d, 0x65, 0x72, 0x73, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x53, 0x6c, 0x69, 0x63, 0x65, 0x73,
+	0x4d, 0x65, 0x72, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x67,
+	0x6f, 0x5f, 0x62, 0x6c, 0x75, 0x64, 0x67, 0x65, 0x6f, 0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x72,
+	0x73, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x53, 0x6c, 0x69, 0x63, 0x65, 0x73, 0x4d, 0x65, 0x72, 0x67,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x71, 0x0a, 0x12, 0x74,
+	0x69, 0x6d, 0x65, 0x5f, 0x73, 0x6c, 0x69, 0x63, 0x65, 0x5f, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72,
+	0x79, 0x12, 0x2b, 0x2e, 0x67, 0x6f, 0x5f, 0x62, 0x6c, 0x75, 0x64, 0x67, 0x65, 0x6f, 0x6e, 0x5f,
+	0x74, 0x69, 0x6d, 0x65, 0x72, 0x73, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x53, 0x6c, 0x69, 0x63, 0x65,
+	0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c,
 	0x2e, 0x67, 0x6f, 0x5f, 0x62, 0x6c, 0x75, 0x64, 0x67, 0x65, 0x6f, 0x6e, 0x5f, 0x74, 0x69, 0x6d,
-	0x65, 0x72, 0x73, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x53, 0x6c, 0x69, 0x63, 0x65, 0x73, 0x52, 0x65,
-	0x61, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x42, 0x39, 0x5a, 0x37,
-	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x6e, 0x74, 0x6f, 0x6e,
-	0x69, 0x6f, 0x2d, 0x61, 0x6c, 0x65, 0x78, 0x61, 0x6e, 0x64, 0x65, 0x72, 0x2f, 0x67, 0x6f, 0x2d,
-	0x62, 0x6c, 0x75, 0x64, 0x67, 0x65, 0x6f, 0x6e, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x72, 0x73, 0x2f,
-	0x64, 0x61, 0x74, 0x61, 0x2f, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x65, 0x72, 0x73, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x53, 0x6c, 0x69, 0x63, 0x65, 0x48, 0x69, 0x73,
+	0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x42, 0x39,
+	0x5a, 0x37, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x6e, 0x74,
+	0x6f, 0x6e, 0x69, 0x6f, 0x2d, 0x61, 0x6c, 0x65, 0x78, 0x61, 0x6e, 0x64, 0x65, 0x72, 0x2f, 0x67,
+	0x6f, 0x2d, 0x62, 0x6c, 0x75, 0x64, 0x67, 0x65, 0x6f, 0x6e, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x72,
+	0x73, 0x2f, 0x64, 0x61, 0x74, 0x61, 0x2f, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x33,
 }
 
 var (
@@ -1066,45 +1619,60 @@ func file_timeslices_proto_rawDescGZIP() []byte {
 	return file_timeslices_proto_rawDescData
 }
 
-var file_timeslices_proto_msgTypes = make([]protoimpl.MessageInfo, 13)
+var file_timeslices_proto_msgTypes = make([]protoimpl.MessageInfo, 19)
 var file_timeslices_proto_goTypes = []interface{}{
-	(*TimeSliceCreateRequest)(nil),  // 0: go_bludgeon_timers.TimeSliceCreateRequest
-	(*TimeSliceCreateResponse)(nil), // 1: go_bludgeon_timers.TimeSliceCreateResponse
-	(*TimeSliceReadRequest)(nil),    // 2: go_bludgeon_timers.TimeSliceReadRequest
-	(*TimeSliceReadResponse)(nil),   // 3: go_bludgeon_timers.TimeSliceReadResponse
-	(*TimeSliceUpdateRequest)(nil),  // 4: go_bludgeon_timers.TimeSliceUpdateRequest
-	(*TimeSliceUpdateResponse)(nil), // 5: go_bludgeon_timers.TimeSliceUpdateResponse
-	(*TimeSliceDeleteRequest)(nil),  // 6: go_bludgeon_timers.TimeSliceDeleteRequest
-	(*TimeSliceDeleteResponse)(nil), // 7: go_bludgeon_timers.TimeSliceDeleteResponse
-	(*TimeSlicesReadRequest)(nil),   // 8: go_bludgeon_timers.TimeSlicesReadRequest
-	(*TimeSlicesReadResponse)(nil),  // 9: go_bludgeon_timers.TimeSlicesReadResponse
-	(*TimeSlicePartial)(nil),        // 10: go_bludgeon_timers.TimeSlicePartial
-	(*TimeSlice)(nil),               // 11: go_bludgeon_timers.TimeSlice
-	(*TimeSliceSearch)(nil),         // 12: go_bludgeon_timers.TimeSliceSearch
+	(*TimeSliceCreateRequest)(nil),   // 0: go_bludgeon_timers.TimeSliceCreateRequest
+	(*TimeSliceCreateResponse)(nil),  // 1: go_bludgeon_timers.TimeSliceCreateResponse
+	(*TimeSliceReadRequest)(nil),     // 2: go_bludgeon_timers.TimeSliceReadRequest
+	(*TimeSliceReadResponse)(nil),    // 3: go_bludgeon_timers.TimeSliceReadResponse
+	(*TimeSliceUpdateRequest)(nil),   // 4: go_bludgeon_timers.TimeSliceUpdateRequest
+	(*TimeSliceUpdateResponse)(nil),  // 5: go_bludgeon_timers.TimeSliceUpdateResponse
+	(*TimeSliceDeleteRequest)(nil),   // 6: go_bludgeon_timers.TimeSliceDeleteRequest
+	(*TimeSliceDeleteResponse)(nil),  // 7: go_bludgeon_timers.TimeSliceDeleteResponse
+	(*TimeSlicesReadRequest)(nil),    // 8: go_bludgeon_timers.TimeSlicesReadRequest
+	(*TimeSlicesReadResponse)(nil),   // 9: go_bludgeon_timers.TimeSlicesReadResponse
+	(*TimeSliceSplitRequest)(nil),    // 10: go_bludgeon_timers.TimeSliceSplitRequest
+	(*TimeSliceSplitResponse)(nil),   // 11: go_bludgeon_timers.TimeSliceSplitResponse
+	(*TimeSlicesMergeRequest)(nil),   // 12: go_bludgeon_timers.TimeSlicesMergeRequest
+	(*TimeSlicesMergeResponse)(nil),  // 13: go_bludgeon_timers.TimeSlicesMergeResponse
+	(*TimeSliceHistoryRequest)(nil),  // 14: go_bludgeon_timers.TimeSliceHistoryRequest
+	(*TimeSliceHistoryResponse)(nil), // 15: go_bludgeon_timers.TimeSliceHistoryResponse
+	(*TimeSlicePartial)(nil),         // 16: go_bludgeon_timers.TimeSlicePartial
+	(*TimeSlice)(nil),                // 17: go_bludgeon_timers.TimeSlice
+	(*TimeSliceSearch)(nil),          // 18: go_bludgeon_timers.TimeSliceSearch
 }
 var file_timeslices_proto_depIdxs = []int32{
-	10, // 0: go_bludgeon_timers.TimeSliceCreateRequest.time_slice_partial:type_name -> go_bludgeon_timers.TimeSlicePartial
-	11, // 1: go_bludgeon_timers.TimeSliceCreateResponse.time_slice:type_name -> go_bludgeon_timers.TimeSlice
-	11, // 2: go_bludgeon_timers.TimeSliceReadResponse.time_slice:type_name -> go_bludgeon_timers.TimeSlice
-	10, // 3: go_bludgeon_timers.TimeSliceUpdateRequest.time_slice_partial:type_name -> go_bludgeon_timers.TimeSlicePartial
-	11, // 4: go_bludgeon_timers.TimeSliceUpdateResponse.time_slice:type_name -> go_bludgeon_timers.TimeSlice
-	12, // 5: go_bludgeon_timers.TimeSlicesReadRequest.time_slice_search:type_name -> go_bludgeon_timers.TimeSliceSearch
-	11, // 6: go_bludgeon_timers.TimeSlicesReadResponse.time_slices:type_name -> go_bludgeon_timers.TimeSlice
-	0,  // 7: go_bludgeon_timers.TimeSlices.time_slice_create:input_type -> go_bludgeon_timers.TimeSliceCreateRequest
-	2,  // 8: go_bludgeon_timers.TimeSlices.time_slice_read:input_type -> go_bludgeon_timers.TimeSliceReadRequest
-	4,  // 9: go_bludgeon_timers.TimeSlices.time_slice_update:input_type -> go_bludgeon_timers.TimeSliceUpdateRequest
-	6,  // 10: go_bludgeon_timers.TimeSlices.time_slice_delete:input_type -> go_bludgeon_timers.TimeSliceDeleteRequest
-	8,  // 11: go_bludgeon_timers.TimeSlices.time_slices_read:input_type -> go_bludgeon_timers.TimeSlicesReadRequest
-	1,  // 12: go_bludgeon_timers.TimeSlices.time_slice_create:output_type -> go_bludgeon_timers.TimeSliceCreateResponse
-	3,  // 13: go_bludgeon_timers.TimeSlices.time_slice_read:output_type -> go_bludgeon_timers.TimeSliceReadResponse
-	5,  // 14: go_bludgeon_timers.TimeSlices.time_slice_update:output_type -> go_bludgeon_timers.TimeSliceUpdateResponse
-	7,  // 15: go_bludgeon_timers.TimeSlices.time_slice_delete:output_type -> go_bludgeon_timers.TimeSliceDeleteResponse
-	9,  // 16: go_bludgeon_timers.TimeSlices.time_slices_read:output_type -> go_bludgeon_timers.TimeSlicesReadResponse
-	12, // [12:17] is the sub-list for method output_type
-	7,  // [7:12] is the sub-list for method input_type
-	7,  // [7:7] is the sub-list for extension type_name
-	7,  // [7:7] is the sub-list for extension extendee
-	0,  // [0:7] is the sub-list for field type_name
+	16, // 0: go_bludgeon_timers.TimeSliceCreateRequest.time_slice_partial:type_name -> go_bludgeon_timers.TimeSlicePartial
+	17, // 1: go_bludgeon_timers.TimeSliceCreateResponse.time_slice:type_name -> go_bludgeon_timers.TimeSlice
+	17, // 2: go_bludgeon_timers.TimeSliceReadResponse.time_slice:type_name -> go_bludgeon_timers.TimeSlice
+	16, // 3: go_bludgeon_timers.TimeSliceUpdateRequest.time_slice_partial:type_name -> go_bludgeon_timers.TimeSlicePartial
+	17, // 4: go_bludgeon_timers.TimeSliceUpdateResponse.time_slice:type_name -> go_bludgeon_timers.TimeSlice
+	18, // 5: go_bludgeon_timers.TimeSlicesReadRequest.time_slice_search:type_name -> go_bludgeon_timers.TimeSliceSearch
+	17, // 6: go_bludgeon_timers.TimeSlicesReadResponse.time_slices:type_name -> go_bludgeon_timers.TimeSlice
+	17, // 7: go_bludgeon_timers.TimeSliceSplitResponse.time_slices:type_name -> go_bludgeon_timers.TimeSlice
+	17, // 8: go_bludgeon_timers.TimeSlicesMergeResponse.time_slice:type_name -> go_bludgeon_timers.TimeSlice
+	17, // 9: go_bludgeon_timers.TimeSliceHistoryResponse.time_slices:type_name -> go_bludgeon_timers.TimeSlice
+	0,  // 10: go_bludgeon_timers.TimeSlices.time_slice_create:input_type -> go_bludgeon_timers.TimeSliceCreateRequest
+	2,  // 11: go_bludgeon_timers.TimeSlices.time_slice_read:input_type -> go_bludgeon_timers.TimeSliceReadRequest
+	4,  // 12: go_bludgeon_timers.TimeSlices.time_slice_update:input_type -> go_bludgeon_timers.TimeSliceUpdateRequest
+	6,  // 13: go_bludgeon_timers.TimeSlices.time_slice_delete:input_type -> go_bludgeon_timers.TimeSliceDeleteRequest
+	8,  // 14: go_bludgeon_timers.TimeSlices.time_slices_read:input_type -> go_bludgeon_timers.TimeSlicesReadRequest
+	10, // 15: go_bludgeon_timers.TimeSlices.time_slice_split:input_type -> go_bludgeon_timers.TimeSliceSplitRequest
+	12, // 16: go_bludgeon_timers.TimeSlices.time_slices_merge:input_type -> go_bludgeon_timers.TimeSlicesMergeRequest
+	14, // 17: go_bludgeon_timers.TimeSlices.time_slice_history:input_type -> go_bludgeon_timers.TimeSliceHistoryRequest
+	1,  // 18: go_bludgeon_timers.TimeSlices.time_slice_create:output_type -> go_bludgeon_timers.TimeSliceCreateResponse
+	3,  // 19: go_bludgeon_timers.TimeSlices.time_slice_read:output_type -> go_bludgeon_timers.TimeSliceReadResponse
+	5,  // 20: go_bludgeon_timers.TimeSlices.time_slice_update:output_type -> go_bludgeon_timers.TimeSliceUpdateResponse
+	7,  // 21: go_bludgeon_timers.TimeSlices.time_slice_delete:output_type -> go_bludgeon_timers.TimeSliceDeleteResponse
+	9,  // 22: go_bludgeon_timers.TimeSlices.time_slices_read:output_type -> go_bludgeon_timers.TimeSlicesReadResponse
+	11, // 23: go_bludgeon_timers.TimeSlices.time_slice_split:output_type -> go_bludgeon_timers.TimeSliceSplitResponse
+	13, // 24: go_bludgeon_timers.TimeSlices.time_slices_merge:output_type -> go_bludgeon_timers.TimeSlicesMergeResponse
+	15, // 25: go_bludgeon_timers.TimeSlices.time_slice_history:output_type -> go_bludgeon_timers.TimeSliceHistoryResponse
+	18, // [18:26] is the sub-list for method output_type
+	10, // [10:18] is the sub-list for method input_type
+	10, // [10:10] is the sub-list for extension type_name
+	10, // [10:10] is the sub-list for extension extendee
+	0,  // [0:10] is the sub-list for field type_name
 }
 
 func init() { file_timeslices_proto_init() }
@@ -1234,7 +1802,7 @@ func file_timeslices_proto_init() {
 			}
 		}
 		file_timeslices_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TimeSlicePartial); i {
+			switch v := v.(*TimeSliceSplitRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1246,7 +1814,7 @@ func file_timeslices_proto_init() {
 			}
 		}
 		file_timeslices_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TimeSlice); i {
+			switch v := v.(*TimeSliceSplitResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1258,6 +1826,78 @@ func file_timeslices_proto_init() {
 			}
 		}
 		file_timeslices_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TimeSlicesMergeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_timeslices_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TimeSlicesMergeResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_timeslices_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TimeSliceHistoryRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_timeslices_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TimeSliceHistoryResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_timeslices_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TimeSlicePartial); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_timeslices_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TimeSlice); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_timeslices_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*TimeSliceSearch); i {
 			case 0:
 				return &v.state
@@ -1270,15 +1910,23 @@ func file_timeslices_proto_init() {
 			}
 		}
 	}
-	file_timeslices_proto_msgTypes[10].OneofWrappers = []interface{}{
+	file_timeslices_proto_msgTypes[4].OneofWrappers = []interface{}{
+		(*TimeSliceUpdateRequest_Version)(nil),
+	}
+	file_timeslices_proto_msgTypes[16].OneofWrappers = []interface{}{
 		(*TimeSlicePartial_TimerId)(nil),
 		(*TimeSlicePartial_Completed)(nil),
 		(*TimeSlicePartial_Start)(nil),
 		(*TimeSlicePartial_Finish)(nil),
 	}
-	file_timeslices_proto_msgTypes[12].OneofWrappers = []interface{}{
+	file_timeslices_proto_msgTypes[18].OneofWrappers = []interface{}{
 		(*TimeSliceSearch_Completed)(nil),
 		(*TimeSliceSearch_TimerId)(nil),
+		(*TimeSliceSearch_Start)(nil),
+		(*TimeSliceSearch_Finish)(nil),
+		(*TimeSliceSearch_Limit)(nil),
+		(*TimeSliceSearch_Cursor)(nil),
+		(*TimeSliceSearch_Sort)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -1286,7 +1934,7 @@ func file_timeslices_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_timeslices_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   13,
+			NumMessages:   19,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -32,6 +32,12 @@ type TimeSlicesClient interface {
 	TimeSliceDelete(ctx context.Context, in *TimeSliceDeleteRequest, opts ...grpc.CallOption) (*TimeSliceDeleteResponse, error)
 	// time_slices_read
 	TimeSlicesRead(ctx context.Context, in *TimeSlicesReadRequest, opts ...grpc.CallOption) (*TimeSlicesReadResponse, error)
+	// time_slice_split
+	TimeSliceSplit(ctx context.Context, in *TimeSliceSplitRequest, opts ...grpc.CallOption) (*TimeSliceSplitResponse, error)
+	// time_slices_merge
+	TimeSlicesMerge(ctx context.Context, in *TimeSlicesMergeRequest, opts ...grpc.CallOption) (*TimeSlicesMergeResponse, error)
+	// time_slice_history
+	TimeSliceHistory(ctx context.Context, in *TimeSliceHistoryRequest, opts ...grpc.CallOption) (*TimeSliceHistoryResponse, error)
 }
 
 type timeSlicesClient struct {
@@ -87,6 +93,33 @@ func (c *timeSlicesClient) TimeSlicesRead(ctx context.Context, in *TimeSlicesRea
 	return out, nil
 }
 
+func (c *timeSlicesClient) TimeSliceSplit(ctx context.Context, in *TimeSliceSplitRequest, opts ...grpc.CallOption) (*TimeSliceSplitResponse, error) {
+	out := new(TimeSliceSplitResponse)
+	err := c.cc.Invoke(ctx, "/go_bludgeon_timers.TimeSlices/time_slice_split", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *timeSlicesClient) TimeSlicesMerge(ctx context.Context, in *TimeSlicesMergeRequest, opts ...grpc.CallOption) (*TimeSlicesMergeResponse, error) {
+	out := new(TimeSlicesMergeResponse)
+	err := c.cc.Invoke(ctx, "/go_bludgeon_timers.TimeSlices/time_slices_merge", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *timeSlicesClient) TimeSliceHistory(ctx context.Context, in *TimeSliceHistoryRequest, opts ...grpc.CallOption) (*TimeSliceHistoryResponse, error) {
+	out := new(TimeSliceHistoryResponse)
+	err := c.cc.Invoke(ctx, "/go_bludgeon_timers.TimeSlices/time_slice_history", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // TimeSlicesServer is the server API for TimeSlices service.
 // All implementations must embed UnimplementedTimeSlicesServer
 // for forward compatibility
@@ -101,6 +134,12 @@ type TimeSlicesServer interface {
 	TimeSliceDelete(context.Context, *TimeSliceDeleteRequest) (*TimeSliceDeleteResponse, error)
 	// time_slices_read
 	TimeSlicesRead(context.Context, *TimeSlicesReadRequest) (*TimeSlicesReadResponse, error)
+	// time_slice_split
+	TimeSliceSplit(context.Context, *TimeSliceSplitRequest) (*TimeSliceSplitResponse, error)
+	// time_slices_merge
+	TimeSlicesMerge(context.Context, *TimeSlicesMergeRequest) (*TimeSlicesMergeResponse, error)
+	// time_slice_history
+	TimeSliceHistory(context.Context, *TimeSliceHistoryRequest) (*TimeSliceHistoryResponse, error)
 	mustEmbedUnimplementedTimeSlicesServer()
 }
 
@@ -123,6 +162,15 @@ func (UnimplementedTimeSlicesServer) TimeSliceDelete(context.Context, *TimeSlice
 func (UnimplementedTimeSlicesServer) TimeSlicesRead(context.Context, *TimeSlicesReadRequest) (*TimeSlicesReadResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method TimeSlicesRead not implemented")
 }
+func (UnimplementedTimeSlicesServer) TimeSliceSplit(context.Context, *TimeSliceSplitRequest) (*TimeSliceSplitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TimeSliceSplit not implemented")
+}
+func (UnimplementedTimeSlicesServer) TimeSlicesMerge(context.Context, *TimeSlicesMergeRequest) (*TimeSlicesMergeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TimeSlicesMerge not implemented")
+}
+func (UnimplementedTimeSlicesServer) TimeSliceHistory(context.Context, *TimeSliceHistoryRequest) (*TimeSliceHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TimeSliceHistory not implemented")
+}
 func (UnimplementedTimeSlicesServer) mustEmbedUnimplementedTimeSlicesServer() {}
 
 // UnsafeTimeSlicesServer may be embedded to opt out of forward compatibility for this service.
@@ -226,6 +274,60 @@ func _TimeSlices_TimeSlicesRead_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _TimeSlices_TimeSliceSplit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TimeSliceSplitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TimeSlicesServer).TimeSliceSplit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/go_bludgeon_timers.TimeSlices/time_slice_split",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TimeSlicesServer).TimeSliceSplit(ctx, req.(*TimeSliceSplitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TimeSlices_TimeSlicesMerge_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TimeSlicesMergeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TimeSlicesServer).TimeSlicesMerge(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/go_bludgeon_timers.TimeSlices/time_slices_merge",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TimeSlicesServer).TimeSlicesMerge(ctx, req.(*TimeSlicesMergeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TimeSlices_TimeSliceHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TimeSliceHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TimeSlicesServer).TimeSliceHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/go_bludgeon_timers.TimeSlices/time_slice_history",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TimeSlicesServer).TimeSliceHistory(ctx, req.(*TimeSliceHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // TimeSlices_ServiceDesc is the grpc.ServiceDesc for TimeSlices service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -253,6 +355,18 @@ var TimeSlices_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "time_slices_read",
 			Handler:    _TimeSlices_TimeSlicesRead_Handler,
 		},
+		{
+			MethodName: "time_slice_split",
+			Handler:    _TimeSlices_TimeSliceSplit_Handler,
+		},
+		{
+			MethodName: "time_slices_merge",
+			Handler:    _TimeSlices_TimeSlicesMerge_Handler,
+		},
+		{
+			MethodName: "time_slice_history",
+			Handler:    _TimeSlices_TimeSliceHistory_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "timeslices.proto",
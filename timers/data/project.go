@@ -0,0 +1,52 @@
+package data
+
+// swagger:model Project
+//Project describes a piece of work that one or more timers can be associated
+// with, it allows time to be reconciled per project rather than only per
+// employee
+type Project struct {
+	//The ID of the project (v4 UUID)
+	// example: "8f6a3d52-5c1e-4d0b-9a5e-3a4b7b2f1c90"
+	ID string `json:"id"`
+
+	//The name of the project, it must be unique
+	// example: "Bludgeon"
+	Name string `json:"name"`
+
+	//A description of the project
+	// example: "Time tracking for the bludgeon project"
+	Description string `json:"description"`
+
+	//Whether or not a project has been archived
+	// example: false
+	Archived bool `json:"archived"`
+
+	//LastUpdated represents the last time (unix nano) something was mutated
+	// example: 1652417242000
+	LastUpdated int64 `json:"last_updated"`
+
+	//LastUpdatedBy will identify the last someone who mutated something
+	// example: bludgeon_employee_memory
+	LastUpdatedBy string `json:"last_updated_by"`
+
+	//Version is an integer that's atomically incremented each time something i smutated
+	// example: 1
+	Version int `json:"version"`
+}
+
+// swagger:model ProjectPartial
+//ProjectPartial represents the properties in project that can be
+// modified from the outside
+type ProjectPartial struct {
+	//The name of the project, it must be unique
+	// example: "Bludgeon"
+	Name *string `json:"name,omitempty"`
+
+	//A description of the project
+	// example: "Time tracking for the bludgeon project"
+	Description *string `json:"description,omitempty"`
+
+	//Whether or not a project has been archived
+	// example: true
+	Archived *bool `json:"archived,omitempty"`
+}
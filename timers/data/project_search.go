@@ -0,0 +1,69 @@
+package data
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// swagger:model ProjectSearch
+//ProjectSearch can be used to inclusively search for one or more
+// projects
+type ProjectSearch struct {
+	//An array of one or more ids to search for
+	// in:query
+	IDs []string `json:"ids,omitempty"`
+
+	//An array of one or more names to search for
+	// in:query
+	Names []string `json:"names,omitempty"`
+
+	//Set to search for archived projects only
+	// in:query
+	Archived *bool `json:"archived,omitempty"`
+}
+
+//ToParams can be used to generate a parameter string from
+// a valid project search pointer
+func (p *ProjectSearch) ToParams() string {
+	const (
+		parameterf     string = "%s=%s"
+		parameterBoolf string = "%s=%t"
+	)
+	var parameters []string
+	if len(p.IDs) > 0 {
+		parameters = append(parameters,
+			fmt.Sprintf(parameterf, ParameterIDs, strings.Join(p.IDs, ",")))
+	}
+	if len(p.Names) > 0 {
+		parameters = append(parameters,
+			fmt.Sprintf(parameterf, ParameterNames, strings.Join(p.Names, ",")))
+	}
+	if archived := p.Archived; archived != nil {
+		parameters = append(parameters,
+			fmt.Sprintf(parameterBoolf, ParameterArchived, *archived))
+	}
+	return "?" + strings.Join(parameters, "&")
+}
+
+//FromParams can be used to convert a set of params into a project
+// search pointer
+func (p *ProjectSearch) FromParams(params map[string][]string) {
+	for key, value := range params {
+		switch strings.ToLower(key) {
+		case ParameterIDs:
+			for _, value := range value {
+				p.IDs = append(p.IDs, strings.Split(value, ",")...)
+			}
+		case ParameterNames:
+			for _, value := range value {
+				p.Names = append(p.Names, strings.Split(value, ",")...)
+			}
+		case ParameterArchived:
+			if archived, err := strconv.ParseBool(value[0]); err == nil {
+				p.Archived = new(bool)
+				*p.Archived = archived
+			}
+		}
+	}
+}
@@ -0,0 +1,118 @@
+package data
+
+// swagger:model RateCard
+//RateCard describes the rate (per hour) that time is billed at for an employee,
+// a project or an employee on a specific project. A rate card is only effective
+// between its effective from and effective to times, an effective to of zero
+// means that the rate card is effective indefinitely
+type RateCard struct {
+	//The ID of the rate card (v4 UUID)
+	// example: "3b0c1f7e-2f5d-4a8f-8a8e-0c6a0d6e2b11"
+	ID string `json:"id"`
+
+	//The ID of an employee (v4 UUID), if empty the rate card
+	// applies to all employees
+	// example: "2e3a4156-b415-4120-982f-399182e99588"
+	EmployeeID string `json:"employee_id"`
+
+	//The ID of a project (v4 UUID), if empty the rate card
+	// applies to all projects
+	// example: "8f6a3d52-5c1e-4d0b-9a5e-3a4b7b2f1c90"
+	ProjectID string `json:"project_id"`
+
+	//The rate per hour in the smallest unit of the currency
+	// example: 15000
+	Rate int64 `json:"rate"`
+
+	//The currency of the rate (ISO 4217)
+	// example: USD
+	Currency string `json:"currency"`
+
+	//The time (unix nano) the rate card becomes effective
+	// example: 1653719208000000000
+	EffectiveFrom int64 `json:"effective_from"`
+
+	//The time (unix nano) the rate card is no longer effective
+	// example: 0
+	EffectiveTo int64 `json:"effective_to"`
+
+	//LastUpdated represents the last time (unix nano) something was mutated
+	// example: 1652417242000
+	LastUpdated int64 `json:"last_updated"`
+
+	//LastUpdatedBy will identify the last someone who mutated something
+	// example: bludgeon_employee_memory
+	LastUpdatedBy string `json:"last_updated_by"`
+
+	//Version is an integer that's atomically incremented each time something i smutated
+	// example: 1
+	Version int `json:"version"`
+}
+
+//EffectiveAt returns true if the rate card is effective at the
+// provided time (unix nano)
+func (r *RateCard) EffectiveAt(t int64) bool {
+	if t < r.EffectiveFrom {
+		return false
+	}
+	if r.EffectiveTo > 0 && t >= r.EffectiveTo {
+		return false
+	}
+	return true
+}
+
+//Applies returns true if the rate card can be applied to
+// the provided employee and project
+func (r *RateCard) Applies(employeeID, projectID string) bool {
+	if r.EmployeeID != "" && r.EmployeeID != employeeID {
+		return false
+	}
+	if r.ProjectID != "" && r.ProjectID != projectID {
+		return false
+	}
+	return true
+}
+
+//Specificity can be used to determine which of two applicable
+// rate cards should be used, rate cards for an employee on a
+// project are more specific than rate cards for a project which
+// are more specific than rate cards for an employee
+func (r *RateCard) Specificity() int {
+	specificity := 0
+	if r.ProjectID != "" {
+		specificity += 2
+	}
+	if r.EmployeeID != "" {
+		specificity++
+	}
+	return specificity
+}
+
+// swagger:model RateCardPartial
+//RateCardPartial represents the properties in rate card that can be
+// modified from the outside
+type RateCardPartial struct {
+	//The ID of an employee (v4 UUID)
+	// example: "2e3a4156-b415-4120-982f-399182e99588"
+	EmployeeID *string `json:"employee_id,omitempty"`
+
+	//The ID of a project (v4 UUID)
+	// example: "8f6a3d52-5c1e-4d0b-9a5e-3a4b7b2f1c90"
+	ProjectID *string `json:"project_id,omitempty"`
+
+	//The rate per hour in the smallest unit of the currency
+	// example: 15000
+	Rate *int64 `json:"rate,omitempty"`
+
+	//The currency of the rate (ISO 4217)
+	// example: USD
+	Currency *string `json:"currency,omitempty"`
+
+	//The time (unix nano) the rate card becomes effective
+	// example: 1653719208000000000
+	EffectiveFrom *int64 `json:"effective_from,omitempty"`
+
+	//The time (unix nano) the rate card is no longer effective
+	// example: 0
+	EffectiveTo *int64 `json:"effective_to,omitempty"`
+}
@@ -0,0 +1,81 @@
+package data
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// swagger:model RateCardSearch
+//RateCardSearch can be used to inclusively search for one or more
+// rate cards
+type RateCardSearch struct {
+	//An array of one or more ids to search for
+	// in:query
+	IDs []string `json:"ids,omitempty"`
+
+	//Set to search for rate cards associated with one or more employees
+	// in:query
+	EmployeeIDs []string `json:"employee_ids,omitempty"`
+
+	//Set to search for rate cards associated with one or more projects
+	// in:query
+	ProjectIDs []string `json:"project_ids,omitempty"`
+
+	//Set to search for rate cards effective at a given time (unix nano)
+	// in:query
+	EffectiveAt *int64 `json:"effective_at,omitempty"`
+}
+
+//ToParams can be used to generate a parameter string from
+// a valid rate card search pointer
+func (r *RateCardSearch) ToParams() string {
+	const (
+		parameterf    string = "%s=%s"
+		parameterIntf string = "%s=%d"
+	)
+	var parameters []string
+	if len(r.IDs) > 0 {
+		parameters = append(parameters,
+			fmt.Sprintf(parameterf, ParameterIDs, strings.Join(r.IDs, ",")))
+	}
+	if len(r.EmployeeIDs) > 0 {
+		parameters = append(parameters,
+			fmt.Sprintf(parameterf, ParameterEmployeeIDs, strings.Join(r.EmployeeIDs, ",")))
+	}
+	if len(r.ProjectIDs) > 0 {
+		parameters = append(parameters,
+			fmt.Sprintf(parameterf, ParameterProjectIDs, strings.Join(r.ProjectIDs, ",")))
+	}
+	if effectiveAt := r.EffectiveAt; effectiveAt != nil {
+		parameters = append(parameters,
+			fmt.Sprintf(parameterIntf, ParameterEffectiveAt, *effectiveAt))
+	}
+	return "?" + strings.Join(parameters, "&")
+}
+
+//FromParams can be used to convert a set of params into a rate card
+// search pointer
+func (r *RateCardSearch) FromParams(params map[string][]string) {
+	for key, value := range params {
+		switch strings.ToLower(key) {
+		case ParameterIDs:
+			for _, value := range value {
+				r.IDs = append(r.IDs, strings.Split(value, ",")...)
+			}
+		case ParameterEmployeeIDs:
+			for _, value := range value {
+				r.EmployeeIDs = append(r.EmployeeIDs, strings.Split(value, ",")...)
+			}
+		case ParameterProjectIDs:
+			for _, value := range value {
+				r.ProjectIDs = append(r.ProjectIDs, strings.Split(value, ",")...)
+			}
+		case ParameterEffectiveAt:
+			if effectiveAt, err := strconv.ParseInt(value[0], 10, 64); err == nil {
+				r.EffectiveAt = new(int64)
+				*r.EffectiveAt = effectiveAt
+			}
+		}
+	}
+}
@@ -4,7 +4,7 @@ package data
 //TimeSlice is the basic unit of "time", the idea is that a task may span over multiple slices that
 // aren't necessarily contiguous, but can be added together to get an altogether time.  This should
 // reduce the overall error when you pause and restart timers (not time slices) from multiple locations
-// time slices can be deleted/archived, but not "edited"
+// time slices can be deleted/archived, but not "edited", they can however be split or merged
 type TimeSlice struct {
 	//Whether or not a timer has been completed
 	// example: true
@@ -65,16 +65,16 @@ func (t *TimeSlice) Contains(tC TimeSlice) bool {
 	}
 	switch {
 	default:
-		//start must be greater than the finish
-		contains := tC.Start <= t.Finish
+		//start must be greater or equal to the finish
+		contains := tC.Start < t.Finish
 		return contains
 	case t.Finish == 0:
 		//finish and start must be less than start
 		contains := tC.Start >= t.Start || tC.Finish >= t.Start
 		return contains
 	case tC.Finish == 0:
-		//start must be greater than finish and start
-		contains := tC.Start <= t.Finish || tC.Start <= t.Start
+		//start must be greater or equal to finish and greater than start
+		contains := tC.Start < t.Finish || tC.Start <= t.Start
 		return contains
 	}
 }
@@ -99,6 +99,12 @@ type TimeSlicePartial struct {
 	//The finish time of the time slice
 	// exmample: 1653720184
 	Finish *int64
+
+	//The expected (current) version of the time slice, if provided
+	// the update will be rejected if the time slice has since been
+	// modified
+	// example: 2
+	Version *int
 }
 
 //TimeSliceByStart implements sort.Interface
@@ -25,6 +25,30 @@ type TimeSliceSearch struct {
 	//Set to search for time slices with one or more ids
 	// in:query
 	IDs []string
+
+	//Set to search for time slices that overlap a range, time slices
+	// that started before finish and are still running (or finished
+	// after start)
+	// in:query
+	Start *int64
+
+	//Set to search for time slices that overlap a range, see start
+	// in:query
+	Finish *int64
+
+	//Set to limit the number of time slices returned
+	// in:query
+	Limit *int
+
+	//Set to the id of the last time slice of the previous page to read
+	// the next page of time slices
+	// in:query
+	Cursor *string
+
+	//Set to sort the time slices by start (then id) ascending (asc) or
+	// descending (desc), defaults to ascending
+	// in:query
+	Sort *string
 }
 
 //ToParams can be used to generate a parameter string from
@@ -34,6 +58,7 @@ func (e *TimeSliceSearch) ToParams() string {
 	const (
 		parameterf     string = "%s=%s"
 		parameterBoolf string = "%s=%t"
+		parameterIntf  string = "%s=%d"
 	)
 	var parameters []string
 	if len(e.IDs) > 0 {
@@ -52,9 +77,25 @@ func (e *TimeSliceSearch) ToParams() string {
 		parameters = append(parameters,
 			fmt.Sprintf(parameterBoolf, ParameterCompleted, *completed))
 	}
-	if archived := e.Completed; archived != nil {
+	if start := e.Start; start != nil {
 		parameters = append(parameters,
-			fmt.Sprintf(parameterBoolf, ParameterArchived, *archived))
+			fmt.Sprintf(parameterIntf, ParameterStart, *start))
+	}
+	if finish := e.Finish; finish != nil {
+		parameters = append(parameters,
+			fmt.Sprintf(parameterIntf, ParameterFinish, *finish))
+	}
+	if limit := e.Limit; limit != nil {
+		parameters = append(parameters,
+			fmt.Sprintf(parameterIntf, ParameterLimit, *limit))
+	}
+	if cursor := e.Cursor; cursor != nil && *cursor != "" {
+		parameters = append(parameters,
+			fmt.Sprintf(parameterf, ParameterCursor, *cursor))
+	}
+	if sort := e.Sort; sort != nil && *sort != "" {
+		parameters = append(parameters,
+			fmt.Sprintf(parameterf, ParameterSort, *sort))
 	}
 	return "?" + strings.Join(parameters, "&")
 }
@@ -72,6 +113,27 @@ func (e *TimeSliceSearch) FromParams(params map[string][]string) {
 				e.Completed = new(bool)
 				*e.Completed = completed
 			}
+		case ParameterStart:
+			if start, err := strconv.ParseInt(value[0], 10, 64); err == nil {
+				e.Start = new(int64)
+				*e.Start = start
+			}
+		case ParameterFinish:
+			if finish, err := strconv.ParseInt(value[0], 10, 64); err == nil {
+				e.Finish = new(int64)
+				*e.Finish = finish
+			}
+		case ParameterLimit:
+			if limit, err := strconv.Atoi(value[0]); err == nil {
+				e.Limit = new(int)
+				*e.Limit = limit
+			}
+		case ParameterCursor:
+			e.Cursor = new(string)
+			*e.Cursor = value[0]
+		case ParameterSort:
+			e.Sort = new(string)
+			*e.Sort = value[0]
 		case ParameterIDs:
 			for _, value := range value {
 				e.IDs = append(e.IDs, strings.Split(value, ",")...)
@@ -33,6 +33,15 @@ type Timer struct {
 	// example: "2e3a4156-b415-4120-982f-399182e99588"
 	EmployeeID string `json:"employee_id"`
 
+	//The ID of the project the timer belongs to (v4 UUID)
+	// example: "8f6a3d52-5c1e-4d0b-9a5e-3a4b7b2f1c90"
+	ProjectID string `json:"project_id"`
+
+	//The ID of the invoice the timer was billed on (v4 UUID), if empty
+	// the timer hasn't been invoiced
+	// example: "0d2b4c9e-7a1f-4e63-b5a2-5f8e9c1d3a77"
+	InvoiceID string `json:"invoice_id"`
+
 	//The ID of the active time slice (v4 UUID)
 	// example: "a33f813e-e9bc-46ad-9956-0c4b6c1367ab"
 	ActiveTimeSliceID string `json:"active_time_slice_id"`
@@ -56,6 +65,11 @@ type Timer struct {
 	//Version is an integer that's atomically incremented each time something i smutated
 	// example: 1
 	Version int `json:"version"`
+
+	//The time (unix nano) the timer was deleted, if non-zero the timer is
+	// in the trash and can be restored until it's purged
+	// example: 1653719229000000000
+	DeletedAt int64 `json:"deleted_at"`
 }
 
 // swagger:model TimerPartial
@@ -74,6 +88,10 @@ type TimerPartial struct {
 	// example: "24b32c23-e3a0-44d1-bdd4-9c370c050b29"
 	EmployeeID *string `json:"employee_id,omitempty"`
 
+	//The ID of the project the timer belongs to (v4 UUID)
+	// example: "8f6a3d52-5c1e-4d0b-9a5e-3a4b7b2f1c90"
+	ProjectID *string `json:"project_id,omitempty"`
+
 	//A comment describing the timer
 	// example: "This is a timer for breakfast"
 	Comment *string `json:"comment,omitempty"`
@@ -81,4 +99,9 @@ type TimerPartial struct {
 	//The finish timer for the timer
 	// example: 1653719229
 	Finish *int64 `json:"finish,omitempty"`
+
+	//The expected (current) version of the timer, if provided the
+	// update will be rejected if the timer has since been modified
+	// example: 2
+	Version *int `json:"version,omitempty"`
 }
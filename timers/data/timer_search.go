@@ -18,6 +18,14 @@ type TimerSearch struct {
 	// in:query
 	EmployeeIDs []string `json:"employee_ids,omitempty"`
 
+	//Set to search for timers associated with a specific project
+	// in:query
+	ProjectID *string `json:"project_id,omitempty"`
+
+	//Set to search for timers associated with one or more projects
+	// in:query
+	ProjectIDs []string `json:"project_ids,omitempty"`
+
 	//Set to search for completed timers only
 	// in:query
 	Completed *bool `json:"completed,omitempty"`
@@ -26,9 +34,43 @@ type TimerSearch struct {
 	// in:query
 	Archived *bool `json:"archived,omitempty"`
 
+	//Set to search for timers that have (or haven't) been invoiced
+	// in:query
+	Invoiced *bool `json:"invoiced,omitempty"`
+
 	//An array of one or more ids to search for
 	// in:query
 	IDs []string `json:"ids,omitempty"`
+
+	//Set to search for timers that overlap a range, timers that started
+	// before finish and haven't been completed (or finished after start),
+	// timers that haven't been started are omitted when start or finish
+	// are set
+	// in:query
+	Start *int64 `json:"start,omitempty"`
+
+	//Set to search for timers that overlap a range, see start
+	// in:query
+	Finish *int64 `json:"finish,omitempty"`
+
+	//Set to limit the number of timers returned
+	// in:query
+	Limit *int `json:"limit,omitempty"`
+
+	//Set to the id of the last timer of the previous page to read the
+	// next page of timers
+	// in:query
+	Cursor *string `json:"cursor,omitempty"`
+
+	//Set to sort the timers by start (then id) ascending (asc) or
+	// descending (desc), defaults to ascending
+	// in:query
+	Sort *string `json:"sort,omitempty"`
+
+	//Set to true to search for deleted timers (the trash) only, deleted
+	// timers are omitted otherwise
+	// in:query
+	Deleted *bool `json:"deleted,omitempty"`
 }
 
 //ToParams can be used to generate a parameter string from
@@ -38,6 +80,7 @@ func (e *TimerSearch) ToParams() string {
 	const (
 		parameterf     string = "%s=%s"
 		parameterBoolf string = "%s=%t"
+		parameterIntf  string = "%s=%d"
 	)
 	var parameters []string
 	if len(e.IDs) > 0 {
@@ -52,14 +95,50 @@ func (e *TimerSearch) ToParams() string {
 		parameters = append(parameters,
 			fmt.Sprintf(parameterf, ParameterEmployeeIDs, strings.Join(e.EmployeeIDs, ",")))
 	}
+	if projectID := e.ProjectID; projectID != nil && *projectID != "" {
+		parameters = append(parameters,
+			fmt.Sprintf(parameterf, ParameterProjectID, *projectID))
+	}
+	if len(e.ProjectIDs) > 0 {
+		parameters = append(parameters,
+			fmt.Sprintf(parameterf, ParameterProjectIDs, strings.Join(e.ProjectIDs, ",")))
+	}
 	if completed := e.Completed; completed != nil {
 		parameters = append(parameters,
 			fmt.Sprintf(parameterBoolf, ParameterCompleted, *completed))
 	}
-	if archived := e.Completed; archived != nil {
+	if archived := e.Archived; archived != nil {
 		parameters = append(parameters,
 			fmt.Sprintf(parameterBoolf, ParameterArchived, *archived))
 	}
+	if invoiced := e.Invoiced; invoiced != nil {
+		parameters = append(parameters,
+			fmt.Sprintf(parameterBoolf, ParameterInvoiced, *invoiced))
+	}
+	if deleted := e.Deleted; deleted != nil {
+		parameters = append(parameters,
+			fmt.Sprintf(parameterBoolf, ParameterDeleted, *deleted))
+	}
+	if start := e.Start; start != nil {
+		parameters = append(parameters,
+			fmt.Sprintf(parameterIntf, ParameterStart, *start))
+	}
+	if finish := e.Finish; finish != nil {
+		parameters = append(parameters,
+			fmt.Sprintf(parameterIntf, ParameterFinish, *finish))
+	}
+	if limit := e.Limit; limit != nil {
+		parameters = append(parameters,
+			fmt.Sprintf(parameterIntf, ParameterLimit, *limit))
+	}
+	if cursor := e.Cursor; cursor != nil && *cursor != "" {
+		parameters = append(parameters,
+			fmt.Sprintf(parameterf, ParameterCursor, *cursor))
+	}
+	if sort := e.Sort; sort != nil && *sort != "" {
+		parameters = append(parameters,
+			fmt.Sprintf(parameterf, ParameterSort, *sort))
+	}
 	return "?" + strings.Join(parameters, "&")
 }
 
@@ -71,6 +150,9 @@ func (e *TimerSearch) FromParams(params map[string][]string) {
 		case ParameterEmployeeID:
 			e.EmployeeID = new(string)
 			*e.EmployeeID = value[0]
+		case ParameterProjectID:
+			e.ProjectID = new(string)
+			*e.ProjectID = value[0]
 		case ParameterCompleted:
 			if completed, err := strconv.ParseBool(value[0]); err == nil {
 				e.Completed = new(bool)
@@ -81,6 +163,37 @@ func (e *TimerSearch) FromParams(params map[string][]string) {
 				e.Archived = new(bool)
 				*e.Archived = archived
 			}
+		case ParameterInvoiced:
+			if invoiced, err := strconv.ParseBool(value[0]); err == nil {
+				e.Invoiced = new(bool)
+				*e.Invoiced = invoiced
+			}
+		case ParameterDeleted:
+			if deleted, err := strconv.ParseBool(value[0]); err == nil {
+				e.Deleted = new(bool)
+				*e.Deleted = deleted
+			}
+		case ParameterStart:
+			if start, err := strconv.ParseInt(value[0], 10, 64); err == nil {
+				e.Start = new(int64)
+				*e.Start = start
+			}
+		case ParameterFinish:
+			if finish, err := strconv.ParseInt(value[0], 10, 64); err == nil {
+				e.Finish = new(int64)
+				*e.Finish = finish
+			}
+		case ParameterLimit:
+			if limit, err := strconv.Atoi(value[0]); err == nil {
+				e.Limit = new(int)
+				*e.Limit = limit
+			}
+		case ParameterCursor:
+			e.Cursor = new(string)
+			*e.Cursor = value[0]
+		case ParameterSort:
+			e.Sort = new(string)
+			*e.Sort = value[0]
 		case ParameterIDs:
 			for _, value := range value {
 				e.IDs = append(e.IDs, strings.Split(value, ",")...)
@@ -89,6 +202,10 @@ func (e *TimerSearch) FromParams(params map[string][]string) {
 			for _, value := range value {
 				e.EmployeeIDs = append(e.EmployeeIDs, strings.Split(value, ",")...)
 			}
+		case ParameterProjectIDs:
+			for _, value := range value {
+				e.ProjectIDs = append(e.ProjectIDs, strings.Split(value, ",")...)
+			}
 		}
 	}
 }
@@ -0,0 +1,61 @@
+package data
+
+// swagger:model Timesheet
+//Timesheet describes the time an employee has spent across all of their
+// timers, totaled per calendar day and per ISO week, time slices that span
+// midnight (in the timezone of the timesheet) are split between the days
+type Timesheet struct {
+	//The ID of the employee (v4 UUID)
+	// example: "2e3a4156-b415-4120-982f-399182e99588"
+	EmployeeID string `json:"employee_id"`
+
+	//The timezone (IANA) used to determine the calendar days/weeks
+	// example: America/Chicago
+	Timezone string `json:"timezone"`
+
+	//The start of the range (unix nano) of the timesheet
+	// example: 1653719208000000000
+	Start int64 `json:"start"`
+
+	//The finish of the range (unix nano) of the timesheet
+	// example: 1654324008000000000
+	Finish int64 `json:"finish"`
+
+	//The total elapsed time (nanoseconds) for the range of the timesheet
+	// example: 28800000000000
+	ElapsedTime int64 `json:"elapsed_time"`
+
+	//The total elapsed time for each calendar day, ordered by date
+	Days []TimesheetDay `json:"days"`
+
+	//The total elapsed time for each ISO week, ordered by year and week
+	Weeks []TimesheetWeek `json:"weeks"`
+}
+
+// swagger:model TimesheetDay
+//TimesheetDay describes the total elapsed time for a calendar day
+type TimesheetDay struct {
+	//The calendar date (YYYY-MM-DD)
+	// example: 2022-05-28
+	Date string `json:"date"`
+
+	//The total elapsed time (nanoseconds) for the day
+	// example: 28800000000000
+	ElapsedTime int64 `json:"elapsed_time"`
+}
+
+// swagger:model TimesheetWeek
+//TimesheetWeek describes the total elapsed time for an ISO week
+type TimesheetWeek struct {
+	//The ISO year of the week
+	// example: 2022
+	Year int `json:"year"`
+
+	//The ISO week (1-53)
+	// example: 21
+	Week int `json:"week"`
+
+	//The total elapsed time (nanoseconds) for the week
+	// example: 144000000000000
+	ElapsedTime int64 `json:"elapsed_time"`
+}
@@ -0,0 +1,84 @@
+package data
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// swagger:model TimesheetSearch
+//TimesheetSearch can be used to generate timesheets for one or more
+// employees over a range of time
+type TimesheetSearch struct {
+	//Set to generate timesheets for one or more employees, if omitted
+	// a timesheet will be generated for every employee with time
+	// in:query
+	EmployeeIDs []string `json:"employee_ids,omitempty"`
+
+	//The start of the range (unix nano), inclusive
+	// in:query
+	Start *int64 `json:"start,omitempty"`
+
+	//The finish of the range (unix nano), exclusive, if omitted
+	// the current time is used
+	// in:query
+	Finish *int64 `json:"finish,omitempty"`
+
+	//The timezone (IANA) used to determine the calendar days/weeks, if
+	// omitted the configured timezone is used
+	// in:query
+	Timezone *string `json:"timezone,omitempty"`
+}
+
+//ToParams can be used to generate a parameter string from
+// a valid timesheet search pointer
+func (t *TimesheetSearch) ToParams() string {
+	const (
+		parameterf    string = "%s=%s"
+		parameterIntf string = "%s=%d"
+	)
+	var parameters []string
+	if len(t.EmployeeIDs) > 0 {
+		parameters = append(parameters,
+			fmt.Sprintf(parameterf, ParameterEmployeeIDs, strings.Join(t.EmployeeIDs, ",")))
+	}
+	if start := t.Start; start != nil {
+		parameters = append(parameters,
+			fmt.Sprintf(parameterIntf, ParameterStart, *start))
+	}
+	if finish := t.Finish; finish != nil {
+		parameters = append(parameters,
+			fmt.Sprintf(parameterIntf, ParameterFinish, *finish))
+	}
+	if timezone := t.Timezone; timezone != nil {
+		parameters = append(parameters,
+			fmt.Sprintf(parameterf, ParameterTimezone, *timezone))
+	}
+	return "?" + strings.Join(parameters, "&")
+}
+
+//FromParams can be used to convert a set of params into a timesheet
+// search pointer
+func (t *TimesheetSearch) FromParams(params map[string][]string) {
+	for key, value := range params {
+		switch strings.ToLower(key) {
+		case ParameterEmployeeIDs:
+			for _, value := range value {
+				t.EmployeeIDs = append(t.EmployeeIDs, strings.Split(value, ",")...)
+			}
+		case ParameterStart:
+			if start, err := strconv.ParseInt(value[0], 10, 64); err == nil {
+				t.Start = new(int64)
+				*t.Start = start
+			}
+		case ParameterFinish:
+			if finish, err := strconv.ParseInt(value[0], 10, 64); err == nil {
+				t.Finish = new(int64)
+				*t.Finish = finish
+			}
+		case ParameterTimezone:
+			t.Timezone = new(string)
+			*t.Timezone = value[0]
+		}
+	}
+}
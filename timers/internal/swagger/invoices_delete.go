@@ -0,0 +1,40 @@
+package swagger
+
+import (
+	"github.com/antonio-alexander/go-bludgeon/internal/errors"
+)
+
+// swagger:route DELETE /invoices/{id} invoices delete_invoices
+// Delete an invoice, the id is required. Timers included in the invoice will be able to be invoiced again.
+//
+//     Consumes:
+//     - application/json
+//
+//     Produces:
+//     - application/json
+//
+//     Schemes: http
+//
+// responses:
+//   204: InvoicesDeleteResponseNoContent
+//   404: InvoicesDeleteResponseNotFound
+
+// When an invoice is successfully deleted, no content is returned
+// swagger:response InvoicesDeleteResponseNoContent
+type InvoicesDeleteResponseNoContent struct {
+	// in:body
+	Body struct{}
+}
+
+// This is the response when you attempt to delete an invoice that doesn't exist
+// swagger:response InvoicesDeleteResponseNotFound
+type InvoicesDeleteResponseNotFound struct {
+	// in:body
+	Body errors.Error
+}
+
+// swagger:parameters delete_invoices
+type InvoicesDeleteParams struct {
+	// in:path
+	ID string `json:"id"`
+}
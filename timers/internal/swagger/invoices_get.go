@@ -0,0 +1,39 @@
+package swagger
+
+import (
+	"github.com/antonio-alexander/go-bludgeon/internal/errors"
+	"github.com/antonio-alexander/go-bludgeon/timers/data"
+)
+
+// swagger:route GET /invoices/{id} invoices read_invoices
+// Read an invoice using its id.
+//
+//     Consumes:
+//     - application/json
+//
+//     Produces:
+//     - application/json
+//
+//     Schemes: http
+//
+// responses:
+//   200: InvoicesGetResponseOk
+//   404: InvoicesGetResponseNotFound
+
+// swagger:response InvoicesGetResponseOk
+type InvoicesGetResponseOk struct {
+	// in:body
+	Body data.Invoice
+}
+
+// swagger:response InvoicesGetResponseNotFound
+type InvoicesGetResponseNotFound struct {
+	// in:body
+	Body errors.Error
+}
+
+// swagger:parameters read_invoices
+type InvoicesGetParams struct {
+	// in:path
+	ID string `json:"id"`
+}
@@ -0,0 +1,67 @@
+package swagger
+
+import (
+	"github.com/antonio-alexander/go-bludgeon/internal/errors"
+	"github.com/antonio-alexander/go-bludgeon/timers/data"
+)
+
+// swagger:route POST /invoices invoices create_invoices
+// Generate an invoice for all completed and non-archived timers that have not been invoiced and finished between the start (inclusive) and finish (exclusive), optionally for a given employee and/or project. Each timer is billed at its elapsed time multiplied by the most specific rate card effective when it finished, included timers are marked as invoiced.
+//
+//     Consumes:
+//     - application/json
+//
+//     Produces:
+//     - application/json
+//
+//     Schemes: http
+//
+// responses:
+//   200: InvoicesPostResponseOK
+//   400: InvoicesPostResponseBadRequest
+//   404: InvoicesPostResponseNotFound
+//   409: InvoicesPostResponseConflict
+//   500: InvoicesPostResponseError
+
+// This is the response when an invoice is successfully created, it will include the line items for each timer that was invoiced as well as audit information.
+// swagger:response InvoicesPostResponseOK
+type InvoicesPostResponseOK struct {
+	// in:body
+	Body data.Invoice
+}
+
+// This is the response when an invoice is created without a valid start/finish, no billable timers are found or the timers are billed in different currencies
+// swagger:response InvoicesPostResponseBadRequest
+type InvoicesPostResponseBadRequest struct {
+	// in:body
+	Body errors.Error
+}
+
+// This is the response when one of the timers was invoiced while the invoice was being generated
+// swagger:response InvoicesPostResponseConflict
+type InvoicesPostResponseConflict struct {
+	// in:body
+	Body errors.Error
+}
+
+// This is the response when no effective rate card can be found for one of the timers
+// swagger:response InvoicesPostResponseNotFound
+type InvoicesPostResponseNotFound struct {
+	// in:body
+	Body errors.Error
+}
+
+// This is the general response when a non-specific error occurs
+// swagger:response InvoicesPostResponseError
+type InvoicesPostResponseError struct {
+	// in:body
+	Body errors.Error
+}
+
+//These parameters must be provided for creation, start and finish are required
+// swagger:parameters create_invoices
+type InvoicesPostParams struct {
+	// This describes the range and (optionally) the employee and project to generate the invoice for.
+	// in: body
+	Body data.InvoicePartial
+}
@@ -0,0 +1,38 @@
+package swagger
+
+import (
+	"github.com/antonio-alexander/go-bludgeon/internal/errors"
+	"github.com/antonio-alexander/go-bludgeon/timers/data"
+)
+
+// swagger:route GET /invoices/search invoices search_invoices
+// Read one or more invoices using search parameters.
+//
+//     Consumes:
+//     - application/json
+//
+//     Produces:
+//     - application/json
+//
+//     Schemes: http
+//
+// responses:
+//   200: InvoicesSearchResponseOk
+//   500: InvoicesSearchResponseError
+
+// swagger:response InvoicesSearchResponseOk
+type InvoicesSearchResponseOk struct {
+	// in:body
+	Body []data.Invoice
+}
+
+// swagger:response InvoicesSearchResponseError
+type InvoicesSearchResponseError struct {
+	// in:body
+	Body errors.Error
+}
+
+// swagger:parameters invoices search_invoices
+type InvoicesSearchParams struct {
+	data.InvoiceSearch
+}
@@ -0,0 +1,40 @@
+package swagger
+
+import (
+	"github.com/antonio-alexander/go-bludgeon/internal/errors"
+)
+
+// swagger:route DELETE /projects/{id} projects delete_projects
+// Delete a project, the id is required. Timers associated with the project will no longer be associated with any project.
+//
+//     Consumes:
+//     - application/json
+//
+//     Produces:
+//     - application/json
+//
+//     Schemes: http
+//
+// responses:
+//   204: ProjectsDeleteResponseNoContent
+//   404: ProjectsDeleteResponseNotFound
+
+// When a project is successfully deleted, no content is returned
+// swagger:response ProjectsDeleteResponseNoContent
+type ProjectsDeleteResponseNoContent struct {
+	// in:body
+	Body struct{}
+}
+
+// This is the response when you attempt to delete a project that doesn't exist
+// swagger:response ProjectsDeleteResponseNotFound
+type ProjectsDeleteResponseNotFound struct {
+	// in:body
+	Body errors.Error
+}
+
+// swagger:parameters delete_projects
+type ProjectsDeleteParams struct {
+	// in:path
+	ID string `json:"id"`
+}
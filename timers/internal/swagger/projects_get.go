@@ -0,0 +1,39 @@
+package swagger
+
+import (
+	"github.com/antonio-alexander/go-bludgeon/internal/errors"
+	"github.com/antonio-alexander/go-bludgeon/timers/data"
+)
+
+// swagger:route GET /projects/{id} projects read_projects
+// Read a project using its id.
+//
+//     Consumes:
+//     - application/json
+//
+//     Produces:
+//     - application/json
+//
+//     Schemes: http
+//
+// responses:
+//   200: ProjectsGetResponseOk
+//   404: ProjectsGetResponseNotFound
+
+// swagger:response ProjectsGetResponseOk
+type ProjectsGetResponseOk struct {
+	// in:body
+	Body data.Project
+}
+
+// swagger:response ProjectsGetResponseNotFound
+type ProjectsGetResponseNotFound struct {
+	// in:body
+	Body errors.Error
+}
+
+// swagger:parameters read_projects
+type ProjectsGetParams struct {
+	// in:path
+	ID string `json:"id"`
+}
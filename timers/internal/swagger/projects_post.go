@@ -0,0 +1,59 @@
+package swagger
+
+import (
+	"github.com/antonio-alexander/go-bludgeon/internal/errors"
+	"github.com/antonio-alexander/go-bludgeon/timers/data"
+)
+
+// swagger:route POST /projects projects create_projects
+// Create a project, the name is required and must be unique.
+//
+//     Consumes:
+//     - application/json
+//
+//     Produces:
+//     - application/json
+//
+//     Schemes: http
+//
+// responses:
+//   200: ProjectsPostResponseOK
+//   400: ProjectsPostResponseBadRequest
+//   409: ProjectsPostResponseConflict
+//   500: ProjectsPostResponseError
+
+// This is the response when a project is successfully created, it will include all items of project that are user-editable as well as other items that are not user editable such as audit information.
+// swagger:response ProjectsPostResponseOK
+type ProjectsPostResponseOK struct {
+	// in:body
+	Body data.Project
+}
+
+// This is the response when a project is created without a name
+// swagger:response ProjectsPostResponseBadRequest
+type ProjectsPostResponseBadRequest struct {
+	// in:body
+	Body errors.Error
+}
+
+// This is the response when a project is created with a name that's already in use
+// swagger:response ProjectsPostResponseConflict
+type ProjectsPostResponseConflict struct {
+	// in:body
+	Body errors.Error
+}
+
+// This is the general response when a non-specific error occurs
+// swagger:response ProjectsPostResponseError
+type ProjectsPostResponseError struct {
+	// in:body
+	Body errors.Error
+}
+
+//These parameters must be provided for creation, name is required
+// swagger:parameters create_projects
+type ProjectsPostParams struct {
+	// This allows you to partially set values for certain properties of a project, the only required parameter (specifically for create) is the name. Any omitted fields will not be set.
+	// in: body
+	Body data.ProjectPartial
+}
@@ -0,0 +1,61 @@
+package swagger
+
+import (
+	"github.com/antonio-alexander/go-bludgeon/internal/errors"
+	"github.com/antonio-alexander/go-bludgeon/timers/data"
+)
+
+// swagger:route PUT /projects/{id} projects update_projects
+// Update a project.
+//
+//     Consumes:
+//     - application/json
+//
+//     Produces:
+//     - application/json
+//
+//     Schemes: http
+//
+// responses:
+//   200: ProjectsPutResponseOK
+//   404: ProjectsPutResponseNotFound
+//   409: ProjectsPutResponseConflict
+//   500: ProjectsPutResponseError
+
+// This is the response when a project is successfully updated
+// swagger:response ProjectsPutResponseOK
+type ProjectsPutResponseOK struct {
+	// in:body
+	Body data.Project
+}
+
+// This is the response when you attempt to update a project that doesn't exist
+// swagger:response ProjectsPutResponseNotFound
+type ProjectsPutResponseNotFound struct {
+	// in:body
+	Body errors.Error
+}
+
+// This is the response when the name of a project is updated to a name that's already in use
+// swagger:response ProjectsPutResponseConflict
+type ProjectsPutResponseConflict struct {
+	// in:body
+	Body errors.Error
+}
+
+// This is the general response when a non-specific error occurs
+// swagger:response ProjectsPutResponseError
+type ProjectsPutResponseError struct {
+	// in:body
+	Body errors.Error
+}
+
+// swagger:parameters update_projects
+type ProjectsPutParams struct {
+	// in:path
+	ID string `json:"id"`
+
+	// This allows you to partially set values for certain properties of a project, any omitted fields will not be updated.
+	// in: body
+	Body data.ProjectPartial
+}
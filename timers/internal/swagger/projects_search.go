@@ -0,0 +1,38 @@
+package swagger
+
+import (
+	"github.com/antonio-alexander/go-bludgeon/internal/errors"
+	"github.com/antonio-alexander/go-bludgeon/timers/data"
+)
+
+// swagger:route GET /projects/search projects search_projects
+// Read one or more projects using search parameters.
+//
+//     Consumes:
+//     - application/json
+//
+//     Produces:
+//     - application/json
+//
+//     Schemes: http
+//
+// responses:
+//   200: ProjectsSearchResponseOk
+//   500: ProjectsSearchResponseError
+
+// swagger:response ProjectsSearchResponseOk
+type ProjectsSearchResponseOk struct {
+	// in:body
+	Body []data.Project
+}
+
+// swagger:response ProjectsSearchResponseError
+type ProjectsSearchResponseError struct {
+	// in:body
+	Body errors.Error
+}
+
+// swagger:parameters projects search_projects
+type ProjectsSearchParams struct {
+	data.ProjectSearch
+}
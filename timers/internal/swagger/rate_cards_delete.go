@@ -0,0 +1,40 @@
+package swagger
+
+import (
+	"github.com/antonio-alexander/go-bludgeon/internal/errors"
+)
+
+// swagger:route DELETE /rate_cards/{id} rate_cards delete_rate_cards
+// Delete a rate card, the id is required. Invoices that used the rate card are not affected.
+//
+//     Consumes:
+//     - application/json
+//
+//     Produces:
+//     - application/json
+//
+//     Schemes: http
+//
+// responses:
+//   204: RateCardsDeleteResponseNoContent
+//   404: RateCardsDeleteResponseNotFound
+
+// When a rate card is successfully deleted, no content is returned
+// swagger:response RateCardsDeleteResponseNoContent
+type RateCardsDeleteResponseNoContent struct {
+	// in:body
+	Body struct{}
+}
+
+// This is the response when you attempt to delete a rate card that doesn't exist
+// swagger:response RateCardsDeleteResponseNotFound
+type RateCardsDeleteResponseNotFound struct {
+	// in:body
+	Body errors.Error
+}
+
+// swagger:parameters delete_rate_cards
+type RateCardsDeleteParams struct {
+	// in:path
+	ID string `json:"id"`
+}
@@ -0,0 +1,39 @@
+package swagger
+
+import (
+	"github.com/antonio-alexander/go-bludgeon/internal/errors"
+	"github.com/antonio-alexander/go-bludgeon/timers/data"
+)
+
+// swagger:route GET /rate_cards/{id} rate_cards read_rate_cards
+// Read a rate card using its id.
+//
+//     Consumes:
+//     - application/json
+//
+//     Produces:
+//     - application/json
+//
+//     Schemes: http
+//
+// responses:
+//   200: RateCardsGetResponseOk
+//   404: RateCardsGetResponseNotFound
+
+// swagger:response RateCardsGetResponseOk
+type RateCardsGetResponseOk struct {
+	// in:body
+	Body data.RateCard
+}
+
+// swagger:response RateCardsGetResponseNotFound
+type RateCardsGetResponseNotFound struct {
+	// in:body
+	Body errors.Error
+}
+
+// swagger:parameters read_rate_cards
+type RateCardsGetParams struct {
+	// in:path
+	ID string `json:"id"`
+}
@@ -0,0 +1,51 @@
+package swagger
+
+import (
+	"github.com/antonio-alexander/go-bludgeon/internal/errors"
+	"github.com/antonio-alexander/go-bludgeon/timers/data"
+)
+
+// swagger:route POST /rate_cards rate_cards create_rate_cards
+// Create a rate card, the rate and currency are required. A rate card without an employee or project applies to all employees or projects respectively.
+//
+//     Consumes:
+//     - application/json
+//
+//     Produces:
+//     - application/json
+//
+//     Schemes: http
+//
+// responses:
+//   200: RateCardsPostResponseOK
+//   400: RateCardsPostResponseBadRequest
+//   500: RateCardsPostResponseError
+
+// This is the response when a rate card is successfully created, it will include all items of rate card that are user-editable as well as other items that are not user editable such as audit information.
+// swagger:response RateCardsPostResponseOK
+type RateCardsPostResponseOK struct {
+	// in:body
+	Body data.RateCard
+}
+
+// This is the response when a rate card is created without a rate or currency
+// swagger:response RateCardsPostResponseBadRequest
+type RateCardsPostResponseBadRequest struct {
+	// in:body
+	Body errors.Error
+}
+
+// This is the general response when a non-specific error occurs
+// swagger:response RateCardsPostResponseError
+type RateCardsPostResponseError struct {
+	// in:body
+	Body errors.Error
+}
+
+//These parameters must be provided for creation, rate and currency are required
+// swagger:parameters create_rate_cards
+type RateCardsPostParams struct {
+	// This allows you to partially set values for certain properties of a rate card, the required parameters (specifically for create) are the rate and currency. Any omitted fields will not be set.
+	// in: body
+	Body data.RateCardPartial
+}
@@ -0,0 +1,53 @@
+package swagger
+
+import (
+	"github.com/antonio-alexander/go-bludgeon/internal/errors"
+	"github.com/antonio-alexander/go-bludgeon/timers/data"
+)
+
+// swagger:route PUT /rate_cards/{id} rate_cards update_rate_cards
+// Update a rate card.
+//
+//     Consumes:
+//     - application/json
+//
+//     Produces:
+//     - application/json
+//
+//     Schemes: http
+//
+// responses:
+//   200: RateCardsPutResponseOK
+//   404: RateCardsPutResponseNotFound
+//   500: RateCardsPutResponseError
+
+// This is the response when a rate card is successfully updated
+// swagger:response RateCardsPutResponseOK
+type RateCardsPutResponseOK struct {
+	// in:body
+	Body data.RateCard
+}
+
+// This is the response when you attempt to update a rate card that doesn't exist
+// swagger:response RateCardsPutResponseNotFound
+type RateCardsPutResponseNotFound struct {
+	// in:body
+	Body errors.Error
+}
+
+// This is the general response when a non-specific error occurs
+// swagger:response RateCardsPutResponseError
+type RateCardsPutResponseError struct {
+	// in:body
+	Body errors.Error
+}
+
+// swagger:parameters update_rate_cards
+type RateCardsPutParams struct {
+	// in:path
+	ID string `json:"id"`
+
+	// This allows you to partially set values for certain properties of a rate card, any omitted fields will not be updated.
+	// in: body
+	Body data.RateCardPartial
+}
@@ -0,0 +1,38 @@
+package swagger
+
+import (
+	"github.com/antonio-alexander/go-bludgeon/internal/errors"
+	"github.com/antonio-alexander/go-bludgeon/timers/data"
+)
+
+// swagger:route GET /rate_cards/search rate_cards search_rate_cards
+// Read one or more rate_cards using search parameters.
+//
+//     Consumes:
+//     - application/json
+//
+//     Produces:
+//     - application/json
+//
+//     Schemes: http
+//
+// responses:
+//   200: RateCardsSearchResponseOk
+//   500: RateCardsSearchResponseError
+
+// swagger:response RateCardsSearchResponseOk
+type RateCardsSearchResponseOk struct {
+	// in:body
+	Body []data.RateCard
+}
+
+// swagger:response RateCardsSearchResponseError
+type RateCardsSearchResponseError struct {
+	// in:body
+	Body errors.Error
+}
+
+// swagger:parameters rate_cards search_rate_cards
+type RateCardsSearchParams struct {
+	data.RateCardSearch
+}
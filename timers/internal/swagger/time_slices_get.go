@@ -22,6 +22,10 @@ import (
 
 // swagger:response TimeSlicesGetResponseOk
 type TimeSlicesGetResponseOk struct {
+	// The version of the time slice, provide it as If-Match when updating
+	// in:header
+	ETag string `json:"ETag"`
+
 	// in:body
 	Body data.TimeSlice
 }
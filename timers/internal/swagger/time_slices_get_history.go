@@ -0,0 +1,39 @@
+package swagger
+
+import (
+	"github.com/antonio-alexander/go-bludgeon/internal/errors"
+	"github.com/antonio-alexander/go-bludgeon/timers/data"
+)
+
+// swagger:route GET /time_slices/{id}/history time_slices read_time_slices_history
+// Read every version of a time slice using its id (oldest first).
+//
+//     Consumes:
+//     - application/json
+//
+//     Produces:
+//     - application/json
+//
+//     Schemes: http
+//
+// responses:
+//   200: TimeSlicesGetHistoryResponseOk
+//   404: TimeSlicesGetHistoryResponseNotFound
+
+// swagger:response TimeSlicesGetHistoryResponseOk
+type TimeSlicesGetHistoryResponseOk struct {
+	// in:body
+	Body []data.TimeSlice
+}
+
+// swagger:response TimeSlicesGetHistoryResponseNotFound
+type TimeSlicesGetHistoryResponseNotFound struct {
+	// in:body
+	Body errors.Error
+}
+
+// swagger:parameters read_timers_history
+type TimeSlicesGetHistoryParams struct {
+	// in:path
+	ID string `json:"id"`
+}
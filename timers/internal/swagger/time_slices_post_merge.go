@@ -0,0 +1,59 @@
+package swagger
+
+import (
+	"github.com/antonio-alexander/go-bludgeon/internal/errors"
+	"github.com/antonio-alexander/go-bludgeon/timers/data"
+)
+
+// swagger:route POST /time_slices/merge time_slices create_time_slices_merge
+// Merge two or more adjacent time slices of the same timer, the latest time slice is kept (and will start at the earliest start) while the others are deleted.
+//
+//     Consumes:
+//     - application/json
+//
+//     Produces:
+//     - application/json
+//
+//     Schemes: http
+//
+// responses:
+//   200: TimeSlicesPostMergeResponseOK
+//   404: TimeSlicesPostMergeResponseNotFound
+//   409: TimeSlicesPostMergeResponseConflict
+//   500: TimeSlicesPostMergeResponseError
+
+// This is the response when time slices are successfully merged, it will include the merged time slice.
+// swagger:response TimeSlicesPostMergeResponseOK
+type TimeSlicesPostMergeResponseOK struct {
+	// in:body
+	Body data.TimeSlice
+}
+
+// This is the response when one of the time slices can't be found
+// swagger:response TimeSlicesPostMergeResponseNotFound
+type TimeSlicesPostMergeResponseNotFound struct {
+	// in:body
+	Body errors.Error
+}
+
+// This is the response when the time slices aren't adjacent or don't share a timer
+// swagger:response TimeSlicesPostMergeResponseConflict
+type TimeSlicesPostMergeResponseConflict struct {
+	// in:body
+	Body errors.Error
+}
+
+// This is the general response when a non-specific error occurs
+// swagger:response TimeSlicesPostMergeResponseError
+type TimeSlicesPostMergeResponseError struct {
+	// in:body
+	Body errors.Error
+}
+
+//These parameters must be provided to merge time slices
+// swagger:parameters create_time_slices_merge
+type TimeSlicesPostMergeParams struct {
+	// This must include the ids (ids) of at least two time slices to merge.
+	// in: body
+	Body data.Contract
+}
@@ -18,11 +18,16 @@ import (
 //
 // responses:
 //   200: TimeSlicesPutResponseOK
+//   409: TimeSlicesPutResponseError
 //   500: TimeSlicesPutResponseError
 
 // This is the response when an timer is successfully updated, it will include all items of timer that are user-editable as well as other items that are not user editable such as audit information and email address which can't be edited post creation.
 // swagger:response TimeSlicesPutResponseOK
 type TimeSlicesPutResponseOK struct {
+	// The version of the updated time slice
+	// in:header
+	ETag string `json:"ETag"`
+
 	// in:body
 	Body data.TimeSlice
 }
@@ -40,6 +45,10 @@ type TimeSlicesPutParams struct {
 	// in:path
 	ID string `json:"id"`
 
+	// The expected (current) version of the time slice, the update is rejected (409) if the time slice has since been modified
+	// in:header
+	IfMatch string `json:"If-Match"`
+
 	// This allows you to partially set values for certain properties of an timer, the only required parameter (specifically for update) is the email address. Any omitted fields (other than email address) will not be set and be null (rather than just empty).
 	// in: body
 	Body data.TimeSlicePartial
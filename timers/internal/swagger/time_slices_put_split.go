@@ -0,0 +1,70 @@
+package swagger
+
+import (
+	"github.com/antonio-alexander/go-bludgeon/internal/errors"
+	"github.com/antonio-alexander/go-bludgeon/timers/data"
+)
+
+// swagger:route PUT /time_slices/{id}/split time_slices update_time_slices_split
+// Split a time slice at a given time, the existing time slice will start at the split time and a new time slice will be created for the time before it.
+//
+//     Consumes:
+//     - application/json
+//
+//     Produces:
+//     - application/json
+//
+//     Schemes: http
+//
+// responses:
+//   200: TimeSlicesPutSplitResponseOK
+//   400: TimeSlicesPutSplitResponseBadRequest
+//   404: TimeSlicesPutSplitResponseNotFound
+//   409: TimeSlicesPutSplitResponseConflict
+//   500: TimeSlicesPutSplitResponseError
+
+// This is the response when a time slice is successfully split, it will include both time slices ordered by start.
+// swagger:response TimeSlicesPutSplitResponseOK
+type TimeSlicesPutSplitResponseOK struct {
+	// in:body
+	Body []data.TimeSlice
+}
+
+// This is the response when the split time is in the future
+// swagger:response TimeSlicesPutSplitResponseBadRequest
+type TimeSlicesPutSplitResponseBadRequest struct {
+	// in:body
+	Body errors.Error
+}
+
+// This is the response when the time slice can't be found
+// swagger:response TimeSlicesPutSplitResponseNotFound
+type TimeSlicesPutSplitResponseNotFound struct {
+	// in:body
+	Body errors.Error
+}
+
+// This is the response when the split time isn't within the time slice
+// swagger:response TimeSlicesPutSplitResponseConflict
+type TimeSlicesPutSplitResponseConflict struct {
+	// in:body
+	Body errors.Error
+}
+
+// This is the general response when a non-specific error occurs
+// swagger:response TimeSlicesPutSplitResponseError
+type TimeSlicesPutSplitResponseError struct {
+	// in:body
+	Body errors.Error
+}
+
+//These parameters must be provided to split a time slice
+// swagger:parameters update_time_slices_split
+type TimeSlicesPutSplitParams struct {
+	// in:path
+	ID string `json:"id"`
+
+	// This must include the split time (split_time) which must be between the start and finish of the time slice.
+	// in: body
+	Body data.Contract
+}
@@ -5,7 +5,7 @@ import (
 )
 
 // swagger:route DELETE /timers/{id} timers delete_timers
-// Delete a timer, the id is required.
+// Delete a timer, the id is required, the timer is moved to the trash and can be restored until it is purged.
 //
 //     Consumes:
 //     - application/json
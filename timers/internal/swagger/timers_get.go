@@ -22,6 +22,10 @@ import (
 
 // swagger:response TimersGetResponseOk
 type TimersGetResponseOk struct {
+	// The version of the timer, provide it as If-Match when updating
+	// in:header
+	ETag string `json:"ETag"`
+
 	// in:body
 	Body data.Timer
 }
@@ -0,0 +1,39 @@
+package swagger
+
+import (
+	"github.com/antonio-alexander/go-bludgeon/internal/errors"
+	"github.com/antonio-alexander/go-bludgeon/timers/data"
+)
+
+// swagger:route GET /timers/{id}/history timers read_timers_history
+// Read every version of a timer using its id (oldest first), start/finish and elapsed time are calculated and not part of the history.
+//
+//     Consumes:
+//     - application/json
+//
+//     Produces:
+//     - application/json
+//
+//     Schemes: http
+//
+// responses:
+//   200: TimersGetHistoryResponseOk
+//   404: TimersGetHistoryResponseNotFound
+
+// swagger:response TimersGetHistoryResponseOk
+type TimersGetHistoryResponseOk struct {
+	// in:body
+	Body []data.Timer
+}
+
+// swagger:response TimersGetHistoryResponseNotFound
+type TimersGetHistoryResponseNotFound struct {
+	// in:body
+	Body errors.Error
+}
+
+// swagger:parameters read_timers_history
+type TimersGetHistoryParams struct {
+	// in:path
+	ID string `json:"id"`
+}
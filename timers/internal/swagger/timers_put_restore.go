@@ -0,0 +1,45 @@
+package swagger
+
+import (
+	"github.com/antonio-alexander/go-bludgeon/internal/errors"
+	"github.com/antonio-alexander/go-bludgeon/timers/data"
+)
+
+// swagger:route PUT /timers/{id}/restore timers restore_timers
+// Restore a deleted timer (from the trash) using its id.
+//
+//     Consumes:
+//     - application/json
+//
+//     Produces:
+//     - application/json
+//
+//     Schemes: http
+//
+// responses:
+//   200: TimersPutRestoreResponseOk
+//   404: TimersPutRestoreResponseNotFound
+
+// swagger:response TimersPutRestoreResponseOk
+type TimersPutRestoreResponseOk struct {
+	// The version of the Timer, provide it as If-Match when updating
+	// in:header
+	ETag string `json:"ETag"`
+
+	// in:body
+	Body data.Timer
+}
+
+// This is the response when you attempt to restore a timer that doesn't exist
+// or hasn't been deleted
+// swagger:response TimersPutRestoreResponseNotFound
+type TimersPutRestoreResponseNotFound struct {
+	// in:body
+	Body errors.Error
+}
+
+// swagger:parameters restore_timers
+type TimersPutRestoreParams struct {
+	// in:path
+	ID string `json:"id"`
+}
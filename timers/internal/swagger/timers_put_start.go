@@ -18,6 +18,8 @@ import (
 //
 // responses:
 //   200: TimersPutStartResponseOK
+//   400: TimersPutStartResponseBadRequest
+//   409: TimersPutStartResponseConflict
 //   500: TimersPutStartResponseError
 
 // This is the response when an timer is successfully updated, it will include all items of timer that are user-editable as well as other items that are not user editable such as audit information and email address which can't be edited post creation.
@@ -27,6 +29,20 @@ type TimersPutStartResponseOK struct {
 	Body data.Timer
 }
 
+// This is the response when the provided start time is in the future
+// swagger:response TimersPutStartResponseBadRequest
+type TimersPutStartResponseBadRequest struct {
+	// in:body
+	Body errors.Error
+}
+
+// This is the response when the provided start time conflicts with the existing time slices of the timer
+// swagger:response TimersPutStartResponseConflict
+type TimersPutStartResponseConflict struct {
+	// in:body
+	Body errors.Error
+}
+
 // This is the general response when a non-specific error occurs
 // swagger:response TimersPutStartResponseError
 type TimersPutStartResponseError struct {
@@ -40,7 +56,7 @@ type TimersPutStartParams struct {
 	// in:path
 	ID string `json:"id"`
 
-	// This allows you to partially set values for certain properties of an timer, the only required parameter (specifically for update) is the email address. Any omitted fields (other than email address) will not be set and be null (rather than just empty).
+	// This allows you to optionally provide a start time (start_time) in the past, if omitted the current time will be used.
 	// in: body
-	Body data.TimerPartial
+	Body data.Contract
 }
@@ -0,0 +1,39 @@
+package swagger
+
+import (
+	"github.com/antonio-alexander/go-bludgeon/internal/errors"
+	"github.com/antonio-alexander/go-bludgeon/timers/data"
+)
+
+// swagger:route GET /timesheets timesheets read_timesheets
+// Read the time spent per employee per calendar day and ISO week.
+//
+//     Consumes:
+//     - application/json
+//
+//     Produces:
+//     - application/json
+//
+//     Schemes: http
+//
+// responses:
+//   200: TimesheetsGetResponseOk
+//   400: TimesheetsGetResponseError
+//   500: TimesheetsGetResponseError
+
+// swagger:response TimesheetsGetResponseOk
+type TimesheetsGetResponseOk struct {
+	// in:body
+	Body []data.Timesheet
+}
+
+// swagger:response TimesheetsGetResponseError
+type TimesheetsGetResponseError struct {
+	// in:body
+	Body errors.Error
+}
+
+// swagger:parameters timesheets read_timesheets
+type TimesheetsGetParams struct {
+	data.TimesheetSearch
+}
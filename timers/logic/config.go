@@ -13,6 +13,26 @@ const (
 	ChangeRateReadLessOrEqualToZero         string = "change read rate less or equal to zero"
 	ChangesTimeoutReadLessOrEqualToZero     string = "changes timeout is less or equal to zero"
 	ChangesRegistrationIdEmpty              string = "changes registration id empty"
+	TimesheetTimezoneInvalid                string = "timesheet timezone invalid"
+	TimesheetRangeInvalid                   string = "timesheet range invalid, finish less than or equal to start"
+	IdleTimerThresholdLessThanZero          string = "idle timer threshold less than zero"
+	IdleTimerRateLessOrEqualToZero          string = "idle timer rate less or equal to zero"
+	IdleTimerPolicyInvalid                  string = "idle timer policy invalid"
+	TimerTimeInFuture                       string = "timer start or finish time is in the future"
+	TimeSliceSplitInFuture                  string = "time slice split time is in the future"
+	TrashRetentionLessThanZero              string = "trash retention less than zero"
+	TrashPurgeRateLessOrEqualToZero         string = "trash purge rate less or equal to zero"
+	OutboxRelayRateLessOrEqualToZero        string = "outbox relay rate less or equal to zero"
+	OutboxRetryMinLessOrEqualToZero         string = "outbox retry minimum less or equal to zero"
+	OutboxRetryMaxLessThanMin               string = "outbox retry maximum less than minimum"
+	OutboxBacklogThresholdLessThanZero      string = "outbox backlog threshold less than zero"
+	OutboxBacklogExceeded                   string = "outbox backlog exceeds threshold"
+	OutboxChangeNotConfirmed                string = "outbox change not confirmed by the changes service"
+)
+
+const (
+	IdleTimerPolicyThreshold    string = "threshold"
+	IdleTimerPolicyLastActivity string = "last_activity"
 )
 
 const (
@@ -20,16 +40,36 @@ const (
 	EnvNameChangeRateRead         string = "BLUDGEON_CHANGE_READ_RATE"
 	EnvNameChangesTimeout         string = "BLUDGEON_CHANGE_TIMEOUT"
 	EnvNameChangesRegistrationId  string = "BLUDGEON_CHANGE_REGISTRATION_ID"
+	EnvNameTimesheetTimezone      string = "BLUDGEON_TIMESHEET_TIMEZONE"
+	EnvNameIdleTimerThreshold     string = "BLUDGEON_IDLE_TIMER_THRESHOLD"
+	EnvNameIdleTimerRate          string = "BLUDGEON_IDLE_TIMER_RATE"
+	EnvNameIdleTimerPolicy        string = "BLUDGEON_IDLE_TIMER_POLICY"
+	EnvNameTrashRetention         string = "BLUDGEON_TRASH_RETENTION"
+	EnvNameTrashPurgeRate         string = "BLUDGEON_TRASH_PURGE_RATE"
+	EnvNameOutboxRelayRate        string = "BLUDGEON_OUTBOX_RELAY_RATE"
+	EnvNameOutboxRetryMin         string = "BLUDGEON_OUTBOX_RETRY_MIN"
+	EnvNameOutboxRetryMax         string = "BLUDGEON_OUTBOX_RETRY_MAX"
+	EnvNameOutboxBacklogThreshold string = "BLUDGEON_OUTBOX_BACKLOG_THRESHOLD"
 )
 
 const (
 	DefaultChangeRateRegistration time.Duration = time.Second
 	DefaultChangeRateRead         time.Duration = 10 * time.Second
 	DefaultChangesTimeout         time.Duration = 10 * time.Second
+	DefaultIdleTimerThreshold     time.Duration = 0
+	DefaultIdleTimerRate          time.Duration = time.Minute
+	DefaultTrashRetention         time.Duration = 30 * 24 * time.Hour
+	DefaultTrashPurgeRate         time.Duration = time.Hour
+	DefaultOutboxRelayRate        time.Duration = 10 * time.Second
+	DefaultOutboxRetryMin         time.Duration = time.Second
+	DefaultOutboxRetryMax         time.Duration = time.Minute
 )
 
 var (
-	DefaultChangesRegistrationId = data.ServiceName
+	DefaultChangesRegistrationId  = data.ServiceName
+	DefaultTimesheetTimezone      = "UTC"
+	DefaultIdleTimerPolicy        = IdleTimerPolicyThreshold
+	DefaultOutboxBacklogThreshold = 1000
 )
 
 var (
@@ -37,6 +77,21 @@ var (
 	ErrChangeRateReadLessOrEqualToZero         = errors.New(ChangeRateReadLessOrEqualToZero)
 	ErrChangesTimeoutLessOrEqualToZero         = errors.New(ChangesTimeoutReadLessOrEqualToZero)
 	ErrChangesRegistrationIdEmpty              = errors.New(ChangesRegistrationIdEmpty)
+	ErrTimesheetTimezoneInvalid                = errors.New(TimesheetTimezoneInvalid)
+	ErrTimesheetRangeInvalid                   = errors.New(TimesheetRangeInvalid)
+	ErrIdleTimerThresholdLessThanZero          = errors.New(IdleTimerThresholdLessThanZero)
+	ErrIdleTimerRateLessOrEqualToZero          = errors.New(IdleTimerRateLessOrEqualToZero)
+	ErrIdleTimerPolicyInvalid                  = errors.New(IdleTimerPolicyInvalid)
+	ErrTimerTimeInFuture                       = errors.New(TimerTimeInFuture)
+	ErrTimeSliceSplitInFuture                  = errors.New(TimeSliceSplitInFuture)
+	ErrTrashRetentionLessThanZero              = errors.New(TrashRetentionLessThanZero)
+	ErrTrashPurgeRateLessOrEqualToZero         = errors.New(TrashPurgeRateLessOrEqualToZero)
+	ErrOutboxRelayRateLessOrEqualToZero        = errors.New(OutboxRelayRateLessOrEqualToZero)
+	ErrOutboxRetryMinLessOrEqualToZero         = errors.New(OutboxRetryMinLessOrEqualToZero)
+	ErrOutboxRetryMaxLessThanMin               = errors.New(OutboxRetryMaxLessThanMin)
+	ErrOutboxChangeNotConfirmed                = errors.New(OutboxChangeNotConfirmed)
+	ErrOutboxBacklogThresholdLessThanZero      = errors.New(OutboxBacklogThresholdLessThanZero)
+	ErrOutboxBacklogExceeded                   = errors.New(OutboxBacklogExceeded)
 )
 
 type Configuration struct {
@@ -44,6 +99,16 @@ type Configuration struct {
 	ChangeRateRead         time.Duration `json:"rate_change_read"`
 	ChangesTimeout         time.Duration `json:"changes_timeout"`
 	ChangesRegistrationId  string        `json:"changes_registration_id"`
+	TimesheetTimezone      string        `json:"timesheet_timezone"`
+	IdleTimerThreshold     time.Duration `json:"idle_timer_threshold"` //zero disables the watchdog
+	IdleTimerRate          time.Duration `json:"idle_timer_rate"`
+	IdleTimerPolicy        string        `json:"idle_timer_policy"`
+	TrashRetention         time.Duration `json:"trash_retention"` //zero disables the purge job
+	TrashPurgeRate         time.Duration `json:"trash_purge_rate"`
+	OutboxRelayRate        time.Duration `json:"outbox_relay_rate"`
+	OutboxRetryMin         time.Duration `json:"outbox_retry_min"`
+	OutboxRetryMax         time.Duration `json:"outbox_retry_max"`
+	OutboxBacklogThreshold int           `json:"outbox_backlog_threshold"` //zero disables the healthcheck
 }
 
 func (c *Configuration) Default() {
@@ -51,6 +116,16 @@ func (c *Configuration) Default() {
 	c.ChangeRateRead = DefaultChangeRateRead
 	c.ChangesTimeout = DefaultChangesTimeout
 	c.ChangesRegistrationId = DefaultChangesRegistrationId
+	c.TimesheetTimezone = DefaultTimesheetTimezone
+	c.IdleTimerThreshold = DefaultIdleTimerThreshold
+	c.IdleTimerRate = DefaultIdleTimerRate
+	c.IdleTimerPolicy = DefaultIdleTimerPolicy
+	c.TrashRetention = DefaultTrashRetention
+	c.TrashPurgeRate = DefaultTrashPurgeRate
+	c.OutboxRelayRate = DefaultOutboxRelayRate
+	c.OutboxRetryMin = DefaultOutboxRetryMin
+	c.OutboxRetryMax = DefaultOutboxRetryMax
+	c.OutboxBacklogThreshold = DefaultOutboxBacklogThreshold
 }
 
 func (c *Configuration) Validate() (err error) {
@@ -66,6 +141,40 @@ func (c *Configuration) Validate() (err error) {
 	if c.ChangesRegistrationId == "" {
 		return ErrChangesRegistrationIdEmpty
 	}
+	if _, err := time.LoadLocation(c.TimesheetTimezone); err != nil {
+		return ErrTimesheetTimezoneInvalid
+	}
+	if c.IdleTimerThreshold < 0 {
+		return ErrIdleTimerThresholdLessThanZero
+	}
+	if c.IdleTimerThreshold > 0 {
+		if c.IdleTimerRate <= 0 {
+			return ErrIdleTimerRateLessOrEqualToZero
+		}
+		switch c.IdleTimerPolicy {
+		default:
+			return ErrIdleTimerPolicyInvalid
+		case IdleTimerPolicyThreshold, IdleTimerPolicyLastActivity:
+		}
+	}
+	if c.TrashRetention < 0 {
+		return ErrTrashRetentionLessThanZero
+	}
+	if c.TrashRetention > 0 && c.TrashPurgeRate <= 0 {
+		return ErrTrashPurgeRateLessOrEqualToZero
+	}
+	if c.OutboxRelayRate <= 0 {
+		return ErrOutboxRelayRateLessOrEqualToZero
+	}
+	if c.OutboxRetryMin <= 0 {
+		return ErrOutboxRetryMinLessOrEqualToZero
+	}
+	if c.OutboxRetryMax < c.OutboxRetryMin {
+		return ErrOutboxRetryMaxLessThanMin
+	}
+	if c.OutboxBacklogThreshold < 0 {
+		return ErrOutboxBacklogThresholdLessThanZero
+	}
 	return
 }
 
@@ -85,4 +194,42 @@ func (c *Configuration) FromEnv(envs map[string]string) {
 	if s, ok := envs[EnvNameChangesRegistrationId]; ok && s != "" {
 		c.ChangesRegistrationId = s
 	}
+	if s, ok := envs[EnvNameTimesheetTimezone]; ok && s != "" {
+		c.TimesheetTimezone = s
+	}
+	if s, ok := envs[EnvNameIdleTimerThreshold]; ok && s != "" {
+		i, _ := strconv.ParseInt(s, 10, 64)
+		c.IdleTimerThreshold = time.Duration(i) * time.Second
+	}
+	if s, ok := envs[EnvNameIdleTimerRate]; ok && s != "" {
+		i, _ := strconv.ParseInt(s, 10, 64)
+		c.IdleTimerRate = time.Duration(i) * time.Second
+	}
+	if s, ok := envs[EnvNameIdleTimerPolicy]; ok && s != "" {
+		c.IdleTimerPolicy = s
+	}
+	if s, ok := envs[EnvNameTrashRetention]; ok && s != "" {
+		i, _ := strconv.ParseInt(s, 10, 64)
+		c.TrashRetention = time.Duration(i) * time.Second
+	}
+	if s, ok := envs[EnvNameTrashPurgeRate]; ok && s != "" {
+		i, _ := strconv.ParseInt(s, 10, 64)
+		c.TrashPurgeRate = time.Duration(i) * time.Second
+	}
+	if s, ok := envs[EnvNameOutboxRelayRate]; ok && s != "" {
+		i, _ := strconv.ParseInt(s, 10, 64)
+		c.OutboxRelayRate = time.Duration(i) * time.Second
+	}
+	if s, ok := envs[EnvNameOutboxRetryMin]; ok && s != "" {
+		i, _ := strconv.ParseInt(s, 10, 64)
+		c.OutboxRetryMin = time.Duration(i) * time.Second
+	}
+	if s, ok := envs[EnvNameOutboxRetryMax]; ok && s != "" {
+		i, _ := strconv.ParseInt(s, 10, 64)
+		c.OutboxRetryMax = time.Duration(i) * time.Second
+	}
+	if s, ok := envs[EnvNameOutboxBacklogThreshold]; ok && s != "" {
+		i, _ := strconv.Atoi(s)
+		c.OutboxBacklogThreshold = i
+	}
 }
@@ -0,0 +1,311 @@
+package logic
+
+import (
+	"encoding/json"
+	"errors"
+	"math"
+	"sort"
+	"time"
+
+	data "github.com/antonio-alexander/go-bludgeon/timers/data"
+
+	changesdata "github.com/antonio-alexander/go-bludgeon/changes/data"
+
+	internal_errors "github.com/antonio-alexander/go-bludgeon/internal/errors"
+)
+
+const (
+	timesheetDateFormat string = "2006-01-02"
+	outboxReadLimit     int    = 100
+)
+
+// rateCardFind will return the most specific rate card that applies
+// to the employee/project of the timer and was effective when the
+// timer finished, if no rate card applies, nil is returned
+func rateCardFind(rateCards []*data.RateCard, timer *data.Timer) *data.RateCard {
+	var found *data.RateCard
+
+	for _, rateCard := range rateCards {
+		if !rateCard.Applies(timer.EmployeeID, timer.ProjectID) ||
+			!rateCard.EffectiveAt(timer.Finish) {
+			continue
+		}
+		switch {
+		case found == nil:
+			found = rateCard
+		case rateCard.Specificity() > found.Specificity():
+			found = rateCard
+		case rateCard.Specificity() == found.Specificity() &&
+			rateCard.EffectiveFrom > found.EffectiveFrom:
+			//KIM: if two rate cards are equally specific, the one
+			// that became effective most recently wins
+			found = rateCard
+		}
+	}
+	return found
+}
+
+// timersWithoutRateCard will return the ids (sorted) of the timers
+// that no rate card applies to (see rateCardFind)
+func timersWithoutRateCard(rateCards []*data.RateCard, timers []*data.Timer) []string {
+	var timerIDs []string
+
+	for _, timer := range timers {
+		if rateCardFind(rateCards, timer) == nil {
+			timerIDs = append(timerIDs, timer.ID)
+		}
+	}
+	sort.Strings(timerIDs)
+	return timerIDs
+}
+
+// invoiceAmount will calculate the amount billed for the elapsed
+// time (nanoseconds) given a rate per hour, the amount is rounded
+// to the nearest unit of currency
+func invoiceAmount(elapsedTime, rate int64) int64 {
+	return int64(math.Round(float64(elapsedTime) / float64(time.Hour) * float64(rate)))
+}
+
+// timesheetsCompute will total the elapsed time of the time slices per
+// employee per calendar day and ISO week (in the provided location), time
+// slices are clipped to the start/finish and active time slices are assumed
+// to finish now; time slices that span midnight are split between the days
+func timesheetsCompute(timers []*data.Timer, timeSlices []*data.TimeSlice, employeeIDs []string,
+	location *time.Location, start, finish, now int64) []*data.Timesheet {
+	type isoWeek struct{ year, week int }
+	type timesheet struct {
+		elapsedTime int64
+		days        map[string]int64
+		weeks       map[isoWeek]int64
+	}
+
+	timesheets := make(map[string]*timesheet)
+	timesheetFx := func(employeeID string) *timesheet {
+		t, ok := timesheets[employeeID]
+		if !ok {
+			t = &timesheet{
+				days:  make(map[string]int64),
+				weeks: make(map[isoWeek]int64),
+			}
+			timesheets[employeeID] = t
+		}
+		return t
+	}
+	for _, employeeID := range employeeIDs {
+		timesheetFx(employeeID)
+	}
+	timerEmployeeIDs := make(map[string]string)
+	for _, timer := range timers {
+		timerEmployeeIDs[timer.ID] = timer.EmployeeID
+	}
+	for _, timeSlice := range timeSlices {
+		employeeID, ok := timerEmployeeIDs[timeSlice.TimerID]
+		if !ok {
+			continue
+		}
+		sliceStart, sliceFinish := timeSlice.Start, timeSlice.Finish
+		if sliceFinish <= 0 {
+			sliceFinish = now
+		}
+		if sliceStart < start {
+			sliceStart = start
+		}
+		if sliceFinish > finish {
+			sliceFinish = finish
+		}
+		if sliceFinish <= sliceStart {
+			continue
+		}
+		t := timesheetFx(employeeID)
+		for sliceStart < sliceFinish {
+			tStart := time.Unix(0, sliceStart).In(location)
+			year, month, day := tStart.Date()
+			//KIM: midnight is calculated using the calendar rather than by
+			// adding 24 hours so that days with DST transitions are handled
+			end := time.Date(year, month, day+1, 0, 0, 0, 0, location).UnixNano()
+			if end > sliceFinish {
+				end = sliceFinish
+			}
+			elapsedTime := end - sliceStart
+			isoYear, isoWeekNumber := tStart.ISOWeek()
+			t.days[tStart.Format(timesheetDateFormat)] += elapsedTime
+			t.weeks[isoWeek{isoYear, isoWeekNumber}] += elapsedTime
+			t.elapsedTime += elapsedTime
+			sliceStart = end
+		}
+	}
+	output := make([]*data.Timesheet, 0, len(timesheets))
+	for employeeID, t := range timesheets {
+		timesheet := &data.Timesheet{
+			EmployeeID:  employeeID,
+			Timezone:    location.String(),
+			Start:       start,
+			Finish:      finish,
+			ElapsedTime: t.elapsedTime,
+			Days:        []data.TimesheetDay{},
+			Weeks:       []data.TimesheetWeek{},
+		}
+		for date, elapsedTime := range t.days {
+			timesheet.Days = append(timesheet.Days, data.TimesheetDay{
+				Date:        date,
+				ElapsedTime: elapsedTime,
+			})
+		}
+		sort.Slice(timesheet.Days, func(i, j int) bool {
+			return timesheet.Days[i].Date < timesheet.Days[j].Date
+		})
+		for week, elapsedTime := range t.weeks {
+			timesheet.Weeks = append(timesheet.Weeks, data.TimesheetWeek{
+				Year:        week.year,
+				Week:        week.week,
+				ElapsedTime: elapsedTime,
+			})
+		}
+		sort.Slice(timesheet.Weeks, func(i, j int) bool {
+			if timesheet.Weeks[i].Year != timesheet.Weeks[j].Year {
+				return timesheet.Weeks[i].Year < timesheet.Weeks[j].Year
+			}
+			return timesheet.Weeks[i].Week < timesheet.Weeks[j].Week
+		})
+		output = append(output, timesheet)
+	}
+	sort.Slice(output, func(i, j int) bool {
+		return output[i].EmployeeID < output[j].EmployeeID
+	})
+	return output
+}
+
+// idleTimerStopAt can be used to determine if a timer's active time slice
+// has been idle longer than the threshold and when it should be stopped
+// according to the policy; threshold stops at start+threshold while last
+// activity stops at the last time the timer or its active time slice was
+// mutated (e.g. its comment was updated) once it's been idle for the
+// threshold since
+func idleTimerStopAt(policy string, threshold time.Duration, timer *data.Timer, timeSlice *data.TimeSlice, now int64) (int64, bool) {
+	if threshold <= 0 || timeSlice.Finish > 0 {
+		return 0, false
+	}
+	stopAt := timeSlice.Start
+	if policy == IdleTimerPolicyLastActivity {
+		//KIM: a time slice can't finish at (or before) its start and
+		// time slices are stored with microsecond precision (mysql)
+		stopAt += int64(time.Microsecond)
+		for _, lastUpdated := range []int64{timer.LastUpdated, timeSlice.LastUpdated} {
+			if lastUpdated > stopAt {
+				stopAt = lastUpdated
+			}
+		}
+		if now <= stopAt+int64(threshold) {
+			return 0, false
+		}
+		return stopAt, true
+	}
+	stopAt += int64(threshold)
+	if now <= stopAt {
+		return 0, false
+	}
+	return stopAt, true
+}
+
+// outboxChangeFromPartial can be used to convert a change partial into
+// an outbox change, optional fields that aren't set are left empty
+func outboxChangeFromPartial(changePartial changesdata.ChangePartial) (data.OutboxChange, error) {
+	var change data.OutboxChange
+
+	if changePartial.WhenChanged != nil {
+		change.WhenChanged = *changePartial.WhenChanged
+	}
+	if changePartial.ChangedBy != nil {
+		change.ChangedBy = *changePartial.ChangedBy
+	}
+	if changePartial.DataId != nil {
+		change.DataId = *changePartial.DataId
+	}
+	if changePartial.DataType != nil {
+		change.DataType = *changePartial.DataType
+	}
+	if changePartial.DataAction != nil {
+		change.DataAction = *changePartial.DataAction
+	}
+	if changePartial.DataVersion != nil {
+		change.DataVersion = *changePartial.DataVersion
+	}
+	if changePartial.Payload != nil {
+		bytes, err := changePartial.Payload.MarshalBinary()
+		if err != nil {
+			return data.OutboxChange{}, err
+		}
+		change.Payload = bytes
+	}
+	return change, nil
+}
+
+// outboxChangeToPartial can be used to convert an outbox change into
+// a change partial, empty optional fields are omitted
+func outboxChangeToPartial(change *data.OutboxChange) (changesdata.ChangePartial, error) {
+	changePartial := changesdata.ChangePartial{
+		DataId:          &change.DataId,
+		DataServiceName: &data.ServiceName,
+		DataType:        &change.DataType,
+		DataAction:      &change.DataAction,
+	}
+	if change.WhenChanged > 0 {
+		changePartial.WhenChanged = &change.WhenChanged
+	}
+	if change.ChangedBy != "" {
+		changePartial.ChangedBy = &change.ChangedBy
+	}
+	if change.DataVersion > 0 {
+		changePartial.DataVersion = &change.DataVersion
+	}
+	if len(change.Payload) > 0 {
+		changePartial.Payload = new(changesdata.ChangePayload)
+		if err := changePartial.Payload.UnmarshalBinary(change.Payload); err != nil {
+			return changesdata.ChangePartial{}, err
+		}
+	}
+	return changePartial, nil
+}
+
+// employeeDeletedAt can be used to determine when an employee was deleted
+// using the payload of the change that restored it (the value of deleted_at
+// before it was restored), it returns false if it can't be determined
+func employeeDeletedAt(change *changesdata.Change) (int64, bool) {
+	var deletedAt int64
+
+	if change.Payload == nil {
+		return 0, false
+	}
+	for _, diff := range change.Payload.Diff {
+		if diff.Field != "deleted_at" {
+			continue
+		}
+		if err := json.Unmarshal(diff.Before, &deletedAt); err != nil || deletedAt <= 0 {
+			return 0, false
+		}
+		return deletedAt, true
+	}
+	return 0, false
+}
+
+// changeConflict can be used to determine if the changes service rejected
+// a change because it already exists (i.e. it was delivered more than once)
+func changeConflict(err error) bool {
+	var e interface{ Type() string }
+
+	return errors.As(err, &e) && e.Type() == internal_errors.ErrTypeConflict
+}
+
+// outboxBackoff can be used to determine how long to wait before
+// attempting to relay the outbox again, the backoff starts at the
+// minimum and doubles with each consecutive failure up to the maximum
+func outboxBackoff(backoff, min, max time.Duration) time.Duration {
+	switch {
+	case backoff < min:
+		return min
+	case backoff*2 > max:
+		return max
+	default:
+		return backoff * 2
+	}
+}
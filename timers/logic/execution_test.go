@@ -0,0 +1,417 @@
+package logic
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	data "github.com/antonio-alexander/go-bludgeon/timers/data"
+	meta "github.com/antonio-alexander/go-bludgeon/timers/meta"
+	metamemory "github.com/antonio-alexander/go-bludgeon/timers/meta/memory"
+
+	changesclient "github.com/antonio-alexander/go-bludgeon/changes/client"
+	changesdata "github.com/antonio-alexander/go-bludgeon/changes/data"
+	employeesdata "github.com/antonio-alexander/go-bludgeon/employees/data"
+
+	internal_errors "github.com/antonio-alexander/go-bludgeon/internal/errors"
+	logger "github.com/antonio-alexander/go-bludgeon/internal/logger"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// outboxTest is an outbox that can fail to acknowledge changes
+type outboxTest struct {
+	meta.Outbox
+	acknowledgeFailures int
+}
+
+func (o *outboxTest) OutboxAcknowledge(ctx context.Context, id string) error {
+	if o.acknowledgeFailures > 0 {
+		o.acknowledgeFailures--
+		return errors.New("acknowledge failed")
+	}
+	return o.Outbox.OutboxAcknowledge(ctx, id)
+}
+
+// changesClientTest is a changes client that behaves like the changes
+// service: a change that's upserted more than once is a conflict, it
+// can also queue changes (without confirming them)
+type changesClientTest struct {
+	changesclient.Client
+	changes map[string]*changesdata.Change
+	queue   bool
+}
+
+func (c *changesClientTest) ChangeUpsert(ctx context.Context, changePartial changesdata.ChangePartial) (*changesdata.Change, error) {
+	if c.queue {
+		return &changesdata.Change{DataId: *changePartial.DataId}, nil
+	}
+	key := fmt.Sprintf("%s:%s:%s:%d", *changePartial.DataId, *changePartial.DataType,
+		*changePartial.DataAction, *changePartial.DataVersion)
+	if _, ok := c.changes[key]; ok {
+		return nil, internal_errors.NewConflict(errors.New("cannot write change"))
+	}
+	change := &changesdata.Change{
+		Id:          fmt.Sprint(len(c.changes) + 1),
+		DataId:      *changePartial.DataId,
+		DataType:    *changePartial.DataType,
+		DataAction:  *changePartial.DataAction,
+		DataVersion: *changePartial.DataVersion,
+	}
+	c.changes[key] = change
+	return change, nil
+}
+
+func TestTimesheetsCompute(t *testing.T) {
+	location, err := time.LoadLocation("America/Chicago")
+	if !assert.Nil(t, err) {
+		return
+	}
+	timers := []*data.Timer{
+		{ID: "timer_a", EmployeeID: "employee_a"},
+		{ID: "timer_b", EmployeeID: "employee_b"},
+	}
+	start := time.Date(2026, time.March, 1, 0, 0, 0, 0, location).UnixNano()
+	finish := time.Date(2026, time.March, 31, 0, 0, 0, 0, location).UnixNano()
+
+	t.Run("Split At Midnight", func(t *testing.T) {
+		//sunday 22:00 to monday 02:00 spans both a day and an ISO week
+		timeSlices := []*data.TimeSlice{{
+			TimerID: "timer_a",
+			Start:   time.Date(2026, time.March, 1, 22, 0, 0, 0, location).UnixNano(),
+			Finish:  time.Date(2026, time.March, 2, 2, 0, 0, 0, location).UnixNano(),
+		}}
+		timesheets := timesheetsCompute(timers, timeSlices, nil, location, start, finish, finish)
+		if !assert.Len(t, timesheets, 1) {
+			return
+		}
+		timesheet := timesheets[0]
+		assert.Equal(t, "employee_a", timesheet.EmployeeID)
+		assert.Equal(t, "America/Chicago", timesheet.Timezone)
+		assert.Equal(t, int64(4*time.Hour), timesheet.ElapsedTime)
+		assert.Equal(t, []data.TimesheetDay{
+			{Date: "2026-03-01", ElapsedTime: int64(2 * time.Hour)},
+			{Date: "2026-03-02", ElapsedTime: int64(2 * time.Hour)},
+		}, timesheet.Days)
+		assert.Equal(t, []data.TimesheetWeek{
+			{Year: 2026, Week: 9, ElapsedTime: int64(2 * time.Hour)},
+			{Year: 2026, Week: 10, ElapsedTime: int64(2 * time.Hour)},
+		}, timesheet.Weeks)
+	})
+	t.Run("Daylight Savings", func(t *testing.T) {
+		//the 8th of march 2026 is only 23 hours long in chicago
+		timeSlices := []*data.TimeSlice{{
+			TimerID: "timer_a",
+			Start:   time.Date(2026, time.March, 7, 23, 0, 0, 0, location).UnixNano(),
+			Finish:  time.Date(2026, time.March, 9, 1, 0, 0, 0, location).UnixNano(),
+		}}
+		timesheets := timesheetsCompute(timers, timeSlices, nil, location, start, finish, finish)
+		if !assert.Len(t, timesheets, 1) {
+			return
+		}
+		assert.Equal(t, []data.TimesheetDay{
+			{Date: "2026-03-07", ElapsedTime: int64(time.Hour)},
+			{Date: "2026-03-08", ElapsedTime: int64(23 * time.Hour)},
+			{Date: "2026-03-09", ElapsedTime: int64(time.Hour)},
+		}, timesheets[0].Days)
+	})
+	t.Run("Clipped And Active", func(t *testing.T) {
+		now := time.Date(2026, time.March, 31, 3, 0, 0, 0, location).UnixNano()
+		timeSlices := []*data.TimeSlice{
+			{
+				TimerID: "timer_a",
+				Start:   time.Date(2026, time.February, 28, 23, 0, 0, 0, location).UnixNano(),
+				Finish:  time.Date(2026, time.March, 1, 1, 0, 0, 0, location).UnixNano(),
+			},
+			{
+				TimerID: "timer_b",
+				Start:   time.Date(2026, time.March, 30, 23, 0, 0, 0, location).UnixNano(),
+			},
+			{
+				TimerID: "timer_unknown",
+				Start:   time.Date(2026, time.March, 2, 0, 0, 0, 0, location).UnixNano(),
+				Finish:  time.Date(2026, time.March, 2, 1, 0, 0, 0, location).UnixNano(),
+			},
+		}
+		timesheets := timesheetsCompute(timers, timeSlices, []string{"employee_a", "employee_b", "employee_c"},
+			location, start, finish, now)
+		if !assert.Len(t, timesheets, 3) {
+			return
+		}
+		assert.Equal(t, "employee_a", timesheets[0].EmployeeID)
+		assert.Equal(t, int64(time.Hour), timesheets[0].ElapsedTime)
+		assert.Equal(t, "employee_b", timesheets[1].EmployeeID)
+		assert.Equal(t, int64(time.Hour), timesheets[1].ElapsedTime)
+		assert.Equal(t, "employee_c", timesheets[2].EmployeeID)
+		assert.Zero(t, timesheets[2].ElapsedTime)
+		assert.Empty(t, timesheets[2].Days)
+	})
+}
+
+func TestTimersWithoutRateCard(t *testing.T) {
+	finish := time.Date(2026, time.March, 2, 17, 0, 0, 0, time.UTC).UnixNano()
+	rateCards := []*data.RateCard{
+		{ID: "rate_card_project_a", ProjectID: "project_a", Rate: 10000, Currency: "USD"},
+		{ID: "rate_card_employee_b", EmployeeID: "employee_b", Rate: 12000, Currency: "USD",
+			EffectiveTo: finish},
+	}
+	timers := []*data.Timer{
+		{ID: "timer_c", EmployeeID: "employee_a", ProjectID: "project_b", Finish: finish},
+		{ID: "timer_a", EmployeeID: "employee_a", ProjectID: "project_a", Finish: finish},
+		{ID: "timer_b", EmployeeID: "employee_b", ProjectID: "project_b", Finish: finish},
+	}
+
+	//KIM: the rate card of employee b isn't effective when timer b
+	// finished, so only timer a has a rate card
+	assert.Equal(t, []string{"timer_b", "timer_c"}, timersWithoutRateCard(rateCards, timers))
+	assert.Empty(t, timersWithoutRateCard(rateCards, timers[1:2]))
+}
+
+func TestIdleTimerStopAt(t *testing.T) {
+	start := time.Date(2026, time.March, 2, 9, 0, 0, 0, time.UTC)
+	timer := &data.Timer{
+		ID:          "timer_a",
+		LastUpdated: start.UnixNano(),
+	}
+	timeSlice := &data.TimeSlice{
+		TimerID:     "timer_a",
+		Start:       start.UnixNano(),
+		LastUpdated: start.UnixNano(),
+	}
+	threshold := 8 * time.Hour
+
+	t.Run("Not Idle", func(t *testing.T) {
+		now := start.Add(4 * time.Hour).UnixNano()
+		_, idle := idleTimerStopAt(IdleTimerPolicyThreshold, threshold, timer, timeSlice, now)
+		assert.False(t, idle)
+	})
+	t.Run("Disabled", func(t *testing.T) {
+		now := start.Add(24 * time.Hour).UnixNano()
+		_, idle := idleTimerStopAt(IdleTimerPolicyThreshold, 0, timer, timeSlice, now)
+		assert.False(t, idle)
+	})
+	t.Run("Threshold", func(t *testing.T) {
+		now := start.Add(24 * time.Hour).UnixNano()
+		stopAt, idle := idleTimerStopAt(IdleTimerPolicyThreshold, threshold, timer, timeSlice, now)
+		assert.True(t, idle)
+		assert.Equal(t, start.Add(threshold).UnixNano(), stopAt)
+	})
+	t.Run("Last Activity", func(t *testing.T) {
+		//KIM: the timer was updated (e.g. its comment) while the time
+		// slice was active
+		timer := &data.Timer{
+			ID:          "timer_a",
+			LastUpdated: start.Add(6 * time.Hour).UnixNano(),
+		}
+		now := start.Add(12 * time.Hour).UnixNano()
+		_, idle := idleTimerStopAt(IdleTimerPolicyLastActivity, threshold, timer, timeSlice, now)
+		assert.False(t, idle)
+		now = start.Add(24 * time.Hour).UnixNano()
+		stopAt, idle := idleTimerStopAt(IdleTimerPolicyLastActivity, threshold, timer, timeSlice, now)
+		assert.True(t, idle)
+		assert.Equal(t, timer.LastUpdated, stopAt)
+	})
+	t.Run("Last Activity At Start", func(t *testing.T) {
+		//KIM: a timer that hasn't been mutated since it was started (or
+		// was backdated) is stopped just after its start
+		timer := &data.Timer{
+			ID:          "timer_a",
+			LastUpdated: start.Add(-time.Hour).UnixNano(),
+		}
+		now := start.Add(24 * time.Hour).UnixNano()
+		stopAt, idle := idleTimerStopAt(IdleTimerPolicyLastActivity, threshold, timer, timeSlice, now)
+		assert.True(t, idle)
+		assert.Greater(t, stopAt, timeSlice.Start)
+		assert.Less(t, stopAt, start.Add(time.Millisecond).UnixNano())
+	})
+}
+
+func TestOutboxBackoff(t *testing.T) {
+	min, max := time.Second, 10*time.Second
+
+	backoff := outboxBackoff(0, min, max)
+	assert.Equal(t, min, backoff)
+	backoff = outboxBackoff(backoff, min, max)
+	assert.Equal(t, 2*time.Second, backoff)
+	backoff = outboxBackoff(backoff, min, max)
+	assert.Equal(t, 4*time.Second, backoff)
+	backoff = outboxBackoff(backoff, min, max)
+	assert.Equal(t, 8*time.Second, backoff)
+	backoff = outboxBackoff(backoff, min, max)
+	assert.Equal(t, max, backoff)
+	backoff = outboxBackoff(backoff, min, max)
+	assert.Equal(t, max, backoff)
+}
+
+func TestOutboxChangePartial(t *testing.T) {
+	t.Run("Update", func(t *testing.T) {
+		id, whenChanged, changedBy, version := "timer_a", time.Now().UnixNano(), "bludgeon_meta_memory", 2
+		payload, err := changesdata.NewChangePayload(&data.Timer{ID: id, Comment: "before"}, &data.Timer{ID: id, Comment: "after"})
+		assert.Nil(t, err)
+		change, err := outboxChangeFromPartial(changesdata.ChangePartial{
+			WhenChanged: &whenChanged,
+			ChangedBy:   &changedBy,
+			DataId:      &id,
+			DataType:    &data.ChangeTypeTimer,
+			DataAction:  &data.ChangeActionUpdate,
+			DataVersion: &version,
+			Payload:     payload,
+		})
+		assert.Nil(t, err)
+		changePartial, err := outboxChangeToPartial(&change)
+		assert.Nil(t, err)
+		if assert.NotNil(t, changePartial.WhenChanged) {
+			assert.Equal(t, whenChanged, *changePartial.WhenChanged)
+		}
+		if assert.NotNil(t, changePartial.ChangedBy) {
+			assert.Equal(t, changedBy, *changePartial.ChangedBy)
+		}
+		if assert.NotNil(t, changePartial.DataVersion) {
+			assert.Equal(t, version, *changePartial.DataVersion)
+		}
+		assert.Equal(t, data.ServiceName, *changePartial.DataServiceName)
+		assert.Equal(t, data.ChangeActionUpdate, *changePartial.DataAction)
+		if assert.NotNil(t, changePartial.Payload) {
+			assert.Equal(t, []string{"comment"}, changePartial.Payload.Fields())
+			assert.JSONEq(t, string(payload.Snapshot), string(changePartial.Payload.Snapshot))
+		}
+	})
+	t.Run("Delete", func(t *testing.T) {
+		id := "timer_a"
+		payload, err := changesdata.NewChangePayload(&data.Timer{ID: id}, (*data.Timer)(nil))
+		assert.Nil(t, err)
+		change, err := outboxChangeFromPartial(changesdata.ChangePartial{
+			DataId:     &id,
+			DataType:   &data.ChangeTypeTimer,
+			DataAction: &data.ChangeActionDelete,
+			Payload:    payload,
+		})
+		assert.Nil(t, err)
+		changePartial, err := outboxChangeToPartial(&change)
+		assert.Nil(t, err)
+		assert.Nil(t, changePartial.WhenChanged)
+		assert.Nil(t, changePartial.ChangedBy)
+		assert.Nil(t, changePartial.DataVersion)
+		assert.Equal(t, id, *changePartial.DataId)
+		if assert.NotNil(t, changePartial.Payload) {
+			assert.Contains(t, string(changePartial.Payload.Snapshot), id)
+		}
+	})
+}
+
+func TestEmployeeDeletedAt(t *testing.T) {
+	deletedAt := time.Now().UnixNano()
+	employeeDeleted := &employeesdata.Employee{ID: "employee_a", DeletedAt: deletedAt, Version: 2}
+	employeeRestored := &employeesdata.Employee{ID: "employee_a", Version: 3}
+	payload, err := changesdata.NewChangePayload(employeeDeleted, employeeRestored)
+	assert.Nil(t, err)
+
+	//the deletion time is the value of deleted_at before the restore
+	deletedAtRead, ok := employeeDeletedAt(&changesdata.Change{Payload: payload})
+	assert.True(t, ok)
+	assert.Equal(t, deletedAt, deletedAtRead)
+
+	//the deletion time can't be determined without a payload
+	_, ok = employeeDeletedAt(&changesdata.Change{})
+	assert.False(t, ok)
+}
+
+func TestOutboxRelay(t *testing.T) {
+	ctx := context.TODO()
+	outbox := &outboxTest{Outbox: metamemory.New()}
+	changesClient := &changesClientTest{changes: make(map[string]*changesdata.Change)}
+	l := &logic{
+		Logger:        logger.NewNullLogger(),
+		outbox:        outbox,
+		changesClient: changesClient,
+		config:        &Configuration{ChangesTimeout: time.Second},
+	}
+
+	//enqueue a delete, its version identifies it if it's delivered
+	// more than once
+	id, version := "timer_a", 2
+	change, err := outboxChangeFromPartial(changesdata.ChangePartial{
+		DataId:      &id,
+		DataType:    &data.ChangeTypeTimer,
+		DataAction:  &data.ChangeActionDelete,
+		DataVersion: &version,
+	})
+	assert.Nil(t, err)
+	_, err = outbox.OutboxEnqueue(ctx, change)
+	assert.Nil(t, err)
+
+	//relay outbox (change queued by the client), the change wasn't
+	// confirmed so it isn't acknowledged
+	changesClient.queue = true
+	err = l.relayOutbox()
+	assert.ErrorIs(t, err, ErrOutboxChangeNotConfirmed)
+	backlog, err := outbox.OutboxBacklog(ctx)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, backlog)
+	changesClient.queue = false
+
+	//relay outbox (acknowledge fails once), the change is delivered
+	// but remains in the outbox
+	outbox.acknowledgeFailures = 1
+	err = l.relayOutbox()
+	assert.NotNil(t, err)
+	backlog, err = outbox.OutboxBacklog(ctx)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, backlog)
+	assert.Len(t, changesClient.changes, 1)
+
+	//relay outbox, the change was already delivered (conflict) so
+	// it's acknowledged rather than stalling the outbox
+	err = l.relayOutbox()
+	assert.Nil(t, err)
+	backlog, err = outbox.OutboxBacklog(ctx)
+	assert.Nil(t, err)
+	assert.Zero(t, backlog)
+	assert.Len(t, changesClient.changes, 1)
+}
+
+func TestProjectDelete(t *testing.T) {
+	ctx := context.TODO()
+	m := metamemory.New()
+	l := &logic{
+		Logger:  logger.NewNullLogger(),
+		Timer:   m,
+		Project: m,
+		outbox:  m,
+		config:  &Configuration{},
+	}
+
+	//create project and a timer for it
+	name := "project_a"
+	project, err := l.ProjectCreate(ctx, data.ProjectPartial{Name: &name})
+	assert.Nil(t, err)
+	timer, err := l.TimerCreate(ctx, data.TimerPartial{ProjectID: &project.ID})
+	assert.Nil(t, err)
+	changes, err := m.OutboxRead(ctx, 0)
+	assert.Nil(t, err)
+	for _, change := range changes {
+		assert.Nil(t, m.OutboxAcknowledge(ctx, change.ID))
+	}
+
+	//delete project, the timer is updated (its project is removed)
+	// and a change is enqueued for it
+	err = l.ProjectDelete(ctx, project.ID)
+	assert.Nil(t, err)
+	timerRead, err := l.TimerRead(ctx, timer.ID)
+	assert.Nil(t, err)
+	assert.Empty(t, timerRead.ProjectID)
+	assert.Greater(t, timerRead.Version, timer.Version)
+	changes, err = m.OutboxRead(ctx, 0)
+	assert.Nil(t, err)
+	if assert.Len(t, changes, 2) {
+		assert.Equal(t, timer.ID, changes[0].DataId)
+		assert.Equal(t, data.ChangeTypeTimer, changes[0].DataType)
+		assert.Equal(t, data.ChangeActionUpdate, changes[0].DataAction)
+		assert.Equal(t, timerRead.Version, changes[0].DataVersion)
+		assert.Equal(t, project.ID, changes[1].DataId)
+		assert.Equal(t, data.ChangeTypeProject, changes[1].DataType)
+		assert.Equal(t, data.ChangeActionDelete, changes[1].DataAction)
+	}
+}
@@ -2,6 +2,9 @@ package logic
 
 import (
 	"context"
+	"fmt"
+	"sort"
+	"strings"
 	"sync"
 	"time"
 
@@ -25,6 +28,12 @@ type logic struct {
 	logger.Logger
 	meta.Timer
 	meta.TimeSlice
+	meta.Project
+	meta.RateCard
+	meta.Invoice
+	purger         meta.Purger
+	outbox         meta.Outbox
+	outboxSignal   chan struct{}
 	stopper        chan struct{}
 	changesClient  changesclient.Client
 	changesHandler changesclient.Handler
@@ -43,23 +52,124 @@ func New() interface {
 	internal.Parameterizer
 	internal.Configurer
 } {
-	return &logic{Logger: logger.NewNullLogger()}
+	return &logic{
+		Logger:       logger.NewNullLogger(),
+		outboxSignal: make(chan struct{}, 1),
+	}
 }
 
-func (l *logic) changeUpsert(changePartial changesdata.ChangePartial) {
+// changeUpsert will write the change (with a payload generated from the
+// data before and after the mutation) to the outbox, it must be called
+// within an outbox transaction (see outboxTransaction) so the change is
+// written alongside the mutation, the relay is responsible for delivering
+// it to the changes service (and retrying until it succeeds)
+func (l *logic) changeUpsert(ctx context.Context, before, after interface{}, changePartial changesdata.ChangePartial) error {
+	changePayload, err := changesdata.NewChangePayload(before, after)
+	if err != nil {
+		return err
+	}
+	changePartial.Payload = changePayload
+	outboxChange, err := outboxChangeFromPartial(changePartial)
+	if err != nil {
+		return err
+	}
+	change, err := l.outbox.OutboxEnqueue(ctx, outboxChange)
+	if err != nil {
+		return err
+	}
+	l.Trace("Enqueued change: %s (%s:%s->%s)", change.ID, change.DataType, change.DataId, change.DataAction)
+	return nil
+}
+
+// outboxTransaction will execute the function within an outbox transaction
+// (the mutations and the changes enqueued are written together or not at
+// all) and signal the relay once it's complete
+func (l *logic) outboxTransaction(ctx context.Context, fx func(ctx context.Context) error) error {
+	if err := l.outbox.OutboxTransaction(ctx, fx); err != nil {
+		return err
+	}
+	select {
+	default:
+	case l.outboxSignal <- struct{}{}:
+	}
+	return nil
+}
+
+// relayOutbox will attempt to deliver the changes in the outbox (oldest
+// first) until it's empty, changes are only removed from the outbox once
+// the changes service has confirmed them, so delivery is at least once
+func (l *logic) relayOutbox() error {
+	for {
+		changes, err := l.outbox.OutboxRead(context.Background(), outboxReadLimit)
+		if err != nil {
+			return err
+		}
+		if len(changes) == 0 {
+			return nil
+		}
+		for _, change := range changes {
+			changePartial, err := outboxChangeToPartial(change)
+			if err != nil {
+				return err
+			}
+			ctx, cancel := context.WithTimeout(context.Background(), l.config.ChangesTimeout)
+			changeUpserted, err := l.changesClient.ChangeUpsert(ctx, changePartial)
+			cancel()
+			switch {
+			case err != nil && !changeConflict(err):
+				return err
+			case err != nil:
+				//KIM: the change has already been upserted (e.g. the
+				// acknowledgement failed after it was delivered)
+				l.Debug("Change already upserted: %s (%s:%s->%s)", change.ID, change.DataType,
+					change.DataId, change.DataAction)
+			case changeUpserted == nil || changeUpserted.Id == "":
+				//KIM: a change without an id wasn't confirmed by the changes
+				// service (e.g. it was queued by the client), it can't be
+				// acknowledged until it has been
+				return ErrOutboxChangeNotConfirmed
+			default:
+				l.Debug("Upserted change: %s (%s:%s->%s)", changeUpserted.Id, changeUpserted.DataType,
+					changeUpserted.DataId, changeUpserted.DataAction)
+			}
+			if err := l.outbox.OutboxAcknowledge(context.Background(), change.ID); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+func (l *logic) launchOutboxRelay() {
+	started := make(chan struct{})
 	l.Add(1)
 	go func() {
 		defer l.Done()
 
-		ctx, cancel := context.WithTimeout(context.Background(), l.config.ChangesTimeout)
-		defer cancel()
-		change, err := l.changesClient.ChangeUpsert(ctx, changePartial)
-		if err != nil {
-			l.Error("error while upserting change (%s:%s->%s): %s", *changePartial.DataType, *changePartial.DataId, *changePartial.DataAction, err)
-			return
+		var backoff time.Duration
+
+		close(started)
+		for {
+			//KIM: while backing off, signals are ignored so that
+			// new changes don't hammer an unavailable changes service
+			signal, wait := l.outboxSignal, l.config.OutboxRelayRate
+			if backoff > 0 {
+				signal, wait = nil, backoff
+			}
+			select {
+			case <-l.stopper:
+				return
+			case <-signal:
+			case <-time.After(wait):
+			}
+			if err := l.relayOutbox(); err != nil {
+				backoff = outboxBackoff(backoff, l.config.OutboxRetryMin, l.config.OutboxRetryMax)
+				l.Error("error while relaying outbox (retrying in %v): %s", backoff, err)
+				continue
+			}
+			backoff = 0
 		}
-		l.Debug("Upserted change: %s (%s:%s->%s)", change.Id, change.DataType, change.DataId, change.DataAction)
 	}()
+	<-started
 }
 func (l *logic) registrationChangeAcknowledge(serviceName string, changeIds ...string) {
 	if len(changeIds) <= 0 {
@@ -103,6 +213,42 @@ func (l *logic) handleChanges(changes ...*changesdata.Change) error {
 				break
 			}
 			changesToAcknowledge = append(changesToAcknowledge, change.Id)
+		case change.DataType == employeesdata.ChangeTypeEmployee &&
+			change.DataAction == employeesdata.ChangeActionRestore:
+			//KIM: only the timers deleted because the employee was deleted
+			// (on or after the employee was deleted) are restored, timers
+			// that were deleted before the employee remain in the trash
+			deletedAt, ok := employeeDeletedAt(change)
+			if !ok {
+				l.Error("error while restoring timers: unable to determine when employee %s was deleted", change.DataId)
+				changesToAcknowledge = append(changesToAcknowledge, change.Id)
+				break
+			}
+			deleted := true
+			timers, err := l.TimersRead(context.Background(), data.TimerSearch{
+				EmployeeID: &change.DataId,
+				Deleted:    &deleted,
+			})
+			if err != nil {
+				l.Error("error while reading timers: %s", err)
+				break
+			}
+			failure := false
+			for _, timer := range timers {
+				if timer.DeletedAt < deletedAt {
+					continue
+				}
+				if _, err := l.TimerRestore(context.Background(), timer.ID); err != nil {
+					l.Error("error while restoring timers: %s", err)
+					failure = true
+					break
+				}
+				l.Debug("Restored timer: %s, due to restoration of employee: %s ", timer.ID, change.DataId)
+			}
+			if failure {
+				break
+			}
+			changesToAcknowledge = append(changesToAcknowledge, change.Id)
 		}
 	}
 	l.registrationChangeAcknowledge(l.config.ChangesRegistrationId, changesToAcknowledge...)
@@ -145,6 +291,141 @@ func (l *logic) launchChangeHandler() {
 	<-started
 }
 
+func (l *logic) stopIdleTimers() {
+	completed := false
+	ctx := context.Background()
+	timers, err := l.Timer.TimersRead(ctx, data.TimerSearch{Completed: &completed})
+	if err != nil {
+		l.Error("error while reading timers: %s", err)
+		return
+	}
+	for _, timer := range timers {
+		if timer.ActiveTimeSliceID == "" {
+			continue
+		}
+		timeSlice, err := l.TimeSlice.TimeSliceRead(ctx, timer.ActiveTimeSliceID)
+		if err != nil {
+			l.Error("error while reading time slice: %s", err)
+			continue
+		}
+		stopAt, idle := idleTimerStopAt(l.config.IdleTimerPolicy, l.config.IdleTimerThreshold,
+			timer, timeSlice, time.Now().UnixNano())
+		if !idle {
+			continue
+		}
+		var timerStopped *data.Timer
+		if err := l.outboxTransaction(ctx, func(ctx context.Context) error {
+			var err error
+
+			if timerStopped, err = l.Timer.TimerStop(ctx, timer.ID, stopAt); err != nil {
+				return err
+			}
+			return l.changeUpsert(ctx, timer, timerStopped, changesdata.ChangePartial{
+				WhenChanged:     &timerStopped.LastUpdated,
+				ChangedBy:       &timerStopped.LastUpdatedBy,
+				DataId:          &timerStopped.ID,
+				DataServiceName: &data.ServiceName,
+				DataType:        &data.ChangeTypeTimer,
+				DataAction:      &data.ChangeActionIdleStop,
+				DataVersion:     &timerStopped.Version,
+			})
+		}); err != nil {
+			l.Error("error while stopping idle timer: %s", err)
+			continue
+		}
+		l.Debug("Stopped idle timer: %s (%s)", timerStopped.ID, time.Unix(0, stopAt))
+	}
+}
+
+func (l *logic) launchIdleTimerWatchdog() {
+	if l.config.IdleTimerThreshold <= 0 {
+		return
+	}
+	started := make(chan struct{})
+	l.Add(1)
+	go func() {
+		defer l.Done()
+
+		tCheck := time.NewTicker(l.config.IdleTimerRate)
+		defer tCheck.Stop()
+		close(started)
+		for {
+			select {
+			case <-l.stopper:
+				return
+			case <-tCheck.C:
+				l.stopIdleTimers()
+			}
+		}
+	}()
+	<-started
+}
+
+func (l *logic) purgeTimers() {
+	var timers []*data.Timer
+
+	deletedBefore := time.Now().Add(-l.config.TrashRetention).UnixNano()
+	if err := l.outboxTransaction(context.Background(), func(ctx context.Context) error {
+		var err error
+
+		if timers, err = l.purger.TimersPurge(ctx, deletedBefore); err != nil {
+			return err
+		}
+		for _, timer := range timers {
+			tNow := time.Now().UnixNano()
+			if err := l.changeUpsert(ctx, timer, nil, changesdata.ChangePartial{
+				WhenChanged:     &tNow,
+				DataId:          &timer.ID,
+				DataVersion:     &timer.Version,
+				DataServiceName: &data.ServiceName,
+				DataType:        &data.ChangeTypeTimer,
+				DataAction:      &data.ChangeActionPurge,
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}); err != nil {
+		l.Error("error while purging timers: %s", err)
+		return
+	}
+	for _, timer := range timers {
+		l.Debug("Purged timer: %s", timer.ID)
+	}
+}
+
+func (l *logic) launchTrashPurger() {
+	if l.config.TrashRetention <= 0 {
+		return
+	}
+	started := make(chan struct{})
+	l.Add(1)
+	go func() {
+		defer l.Done()
+
+		tPurge := time.NewTicker(l.config.TrashPurgeRate)
+		defer tPurge.Stop()
+		close(started)
+		for {
+			select {
+			case <-l.stopper:
+				return
+			case <-tPurge.C:
+				l.purgeTimers()
+			}
+		}
+	}()
+	<-started
+}
+
+// changesFilter is used to only register for (and handle) the changes
+// that timers reacts to: employees that have been deleted or restored
+var changesFilter = changesdata.RegistrationFilter{
+	ServiceNames: []string{employeesdata.ServiceName},
+	Types:        []string{employeesdata.ChangeTypeEmployee},
+	Actions:      []string{employeesdata.ChangeActionDelete, employeesdata.ChangeActionRestore},
+}
+
 func (l *logic) launchChangeRegistration() {
 	started := make(chan struct{})
 	l.Add(1)
@@ -163,7 +444,9 @@ func (l *logic) launchChangeRegistration() {
 				return
 			case <-tRegister.C:
 				if !handlerSet {
-					if l.handlerId, err = l.changesHandler.HandlerCreate(l.handleChanges); err != nil {
+					if l.handlerId, err = l.changesHandler.HandlerCreate(l.handleChanges,
+						changesclient.HandlerFilter(changesFilter),
+						changesclient.HandlerRegistrationId(l.config.ChangesRegistrationId)); err != nil {
 						l.Error("error while creating change handler: %s", err)
 						break
 					}
@@ -173,7 +456,8 @@ func (l *logic) launchChangeRegistration() {
 				if !registered {
 					ctx, cancel := context.WithTimeout(context.Background(), l.config.ChangesTimeout)
 					defer cancel()
-					if err := l.changesClient.RegistrationUpsert(ctx, l.config.ChangesRegistrationId); err != nil {
+					if err := l.changesClient.RegistrationUpsert(ctx, l.config.ChangesRegistrationId,
+						changesclient.RegistrationFilter(changesFilter)); err != nil {
 						l.Error("error while upserting change registration: %s", err)
 						break
 					}
@@ -192,6 +476,30 @@ func (l *logic) launchChangeRegistration() {
 func (l *logic) SetParameters(parameters ...interface{}) {
 	for _, parameter := range parameters {
 		switch p := parameter.(type) {
+		case interface {
+			meta.Timer
+			meta.TimeSlice
+			meta.Project
+			meta.RateCard
+			meta.Invoice
+			meta.Purger
+			meta.Outbox
+		}:
+			l.Timer = p
+			l.TimeSlice = p
+			l.Project = p
+			l.RateCard = p
+			l.Invoice = p
+			l.purger = p
+			l.outbox = p
+		case interface {
+			meta.Timer
+			meta.TimeSlice
+			meta.Project
+		}:
+			l.Timer = p
+			l.TimeSlice = p
+			l.Project = p
 		case interface {
 			meta.Timer
 			meta.TimeSlice
@@ -202,6 +510,16 @@ func (l *logic) SetParameters(parameters ...interface{}) {
 			l.Timer = p
 		case meta.TimeSlice:
 			l.TimeSlice = p
+		case meta.Project:
+			l.Project = p
+		case meta.RateCard:
+			l.RateCard = p
+		case meta.Invoice:
+			l.Invoice = p
+		case meta.Purger:
+			l.purger = p
+		case meta.Outbox:
+			l.outbox = p
 		case interface {
 			changesclient.Handler
 			changesclient.Client
@@ -223,6 +541,16 @@ func (l *logic) SetParameters(parameters ...interface{}) {
 		panic("no meta found for time slice")
 	case l.Timer == nil:
 		panic("no meta found for timer")
+	case l.Project == nil:
+		panic("no meta found for project")
+	case l.RateCard == nil:
+		panic("no meta found for rate card")
+	case l.Invoice == nil:
+		panic("no meta found for invoice")
+	case l.purger == nil:
+		panic("no meta found for purger")
+	case l.outbox == nil:
+		panic("no meta found for outbox")
 	}
 }
 
@@ -272,6 +600,15 @@ func (l *logic) Initialize() error {
 	l.stopper = make(chan struct{})
 	l.launchChangeHandler()
 	l.launchChangeRegistration()
+	l.launchIdleTimerWatchdog()
+	l.launchTrashPurger()
+	l.launchOutboxRelay()
+	//KIM: changes left in the outbox (e.g. from before a restart)
+	// are relayed immediately rather than at the next tick
+	select {
+	default:
+	case l.outboxSignal <- struct{}{}:
+	}
 	l.initialized = true
 	return nil
 }
@@ -309,121 +646,676 @@ func (l *logic) IsConnected() bool {
 // all fields are available, the only fields that will
 // actually be set are: timer_id and comment
 func (l *logic) TimerCreate(ctx context.Context, timerPartial data.TimerPartial) (*data.Timer, error) {
-	timer, err := l.Timer.TimerCreate(ctx, timerPartial)
-	if err != nil {
+	var timer *data.Timer
+
+	if err := l.outboxTransaction(ctx, func(ctx context.Context) error {
+		var err error
+
+		if timer, err = l.Timer.TimerCreate(ctx, timerPartial); err != nil {
+			return err
+		}
+		return l.changeUpsert(ctx, nil, timer, changesdata.ChangePartial{
+			WhenChanged:     &timer.LastUpdated,
+			ChangedBy:       &timer.LastUpdatedBy,
+			DataId:          &timer.ID,
+			DataServiceName: &data.ServiceName,
+			DataType:        &data.ChangeTypeTimer,
+			DataAction:      &data.ChangeActionCreate,
+			DataVersion:     &timer.Version,
+		})
+	}); err != nil {
 		return nil, err
 	}
-	l.changeUpsert(changesdata.ChangePartial{
-		WhenChanged:     &timer.LastUpdated,
-		ChangedBy:       &timer.LastUpdatedBy,
-		DataId:          &timer.ID,
-		DataServiceName: &data.ServiceName,
-		DataType:        &data.ChangeTypeTimer,
-		DataAction:      &data.ChangeActionCreate,
-		DataVersion:     &timer.Version,
-	})
 	return timer, nil
 }
 
 // TimerStart can be used to start a given timer or do nothing
-// if the timer is already started
-func (l *logic) TimerStart(ctx context.Context, id string) (*data.Timer, error) {
-	timer, err := l.Timer.TimerStart(ctx, id)
-	if err != nil {
+// if the timer is already started, the start time is optional
+// but can't be in the future
+func (l *logic) TimerStart(ctx context.Context, id string, startTime int64) (*data.Timer, error) {
+	var timer *data.Timer
+
+	if startTime > time.Now().UnixNano() {
+		return nil, ErrTimerTimeInFuture
+	}
+	if err := l.outboxTransaction(ctx, func(ctx context.Context) error {
+		timerBefore, err := l.Timer.TimerRead(ctx, id)
+		if err != nil {
+			return err
+		}
+		if timer, err = l.Timer.TimerStart(ctx, id, startTime); err != nil {
+			return err
+		}
+		return l.changeUpsert(ctx, timerBefore, timer, changesdata.ChangePartial{
+			WhenChanged:     &timer.LastUpdated,
+			ChangedBy:       &timer.LastUpdatedBy,
+			DataId:          &timer.ID,
+			DataServiceName: &data.ServiceName,
+			DataType:        &data.ChangeTypeTimer,
+			DataAction:      &data.ChangeActionStart,
+			DataVersion:     &timer.Version,
+		})
+	}); err != nil {
 		return nil, err
 	}
-	l.changeUpsert(changesdata.ChangePartial{
-		WhenChanged:     &timer.LastUpdated,
-		ChangedBy:       &timer.LastUpdatedBy,
-		DataId:          &timer.ID,
-		DataServiceName: &data.ServiceName,
-		DataType:        &data.ChangeTypeTimer,
-		DataAction:      &data.ChangeActionStart,
-		DataVersion:     &timer.Version,
-	})
 	return timer, nil
 }
 
 // TimerStop can be used to stop a given timer or do nothing
-// if the timer is not started
-func (l *logic) TimerStop(ctx context.Context, id string) (*data.Timer, error) {
-	timer, err := l.Timer.TimerStop(ctx, id)
-	if err != nil {
+// if the timer is not started, the finish time is optional
+// but can't be in the future
+func (l *logic) TimerStop(ctx context.Context, id string, finishTime int64) (*data.Timer, error) {
+	var timer *data.Timer
+
+	if finishTime > time.Now().UnixNano() {
+		return nil, ErrTimerTimeInFuture
+	}
+	if err := l.outboxTransaction(ctx, func(ctx context.Context) error {
+		timerBefore, err := l.Timer.TimerRead(ctx, id)
+		if err != nil {
+			return err
+		}
+		if timer, err = l.Timer.TimerStop(ctx, id, finishTime); err != nil {
+			return err
+		}
+		return l.changeUpsert(ctx, timerBefore, timer, changesdata.ChangePartial{
+			WhenChanged:     &timer.LastUpdated,
+			ChangedBy:       &timer.LastUpdatedBy,
+			DataId:          &timer.ID,
+			DataServiceName: &data.ServiceName,
+			DataType:        &data.ChangeTypeTimer,
+			DataAction:      &data.ChangeActionStop,
+			DataVersion:     &timer.Version,
+		})
+	}); err != nil {
 		return nil, err
 	}
-	l.changeUpsert(changesdata.ChangePartial{
-		WhenChanged:     &timer.LastUpdated,
-		ChangedBy:       &timer.LastUpdatedBy,
-		DataId:          &timer.ID,
-		DataServiceName: &data.ServiceName,
-		DataType:        &data.ChangeTypeTimer,
-		DataAction:      &data.ChangeActionStop,
-		DataVersion:     &timer.Version,
-	})
 	return timer, nil
 }
 
-// TimerDelete can be used to delete a timer if it exists
+// TimerDelete can be used to delete a timer if it exists, the timer
+// is moved to the trash and purged once the retention has elapsed
 func (l *logic) TimerDelete(ctx context.Context, id string) error {
-	if err := l.Timer.TimerDelete(ctx, id); err != nil {
-		return err
-	}
-	tNow := time.Now().UnixNano()
-	l.changeUpsert(changesdata.ChangePartial{
-		WhenChanged:     &tNow,
-		DataId:          &id,
-		DataServiceName: &data.ServiceName,
-		DataType:        &data.ChangeTypeTimer,
-		DataAction:      &data.ChangeActionDelete,
+	return l.outboxTransaction(ctx, func(ctx context.Context) error {
+		timer, err := l.Timer.TimerRead(ctx, id)
+		if err != nil {
+			return err
+		}
+		if err := l.Timer.TimerDelete(ctx, id); err != nil {
+			return err
+		}
+		//KIM: deleting a timer (moving it to the trash) increments its
+		// version, the version is what allows the changes service to
+		// identify a delete that's delivered more than once
+		tNow, version := time.Now().UnixNano(), timer.Version+1
+		return l.changeUpsert(ctx, timer, nil, changesdata.ChangePartial{
+			WhenChanged:     &tNow,
+			DataId:          &id,
+			DataVersion:     &version,
+			DataServiceName: &data.ServiceName,
+			DataType:        &data.ChangeTypeTimer,
+			DataAction:      &data.ChangeActionDelete,
+		})
 	})
-	return nil
+}
+
+// TimerRestore can be used to restore a deleted timer (from the trash)
+func (l *logic) TimerRestore(ctx context.Context, id string) (*data.Timer, error) {
+	var timer *data.Timer
+
+	if err := l.outboxTransaction(ctx, func(ctx context.Context) error {
+		var err error
+
+		if timer, err = l.Timer.TimerRestore(ctx, id); err != nil {
+			return err
+		}
+		//KIM: a deleted timer can't be read, so the payload only
+		// has a snapshot of the restored timer
+		return l.changeUpsert(ctx, nil, timer, changesdata.ChangePartial{
+			WhenChanged:     &timer.LastUpdated,
+			ChangedBy:       &timer.LastUpdatedBy,
+			DataId:          &timer.ID,
+			DataServiceName: &data.ServiceName,
+			DataType:        &data.ChangeTypeTimer,
+			DataAction:      &data.ChangeActionRestore,
+			DataVersion:     &timer.Version,
+		})
+	}); err != nil {
+		return nil, err
+	}
+	return timer, nil
 }
 
 // TimerSubmit can be used to stop a timer and set completed to true
 func (l *logic) TimerSubmit(ctx context.Context, id string, submitTime int64) (*data.Timer, error) {
+	var timer *data.Timer
+
 	if submitTime <= 0 {
 		submitTime = time.Now().UnixNano()
 	}
-	timer, err := l.Timer.TimerSubmit(ctx, id, submitTime)
-	if err != nil {
+	if err := l.outboxTransaction(ctx, func(ctx context.Context) error {
+		timerBefore, err := l.Timer.TimerRead(ctx, id)
+		if err != nil {
+			return err
+		}
+		if timer, err = l.Timer.TimerSubmit(ctx, id, submitTime); err != nil {
+			return err
+		}
+		return l.changeUpsert(ctx, timerBefore, timer, changesdata.ChangePartial{
+			WhenChanged:     &timer.LastUpdated,
+			ChangedBy:       &timer.LastUpdatedBy,
+			DataId:          &timer.ID,
+			DataServiceName: &data.ServiceName,
+			DataType:        &data.ChangeTypeTimer,
+			DataAction:      &data.ChangeActionSubmit,
+			DataVersion:     &timer.Version,
+		})
+	}); err != nil {
 		return nil, err
 	}
-	l.changeUpsert(changesdata.ChangePartial{
-		WhenChanged:     &timer.LastUpdated,
-		ChangedBy:       &timer.LastUpdatedBy,
-		DataId:          &timer.ID,
-		DataServiceName: &data.ServiceName,
-		DataType:        &data.ChangeTypeTimer,
-		DataAction:      &data.ChangeActionSubmit,
-		DataVersion:     &timer.Version,
-	})
 	return timer, nil
 }
 
+// TimeSliceSplit can be used to split an existing time slice at
+// the given time, the split time can't be in the future
+func (l *logic) TimeSliceSplit(ctx context.Context, id string, splitTime int64) ([]*data.TimeSlice, error) {
+	var timeSlices []*data.TimeSlice
+
+	if splitTime > time.Now().UnixNano() {
+		return nil, ErrTimeSliceSplitInFuture
+	}
+	if err := l.outboxTransaction(ctx, func(ctx context.Context) error {
+		timeSliceBefore, err := l.TimeSlice.TimeSliceRead(ctx, id)
+		if err != nil {
+			return err
+		}
+		if timeSlices, err = l.TimeSlice.TimeSliceSplit(ctx, id, splitTime); err != nil {
+			return err
+		}
+		for _, timeSlice := range timeSlices {
+			//KIM: the time slice that was split keeps its id, the
+			// other time slice is created by the split
+			var before *data.TimeSlice
+			if timeSlice.ID == id {
+				before = timeSliceBefore
+			}
+			if err := l.changeUpsert(ctx, before, timeSlice, changesdata.ChangePartial{
+				WhenChanged:     &timeSlice.LastUpdated,
+				ChangedBy:       &timeSlice.LastUpdatedBy,
+				DataId:          &timeSlice.ID,
+				DataServiceName: &data.ServiceName,
+				DataType:        &data.ChangeTypeTimeSlice,
+				DataAction:      &data.ChangeActionSplit,
+				DataVersion:     &timeSlice.Version,
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	return timeSlices, nil
+}
+
+// TimeSlicesMerge can be used to merge two or more adjacent time
+// slices of the same timer, a change is emitted for the merged time
+// slice as well as the time slices that were deleted
+func (l *logic) TimeSlicesMerge(ctx context.Context, ids []string) (*data.TimeSlice, error) {
+	var timeSlice *data.TimeSlice
+
+	if err := l.outboxTransaction(ctx, func(ctx context.Context) error {
+		var err error
+
+		timeSlicesBefore := make(map[string]*data.TimeSlice, len(ids))
+		for _, id := range ids {
+			timeSlice, err := l.TimeSlice.TimeSliceRead(ctx, id)
+			if err != nil {
+				return err
+			}
+			timeSlicesBefore[id] = timeSlice
+		}
+		if timeSlice, err = l.TimeSlice.TimeSlicesMerge(ctx, ids); err != nil {
+			return err
+		}
+		for _, id := range ids {
+			if id == timeSlice.ID {
+				continue
+			}
+			id := id
+			if err := l.changeUpsert(ctx, timeSlicesBefore[id], nil, changesdata.ChangePartial{
+				WhenChanged:     &timeSlice.LastUpdated,
+				ChangedBy:       &timeSlice.LastUpdatedBy,
+				DataId:          &id,
+				DataVersion:     &timeSlicesBefore[id].Version,
+				DataServiceName: &data.ServiceName,
+				DataType:        &data.ChangeTypeTimeSlice,
+				DataAction:      &data.ChangeActionDelete,
+			}); err != nil {
+				return err
+			}
+		}
+		return l.changeUpsert(ctx, timeSlicesBefore[timeSlice.ID], timeSlice, changesdata.ChangePartial{
+			WhenChanged:     &timeSlice.LastUpdated,
+			ChangedBy:       &timeSlice.LastUpdatedBy,
+			DataId:          &timeSlice.ID,
+			DataServiceName: &data.ServiceName,
+			DataType:        &data.ChangeTypeTimeSlice,
+			DataAction:      &data.ChangeActionMerge,
+			DataVersion:     &timeSlice.Version,
+		})
+	}); err != nil {
+		return nil, err
+	}
+	return timeSlice, nil
+}
+
 // TimerUpdate can be used to update values a given timer
 // not associated with timer operations, values such as:
 // comment, archived and completed
 func (l *logic) TimerUpdate(ctx context.Context, id string, timerPartial data.TimerPartial) (*data.Timer, error) {
-	timer, err := l.Timer.TimerUpdate(ctx, id, data.TimerPartial{
-		Completed: timerPartial.Completed,
-		Archived:  timerPartial.Archived,
-		Comment:   timerPartial.Comment,
+	var timer *data.Timer
+
+	if err := l.outboxTransaction(ctx, func(ctx context.Context) error {
+		timerBefore, err := l.Timer.TimerRead(ctx, id)
+		if err != nil {
+			return err
+		}
+		if timer, err = l.Timer.TimerUpdate(ctx, id, data.TimerPartial{
+			Completed: timerPartial.Completed,
+			Archived:  timerPartial.Archived,
+			Comment:   timerPartial.Comment,
+			ProjectID: timerPartial.ProjectID,
+			Version:   timerPartial.Version,
+		}); err != nil {
+			return err
+		}
+		return l.changeUpsert(ctx, timerBefore, timer, changesdata.ChangePartial{
+			WhenChanged:     &timer.LastUpdated,
+			ChangedBy:       &timer.LastUpdatedBy,
+			DataId:          &timer.ID,
+			DataServiceName: &data.ServiceName,
+			DataType:        &data.ChangeTypeTimer,
+			DataAction:      &data.ChangeActionUpdate,
+			DataVersion:     &timer.Version,
+		})
+	}); err != nil {
+		return nil, err
+	}
+	return timer, nil
+}
+
+// ProjectCreate can be used to create a project, the name
+// is required and must be unique
+func (l *logic) ProjectCreate(ctx context.Context, projectPartial data.ProjectPartial) (*data.Project, error) {
+	var project *data.Project
+
+	if err := l.outboxTransaction(ctx, func(ctx context.Context) error {
+		var err error
+
+		if project, err = l.Project.ProjectCreate(ctx, projectPartial); err != nil {
+			return err
+		}
+		return l.changeUpsert(ctx, nil, project, changesdata.ChangePartial{
+			WhenChanged:     &project.LastUpdated,
+			ChangedBy:       &project.LastUpdatedBy,
+			DataId:          &project.ID,
+			DataServiceName: &data.ServiceName,
+			DataType:        &data.ChangeTypeProject,
+			DataAction:      &data.ChangeActionCreate,
+			DataVersion:     &project.Version,
+		})
+	}); err != nil {
+		return nil, err
+	}
+	return project, nil
+}
+
+// ProjectUpdate can be used to update an existing project
+func (l *logic) ProjectUpdate(ctx context.Context, id string, projectPartial data.ProjectPartial) (*data.Project, error) {
+	var project *data.Project
+
+	if err := l.outboxTransaction(ctx, func(ctx context.Context) error {
+		projectBefore, err := l.Project.ProjectRead(ctx, id)
+		if err != nil {
+			return err
+		}
+		if project, err = l.Project.ProjectUpdate(ctx, id, projectPartial); err != nil {
+			return err
+		}
+		return l.changeUpsert(ctx, projectBefore, project, changesdata.ChangePartial{
+			WhenChanged:     &project.LastUpdated,
+			ChangedBy:       &project.LastUpdatedBy,
+			DataId:          &project.ID,
+			DataServiceName: &data.ServiceName,
+			DataType:        &data.ChangeTypeProject,
+			DataAction:      &data.ChangeActionUpdate,
+			DataVersion:     &project.Version,
+		})
+	}); err != nil {
+		return nil, err
+	}
+	return project, nil
+}
+
+// ProjectDelete can be used to delete a project if it exists
+func (l *logic) ProjectDelete(ctx context.Context, id string) error {
+	return l.outboxTransaction(ctx, func(ctx context.Context) error {
+		project, err := l.Project.ProjectRead(ctx, id)
+		if err != nil {
+			return err
+		}
+		//KIM: the timers of the project are updated (their project is
+		// removed) when the project is deleted
+		timers, err := l.Timer.TimersRead(ctx, data.TimerSearch{
+			ProjectID: &id,
+		})
+		if err != nil {
+			return err
+		}
+		if err := l.Project.ProjectDelete(ctx, id); err != nil {
+			return err
+		}
+		for _, timerBefore := range timers {
+			timer, err := l.Timer.TimerRead(ctx, timerBefore.ID)
+			if err != nil {
+				return err
+			}
+			if err := l.changeUpsert(ctx, timerBefore, timer, changesdata.ChangePartial{
+				WhenChanged:     &timer.LastUpdated,
+				ChangedBy:       &timer.LastUpdatedBy,
+				DataId:          &timer.ID,
+				DataServiceName: &data.ServiceName,
+				DataType:        &data.ChangeTypeTimer,
+				DataAction:      &data.ChangeActionUpdate,
+				DataVersion:     &timer.Version,
+			}); err != nil {
+				return err
+			}
+		}
+		tNow := time.Now().UnixNano()
+		return l.changeUpsert(ctx, project, nil, changesdata.ChangePartial{
+			WhenChanged:     &tNow,
+			DataId:          &id,
+			DataVersion:     &project.Version,
+			DataServiceName: &data.ServiceName,
+			DataType:        &data.ChangeTypeProject,
+			DataAction:      &data.ChangeActionDelete,
+		})
+	})
+}
+
+// RateCardCreate can be used to create a rate card, the rate
+// and currency are required
+func (l *logic) RateCardCreate(ctx context.Context, rateCardPartial data.RateCardPartial) (*data.RateCard, error) {
+	var rateCard *data.RateCard
+
+	if err := l.outboxTransaction(ctx, func(ctx context.Context) error {
+		var err error
+
+		if rateCard, err = l.RateCard.RateCardCreate(ctx, rateCardPartial); err != nil {
+			return err
+		}
+		return l.changeUpsert(ctx, nil, rateCard, changesdata.ChangePartial{
+			WhenChanged:     &rateCard.LastUpdated,
+			ChangedBy:       &rateCard.LastUpdatedBy,
+			DataId:          &rateCard.ID,
+			DataServiceName: &data.ServiceName,
+			DataType:        &data.ChangeTypeRateCard,
+			DataAction:      &data.ChangeActionCreate,
+			DataVersion:     &rateCard.Version,
+		})
+	}); err != nil {
+		return nil, err
+	}
+	return rateCard, nil
+}
+
+// RateCardUpdate can be used to update an existing rate card
+func (l *logic) RateCardUpdate(ctx context.Context, id string, rateCardPartial data.RateCardPartial) (*data.RateCard, error) {
+	var rateCard *data.RateCard
+
+	if err := l.outboxTransaction(ctx, func(ctx context.Context) error {
+		rateCardBefore, err := l.RateCard.RateCardRead(ctx, id)
+		if err != nil {
+			return err
+		}
+		if rateCard, err = l.RateCard.RateCardUpdate(ctx, id, rateCardPartial); err != nil {
+			return err
+		}
+		return l.changeUpsert(ctx, rateCardBefore, rateCard, changesdata.ChangePartial{
+			WhenChanged:     &rateCard.LastUpdated,
+			ChangedBy:       &rateCard.LastUpdatedBy,
+			DataId:          &rateCard.ID,
+			DataServiceName: &data.ServiceName,
+			DataType:        &data.ChangeTypeRateCard,
+			DataAction:      &data.ChangeActionUpdate,
+			DataVersion:     &rateCard.Version,
+		})
+	}); err != nil {
+		return nil, err
+	}
+	return rateCard, nil
+}
+
+// RateCardDelete can be used to delete a rate card if it exists
+func (l *logic) RateCardDelete(ctx context.Context, id string) error {
+	return l.outboxTransaction(ctx, func(ctx context.Context) error {
+		rateCard, err := l.RateCard.RateCardRead(ctx, id)
+		if err != nil {
+			return err
+		}
+		if err := l.RateCard.RateCardDelete(ctx, id); err != nil {
+			return err
+		}
+		tNow := time.Now().UnixNano()
+		return l.changeUpsert(ctx, rateCard, nil, changesdata.ChangePartial{
+			WhenChanged:     &tNow,
+			DataId:          &id,
+			DataVersion:     &rateCard.Version,
+			DataServiceName: &data.ServiceName,
+			DataType:        &data.ChangeTypeRateCard,
+			DataAction:      &data.ChangeActionDelete,
+		})
 	})
+}
+
+// InvoiceCreate can be used to generate an invoice for all completed
+// and non-archived timers that haven't been invoiced and finished
+// within the start/finish of the invoice partial, the amount billed
+// for each timer is calculated from its elapsed time and the most
+// specific rate card effective when the timer finished
+func (l *logic) InvoiceCreate(ctx context.Context, invoicePartial data.InvoicePartial) (*data.Invoice, error) {
+	var invoiceCreated *data.Invoice
+	completed, archived, invoiced := true, false, false
+
+	if invoicePartial.Start == nil || invoicePartial.Finish == nil ||
+		*invoicePartial.Finish <= *invoicePartial.Start {
+		return nil, meta.ErrInvoiceInvalidRange
+	}
+	invoice := data.Invoice{
+		Start:  *invoicePartial.Start,
+		Finish: *invoicePartial.Finish,
+	}
+	timerSearch := data.TimerSearch{
+		Completed: &completed,
+		Archived:  &archived,
+		Invoiced:  &invoiced,
+	}
+	if employeeID := invoicePartial.EmployeeID; employeeID != nil && *employeeID != "" {
+		invoice.EmployeeID = *employeeID
+		timerSearch.EmployeeID = employeeID
+	}
+	if projectID := invoicePartial.ProjectID; projectID != nil && *projectID != "" {
+		invoice.ProjectID = *projectID
+		timerSearch.ProjectID = projectID
+	}
+	timers, err := l.Timer.TimersRead(ctx, timerSearch)
 	if err != nil {
 		return nil, err
 	}
-	l.changeUpsert(changesdata.ChangePartial{
-		WhenChanged:     &timer.LastUpdated,
-		ChangedBy:       &timer.LastUpdatedBy,
-		DataId:          &timer.ID,
-		DataServiceName: &data.ServiceName,
-		DataType:        &data.ChangeTypeTimer,
-		DataAction:      &data.ChangeActionUpdate,
-		DataVersion:     &timer.Version,
+	rateCards, err := l.RateCard.RateCardsRead(ctx, data.RateCardSearch{})
+	if err != nil {
+		return nil, err
+	}
+	timersBilled := make([]*data.Timer, 0, len(timers))
+	timersBefore := make(map[string]*data.Timer, len(timers))
+	for _, timer := range timers {
+		if timer.Finish < invoice.Start || timer.Finish >= invoice.Finish {
+			continue
+		}
+		timersBilled = append(timersBilled, timer)
+		timersBefore[timer.ID] = timer
+	}
+	if timerIDs := timersWithoutRateCard(rateCards, timersBilled); len(timerIDs) > 0 {
+		return nil, fmt.Errorf("%w: %s", meta.ErrTimerNoRateCard, strings.Join(timerIDs, ", "))
+	}
+	for _, timer := range timersBilled {
+		rateCard := rateCardFind(rateCards, timer)
+		switch {
+		case invoice.Currency == "":
+			invoice.Currency = rateCard.Currency
+		case invoice.Currency != rateCard.Currency:
+			return nil, meta.ErrInvoiceMixedCurrency
+		}
+		lineItem := data.InvoiceLineItem{
+			TimerID:     timer.ID,
+			RateCardID:  rateCard.ID,
+			ElapsedTime: timer.ElapsedTime,
+			Rate:        rateCard.Rate,
+			Amount:      invoiceAmount(timer.ElapsedTime, rateCard.Rate),
+		}
+		invoice.LineItems = append(invoice.LineItems, lineItem)
+		invoice.Total += lineItem.Amount
+	}
+	if len(invoice.LineItems) <= 0 {
+		return nil, meta.ErrInvoiceNoTimers
+	}
+	sort.Slice(invoice.LineItems, func(i, j int) bool {
+		return invoice.LineItems[i].TimerID < invoice.LineItems[j].TimerID
+	})
+	if err := l.outboxTransaction(ctx, func(ctx context.Context) error {
+		var err error
+
+		if invoiceCreated, err = l.Invoice.InvoiceCreate(ctx, invoice); err != nil {
+			return err
+		}
+		if err := l.changeUpsert(ctx, nil, invoiceCreated, changesdata.ChangePartial{
+			WhenChanged:     &invoiceCreated.LastUpdated,
+			ChangedBy:       &invoiceCreated.LastUpdatedBy,
+			DataId:          &invoiceCreated.ID,
+			DataServiceName: &data.ServiceName,
+			DataType:        &data.ChangeTypeInvoice,
+			DataAction:      &data.ChangeActionCreate,
+			DataVersion:     &invoiceCreated.Version,
+		}); err != nil {
+			return err
+		}
+		for _, lineItem := range invoiceCreated.LineItems {
+			timer, err := l.Timer.TimerRead(ctx, lineItem.TimerID)
+			if err != nil {
+				return err
+			}
+			if err := l.changeUpsert(ctx, timersBefore[timer.ID], timer, changesdata.ChangePartial{
+				WhenChanged:     &timer.LastUpdated,
+				ChangedBy:       &timer.LastUpdatedBy,
+				DataId:          &timer.ID,
+				DataServiceName: &data.ServiceName,
+				DataType:        &data.ChangeTypeTimer,
+				DataAction:      &data.ChangeActionInvoice,
+				DataVersion:     &timer.Version,
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	return invoiceCreated, nil
+}
+
+// InvoiceDelete can be used to delete an invoice if it exists, the
+// timers of its line items will be able to be invoiced again
+func (l *logic) InvoiceDelete(ctx context.Context, id string) error {
+	return l.outboxTransaction(ctx, func(ctx context.Context) error {
+		invoice, err := l.Invoice.InvoiceRead(ctx, id)
+		if err != nil {
+			return err
+		}
+		if err := l.Invoice.InvoiceDelete(ctx, id); err != nil {
+			return err
+		}
+		tNow := time.Now().UnixNano()
+		return l.changeUpsert(ctx, invoice, nil, changesdata.ChangePartial{
+			WhenChanged:     &tNow,
+			DataId:          &id,
+			DataVersion:     &invoice.Version,
+			DataServiceName: &data.ServiceName,
+			DataType:        &data.ChangeTypeInvoice,
+			DataAction:      &data.ChangeActionDelete,
+		})
 	})
-	return timer, nil
 }
 
+// TimesheetsRead can be used to total the time spent per employee
+// per calendar day and ISO week, time slices that span midnight (in
+// the timezone of the search or the configured timezone) are split
+// between days
+func (l *logic) TimesheetsRead(ctx context.Context, search data.TimesheetSearch) ([]*data.Timesheet, error) {
+	var timeSlices []*data.TimeSlice
+
+	timezone := l.config.TimesheetTimezone
+	if search.Timezone != nil && *search.Timezone != "" {
+		timezone = *search.Timezone
+	}
+	location, err := time.LoadLocation(timezone)
+	if err != nil {
+		return nil, ErrTimesheetTimezoneInvalid
+	}
+	now := time.Now().UnixNano()
+	start, finish := int64(0), now
+	if search.Start != nil {
+		start = *search.Start
+	}
+	if search.Finish != nil {
+		finish = *search.Finish
+	}
+	if finish <= start {
+		return nil, ErrTimesheetRangeInvalid
+	}
+	timers, err := l.Timer.TimersRead(ctx, data.TimerSearch{
+		EmployeeIDs: search.EmployeeIDs,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(timers) > 0 {
+		timerIDs := make([]string, 0, len(timers))
+		for _, timer := range timers {
+			timerIDs = append(timerIDs, timer.ID)
+		}
+		if timeSlices, err = l.TimeSlice.TimeSlicesRead(ctx, data.TimeSliceSearch{
+			TimerIDs: timerIDs,
+		}); err != nil {
+			return nil, err
+		}
+	}
+	return timesheetsCompute(timers, timeSlices, search.EmployeeIDs,
+		location, start, finish, now), nil
+}
+
+// HealthCheck can be used to determine the health of the service, it
+// will fail if the backlog of the outbox (changes yet to be delivered)
+// meets or exceeds the configured threshold
 func (l *logic) HealthCheck(ctx context.Context) (*healthcheckdata.HealthCheck, error) {
+	backlog, err := l.outbox.OutboxBacklog(ctx)
+	if err != nil {
+		return nil, err
+	}
+	l.Trace("Outbox backlog: %d", backlog)
+	if threshold := l.config.OutboxBacklogThreshold; threshold > 0 && backlog >= threshold {
+		return nil, fmt.Errorf("%w: %d of %d", ErrOutboxBacklogExceeded, backlog, threshold)
+	}
 	return &healthcheckdata.HealthCheck{Time: time.Now().UnixNano()}, nil
 }
@@ -43,7 +43,7 @@ const filename string = "bludgeon_logic.json"
 var (
 	configMetaMysql          = new(internal_mysql.Configuration)
 	configMetaFile           = new(internal_file.Configuration)
-	configChangesClientRest  = new(changesclientrest.Configuration)
+	configChangesClientRest  = changesclientrest.NewConfiguration()
 	configChangesClientKafka = new(changesclientkafka.Configuration)
 	configEmployeeClientRest = new(employeesclientrest.Configuration)
 	configEmployeeClientGrpc = new(employeesclientgrpc.Configuration)
@@ -351,7 +351,7 @@ func (l *logicTest) TestTimerChanges(t *testing.T) {
 	assert.Condition(t, l.assertTimerChange(t, ctx, timerUpdated, data.ChangeActionUpdate))
 
 	// start timer
-	timerStarted, err := l.TimerStart(ctx, timerId)
+	timerStarted, err := l.TimerStart(ctx, timerId, 0)
 	assert.Nil(t, err)
 	assert.NotNil(t, timerStarted)
 
@@ -365,7 +365,7 @@ func (l *logicTest) TestTimerChanges(t *testing.T) {
 	time.Sleep(time.Second)
 
 	// stop timer
-	timerStopped, err := l.TimerStop(ctx, timerId)
+	timerStopped, err := l.TimerStop(ctx, timerId, 0)
 	assert.Nil(t, err)
 	assert.NotNil(t, timerStopped)
 
@@ -1,6 +1,9 @@
 package logic
 
 import (
+	"context"
+
+	data "github.com/antonio-alexander/go-bludgeon/timers/data"
 	meta "github.com/antonio-alexander/go-bludgeon/timers/meta"
 )
 
@@ -9,6 +12,32 @@ import (
 type Logic interface {
 	meta.TimeSlice
 	meta.Timer
+	meta.Project
+	meta.RateCard
+
+	//InvoiceCreate can be used to generate an invoice for all completed
+	// and non-archived timers that haven't been invoiced and finished
+	// within the start/finish of the invoice partial, the amount billed
+	// for each timer is calculated from its elapsed time and the most
+	// specific rate card effective when the timer finished
+	InvoiceCreate(ctx context.Context, invoicePartial data.InvoicePartial) (*data.Invoice, error)
+
+	//InvoiceRead can be used to read an existing invoice
+	InvoiceRead(ctx context.Context, id string) (*data.Invoice, error)
+
+	//InvoiceDelete can be used to delete an invoice if it exists, the
+	// timers of its line items will be able to be invoiced again
+	InvoiceDelete(ctx context.Context, id string) error
+
+	//InvoicesRead can be used to read zero or more invoices depending
+	// on the search criteria
+	InvoicesRead(ctx context.Context, search data.InvoiceSearch) ([]*data.Invoice, error)
+
+	//TimesheetsRead can be used to total the time spent per employee
+	// per calendar day and ISO week, time slices that span midnight (in
+	// the timezone of the search or the configured timezone) are split
+	// between days
+	TimesheetsRead(ctx context.Context, search data.TimesheetSearch) ([]*data.Timesheet, error)
 
 	// IsConnected can be used to determine whether or not
 	// the underlying change handler is connected
@@ -14,6 +14,12 @@ import (
 	internal_file "github.com/antonio-alexander/go-bludgeon/internal/meta/file"
 )
 
+type contextKey string
+
+// contextKeyTransaction is used to store the file (pointer) that's
+// locked for the duration of an outbox transaction
+const contextKeyTransaction contextKey = "transaction"
+
 type file struct {
 	sync.RWMutex
 	logger.Logger
@@ -30,11 +36,21 @@ type file struct {
 	}
 	meta.Timer
 	meta.TimeSlice
+	meta.Project
+	meta.RateCard
+	meta.Invoice
+	meta.Purger
+	meta.Outbox
 }
 
 func New() interface {
 	meta.Timer
 	meta.TimeSlice
+	meta.Project
+	meta.RateCard
+	meta.Invoice
+	meta.Purger
+	meta.Outbox
 	internal.Initializer
 	internal.Parameterizer
 	internal.Configurer
@@ -46,10 +62,21 @@ func New() interface {
 		memory:    memory,
 		Timer:     memory,
 		TimeSlice: memory,
+		Project:   memory,
+		RateCard:  memory,
+		Invoice:   memory,
+		Purger:    memory,
+		Outbox:    memory,
 	}
 }
 
-func (m *file) write() error {
+// write will serialize the memory and write it to the file, if the
+// context is within an outbox transaction, the memory is written once
+// the transaction is complete
+func (m *file) write(ctx context.Context) error {
+	if ctx != nil && ctx.Value(contextKeyTransaction) == m {
+		return nil
+	}
 	serializedData, err := m.memory.Serialize()
 	if err != nil {
 		return err
@@ -57,6 +84,17 @@ func (m *file) write() error {
 	return m.file.Write(serializedData)
 }
 
+// lock will lock the file and return the function to unlock it, if
+// the context is within an outbox transaction, the file is already
+// locked
+func (m *file) lock(ctx context.Context) func() {
+	if ctx != nil && ctx.Value(contextKeyTransaction) == m {
+		return func() {}
+	}
+	m.Lock()
+	return m.Unlock
+}
+
 func (m *file) read() error {
 	serializedData := &meta.SerializedData{}
 	if err := m.file.Read(serializedData); err != nil {
@@ -73,6 +111,11 @@ func (m *file) SetParameters(parameters ...interface{}) {
 		case interface {
 			meta.Timer
 			meta.TimeSlice
+			meta.Project
+			meta.RateCard
+			meta.Invoice
+			meta.Purger
+			meta.Outbox
 			meta.Serializer
 			internal.Parameterizer
 			internal.Initializer
@@ -80,10 +123,25 @@ func (m *file) SetParameters(parameters ...interface{}) {
 			m.memory = p
 			m.Timer = p
 			m.TimeSlice = p
+			m.Project = p
+			m.RateCard = p
+			m.Invoice = p
+			m.Purger = p
+			m.Outbox = p
 		case meta.Timer:
 			m.Timer = p
 		case meta.TimeSlice:
 			m.TimeSlice = p
+		case meta.Project:
+			m.Project = p
+		case meta.RateCard:
+			m.RateCard = p
+		case meta.Invoice:
+			m.Invoice = p
+		case meta.Purger:
+			m.Purger = p
+		case meta.Outbox:
+			m.Outbox = p
 		}
 	}
 }
@@ -122,109 +180,291 @@ func (m *file) Shutdown() {
 	m.Lock()
 	defer m.Unlock()
 
-	if err := m.write(); err != nil {
+	if err := m.write(context.Background()); err != nil {
 		m.Error("error while shutting down: %s", err.Error())
 	}
 }
 
 func (m *file) TimerCreate(ctx context.Context, t data.TimerPartial) (*data.Timer, error) {
-	m.Lock()
-	defer m.Unlock()
+	defer m.lock(ctx)()
 	timer, err := m.Timer.TimerCreate(ctx, t)
 	if err != nil {
 		return nil, err
 	}
-	if err := m.write(); err != nil {
+	if err := m.write(ctx); err != nil {
 		return nil, err
 	}
 	return timer, nil
 }
 
 func (m *file) TimerUpdate(ctx context.Context, id string, t data.TimerPartial) (*data.Timer, error) {
-	m.Lock()
-	defer m.Unlock()
+	defer m.lock(ctx)()
 	timer, err := m.Timer.TimerUpdate(ctx, id, t)
 	if err != nil {
 		return nil, err
 	}
-	if err := m.write(); err != nil {
+	if err := m.write(ctx); err != nil {
 		return nil, err
 	}
 	return timer, nil
 }
 
 func (m *file) TimerDelete(ctx context.Context, id string) error {
-	m.Lock()
-	defer m.Unlock()
+	defer m.lock(ctx)()
 	if err := m.Timer.TimerDelete(ctx, id); err != nil {
 		return err
 	}
-	if err := m.write(); err != nil {
+	if err := m.write(ctx); err != nil {
 		return err
 	}
 	return nil
 }
 
-func (m *file) TimerStart(ctx context.Context, id string) (*data.Timer, error) {
-	m.Lock()
-	defer m.Unlock()
-	timer, err := m.Timer.TimerStart(ctx, id)
+func (m *file) TimerRestore(ctx context.Context, id string) (*data.Timer, error) {
+	defer m.lock(ctx)()
+	timer, err := m.Timer.TimerRestore(ctx, id)
 	if err != nil {
 		return nil, err
 	}
-	if err := m.write(); err != nil {
+	if err := m.write(ctx); err != nil {
 		return nil, err
 	}
 	return timer, nil
 }
 
-func (m *file) TimerStop(ctx context.Context, id string) (*data.Timer, error) {
-	m.Lock()
-	defer m.Unlock()
-	timer, err := m.Timer.TimerStop(ctx, id)
+func (m *file) TimersPurge(ctx context.Context, deletedBefore int64) ([]*data.Timer, error) {
+	defer m.lock(ctx)()
+	timers, err := m.Purger.TimersPurge(ctx, deletedBefore)
+	if err != nil {
+		return nil, err
+	}
+	if len(timers) <= 0 {
+		return nil, nil
+	}
+	if err := m.write(ctx); err != nil {
+		return nil, err
+	}
+	return timers, nil
+}
+
+func (m *file) TimerStart(ctx context.Context, id string, startTime int64) (*data.Timer, error) {
+	defer m.lock(ctx)()
+	timer, err := m.Timer.TimerStart(ctx, id, startTime)
+	if err != nil {
+		return nil, err
+	}
+	if err := m.write(ctx); err != nil {
+		return nil, err
+	}
+	return timer, nil
+}
+
+func (m *file) TimerStop(ctx context.Context, id string, finishTime int64) (*data.Timer, error) {
+	defer m.lock(ctx)()
+	timer, err := m.Timer.TimerStop(ctx, id, finishTime)
+	if err != nil {
+		return nil, err
+	}
+	if err := m.write(ctx); err != nil {
+		return nil, err
+	}
+	return timer, nil
+}
+
+func (m *file) TimerSubmit(ctx context.Context, id string, finishTime int64) (*data.Timer, error) {
+	defer m.lock(ctx)()
+	timer, err := m.Timer.TimerSubmit(ctx, id, finishTime)
 	if err != nil {
 		return nil, err
 	}
-	if err := m.write(); err != nil {
+	if err := m.write(ctx); err != nil {
 		return nil, err
 	}
 	return timer, nil
 }
 
 func (m *file) TimeSliceCreate(ctx context.Context, t data.TimeSlicePartial) (*data.TimeSlice, error) {
-	m.Lock()
-	defer m.Unlock()
+	defer m.lock(ctx)()
 	timeSlice, err := m.TimeSlice.TimeSliceCreate(ctx, t)
 	if err != nil {
 		return nil, err
 	}
-	if err := m.write(); err != nil {
+	if err := m.write(ctx); err != nil {
 		return nil, err
 	}
 	return timeSlice, nil
 }
 
 func (m *file) TimeSliceUpdate(ctx context.Context, id string, t data.TimeSlicePartial) (*data.TimeSlice, error) {
-	m.Lock()
-	defer m.Unlock()
+	defer m.lock(ctx)()
 	timeSlice, err := m.TimeSlice.TimeSliceUpdate(ctx, id, t)
 	if err != nil {
 		return nil, err
 	}
-	if err := m.write(); err != nil {
+	if err := m.write(ctx); err != nil {
 		return nil, err
 	}
 	return timeSlice, nil
 }
 
 func (m *file) TimeSliceDelete(ctx context.Context, id string) error {
-	m.Lock()
-	defer m.Unlock()
+	defer m.lock(ctx)()
 	if err := m.TimeSlice.TimeSliceDelete(ctx, id); err != nil {
 		return err
 	}
-	if err := m.write(); err != nil {
+	if err := m.write(ctx); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *file) TimeSliceSplit(ctx context.Context, id string, splitTime int64) ([]*data.TimeSlice, error) {
+	defer m.lock(ctx)()
+	timeSlices, err := m.TimeSlice.TimeSliceSplit(ctx, id, splitTime)
+	if err != nil {
+		return nil, err
+	}
+	if err := m.write(ctx); err != nil {
+		return nil, err
+	}
+	return timeSlices, nil
+}
+
+func (m *file) TimeSlicesMerge(ctx context.Context, ids []string) (*data.TimeSlice, error) {
+	defer m.lock(ctx)()
+	timeSlice, err := m.TimeSlice.TimeSlicesMerge(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+	if err := m.write(ctx); err != nil {
+		return nil, err
+	}
+	return timeSlice, nil
+}
+
+func (m *file) ProjectCreate(ctx context.Context, p data.ProjectPartial) (*data.Project, error) {
+	defer m.lock(ctx)()
+	project, err := m.Project.ProjectCreate(ctx, p)
+	if err != nil {
+		return nil, err
+	}
+	if err := m.write(ctx); err != nil {
+		return nil, err
+	}
+	return project, nil
+}
+
+func (m *file) ProjectUpdate(ctx context.Context, id string, p data.ProjectPartial) (*data.Project, error) {
+	defer m.lock(ctx)()
+	project, err := m.Project.ProjectUpdate(ctx, id, p)
+	if err != nil {
+		return nil, err
+	}
+	if err := m.write(ctx); err != nil {
+		return nil, err
+	}
+	return project, nil
+}
+
+func (m *file) ProjectDelete(ctx context.Context, id string) error {
+	defer m.lock(ctx)()
+	if err := m.Project.ProjectDelete(ctx, id); err != nil {
+		return err
+	}
+	if err := m.write(ctx); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *file) RateCardCreate(ctx context.Context, r data.RateCardPartial) (*data.RateCard, error) {
+	defer m.lock(ctx)()
+	rateCard, err := m.RateCard.RateCardCreate(ctx, r)
+	if err != nil {
+		return nil, err
+	}
+	if err := m.write(ctx); err != nil {
+		return nil, err
+	}
+	return rateCard, nil
+}
+
+func (m *file) RateCardUpdate(ctx context.Context, id string, r data.RateCardPartial) (*data.RateCard, error) {
+	defer m.lock(ctx)()
+	rateCard, err := m.RateCard.RateCardUpdate(ctx, id, r)
+	if err != nil {
+		return nil, err
+	}
+	if err := m.write(ctx); err != nil {
+		return nil, err
+	}
+	return rateCard, nil
+}
+
+func (m *file) RateCardDelete(ctx context.Context, id string) error {
+	defer m.lock(ctx)()
+	if err := m.RateCard.RateCardDelete(ctx, id); err != nil {
+		return err
+	}
+	if err := m.write(ctx); err != nil {
 		return err
 	}
 	return nil
 }
+
+func (m *file) InvoiceCreate(ctx context.Context, i data.Invoice) (*data.Invoice, error) {
+	defer m.lock(ctx)()
+	invoice, err := m.Invoice.InvoiceCreate(ctx, i)
+	if err != nil {
+		return nil, err
+	}
+	if err := m.write(ctx); err != nil {
+		return nil, err
+	}
+	return invoice, nil
+}
+
+func (m *file) InvoiceDelete(ctx context.Context, id string) error {
+	defer m.lock(ctx)()
+	if err := m.Invoice.InvoiceDelete(ctx, id); err != nil {
+		return err
+	}
+	if err := m.write(ctx); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *file) OutboxEnqueue(ctx context.Context, change data.OutboxChange) (*data.OutboxChange, error) {
+	defer m.lock(ctx)()
+	outboxChange, err := m.Outbox.OutboxEnqueue(ctx, change)
+	if err != nil {
+		return nil, err
+	}
+	if err := m.write(ctx); err != nil {
+		return nil, err
+	}
+	return outboxChange, nil
+}
+
+func (m *file) OutboxAcknowledge(ctx context.Context, id string) error {
+	defer m.lock(ctx)()
+	if err := m.Outbox.OutboxAcknowledge(ctx, id); err != nil {
+		return err
+	}
+	return m.write(ctx)
+}
+
+func (m *file) OutboxTransaction(ctx context.Context, fx func(ctx context.Context) error) error {
+	if ctx != nil && ctx.Value(contextKeyTransaction) == m {
+		return fx(ctx)
+	}
+	m.Lock()
+	defer m.Unlock()
+	//KIM: the mutations (and their changes) are written to the
+	// file once rather than once per mutation
+	if err := m.Outbox.OutboxTransaction(context.WithValue(ctx, contextKeyTransaction, m), fx); err != nil {
+		return err
+	}
+	return m.write(ctx)
+}
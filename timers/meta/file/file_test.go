@@ -50,6 +50,18 @@ func TestMetaFile(t *testing.T) {
 
 	t.Run("Timer CRUD", tests.TestTimerCRUD(ctx, m))
 	t.Run("Timers Read", tests.TestTimersRead(ctx, m))
+	t.Run("Time Slices Read", tests.TestTimeSlicesRead(ctx, m))
+	t.Run("Time Slice Update", tests.TestTimeSliceUpdate(ctx, m))
 	t.Run("Timer Logic", tests.TestTimerLogic(ctx, m))
+	t.Run("Timer Backdate", tests.TestTimerBackdate(ctx, m))
+	t.Run("Time Slice Split Merge", tests.TestTimeSliceSplitMerge(ctx, m))
+	t.Run("Time Slices Merge History", tests.TestTimeSlicesMergeHistory(ctx, m))
+	t.Run("Timer History", tests.TestTimerHistory(ctx, m))
+	t.Run("Timer Trash", tests.TestTimerTrash(ctx, m))
+	t.Run("Project CRUD", tests.TestProjectCRUD(ctx, m))
+	t.Run("Rate Card CRUD", tests.TestRateCardCRUD(ctx, m))
+	t.Run("Invoice Create", tests.TestInvoiceCreate(ctx, m))
+	t.Run("Outbox", tests.TestOutbox(ctx, m))
+	t.Run("Outbox Transaction", tests.TestOutboxTransaction(ctx, m))
 	m.Shutdown()
 }
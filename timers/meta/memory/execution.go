@@ -1,9 +1,12 @@
 package memory
 
 import (
+	"encoding/json"
+	"sort"
 	"time"
 
 	data "github.com/antonio-alexander/go-bludgeon/timers/data"
+	meta "github.com/antonio-alexander/go-bludgeon/timers/meta"
 
 	"github.com/google/uuid"
 )
@@ -28,6 +31,21 @@ func elapsedTime(timer *data.Timer, timeSlices []*data.TimeSlice) *data.Timer {
 	return timer
 }
 
+// startLess can be used to determine if a (start, id) pair sorts
+// before another (start, id) pair, ties in start are broken by id
+func startLess(startA, startB int64, idA, idB string, descending bool) bool {
+	if startA != startB {
+		if descending {
+			return startA > startB
+		}
+		return startA < startB
+	}
+	if descending {
+		return idA > idB
+	}
+	return idA < idB
+}
+
 func copyTimer(t *data.Timer) *data.Timer {
 	return &data.Timer{
 		LastUpdated:       t.LastUpdated,
@@ -39,9 +57,12 @@ func copyTimer(t *data.Timer) *data.Timer {
 		Finish:            t.Finish,
 		ElapsedTime:       t.ElapsedTime,
 		EmployeeID:        t.EmployeeID,
+		ProjectID:         t.ProjectID,
+		InvoiceID:         t.InvoiceID,
 		ActiveTimeSliceID: t.ActiveTimeSliceID,
 		ID:                t.ID,
 		Comment:           t.Comment,
+		DeletedAt:         t.DeletedAt,
 	}
 }
 
@@ -59,6 +80,63 @@ func copyTimeSlice(t *data.TimeSlice) *data.TimeSlice {
 	}
 }
 
+func copyProject(p *data.Project) *data.Project {
+	return &data.Project{
+		ID:            p.ID,
+		Name:          p.Name,
+		Description:   p.Description,
+		Archived:      p.Archived,
+		LastUpdated:   p.LastUpdated,
+		LastUpdatedBy: p.LastUpdatedBy,
+		Version:       p.Version,
+	}
+}
+
+func copyRateCard(r *data.RateCard) *data.RateCard {
+	return &data.RateCard{
+		ID:            r.ID,
+		EmployeeID:    r.EmployeeID,
+		ProjectID:     r.ProjectID,
+		Rate:          r.Rate,
+		Currency:      r.Currency,
+		EffectiveFrom: r.EffectiveFrom,
+		EffectiveTo:   r.EffectiveTo,
+		LastUpdated:   r.LastUpdated,
+		LastUpdatedBy: r.LastUpdatedBy,
+		Version:       r.Version,
+	}
+}
+
+func copyInvoice(i *data.Invoice) *data.Invoice {
+	return &data.Invoice{
+		ID:            i.ID,
+		EmployeeID:    i.EmployeeID,
+		ProjectID:     i.ProjectID,
+		Start:         i.Start,
+		Finish:        i.Finish,
+		Currency:      i.Currency,
+		Total:         i.Total,
+		LineItems:     append([]data.InvoiceLineItem{}, i.LineItems...),
+		LastUpdated:   i.LastUpdated,
+		LastUpdatedBy: i.LastUpdatedBy,
+		Version:       i.Version,
+	}
+}
+
+func copyOutboxChange(c *data.OutboxChange) *data.OutboxChange {
+	return &data.OutboxChange{
+		ID:          c.ID,
+		WhenChanged: c.WhenChanged,
+		ChangedBy:   c.ChangedBy,
+		DataId:      c.DataId,
+		DataType:    c.DataType,
+		DataAction:  c.DataAction,
+		DataVersion: c.DataVersion,
+		Payload:     append(json.RawMessage(nil), c.Payload...),
+		Enqueued:    c.Enqueued,
+	}
+}
+
 func validateTimeSlice(t data.TimeSlice) error {
 	if !t.Validate() {
 		if t.TimerID == "" {
@@ -73,3 +151,86 @@ func validateTimeSlice(t data.TimeSlice) error {
 	}
 	return nil
 }
+
+// validateTimerStart can be used to confirm that a time slice started
+// at the given time won't conflict with the existing time slices; the
+// start can't fall within or precede an existing time slice, but it can
+// be the finish of an existing time slice
+func validateTimerStart(timeSlices []*data.TimeSlice, start int64) error {
+	for _, timeSlice := range timeSlices {
+		if start <= timeSlice.Start || start < timeSlice.Finish {
+			return meta.ErrTimerConflictStart
+		}
+	}
+	return nil
+}
+
+// validateTimerStop can be used to confirm that the active time slice
+// can be finished at the given time; the finish must be after its start
+// and no other time slice can start between its start and finish
+func validateTimerStop(timeSlices []*data.TimeSlice, activeTimeSliceID string, finish int64) error {
+	var activeTimeSlice *data.TimeSlice
+
+	for _, timeSlice := range timeSlices {
+		if timeSlice.ID == activeTimeSliceID {
+			activeTimeSlice = timeSlice
+			break
+		}
+	}
+	if activeTimeSlice == nil {
+		return meta.ErrTimeSliceNotFound
+	}
+	if finish <= activeTimeSlice.Start {
+		return meta.ErrTimerConflictStop
+	}
+	for _, timeSlice := range timeSlices {
+		if timeSlice.ID == activeTimeSliceID {
+			continue
+		}
+		if timeSlice.Start >= activeTimeSlice.Start && timeSlice.Start <= finish {
+			return meta.ErrTimerConflictStop
+		}
+	}
+	return nil
+}
+
+// validateTimeSliceSplit can be used to confirm that the split time is
+// within the time slice (and not at its start or finish)
+func validateTimeSliceSplit(timeSlice *data.TimeSlice, splitTime int64) error {
+	if splitTime <= timeSlice.Start {
+		return meta.ErrTimeSliceSplitInvalid
+	}
+	if timeSlice.Finish > 0 && splitTime >= timeSlice.Finish {
+		return meta.ErrTimeSliceSplitInvalid
+	}
+	return nil
+}
+
+// validateTimeSlicesMerge can be used to confirm that the time slices can
+// be merged, they must share a timer and no other time slice for the timer
+// can start between them; the time slices will be sorted by start
+func validateTimeSlicesMerge(timeSlices, timerTimeSlices []*data.TimeSlice) error {
+	ids := make(map[string]struct{})
+	for _, timeSlice := range timeSlices {
+		if _, ok := ids[timeSlice.ID]; ok || timeSlice.TimerID != timeSlices[0].TimerID {
+			return meta.ErrTimeSliceMergeInvalid
+		}
+		ids[timeSlice.ID] = struct{}{}
+	}
+	sort.Sort(data.TimeSliceByStart(timeSlices))
+	for i, timeSlice := range timeSlices[:len(timeSlices)-1] {
+		if timeSlice.Finish != timeSlices[i+1].Start {
+			return meta.ErrTimeSliceMergeInvalid
+		}
+	}
+	first, last := timeSlices[0], timeSlices[len(timeSlices)-1]
+	for _, timeSlice := range timerTimeSlices {
+		if _, ok := ids[timeSlice.ID]; ok {
+			continue
+		}
+		if timeSlice.Start >= first.Start && timeSlice.Start <= last.Start {
+			return meta.ErrTimeSliceMergeInvalid
+		}
+	}
+	return nil
+}
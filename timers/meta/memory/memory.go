@@ -17,28 +17,80 @@ import (
 
 const lastUpdatedBy string = "bludgeon_meta_memory"
 
+type contextKey string
+
+// contextKeyTransaction is used to store the memory (pointer) that's
+// locked for the duration of an outbox transaction
+const contextKeyTransaction contextKey = "transaction"
+
 type memory struct {
-	sync.RWMutex                             //mutex for threadsafe functionality
-	logger.Logger                            //logger
-	timers        map[string]*data.Timer     //map to store timers
-	timeSlices    map[string]*data.TimeSlice //active time slices indexed by timer id
+	sync.RWMutex                                   //mutex for threadsafe functionality
+	logger.Logger                                  //logger
+	timers            map[string]*data.Timer       //map to store timers
+	timeSlices        map[string]*data.TimeSlice   //active time slices indexed by timer id
+	timersHistory     map[string][]*data.Timer     //every version of a timer indexed by timer id
+	timeSlicesHistory map[string][]*data.TimeSlice //every version of a time slice indexed by time slice id
+	projects          map[string]*data.Project     //map to store projects
+	rateCards         map[string]*data.RateCard    //map to store rate cards
+	invoices          map[string]*data.Invoice     //map to store invoices
+	outbox            []*data.OutboxChange         //changes yet to be delivered (oldest first)
 }
 
 func New() interface {
 	meta.Timer
 	meta.TimeSlice
+	meta.Project
+	meta.RateCard
+	meta.Invoice
+	meta.Purger
+	meta.Outbox
 	meta.Serializer
 	internal.Parameterizer
 	internal.Initializer
 	internal.Configurer
 } {
 	return &memory{
-		timers:     make(map[string]*data.Timer),
-		timeSlices: make(map[string]*data.TimeSlice),
-		Logger:     logger.NewNullLogger(),
+		timers:            make(map[string]*data.Timer),
+		timeSlices:        make(map[string]*data.TimeSlice),
+		timersHistory:     make(map[string][]*data.Timer),
+		timeSlicesHistory: make(map[string][]*data.TimeSlice),
+		projects:          make(map[string]*data.Project),
+		rateCards:         make(map[string]*data.RateCard),
+		invoices:          make(map[string]*data.Invoice),
+		Logger:            logger.NewNullLogger(),
 	}
 }
 
+// lock will lock the memory (for writing) and return the function to
+// unlock it, if the context is within an outbox transaction, the memory
+// is already locked
+func (m *memory) lock(ctx context.Context) func() {
+	if ctx != nil && ctx.Value(contextKeyTransaction) == m {
+		return func() {}
+	}
+	m.Lock()
+	return m.Unlock
+}
+
+// rlock will lock the memory (for reading) and return the function to
+// unlock it, if the context is within an outbox transaction, the memory
+// is already locked
+func (m *memory) rlock(ctx context.Context) func() {
+	if ctx != nil && ctx.Value(contextKeyTransaction) == m {
+		return func() {}
+	}
+	m.RLock()
+	return m.RUnlock
+}
+
+func (m *memory) timerAudit(timer *data.Timer) {
+	m.timersHistory[timer.ID] = append(m.timersHistory[timer.ID], copyTimer(timer))
+}
+
+func (m *memory) timeSliceAudit(timeSlice *data.TimeSlice) {
+	m.timeSlicesHistory[timeSlice.ID] = append(m.timeSlicesHistory[timeSlice.ID], copyTimeSlice(timeSlice))
+}
+
 func (m *memory) validateTimeSlice(p data.TimeSlicePartial, ids ...string) error {
 	var timeSlices []*data.TimeSlice
 	var t *data.TimeSlice
@@ -90,9 +142,21 @@ func (m *memory) validateTimeSlice(p data.TimeSlicePartial, ids ...string) error
 	return nil
 }
 
-func (m *memory) timerStop(id string) (*data.Timer, error) {
+// validateTimerProject can be used to confirm that the project of a timer
+// exists, this mirrors the foreign key in the mysql schema
+func (m *memory) validateTimerProject(t data.TimerPartial) error {
+	if t.ProjectID == nil || *t.ProjectID == "" {
+		return nil
+	}
+	if _, ok := m.projects[*t.ProjectID]; !ok {
+		return meta.ErrProjectNotFound
+	}
+	return nil
+}
+
+func (m *memory) timerStop(id string, finishTime int64) (*data.Timer, error) {
 	timer, ok := m.timers[id]
-	if !ok {
+	if !ok || timer.DeletedAt > 0 {
 		return nil, meta.ErrTimerNotFound
 	}
 	timeSlices, err := m.timeSlicesRead(data.TimeSliceSearch{
@@ -105,7 +169,13 @@ func (m *memory) timerStop(id string) (*data.Timer, error) {
 	if timer.ActiveTimeSliceID == "" {
 		return copyTimer(timer), nil
 	}
-	timer.Finish = time.Now().UnixNano()
+	if finishTime <= 0 {
+		finishTime = time.Now().UnixNano()
+	}
+	if err := validateTimerStop(timeSlices, timer.ActiveTimeSliceID, finishTime); err != nil {
+		return nil, err
+	}
+	timer.Finish = finishTime
 	timeSlice, err := m.timeSliceUpdate(timer.ActiveTimeSliceID, data.TimeSlicePartial{
 		Finish: &timer.Finish,
 	})
@@ -117,6 +187,7 @@ func (m *memory) timerStop(id string) (*data.Timer, error) {
 	timer.ActiveTimeSliceID = ""
 	timer.LastUpdated = time.Now().UnixNano()
 	timer.Version++
+	m.timerAudit(timer)
 	return copyTimer(timer), nil
 }
 
@@ -150,10 +221,20 @@ func (m *memory) timeSliceCreate(t data.TimeSlicePartial) (*data.TimeSlice, erro
 		timeSlice.Start = *t.Start
 	}
 	m.timeSlices[id] = timeSlice
+	m.timeSliceAudit(timeSlice)
 	return copyTimeSlice(timeSlice), nil
 }
 
 func (m *memory) timeSliceUpdate(id string, t data.TimeSlicePartial) (*data.TimeSlice, error) {
+	if version := t.Version; version != nil {
+		timeSlice, ok := m.timeSlices[id]
+		if !ok {
+			return nil, meta.ErrTimeSliceNotFound
+		}
+		if *version != timeSlice.Version {
+			return nil, meta.ErrTimeSliceConflictVersion
+		}
+	}
 	if err := m.validateTimeSlice(t, id); err != nil {
 		return nil, err
 	}
@@ -172,6 +253,7 @@ func (m *memory) timeSliceUpdate(id string, t data.TimeSlicePartial) (*data.Time
 	}
 	timeSlice.LastUpdated = time.Now().UnixNano()
 	timeSlice.Version++
+	m.timeSliceAudit(timeSlice)
 	return copyTimeSlice(timeSlice), nil
 }
 
@@ -210,6 +292,12 @@ func (m *memory) timeSlicesRead(search data.TimeSliceSearch) ([]*data.TimeSlice,
 		if search.Completed != nil && t.Completed != *search.Completed {
 			return false
 		}
+		if search.Finish != nil && t.Start >= *search.Finish {
+			return false
+		}
+		if search.Start != nil && t.Finish > 0 && t.Finish <= *search.Start {
+			return false
+		}
 		return true
 	}
 	var timeSlices []*data.TimeSlice
@@ -218,6 +306,25 @@ func (m *memory) timeSlicesRead(search data.TimeSliceSearch) ([]*data.TimeSlice,
 			timeSlices = append(timeSlices, copyTimeSlice(timeSlice))
 		}
 	}
+	descending := search.Sort != nil && *search.Sort == data.SortDescending
+	sort.Slice(timeSlices, func(i, j int) bool {
+		return startLess(timeSlices[i].Start, timeSlices[j].Start,
+			timeSlices[i].ID, timeSlices[j].ID, descending)
+	})
+	if cursorID := search.Cursor; cursorID != nil && *cursorID != "" {
+		cursor, ok := m.timeSlices[*cursorID]
+		if !ok {
+			return nil, meta.ErrTimeSliceNotFound
+		}
+		i := sort.Search(len(timeSlices), func(i int) bool {
+			return startLess(cursor.Start, timeSlices[i].Start,
+				cursor.ID, timeSlices[i].ID, descending)
+		})
+		timeSlices = timeSlices[i:]
+	}
+	if limit := search.Limit; limit != nil && *limit > 0 && len(timeSlices) > *limit {
+		timeSlices = timeSlices[:*limit]
+	}
 	return timeSlices, nil
 }
 
@@ -247,17 +354,21 @@ func (m *memory) Shutdown() {
 	defer m.Unlock()
 	m.timers = nil
 	m.timeSlices = nil
+	m.timersHistory = nil
+	m.timeSlicesHistory = nil
+	m.projects = nil
+	m.rateCards = nil
+	m.invoices = nil
+	m.outbox = nil
 }
 
 func (m *memory) TimeSliceCreate(ctx context.Context, t data.TimeSlicePartial) (*data.TimeSlice, error) {
-	m.Lock()
-	defer m.Unlock()
+	defer m.lock(ctx)()
 	return m.timeSliceCreate(t)
 }
 
 func (m *memory) TimeSliceRead(ctx context.Context, id string) (*data.TimeSlice, error) {
-	m.RLock()
-	defer m.RUnlock()
+	defer m.rlock(ctx)()
 	timeSlice, ok := m.timeSlices[id]
 	if !ok {
 		return nil, meta.ErrTimeSliceNotFound
@@ -266,30 +377,112 @@ func (m *memory) TimeSliceRead(ctx context.Context, id string) (*data.TimeSlice,
 }
 
 func (m *memory) TimeSliceUpdate(ctx context.Context, id string, t data.TimeSlicePartial) (*data.TimeSlice, error) {
-	m.Lock()
-	defer m.Unlock()
+	defer m.lock(ctx)()
 	return m.timeSliceUpdate(id, t)
 }
 
 func (m *memory) TimeSliceDelete(ctx context.Context, id string) error {
-	m.Lock()
-	defer m.Unlock()
+	defer m.lock(ctx)()
 	if _, ok := m.timeSlices[id]; !ok {
 		return meta.ErrTimeSliceNotFound
 	}
 	delete(m.timeSlices, id)
+	delete(m.timeSlicesHistory, id)
 	return nil
 }
 
+func (m *memory) TimeSliceSplit(ctx context.Context, id string, splitTime int64) ([]*data.TimeSlice, error) {
+	defer m.lock(ctx)()
+	timeSlice, ok := m.timeSlices[id]
+	if !ok {
+		return nil, meta.ErrTimeSliceNotFound
+	}
+	if err := validateTimeSliceSplit(timeSlice, splitTime); err != nil {
+		return nil, err
+	}
+	newID, err := generateID()
+	if err != nil {
+		return nil, err
+	}
+	tNow := time.Now().UnixNano()
+	timeSliceBefore := &data.TimeSlice{
+		ID:            newID,
+		TimerID:       timeSlice.TimerID,
+		Start:         timeSlice.Start,
+		Finish:        splitTime,
+		Completed:     timeSlice.Completed,
+		LastUpdated:   tNow,
+		LastUpdatedBy: lastUpdatedBy,
+		Version:       1,
+	}
+	timeSlice.Start = splitTime
+	timeSlice.LastUpdated = tNow
+	timeSlice.Version++
+	m.timeSlices[newID] = timeSliceBefore
+	m.timeSliceAudit(timeSliceBefore)
+	m.timeSliceAudit(timeSlice)
+	return []*data.TimeSlice{copyTimeSlice(timeSliceBefore), copyTimeSlice(timeSlice)}, nil
+}
+
+func (m *memory) TimeSlicesMerge(ctx context.Context, ids []string) (*data.TimeSlice, error) {
+	var timeSlices []*data.TimeSlice
+
+	defer m.lock(ctx)()
+	if len(ids) < 2 {
+		return nil, meta.ErrTimeSliceMergeInvalid
+	}
+	for _, id := range ids {
+		timeSlice, ok := m.timeSlices[id]
+		if !ok {
+			return nil, meta.ErrTimeSliceNotFound
+		}
+		timeSlices = append(timeSlices, timeSlice)
+	}
+	timerTimeSlices, err := m.timeSlicesRead(data.TimeSliceSearch{
+		TimerID: &timeSlices[0].TimerID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if err := validateTimeSlicesMerge(timeSlices, timerTimeSlices); err != nil {
+		return nil, err
+	}
+	//KIM: time slices are sorted by start once validated, the history
+	// of the time slices merged is kept
+	first, last := timeSlices[0], timeSlices[len(timeSlices)-1]
+	for _, timeSlice := range timeSlices[:len(timeSlices)-1] {
+		delete(m.timeSlices, timeSlice.ID)
+	}
+	last.Start = first.Start
+	last.LastUpdated = time.Now().UnixNano()
+	last.Version++
+	m.timeSliceAudit(last)
+	return copyTimeSlice(last), nil
+}
+
+func (m *memory) TimeSliceHistory(ctx context.Context, id string) ([]*data.TimeSlice, error) {
+	defer m.rlock(ctx)()
+	history, ok := m.timeSlicesHistory[id]
+	if !ok {
+		return nil, meta.ErrTimeSliceNotFound
+	}
+	timeSlices := make([]*data.TimeSlice, 0, len(history))
+	for _, timeSlice := range history {
+		timeSlices = append(timeSlices, copyTimeSlice(timeSlice))
+	}
+	return timeSlices, nil
+}
+
 func (m *memory) TimeSlicesRead(ctx context.Context, search data.TimeSliceSearch) ([]*data.TimeSlice, error) {
-	m.RLock()
-	defer m.RUnlock()
+	defer m.rlock(ctx)()
 	return m.timeSlicesRead(search)
 }
 
 func (m *memory) TimerCreate(ctx context.Context, t data.TimerPartial) (*data.Timer, error) {
-	m.Lock()
-	defer m.Unlock()
+	defer m.lock(ctx)()
+	if err := m.validateTimerProject(t); err != nil {
+		return nil, err
+	}
 	id, err := generateID()
 	if err != nil {
 		return nil, err
@@ -312,15 +505,18 @@ func (m *memory) TimerCreate(ctx context.Context, t data.TimerPartial) (*data.Ti
 	if employeeID := t.EmployeeID; employeeID != nil {
 		timer.EmployeeID = *employeeID
 	}
+	if projectID := t.ProjectID; projectID != nil {
+		timer.ProjectID = *projectID
+	}
 	m.timers[timer.ID] = timer
+	m.timerAudit(timer)
 	return copyTimer(timer), nil
 }
 
 func (m *memory) TimerRead(ctx context.Context, id string) (*data.Timer, error) {
-	m.RLock()
-	defer m.RUnlock()
+	defer m.rlock(ctx)()
 	timer, ok := m.timers[id]
-	if !ok {
+	if !ok || timer.DeletedAt > 0 {
 		return nil, meta.ErrTimerNotFound
 	}
 	timeSlices, err := m.timeSlicesRead(data.TimeSliceSearch{
@@ -334,12 +530,17 @@ func (m *memory) TimerRead(ctx context.Context, id string) (*data.Timer, error)
 }
 
 func (m *memory) TimerUpdate(ctx context.Context, id string, t data.TimerPartial) (*data.Timer, error) {
-	m.Lock()
-	defer m.Unlock()
+	defer m.lock(ctx)()
 	timer, ok := m.timers[id]
-	if !ok {
+	if !ok || timer.DeletedAt > 0 {
 		return nil, meta.ErrTimerNotFound
 	}
+	if version := t.Version; version != nil && *version != timer.Version {
+		return nil, meta.ErrTimerConflictVersion
+	}
+	if err := m.validateTimerProject(t); err != nil {
+		return nil, err
+	}
 	//REVIEW: should we give an error if nothing was
 	// actually updated?
 	if archived := t.Archived; archived != nil {
@@ -354,30 +555,85 @@ func (m *memory) TimerUpdate(ctx context.Context, id string, t data.TimerPartial
 	if employeeID := t.EmployeeID; employeeID != nil {
 		timer.EmployeeID = *employeeID
 	}
+	if projectID := t.ProjectID; projectID != nil {
+		timer.ProjectID = *projectID
+	}
 	timer.LastUpdated = time.Now().UnixNano()
 	timer.Version++
+	m.timerAudit(timer)
 	return copyTimer(timer), nil
 }
 
 func (m *memory) TimerDelete(ctx context.Context, id string) error {
-	m.Lock()
-	defer m.Unlock()
-	_, ok := m.timers[id]
-	if !ok {
+	defer m.lock(ctx)()
+	timer, ok := m.timers[id]
+	if !ok || timer.DeletedAt > 0 {
 		return meta.ErrTimerNotFound
 	}
-	for _, timeSlice := range m.timeSlices {
-		if timeSlice.TimerID == id {
-			delete(m.timeSlices, timeSlice.ID)
-		}
+	//KIM: the timer is stopped so that a deleted timer
+	// doesn't have an active time slice
+	if _, err := m.timerStop(id, 0); err != nil {
+		return err
 	}
-	delete(m.timers, id)
+	timer.DeletedAt = time.Now().UnixNano()
+	timer.LastUpdated = timer.DeletedAt
+	timer.Version++
+	m.timerAudit(timer)
 	return nil
 }
 
+func (m *memory) TimerRestore(ctx context.Context, id string) (*data.Timer, error) {
+	defer m.lock(ctx)()
+	timer, ok := m.timers[id]
+	if !ok || timer.DeletedAt <= 0 {
+		return nil, meta.ErrTimerNotFound
+	}
+	timeSlices, err := m.timeSlicesRead(data.TimeSliceSearch{
+		TimerID: &id,
+	})
+	if err != nil {
+		return nil, err
+	}
+	timer = elapsedTime(timer, timeSlices)
+	timer.DeletedAt = 0
+	timer.LastUpdated = time.Now().UnixNano()
+	timer.Version++
+	m.timerAudit(timer)
+	return copyTimer(timer), nil
+}
+
+func (m *memory) TimersPurge(ctx context.Context, deletedBefore int64) ([]*data.Timer, error) {
+	defer m.lock(ctx)()
+	var timers []*data.Timer
+	for id, timer := range m.timers {
+		if timer.DeletedAt <= 0 || timer.DeletedAt > deletedBefore {
+			continue
+		}
+		id := id
+		timeSlices, err := m.timeSlicesRead(data.TimeSliceSearch{
+			TimerID: &id,
+		})
+		if err != nil {
+			return nil, err
+		}
+		timers = append(timers, copyTimer(elapsedTime(timer, timeSlices)))
+		for _, timeSlice := range m.timeSlices {
+			if timeSlice.TimerID == id {
+				delete(m.timeSlices, timeSlice.ID)
+				delete(m.timeSlicesHistory, timeSlice.ID)
+			}
+		}
+		delete(m.timers, id)
+		delete(m.timersHistory, id)
+	}
+	sort.Slice(timers, func(i, j int) bool {
+		return timers[i].ID < timers[j].ID
+	})
+	return timers, nil
+}
+
 func (m *memory) TimersRead(ctx context.Context, search data.TimerSearch) ([]*data.Timer, error) {
-	m.RLock()
-	defer m.RUnlock()
+	defer m.rlock(ctx)()
 	searchFx := func(t *data.Timer) bool {
 		//KIM: this is an inclusive search and is computationally expensive
 		if len(search.IDs) > 0 {
@@ -409,12 +665,48 @@ func (m *memory) TimersRead(ctx context.Context, search data.TimerSearch) ([]*da
 				return false
 			}
 		}
+		switch {
+		case search.ProjectID != nil:
+			if t.ProjectID != *search.ProjectID {
+				return false
+			}
+		case len(search.ProjectIDs) > 0:
+			found := false
+			for _, projectID := range search.ProjectIDs {
+				if t.ProjectID == projectID {
+					found = true
+					break
+				}
+			}
+			if !found {
+				return false
+			}
+		}
 		if search.Completed != nil && t.Completed != *search.Completed {
 			return false
 		}
 		if search.Archived != nil && t.Archived != *search.Archived {
 			return false
 		}
+		if search.Invoiced != nil && (t.InvoiceID != "") != *search.Invoiced {
+			return false
+		}
+		if deleted := search.Deleted != nil && *search.Deleted; (t.DeletedAt > 0) != deleted {
+			return false
+		}
+		if search.Start != nil || search.Finish != nil {
+			//KIM: timers that haven't been completed are considered
+			// open regardless of whether they've been stopped
+			if t.Start <= 0 {
+				return false
+			}
+			if search.Finish != nil && t.Start >= *search.Finish {
+				return false
+			}
+			if search.Start != nil && t.Completed && t.Finish <= *search.Start {
+				return false
+			}
+		}
 		return true
 	}
 	var timers []*data.Timer
@@ -423,16 +715,47 @@ func (m *memory) TimersRead(ctx context.Context, search data.TimerSearch) ([]*da
 			timers = append(timers, copyTimer(timer))
 		}
 	}
+	descending := search.Sort != nil && *search.Sort == data.SortDescending
+	sort.Slice(timers, func(i, j int) bool {
+		return startLess(timers[i].Start, timers[j].Start,
+			timers[i].ID, timers[j].ID, descending)
+	})
+	if cursorID := search.Cursor; cursorID != nil && *cursorID != "" {
+		cursor, ok := m.timers[*cursorID]
+		if !ok {
+			return nil, meta.ErrTimerNotFound
+		}
+		i := sort.Search(len(timers), func(i int) bool {
+			return startLess(cursor.Start, timers[i].Start,
+				cursor.ID, timers[i].ID, descending)
+		})
+		timers = timers[i:]
+	}
+	if limit := search.Limit; limit != nil && *limit > 0 && len(timers) > *limit {
+		timers = timers[:*limit]
+	}
 	return timers, nil
 }
 
-func (m *memory) TimerStart(ctx context.Context, id string) (*data.Timer, error) {
-	m.Lock()
-	defer m.Unlock()
-	timer, ok := m.timers[id]
+func (m *memory) TimerHistory(ctx context.Context, id string) ([]*data.Timer, error) {
+	defer m.rlock(ctx)()
+	history, ok := m.timersHistory[id]
 	if !ok {
 		return nil, meta.ErrTimerNotFound
 	}
+	timers := make([]*data.Timer, 0, len(history))
+	for _, timer := range history {
+		timers = append(timers, copyTimer(timer))
+	}
+	return timers, nil
+}
+
+func (m *memory) TimerStart(ctx context.Context, id string, startTime int64) (*data.Timer, error) {
+	defer m.lock(ctx)()
+	timer, ok := m.timers[id]
+	if !ok || timer.DeletedAt > 0 {
+		return nil, meta.ErrTimerNotFound
+	}
 	timeSlices, err := m.timeSlicesRead(data.TimeSliceSearch{
 		TimerID: &id,
 	})
@@ -443,13 +766,18 @@ func (m *memory) TimerStart(ctx context.Context, id string) (*data.Timer, error)
 	if timer.ActiveTimeSliceID != "" {
 		return copyTimer(timer), nil
 	}
-	start := time.Now().UnixNano()
+	if startTime <= 0 {
+		startTime = time.Now().UnixNano()
+	}
+	if err := validateTimerStart(timeSlices, startTime); err != nil {
+		return nil, err
+	}
 	if len(timeSlices) <= 0 {
-		timer.Start = start
+		timer.Start = startTime
 	}
 	timeSlice, err := m.timeSliceCreate(data.TimeSlicePartial{
 		TimerID: &timer.ID,
-		Start:   &start,
+		Start:   &startTime,
 	})
 	if err != nil {
 		return nil, err
@@ -457,25 +785,27 @@ func (m *memory) TimerStart(ctx context.Context, id string) (*data.Timer, error)
 	timer.ActiveTimeSliceID = timeSlice.ID
 	timer.LastUpdated = time.Now().UnixNano()
 	timer.Version++
+	m.timerAudit(timer)
 	return copyTimer(timer), nil
 }
 
-func (m *memory) TimerStop(ctx context.Context, id string) (*data.Timer, error) {
-	m.Lock()
-	defer m.Unlock()
-	return m.timerStop(id)
+func (m *memory) TimerStop(ctx context.Context, id string, finishTime int64) (*data.Timer, error) {
+	defer m.lock(ctx)()
+	return m.timerStop(id, finishTime)
 }
 
 // TimerSubmit can be used to stop a timer and set completed to true
 func (m *memory) TimerSubmit(ctx context.Context, id string, finishTime int64) (*data.Timer, error) {
-	m.Lock()
-	defer m.Unlock()
-	timer, err := m.timerStop(id)
-	if err != nil {
+	defer m.lock(ctx)()
+	if _, err := m.timerStop(id, 0); err != nil {
 		return nil, err
 	}
+	timer := m.timers[id]
 	timer.Completed = true
 	timer.Finish = finishTime
+	timer.LastUpdated = time.Now().UnixNano()
+	timer.Version++
+	m.timerAudit(timer)
 	return copyTimer(timer), nil
 }
 
@@ -483,8 +813,13 @@ func (m *memory) Serialize() (*meta.SerializedData, error) {
 	m.Lock()
 	defer m.Unlock()
 	serializedData := &meta.SerializedData{
-		Timers:     make(map[string]data.Timer),
-		TimeSlices: make(map[string]data.TimeSlice),
+		Timers:            make(map[string]data.Timer),
+		TimeSlices:        make(map[string]data.TimeSlice),
+		TimersHistory:     make(map[string][]data.Timer),
+		TimeSlicesHistory: make(map[string][]data.TimeSlice),
+		Projects:          make(map[string]data.Project),
+		RateCards:         make(map[string]data.RateCard),
+		Invoices:          make(map[string]data.Invoice),
 	}
 	for id, timer := range m.timers {
 		serializedData.Timers[id] = *timer
@@ -492,6 +827,28 @@ func (m *memory) Serialize() (*meta.SerializedData, error) {
 	for id, timeslice := range m.timeSlices {
 		serializedData.TimeSlices[id] = *timeslice
 	}
+	for id, timers := range m.timersHistory {
+		for _, timer := range timers {
+			serializedData.TimersHistory[id] = append(serializedData.TimersHistory[id], *timer)
+		}
+	}
+	for id, timeSlices := range m.timeSlicesHistory {
+		for _, timeSlice := range timeSlices {
+			serializedData.TimeSlicesHistory[id] = append(serializedData.TimeSlicesHistory[id], *timeSlice)
+		}
+	}
+	for id, project := range m.projects {
+		serializedData.Projects[id] = *project
+	}
+	for id, rateCard := range m.rateCards {
+		serializedData.RateCards[id] = *rateCard
+	}
+	for id, invoice := range m.invoices {
+		serializedData.Invoices[id] = *copyInvoice(invoice)
+	}
+	for _, change := range m.outbox {
+		serializedData.Outbox = append(serializedData.Outbox, *change)
+	}
 	return serializedData, nil
 }
 
@@ -511,5 +868,461 @@ func (m *memory) Deserialize(serializedData *meta.SerializedData) error {
 		timeSlice := serializedData.TimeSlices[id]
 		m.timeSlices[id] = copyTimeSlice(&timeSlice)
 	}
+	m.timersHistory = make(map[string][]*data.Timer)
+	for id, timers := range serializedData.TimersHistory {
+		for i := range timers {
+			m.timersHistory[id] = append(m.timersHistory[id], copyTimer(&timers[i]))
+		}
+	}
+	m.timeSlicesHistory = make(map[string][]*data.TimeSlice)
+	for id, timeSlices := range serializedData.TimeSlicesHistory {
+		for i := range timeSlices {
+			m.timeSlicesHistory[id] = append(m.timeSlicesHistory[id], copyTimeSlice(&timeSlices[i]))
+		}
+	}
+	m.projects = make(map[string]*data.Project)
+	for id := range serializedData.Projects {
+		project := serializedData.Projects[id]
+		m.projects[id] = copyProject(&project)
+	}
+	m.rateCards = make(map[string]*data.RateCard)
+	for id := range serializedData.RateCards {
+		rateCard := serializedData.RateCards[id]
+		m.rateCards[id] = copyRateCard(&rateCard)
+	}
+	m.invoices = make(map[string]*data.Invoice)
+	for id := range serializedData.Invoices {
+		invoice := serializedData.Invoices[id]
+		m.invoices[id] = copyInvoice(&invoice)
+	}
+	m.outbox = nil
+	for i := range serializedData.Outbox {
+		m.outbox = append(m.outbox, copyOutboxChange(&serializedData.Outbox[i]))
+	}
 	return nil
 }
+
+func (m *memory) ProjectCreate(ctx context.Context, p data.ProjectPartial) (*data.Project, error) {
+	defer m.lock(ctx)()
+	if p.Name == nil || *p.Name == "" {
+		return nil, meta.ErrProjectNotCreated
+	}
+	for _, project := range m.projects {
+		if project.Name == *p.Name {
+			return nil, meta.ErrProjectConflictCreate
+		}
+	}
+	id, err := generateID()
+	if err != nil {
+		return nil, err
+	}
+	project := &data.Project{
+		ID:            id,
+		Name:          *p.Name,
+		LastUpdated:   time.Now().UnixNano(),
+		LastUpdatedBy: lastUpdatedBy,
+		Version:       1,
+	}
+	if description := p.Description; description != nil {
+		project.Description = *description
+	}
+	if archived := p.Archived; archived != nil {
+		project.Archived = *archived
+	}
+	m.projects[project.ID] = project
+	return copyProject(project), nil
+}
+
+func (m *memory) ProjectRead(ctx context.Context, id string) (*data.Project, error) {
+	defer m.rlock(ctx)()
+	project, ok := m.projects[id]
+	if !ok {
+		return nil, meta.ErrProjectNotFound
+	}
+	return copyProject(project), nil
+}
+
+func (m *memory) ProjectUpdate(ctx context.Context, id string, p data.ProjectPartial) (*data.Project, error) {
+	defer m.lock(ctx)()
+	project, ok := m.projects[id]
+	if !ok {
+		return nil, meta.ErrProjectNotFound
+	}
+	if name := p.Name; name != nil {
+		if *name == "" {
+			return nil, meta.ErrProjectNotUpdated
+		}
+		for _, existing := range m.projects {
+			if existing.ID != id && existing.Name == *name {
+				return nil, meta.ErrProjectConflictUpdate
+			}
+		}
+		project.Name = *name
+	}
+	if description := p.Description; description != nil {
+		project.Description = *description
+	}
+	if archived := p.Archived; archived != nil {
+		project.Archived = *archived
+	}
+	project.LastUpdated = time.Now().UnixNano()
+	project.Version++
+	return copyProject(project), nil
+}
+
+func (m *memory) ProjectDelete(ctx context.Context, id string) error {
+	defer m.lock(ctx)()
+	if _, ok := m.projects[id]; !ok {
+		return meta.ErrProjectNotFound
+	}
+	//KIM: this mirrors the ON DELETE SET NULL behavior of
+	// the foreign key in the mysql schema
+	for _, timer := range m.timers {
+		if timer.ProjectID == id {
+			timer.ProjectID = ""
+			timer.LastUpdated = time.Now().UnixNano()
+			timer.Version++
+			m.timerAudit(timer)
+		}
+	}
+	delete(m.projects, id)
+	return nil
+}
+
+func (m *memory) ProjectsRead(ctx context.Context, search data.ProjectSearch) ([]*data.Project, error) {
+	defer m.rlock(ctx)()
+	searchFx := func(p *data.Project) bool {
+		//KIM: this is an inclusive search and is computationally expensive
+		if len(search.IDs) > 0 {
+			found := false
+			for _, id := range search.IDs {
+				if p.ID == id {
+					found = true
+					break
+				}
+			}
+			if !found {
+				return false
+			}
+		}
+		if len(search.Names) > 0 {
+			found := false
+			for _, name := range search.Names {
+				if p.Name == name {
+					found = true
+					break
+				}
+			}
+			if !found {
+				return false
+			}
+		}
+		if search.Archived != nil && p.Archived != *search.Archived {
+			return false
+		}
+		return true
+	}
+	var projects []*data.Project
+	for _, project := range m.projects {
+		if searchFx(project) {
+			projects = append(projects, copyProject(project))
+		}
+	}
+	return projects, nil
+}
+
+func (m *memory) RateCardCreate(ctx context.Context, r data.RateCardPartial) (*data.RateCard, error) {
+	defer m.lock(ctx)()
+	if r.Rate == nil || r.Currency == nil || *r.Currency == "" {
+		return nil, meta.ErrRateCardNotCreated
+	}
+	id, err := generateID()
+	if err != nil {
+		return nil, err
+	}
+	rateCard := &data.RateCard{
+		ID:            id,
+		Rate:          *r.Rate,
+		Currency:      *r.Currency,
+		LastUpdated:   time.Now().UnixNano(),
+		LastUpdatedBy: lastUpdatedBy,
+		Version:       1,
+	}
+	if employeeID := r.EmployeeID; employeeID != nil {
+		rateCard.EmployeeID = *employeeID
+	}
+	if projectID := r.ProjectID; projectID != nil {
+		rateCard.ProjectID = *projectID
+	}
+	if effectiveFrom := r.EffectiveFrom; effectiveFrom != nil {
+		rateCard.EffectiveFrom = *effectiveFrom
+	}
+	if effectiveTo := r.EffectiveTo; effectiveTo != nil {
+		rateCard.EffectiveTo = *effectiveTo
+	}
+	m.rateCards[rateCard.ID] = rateCard
+	return copyRateCard(rateCard), nil
+}
+
+func (m *memory) RateCardRead(ctx context.Context, id string) (*data.RateCard, error) {
+	defer m.rlock(ctx)()
+	rateCard, ok := m.rateCards[id]
+	if !ok {
+		return nil, meta.ErrRateCardNotFound
+	}
+	return copyRateCard(rateCard), nil
+}
+
+func (m *memory) RateCardUpdate(ctx context.Context, id string, r data.RateCardPartial) (*data.RateCard, error) {
+	defer m.lock(ctx)()
+	rateCard, ok := m.rateCards[id]
+	if !ok {
+		return nil, meta.ErrRateCardNotFound
+	}
+	if currency := r.Currency; currency != nil {
+		if *currency == "" {
+			return nil, meta.ErrRateCardNotUpdated
+		}
+		rateCard.Currency = *currency
+	}
+	if rate := r.Rate; rate != nil {
+		rateCard.Rate = *rate
+	}
+	if employeeID := r.EmployeeID; employeeID != nil {
+		rateCard.EmployeeID = *employeeID
+	}
+	if projectID := r.ProjectID; projectID != nil {
+		rateCard.ProjectID = *projectID
+	}
+	if effectiveFrom := r.EffectiveFrom; effectiveFrom != nil {
+		rateCard.EffectiveFrom = *effectiveFrom
+	}
+	if effectiveTo := r.EffectiveTo; effectiveTo != nil {
+		rateCard.EffectiveTo = *effectiveTo
+	}
+	rateCard.LastUpdated = time.Now().UnixNano()
+	rateCard.Version++
+	return copyRateCard(rateCard), nil
+}
+
+func (m *memory) RateCardDelete(ctx context.Context, id string) error {
+	defer m.lock(ctx)()
+	if _, ok := m.rateCards[id]; !ok {
+		return meta.ErrRateCardNotFound
+	}
+	delete(m.rateCards, id)
+	return nil
+}
+
+func (m *memory) RateCardsRead(ctx context.Context, search data.RateCardSearch) ([]*data.RateCard, error) {
+	defer m.rlock(ctx)()
+	searchFx := func(r *data.RateCard) bool {
+		//KIM: this is an inclusive search and is computationally expensive
+		if len(search.IDs) > 0 {
+			found := false
+			for _, id := range search.IDs {
+				if r.ID == id {
+					found = true
+					break
+				}
+			}
+			if !found {
+				return false
+			}
+		}
+		if len(search.EmployeeIDs) > 0 {
+			found := false
+			for _, employeeID := range search.EmployeeIDs {
+				if r.EmployeeID == employeeID {
+					found = true
+					break
+				}
+			}
+			if !found {
+				return false
+			}
+		}
+		if len(search.ProjectIDs) > 0 {
+			found := false
+			for _, projectID := range search.ProjectIDs {
+				if r.ProjectID == projectID {
+					found = true
+					break
+				}
+			}
+			if !found {
+				return false
+			}
+		}
+		if search.EffectiveAt != nil && !r.EffectiveAt(*search.EffectiveAt) {
+			return false
+		}
+		return true
+	}
+	var rateCards []*data.RateCard
+	for _, rateCard := range m.rateCards {
+		if searchFx(rateCard) {
+			rateCards = append(rateCards, copyRateCard(rateCard))
+		}
+	}
+	return rateCards, nil
+}
+
+func (m *memory) InvoiceCreate(ctx context.Context, i data.Invoice) (*data.Invoice, error) {
+	defer m.lock(ctx)()
+	if len(i.LineItems) <= 0 {
+		return nil, meta.ErrInvoiceNotCreated
+	}
+	//KIM: all timers are validated before any are mutated so that
+	// the invoice is created (and the timers invoiced) atomically
+	for _, lineItem := range i.LineItems {
+		timer, ok := m.timers[lineItem.TimerID]
+		if !ok || timer.DeletedAt > 0 {
+			return nil, meta.ErrTimerNotFound
+		}
+		if timer.InvoiceID != "" {
+			return nil, meta.ErrTimerInvoiced
+		}
+	}
+	id, err := generateID()
+	if err != nil {
+		return nil, err
+	}
+	invoice := copyInvoice(&i)
+	invoice.ID = id
+	invoice.LastUpdated = time.Now().UnixNano()
+	invoice.LastUpdatedBy = lastUpdatedBy
+	invoice.Version = 1
+	for _, lineItem := range invoice.LineItems {
+		timer := m.timers[lineItem.TimerID]
+		timer.InvoiceID = id
+		timer.LastUpdated = invoice.LastUpdated
+		timer.Version++
+		m.timerAudit(timer)
+	}
+	m.invoices[id] = invoice
+	return copyInvoice(invoice), nil
+}
+
+func (m *memory) InvoiceRead(ctx context.Context, id string) (*data.Invoice, error) {
+	defer m.rlock(ctx)()
+	invoice, ok := m.invoices[id]
+	if !ok {
+		return nil, meta.ErrInvoiceNotFound
+	}
+	return copyInvoice(invoice), nil
+}
+
+func (m *memory) InvoiceDelete(ctx context.Context, id string) error {
+	defer m.lock(ctx)()
+	if _, ok := m.invoices[id]; !ok {
+		return meta.ErrInvoiceNotFound
+	}
+	//KIM: this mirrors the ON DELETE SET NULL behavior of
+	// the foreign key in the mysql schema
+	for _, timer := range m.timers {
+		if timer.InvoiceID == id {
+			timer.InvoiceID = ""
+		}
+	}
+	delete(m.invoices, id)
+	return nil
+}
+
+func (m *memory) InvoicesRead(ctx context.Context, search data.InvoiceSearch) ([]*data.Invoice, error) {
+	defer m.rlock(ctx)()
+	searchFx := func(i *data.Invoice) bool {
+		//KIM: this is an inclusive search and is computationally expensive
+		if len(search.IDs) > 0 {
+			found := false
+			for _, id := range search.IDs {
+				if i.ID == id {
+					found = true
+					break
+				}
+			}
+			if !found {
+				return false
+			}
+		}
+		if len(search.EmployeeIDs) > 0 {
+			found := false
+			for _, employeeID := range search.EmployeeIDs {
+				if i.EmployeeID == employeeID {
+					found = true
+					break
+				}
+			}
+			if !found {
+				return false
+			}
+		}
+		if len(search.ProjectIDs) > 0 {
+			found := false
+			for _, projectID := range search.ProjectIDs {
+				if i.ProjectID == projectID {
+					found = true
+					break
+				}
+			}
+			if !found {
+				return false
+			}
+		}
+		return true
+	}
+	var invoices []*data.Invoice
+	for _, invoice := range m.invoices {
+		if searchFx(invoice) {
+			invoices = append(invoices, copyInvoice(invoice))
+		}
+	}
+	return invoices, nil
+}
+
+func (m *memory) OutboxEnqueue(ctx context.Context, change data.OutboxChange) (*data.OutboxChange, error) {
+	defer m.lock(ctx)()
+	id, err := generateID()
+	if err != nil {
+		return nil, err
+	}
+	change.ID = id
+	change.Enqueued = time.Now().UnixNano()
+	m.outbox = append(m.outbox, copyOutboxChange(&change))
+	return copyOutboxChange(&change), nil
+}
+
+func (m *memory) OutboxRead(ctx context.Context, limit int) ([]*data.OutboxChange, error) {
+	defer m.rlock(ctx)()
+	var changes []*data.OutboxChange
+	for _, change := range m.outbox {
+		if limit > 0 && len(changes) >= limit {
+			break
+		}
+		changes = append(changes, copyOutboxChange(change))
+	}
+	return changes, nil
+}
+
+func (m *memory) OutboxAcknowledge(ctx context.Context, id string) error {
+	defer m.lock(ctx)()
+	for i, change := range m.outbox {
+		if change.ID == id {
+			m.outbox = append(m.outbox[:i], m.outbox[i+1:]...)
+			return nil
+		}
+	}
+	return meta.ErrOutboxChangeNotFound
+}
+
+func (m *memory) OutboxBacklog(ctx context.Context) (int, error) {
+	defer m.rlock(ctx)()
+	return len(m.outbox), nil
+}
+
+func (m *memory) OutboxTransaction(ctx context.Context, fx func(ctx context.Context) error) error {
+	defer m.lock(ctx)()
+	//KIM: mutations can't be rolled back, but since the memory is
+	// locked, the mutations and their changes are seen together
+	return fx(context.WithValue(ctx, contextKeyTransaction, m))
+}
@@ -3,6 +3,7 @@ package mysql
 import (
 	"context"
 	"database/sql"
+	"encoding/json"
 	"fmt"
 	"strings"
 	"time"
@@ -10,6 +11,7 @@ import (
 	"github.com/antonio-alexander/go-bludgeon/timers/data"
 	"github.com/antonio-alexander/go-bludgeon/timers/meta"
 
+	driver_mysql "github.com/go-sql-driver/mysql"
 	"github.com/pkg/errors"
 )
 
@@ -29,10 +31,39 @@ func rowsAffected(result sql.Result, customErr error) error {
 	return nil
 }
 
+// cursorCondition can be used to generate a condition that will omit rows
+// that sort before (or at) the row identified by the cursor, ties in start
+// are broken by id
+func cursorCondition(table, idColumn string, sort *string) string {
+	operator := ">"
+	if sort != nil && *sort == data.SortDescending {
+		operator = "<"
+	}
+	return fmt.Sprintf("(IFNULL(start, 0), %s) %s (SELECT IFNULL(start, 0), %s FROM %s WHERE %s = ?)",
+		idColumn, operator, idColumn, table, idColumn)
+}
+
+// pageClause can be used to generate the order by and (optional) limit
+// clauses for a search, rows are sorted by start then id
+func pageClause(idColumn string, sort *string, limit *int) (string, []interface{}) {
+	var args []interface{}
+
+	direction := "ASC"
+	if sort != nil && *sort == data.SortDescending {
+		direction = "DESC"
+	}
+	clause := fmt.Sprintf(" ORDER BY IFNULL(start, 0) %s, %s %s", direction, idColumn, direction)
+	if limit != nil && *limit > 0 {
+		clause += " LIMIT ?"
+		args = append(args, *limit)
+	}
+	return clause, args
+}
+
 func timerScan(scanFx func(...interface{}) error) (*data.Timer, error) {
-	var employeeID, activeTimeSliceID sql.NullString
+	var employeeID, projectID, invoiceID, activeTimeSliceID sql.NullString
 
-	var start, finish, elapsedTime, lastUpdated sql.NullFloat64
+	var start, finish, elapsedTime, lastUpdated, deletedAt sql.NullFloat64
 
 	timer := &data.Timer{}
 	if err := scanFx(
@@ -44,10 +75,13 @@ func timerScan(scanFx func(...interface{}) error) (*data.Timer, error) {
 		&timer.Archived,
 		&timer.Completed,
 		&employeeID,
+		&projectID,
+		&invoiceID,
 		&activeTimeSliceID,
 		&timer.Version,
 		&lastUpdated,
 		&timer.LastUpdatedBy,
+		&deletedAt,
 	); err != nil {
 		switch {
 		default:
@@ -57,9 +91,11 @@ func timerScan(scanFx func(...interface{}) error) (*data.Timer, error) {
 		}
 	}
 	timer.EmployeeID, timer.ActiveTimeSliceID = employeeID.String, activeTimeSliceID.String
+	timer.ProjectID, timer.InvoiceID = projectID.String, invoiceID.String
 	timer.Start, timer.Finish = int64(start.Float64*secondToNanoSecond), int64(finish.Float64*secondToNanoSecond)
 	timer.ElapsedTime = int64(elapsedTime.Float64 * secondToNanoSecond)
 	timer.LastUpdated = int64(lastUpdated.Float64 * secondToNanoSecond)
+	timer.DeletedAt = int64(deletedAt.Float64 * secondToNanoSecond)
 	return timer, nil
 }
 
@@ -85,9 +121,9 @@ func timeSliceScan(scanFx func(...interface{}) error) (*data.TimeSlice, error) {
 			return nil, meta.ErrTimeSliceNotFound
 		}
 	}
-	timeSlice.Start, timeSlice.Finish = int64(start.Float64*1000), int64(finish.Float64*1000)
-	timeSlice.ElapsedTime = int64(elapsedTime.Float64 * 1000)
-	timeSlice.LastUpdated = int64(lastUpdated.Float64 * 1000)
+	timeSlice.Start, timeSlice.Finish = int64(start.Float64*secondToNanoSecond), int64(finish.Float64*secondToNanoSecond)
+	timeSlice.ElapsedTime = int64(elapsedTime.Float64 * secondToNanoSecond)
+	timeSlice.LastUpdated = int64(lastUpdated.Float64 * secondToNanoSecond)
 	return timeSlice, nil
 }
 
@@ -164,12 +200,28 @@ func timeSliceUpdate(ctx context.Context, db interface {
 	if len(updates) <= 0 || len(args) <= 0 {
 		return nil, errors.New("nothing to update")
 	}
+	conditions := []string{column + " = ?"}
 	args = append(args, id)
-	query := fmt.Sprintf(`UPDATE %s SET %s WHERE %s = ?;`, tableTimeSlices, strings.Join(updates, ","), column)
-	_, err := db.ExecContext(ctx, query, args...)
+	if version := timeSlicePartial.Version; version != nil {
+		conditions = append(conditions, "version = ?")
+		args = append(args, *version)
+	}
+	query := fmt.Sprintf(`UPDATE %s SET %s WHERE %s;`, tableTimeSlices,
+		strings.Join(updates, ","), strings.Join(conditions, " AND "))
+	result, err := db.ExecContext(ctx, query, args...)
 	if err != nil {
 		return nil, err
 	}
+	if version := timeSlicePartial.Version; version != nil {
+		if err := rowsAffected(result, meta.ErrTimeSliceConflictVersion); err != nil {
+			//KIM: no rows are affected if the time slice doesn't exist
+			// or if its version doesn't match
+			if _, errRead := timeSliceRead(ctx, db, id); errRead != nil {
+				return nil, errRead
+			}
+			return nil, err
+		}
+	}
 	return timeSliceRead(ctx, db, id)
 }
 
@@ -208,8 +260,10 @@ func timerRead(ctx context.Context, db interface {
 	case int64:
 		condition = fmt.Sprintf("timer_id = (SELECT id FROM %s WHERE aux_id = ?)", tableTimers)
 	}
+	//KIM: deleted timers (in the trash) are treated as not found
 	query := fmt.Sprintf(`SELECT timer_id, start, finish, elapsed_time, comment, archived, completed, 
-		employee_id, active_time_slice_id, version, last_updated, last_updated_by FROM %s WHERE %s;`,
+		employee_id, project_id, invoice_id, active_time_slice_id, version, last_updated, last_updated_by,
+		deleted_at FROM %s WHERE %s AND deleted_at IS NULL;`,
 		tableTimersV1, condition)
 	row := db.QueryRowContext(ctx, query, id)
 	return timerScan(row.Scan)
@@ -236,6 +290,10 @@ func timerUpdate(ctx context.Context, db interface {
 		updates = append(updates, "employee_id = ?")
 		args = append(args, employeeID)
 	}
+	if projectID := timerPartial.ProjectID; projectID != nil {
+		updates = append(updates, "project_id = NULLIF(?, '')")
+		args = append(args, projectID)
+	}
 	if archived := timerPartial.Archived; archived != nil {
 		updates = append(updates, "archived = ?")
 		args = append(args, archived)
@@ -247,24 +305,104 @@ func timerUpdate(ctx context.Context, db interface {
 	if len(updates) <= 0 || len(args) <= 0 {
 		return nil, errors.New("nothing to update")
 	}
+	conditions := []string{"id = ?", "deleted_at IS NULL"}
 	args = append(args, id)
-	query := fmt.Sprintf(`UPDATE %s SET %s WHERE id = ?;`, tableTimers, strings.Join(updates, ","))
+	if version := timerPartial.Version; version != nil {
+		conditions = append(conditions, "version = ?")
+		args = append(args, *version)
+	}
+	query := fmt.Sprintf(`UPDATE %s SET %s WHERE %s;`, tableTimers,
+		strings.Join(updates, ","), strings.Join(conditions, " AND "))
 	result, err := db.ExecContext(ctx, query, args...)
 	if err != nil {
-		return nil, err
+		return nil, timerProjectError(err)
 	}
 	if err := rowsAffected(result, meta.ErrTimerNotFound); err != nil {
+		//KIM: no rows are affected if the timer doesn't exist
+		// or if its version doesn't match
+		if timerPartial.Version != nil {
+			if _, errRead := timerRead(ctx, db, id); errRead == nil {
+				return nil, meta.ErrTimerConflictVersion
+			}
+		}
 		return nil, err
 	}
 	return timerRead(ctx, db, id)
 }
 
+// validateTimerStart mirrors the validate_time_slice_start_insert trigger, but
+// also prevents starting before an existing time slice since the new time slice
+// would be active and overlap it
+func validateTimerStart(ctx context.Context, db interface {
+	QueryRowContext(ctx context.Context, query string, args ...interface{}) *sql.Row
+}, id string, start int64) error {
+	var count int
+
+	tStart := time.Unix(0, start)
+	query := fmt.Sprintf(`SELECT COUNT(*) FROM %s WHERE timer_id = ? AND (? <= start OR ? < finish);`,
+		tableTimeSlices)
+	if err := db.QueryRowContext(ctx, query, id, tStart, tStart).Scan(&count); err != nil {
+		return err
+	}
+	if count > 0 {
+		return meta.ErrTimerConflictStart
+	}
+	return nil
+}
+
+// validateTimerStop confirms that the active time slice can be finished at the
+// given time; no other time slice for the timer can start between its start and
+// finish
+func validateTimerStop(ctx context.Context, db interface {
+	QueryRowContext(ctx context.Context, query string, args ...interface{}) *sql.Row
+}, timeSlice *data.TimeSlice, finish int64) error {
+	var count int
+
+	if finish <= timeSlice.Start {
+		return meta.ErrTimerConflictStop
+	}
+	query := fmt.Sprintf(`SELECT COUNT(*) FROM %s WHERE timer_id = ? AND id <> ? AND start BETWEEN ? AND ?;`,
+		tableTimeSlices)
+	if err := db.QueryRowContext(ctx, query, timeSlice.TimerID, timeSlice.ID,
+		time.Unix(0, timeSlice.Start), time.Unix(0, finish)).Scan(&count); err != nil {
+		return err
+	}
+	if count > 0 {
+		return meta.ErrTimerConflictStop
+	}
+	return nil
+}
+
+// timerProjectError can be used to convert the error returned when a timer
+// references a project that doesn't exist (the foreign key) into a not found
+// error
+func timerProjectError(err error) error {
+	if err, ok := err.(*driver_mysql.MySQLError); ok && err.Number == errNoReferencedRow {
+		return meta.ErrProjectNotFound
+	}
+	return err
+}
+
+// timerTouch can be used to update the last updated of a timer when its
+// time slices change (e.g. start or stop), the timers_audit_info_update
+// trigger will increment its version and timers_audit_update will audit it
+func timerTouch(ctx context.Context, db interface {
+	ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error)
+}, id string) error {
+	query := fmt.Sprintf("UPDATE %s SET last_updated = CURRENT_TIMESTAMP(6) WHERE id = ? AND deleted_at IS NULL;", tableTimers)
+	result, err := db.ExecContext(ctx, query, id)
+	if err != nil {
+		return err
+	}
+	return rowsAffected(result, meta.ErrTimerNotFound)
+}
+
 func timerStop(ctx context.Context, db interface {
 	Exec(query string, args ...interface{}) (sql.Result, error)
 	ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error)
 	QueryRow(query string, args ...interface{}) *sql.Row
 	QueryRowContext(ctx context.Context, query string, args ...interface{}) *sql.Row
-}, id string) (*data.Timer, error) {
+}, id string, finish int64) (*data.Timer, error) {
 	timer, err := timerRead(ctx, db, id)
 	if err != nil {
 		return nil, err
@@ -272,11 +410,222 @@ func timerStop(ctx context.Context, db interface {
 	if timer.ActiveTimeSliceID == "" {
 		return timer, nil
 	}
-	finish := time.Now().UnixNano()
+	if finish <= 0 {
+		finish = time.Now().UnixNano()
+	}
+	timeSlice, err := timeSliceRead(ctx, db, timer.ActiveTimeSliceID)
+	if err != nil {
+		return nil, err
+	}
+	if err := validateTimerStop(ctx, db, timeSlice, finish); err != nil {
+		return nil, err
+	}
 	if _, err := timeSliceUpdate(ctx, db, timer.ActiveTimeSliceID, data.TimeSlicePartial{
 		Finish: &finish,
 	}); err != nil {
 		return nil, err
 	}
+	if err := timerTouch(ctx, db, id); err != nil {
+		return nil, err
+	}
 	return timerRead(ctx, db, id)
 }
+
+func projectScan(scanFx func(...interface{}) error) (*data.Project, error) {
+	var lastUpdated sql.NullFloat64
+
+	project := &data.Project{}
+	if err := scanFx(
+		&project.ID,
+		&project.Name,
+		&project.Description,
+		&project.Archived,
+		&project.Version,
+		&lastUpdated,
+		&project.LastUpdatedBy,
+	); err != nil {
+		switch {
+		default:
+			return nil, err
+		case err == sql.ErrNoRows:
+			return nil, meta.ErrProjectNotFound
+		}
+	}
+	project.LastUpdated = int64(lastUpdated.Float64 * secondToNanoSecond)
+	return project, nil
+}
+
+func outboxChangeScan(scanFx func(...interface{}) error) (*data.OutboxChange, error) {
+	var enqueued sql.NullFloat64
+	var payload sql.NullString
+
+	change := &data.OutboxChange{}
+	if err := scanFx(
+		&change.ID,
+		&change.WhenChanged,
+		&change.ChangedBy,
+		&change.DataId,
+		&change.DataType,
+		&change.DataAction,
+		&change.DataVersion,
+		&payload,
+		&enqueued,
+	); err != nil {
+		switch {
+		default:
+			return nil, err
+		case err == sql.ErrNoRows:
+			return nil, meta.ErrOutboxChangeNotFound
+		}
+	}
+	if payload.Valid && payload.String != "" {
+		change.Payload = json.RawMessage(payload.String)
+	}
+	change.Enqueued = int64(enqueued.Float64 * secondToNanoSecond)
+	return change, nil
+}
+
+func projectRead(ctx context.Context, db interface {
+	Exec(query string, args ...interface{}) (sql.Result, error)
+	ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error)
+	QueryRow(query string, args ...interface{}) *sql.Row
+	QueryRowContext(ctx context.Context, query string, args ...interface{}) *sql.Row
+}, id interface{}) (*data.Project, error) {
+	var condition string
+
+	switch id.(type) {
+	case string:
+		condition = "project_id = ?"
+	case int64:
+		condition = fmt.Sprintf("project_id = (SELECT id FROM %s WHERE aux_id = ?)", tableProjects)
+	}
+	query := fmt.Sprintf(`SELECT project_id, name, description, archived, version,
+		last_updated, last_updated_by FROM %s WHERE %s;`, tableProjectsV1, condition)
+	row := db.QueryRowContext(ctx, query, id)
+	return projectScan(row.Scan)
+}
+
+func rateCardScan(scanFx func(...interface{}) error) (*data.RateCard, error) {
+	var employeeID, projectID sql.NullString
+	var effectiveFrom, effectiveTo, lastUpdated sql.NullFloat64
+
+	rateCard := &data.RateCard{}
+	if err := scanFx(
+		&rateCard.ID,
+		&employeeID,
+		&projectID,
+		&rateCard.Rate,
+		&rateCard.Currency,
+		&effectiveFrom,
+		&effectiveTo,
+		&rateCard.Version,
+		&lastUpdated,
+		&rateCard.LastUpdatedBy,
+	); err != nil {
+		switch {
+		default:
+			return nil, err
+		case err == sql.ErrNoRows:
+			return nil, meta.ErrRateCardNotFound
+		}
+	}
+	rateCard.EmployeeID, rateCard.ProjectID = employeeID.String, projectID.String
+	rateCard.EffectiveFrom = int64(effectiveFrom.Float64 * secondToNanoSecond)
+	rateCard.EffectiveTo = int64(effectiveTo.Float64 * secondToNanoSecond)
+	rateCard.LastUpdated = int64(lastUpdated.Float64 * secondToNanoSecond)
+	return rateCard, nil
+}
+
+func rateCardRead(ctx context.Context, db interface {
+	Exec(query string, args ...interface{}) (sql.Result, error)
+	ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error)
+	QueryRow(query string, args ...interface{}) *sql.Row
+	QueryRowContext(ctx context.Context, query string, args ...interface{}) *sql.Row
+}, id interface{}) (*data.RateCard, error) {
+	var condition string
+
+	switch id.(type) {
+	case string:
+		condition = "rate_card_id = ?"
+	case int64:
+		condition = fmt.Sprintf("rate_card_id = (SELECT id FROM %s WHERE aux_id = ?)", tableRateCards)
+	}
+	query := fmt.Sprintf(`SELECT rate_card_id, employee_id, project_id, rate, currency, effective_from,
+		effective_to, version, last_updated, last_updated_by FROM %s WHERE %s;`, tableRateCardsV1, condition)
+	row := db.QueryRowContext(ctx, query, id)
+	return rateCardScan(row.Scan)
+}
+
+func invoiceScan(scanFx func(...interface{}) error) (*data.Invoice, error) {
+	var employeeID, projectID sql.NullString
+	var start, finish, lastUpdated sql.NullFloat64
+
+	invoice := &data.Invoice{}
+	if err := scanFx(
+		&invoice.ID,
+		&employeeID,
+		&projectID,
+		&start,
+		&finish,
+		&invoice.Currency,
+		&invoice.Total,
+		&invoice.Version,
+		&lastUpdated,
+		&invoice.LastUpdatedBy,
+	); err != nil {
+		switch {
+		default:
+			return nil, err
+		case err == sql.ErrNoRows:
+			return nil, meta.ErrInvoiceNotFound
+		}
+	}
+	invoice.EmployeeID, invoice.ProjectID = employeeID.String, projectID.String
+	invoice.Start, invoice.Finish = int64(start.Float64*secondToNanoSecond), int64(finish.Float64*secondToNanoSecond)
+	invoice.LastUpdated = int64(lastUpdated.Float64 * secondToNanoSecond)
+	return invoice, nil
+}
+
+func invoiceLineItemsRead(ctx context.Context, db interface {
+	QueryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error)
+}, invoice *data.Invoice) (*data.Invoice, error) {
+	query := fmt.Sprintf(`SELECT timer_id, rate_card_id, elapsed_time, rate, amount
+		FROM %s WHERE invoice_id = ? ORDER BY timer_id;`, tableInvoiceLineItems)
+	rows, err := db.QueryContext(ctx, query, invoice.ID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var lineItem data.InvoiceLineItem
+
+		if err := rows.Scan(
+			&lineItem.TimerID,
+			&lineItem.RateCardID,
+			&lineItem.ElapsedTime,
+			&lineItem.Rate,
+			&lineItem.Amount,
+		); err != nil {
+			return nil, err
+		}
+		invoice.LineItems = append(invoice.LineItems, lineItem)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return invoice, nil
+}
+
+func invoiceRead(ctx context.Context, db interface {
+	QueryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error)
+	QueryRowContext(ctx context.Context, query string, args ...interface{}) *sql.Row
+}, id string) (*data.Invoice, error) {
+	query := fmt.Sprintf(`SELECT invoice_id, employee_id, project_id, start, finish, currency, total,
+		version, last_updated, last_updated_by FROM %s WHERE invoice_id = ?;`, tableInvoicesV1)
+	row := db.QueryRowContext(ctx, query, id)
+	invoice, err := invoiceScan(row.Scan)
+	if err != nil {
+		return nil, err
+	}
+	return invoiceLineItemsRead(ctx, db, invoice)
+}
@@ -2,7 +2,9 @@ package mysql
 
 import (
 	"context"
+	"database/sql"
 	"fmt"
+	"sort"
 	"strings"
 	"sync"
 	"time"
@@ -14,17 +16,36 @@ import (
 
 	internal_mysql "github.com/antonio-alexander/go-bludgeon/internal/meta/mysql"
 
-	_ "github.com/go-sql-driver/mysql" //import for driver support
+	driver_mysql "github.com/go-sql-driver/mysql" //import for driver support
 )
 
+// errDuplicateEntry is the mysql error number returned when
+// a unique constraint is violated
+const errDuplicateEntry uint16 = 1062
+
+// errNoReferencedRow is the mysql error number returned when a
+// foreign key references a row that doesn't exist
+const errNoReferencedRow uint16 = 1452
+
 // query constants
 const (
 	//REVIEW: figure out why this was originally here
 	// tableEmployees    string = "employees"
-	tableTimers       string = "timers"
-	tableTimeSlices   string = "time_slices"
-	tableTimersV1     string = "timers_v1"
-	tableTimeSlicesV1 string = "time_slices_v1"
+	tableTimers            string = "timers"
+	tableTimeSlices        string = "time_slices"
+	tableTimersV1          string = "timers_v1"
+	tableTimeSlicesV1      string = "time_slices_v1"
+	tableTimersAuditV1     string = "timers_audit_v1"
+	tableTimeSlicesAuditV1 string = "time_slices_audit_v1"
+	tableProjects          string = "projects"
+	tableProjectsV1        string = "projects_v1"
+	tableRateCards         string = "rate_cards"
+	tableRateCardsV1       string = "rate_cards_v1"
+	tableInvoices          string = "invoices"
+	tableInvoicesV1        string = "invoices_v1"
+	tableInvoiceLineItems  string = "invoice_line_items"
+	tableTimersOutbox      string = "timers_outbox"
+	tableTimersOutboxV1    string = "timers_outbox_v1"
 )
 
 type mysql struct {
@@ -34,6 +55,41 @@ type mysql struct {
 	logger.Logger
 }
 
+type contextKey string
+
+// contextKeyTransaction is the key of the transaction stored in the
+// context of an outbox transaction
+const contextKeyTransaction contextKey = "transaction"
+
+// transaction is stored in the context of an outbox transaction, mutations
+// made with that context share its (sql) transaction
+type transaction struct {
+	*sql.Tx
+	mysql *mysql
+}
+
+// tx is the transaction returned by begin, if it's shared (i.e., it's the
+// transaction of an outbox transaction) it's only committed (or rolled
+// back) by the outbox transaction
+type tx struct {
+	*sql.Tx
+	shared bool
+}
+
+func (t *tx) Commit() error {
+	if t.shared {
+		return nil
+	}
+	return t.Tx.Commit()
+}
+
+func (t *tx) Rollback() error {
+	if t.shared {
+		return nil
+	}
+	return t.Tx.Rollback()
+}
+
 // New will instante a concrete implementation of the MySQL
 // pointer that implements the meta abstraction for interacting
 // with timers, if Configuration provided as a parameter, it will
@@ -41,6 +97,11 @@ type mysql struct {
 func New() interface {
 	meta.Timer
 	meta.TimeSlice
+	meta.Project
+	meta.RateCard
+	meta.Invoice
+	meta.Purger
+	meta.Outbox
 	internal.Initializer
 	internal.Configurer
 	internal.Parameterizer
@@ -75,7 +136,7 @@ func (m *mysql) SetUtilities(parameters ...interface{}) {
 // all fields are available, the only fields that will
 // actually be set are: timer_id and comment
 func (m *mysql) TimerCreate(ctx context.Context, timerValues data.TimerPartial) (*data.Timer, error) {
-	tx, err := m.Begin()
+	tx, err := m.begin(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -90,6 +151,11 @@ func (m *mysql) TimerCreate(ctx context.Context, timerValues data.TimerPartial)
 		values = append(values, "?")
 		args = append(args, employeeID)
 	}
+	if projectID := timerValues.ProjectID; projectID != nil {
+		columns = append(columns, "project_id")
+		values = append(values, "NULLIF(?, '')")
+		args = append(args, projectID)
+	}
 	if completed := timerValues.Completed; completed != nil {
 		columns = append(columns, "completed")
 		values = append(values, "?")
@@ -108,7 +174,7 @@ func (m *mysql) TimerCreate(ctx context.Context, timerValues data.TimerPartial)
 	query := fmt.Sprintf("INSERT INTO %s(%s) VALUES(%s);", tableTimers, strings.Join(columns, ","), strings.Join(values, ","))
 	result, err := tx.ExecContext(ctx, query, args...)
 	if err != nil {
-		return nil, err
+		return nil, timerProjectError(err)
 	}
 	timerID, err := result.LastInsertId()
 	if err != nil {
@@ -128,14 +194,14 @@ func (m *mysql) TimerCreate(ctx context.Context, timerValues data.TimerPartial)
 // timer, values such as start/finish and elapsed time are
 // "calculated" values rather than values that can be set
 func (m *mysql) TimerRead(ctx context.Context, id string) (*data.Timer, error) {
-	return timerRead(ctx, m, id)
+	return timerRead(ctx, m.db(ctx), id)
 }
 
 // TimerUpdate can be used to update values a given timer
 // not associated with timer operations, values such as:
 // comment, archived and completed
 func (m *mysql) TimerUpdate(ctx context.Context, id string, timerPartial data.TimerPartial) (*data.Timer, error) {
-	tx, err := m.Begin()
+	tx, err := m.begin(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -150,14 +216,118 @@ func (m *mysql) TimerUpdate(ctx context.Context, id string, timerPartial data.Ti
 	return timer, nil
 }
 
-// TimerDelete can be used to delete a timer if it exists
+// TimerDelete can be used to delete a timer if it exists, the timer
+// is moved to the trash (stopped if active) and can be restored until
+// it's purged
 func (m *mysql) TimerDelete(ctx context.Context, id string) error {
-	query := fmt.Sprintf("DELETE FROM %s WHERE id = ?", tableTimers)
-	result, err := m.ExecContext(ctx, query, id)
+	tx, err := m.begin(ctx)
 	if err != nil {
 		return err
 	}
-	return rowsAffected(result, meta.ErrTimerNotFound)
+	defer tx.Rollback()
+	//KIM: the timer is stopped so that a deleted timer
+	// doesn't have an active time slice
+	if _, err := timerStop(ctx, tx, id, 0); err != nil {
+		return err
+	}
+	query := fmt.Sprintf("UPDATE %s SET deleted_at = CURRENT_TIMESTAMP(6) WHERE id = ? AND deleted_at IS NULL;", tableTimers)
+	result, err := tx.ExecContext(ctx, query, id)
+	if err != nil {
+		return err
+	}
+	if err := rowsAffected(result, meta.ErrTimerNotFound); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+// TimerRestore can be used to restore a deleted timer (from the trash)
+func (m *mysql) TimerRestore(ctx context.Context, id string) (*data.Timer, error) {
+	tx, err := m.begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+	query := fmt.Sprintf("UPDATE %s SET deleted_at = NULL WHERE id = ? AND deleted_at IS NOT NULL;", tableTimers)
+	result, err := tx.ExecContext(ctx, query, id)
+	if err != nil {
+		return nil, err
+	}
+	if err := rowsAffected(result, meta.ErrTimerNotFound); err != nil {
+		return nil, err
+	}
+	timer, err := timerRead(ctx, tx, id)
+	if err != nil {
+		return nil, err
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return timer, nil
+}
+
+// TimersPurge can be used to permanently delete timers (and their
+// time slices) that were deleted at or before the given time, the
+// purged timers (as they were before being purged) are returned
+func (m *mysql) TimersPurge(ctx context.Context, deletedBefore int64) ([]*data.Timer, error) {
+	var parameters []string
+	var args []interface{}
+	var timers []*data.Timer
+
+	tx, err := m.begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+	query := fmt.Sprintf("SELECT id FROM %s WHERE deleted_at IS NOT NULL AND deleted_at <= ? ORDER BY id FOR UPDATE;",
+		tableTimers)
+	rows, err := tx.QueryContext(ctx, query, time.Unix(0, deletedBefore))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		args = append(args, id)
+		parameters = append(parameters, "?")
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	if len(args) <= 0 {
+		return nil, nil
+	}
+	query = fmt.Sprintf(`SELECT timer_id, start, finish, elapsed_time, comment, archived, completed,
+		employee_id, project_id, invoice_id, active_time_slice_id, version, last_updated, last_updated_by,
+		deleted_at FROM %s WHERE timer_id IN(%s) ORDER BY timer_id;`, tableTimersV1, strings.Join(parameters, ","))
+	rows, err = tx.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		timer, err := timerScan(rows.Scan)
+		if err != nil {
+			return nil, err
+		}
+		timers = append(timers, timer)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	//KIM: time slices (and audit) are removed by the ON DELETE
+	// CASCADE of their foreign keys
+	query = fmt.Sprintf("DELETE FROM %s WHERE id IN(%s);", tableTimers, strings.Join(parameters, ","))
+	if _, err := tx.ExecContext(ctx, query, args...); err != nil {
+		return nil, err
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return timers, nil
 }
 
 // TimersRead can be used to read one or more timers depending
@@ -188,6 +358,18 @@ func (m *mysql) TimersRead(ctx context.Context, search data.TimerSearch) ([]*dat
 		}
 		searchParameters = append(searchParameters, fmt.Sprintf("employee_id IN(%s)", strings.Join(parameters, ",")))
 	}
+	switch {
+	case search.ProjectID != nil:
+		searchParameters = append(searchParameters, "project_id = ?")
+		args = append(args, search.ProjectID)
+	case len(search.ProjectIDs) > 0:
+		var parameters []string
+		for _, projectId := range search.ProjectIDs {
+			args = append(args, projectId)
+			parameters = append(parameters, "?")
+		}
+		searchParameters = append(searchParameters, fmt.Sprintf("project_id IN(%s)", strings.Join(parameters, ",")))
+	}
 	if completed := search.Completed; completed != nil {
 		searchParameters = append(searchParameters, "completed = ?")
 		args = append(args, completed)
@@ -196,16 +378,73 @@ func (m *mysql) TimersRead(ctx context.Context, search data.TimerSearch) ([]*dat
 		searchParameters = append(searchParameters, "archived = ?")
 		args = append(args, archived)
 	}
-	if len(searchParameters) > 0 {
-		query = fmt.Sprintf(`SELECT timer_id, start, finish, elapsed_time, comment, archived, completed, 
-		employee_id, active_time_slice_id, version, last_updated, last_updated_by FROM %s WHERE %s`,
-			tableTimersV1, strings.Join(searchParameters, " AND "))
-	} else {
-		query = fmt.Sprintf(`SELECT timer_id, start, finish, elapsed_time, comment, archived, completed, 
-		employee_id, active_time_slice_id, version, last_updated, last_updated_by FROM %s`,
-			tableTimersV1)
+	if invoiced := search.Invoiced; invoiced != nil {
+		switch {
+		case *invoiced:
+			searchParameters = append(searchParameters, "invoice_id IS NOT NULL")
+		default:
+			searchParameters = append(searchParameters, "invoice_id IS NULL")
+		}
+	}
+	deletedCondition := "deleted_at IS NULL"
+	if deleted := search.Deleted; deleted != nil && *deleted {
+		deletedCondition = "deleted_at IS NOT NULL"
+	}
+	searchParameters = append(searchParameters, deletedCondition)
+	if search.Start != nil || search.Finish != nil {
+		searchParameters = append(searchParameters, "start IS NOT NULL")
+	}
+	if finish := search.Finish; finish != nil {
+		searchParameters = append(searchParameters, "start < ?")
+		args = append(args, float64(*finish)/secondToNanoSecond)
+	}
+	if start := search.Start; start != nil {
+		searchParameters = append(searchParameters, "(completed = FALSE OR finish > ?)")
+		args = append(args, float64(*start)/secondToNanoSecond)
+	}
+	if cursor := search.Cursor; cursor != nil && *cursor != "" {
+		var count int
+
+		query := fmt.Sprintf("SELECT COUNT(*) FROM %s WHERE timer_id = ? AND %s;", tableTimersV1, deletedCondition)
+		if err := m.db(ctx).QueryRowContext(ctx, query, *cursor).Scan(&count); err != nil {
+			return nil, err
+		}
+		if count <= 0 {
+			return nil, meta.ErrTimerNotFound
+		}
+		searchParameters = append(searchParameters, cursorCondition(tableTimersV1, "timer_id", search.Sort))
+		args = append(args, *cursor)
+	}
+	query = fmt.Sprintf(`SELECT timer_id, start, finish, elapsed_time, comment, archived, completed, 
+		employee_id, project_id, invoice_id, active_time_slice_id, version, last_updated, last_updated_by,
+		deleted_at FROM %s WHERE %s`, tableTimersV1, strings.Join(searchParameters, " AND "))
+	clause, clauseArgs := pageClause("timer_id", search.Sort, search.Limit)
+	query, args = query+clause, append(args, clauseArgs...)
+	rows, err := m.db(ctx).QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		timer, err := timerScan(rows.Scan)
+		if err != nil {
+			return nil, err
+		}
+		timers = append(timers, timer)
 	}
-	rows, err := m.QueryContext(ctx, query, args...)
+	return timers, nil
+}
+
+// TimerHistory can be used to read every version of a given
+// timer (oldest first), values such as start/finish and elapsed
+// time are calculated (from the time slices) when audited
+func (m *mysql) TimerHistory(ctx context.Context, id string) ([]*data.Timer, error) {
+	var timers []*data.Timer
+
+	query := fmt.Sprintf(`SELECT timer_id, start, finish, elapsed_time, comment, archived, completed,
+		employee_id, project_id, invoice_id, active_time_slice_id, version, last_updated, last_updated_by,
+		deleted_at FROM %s WHERE timer_id = ? ORDER BY version ASC;`, tableTimersAuditV1)
+	rows, err := m.db(ctx).QueryContext(ctx, query, id)
 	if err != nil {
 		return nil, err
 	}
@@ -217,25 +456,36 @@ func (m *mysql) TimersRead(ctx context.Context, search data.TimerSearch) ([]*dat
 		}
 		timers = append(timers, timer)
 	}
+	if len(timers) <= 0 {
+		return nil, meta.ErrTimerNotFound
+	}
 	return timers, nil
 }
 
 // TimerStart can be used to start a given timer or do nothing
 // if the timer is already started
-func (m *mysql) TimerStart(ctx context.Context, id string) (*data.Timer, error) {
-	tx, err := m.Begin()
+func (m *mysql) TimerStart(ctx context.Context, id string, startTime int64) (*data.Timer, error) {
+	tx, err := m.begin(ctx)
 	if err != nil {
 		return nil, err
 	}
 	defer tx.Rollback()
-	start := time.Now().UnixNano()
+	if startTime <= 0 {
+		startTime = time.Now().UnixNano()
+	}
+	if err := validateTimerStart(ctx, tx, id, startTime); err != nil {
+		return nil, err
+	}
 	if _, err = timeSliceCreate(ctx, tx, data.TimeSlicePartial{
 		TimerID: &id,
-		Start:   &start,
+		Start:   &startTime,
 	}); err != nil {
 		//KIM: this will fail if an active time slice already exists
 		return nil, err
 	}
+	if err := timerTouch(ctx, tx, id); err != nil {
+		return nil, err
+	}
 	timer, err := timerRead(ctx, tx, id)
 	if err != nil {
 		return nil, err
@@ -248,13 +498,13 @@ func (m *mysql) TimerStart(ctx context.Context, id string) (*data.Timer, error)
 
 // TimerStop can be used to stop a given timer or do nothing
 // if the timer is not started
-func (m *mysql) TimerStop(ctx context.Context, id string) (*data.Timer, error) {
-	tx, err := m.Begin()
+func (m *mysql) TimerStop(ctx context.Context, id string, finishTime int64) (*data.Timer, error) {
+	tx, err := m.begin(ctx)
 	if err != nil {
 		return nil, err
 	}
 	defer tx.Rollback()
-	timer, err := timerStop(ctx, tx, id)
+	timer, err := timerStop(ctx, tx, id, finishTime)
 	if err != nil {
 		return nil, err
 	}
@@ -266,12 +516,12 @@ func (m *mysql) TimerStop(ctx context.Context, id string) (*data.Timer, error) {
 
 // TimerSubmit can be used to stop a timer and set completed to true
 func (m *mysql) TimerSubmit(ctx context.Context, id string, finishTime int64) (*data.Timer, error) {
-	tx, err := m.Begin()
+	tx, err := m.begin(ctx)
 	if err != nil {
 		return nil, err
 	}
 	defer tx.Rollback()
-	if _, err := timerStop(ctx, tx, id); err != nil {
+	if _, err := timerStop(ctx, tx, id, 0); err != nil {
 		return nil, err
 	}
 	completed := true
@@ -290,35 +540,167 @@ func (m *mysql) TimerSubmit(ctx context.Context, id string, finishTime int64) (*
 // TimeSliceCreate can be used to create a single time
 // slice
 func (m *mysql) TimeSliceCreate(ctx context.Context, timeSlicePartial data.TimeSlicePartial) (*data.TimeSlice, error) {
-	return timeSliceCreate(ctx, m, timeSlicePartial)
+	return timeSliceCreate(ctx, m.db(ctx), timeSlicePartial)
 }
 
 // TimeSliceRead can be used to read an existing time slice
 func (m *mysql) TimeSliceRead(ctx context.Context, timeSliceID string) (*data.TimeSlice, error) {
-	return timeSliceRead(ctx, m, timeSliceID)
+	return timeSliceRead(ctx, m.db(ctx), timeSliceID)
 }
 
 // TimeSliceUpdate can be used to update an existing time slice
 func (m *mysql) TimeSliceUpdate(ctx context.Context, timeSliceID string, timeSlicePartial data.TimeSlicePartial) (*data.TimeSlice, error) {
-	return timeSliceUpdate(ctx, m, timeSliceID, timeSlicePartial)
+	return timeSliceUpdate(ctx, m.db(ctx), timeSliceID, timeSlicePartial)
 }
 
 // TimeSliceDelete can be used to delete an existing time slice
 func (m *mysql) TimeSliceDelete(ctx context.Context, timeSliceID string) error {
 	query := fmt.Sprintf("DELETE FROM %s WHERE id=?", tableTimeSlices)
-	result, err := m.ExecContext(ctx, query, timeSliceID)
+	result, err := m.db(ctx).ExecContext(ctx, query, timeSliceID)
 	if err != nil {
 		return err
 	}
 	return rowsAffected(result, meta.ErrTimerNotFound)
 }
 
+// TimeSliceHistory can be used to read every version of a given
+// time slice (oldest first)
+func (m *mysql) TimeSliceHistory(ctx context.Context, id string) ([]*data.TimeSlice, error) {
+	var timeSlices []*data.TimeSlice
+
+	query := fmt.Sprintf(`SELECT time_slice_id, start, finish, completed, elapsed_time, timer_id,
+		version, last_updated, last_updated_by FROM %s WHERE time_slice_id = ? ORDER BY version ASC;`,
+		tableTimeSlicesAuditV1)
+	rows, err := m.db(ctx).QueryContext(ctx, query, id)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		timeSlice, err := timeSliceScan(rows.Scan)
+		if err != nil {
+			return nil, err
+		}
+		timeSlices = append(timeSlices, timeSlice)
+	}
+	if len(timeSlices) <= 0 {
+		return nil, meta.ErrTimeSliceNotFound
+	}
+	return timeSlices, nil
+}
+
+// TimeSliceSplit can be used to split an existing time slice at the
+// given time, the existing time slice will start at the split time
+// and a new time slice is created for the time before it
+func (m *mysql) TimeSliceSplit(ctx context.Context, id string, splitTime int64) ([]*data.TimeSlice, error) {
+	tx, err := m.begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+	timeSlice, err := timeSliceRead(ctx, tx, id)
+	if err != nil {
+		return nil, err
+	}
+	if splitTime <= timeSlice.Start || (timeSlice.Finish > 0 && splitTime >= timeSlice.Finish) {
+		return nil, meta.ErrTimeSliceSplitInvalid
+	}
+	//KIM: the existing time slice is moved first, otherwise the
+	// validate_time_slice_start_insert trigger would fire
+	timeSliceAfter, err := timeSliceUpdate(ctx, tx, id, data.TimeSlicePartial{
+		Start: &splitTime,
+	})
+	if err != nil {
+		return nil, err
+	}
+	timeSliceBefore, err := timeSliceCreate(ctx, tx, data.TimeSlicePartial{
+		TimerID:   &timeSlice.TimerID,
+		Start:     &timeSlice.Start,
+		Finish:    &splitTime,
+		Completed: &timeSlice.Completed,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return []*data.TimeSlice{timeSliceBefore, timeSliceAfter}, nil
+}
+
+// TimeSlicesMerge can be used to merge two or more adjacent time slices
+// of the same timer, the latest time slice is kept (and will start at
+// the earliest start) while the others are deleted
+func (m *mysql) TimeSlicesMerge(ctx context.Context, ids []string) (*data.TimeSlice, error) {
+	var timeSlices []*data.TimeSlice
+	var count int
+
+	if len(ids) < 2 {
+		return nil, meta.ErrTimeSliceMergeInvalid
+	}
+	tx, err := m.begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+	args, values := []interface{}{}, []string{}
+	unique := make(map[string]struct{})
+	for _, id := range ids {
+		if _, ok := unique[id]; ok {
+			return nil, meta.ErrTimeSliceMergeInvalid
+		}
+		unique[id] = struct{}{}
+		timeSlice, err := timeSliceRead(ctx, tx, id)
+		if err != nil {
+			return nil, err
+		}
+		if len(timeSlices) > 0 && timeSlice.TimerID != timeSlices[0].TimerID {
+			return nil, meta.ErrTimeSliceMergeInvalid
+		}
+		timeSlices = append(timeSlices, timeSlice)
+		args, values = append(args, id), append(values, "?")
+	}
+	sort.Sort(data.TimeSliceByStart(timeSlices))
+	for i, timeSlice := range timeSlices[:len(timeSlices)-1] {
+		if timeSlice.Finish != timeSlices[i+1].Start {
+			return nil, meta.ErrTimeSliceMergeInvalid
+		}
+	}
+	first, last := timeSlices[0], timeSlices[len(timeSlices)-1]
+	query := fmt.Sprintf(`SELECT COUNT(*) FROM %s WHERE timer_id = ? AND id NOT IN (%s) AND start BETWEEN ? AND ?;`,
+		tableTimeSlices, strings.Join(values, ","))
+	if err := tx.QueryRowContext(ctx, query, append(append([]interface{}{first.TimerID}, args...),
+		time.Unix(0, first.Start), time.Unix(0, last.Start))...).Scan(&count); err != nil {
+		return nil, err
+	}
+	if count > 0 {
+		return nil, meta.ErrTimeSliceMergeInvalid
+	}
+	for _, timeSlice := range timeSlices[:len(timeSlices)-1] {
+		query := fmt.Sprintf("DELETE FROM %s WHERE id = ?;", tableTimeSlices)
+		if _, err := tx.ExecContext(ctx, query, timeSlice.ID); err != nil {
+			return nil, err
+		}
+	}
+	timeSlice, err := timeSliceUpdate(ctx, tx, last.ID, data.TimeSlicePartial{
+		Start: &first.Start,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return timeSlice, nil
+}
+
 // TimeSlicesRead can be used to read zero or more time slices depending on the
 // search criteria
 func (m *mysql) TimeSlicesRead(ctx context.Context, search data.TimeSliceSearch) ([]*data.TimeSlice, error) {
 	var timeSlices []*data.TimeSlice
 	var searchParameters []string
 	var args []interface{}
+	var query string
 
 	if search.Completed != nil {
 		searchParameters = append(searchParameters, "completed = ?")
@@ -328,18 +710,48 @@ func (m *mysql) TimeSlicesRead(ctx context.Context, search data.TimeSliceSearch)
 		searchParameters = append(searchParameters, "timer_id = ?")
 		args = append(args, search.TimerID)
 	}
-	if len(search.TimerIDs) > 0 {
-		searchParameters = append(searchParameters, "timer_id IN(?)")
-		args = append(args, search.TimerIDs)
+	if timerIDs := search.TimerIDs; len(timerIDs) > 0 {
+		var parameters []string
+		for _, timerID := range timerIDs {
+			args = append(args, timerID)
+			parameters = append(parameters, "?")
+		}
+		searchParameters = append(searchParameters, fmt.Sprintf("timer_id IN(%s)", strings.Join(parameters, ",")))
 	}
-	if len(search.IDs) > 0 {
-		searchParameters = append(searchParameters, "id IN(?)")
-		args = append(args, search.IDs)
+	if ids := search.IDs; len(ids) > 0 {
+		var parameters []string
+		for _, id := range ids {
+			args = append(args, id)
+			parameters = append(parameters, "?")
+		}
+		searchParameters = append(searchParameters, fmt.Sprintf("time_slice_id IN(%s)", strings.Join(parameters, ",")))
 	}
-	query := fmt.Sprintf(`SELECT time_slice_id, start, finish, completed, elapsed_time, timer_id,
+	if finish := search.Finish; finish != nil {
+		searchParameters = append(searchParameters, "start < ?")
+		args = append(args, float64(*finish)/secondToNanoSecond)
+	}
+	if start := search.Start; start != nil {
+		searchParameters = append(searchParameters, "(finish IS NULL OR finish > ?)")
+		args = append(args, float64(*start)/secondToNanoSecond)
+	}
+	if cursor := search.Cursor; cursor != nil && *cursor != "" {
+		if _, err := timeSliceRead(ctx, m.db(ctx), *cursor); err != nil {
+			return nil, err
+		}
+		searchParameters = append(searchParameters, cursorCondition(tableTimeSlicesV1, "time_slice_id", search.Sort))
+		args = append(args, *cursor)
+	}
+	if len(searchParameters) > 0 {
+		query = fmt.Sprintf(`SELECT time_slice_id, start, finish, completed, elapsed_time, timer_id,
 		version, last_updated, last_updated_by FROM %s WHERE %s`,
-		tableTimeSlicesV1, strings.Join(searchParameters, " AND "))
-	rows, err := m.QueryContext(ctx, query, args...)
+			tableTimeSlicesV1, strings.Join(searchParameters, " AND "))
+	} else {
+		query = fmt.Sprintf(`SELECT time_slice_id, start, finish, completed, elapsed_time, timer_id,
+		version, last_updated, last_updated_by FROM %s`, tableTimeSlicesV1)
+	}
+	clause, clauseArgs := pageClause("time_slice_id", search.Sort, search.Limit)
+	query, args = query+clause, append(args, clauseArgs...)
+	rows, err := m.db(ctx).QueryContext(ctx, query, args...)
 	if err != nil {
 		return nil, err
 	}
@@ -353,3 +765,660 @@ func (m *mysql) TimeSlicesRead(ctx context.Context, search data.TimeSliceSearch)
 	}
 	return timeSlices, nil
 }
+
+// ProjectCreate can be used to create a project, the name
+// is required and must be unique
+func (m *mysql) ProjectCreate(ctx context.Context, projectPartial data.ProjectPartial) (*data.Project, error) {
+	var columns, values []string
+	var args []interface{}
+
+	if projectPartial.Name == nil || *projectPartial.Name == "" {
+		return nil, meta.ErrProjectNotCreated
+	}
+	tx, err := m.begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+	columns = append(columns, "name")
+	values = append(values, "?")
+	args = append(args, projectPartial.Name)
+	if description := projectPartial.Description; description != nil {
+		columns = append(columns, "description")
+		values = append(values, "?")
+		args = append(args, description)
+	}
+	if archived := projectPartial.Archived; archived != nil {
+		columns = append(columns, "archived")
+		values = append(values, "?")
+		args = append(args, archived)
+	}
+	query := fmt.Sprintf("INSERT INTO %s(%s) VALUES(%s);", tableProjects,
+		strings.Join(columns, ","), strings.Join(values, ","))
+	result, err := tx.ExecContext(ctx, query, args...)
+	if err != nil {
+		switch err := err.(type) {
+		default:
+			return nil, err
+		case *driver_mysql.MySQLError:
+			switch err.Number {
+			default:
+				return nil, err
+			case errDuplicateEntry:
+				return nil, meta.ErrProjectConflictCreate
+			}
+		}
+	}
+	projectID, err := result.LastInsertId()
+	if err != nil {
+		return nil, err
+	}
+	project, err := projectRead(ctx, tx, projectID)
+	if err != nil {
+		return nil, err
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return project, nil
+}
+
+// ProjectRead can be used to read an existing project
+func (m *mysql) ProjectRead(ctx context.Context, id string) (*data.Project, error) {
+	return projectRead(ctx, m.db(ctx), id)
+}
+
+// ProjectUpdate can be used to update an existing project
+func (m *mysql) ProjectUpdate(ctx context.Context, id string, projectPartial data.ProjectPartial) (*data.Project, error) {
+	var updates []string
+	var args []interface{}
+
+	if name := projectPartial.Name; name != nil {
+		if *name == "" {
+			return nil, meta.ErrProjectNotUpdated
+		}
+		updates = append(updates, "name = ?")
+		args = append(args, name)
+	}
+	if description := projectPartial.Description; description != nil {
+		updates = append(updates, "description = ?")
+		args = append(args, description)
+	}
+	if archived := projectPartial.Archived; archived != nil {
+		updates = append(updates, "archived = ?")
+		args = append(args, archived)
+	}
+	if len(updates) <= 0 {
+		return nil, meta.ErrProjectNotUpdated
+	}
+	tx, err := m.begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+	args = append(args, id)
+	query := fmt.Sprintf(`UPDATE %s SET %s WHERE id = ?;`, tableProjects, strings.Join(updates, ","))
+	result, err := tx.ExecContext(ctx, query, args...)
+	if err != nil {
+		switch err := err.(type) {
+		default:
+			return nil, err
+		case *driver_mysql.MySQLError:
+			switch err.Number {
+			default:
+				return nil, err
+			case errDuplicateEntry:
+				return nil, meta.ErrProjectConflictUpdate
+			}
+		}
+	}
+	if err := rowsAffected(result, meta.ErrProjectNotFound); err != nil {
+		return nil, err
+	}
+	project, err := projectRead(ctx, tx, id)
+	if err != nil {
+		return nil, err
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return project, nil
+}
+
+// ProjectDelete can be used to delete a project if it exists
+func (m *mysql) ProjectDelete(ctx context.Context, id string) error {
+	tx, err := m.begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+	//KIM: the timers are updated rather than relying on the ON DELETE
+	// SET NULL of the foreign key since cascaded foreign key actions
+	// don't fire the triggers that version and audit the timers
+	query := fmt.Sprintf("UPDATE %s SET project_id = NULL WHERE project_id = ?;", tableTimers)
+	if _, err := tx.ExecContext(ctx, query, id); err != nil {
+		return err
+	}
+	query = fmt.Sprintf("DELETE FROM %s WHERE id = ?", tableProjects)
+	result, err := tx.ExecContext(ctx, query, id)
+	if err != nil {
+		return err
+	}
+	if err := rowsAffected(result, meta.ErrProjectNotFound); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+// ProjectsRead can be used to read zero or more projects depending
+// on the search criteria
+func (m *mysql) ProjectsRead(ctx context.Context, search data.ProjectSearch) ([]*data.Project, error) {
+	var searchParameters []string
+	var projects []*data.Project
+	var args []interface{}
+	var query string
+
+	if len(search.IDs) > 0 {
+		var parameters []string
+		for _, id := range search.IDs {
+			args = append(args, id)
+			parameters = append(parameters, "?")
+		}
+		searchParameters = append(searchParameters, fmt.Sprintf("project_id IN(%s)", strings.Join(parameters, ",")))
+	}
+	if len(search.Names) > 0 {
+		var parameters []string
+		for _, name := range search.Names {
+			args = append(args, name)
+			parameters = append(parameters, "?")
+		}
+		searchParameters = append(searchParameters, fmt.Sprintf("name IN(%s)", strings.Join(parameters, ",")))
+	}
+	if archived := search.Archived; archived != nil {
+		searchParameters = append(searchParameters, "archived = ?")
+		args = append(args, archived)
+	}
+	query = fmt.Sprintf(`SELECT project_id, name, description, archived, version,
+		last_updated, last_updated_by FROM %s`, tableProjectsV1)
+	if len(searchParameters) > 0 {
+		query += " WHERE " + strings.Join(searchParameters, " AND ")
+	}
+	rows, err := m.db(ctx).QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		project, err := projectScan(rows.Scan)
+		if err != nil {
+			return nil, err
+		}
+		projects = append(projects, project)
+	}
+	return projects, nil
+}
+
+// RateCardCreate can be used to create a rate card, the rate
+// and currency are required
+func (m *mysql) RateCardCreate(ctx context.Context, rateCardPartial data.RateCardPartial) (*data.RateCard, error) {
+	var columns, values []string
+	var args []interface{}
+
+	if rateCardPartial.Rate == nil || rateCardPartial.Currency == nil || *rateCardPartial.Currency == "" {
+		return nil, meta.ErrRateCardNotCreated
+	}
+	tx, err := m.begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+	columns = append(columns, "rate", "currency", "effective_from")
+	values = append(values, "?", "?", "?")
+	effectiveFrom := int64(0)
+	if rateCardPartial.EffectiveFrom != nil {
+		effectiveFrom = *rateCardPartial.EffectiveFrom
+	}
+	args = append(args, rateCardPartial.Rate, rateCardPartial.Currency, time.Unix(0, effectiveFrom))
+	if employeeID := rateCardPartial.EmployeeID; employeeID != nil {
+		columns = append(columns, "employee_id")
+		values = append(values, "NULLIF(?, '')")
+		args = append(args, employeeID)
+	}
+	if projectID := rateCardPartial.ProjectID; projectID != nil {
+		columns = append(columns, "project_id")
+		values = append(values, "NULLIF(?, '')")
+		args = append(args, projectID)
+	}
+	if effectiveTo := rateCardPartial.EffectiveTo; effectiveTo != nil && *effectiveTo > 0 {
+		columns = append(columns, "effective_to")
+		values = append(values, "?")
+		args = append(args, time.Unix(0, *effectiveTo))
+	}
+	query := fmt.Sprintf("INSERT INTO %s(%s) VALUES(%s);", tableRateCards,
+		strings.Join(columns, ","), strings.Join(values, ","))
+	result, err := tx.ExecContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	rateCardID, err := result.LastInsertId()
+	if err != nil {
+		return nil, err
+	}
+	rateCard, err := rateCardRead(ctx, tx, rateCardID)
+	if err != nil {
+		return nil, err
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return rateCard, nil
+}
+
+// RateCardRead can be used to read an existing rate card
+func (m *mysql) RateCardRead(ctx context.Context, id string) (*data.RateCard, error) {
+	return rateCardRead(ctx, m.db(ctx), id)
+}
+
+// RateCardUpdate can be used to update an existing rate card
+func (m *mysql) RateCardUpdate(ctx context.Context, id string, rateCardPartial data.RateCardPartial) (*data.RateCard, error) {
+	var updates []string
+	var args []interface{}
+
+	if currency := rateCardPartial.Currency; currency != nil {
+		if *currency == "" {
+			return nil, meta.ErrRateCardNotUpdated
+		}
+		updates = append(updates, "currency = ?")
+		args = append(args, currency)
+	}
+	if rate := rateCardPartial.Rate; rate != nil {
+		updates = append(updates, "rate = ?")
+		args = append(args, rate)
+	}
+	if employeeID := rateCardPartial.EmployeeID; employeeID != nil {
+		updates = append(updates, "employee_id = NULLIF(?, '')")
+		args = append(args, employeeID)
+	}
+	if projectID := rateCardPartial.ProjectID; projectID != nil {
+		updates = append(updates, "project_id = NULLIF(?, '')")
+		args = append(args, projectID)
+	}
+	if effectiveFrom := rateCardPartial.EffectiveFrom; effectiveFrom != nil {
+		updates = append(updates, "effective_from = ?")
+		args = append(args, time.Unix(0, *effectiveFrom))
+	}
+	if effectiveTo := rateCardPartial.EffectiveTo; effectiveTo != nil {
+		switch {
+		case *effectiveTo > 0:
+			updates = append(updates, "effective_to = ?")
+			args = append(args, time.Unix(0, *effectiveTo))
+		default:
+			updates = append(updates, "effective_to = NULL")
+		}
+	}
+	if len(updates) <= 0 {
+		return nil, meta.ErrRateCardNotUpdated
+	}
+	tx, err := m.begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+	args = append(args, id)
+	query := fmt.Sprintf(`UPDATE %s SET %s WHERE id = ?;`, tableRateCards, strings.Join(updates, ","))
+	result, err := tx.ExecContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	if err := rowsAffected(result, meta.ErrRateCardNotFound); err != nil {
+		return nil, err
+	}
+	rateCard, err := rateCardRead(ctx, tx, id)
+	if err != nil {
+		return nil, err
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return rateCard, nil
+}
+
+// RateCardDelete can be used to delete a rate card if it exists
+func (m *mysql) RateCardDelete(ctx context.Context, id string) error {
+	query := fmt.Sprintf("DELETE FROM %s WHERE id = ?", tableRateCards)
+	result, err := m.db(ctx).ExecContext(ctx, query, id)
+	if err != nil {
+		return err
+	}
+	return rowsAffected(result, meta.ErrRateCardNotFound)
+}
+
+// RateCardsRead can be used to read zero or more rate cards depending
+// on the search criteria
+func (m *mysql) RateCardsRead(ctx context.Context, search data.RateCardSearch) ([]*data.RateCard, error) {
+	var searchParameters []string
+	var rateCards []*data.RateCard
+	var args []interface{}
+	var query string
+
+	if len(search.IDs) > 0 {
+		var parameters []string
+		for _, id := range search.IDs {
+			args = append(args, id)
+			parameters = append(parameters, "?")
+		}
+		searchParameters = append(searchParameters, fmt.Sprintf("rate_card_id IN(%s)", strings.Join(parameters, ",")))
+	}
+	if len(search.EmployeeIDs) > 0 {
+		var parameters []string
+		for _, employeeID := range search.EmployeeIDs {
+			args = append(args, employeeID)
+			parameters = append(parameters, "?")
+		}
+		searchParameters = append(searchParameters, fmt.Sprintf("employee_id IN(%s)", strings.Join(parameters, ",")))
+	}
+	if len(search.ProjectIDs) > 0 {
+		var parameters []string
+		for _, projectID := range search.ProjectIDs {
+			args = append(args, projectID)
+			parameters = append(parameters, "?")
+		}
+		searchParameters = append(searchParameters, fmt.Sprintf("project_id IN(%s)", strings.Join(parameters, ",")))
+	}
+	if effectiveAt := search.EffectiveAt; effectiveAt != nil {
+		//KIM: the view converts the datetime to a unix timestamp (seconds)
+		searchParameters = append(searchParameters, "effective_from <= ? AND (effective_to IS NULL OR effective_to > ?)")
+		args = append(args, float64(*effectiveAt)/secondToNanoSecond, float64(*effectiveAt)/secondToNanoSecond)
+	}
+	query = fmt.Sprintf(`SELECT rate_card_id, employee_id, project_id, rate, currency, effective_from,
+		effective_to, version, last_updated, last_updated_by FROM %s`, tableRateCardsV1)
+	if len(searchParameters) > 0 {
+		query += " WHERE " + strings.Join(searchParameters, " AND ")
+	}
+	rows, err := m.db(ctx).QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		rateCard, err := rateCardScan(rows.Scan)
+		if err != nil {
+			return nil, err
+		}
+		rateCards = append(rateCards, rateCard)
+	}
+	return rateCards, nil
+}
+
+// InvoiceCreate can be used to store an invoice and atomically mark
+// the timers of its line items as invoiced, if any of the timers
+// have already been invoiced, the invoice won't be created
+func (m *mysql) InvoiceCreate(ctx context.Context, invoice data.Invoice) (*data.Invoice, error) {
+	var invoiceID string
+
+	if len(invoice.LineItems) <= 0 {
+		return nil, meta.ErrInvoiceNotCreated
+	}
+	tx, err := m.begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+	query := fmt.Sprintf(`INSERT INTO %s(employee_id, project_id, start, finish, currency, total)
+		VALUES(NULLIF(?, ''), NULLIF(?, ''), ?, ?, ?, ?);`, tableInvoices)
+	result, err := tx.ExecContext(ctx, query, invoice.EmployeeID, invoice.ProjectID,
+		time.Unix(0, invoice.Start), time.Unix(0, invoice.Finish), invoice.Currency, invoice.Total)
+	if err != nil {
+		return nil, err
+	}
+	auxID, err := result.LastInsertId()
+	if err != nil {
+		return nil, err
+	}
+	query = fmt.Sprintf("SELECT id FROM %s WHERE aux_id = ?;", tableInvoices)
+	if err := tx.QueryRowContext(ctx, query, auxID).Scan(&invoiceID); err != nil {
+		return nil, err
+	}
+	for _, lineItem := range invoice.LineItems {
+		//KIM: the invoice_id IS NULL condition ensures that a timer
+		// can't be invoiced more than once, even concurrently
+		query := fmt.Sprintf("UPDATE %s SET invoice_id = ? WHERE id = ? AND invoice_id IS NULL AND deleted_at IS NULL;", tableTimers)
+		result, err := tx.ExecContext(ctx, query, invoiceID, lineItem.TimerID)
+		if err != nil {
+			return nil, err
+		}
+		if err := rowsAffected(result, meta.ErrTimerInvoiced); err != nil {
+			if _, err := timerRead(ctx, tx, lineItem.TimerID); err != nil {
+				return nil, err
+			}
+			return nil, err
+		}
+		query = fmt.Sprintf(`INSERT INTO %s(invoice_id, timer_id, rate_card_id, elapsed_time, rate, amount)
+			VALUES(?, ?, ?, ?, ?, ?);`, tableInvoiceLineItems)
+		if _, err := tx.ExecContext(ctx, query, invoiceID, lineItem.TimerID, lineItem.RateCardID,
+			lineItem.ElapsedTime, lineItem.Rate, lineItem.Amount); err != nil {
+			return nil, err
+		}
+	}
+	invoiceRead, err := invoiceRead(ctx, tx, invoiceID)
+	if err != nil {
+		return nil, err
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return invoiceRead, nil
+}
+
+// InvoiceRead can be used to read an existing invoice
+func (m *mysql) InvoiceRead(ctx context.Context, id string) (*data.Invoice, error) {
+	return invoiceRead(ctx, m.db(ctx), id)
+}
+
+// InvoiceDelete can be used to delete an invoice if it exists, the
+// timers of its line items will no longer be invoiced
+func (m *mysql) InvoiceDelete(ctx context.Context, id string) error {
+	query := fmt.Sprintf("DELETE FROM %s WHERE id = ?", tableInvoices)
+	result, err := m.db(ctx).ExecContext(ctx, query, id)
+	if err != nil {
+		return err
+	}
+	return rowsAffected(result, meta.ErrInvoiceNotFound)
+}
+
+// InvoicesRead can be used to read zero or more invoices depending
+// on the search criteria
+func (m *mysql) InvoicesRead(ctx context.Context, search data.InvoiceSearch) ([]*data.Invoice, error) {
+	var searchParameters []string
+	var invoices []*data.Invoice
+	var args []interface{}
+	var query string
+
+	if len(search.IDs) > 0 {
+		var parameters []string
+		for _, id := range search.IDs {
+			args = append(args, id)
+			parameters = append(parameters, "?")
+		}
+		searchParameters = append(searchParameters, fmt.Sprintf("invoice_id IN(%s)", strings.Join(parameters, ",")))
+	}
+	if len(search.EmployeeIDs) > 0 {
+		var parameters []string
+		for _, employeeID := range search.EmployeeIDs {
+			args = append(args, employeeID)
+			parameters = append(parameters, "?")
+		}
+		searchParameters = append(searchParameters, fmt.Sprintf("employee_id IN(%s)", strings.Join(parameters, ",")))
+	}
+	if len(search.ProjectIDs) > 0 {
+		var parameters []string
+		for _, projectID := range search.ProjectIDs {
+			args = append(args, projectID)
+			parameters = append(parameters, "?")
+		}
+		searchParameters = append(searchParameters, fmt.Sprintf("project_id IN(%s)", strings.Join(parameters, ",")))
+	}
+	query = fmt.Sprintf(`SELECT invoice_id, employee_id, project_id, start, finish, currency, total,
+		version, last_updated, last_updated_by FROM %s`, tableInvoicesV1)
+	if len(searchParameters) > 0 {
+		query += " WHERE " + strings.Join(searchParameters, " AND ")
+	}
+	rows, err := m.db(ctx).QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		invoice, err := invoiceScan(rows.Scan)
+		if err != nil {
+			return nil, err
+		}
+		invoices = append(invoices, invoice)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	for _, invoice := range invoices {
+		if _, err := invoiceLineItemsRead(ctx, m.db(ctx), invoice); err != nil {
+			return nil, err
+		}
+	}
+	return invoices, nil
+}
+
+// OutboxEnqueue can be used to add a change to the end of the outbox
+func (m *mysql) OutboxEnqueue(ctx context.Context, change data.OutboxChange) (*data.OutboxChange, error) {
+	tx, err := m.begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+	query := fmt.Sprintf(`INSERT INTO %s(when_changed, changed_by, data_id, data_type, data_action, data_version, payload)
+		VALUES(?, ?, ?, ?, ?, ?, ?);`, tableTimersOutbox)
+	result, err := tx.ExecContext(ctx, query, change.WhenChanged, change.ChangedBy,
+		change.DataId, change.DataType, change.DataAction, change.DataVersion,
+		sql.NullString{String: string(change.Payload), Valid: len(change.Payload) > 0})
+	if err != nil {
+		return nil, err
+	}
+	auxID, err := result.LastInsertId()
+	if err != nil {
+		return nil, err
+	}
+	query = fmt.Sprintf(`SELECT outbox_id, when_changed, changed_by, data_id, data_type, data_action,
+		data_version, payload, enqueued FROM %s WHERE aux_id = ?;`, tableTimersOutboxV1)
+	outboxChange, err := outboxChangeScan(tx.QueryRowContext(ctx, query, auxID).Scan)
+	if err != nil {
+		return nil, err
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return outboxChange, nil
+}
+
+// OutboxRead can be used to read up to limit of the oldest changes
+// in the outbox (oldest first)
+func (m *mysql) OutboxRead(ctx context.Context, limit int) ([]*data.OutboxChange, error) {
+	var changes []*data.OutboxChange
+	var args []interface{}
+
+	query := fmt.Sprintf(`SELECT outbox_id, when_changed, changed_by, data_id, data_type, data_action,
+		data_version, payload, enqueued FROM %s ORDER BY aux_id ASC`, tableTimersOutboxV1)
+	if limit > 0 {
+		query, args = query+" LIMIT ?", append(args, limit)
+	}
+	rows, err := m.db(ctx).QueryContext(ctx, query+";", args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		change, err := outboxChangeScan(rows.Scan)
+		if err != nil {
+			return nil, err
+		}
+		changes = append(changes, change)
+	}
+	return changes, rows.Err()
+}
+
+// OutboxAcknowledge can be used to remove a change from the outbox
+// once it's been delivered
+func (m *mysql) OutboxAcknowledge(ctx context.Context, id string) error {
+	query := fmt.Sprintf("DELETE FROM %s WHERE id = ?;", tableTimersOutbox)
+	result, err := m.db(ctx).ExecContext(ctx, query, id)
+	if err != nil {
+		return err
+	}
+	return rowsAffected(result, meta.ErrOutboxChangeNotFound)
+}
+
+// OutboxBacklog can be used to read the number of changes in the
+// outbox that have yet to be delivered
+func (m *mysql) OutboxBacklog(ctx context.Context) (int, error) {
+	var backlog int
+
+	query := fmt.Sprintf("SELECT COUNT(*) FROM %s;", tableTimersOutbox)
+	if err := m.db(ctx).QueryRowContext(ctx, query).Scan(&backlog); err != nil {
+		return 0, err
+	}
+	return backlog, nil
+}
+
+// transaction will return the (sql) transaction of the context if it's
+// the context of an outbox transaction
+func (m *mysql) transaction(ctx context.Context) (*sql.Tx, bool) {
+	if ctx == nil {
+		return nil, false
+	}
+	t, ok := ctx.Value(contextKeyTransaction).(*transaction)
+	if !ok || t.mysql != m {
+		return nil, false
+	}
+	return t.Tx, true
+}
+
+// begin will begin a transaction, if the context is the context of an outbox
+// transaction, its transaction is shared
+func (m *mysql) begin(ctx context.Context) (*tx, error) {
+	if t, ok := m.transaction(ctx); ok {
+		return &tx{Tx: t, shared: true}, nil
+	}
+	t, err := m.Begin()
+	if err != nil {
+		return nil, err
+	}
+	return &tx{Tx: t}, nil
+}
+
+// db will return the transaction of the context if it's the context of
+// an outbox transaction (so its mutations can be read), otherwise the
+// database is returned
+func (m *mysql) db(ctx context.Context) interface {
+	Exec(query string, args ...interface{}) (sql.Result, error)
+	ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error)
+	QueryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error)
+	QueryRow(query string, args ...interface{}) *sql.Row
+	QueryRowContext(ctx context.Context, query string, args ...interface{}) *sql.Row
+} {
+	if t, ok := m.transaction(ctx); ok {
+		return t
+	}
+	return m.DB
+}
+
+func (m *mysql) OutboxTransaction(ctx context.Context, fx func(ctx context.Context) error) error {
+	tx, err := m.begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+	if !tx.shared {
+		ctx = context.WithValue(ctx, contextKeyTransaction, &transaction{Tx: tx.Tx, mysql: m})
+	}
+	if err := fx(ctx); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
@@ -2,6 +2,7 @@ package tests
 
 import (
 	"context"
+	"errors"
 	"math/rand"
 	"testing"
 	"time"
@@ -58,6 +59,22 @@ func TestTimerCRUD(ctx context.Context, m meta.Timer) func(*testing.T) {
 		timer.LastUpdatedBy = timerUpdated.LastUpdatedBy
 		timer.Version = timerUpdated.Version
 		assert.Equal(t, timer, timerUpdated)
+		//update (stale version)
+		staleVersion := timerUpdated.Version - 1
+		timerUpdated, err = m.TimerUpdate(ctx, timer.ID, data.TimerPartial{
+			Comment: &comment,
+			Version: &staleVersion,
+		})
+		assert.True(t, errors.Is(err, meta.ErrTimerConflictVersion))
+		assert.Nil(t, timerUpdated)
+		//update (current version)
+		timerUpdated, err = m.TimerUpdate(ctx, timer.ID, data.TimerPartial{
+			Comment: &comment,
+			Version: &timer.Version,
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, comment, timerUpdated.Comment)
+		assert.Greater(t, timerUpdated.Version, timer.Version)
 		//delete
 		err = m.TimerDelete(ctx, timer.ID)
 		assert.Nil(t, err)
@@ -72,7 +89,181 @@ func TestTimerCRUD(ctx context.Context, m meta.Timer) func(*testing.T) {
 
 func TestTimersRead(ctx context.Context, m meta.Timer) func(*testing.T) {
 	return func(t *testing.T) {
-		//TODO: create test
+		//create and start timers (in order)
+		var timers []*data.Timer
+		var ids []string
+		for i := 0; i < 3; i++ {
+			comment := randomString(25)
+			timer, err := m.TimerCreate(ctx, data.TimerPartial{
+				Comment: &comment,
+			})
+			assert.Nil(t, err)
+			timer, err = m.TimerStart(ctx, timer.ID, 0)
+			assert.Nil(t, err)
+			timers, ids = append(timers, timer), append(ids, timer.ID)
+			time.Sleep(10 * time.Millisecond)
+		}
+		defer func() {
+			for _, id := range ids {
+				_ = m.TimerDelete(ctx, id)
+			}
+		}()
+		idsFx := func(timers []*data.Timer) []string {
+			var ids []string
+			for _, timer := range timers {
+				ids = append(ids, timer.ID)
+			}
+			return ids
+		}
+		//read (sorted ascending by default)
+		timersRead, err := m.TimersRead(ctx, data.TimerSearch{IDs: ids})
+		assert.Nil(t, err)
+		assert.Equal(t, ids, idsFx(timersRead))
+		//read (sorted descending)
+		sort := data.SortDescending
+		timersRead, err = m.TimersRead(ctx, data.TimerSearch{IDs: ids, Sort: &sort})
+		assert.Nil(t, err)
+		assert.Equal(t, []string{ids[2], ids[1], ids[0]}, idsFx(timersRead))
+		//read (paginated)
+		limit := 2
+		timersRead, err = m.TimersRead(ctx, data.TimerSearch{IDs: ids, Limit: &limit})
+		assert.Nil(t, err)
+		assert.Equal(t, ids[:2], idsFx(timersRead))
+		cursor := timersRead[len(timersRead)-1].ID
+		timersRead, err = m.TimersRead(ctx, data.TimerSearch{IDs: ids, Limit: &limit, Cursor: &cursor})
+		assert.Nil(t, err)
+		assert.Equal(t, ids[2:], idsFx(timersRead))
+		timersRead, err = m.TimersRead(ctx, data.TimerSearch{IDs: ids, Limit: &limit, Cursor: &cursor, Sort: &sort})
+		assert.Nil(t, err)
+		assert.Equal(t, []string{ids[0]}, idsFx(timersRead))
+		//read (range)
+		timersRead, err = m.TimersRead(ctx, data.TimerSearch{IDs: ids, Finish: &timers[1].Start})
+		assert.Nil(t, err)
+		assert.Equal(t, ids[:1], idsFx(timersRead))
+		timersRead, err = m.TimersRead(ctx, data.TimerSearch{IDs: ids, Start: &timers[1].Start})
+		assert.Nil(t, err)
+		assert.Equal(t, ids, idsFx(timersRead))
+	}
+}
+
+func TestTimeSlicesRead(ctx context.Context, m interface {
+	meta.Timer
+	meta.TimeSlice
+}) func(*testing.T) {
+	return func(t *testing.T) {
+		//create timer
+		comment := randomString(25)
+		timer, err := m.TimerCreate(ctx, data.TimerPartial{
+			Comment: &comment,
+		})
+		assert.Nil(t, err)
+		defer func() {
+			_ = m.TimerDelete(ctx, timer.ID)
+		}()
+		//create time slices, one a day
+		var ids []string
+		tStart := time.Date(2020, time.January, 1, 10, 0, 0, 0, time.UTC)
+		for i := 0; i < 3; i++ {
+			start := tStart.AddDate(0, 0, i).UnixNano()
+			finish := tStart.AddDate(0, 0, i).Add(time.Hour).UnixNano()
+			completed := true
+			timeSlice, err := m.TimeSliceCreate(ctx, data.TimeSlicePartial{
+				TimerID:   &timer.ID,
+				Start:     &start,
+				Finish:    &finish,
+				Completed: &completed,
+			})
+			assert.Nil(t, err)
+			ids = append(ids, timeSlice.ID)
+		}
+		defer func() {
+			for _, id := range ids {
+				_ = m.TimeSliceDelete(ctx, id)
+			}
+		}()
+		idsFx := func(timeSlices []*data.TimeSlice) []string {
+			var ids []string
+			for _, timeSlice := range timeSlices {
+				ids = append(ids, timeSlice.ID)
+			}
+			return ids
+		}
+		//read (sorted ascending by default)
+		timeSlices, err := m.TimeSlicesRead(ctx, data.TimeSliceSearch{TimerID: &timer.ID})
+		assert.Nil(t, err)
+		assert.Equal(t, ids, idsFx(timeSlices))
+		//read (sorted descending)
+		sort := data.SortDescending
+		timeSlices, err = m.TimeSlicesRead(ctx, data.TimeSliceSearch{TimerID: &timer.ID, Sort: &sort})
+		assert.Nil(t, err)
+		assert.Equal(t, []string{ids[2], ids[1], ids[0]}, idsFx(timeSlices))
+		//read (paginated)
+		limit := 2
+		timeSlices, err = m.TimeSlicesRead(ctx, data.TimeSliceSearch{TimerID: &timer.ID, Limit: &limit})
+		assert.Nil(t, err)
+		assert.Equal(t, ids[:2], idsFx(timeSlices))
+		cursor := timeSlices[len(timeSlices)-1].ID
+		timeSlices, err = m.TimeSlicesRead(ctx, data.TimeSliceSearch{TimerID: &timer.ID, Limit: &limit, Cursor: &cursor})
+		assert.Nil(t, err)
+		assert.Equal(t, ids[2:], idsFx(timeSlices))
+		//read (range)
+		start := tStart.AddDate(0, 0, 1).UnixNano()
+		finish := tStart.AddDate(0, 0, 2).UnixNano()
+		timeSlices, err = m.TimeSlicesRead(ctx, data.TimeSliceSearch{TimerID: &timer.ID, Start: &start, Finish: &finish})
+		assert.Nil(t, err)
+		assert.Equal(t, ids[1:2], idsFx(timeSlices))
+		//read (invalid cursor)
+		cursor = randomString(25)
+		_, err = m.TimeSlicesRead(ctx, data.TimeSliceSearch{TimerID: &timer.ID, Cursor: &cursor})
+		assert.NotNil(t, err)
+	}
+}
+
+func TestTimeSliceUpdate(ctx context.Context, m interface {
+	meta.Timer
+	meta.TimeSlice
+}) func(*testing.T) {
+	return func(t *testing.T) {
+		//create timer
+		comment := randomString(25)
+		timer, err := m.TimerCreate(ctx, data.TimerPartial{
+			Comment: &comment,
+		})
+		assert.Nil(t, err)
+		defer func() {
+			_ = m.TimerDelete(ctx, timer.ID)
+		}()
+		//create time slice
+		tStart := time.Date(2020, time.February, 1, 10, 0, 0, 0, time.UTC)
+		start, finish := tStart.UnixNano(), tStart.Add(time.Hour).UnixNano()
+		timeSlice, err := m.TimeSliceCreate(ctx, data.TimeSlicePartial{
+			TimerID: &timer.ID,
+			Start:   &start,
+			Finish:  &finish,
+		})
+		assert.Nil(t, err)
+		defer func() {
+			_ = m.TimeSliceDelete(ctx, timeSlice.ID)
+		}()
+		//update (current version)
+		finish = tStart.Add(2 * time.Hour).UnixNano()
+		timeSliceUpdated, err := m.TimeSliceUpdate(ctx, timeSlice.ID, data.TimeSlicePartial{
+			Finish:  &finish,
+			Version: &timeSlice.Version,
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, finish, timeSliceUpdated.Finish)
+		assert.Greater(t, timeSliceUpdated.Version, timeSlice.Version)
+		//update (stale version)
+		finish = tStart.Add(3 * time.Hour).UnixNano()
+		_, err = m.TimeSliceUpdate(ctx, timeSlice.ID, data.TimeSlicePartial{
+			Finish:  &finish,
+			Version: &timeSlice.Version,
+		})
+		assert.True(t, errors.Is(err, meta.ErrTimeSliceConflictVersion))
+		timeSliceRead, err := m.TimeSliceRead(ctx, timeSlice.ID)
+		assert.Nil(t, err)
+		assert.Equal(t, timeSliceUpdated, timeSliceRead)
 	}
 }
 
@@ -89,7 +280,7 @@ func TestTimerLogic(ctx context.Context, m meta.Timer) func(*testing.T) {
 		assert.Equal(t, timer.Comment, comment)
 		// assert.Equal(t, timer.EmployeeID, employee.ID)
 		//start
-		timerStarted, err := m.TimerStart(ctx, timer.ID)
+		timerStarted, err := m.TimerStart(ctx, timer.ID, 0)
 		assert.Nil(t, err)
 		assert.Greater(t, timerStarted.Start, int64(0))
 		assert.Zero(t, timerStarted.Finish)
@@ -99,7 +290,7 @@ func TestTimerLogic(ctx context.Context, m meta.Timer) func(*testing.T) {
 		assert.Nil(t, err)
 		assert.GreaterOrEqual(t, timerRead.ElapsedTime, int64(1))
 		//stop
-		timerStopped, err := m.TimerStop(ctx, timer.ID)
+		timerStopped, err := m.TimerStop(ctx, timer.ID, 0)
 		assert.Nil(t, err)
 		assert.Equal(t, timerStarted.Start, timerStopped.Start)
 		//read
@@ -109,6 +300,707 @@ func TestTimerLogic(ctx context.Context, m meta.Timer) func(*testing.T) {
 	}
 }
 
+func TestTimerBackdate(ctx context.Context, m interface {
+	meta.Timer
+	meta.TimeSlice
+}) func(*testing.T) {
+	return func(t *testing.T) {
+		//create timer
+		comment := randomString(25)
+		timer, err := m.TimerCreate(ctx, data.TimerPartial{
+			Comment: &comment,
+		})
+		assert.Nil(t, err)
+		defer func() {
+			_ = m.TimerDelete(ctx, timer.ID)
+		}()
+		tNow := time.Now().Truncate(time.Second)
+		start, finish := tNow.Add(-3*time.Hour).UnixNano(), tNow.Add(-2*time.Hour).UnixNano()
+		//start and stop (backdated)
+		timerStarted, err := m.TimerStart(ctx, timer.ID, start)
+		assert.Nil(t, err)
+		assert.Equal(t, start, timerStarted.Start)
+		_, err = m.TimerStop(ctx, timer.ID, finish)
+		assert.Nil(t, err)
+		timeSlices, err := m.TimeSlicesRead(ctx, data.TimeSliceSearch{
+			TimerID: &timer.ID,
+		})
+		assert.Nil(t, err)
+		if assert.Len(t, timeSlices, 1) {
+			assert.Equal(t, start, timeSlices[0].Start)
+			assert.Equal(t, finish, timeSlices[0].Finish)
+		}
+		//start (within and before an existing time slice)
+		_, err = m.TimerStart(ctx, timer.ID, tNow.Add(-150*time.Minute).UnixNano())
+		assert.True(t, errors.Is(err, meta.ErrTimerConflictStart))
+		_, err = m.TimerStart(ctx, timer.ID, tNow.Add(-4*time.Hour).UnixNano())
+		assert.True(t, errors.Is(err, meta.ErrTimerConflictStart))
+		//start (backdated) and stop (before start)
+		start = tNow.Add(-time.Hour).UnixNano()
+		_, err = m.TimerStart(ctx, timer.ID, start)
+		assert.Nil(t, err)
+		_, err = m.TimerStop(ctx, timer.ID, tNow.Add(-90*time.Minute).UnixNano())
+		assert.True(t, errors.Is(err, meta.ErrTimerConflictStop))
+		//stop
+		timerStopped, err := m.TimerStop(ctx, timer.ID, 0)
+		assert.Nil(t, err)
+		assert.Empty(t, timerStopped.ActiveTimeSliceID)
+		assert.GreaterOrEqual(t, timerStopped.ElapsedTime, int64(2*time.Hour))
+	}
+}
+
+func TestTimeSliceSplitMerge(ctx context.Context, m interface {
+	meta.Timer
+	meta.TimeSlice
+}) func(*testing.T) {
+	return func(t *testing.T) {
+		//create, start and stop timer
+		comment := randomString(25)
+		timer, err := m.TimerCreate(ctx, data.TimerPartial{
+			Comment: &comment,
+		})
+		assert.Nil(t, err)
+		defer func() {
+			_ = m.TimerDelete(ctx, timer.ID)
+		}()
+		tNow := time.Now().Truncate(time.Second)
+		start, finish := tNow.Add(-3*time.Hour).UnixNano(), tNow.Add(-time.Hour).UnixNano()
+		_, err = m.TimerStart(ctx, timer.ID, start)
+		assert.Nil(t, err)
+		_, err = m.TimerStop(ctx, timer.ID, finish)
+		assert.Nil(t, err)
+		timeSlices, err := m.TimeSlicesRead(ctx, data.TimeSliceSearch{
+			TimerID: &timer.ID,
+		})
+		assert.Nil(t, err)
+		if !assert.Len(t, timeSlices, 1) {
+			return
+		}
+		timeSlice := timeSlices[0]
+		//split (invalid)
+		_, err = m.TimeSliceSplit(ctx, timeSlice.ID, start)
+		assert.True(t, errors.Is(err, meta.ErrTimeSliceSplitInvalid))
+		_, err = m.TimeSliceSplit(ctx, timeSlice.ID, tNow.UnixNano())
+		assert.True(t, errors.Is(err, meta.ErrTimeSliceSplitInvalid))
+		//split
+		splitTime := tNow.Add(-2 * time.Hour).UnixNano()
+		timeSlicesSplit, err := m.TimeSliceSplit(ctx, timeSlice.ID, splitTime)
+		assert.Nil(t, err)
+		if !assert.Len(t, timeSlicesSplit, 2) {
+			return
+		}
+		assert.Equal(t, start, timeSlicesSplit[0].Start)
+		assert.Equal(t, splitTime, timeSlicesSplit[0].Finish)
+		assert.Equal(t, timeSlice.ID, timeSlicesSplit[1].ID)
+		assert.Equal(t, splitTime, timeSlicesSplit[1].Start)
+		assert.Equal(t, finish, timeSlicesSplit[1].Finish)
+		timerRead, err := m.TimerRead(ctx, timer.ID)
+		assert.Nil(t, err)
+		assert.InDelta(t, int64(2*time.Hour), timerRead.ElapsedTime, float64(time.Millisecond))
+		//split again and merge (not adjacent)
+		timeSlicesSplitAgain, err := m.TimeSliceSplit(ctx, timeSlice.ID, tNow.Add(-90*time.Minute).UnixNano())
+		assert.Nil(t, err)
+		if !assert.Len(t, timeSlicesSplitAgain, 2) {
+			return
+		}
+		_, err = m.TimeSlicesMerge(ctx, []string{timeSlicesSplit[0].ID, timeSlice.ID})
+		assert.True(t, errors.Is(err, meta.ErrTimeSliceMergeInvalid))
+		_, err = m.TimeSlicesMerge(ctx, []string{timeSlice.ID})
+		assert.True(t, errors.Is(err, meta.ErrTimeSliceMergeInvalid))
+		//merge
+		timeSliceMerged, err := m.TimeSlicesMerge(ctx, []string{timeSlice.ID,
+			timeSlicesSplitAgain[0].ID, timeSlicesSplit[0].ID})
+		assert.Nil(t, err)
+		assert.Equal(t, timeSlice.ID, timeSliceMerged.ID)
+		assert.Equal(t, start, timeSliceMerged.Start)
+		assert.Equal(t, finish, timeSliceMerged.Finish)
+		timeSlices, err = m.TimeSlicesRead(ctx, data.TimeSliceSearch{
+			TimerID: &timer.ID,
+		})
+		assert.Nil(t, err)
+		assert.Len(t, timeSlices, 1)
+		timerRead, err = m.TimerRead(ctx, timer.ID)
+		assert.Nil(t, err)
+		assert.InDelta(t, int64(2*time.Hour), timerRead.ElapsedTime, float64(time.Millisecond))
+		//start and stop the timer again and merge (not contiguous)
+		_, err = m.TimerStart(ctx, timer.ID, tNow.Add(-30*time.Minute).UnixNano())
+		assert.Nil(t, err)
+		_, err = m.TimerStop(ctx, timer.ID, tNow.Add(-15*time.Minute).UnixNano())
+		assert.Nil(t, err)
+		timeSlices, err = m.TimeSlicesRead(ctx, data.TimeSliceSearch{
+			TimerID: &timer.ID,
+		})
+		assert.Nil(t, err)
+		if !assert.Len(t, timeSlices, 2) {
+			return
+		}
+		_, err = m.TimeSlicesMerge(ctx, []string{timeSlices[0].ID, timeSlices[1].ID})
+		assert.True(t, errors.Is(err, meta.ErrTimeSliceMergeInvalid))
+		//start the timer where it was stopped, stop it and merge (contiguous)
+		timerStarted, err := m.TimerStart(ctx, timer.ID, tNow.Add(-15*time.Minute).UnixNano())
+		assert.Nil(t, err)
+		timeSliceContiguousID := timerStarted.ActiveTimeSliceID
+		_, err = m.TimerStop(ctx, timer.ID, tNow.Add(-10*time.Minute).UnixNano())
+		assert.Nil(t, err)
+		timeSlice, err = m.TimeSliceRead(ctx, timeSliceContiguousID)
+		assert.Nil(t, err)
+		timeSlices, err = m.TimeSlicesRead(ctx, data.TimeSliceSearch{
+			TimerID: &timer.ID,
+		})
+		assert.Nil(t, err)
+		if !assert.Len(t, timeSlices, 3) {
+			return
+		}
+		var timeSliceIDs []string
+		for _, timeSlice := range timeSlices {
+			if timeSlice.Start >= tNow.Add(-30*time.Minute).UnixNano() {
+				timeSliceIDs = append(timeSliceIDs, timeSlice.ID)
+			}
+		}
+		timeSliceMerged, err = m.TimeSlicesMerge(ctx, timeSliceIDs)
+		assert.Nil(t, err)
+		assert.Equal(t, timeSliceContiguousID, timeSliceMerged.ID)
+		assert.Equal(t, tNow.Add(-30*time.Minute).UnixNano(), timeSliceMerged.Start)
+		assert.Equal(t, timeSlice.Finish, timeSliceMerged.Finish)
+		//start the timer and split the active time slice
+		timerStarted, err = m.TimerStart(ctx, timer.ID, tNow.Add(-5*time.Minute).UnixNano())
+		assert.Nil(t, err)
+		splitTime = tNow.Add(-3 * time.Minute).UnixNano()
+		timeSlicesSplit, err = m.TimeSliceSplit(ctx, timerStarted.ActiveTimeSliceID, splitTime)
+		assert.Nil(t, err)
+		if !assert.Len(t, timeSlicesSplit, 2) {
+			return
+		}
+		assert.Equal(t, tNow.Add(-5*time.Minute).UnixNano(), timeSlicesSplit[0].Start)
+		assert.Equal(t, splitTime, timeSlicesSplit[0].Finish)
+		assert.Equal(t, timerStarted.ActiveTimeSliceID, timeSlicesSplit[1].ID)
+		assert.Equal(t, splitTime, timeSlicesSplit[1].Start)
+		assert.Zero(t, timeSlicesSplit[1].Finish)
+		timerRead, err = m.TimerRead(ctx, timer.ID)
+		assert.Nil(t, err)
+		assert.Equal(t, timerStarted.ActiveTimeSliceID, timerRead.ActiveTimeSliceID)
+		_, err = m.TimerStop(ctx, timer.ID, 0)
+		assert.Nil(t, err)
+	}
+}
+
+func TestTimeSlicesMergeHistory(ctx context.Context, m interface {
+	meta.Timer
+	meta.TimeSlice
+}) func(*testing.T) {
+	return func(t *testing.T) {
+		//create, start and stop timer
+		comment := randomString(25)
+		timer, err := m.TimerCreate(ctx, data.TimerPartial{
+			Comment: &comment,
+		})
+		assert.Nil(t, err)
+		defer func() {
+			_ = m.TimerDelete(ctx, timer.ID)
+		}()
+		tNow := time.Now().Truncate(time.Second)
+		_, err = m.TimerStart(ctx, timer.ID, tNow.Add(-2*time.Hour).UnixNano())
+		assert.Nil(t, err)
+		_, err = m.TimerStop(ctx, timer.ID, tNow.Add(-time.Hour).UnixNano())
+		assert.Nil(t, err)
+		timeSlices, err := m.TimeSlicesRead(ctx, data.TimeSliceSearch{
+			TimerID: &timer.ID,
+		})
+		assert.Nil(t, err)
+		if !assert.Len(t, timeSlices, 1) {
+			return
+		}
+		//split and merge, the history of the time slice merged
+		// should be kept
+		timeSlices, err = m.TimeSliceSplit(ctx, timeSlices[0].ID, tNow.Add(-90*time.Minute).UnixNano())
+		assert.Nil(t, err)
+		if !assert.Len(t, timeSlices, 2) {
+			return
+		}
+		_, err = m.TimeSlicesMerge(ctx, []string{timeSlices[0].ID, timeSlices[1].ID})
+		assert.Nil(t, err)
+		history, err := m.TimeSliceHistory(ctx, timeSlices[0].ID)
+		assert.Nil(t, err)
+		assert.NotEmpty(t, history)
+	}
+}
+
+func TestTimerHistory(ctx context.Context, m interface {
+	meta.Timer
+	meta.TimeSlice
+}) func(*testing.T) {
+	return func(t *testing.T) {
+		//create, update, start and stop timer
+		comment, commentUpdated := randomString(25), randomString(25)
+		timer, err := m.TimerCreate(ctx, data.TimerPartial{
+			Comment: &comment,
+		})
+		assert.Nil(t, err)
+		_, err = m.TimerUpdate(ctx, timer.ID, data.TimerPartial{
+			Comment: &commentUpdated,
+		})
+		assert.Nil(t, err)
+		timerStarted, err := m.TimerStart(ctx, timer.ID, 0)
+		assert.Nil(t, err)
+		_, err = m.TimerStop(ctx, timer.ID, 0)
+		assert.Nil(t, err)
+		//read timer history
+		timers, err := m.TimerHistory(ctx, timer.ID)
+		assert.Nil(t, err)
+		//KIM: create, update, start and stop each add a version
+		if assert.Len(t, timers, 4) {
+			assert.Equal(t, comment, timers[0].Comment)
+			assert.Equal(t, commentUpdated, timers[1].Comment)
+			assert.Empty(t, timers[1].ActiveTimeSliceID)
+			assert.Equal(t, timerStarted.ActiveTimeSliceID, timers[2].ActiveTimeSliceID)
+			assert.NotZero(t, timers[2].Start)
+			assert.Empty(t, timers[3].ActiveTimeSliceID)
+			assert.Equal(t, commentUpdated, timers[3].Comment)
+			for i, timer := range timers {
+				assert.Equal(t, i+1, timer.Version)
+				assert.NotEmpty(t, timer.LastUpdatedBy)
+				assert.NotZero(t, timer.LastUpdated)
+			}
+		}
+		//read time slice history
+		timeSlices, err := m.TimeSliceHistory(ctx, timerStarted.ActiveTimeSliceID)
+		assert.Nil(t, err)
+		if assert.Len(t, timeSlices, 2) {
+			assert.Equal(t, 1, timeSlices[0].Version)
+			assert.Zero(t, timeSlices[0].Finish)
+			assert.Equal(t, 2, timeSlices[1].Version)
+			assert.NotZero(t, timeSlices[1].Finish)
+		}
+		//delete timer and read history, the history is kept until
+		// the timer is purged
+		err = m.TimerDelete(ctx, timer.ID)
+		assert.Nil(t, err)
+		timers, err = m.TimerHistory(ctx, timer.ID)
+		assert.Nil(t, err)
+		if assert.Len(t, timers, 5) {
+			assert.Equal(t, 5, timers[4].Version)
+			assert.NotZero(t, timers[4].DeletedAt)
+		}
+		_, err = m.TimeSliceHistory(ctx, timerStarted.ActiveTimeSliceID)
+		assert.Nil(t, err)
+	}
+}
+
+func TestTimerTrash(ctx context.Context, m interface {
+	meta.Timer
+	meta.TimeSlice
+	meta.Purger
+}) func(*testing.T) {
+	return func(t *testing.T) {
+		//create and start timer
+		comment := randomString(25)
+		timer, err := m.TimerCreate(ctx, data.TimerPartial{
+			Comment: &comment,
+		})
+		assert.Nil(t, err)
+		timer, err = m.TimerStart(ctx, timer.ID, 0)
+		assert.Nil(t, err)
+		activeTimeSliceID := timer.ActiveTimeSliceID
+		//delete timer, it should be stopped and only found
+		// when searching for deleted timers
+		err = m.TimerDelete(ctx, timer.ID)
+		assert.Nil(t, err)
+		err = m.TimerDelete(ctx, timer.ID)
+		assert.True(t, errors.Is(err, meta.ErrTimerNotFound))
+		_, err = m.TimerRead(ctx, timer.ID)
+		assert.True(t, errors.Is(err, meta.ErrTimerNotFound))
+		_, err = m.TimerUpdate(ctx, timer.ID, data.TimerPartial{
+			Comment: &comment,
+		})
+		assert.True(t, errors.Is(err, meta.ErrTimerNotFound))
+		_, err = m.TimerStart(ctx, timer.ID, 0)
+		assert.True(t, errors.Is(err, meta.ErrTimerNotFound))
+		timers, err := m.TimersRead(ctx, data.TimerSearch{
+			IDs: []string{timer.ID},
+		})
+		assert.Nil(t, err)
+		assert.Len(t, timers, 0)
+		deleted := true
+		timers, err = m.TimersRead(ctx, data.TimerSearch{
+			IDs:     []string{timer.ID},
+			Deleted: &deleted,
+		})
+		assert.Nil(t, err)
+		if assert.Len(t, timers, 1) {
+			assert.NotZero(t, timers[0].DeletedAt)
+			assert.Empty(t, timers[0].ActiveTimeSliceID)
+		}
+		//restore timer
+		timerRestored, err := m.TimerRestore(ctx, timer.ID)
+		assert.Nil(t, err)
+		if assert.NotNil(t, timerRestored) {
+			assert.Zero(t, timerRestored.DeletedAt)
+			assert.Greater(t, timerRestored.Version, timer.Version)
+		}
+		_, err = m.TimerRestore(ctx, timer.ID)
+		assert.True(t, errors.Is(err, meta.ErrTimerNotFound))
+		timerRead, err := m.TimerRead(ctx, timer.ID)
+		assert.Nil(t, err)
+		assert.Equal(t, timerRestored, timerRead)
+		//delete and purge timer
+		err = m.TimerDelete(ctx, timer.ID)
+		assert.Nil(t, err)
+		timersPurged, err := m.TimersPurge(ctx, time.Now().Add(-time.Hour).UnixNano())
+		assert.Nil(t, err)
+		for _, timerPurged := range timersPurged {
+			assert.NotEqual(t, timer.ID, timerPurged.ID)
+		}
+		timersPurged, err = m.TimersPurge(ctx, time.Now().Add(time.Second).UnixNano())
+		assert.Nil(t, err)
+		found := false
+		for _, timerPurged := range timersPurged {
+			if timerPurged.ID == timer.ID {
+				assert.Equal(t, timerRestored.Comment, timerPurged.Comment)
+				assert.NotZero(t, timerPurged.DeletedAt)
+				found = true
+			}
+		}
+		assert.True(t, found)
+		_, err = m.TimerRestore(ctx, timer.ID)
+		assert.True(t, errors.Is(err, meta.ErrTimerNotFound))
+		_, err = m.TimerHistory(ctx, timer.ID)
+		assert.True(t, errors.Is(err, meta.ErrTimerNotFound))
+		_, err = m.TimeSliceRead(ctx, activeTimeSliceID)
+		assert.True(t, errors.Is(err, meta.ErrTimeSliceNotFound))
+	}
+}
+
+func TestOutbox(ctx context.Context, m meta.Outbox) func(*testing.T) {
+	return func(t *testing.T) {
+		var changes []*data.OutboxChange
+
+		//KIM: the outbox may not be empty, so the backlog is
+		// compared relative to what's already there
+		backlog, err := m.OutboxBacklog(ctx)
+		assert.Nil(t, err)
+		//enqueue changes
+		for _, action := range []string{data.ChangeActionCreate, data.ChangeActionUpdate, data.ChangeActionDelete} {
+			change, err := m.OutboxEnqueue(ctx, data.OutboxChange{
+				WhenChanged: time.Now().UnixNano(),
+				ChangedBy:   randomString(10),
+				DataId:      randomString(36),
+				DataType:    data.ChangeTypeTimer,
+				DataAction:  action,
+				DataVersion: 1,
+			})
+			assert.Nil(t, err)
+			if assert.NotNil(t, change) {
+				assert.NotEmpty(t, change.ID)
+				assert.NotZero(t, change.Enqueued)
+				assert.Equal(t, action, change.DataAction)
+				changes = append(changes, change)
+			}
+		}
+		backlogEnqueued, err := m.OutboxBacklog(ctx)
+		assert.Nil(t, err)
+		assert.Equal(t, backlog+len(changes), backlogEnqueued)
+		//read changes, they should be read oldest first
+		changesRead, err := m.OutboxRead(ctx, 0)
+		assert.Nil(t, err)
+		if assert.GreaterOrEqual(t, len(changesRead), len(changes)) {
+			changesRead = changesRead[len(changesRead)-len(changes):]
+			for i, change := range changes {
+				assert.Equal(t, change.ID, changesRead[i].ID)
+				assert.Equal(t, change.DataId, changesRead[i].DataId)
+				assert.Equal(t, change.ChangedBy, changesRead[i].ChangedBy)
+			}
+		}
+		changesRead, err = m.OutboxRead(ctx, 1)
+		assert.Nil(t, err)
+		assert.Len(t, changesRead, 1)
+		//acknowledge changes
+		for _, change := range changes {
+			err := m.OutboxAcknowledge(ctx, change.ID)
+			assert.Nil(t, err)
+			err = m.OutboxAcknowledge(ctx, change.ID)
+			assert.True(t, errors.Is(err, meta.ErrOutboxChangeNotFound))
+		}
+		backlogAcknowledged, err := m.OutboxBacklog(ctx)
+		assert.Nil(t, err)
+		assert.Equal(t, backlog, backlogAcknowledged)
+	}
+}
+
+func TestOutboxTransaction(ctx context.Context, m interface {
+	meta.Timer
+	meta.Outbox
+}) func(*testing.T) {
+	return func(t *testing.T) {
+		var timer *data.Timer
+		var change *data.OutboxChange
+
+		backlog, err := m.OutboxBacklog(ctx)
+		assert.Nil(t, err)
+		//create timer and enqueue its change within a transaction, the
+		// timer should be readable within the transaction
+		comment := randomString(25)
+		err = m.OutboxTransaction(ctx, func(ctx context.Context) error {
+			var err error
+
+			if timer, err = m.TimerCreate(ctx, data.TimerPartial{
+				Comment: &comment,
+			}); err != nil {
+				return err
+			}
+			if _, err := m.TimerRead(ctx, timer.ID); err != nil {
+				return err
+			}
+			change, err = m.OutboxEnqueue(ctx, data.OutboxChange{
+				WhenChanged: timer.LastUpdated,
+				ChangedBy:   timer.LastUpdatedBy,
+				DataId:      timer.ID,
+				DataType:    data.ChangeTypeTimer,
+				DataAction:  data.ChangeActionCreate,
+				DataVersion: timer.Version,
+			})
+			return err
+		})
+		assert.Nil(t, err)
+		if !assert.NotNil(t, timer) || !assert.NotNil(t, change) {
+			return
+		}
+		timerRead, err := m.TimerRead(ctx, timer.ID)
+		assert.Nil(t, err)
+		assert.Equal(t, comment, timerRead.Comment)
+		backlogEnqueued, err := m.OutboxBacklog(ctx)
+		assert.Nil(t, err)
+		assert.Equal(t, backlog+1, backlogEnqueued)
+		err = m.OutboxAcknowledge(ctx, change.ID)
+		assert.Nil(t, err)
+		//validate that an error within the transaction is returned
+		err = m.OutboxTransaction(ctx, func(ctx context.Context) error {
+			return m.TimerDelete(ctx, randomString(36))
+		})
+		assert.True(t, errors.Is(err, meta.ErrTimerNotFound))
+		//delete timer
+		err = m.TimerDelete(ctx, timer.ID)
+		assert.Nil(t, err)
+	}
+}
+
+func TestProjectCRUD(ctx context.Context, m interface {
+	meta.Project
+	meta.Timer
+}) func(*testing.T) {
+	return func(t *testing.T) {
+		//create project
+		name, description := randomString(25), randomString(25)
+		project, err := m.ProjectCreate(ctx, data.ProjectPartial{
+			Name:        &name,
+			Description: &description,
+		})
+		assert.Nil(t, err)
+		assert.NotEmpty(t, project.ID)
+		assert.Equal(t, name, project.Name)
+		assert.Equal(t, description, project.Description)
+		//create project (conflict)
+		_, err = m.ProjectCreate(ctx, data.ProjectPartial{
+			Name: &name,
+		})
+		assert.ErrorIs(t, err, meta.ErrProjectConflictCreate)
+		//read
+		projectRead, err := m.ProjectRead(ctx, project.ID)
+		assert.Nil(t, err)
+		assert.Equal(t, project, projectRead)
+		projects, err := m.ProjectsRead(ctx, data.ProjectSearch{
+			Names: []string{name},
+		})
+		assert.Nil(t, err)
+		assert.Contains(t, projects, project)
+		//update
+		updatedDescription := randomString(25)
+		projectUpdated, err := m.ProjectUpdate(ctx, project.ID, data.ProjectPartial{
+			Description: &updatedDescription,
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, updatedDescription, projectUpdated.Description)
+		assert.Greater(t, projectUpdated.Version, project.Version)
+		//create timer for project
+		comment := randomString(25)
+		timer, err := m.TimerCreate(ctx, data.TimerPartial{
+			Comment:   &comment,
+			ProjectID: &project.ID,
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, project.ID, timer.ProjectID)
+		//create and update timer (project not found)
+		projectIDNotFound := randomString(25)
+		_, err = m.TimerCreate(ctx, data.TimerPartial{
+			Comment:   &comment,
+			ProjectID: &projectIDNotFound,
+		})
+		assert.ErrorIs(t, err, meta.ErrProjectNotFound)
+		_, err = m.TimerUpdate(ctx, timer.ID, data.TimerPartial{
+			ProjectID: &projectIDNotFound,
+		})
+		assert.ErrorIs(t, err, meta.ErrProjectNotFound)
+		timers, err := m.TimersRead(ctx, data.TimerSearch{
+			ProjectID: &project.ID,
+		})
+		assert.Nil(t, err)
+		if assert.Len(t, timers, 1) {
+			assert.Equal(t, timer.ID, timers[0].ID)
+		}
+		//delete
+		err = m.ProjectDelete(ctx, project.ID)
+		assert.Nil(t, err)
+		err = m.ProjectDelete(ctx, project.ID)
+		assert.NotNil(t, err)
+		//read
+		projectRead, err = m.ProjectRead(ctx, project.ID)
+		assert.NotNil(t, err)
+		assert.Nil(t, projectRead)
+		timerRead, err := m.TimerRead(ctx, timer.ID)
+		assert.Nil(t, err)
+		assert.Empty(t, timerRead.ProjectID)
+		assert.Equal(t, timer.Version+1, timerRead.Version)
+		err = m.TimerDelete(ctx, timer.ID)
+		assert.Nil(t, err)
+	}
+}
+
+func TestRateCardCRUD(ctx context.Context, m meta.RateCard) func(*testing.T) {
+	return func(t *testing.T) {
+		//create rate card
+		employeeID, currency := randomString(25), "USD"
+		rate, effectiveFrom := int64(15000), time.Now().Add(-time.Hour).UnixNano()
+		rateCard, err := m.RateCardCreate(ctx, data.RateCardPartial{
+			EmployeeID:    &employeeID,
+			Rate:          &rate,
+			Currency:      &currency,
+			EffectiveFrom: &effectiveFrom,
+		})
+		assert.Nil(t, err)
+		assert.NotEmpty(t, rateCard.ID)
+		assert.Equal(t, employeeID, rateCard.EmployeeID)
+		assert.Equal(t, rate, rateCard.Rate)
+		assert.Equal(t, currency, rateCard.Currency)
+		//create rate card (no rate)
+		_, err = m.RateCardCreate(ctx, data.RateCardPartial{
+			Currency: &currency,
+		})
+		assert.ErrorIs(t, err, meta.ErrRateCardNotCreated)
+		//read
+		rateCardRead, err := m.RateCardRead(ctx, rateCard.ID)
+		assert.Nil(t, err)
+		assert.Equal(t, rateCard, rateCardRead)
+		effectiveAt := time.Now().UnixNano()
+		rateCards, err := m.RateCardsRead(ctx, data.RateCardSearch{
+			EmployeeIDs: []string{employeeID},
+			EffectiveAt: &effectiveAt,
+		})
+		assert.Nil(t, err)
+		assert.Contains(t, rateCards, rateCard)
+		//update
+		updatedRate, effectiveTo := int64(20000), time.Now().Add(-time.Minute).UnixNano()
+		rateCardUpdated, err := m.RateCardUpdate(ctx, rateCard.ID, data.RateCardPartial{
+			Rate:        &updatedRate,
+			EffectiveTo: &effectiveTo,
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, updatedRate, rateCardUpdated.Rate)
+		assert.Greater(t, rateCardUpdated.Version, rateCard.Version)
+		rateCards, err = m.RateCardsRead(ctx, data.RateCardSearch{
+			EmployeeIDs: []string{employeeID},
+			EffectiveAt: &effectiveAt,
+		})
+		assert.Nil(t, err)
+		assert.Empty(t, rateCards)
+		//delete
+		err = m.RateCardDelete(ctx, rateCard.ID)
+		assert.Nil(t, err)
+		err = m.RateCardDelete(ctx, rateCard.ID)
+		assert.NotNil(t, err)
+		//read
+		rateCardRead, err = m.RateCardRead(ctx, rateCard.ID)
+		assert.NotNil(t, err)
+		assert.Nil(t, rateCardRead)
+	}
+}
+
+func TestInvoiceCreate(ctx context.Context, m interface {
+	meta.Invoice
+	meta.Timer
+}) func(*testing.T) {
+	return func(t *testing.T) {
+		//create and submit timer
+		comment := randomString(25)
+		timer, err := m.TimerCreate(ctx, data.TimerPartial{
+			Comment: &comment,
+		})
+		assert.Nil(t, err)
+		_, err = m.TimerStart(ctx, timer.ID, 0)
+		assert.Nil(t, err)
+		timer, err = m.TimerSubmit(ctx, timer.ID, time.Now().UnixNano())
+		assert.Nil(t, err)
+		assert.True(t, timer.Completed)
+		assert.Empty(t, timer.InvoiceID)
+		//create invoice (no line items)
+		_, err = m.InvoiceCreate(ctx, data.Invoice{Currency: "USD"})
+		assert.ErrorIs(t, err, meta.ErrInvoiceNotCreated)
+		//create invoice
+		invoice, err := m.InvoiceCreate(ctx, data.Invoice{
+			Start:    timer.Start,
+			Finish:   time.Now().UnixNano(),
+			Currency: "USD",
+			Total:    100,
+			LineItems: []data.InvoiceLineItem{{
+				TimerID:     timer.ID,
+				RateCardID:  randomString(25),
+				ElapsedTime: timer.ElapsedTime,
+				Rate:        15000,
+				Amount:      100,
+			}},
+		})
+		assert.Nil(t, err)
+		assert.NotEmpty(t, invoice.ID)
+		assert.Equal(t, int64(100), invoice.Total)
+		if assert.Len(t, invoice.LineItems, 1) {
+			assert.Equal(t, timer.ID, invoice.LineItems[0].TimerID)
+		}
+		timerRead, err := m.TimerRead(ctx, timer.ID)
+		assert.Nil(t, err)
+		assert.Equal(t, invoice.ID, timerRead.InvoiceID)
+		invoiced := true
+		timers, err := m.TimersRead(ctx, data.TimerSearch{
+			IDs:      []string{timer.ID},
+			Invoiced: &invoiced,
+		})
+		assert.Nil(t, err)
+		assert.Len(t, timers, 1)
+		//create invoice (already invoiced)
+		_, err = m.InvoiceCreate(ctx, data.Invoice{
+			Currency:  "USD",
+			LineItems: []data.InvoiceLineItem{{TimerID: timer.ID}},
+		})
+		assert.ErrorIs(t, err, meta.ErrTimerInvoiced)
+		//read
+		invoiceRead, err := m.InvoiceRead(ctx, invoice.ID)
+		assert.Nil(t, err)
+		assert.Equal(t, invoice, invoiceRead)
+		invoices, err := m.InvoicesRead(ctx, data.InvoiceSearch{
+			IDs: []string{invoice.ID},
+		})
+		assert.Nil(t, err)
+		assert.Contains(t, invoices, invoice)
+		//delete
+		err = m.InvoiceDelete(ctx, invoice.ID)
+		assert.Nil(t, err)
+		err = m.InvoiceDelete(ctx, invoice.ID)
+		assert.NotNil(t, err)
+		timerRead, err = m.TimerRead(ctx, timer.ID)
+		assert.Nil(t, err)
+		assert.Empty(t, timerRead.InvoiceID)
+		err = m.TimerDelete(ctx, timer.ID)
+		assert.Nil(t, err)
+	}
+}
+
 //TODO: write test for deleting a timer
 //TODO: write test for calculating elapsed time on
 // an active time slice
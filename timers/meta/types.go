@@ -10,29 +10,79 @@ import (
 
 // error constants
 const (
-	TimerNotFound       string = "timer not found"
-	TimerNotUpdated     string = "timer not updated"
-	TimerNotCreated     string = "timer not created, email address not provided"
-	TimerConflictCreate string = "cannot create timer; email address in use"
-	TimerConflictUpdate string = "cannot update timer; email address in use"
-	TimeSliceNotFound   string = "time slice not found"
+	TimerNotFound            string = "timer not found"
+	TimerNotUpdated          string = "timer not updated"
+	TimerNotCreated          string = "timer not created, email address not provided"
+	TimerConflictCreate      string = "cannot create timer; email address in use"
+	TimerConflictUpdate      string = "cannot update timer; email address in use"
+	TimeSliceNotFound        string = "time slice not found"
+	ProjectNotFound          string = "project not found"
+	ProjectNotUpdated        string = "project not updated"
+	ProjectNotCreated        string = "project not created, name not provided"
+	ProjectConflictCreate    string = "cannot create project; name in use"
+	ProjectConflictUpdate    string = "cannot update project; name in use"
+	RateCardNotFound         string = "rate card not found"
+	RateCardNotUpdated       string = "rate card not updated"
+	RateCardNotCreated       string = "rate card not created, rate or currency not provided"
+	InvoiceNotFound          string = "invoice not found"
+	InvoiceNotCreated        string = "invoice not created, no line items provided"
+	InvoiceInvalidRange      string = "invoice not created, start and finish not provided or invalid"
+	InvoiceNoTimers          string = "invoice not created, no billable timers found"
+	InvoiceMixedCurrency     string = "invoice not created, timers billed in different currencies"
+	TimerInvoiced            string = "timer already invoiced"
+	TimerNoRateCard          string = "invoice not created, no effective rate card found for timers"
+	TimerConflictVersion     string = "cannot update timer; version mismatch"
+	TimeSliceConflictVersion string = "cannot update time slice; version mismatch"
+	TimerConflictStart       string = "cannot start timer; start conflicts with existing time slices"
+	TimerConflictStop        string = "cannot stop timer; finish conflicts with existing time slices"
+	TimeSliceSplitInvalid    string = "cannot split time slice; split time not within time slice"
+	TimeSliceMergeInvalid    string = "cannot merge time slices; time slices must be adjacent (without gaps) and share a timer"
+	OutboxChangeNotFound     string = "outbox change not found"
 )
 
 // error variables
 var (
-	ErrTimerNotFound       = errors.NewNotFound(errors.New(TimerNotFound))
-	ErrTimerNotUpdated     = errors.NewNotUpdated(errors.New(TimerNotUpdated))
-	ErrTimerNotCreated     = errors.NewNotCreated(errors.New(TimerNotCreated))
-	ErrTimerConflictCreate = errors.NewConflict(errors.New(TimerConflictCreate))
-	ErrTimerConflictUpdate = errors.NewConflict(errors.New(TimerConflictUpdate))
-	ErrTimeSliceNotFound   = errors.NewNotFound(errors.New(TimeSliceNotFound))
+	ErrTimerNotFound            = errors.NewNotFound(errors.New(TimerNotFound))
+	ErrTimerNotUpdated          = errors.NewNotUpdated(errors.New(TimerNotUpdated))
+	ErrTimerNotCreated          = errors.NewNotCreated(errors.New(TimerNotCreated))
+	ErrTimerConflictCreate      = errors.NewConflict(errors.New(TimerConflictCreate))
+	ErrTimerConflictUpdate      = errors.NewConflict(errors.New(TimerConflictUpdate))
+	ErrTimeSliceNotFound        = errors.NewNotFound(errors.New(TimeSliceNotFound))
+	ErrProjectNotFound          = errors.NewNotFound(errors.New(ProjectNotFound))
+	ErrProjectNotUpdated        = errors.NewNotUpdated(errors.New(ProjectNotUpdated))
+	ErrProjectNotCreated        = errors.NewNotCreated(errors.New(ProjectNotCreated))
+	ErrProjectConflictCreate    = errors.NewConflict(errors.New(ProjectConflictCreate))
+	ErrProjectConflictUpdate    = errors.NewConflict(errors.New(ProjectConflictUpdate))
+	ErrRateCardNotFound         = errors.NewNotFound(errors.New(RateCardNotFound))
+	ErrRateCardNotUpdated       = errors.NewNotUpdated(errors.New(RateCardNotUpdated))
+	ErrRateCardNotCreated       = errors.NewNotCreated(errors.New(RateCardNotCreated))
+	ErrInvoiceNotFound          = errors.NewNotFound(errors.New(InvoiceNotFound))
+	ErrInvoiceNotCreated        = errors.NewNotCreated(errors.New(InvoiceNotCreated))
+	ErrInvoiceInvalidRange      = errors.NewNotCreated(errors.New(InvoiceInvalidRange))
+	ErrInvoiceNoTimers          = errors.NewNotCreated(errors.New(InvoiceNoTimers))
+	ErrInvoiceMixedCurrency     = errors.NewNotCreated(errors.New(InvoiceMixedCurrency))
+	ErrTimerInvoiced            = errors.NewConflict(errors.New(TimerInvoiced))
+	ErrTimerNoRateCard          = errors.NewNotCreated(errors.New(TimerNoRateCard))
+	ErrTimerConflictVersion     = errors.NewConflict(errors.New(TimerConflictVersion))
+	ErrTimeSliceConflictVersion = errors.NewConflict(errors.New(TimeSliceConflictVersion))
+	ErrTimerConflictStart       = errors.NewConflict(errors.New(TimerConflictStart))
+	ErrTimerConflictStop        = errors.NewConflict(errors.New(TimerConflictStop))
+	ErrTimeSliceSplitInvalid    = errors.NewConflict(errors.New(TimeSliceSplitInvalid))
+	ErrTimeSliceMergeInvalid    = errors.NewConflict(errors.New(TimeSliceMergeInvalid))
+	ErrOutboxChangeNotFound     = errors.NewNotFound(errors.New(OutboxChangeNotFound))
 )
 
 // SerializedData provides a struct that describes the representation
 // of the data when serialized
 type SerializedData struct {
-	Timers     map[string]data.Timer     `json:"timers"`
-	TimeSlices map[string]data.TimeSlice `json:"time_slices"`
+	Timers            map[string]data.Timer       `json:"timers"`
+	TimeSlices        map[string]data.TimeSlice   `json:"time_slices"`
+	TimersHistory     map[string][]data.Timer     `json:"timers_history,omitempty"`
+	TimeSlicesHistory map[string][]data.TimeSlice `json:"time_slices_history,omitempty"`
+	Projects          map[string]data.Project     `json:"projects"`
+	RateCards         map[string]data.RateCard    `json:"rate_cards"`
+	Invoices          map[string]data.Invoice     `json:"invoices"`
+	Outbox            []data.OutboxChange         `json:"outbox,omitempty"`
 }
 
 type Type string
@@ -89,12 +139,16 @@ type Timer interface {
 	TimerRead(ctx context.Context, id string) (*data.Timer, error)
 
 	//TimerStart can be used to start a given timer or do nothing
-	// if the timer is already started
-	TimerStart(ctx context.Context, id string) (*data.Timer, error)
+	// if the timer is already started, if start time is zero
+	// the current time is used, otherwise it must not conflict
+	// with any of the timer's existing time slices
+	TimerStart(ctx context.Context, id string, startTime int64) (*data.Timer, error)
 
 	//TimerStop can be used to stop a given timer or do nothing
-	// if the timer is not started
-	TimerStop(ctx context.Context, id string) (*data.Timer, error)
+	// if the timer is not started, if finish time is zero the
+	// current time is used, otherwise it must be after the start
+	// of the active time slice and not conflict with any others
+	TimerStop(ctx context.Context, id string, finishTime int64) (*data.Timer, error)
 
 	//TimerUpdate can be used to update values a given timer
 	// not associated with timer operations, values such as:
@@ -104,12 +158,22 @@ type Timer interface {
 	//TimerSubmit can be used to stop a timer and set completed to true
 	TimerSubmit(ctx context.Context, id string, finishTime int64) (*data.Timer, error)
 
-	//TimerDelete can be used to delete a timer if it exists
+	//TimerDelete can be used to delete a timer if it exists, the timer
+	// is moved to the trash (stopped if active) and can be restored until
+	// it's purged
 	TimerDelete(ctx context.Context, id string) error
 
+	//TimerRestore can be used to restore a deleted timer (from the trash)
+	TimerRestore(ctx context.Context, id string) (*data.Timer, error)
+
 	//TimersRead can be used to read one or more timers depending
 	// on search values provided
 	TimersRead(ctx context.Context, search data.TimerSearch) ([]*data.Timer, error)
+
+	//TimerHistory can be used to read every version of a given
+	// timer (oldest first), values such as start/finish and elapsed
+	// time are calculated and not part of the history
+	TimerHistory(ctx context.Context, id string) ([]*data.Timer, error)
 }
 
 // TimeSlice provides an interface that can be used to interact with time slices
@@ -127,7 +191,115 @@ type TimeSlice interface {
 	//TimeSliceDelete can be used to delete an existing time slice
 	TimeSliceDelete(ctx context.Context, id string) error
 
+	//TimeSliceSplit can be used to split an existing time slice at the
+	// given time, the existing time slice will start at the split time
+	// and a new time slice is created for the time before it
+	TimeSliceSplit(ctx context.Context, id string, splitTime int64) ([]*data.TimeSlice, error)
+
+	//TimeSlicesMerge can be used to merge two or more adjacent time slices
+	// of the same timer, the latest time slice is kept (and will start at
+	// the earliest start) while the others are deleted
+	TimeSlicesMerge(ctx context.Context, ids []string) (*data.TimeSlice, error)
+
 	//TimeSlicesRead can be used to read zero or more time slices depending on the
 	// search criteria
 	TimeSlicesRead(ctx context.Context, search data.TimeSliceSearch) ([]*data.TimeSlice, error)
+
+	//TimeSliceHistory can be used to read every version of a given
+	// time slice (oldest first)
+	TimeSliceHistory(ctx context.Context, id string) ([]*data.TimeSlice, error)
+}
+
+// Project provides an interface that can be used to interact with projects
+type Project interface {
+	//ProjectCreate can be used to create a project, the name
+	// is required and must be unique
+	ProjectCreate(ctx context.Context, p data.ProjectPartial) (*data.Project, error)
+
+	//ProjectRead can be used to read an existing project
+	ProjectRead(ctx context.Context, id string) (*data.Project, error)
+
+	//ProjectUpdate can be used to update an existing project
+	ProjectUpdate(ctx context.Context, id string, p data.ProjectPartial) (*data.Project, error)
+
+	//ProjectDelete can be used to delete a project if it exists
+	ProjectDelete(ctx context.Context, id string) error
+
+	//ProjectsRead can be used to read zero or more projects depending
+	// on the search criteria
+	ProjectsRead(ctx context.Context, search data.ProjectSearch) ([]*data.Project, error)
+}
+
+// RateCard provides an interface that can be used to interact with rate cards
+type RateCard interface {
+	//RateCardCreate can be used to create a rate card, the rate
+	// and currency are required
+	RateCardCreate(ctx context.Context, r data.RateCardPartial) (*data.RateCard, error)
+
+	//RateCardRead can be used to read an existing rate card
+	RateCardRead(ctx context.Context, id string) (*data.RateCard, error)
+
+	//RateCardUpdate can be used to update an existing rate card
+	RateCardUpdate(ctx context.Context, id string, r data.RateCardPartial) (*data.RateCard, error)
+
+	//RateCardDelete can be used to delete a rate card if it exists
+	RateCardDelete(ctx context.Context, id string) error
+
+	//RateCardsRead can be used to read zero or more rate cards depending
+	// on the search criteria
+	RateCardsRead(ctx context.Context, search data.RateCardSearch) ([]*data.RateCard, error)
+}
+
+// Invoice provides an interface that can be used to interact with invoices
+type Invoice interface {
+	//InvoiceCreate can be used to store an invoice and atomically mark
+	// the timers of its line items as invoiced, if any of the timers
+	// have already been invoiced, the invoice won't be created
+	InvoiceCreate(ctx context.Context, invoice data.Invoice) (*data.Invoice, error)
+
+	//InvoiceRead can be used to read an existing invoice
+	InvoiceRead(ctx context.Context, id string) (*data.Invoice, error)
+
+	//InvoiceDelete can be used to delete an invoice if it exists, the
+	// timers of its line items will no longer be invoiced
+	InvoiceDelete(ctx context.Context, id string) error
+
+	//InvoicesRead can be used to read zero or more invoices depending
+	// on the search criteria
+	InvoicesRead(ctx context.Context, search data.InvoiceSearch) ([]*data.Invoice, error)
+}
+
+// Purger provides an interface that can be used to permanently remove
+// deleted items
+type Purger interface {
+	//TimersPurge can be used to permanently delete timers (and their
+	// time slices) that were deleted at or before the given time, the
+	// purged timers (as they were before being purged) are returned
+	TimersPurge(ctx context.Context, deletedBefore int64) ([]*data.Timer, error)
+}
+
+// Outbox provides an interface that can be used to durably store
+// changes until they've been delivered
+type Outbox interface {
+	//OutboxEnqueue can be used to add a change to the end of the outbox
+	OutboxEnqueue(ctx context.Context, change data.OutboxChange) (*data.OutboxChange, error)
+
+	//OutboxRead can be used to read up to limit of the oldest changes
+	// in the outbox (oldest first)
+	OutboxRead(ctx context.Context, limit int) ([]*data.OutboxChange, error)
+
+	//OutboxAcknowledge can be used to remove a change from the outbox
+	// once it's been delivered
+	OutboxAcknowledge(ctx context.Context, id string) error
+
+	//OutboxBacklog can be used to read the number of changes in the
+	// outbox that have yet to be delivered
+	OutboxBacklog(ctx context.Context) (int, error)
+
+	//OutboxTransaction can be used to mutate data and enqueue the changes
+	// of those mutations atomically, the mutations and enqueues must use
+	// the context provided to the function (mysql uses a transaction that's
+	// rolled back if the function returns an error, memory and file are
+	// locked for the duration of the function)
+	OutboxTransaction(ctx context.Context, fx func(ctx context.Context) error) error
 }
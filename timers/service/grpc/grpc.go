@@ -21,6 +21,10 @@ type grpcService struct {
 	logger.Logger
 	pb.UnimplementedTimersServer
 	pb.UnimplementedTimeSlicesServer
+	pb.UnimplementedProjectsServer
+	pb.UnimplementedRateCardsServer
+	pb.UnimplementedInvoicesServer
+	pb.UnimplementedTimesheetsServer
 	logic logic.Logic
 }
 
@@ -29,6 +33,10 @@ type grpcService struct {
 var (
 	_ pb.TimersServer     = &grpcService{}
 	_ pb.TimeSlicesServer = &grpcService{}
+	_ pb.ProjectsServer   = &grpcService{}
+	_ pb.RateCardsServer  = &grpcService{}
+	_ pb.InvoicesServer   = &grpcService{}
+	_ pb.TimesheetsServer = &grpcService{}
 )
 
 func New(parameters ...interface{}) interface {
@@ -61,6 +69,10 @@ func (s *grpcService) SetParameters(parameters ...interface{}) {
 func (s *grpcService) Register(server grpc.ServiceRegistrar) {
 	pb.RegisterTimersServer(server, s)
 	pb.RegisterTimeSlicesServer(server, s)
+	pb.RegisterProjectsServer(server, s)
+	pb.RegisterRateCardsServer(server, s)
+	pb.RegisterInvoicesServer(server, s)
+	pb.RegisterTimesheetsServer(server, s)
 }
 
 func (s *grpcService) TimerCreate(ctx context.Context, request *pb.TimerCreateRequest) (*pb.TimerCreateResponse, error) {
@@ -74,7 +86,12 @@ func (s *grpcService) TimerRead(ctx context.Context, request *pb.TimerReadReques
 }
 
 func (s *grpcService) TimerUpdate(ctx context.Context, request *pb.TimerUpdateRequest) (*pb.TimerUpdateResponse, error) {
-	timer, err := s.logic.TimerUpdate(ctx, request.GetId(), *pb.ToTimerPartial(request.GetTimerPartial()))
+	timerPartial := pb.ToTimerPartial(request.GetTimerPartial())
+	if request.VersionOneof != nil {
+		version := int(request.GetVersion())
+		timerPartial.Version = &version
+	}
+	timer, err := s.logic.TimerUpdate(ctx, request.GetId(), *timerPartial)
 	return &pb.TimerUpdateResponse{Timer: pb.FromTimer(timer)}, err
 }
 
@@ -89,12 +106,20 @@ func (s *grpcService) TimersRead(ctx context.Context, request *pb.TimersReadRequ
 }
 
 func (s *grpcService) TimerStart(ctx context.Context, request *pb.TimerStartRequest) (*pb.TimerStartResponse, error) {
-	timer, err := s.logic.TimerStart(ctx, request.GetId())
+	var start int64
+	if request.StartOneof != nil {
+		start = request.GetStart()
+	}
+	timer, err := s.logic.TimerStart(ctx, request.GetId(), start)
 	return &pb.TimerStartResponse{Timer: pb.FromTimer(timer)}, err
 }
 
 func (s *grpcService) TimerStop(ctx context.Context, request *pb.TimerStopRequest) (*pb.TimerStopResponse, error) {
-	timer, err := s.logic.TimerStop(ctx, request.GetId())
+	var finish int64
+	if request.FinishOneof != nil {
+		finish = request.GetFinish()
+	}
+	timer, err := s.logic.TimerStop(ctx, request.GetId(), finish)
 	return &pb.TimerStopResponse{Timer: pb.FromTimer(timer)}, err
 }
 
@@ -107,6 +132,16 @@ func (s *grpcService) TimerSubmit(ctx context.Context, request *pb.TimerSubmitRe
 	return &pb.TimerSubmitResponse{Timer: pb.FromTimer(timer)}, err
 }
 
+func (s *grpcService) TimerHistory(ctx context.Context, request *pb.TimerHistoryRequest) (*pb.TimerHistoryResponse, error) {
+	timers, err := s.logic.TimerHistory(ctx, request.GetId())
+	return &pb.TimerHistoryResponse{Timers: pb.FromTimers(timers)}, err
+}
+
+func (s *grpcService) TimerRestore(ctx context.Context, request *pb.TimerRestoreRequest) (*pb.TimerRestoreResponse, error) {
+	timer, err := s.logic.TimerRestore(ctx, request.GetId())
+	return &pb.TimerRestoreResponse{Timer: pb.FromTimer(timer)}, err
+}
+
 func (s *grpcService) TimeSliceCreate(ctx context.Context, request *pb.TimeSliceCreateRequest) (*pb.TimeSliceCreateResponse, error) {
 	timeSlice, err := s.logic.TimeSliceCreate(ctx, *pb.ToTimeSlicePartial(request.GetTimeSlicePartial()))
 	return &pb.TimeSliceCreateResponse{TimeSlice: pb.FromTimeSlice(timeSlice)}, err
@@ -118,7 +153,12 @@ func (s *grpcService) TimeSliceRead(ctx context.Context, request *pb.TimeSliceRe
 }
 
 func (s *grpcService) TimeSliceUpdate(ctx context.Context, request *pb.TimeSliceUpdateRequest) (*pb.TimeSliceUpdateResponse, error) {
-	timeSlice, err := s.logic.TimeSliceUpdate(ctx, request.GetId(), *pb.ToTimeSlicePartial(request.GetTimeSlicePartial()))
+	timeSlicePartial := pb.ToTimeSlicePartial(request.GetTimeSlicePartial())
+	if request.VersionOneof != nil {
+		version := int(request.GetVersion())
+		timeSlicePartial.Version = &version
+	}
+	timeSlice, err := s.logic.TimeSliceUpdate(ctx, request.GetId(), *timeSlicePartial)
 	return &pb.TimeSliceUpdateResponse{TimeSlice: pb.FromTimeSlice(timeSlice)}, err
 }
 
@@ -131,3 +171,93 @@ func (s *grpcService) TimeSlicesRead(ctx context.Context, request *pb.TimeSlices
 	timeSlices, err := s.logic.TimeSlicesRead(ctx, *pb.FromTimeSliceSearch(request.GetTimeSliceSearch()))
 	return &pb.TimeSlicesReadResponse{TimeSlices: pb.FromTimeSlices(timeSlices)}, err
 }
+
+func (s *grpcService) TimeSliceSplit(ctx context.Context, request *pb.TimeSliceSplitRequest) (*pb.TimeSliceSplitResponse, error) {
+	timeSlices, err := s.logic.TimeSliceSplit(ctx, request.GetId(), request.GetSplitTime())
+	return &pb.TimeSliceSplitResponse{TimeSlices: pb.FromTimeSlices(timeSlices)}, err
+}
+
+func (s *grpcService) TimeSlicesMerge(ctx context.Context, request *pb.TimeSlicesMergeRequest) (*pb.TimeSlicesMergeResponse, error) {
+	timeSlice, err := s.logic.TimeSlicesMerge(ctx, request.GetIds())
+	return &pb.TimeSlicesMergeResponse{TimeSlice: pb.FromTimeSlice(timeSlice)}, err
+}
+
+func (s *grpcService) TimeSliceHistory(ctx context.Context, request *pb.TimeSliceHistoryRequest) (*pb.TimeSliceHistoryResponse, error) {
+	timeSlices, err := s.logic.TimeSliceHistory(ctx, request.GetId())
+	return &pb.TimeSliceHistoryResponse{TimeSlices: pb.FromTimeSlices(timeSlices)}, err
+}
+
+func (s *grpcService) ProjectCreate(ctx context.Context, request *pb.ProjectCreateRequest) (*pb.ProjectCreateResponse, error) {
+	project, err := s.logic.ProjectCreate(ctx, *pb.ToProjectPartial(request.GetProjectPartial()))
+	return &pb.ProjectCreateResponse{Project: pb.FromProject(project)}, err
+}
+
+func (s *grpcService) ProjectRead(ctx context.Context, request *pb.ProjectReadRequest) (*pb.ProjectReadResponse, error) {
+	project, err := s.logic.ProjectRead(ctx, request.GetId())
+	return &pb.ProjectReadResponse{Project: pb.FromProject(project)}, err
+}
+
+func (s *grpcService) ProjectUpdate(ctx context.Context, request *pb.ProjectUpdateRequest) (*pb.ProjectUpdateResponse, error) {
+	project, err := s.logic.ProjectUpdate(ctx, request.GetId(), *pb.ToProjectPartial(request.GetProjectPartial()))
+	return &pb.ProjectUpdateResponse{Project: pb.FromProject(project)}, err
+}
+
+func (s *grpcService) ProjectDelete(ctx context.Context, request *pb.ProjectDeleteRequest) (*pb.ProjectDeleteResponse, error) {
+	err := s.logic.ProjectDelete(ctx, request.GetId())
+	return &pb.ProjectDeleteResponse{}, err
+}
+
+func (s *grpcService) ProjectsRead(ctx context.Context, request *pb.ProjectsReadRequest) (*pb.ProjectsReadResponse, error) {
+	projects, err := s.logic.ProjectsRead(ctx, *pb.FromProjectSearch(request.GetProjectSearch()))
+	return &pb.ProjectsReadResponse{Projects: pb.FromProjects(projects)}, err
+}
+
+func (s *grpcService) RateCardCreate(ctx context.Context, request *pb.RateCardCreateRequest) (*pb.RateCardCreateResponse, error) {
+	rateCard, err := s.logic.RateCardCreate(ctx, *pb.ToRateCardPartial(request.GetRateCardPartial()))
+	return &pb.RateCardCreateResponse{RateCard: pb.FromRateCard(rateCard)}, err
+}
+
+func (s *grpcService) RateCardRead(ctx context.Context, request *pb.RateCardReadRequest) (*pb.RateCardReadResponse, error) {
+	rateCard, err := s.logic.RateCardRead(ctx, request.GetId())
+	return &pb.RateCardReadResponse{RateCard: pb.FromRateCard(rateCard)}, err
+}
+
+func (s *grpcService) RateCardUpdate(ctx context.Context, request *pb.RateCardUpdateRequest) (*pb.RateCardUpdateResponse, error) {
+	rateCard, err := s.logic.RateCardUpdate(ctx, request.GetId(), *pb.ToRateCardPartial(request.GetRateCardPartial()))
+	return &pb.RateCardUpdateResponse{RateCard: pb.FromRateCard(rateCard)}, err
+}
+
+func (s *grpcService) RateCardDelete(ctx context.Context, request *pb.RateCardDeleteRequest) (*pb.RateCardDeleteResponse, error) {
+	err := s.logic.RateCardDelete(ctx, request.GetId())
+	return &pb.RateCardDeleteResponse{}, err
+}
+
+func (s *grpcService) RateCardsRead(ctx context.Context, request *pb.RateCardsReadRequest) (*pb.RateCardsReadResponse, error) {
+	rateCards, err := s.logic.RateCardsRead(ctx, *pb.FromRateCardSearch(request.GetRateCardSearch()))
+	return &pb.RateCardsReadResponse{RateCards: pb.FromRateCards(rateCards)}, err
+}
+
+func (s *grpcService) InvoiceCreate(ctx context.Context, request *pb.InvoiceCreateRequest) (*pb.InvoiceCreateResponse, error) {
+	invoice, err := s.logic.InvoiceCreate(ctx, *pb.ToInvoicePartial(request.GetInvoicePartial()))
+	return &pb.InvoiceCreateResponse{Invoice: pb.FromInvoice(invoice)}, err
+}
+
+func (s *grpcService) InvoiceRead(ctx context.Context, request *pb.InvoiceReadRequest) (*pb.InvoiceReadResponse, error) {
+	invoice, err := s.logic.InvoiceRead(ctx, request.GetId())
+	return &pb.InvoiceReadResponse{Invoice: pb.FromInvoice(invoice)}, err
+}
+
+func (s *grpcService) InvoiceDelete(ctx context.Context, request *pb.InvoiceDeleteRequest) (*pb.InvoiceDeleteResponse, error) {
+	err := s.logic.InvoiceDelete(ctx, request.GetId())
+	return &pb.InvoiceDeleteResponse{}, err
+}
+
+func (s *grpcService) InvoicesRead(ctx context.Context, request *pb.InvoicesReadRequest) (*pb.InvoicesReadResponse, error) {
+	invoices, err := s.logic.InvoicesRead(ctx, *pb.FromInvoiceSearch(request.GetInvoiceSearch()))
+	return &pb.InvoicesReadResponse{Invoices: pb.FromInvoices(invoices)}, err
+}
+
+func (s *grpcService) TimesheetsRead(ctx context.Context, request *pb.TimesheetsReadRequest) (*pb.TimesheetsReadResponse, error) {
+	timesheets, err := s.logic.TimesheetsRead(ctx, *pb.FromTimesheetSearch(request.GetTimesheetSearch()))
+	return &pb.TimesheetsReadResponse{Timesheets: pb.FromTimesheets(timesheets)}, err
+}
@@ -44,7 +44,7 @@ var (
 	configLogger             = new(internal_logger.Configuration)
 	configServer             = new(internal_server.Configuration)
 	configLogic              = new(logic.Configuration)
-	configChangesClientRest  = new(changesclientrest.Configuration)
+	configChangesClientRest  = changesclientrest.NewConfiguration()
 	configChangesClientKafka = new(changesclientkafka.Configuration)
 	configKafkaClient        = new(internal_kafka.Configuration)
 )
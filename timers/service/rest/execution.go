@@ -1,9 +1,26 @@
 package rest
 
 import (
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+
 	"github.com/antonio-alexander/go-bludgeon/timers/data"
 )
 
+// header constants
+const (
+	headerETag    string = "ETag"
+	headerIfMatch string = "If-Match"
+)
+
+// error constants
+const ifMatchInvalid string = "if-match header invalid; expected a version"
+
+// error variables
+var ErrIfMatchInvalid = errors.New(ifMatchInvalid)
+
 func idFromPath(pathVariables map[string]string) string {
 	id, ok := pathVariables[data.PathID]
 	if !ok {
@@ -11,3 +28,23 @@ func idFromPath(pathVariables map[string]string) string {
 	}
 	return id
 }
+
+// versionFromIfMatch can be used to read the expected version from the
+// If-Match header, nil is returned if the header is absent or a wildcard
+func versionFromIfMatch(request *http.Request) (*int, error) {
+	ifMatch := strings.TrimSpace(request.Header.Get(headerIfMatch))
+	if ifMatch == "" || ifMatch == "*" {
+		return nil, nil
+	}
+	ifMatch = strings.Trim(strings.TrimPrefix(ifMatch, "W/"), `"`)
+	version, err := strconv.Atoi(ifMatch)
+	if err != nil {
+		return nil, ErrIfMatchInvalid
+	}
+	return &version, nil
+}
+
+// setETag can be used to set the ETag header to the provided version
+func setETag(writer http.ResponseWriter, version int) {
+	writer.Header().Set(headerETag, strconv.Quote(strconv.Itoa(version)))
+}
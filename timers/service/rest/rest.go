@@ -43,11 +43,35 @@ func (s *restService) handleResponse(writer http.ResponseWriter, err error, byte
 		switch {
 		default:
 			writer.WriteHeader(http.StatusInternalServerError)
-		case errors.Is(err, meta.ErrTimerNotFound):
+		case errors.Is(err, meta.ErrTimerNotFound) ||
+			errors.Is(err, meta.ErrTimeSliceNotFound) ||
+			errors.Is(err, meta.ErrProjectNotFound) ||
+			errors.Is(err, meta.ErrRateCardNotFound) ||
+			errors.Is(err, meta.ErrInvoiceNotFound):
 			writer.WriteHeader(http.StatusNotFound)
-		case errors.Is(err, meta.ErrTimerNotUpdated):
+		case errors.Is(err, meta.ErrTimerNotUpdated) ||
+			errors.Is(err, meta.ErrProjectNotUpdated) ||
+			errors.Is(err, meta.ErrRateCardNotUpdated):
 			writer.WriteHeader(http.StatusNotModified)
-		case errors.Is(err, meta.ErrTimerConflictCreate) || errors.Is(err, meta.ErrTimerConflictUpdate):
+		case errors.Is(err, meta.ErrProjectNotCreated) ||
+			errors.Is(err, meta.ErrRateCardNotCreated) ||
+			errors.Is(err, meta.ErrInvoiceNotCreated) ||
+			errors.Is(err, meta.ErrInvoiceInvalidRange) ||
+			errors.Is(err, meta.ErrInvoiceNoTimers) ||
+			errors.Is(err, meta.ErrInvoiceMixedCurrency) ||
+			errors.Is(err, meta.ErrTimerNoRateCard) ||
+			errors.Is(err, logic.ErrTimesheetTimezoneInvalid) ||
+			errors.Is(err, logic.ErrTimesheetRangeInvalid) ||
+			errors.Is(err, logic.ErrTimerTimeInFuture) ||
+			errors.Is(err, logic.ErrTimeSliceSplitInFuture) ||
+			errors.Is(err, ErrIfMatchInvalid):
+			writer.WriteHeader(http.StatusBadRequest)
+		case errors.Is(err, meta.ErrTimerConflictCreate) || errors.Is(err, meta.ErrTimerConflictUpdate) ||
+			errors.Is(err, meta.ErrProjectConflictCreate) || errors.Is(err, meta.ErrProjectConflictUpdate) ||
+			errors.Is(err, meta.ErrTimerInvoiced) ||
+			errors.Is(err, meta.ErrTimerConflictVersion) || errors.Is(err, meta.ErrTimeSliceConflictVersion) ||
+			errors.Is(err, meta.ErrTimerConflictStart) || errors.Is(err, meta.ErrTimerConflictStop) ||
+			errors.Is(err, meta.ErrTimeSliceSplitInvalid) || errors.Is(err, meta.ErrTimeSliceMergeInvalid):
 			writer.WriteHeader(http.StatusConflict)
 		}
 		switch i := err.(type) {
@@ -99,6 +123,7 @@ func (s *restService) endpointTimerRead() func(http.ResponseWriter, *http.Reques
 
 		id := idFromPath(mux.Vars(request))
 		if timer, err = s.TimerRead(request.Context(), id); err == nil {
+			setETag(writer, timer.Version)
 			bytes, err = json.Marshal(timer)
 		}
 		if err = s.handleResponse(writer, err, bytes); err != nil {
@@ -136,15 +161,57 @@ func (s *restService) endpointTimerDelete() func(http.ResponseWriter, *http.Requ
 	}
 }
 
+func (s *restService) endpointTimerHistory() func(http.ResponseWriter, *http.Request) {
+	return func(writer http.ResponseWriter, request *http.Request) {
+		var timers []*data.Timer
+		var bytes []byte
+		var err error
+
+		id := idFromPath(mux.Vars(request))
+		if timers, err = s.TimerHistory(request.Context(), id); err == nil {
+			bytes, err = json.Marshal(timers)
+		}
+		if err = s.handleResponse(writer, err, bytes); err != nil {
+			s.Error("timer history -  %s", err)
+		}
+	}
+}
+
+func (s *restService) endpointTimerRestore() func(http.ResponseWriter, *http.Request) {
+	return func(writer http.ResponseWriter, request *http.Request) {
+		var timer *data.Timer
+		var bytes []byte
+		var err error
+
+		id := idFromPath(mux.Vars(request))
+		if timer, err = s.TimerRestore(request.Context(), id); err == nil {
+			setETag(writer, timer.Version)
+			bytes, err = json.Marshal(timer)
+		}
+		if err = s.handleResponse(writer, err, bytes); err != nil {
+			s.Error("timer restore -  %s", err)
+		}
+	}
+}
+
 func (s *restService) endpointTimerStart() func(http.ResponseWriter, *http.Request) {
 	return func(writer http.ResponseWriter, request *http.Request) {
+		var contract data.Contract
 		var timer *data.Timer
 		var bytes []byte
 		var err error
 
 		id := idFromPath(mux.Vars(request))
-		if timer, err = s.TimerStart(request.Context(), id); err == nil {
-			bytes, err = json.Marshal(&timer)
+		if bytes, err = io.ReadAll(request.Body); err == nil {
+			defer request.Body.Close()
+			if len(bytes) > 0 {
+				err = json.Unmarshal(bytes, &contract)
+			}
+			if err == nil {
+				if timer, err = s.TimerStart(request.Context(), id, contract.Start); err == nil {
+					bytes, err = json.Marshal(&timer)
+				}
+			}
 		}
 		if err = s.handleResponse(writer, err, bytes); err != nil {
 			s.Error("timer start -  %s", err)
@@ -154,13 +221,22 @@ func (s *restService) endpointTimerStart() func(http.ResponseWriter, *http.Reque
 
 func (s *restService) endpointTimerStop() func(http.ResponseWriter, *http.Request) {
 	return func(writer http.ResponseWriter, request *http.Request) {
+		var contract data.Contract
 		var timer *data.Timer
 		var bytes []byte
 		var err error
 
 		id := idFromPath(mux.Vars(request))
-		if timer, err = s.TimerStop(request.Context(), id); err == nil {
-			bytes, err = json.Marshal(&timer)
+		if bytes, err = io.ReadAll(request.Body); err == nil {
+			defer request.Body.Close()
+			if len(bytes) > 0 {
+				err = json.Unmarshal(bytes, &contract)
+			}
+			if err == nil {
+				if timer, err = s.TimerStop(request.Context(), id, contract.Finish); err == nil {
+					bytes, err = json.Marshal(&timer)
+				}
+			}
 		}
 		if err = s.handleResponse(writer, err, bytes); err != nil {
 			s.Error("timer pause -  %s", err)
@@ -203,13 +279,20 @@ func (s *restService) endpointTimerUpdate() func(http.ResponseWriter, *http.Requ
 		var bytes []byte
 		var err error
 		var timerPartial data.TimerPartial
+		var version *int
 
 		id := idFromPath(mux.Vars(request))
 		if bytes, err = io.ReadAll(request.Body); err == nil {
 			defer request.Body.Close()
 			if err = json.Unmarshal(bytes, &timerPartial); err == nil {
-				if timer, err = s.TimerUpdate(request.Context(), id, timerPartial); err == nil {
-					bytes, err = json.Marshal(&timer)
+				if version, err = versionFromIfMatch(request); err == nil {
+					if version != nil {
+						timerPartial.Version = version
+					}
+					if timer, err = s.TimerUpdate(request.Context(), id, timerPartial); err == nil {
+						setETag(writer, timer.Version)
+						bytes, err = json.Marshal(&timer)
+					}
 				}
 			}
 		}
@@ -247,6 +330,7 @@ func (s *restService) endpointTimeSliceRead() func(http.ResponseWriter, *http.Re
 
 		id := idFromPath(mux.Vars(request))
 		if timeSlice, err = s.TimeSliceRead(request.Context(), id); err == nil {
+			setETag(writer, timeSlice.Version)
 			bytes, err = json.Marshal(timeSlice)
 		}
 		if err = s.handleResponse(writer, err, bytes); err != nil {
@@ -276,14 +360,21 @@ func (s *restService) endpointTimeSliceUpdate() func(http.ResponseWriter, *http.
 	return func(writer http.ResponseWriter, request *http.Request) {
 		var timeSlicePartial data.TimeSlicePartial
 		var timeSlice *data.TimeSlice
+		var version *int
 		var bytes []byte
 		var err error
 
 		id := idFromPath(mux.Vars(request))
 		if bytes, err = io.ReadAll(request.Body); err == nil {
 			if err = json.Unmarshal(bytes, &timeSlicePartial); err == nil {
-				if timeSlice, err = s.TimeSliceUpdate(request.Context(), id, timeSlicePartial); err == nil {
-					bytes, err = json.Marshal(timeSlice)
+				if version, err = versionFromIfMatch(request); err == nil {
+					if version != nil {
+						timeSlicePartial.Version = version
+					}
+					if timeSlice, err = s.TimeSliceUpdate(request.Context(), id, timeSlicePartial); err == nil {
+						setETag(writer, timeSlice.Version)
+						bytes, err = json.Marshal(timeSlice)
+					}
 				}
 			}
 		}
@@ -305,6 +396,320 @@ func (s *restService) endpointTimeSliceDelete() func(http.ResponseWriter, *http.
 	}
 }
 
+func (s *restService) endpointTimeSliceHistory() func(http.ResponseWriter, *http.Request) {
+	return func(writer http.ResponseWriter, request *http.Request) {
+		var timeSlices []*data.TimeSlice
+		var bytes []byte
+		var err error
+
+		id := idFromPath(mux.Vars(request))
+		if timeSlices, err = s.TimeSliceHistory(request.Context(), id); err == nil {
+			bytes, err = json.Marshal(timeSlices)
+		}
+		if err = s.handleResponse(writer, err, bytes); err != nil {
+			s.Error("time slice history -  %s", err)
+		}
+	}
+}
+
+func (s *restService) endpointTimeSliceSplit() func(http.ResponseWriter, *http.Request) {
+	return func(writer http.ResponseWriter, request *http.Request) {
+		var timeSlices []*data.TimeSlice
+		var contract data.Contract
+		var bytes []byte
+		var err error
+
+		id := idFromPath(mux.Vars(request))
+		if bytes, err = io.ReadAll(request.Body); err == nil {
+			defer request.Body.Close()
+			if err = json.Unmarshal(bytes, &contract); err == nil {
+				if timeSlices, err = s.TimeSliceSplit(request.Context(), id, contract.SplitTime); err == nil {
+					bytes, err = json.Marshal(timeSlices)
+				}
+			}
+		}
+		if err = s.handleResponse(writer, err, bytes); err != nil {
+			s.Error("time slice split -  %s", err)
+		}
+	}
+}
+
+func (s *restService) endpointTimeSlicesMerge() func(http.ResponseWriter, *http.Request) {
+	return func(writer http.ResponseWriter, request *http.Request) {
+		var timeSlice *data.TimeSlice
+		var contract data.Contract
+		var bytes []byte
+		var err error
+
+		if bytes, err = io.ReadAll(request.Body); err == nil {
+			defer request.Body.Close()
+			if err = json.Unmarshal(bytes, &contract); err == nil {
+				if timeSlice, err = s.TimeSlicesMerge(request.Context(), contract.IDs); err == nil {
+					setETag(writer, timeSlice.Version)
+					bytes, err = json.Marshal(timeSlice)
+				}
+			}
+		}
+		if err = s.handleResponse(writer, err, bytes); err != nil {
+			s.Error("time slices merge -  %s", err)
+		}
+	}
+}
+
+func (s *restService) endpointProjectCreate() func(http.ResponseWriter, *http.Request) {
+	return func(writer http.ResponseWriter, request *http.Request) {
+		var projectPartial data.ProjectPartial
+		var project *data.Project
+		var bytes []byte
+		var err error
+
+		if bytes, err = io.ReadAll(request.Body); err == nil {
+			if err = json.Unmarshal(bytes, &projectPartial); err == nil {
+				if project, err = s.ProjectCreate(request.Context(), projectPartial); err == nil {
+					bytes, err = json.Marshal(project)
+				}
+			}
+		}
+		if err = s.handleResponse(writer, err, bytes); err != nil {
+			s.Error("project create -  %s", err)
+		}
+	}
+}
+
+func (s *restService) endpointProjectRead() func(http.ResponseWriter, *http.Request) {
+	return func(writer http.ResponseWriter, request *http.Request) {
+		var project *data.Project
+		var bytes []byte
+		var err error
+
+		id := idFromPath(mux.Vars(request))
+		if project, err = s.ProjectRead(request.Context(), id); err == nil {
+			bytes, err = json.Marshal(project)
+		}
+		if err = s.handleResponse(writer, err, bytes); err != nil {
+			s.Error("project read -  %s", err)
+		}
+	}
+}
+
+func (s *restService) endpointProjectsRead() func(http.ResponseWriter, *http.Request) {
+	return func(writer http.ResponseWriter, request *http.Request) {
+		var search data.ProjectSearch
+		var projects []*data.Project
+		var bytes []byte
+		var err error
+
+		search.FromParams(request.URL.Query())
+		if projects, err = s.ProjectsRead(request.Context(), search); err == nil {
+			bytes, err = json.Marshal(projects)
+		}
+		if err = s.handleResponse(writer, err, bytes); err != nil {
+			s.Error("projects read -  %s", err)
+		}
+	}
+}
+
+func (s *restService) endpointProjectUpdate() func(http.ResponseWriter, *http.Request) {
+	return func(writer http.ResponseWriter, request *http.Request) {
+		var projectPartial data.ProjectPartial
+		var project *data.Project
+		var bytes []byte
+		var err error
+
+		id := idFromPath(mux.Vars(request))
+		if bytes, err = io.ReadAll(request.Body); err == nil {
+			if err = json.Unmarshal(bytes, &projectPartial); err == nil {
+				if project, err = s.ProjectUpdate(request.Context(), id, projectPartial); err == nil {
+					bytes, err = json.Marshal(project)
+				}
+			}
+		}
+		if err = s.handleResponse(writer, err, bytes); err != nil {
+			s.Error("project update -  %s", err)
+		}
+	}
+}
+
+func (s *restService) endpointProjectDelete() func(http.ResponseWriter, *http.Request) {
+	return func(writer http.ResponseWriter, request *http.Request) {
+		var err error
+
+		id := idFromPath(mux.Vars(request))
+		err = s.ProjectDelete(request.Context(), id)
+		if err = s.handleResponse(writer, err, nil); err != nil {
+			s.Error("project delete -  %s", err)
+		}
+	}
+}
+
+func (s *restService) endpointRateCardCreate() func(http.ResponseWriter, *http.Request) {
+	return func(writer http.ResponseWriter, request *http.Request) {
+		var rateCardPartial data.RateCardPartial
+		var rateCard *data.RateCard
+		var bytes []byte
+		var err error
+
+		if bytes, err = io.ReadAll(request.Body); err == nil {
+			if err = json.Unmarshal(bytes, &rateCardPartial); err == nil {
+				if rateCard, err = s.RateCardCreate(request.Context(), rateCardPartial); err == nil {
+					bytes, err = json.Marshal(rateCard)
+				}
+			}
+		}
+		if err = s.handleResponse(writer, err, bytes); err != nil {
+			s.Error("rate card create -  %s", err)
+		}
+	}
+}
+
+func (s *restService) endpointRateCardRead() func(http.ResponseWriter, *http.Request) {
+	return func(writer http.ResponseWriter, request *http.Request) {
+		var rateCard *data.RateCard
+		var bytes []byte
+		var err error
+
+		id := idFromPath(mux.Vars(request))
+		if rateCard, err = s.RateCardRead(request.Context(), id); err == nil {
+			bytes, err = json.Marshal(rateCard)
+		}
+		if err = s.handleResponse(writer, err, bytes); err != nil {
+			s.Error("rate card read -  %s", err)
+		}
+	}
+}
+
+func (s *restService) endpointRateCardsRead() func(http.ResponseWriter, *http.Request) {
+	return func(writer http.ResponseWriter, request *http.Request) {
+		var search data.RateCardSearch
+		var rateCards []*data.RateCard
+		var bytes []byte
+		var err error
+
+		search.FromParams(request.URL.Query())
+		if rateCards, err = s.RateCardsRead(request.Context(), search); err == nil {
+			bytes, err = json.Marshal(rateCards)
+		}
+		if err = s.handleResponse(writer, err, bytes); err != nil {
+			s.Error("rate cards read -  %s", err)
+		}
+	}
+}
+
+func (s *restService) endpointRateCardUpdate() func(http.ResponseWriter, *http.Request) {
+	return func(writer http.ResponseWriter, request *http.Request) {
+		var rateCardPartial data.RateCardPartial
+		var rateCard *data.RateCard
+		var bytes []byte
+		var err error
+
+		id := idFromPath(mux.Vars(request))
+		if bytes, err = io.ReadAll(request.Body); err == nil {
+			if err = json.Unmarshal(bytes, &rateCardPartial); err == nil {
+				if rateCard, err = s.RateCardUpdate(request.Context(), id, rateCardPartial); err == nil {
+					bytes, err = json.Marshal(rateCard)
+				}
+			}
+		}
+		if err = s.handleResponse(writer, err, bytes); err != nil {
+			s.Error("rate card update -  %s", err)
+		}
+	}
+}
+
+func (s *restService) endpointRateCardDelete() func(http.ResponseWriter, *http.Request) {
+	return func(writer http.ResponseWriter, request *http.Request) {
+		var err error
+
+		id := idFromPath(mux.Vars(request))
+		err = s.RateCardDelete(request.Context(), id)
+		if err = s.handleResponse(writer, err, nil); err != nil {
+			s.Error("rate card delete -  %s", err)
+		}
+	}
+}
+
+func (s *restService) endpointInvoiceCreate() func(http.ResponseWriter, *http.Request) {
+	return func(writer http.ResponseWriter, request *http.Request) {
+		var invoicePartial data.InvoicePartial
+		var invoice *data.Invoice
+		var bytes []byte
+		var err error
+
+		if bytes, err = io.ReadAll(request.Body); err == nil {
+			if err = json.Unmarshal(bytes, &invoicePartial); err == nil {
+				if invoice, err = s.InvoiceCreate(request.Context(), invoicePartial); err == nil {
+					bytes, err = json.Marshal(invoice)
+				}
+			}
+		}
+		if err = s.handleResponse(writer, err, bytes); err != nil {
+			s.Error("invoice create -  %s", err)
+		}
+	}
+}
+
+func (s *restService) endpointInvoiceRead() func(http.ResponseWriter, *http.Request) {
+	return func(writer http.ResponseWriter, request *http.Request) {
+		var invoice *data.Invoice
+		var bytes []byte
+		var err error
+
+		id := idFromPath(mux.Vars(request))
+		if invoice, err = s.InvoiceRead(request.Context(), id); err == nil {
+			bytes, err = json.Marshal(invoice)
+		}
+		if err = s.handleResponse(writer, err, bytes); err != nil {
+			s.Error("invoice read -  %s", err)
+		}
+	}
+}
+
+func (s *restService) endpointInvoicesRead() func(http.ResponseWriter, *http.Request) {
+	return func(writer http.ResponseWriter, request *http.Request) {
+		var search data.InvoiceSearch
+		var invoices []*data.Invoice
+		var bytes []byte
+		var err error
+
+		search.FromParams(request.URL.Query())
+		if invoices, err = s.InvoicesRead(request.Context(), search); err == nil {
+			bytes, err = json.Marshal(invoices)
+		}
+		if err = s.handleResponse(writer, err, bytes); err != nil {
+			s.Error("invoices read -  %s", err)
+		}
+	}
+}
+
+func (s *restService) endpointInvoiceDelete() func(http.ResponseWriter, *http.Request) {
+	return func(writer http.ResponseWriter, request *http.Request) {
+		var err error
+
+		id := idFromPath(mux.Vars(request))
+		err = s.InvoiceDelete(request.Context(), id)
+		if err = s.handleResponse(writer, err, nil); err != nil {
+			s.Error("invoice delete -  %s", err)
+		}
+	}
+}
+
+func (s *restService) endpointTimesheetsRead() func(http.ResponseWriter, *http.Request) {
+	return func(writer http.ResponseWriter, request *http.Request) {
+		var search data.TimesheetSearch
+		var timesheets []*data.Timesheet
+		var bytes []byte
+		var err error
+
+		search.FromParams(request.URL.Query())
+		if timesheets, err = s.TimesheetsRead(request.Context(), search); err == nil {
+			bytes, err = json.Marshal(timesheets)
+		}
+		if err = s.handleResponse(writer, err, bytes); err != nil {
+			s.Error("timesheets read -  %s", err)
+		}
+	}
+}
+
 func (s *restService) SetUtilities(parameters ...interface{}) {
 	for _, parameter := range parameters {
 		switch p := parameter.(type) {
@@ -340,11 +745,35 @@ func (s *restService) BuildRoutes() []internal_rest.HandleFuncConfig {
 		{Route: data.RouteTimersIDStart, Method: http.MethodPut, HandleFx: s.endpointTimerStart()},
 		{Route: data.RouteTimersIDStop, Method: http.MethodPut, HandleFx: s.endpointTimerStop()},
 		{Route: data.RouteTimersIDSubmit, Method: http.MethodPut, HandleFx: s.endpointTimerSubmit()},
+		{Route: data.RouteTimersIDHistory, Method: http.MethodGet, HandleFx: s.endpointTimerHistory()},
+		{Route: data.RouteTimersIDRestore, Method: http.MethodPut, HandleFx: s.endpointTimerRestore()},
 		//time slice
 		{Route: data.RouteTimeSlices, Method: http.MethodPost, HandleFx: s.endpointTimeSliceCreate()},
 		{Route: data.RouteTimeSlicesID, Method: http.MethodGet, HandleFx: s.endpointTimeSliceRead()},
 		{Route: data.RouteTimeSlices, Method: http.MethodGet, HandleFx: s.endpointTimeSlicesRead()},
 		{Route: data.RouteTimeSlicesID, Method: http.MethodPut, HandleFx: s.endpointTimeSliceUpdate()},
 		{Route: data.RouteTimeSlicesID, Method: http.MethodDelete, HandleFx: s.endpointTimeSliceDelete()},
+		{Route: data.RouteTimeSlicesIDSplit, Method: http.MethodPut, HandleFx: s.endpointTimeSliceSplit()},
+		{Route: data.RouteTimeSlicesMerge, Method: http.MethodPost, HandleFx: s.endpointTimeSlicesMerge()},
+		{Route: data.RouteTimeSlicesIDHistory, Method: http.MethodGet, HandleFx: s.endpointTimeSliceHistory()},
+		//project
+		{Route: data.RouteProjects, Method: http.MethodPost, HandleFx: s.endpointProjectCreate()},
+		{Route: data.RouteProjectsSearch, Method: http.MethodGet, HandleFx: s.endpointProjectsRead()},
+		{Route: data.RouteProjectsID, Method: http.MethodGet, HandleFx: s.endpointProjectRead()},
+		{Route: data.RouteProjectsID, Method: http.MethodPut, HandleFx: s.endpointProjectUpdate()},
+		{Route: data.RouteProjectsID, Method: http.MethodDelete, HandleFx: s.endpointProjectDelete()},
+		//rate card
+		{Route: data.RouteRateCards, Method: http.MethodPost, HandleFx: s.endpointRateCardCreate()},
+		{Route: data.RouteRateCardsSearch, Method: http.MethodGet, HandleFx: s.endpointRateCardsRead()},
+		{Route: data.RouteRateCardsID, Method: http.MethodGet, HandleFx: s.endpointRateCardRead()},
+		{Route: data.RouteRateCardsID, Method: http.MethodPut, HandleFx: s.endpointRateCardUpdate()},
+		{Route: data.RouteRateCardsID, Method: http.MethodDelete, HandleFx: s.endpointRateCardDelete()},
+		//invoice
+		{Route: data.RouteInvoices, Method: http.MethodPost, HandleFx: s.endpointInvoiceCreate()},
+		{Route: data.RouteInvoicesSearch, Method: http.MethodGet, HandleFx: s.endpointInvoicesRead()},
+		{Route: data.RouteInvoicesID, Method: http.MethodGet, HandleFx: s.endpointInvoiceRead()},
+		{Route: data.RouteInvoicesID, Method: http.MethodDelete, HandleFx: s.endpointInvoiceDelete()},
+		//timesheet
+		{Route: data.RouteTimesheets, Method: http.MethodGet, HandleFx: s.endpointTimesheetsRead()},
 	}
 }
@@ -43,7 +43,7 @@ var (
 	configMetaFile           = new(internal_file.Configuration)
 	configLogic              = new(logic.Configuration)
 	configServer             = new(internal_server.Configuration)
-	configChangesClientRest  = new(changesclientrest.Configuration)
+	configChangesClientRest  = changesclientrest.NewConfiguration()
 	configChangesClientKafka = new(changesclientkafka.Configuration)
 	configKafkaClient        = new(internal_kafka.Configuration)
 )